@@ -0,0 +1,112 @@
+// Command simhash-eval sweeps SimHash configurations over a labeled corpus of duplicate and
+// non-duplicate pairs, and reports the precision, recall and ROC curve of each of them.
+//
+//	simhash-eval -pairs simhash/fixtures/pairs.jsonl -languages english,mixed -topn 0,5,20 -normalize none,all
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/amazingchow/photon-dance-bigdata-toolkit/simhash"
+	"github.com/amazingchow/photon-dance-bigdata-toolkit/simhash/eval"
+)
+
+var (
+	_Languages = map[string]simhash.LanguageType{
+		"english":  simhash.ENGLISH,
+		"chinese":  simhash.CHINESE,
+		"japanese": simhash.JAPANESE,
+		"korean":   simhash.KOREAN,
+		"auto":     simhash.AUTO,
+		"mixed":    simhash.MIXED,
+	}
+
+	_Tokenizers = map[string]func() simhash.Tokenizer{
+		"default":  func() simhash.Tokenizer { return nil },
+		"word":     func() simhash.Tokenizer { return simhash.WordTokenizer{} },
+		"bigram":   func() simhash.Tokenizer { return simhash.CJKBigramTokenizer{} },
+		"ngram2":   func() simhash.Tokenizer { return simhash.NewNGramTokenizer(2) },
+		"ngram3":   func() simhash.Tokenizer { return simhash.NewNGramTokenizer(3) },
+		"shingle2": func() simhash.Tokenizer { return simhash.NewShingleTokenizer(nil, 2) },
+	}
+
+	_Normalizations = map[string]simhash.Normalization{
+		"none": simhash.NormalizeNone,
+		"all":  simhash.NormalizeAll,
+	}
+)
+
+func main() {
+	pairsPath := flag.String("pairs", "", "labeled pairs, as JSON lines {\"a\": ..., \"b\": ..., \"duplicate\": ...}")
+	languages := flag.String("languages", "mixed", "comma-separated languages: english, chinese, japanese, korean, auto, mixed")
+	tokenizers := flag.String("tokenizers", "default", "comma-separated tokenizers: default, word, bigram, ngram2, ngram3, shingle2")
+	normalizations := flag.String("normalize", "none", "comma-separated normalizations: none, all")
+	topNs := flag.String("topn", "0", "comma-separated topN values, 0 uses every token")
+	dict := flag.String("dict", "", "comma-separated Chinese dictionary files, the built-in dictionary if empty")
+	roc := flag.Bool("roc", false, "print the ROC curve of every configuration as CSV")
+	flag.Parse()
+
+	if *pairsPath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	pairs, err := eval.LoadPairsFromFile(*pairsPath)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to load pairs")
+	}
+
+	var ns []uint32
+	for _, s := range strings.Split(*topNs, ",") {
+		n, err := strconv.ParseUint(strings.TrimSpace(s), 10, 32)
+		if err != nil {
+			log.Fatal().Err(err).Msgf("invalid topN %q", s)
+		}
+		ns = append(ns, uint32(n))
+	}
+
+	var configs []eval.Config
+	for _, l := range strings.Split(*languages, ",") {
+		language, ok := _Languages[l]
+		if !ok {
+			log.Fatal().Msgf("unsupported language %q", l)
+		}
+		for _, t := range strings.Split(*tokenizers, ",") {
+			tokenizer, ok := _Tokenizers[t]
+			if !ok {
+				log.Fatal().Msgf("unsupported tokenizer %q", t)
+			}
+			for _, n := range strings.Split(*normalizations, ",") {
+				normalization, ok := _Normalizations[n]
+				if !ok {
+					log.Fatal().Msgf("unsupported normalization %q", n)
+				}
+				sh, err := simhash.NewSimHashWithDict(language, *dict, tokenizer())
+				if err != nil {
+					log.Fatal().Err(err).Msg("failed to create SimHash")
+				}
+				sh.SetNormalization(normalization)
+				configs = append(configs, eval.Config{Name: l + "/" + t + "/" + n, SimHash: sh})
+			}
+		}
+	}
+
+	results := eval.Sweep(configs, ns, pairs)
+	fmt.Printf("%d pairs\n", len(pairs))
+	for _, r := range results {
+		fmt.Println(r)
+	}
+	if *roc {
+		for _, r := range results {
+			fmt.Printf("\n# %s topN=%d\n", r.Config.Name, r.Config.TopN)
+			if err := r.WriteROC(os.Stdout); err != nil {
+				log.Fatal().Err(err).Msg("failed to write ROC curve")
+			}
+		}
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"math/bits"
 	"math/rand"
 	"sync"
+
+	"github.com/amazingchow/photon-dance-bigdata-toolkit/hash"
 )
 
 const (
@@ -19,9 +21,21 @@ type CuckooFilter struct {
 	buckets   []Bucket
 	bucketPow uint
 	count     uint
+
+	key *hash.SipKey
 }
 
 func NewCuckooFilter(cap uint) *CuckooFilter {
+	return newCuckooFilter(cap, nil)
+}
+
+// NewKeyedCuckooFilter creates a CuckooFilter whose fingerprints and indexes are derived from
+// SipHash keyed by key, use it when items come from untrusted input. The key is persisted by Serialize.
+func NewKeyedCuckooFilter(cap uint, key hash.SipKey) *CuckooFilter {
+	return newCuckooFilter(cap, &key)
+}
+
+func newCuckooFilter(cap uint, key *hash.SipKey) *CuckooFilter {
 	if cap == 0 {
 		cap = 1024 * 1024 * 256
 	}
@@ -31,9 +45,18 @@ func NewCuckooFilter(cap uint) *CuckooFilter {
 		buckets:   make([]Bucket, cap),
 		bucketPow: uint(bits.TrailingZeros(cap)),
 		count:     0,
+		key:       key,
 	}
 }
 
+// fingerprintAndIndex returns f = fingerprint(x) and i_1 = hash(x).
+func (cf *CuckooFilter) fingerprintAndIndex(x string) (Fingerprint, uint) {
+	if cf.key != nil {
+		return GetKeyedFingerprintAndIndex(x, *cf.key, cf.bucketPow)
+	}
+	return GetFingerprint(x), GetOneIndex(x, cf.bucketPow)
+}
+
 func resizeCap(cap uint) uint {
 	cap--
 	cap |= cap >> 1
@@ -70,8 +93,7 @@ func (cf *CuckooFilter) Insert(x string) bool {
 	cf.mu.Lock()
 	defer cf.mu.Unlock()
 
-	fp := GetFingerprint(x)
-	i1 := GetOneIndex(x, cf.bucketPow)
+	fp, i1 := cf.fingerprintAndIndex(x)
 	if cf.insert(i1, fp) {
 		return true
 	}
@@ -116,8 +138,7 @@ func (cf *CuckooFilter) Lookup(x string) bool {
 	cf.mu.RLock()
 	defer cf.mu.RUnlock()
 
-	fp := GetFingerprint(x)
-	i1 := GetOneIndex(x, cf.bucketPow)
+	fp, i1 := cf.fingerprintAndIndex(x)
 	if cf.buckets[i1].GetFingerprintIndex(fp) != -1 {
 		return true
 	}
@@ -139,8 +160,7 @@ func (cf *CuckooFilter) Delete(x string) bool {
 	cf.mu.Lock()
 	defer cf.mu.Unlock()
 
-	fp := GetFingerprint(x)
-	i1 := GetOneIndex(x, cf.bucketPow)
+	fp, i1 := cf.fingerprintAndIndex(x)
 	if cf.delete(i1, fp) {
 		return true
 	}
@@ -175,12 +195,35 @@ func (cf *CuckooFilter) Count() uint {
 	return cf.count
 }
 
+const (
+	_KeyedMarker byte = 0x01
+	// marker + key
+	_KeyedHeaderSize = 1 + len(hash.SipKey{})
+)
+
 // Serialize returns a byte slice representing a CuckooFilter.
+/*
+	An unkeyed CuckooFilter is serialized as its buckets only, so the length is a multiple of 4.
+	A keyed CuckooFilter is prefixed with a marker byte and the 16-byte hash key, so the length
+	is a multiple of 4 plus 1, which keeps the unkeyed format unchanged.
+*/
 func Serialize(cf *CuckooFilter) []byte {
-	bytes := make([]byte, len(cf.buckets)*_BucketSize)
+	cf.mu.RLock()
+	defer cf.mu.RUnlock()
+
+	var offset int
+	var bytes []byte
+	if cf.key != nil {
+		bytes = make([]byte, _KeyedHeaderSize+len(cf.buckets)*_BucketSize)
+		bytes[0] = _KeyedMarker
+		copy(bytes[1:], cf.key[:])
+		offset = _KeyedHeaderSize
+	} else {
+		bytes = make([]byte, len(cf.buckets)*_BucketSize)
+	}
 	for i := range cf.buckets {
 		for j, fp := range cf.buckets[i] {
-			bytes[i*len(cf.buckets[i])+j] = byte(fp)
+			bytes[offset+i*len(cf.buckets[i])+j] = byte(fp)
 		}
 	}
 	return bytes
@@ -188,6 +231,15 @@ func Serialize(cf *CuckooFilter) []byte {
 
 // Deserialize returns a CuckooFilter from a byte slice.
 func Deserialize(bytes []byte) (*CuckooFilter, error) {
+	var key *hash.SipKey
+	if len(bytes)%_BucketSize == _KeyedHeaderSize%_BucketSize && len(bytes) >= _KeyedHeaderSize {
+		if bytes[0] != _KeyedMarker {
+			return nil, fmt.Errorf("unknown marker byte 0x%02x", bytes[0])
+		}
+		key = new(hash.SipKey)
+		copy(key[:], bytes[1:_KeyedHeaderSize])
+		bytes = bytes[_KeyedHeaderSize:]
+	}
 	if len(bytes)%_BucketSize != 0 {
 		return nil, fmt.Errorf("expected input byte slice to be multiple of %d, got %d", _BucketSize, len(bytes))
 	}
@@ -207,5 +259,6 @@ func Deserialize(bytes []byte) (*CuckooFilter, error) {
 		buckets:   buckets,
 		bucketPow: uint(bits.TrailingZeros(uint(len(buckets)))),
 		count:     count,
+		key:       key,
 	}, nil
 }
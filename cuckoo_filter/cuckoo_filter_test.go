@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/amazingchow/photon-dance-bigdata-toolkit/hash"
 )
 
 func TestCuckooFilter(t *testing.T) {
@@ -18,3 +20,30 @@ func TestCuckooFilter(t *testing.T) {
 	bf.Delete("PHA")
 	assert.Equal(t, false, bf.Lookup("PHA"))
 }
+
+func TestKeyedCuckooFilterSerialization(t *testing.T) {
+	key, err := hash.NewSipKey()
+	assert.Empty(t, err)
+
+	cf := NewKeyedCuckooFilter(1024, key)
+	assert.Equal(t, true, cf.Insert("BTC"))
+	assert.Equal(t, true, cf.Insert("ETH"))
+
+	ncf, err := Deserialize(Serialize(cf))
+	assert.Empty(t, err)
+	assert.Equal(t, key, *ncf.key)
+	assert.Equal(t, uint(2), ncf.Count())
+	assert.Equal(t, true, ncf.Lookup("BTC"))
+	assert.Equal(t, true, ncf.Lookup("ETH"))
+	assert.Equal(t, true, ncf.Delete("ETH"))
+	assert.Equal(t, false, ncf.Lookup("ETH"))
+
+	cf = NewCuckooFilter(1024)
+	cf.Insert("BTC")
+	bytes := Serialize(cf)
+	assert.Equal(t, 0, len(bytes)%_BucketSize)
+	ncf, err = Deserialize(bytes)
+	assert.Empty(t, err)
+	assert.Nil(t, ncf.key)
+	assert.Equal(t, true, ncf.Lookup("BTC"))
+}
@@ -33,6 +33,17 @@ func GetOneIndex(x string, bucketPow uint) uint {
 	return i1
 }
 
+// GetKeyedFingerprintAndIndex is the keyed version of GetFingerprint and GetOneIndex,
+// both are derived from a single SipHash-2-4 of x.
+func GetKeyedFingerprintAndIndex(x string, key hash.SipKey, bucketPow uint) (Fingerprint, uint) {
+	h := hash.SIPHASH24(x, key)
+	// use least significant bits for fingerprint
+	fp := Fingerprint(h%255 + 1)
+	// use most significant bits for derived index
+	i1 := uint(h>>32) & _Masks[bucketPow]
+	return fp, i1
+}
+
 func GetAnotherIndex(i uint, fp Fingerprint, bucketPow uint) uint {
 	mask := _Masks[bucketPow]
 	hash := _HashForFingerprint[fp] & mask
@@ -3,9 +3,7 @@ module github.com/amazingchow/photon-dance-bigdata-toolkit
 go 1.15
 
 require (
-	github.com/adamzy/cedar-go v0.0.0-20170805034717-80a9c64b256d // indirect
-	github.com/huichen/sego v0.0.0-20180617034105-3f3c8a8cfacc
-	github.com/issue9/assert v1.4.1 // indirect
 	github.com/rs/zerolog v1.23.0
 	github.com/stretchr/testify v1.7.0
+	golang.org/x/text v0.3.7
 )
@@ -0,0 +1,160 @@
+package hash
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+)
+
+/*
+	Consistent hashing places every member on a 32-bit ring at many pseudo-random
+	points (virtual nodes), a key belongs to the first member clockwise from its own
+	hash. When a member joins or leaves, only the keys on the arcs it gains or loses
+	move, around 1/n of them, instead of nearly all of them as with hash(key) % n.
+*/
+
+// More info: "Consistent Hashing and Random Trees: Distributed Caching Protocols for Relieving Hot Spots on the World Wide Web"
+
+const _DefaultVirtualNodes = 160
+
+type virtualNode struct {
+	h    uint32
+	node string
+}
+
+// Ring implements the consistent hashing ring with virtual nodes and weighted members.
+type Ring struct {
+	mu sync.RWMutex
+
+	hashFunc     HashFunc
+	virtualNodes int
+	weights      map[string]int
+	ring         []virtualNode
+}
+
+// NewRing creates a Ring, each member with weight w owns w * virtualNodes points on the ring.
+// If virtualNodes is 0, 160 is used. If fn is nil, MurmurHash3_x86_32 is used.
+func NewRing(virtualNodes int, fn HashFunc) *Ring {
+	if virtualNodes <= 0 {
+		virtualNodes = _DefaultVirtualNodes
+	}
+	if fn == nil {
+		fn = func(key string) uint32 { return murmur_hash_3_x86_32(key, 0) }
+	}
+	return &Ring{
+		hashFunc:     fn,
+		virtualNodes: virtualNodes,
+		weights:      make(map[string]int),
+	}
+}
+
+// Add adds a member with the given weight, or updates its weight if it is already a member.
+// A weight <= 0 is treated as 1.
+func (r *Ring) Add(node string, weight int) {
+	if weight <= 0 {
+		weight = 1
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if w, ok := r.weights[node]; ok {
+		if w == weight {
+			return
+		}
+		r.removeVirtualNodes(node)
+	}
+	r.weights[node] = weight
+	for i := 0; i < weight*r.virtualNodes; i++ {
+		r.ring = append(r.ring, virtualNode{h: r.hashFunc(node + "#" + strconv.Itoa(i)), node: node})
+	}
+	// break ties by member name, so the ring does not depend on the order members are added.
+	sort.Slice(r.ring, func(i, j int) bool {
+		if r.ring[i].h != r.ring[j].h {
+			return r.ring[i].h < r.ring[j].h
+		}
+		return r.ring[i].node < r.ring[j].node
+	})
+}
+
+// Remove removes a member, only the keys it owned are moved to other members.
+func (r *Ring) Remove(node string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.weights[node]; !ok {
+		return
+	}
+	delete(r.weights, node)
+	r.removeVirtualNodes(node)
+}
+
+func (r *Ring) removeVirtualNodes(node string) {
+	ring := r.ring[:0]
+	for _, vn := range r.ring {
+		if vn.node != node {
+			ring = append(ring, vn)
+		}
+	}
+	r.ring = ring
+}
+
+// Members returns all members in sorted order.
+func (r *Ring) Members() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	members := make([]string, 0, len(r.weights))
+	for node := range r.weights {
+		members = append(members, node)
+	}
+	sort.Strings(members)
+	return members
+}
+
+// Get returns the member which owns key, false if the ring is empty.
+func (r *Ring) Get(key string) (string, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if len(r.ring) == 0 {
+		return "", false
+	}
+	return r.ring[r.search(key)].node, true
+}
+
+// GetN returns up to n distinct members for key, walking the ring clockwise from the owner of key.
+// The first one is the same as Get, the rest can be used as replicas.
+func (r *Ring) GetN(key string, n int) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if len(r.ring) == 0 || n <= 0 {
+		return nil
+	}
+	if n > len(r.weights) {
+		n = len(r.weights)
+	}
+
+	nodes := make([]string, 0, n)
+	seen := make(map[string]struct{}, n)
+	for i, start := 0, r.search(key); i < len(r.ring) && len(nodes) < n; i++ {
+		vn := r.ring[(start+i)%len(r.ring)]
+		if _, ok := seen[vn.node]; ok {
+			continue
+		}
+		seen[vn.node] = struct{}{}
+		nodes = append(nodes, vn.node)
+	}
+	return nodes
+}
+
+// search returns the position of the first virtual node clockwise from key.
+func (r *Ring) search(key string) int {
+	h := r.hashFunc(key)
+	i := sort.Search(len(r.ring), func(i int) bool { return r.ring[i].h >= h })
+	if i == len(r.ring) {
+		i = 0
+	}
+	return i
+}
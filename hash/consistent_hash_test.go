@@ -0,0 +1,105 @@
+package hash
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func ringKeys(n int) []string {
+	keys := make([]string, n)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("key-%d", i)
+	}
+	return keys
+}
+
+func TestRingDistribution(t *testing.T) {
+	r := NewRing(0, nil)
+	for i := 0; i < 10; i++ {
+		r.Add(fmt.Sprintf("node-%d", i), 1)
+	}
+	r.Add("node-heavy", 2)
+
+	load := make(map[string]int)
+	keys := ringKeys(120000)
+	for _, k := range keys {
+		node, ok := r.Get(k)
+		assert.True(t, ok)
+		load[node]++
+	}
+
+	// 12 units of weight in total, each unit should get ~1/12 of the keys.
+	mean := float64(len(keys)) / 12
+	var maxDeviation float64
+	for node, cnt := range load {
+		expected := mean
+		if node == "node-heavy" {
+			expected *= 2
+		}
+		deviation := math.Abs(float64(cnt)-expected) / expected
+		maxDeviation = math.Max(maxDeviation, deviation)
+		assert.True(t, deviation < 0.2, "%s owns %d keys, expected ~%.0f", node, cnt, expected)
+	}
+	t.Logf("load: %v, max deviation: %.1f%%", load, maxDeviation*100)
+}
+
+func TestRingMinimalMovement(t *testing.T) {
+	r := NewRing(0, nil)
+	for i := 0; i < 10; i++ {
+		r.Add(fmt.Sprintf("node-%d", i), 1)
+	}
+	keys := ringKeys(50000)
+	before := make(map[string]string, len(keys))
+	for _, k := range keys {
+		before[k], _ = r.Get(k)
+	}
+
+	// adding a member only moves keys onto the new member.
+	r.Add("node-new", 1)
+	moved := 0
+	for _, k := range keys {
+		node, _ := r.Get(k)
+		if node != before[k] {
+			assert.Equal(t, "node-new", node)
+			moved++
+		}
+	}
+	assert.InDelta(t, float64(len(keys))/11, float64(moved), float64(len(keys))/11*0.25)
+
+	// removing it again restores the original placement.
+	r.Remove("node-new")
+	for _, k := range keys {
+		node, _ := r.Get(k)
+		assert.Equal(t, before[k], node)
+	}
+}
+
+func TestRingGetN(t *testing.T) {
+	r := NewRing(16, FNV1A32)
+	_, ok := r.Get("BTC")
+	assert.False(t, ok)
+	assert.Nil(t, r.GetN("BTC", 3))
+
+	r.Add("a", 1)
+	r.Add("b", 1)
+	r.Add("c", 3)
+	assert.Equal(t, []string{"a", "b", "c"}, r.Members())
+
+	replicas := r.GetN("BTC", 3)
+	assert.Equal(t, 3, len(replicas))
+	owner, _ := r.Get("BTC")
+	assert.Equal(t, owner, replicas[0])
+	seen := map[string]bool{}
+	for _, n := range replicas {
+		assert.False(t, seen[n])
+		seen[n] = true
+	}
+	assert.Equal(t, 3, len(r.GetN("BTC", 10)))
+
+	r.Remove("c")
+	assert.Equal(t, []string{"a", "b"}, r.Members())
+	assert.Equal(t, 2, len(r.GetN("BTC", 3)))
+}
@@ -3,75 +3,141 @@ package hash
 type HashFunc func(key string) uint32
 
 // DoubleHashing provides double-hashing technique: hi(x) = h1(x) + f(x) * h2(x), f(x) = i * i
+//
+// Deprecated: each DoubleHashing_N re-hashes the key, use KIndexes or KIndexGenerator to derive
+// any number of indexes from a single hash.
 func DoubleHashing(key string, factor uint32) uint32 {
-	return murmur_hash_2(key) + (factor*factor)*fnv_1a_32(key)
+	return murmur_hash_2(key, _Murmur2DefaultSeed) + (factor*factor)*fnv_1a_32(key)
 }
 
+// DoubleHashing_2 is DoubleHashing with factor 2.
+//
+// Deprecated: use KIndexes or KIndexGenerator.
 func DoubleHashing_2(key string) uint32 {
 	return DoubleHashing(key, 2)
 }
 
+// DoubleHashing_3 is DoubleHashing with factor 3.
+//
+// Deprecated: use KIndexes or KIndexGenerator.
 func DoubleHashing_3(key string) uint32 {
 	return DoubleHashing(key, 3)
 }
 
+// DoubleHashing_5 is DoubleHashing with factor 5.
+//
+// Deprecated: use KIndexes or KIndexGenerator.
 func DoubleHashing_5(key string) uint32 {
 	return DoubleHashing(key, 5)
 }
 
+// DoubleHashing_7 is DoubleHashing with factor 7.
+//
+// Deprecated: use KIndexes or KIndexGenerator.
 func DoubleHashing_7(key string) uint32 {
 	return DoubleHashing(key, 7)
 }
 
+// DoubleHashing_11 is DoubleHashing with factor 11.
+//
+// Deprecated: use KIndexes or KIndexGenerator.
 func DoubleHashing_11(key string) uint32 {
 	return DoubleHashing(key, 11)
 }
 
+// DoubleHashing_13 is DoubleHashing with factor 13.
+//
+// Deprecated: use KIndexes or KIndexGenerator.
 func DoubleHashing_13(key string) uint32 {
 	return DoubleHashing(key, 13)
 }
 
+// DoubleHashing_17 is DoubleHashing with factor 17.
+//
+// Deprecated: use KIndexes or KIndexGenerator.
 func DoubleHashing_17(key string) uint32 {
 	return DoubleHashing(key, 17)
 }
 
+// DoubleHashing_19 is DoubleHashing with factor 19.
+//
+// Deprecated: use KIndexes or KIndexGenerator.
 func DoubleHashing_19(key string) uint32 {
 	return DoubleHashing(key, 19)
 }
 
+// SipDoubleHashing provides the keyed version of double-hashing technique: hi(x) = h1(x) + f(x) * h2(x), f(x) = i * i,
+// where h1(x) and h2(x) are the low and high 32 bits of SipHash-2-4(x), so hi(x) can not be predicted without sk.
+//
+// Deprecated: each SipDoubleHashing function re-hashes the key, hash it once with SIPHASH24 and derive
+// the indexes from its low and high 32 bits with KIndexGenerator.
+func SipDoubleHashing(sk SipKey, factor uint32) HashFunc {
+	return func(key string) uint32 {
+		h := SIPHASH24(key, sk)
+		return uint32(h) + (factor*factor)*uint32(h>>32)
+	}
+}
+
 // TripleHashing provides triple-hashing technique: hi(x) = h1(x) + f(x) * h2(x) + g(x) * h3(x), f(x) = i, g(x) = i * i
+//
+// Deprecated: each TripleHashing_N re-hashes the key, use KIndexes or KIndexGenerator to derive
+// any number of indexes from a single hash.
 func TripleHashing(key string, factor uint32) uint32 {
-	return murmur_hash_2(key) + factor*fnv_1a_32(key) + (factor*factor)*bkdr_hash(key)
+	return murmur_hash_2(key, _Murmur2DefaultSeed) + factor*fnv_1a_32(key) + (factor*factor)*bkdr_hash(key)
 }
 
+// TripleHashing_2 is TripleHashing with factor 2.
+//
+// Deprecated: use KIndexes or KIndexGenerator.
 func TripleHashing_2(key string) uint32 {
 	return TripleHashing(key, 2)
 }
 
+// TripleHashing_3 is TripleHashing with factor 3.
+//
+// Deprecated: use KIndexes or KIndexGenerator.
 func TripleHashing_3(key string) uint32 {
 	return TripleHashing(key, 3)
 }
 
+// TripleHashing_5 is TripleHashing with factor 5.
+//
+// Deprecated: use KIndexes or KIndexGenerator.
 func TripleHashing_5(key string) uint32 {
 	return TripleHashing(key, 5)
 }
 
+// TripleHashing_7 is TripleHashing with factor 7.
+//
+// Deprecated: use KIndexes or KIndexGenerator.
 func TripleHashing_7(key string) uint32 {
 	return TripleHashing(key, 7)
 }
 
+// TripleHashing_11 is TripleHashing with factor 11.
+//
+// Deprecated: use KIndexes or KIndexGenerator.
 func TripleHashing_11(key string) uint32 {
 	return TripleHashing(key, 11)
 }
 
+// TripleHashing_13 is TripleHashing with factor 13.
+//
+// Deprecated: use KIndexes or KIndexGenerator.
 func TripleHashing_13(key string) uint32 {
 	return TripleHashing(key, 13)
 }
 
+// TripleHashing_17 is TripleHashing with factor 17.
+//
+// Deprecated: use KIndexes or KIndexGenerator.
 func TripleHashing_17(key string) uint32 {
 	return TripleHashing(key, 17)
 }
 
+// TripleHashing_19 is TripleHashing with factor 19.
+//
+// Deprecated: use KIndexes or KIndexGenerator.
 func TripleHashing_19(key string) uint32 {
 	return TripleHashing(key, 19)
 }
@@ -0,0 +1,65 @@
+package hash
+
+import (
+	"fmt"
+	"testing"
+)
+
+var _BenchKeyLens = []int{4, 16, 64, 256, 1024, 8192}
+
+func benchmarkHash(b *testing.B, h func(key string)) {
+	for _, n := range _BenchKeyLens {
+		key := string(testInput(n))
+		b.Run(fmt.Sprintf("%d", n), func(b *testing.B) {
+			b.SetBytes(int64(n))
+			for i := 0; i < b.N; i++ {
+				h(key)
+			}
+		})
+	}
+}
+
+var _Sink64 uint64
+
+func BenchmarkFNV1A64(b *testing.B) {
+	benchmarkHash(b, func(key string) { _Sink64 = FNV1A64(key) })
+}
+
+func BenchmarkMURMUR2(b *testing.B) {
+	benchmarkHash(b, func(key string) { _Sink64 = uint64(MURMUR2(key)) })
+}
+
+func BenchmarkMURMUR3X64_128(b *testing.B) {
+	benchmarkHash(b, func(key string) { _Sink64, _ = MURMUR3X64_128(key, 0) })
+}
+
+func BenchmarkDoubleHashing(b *testing.B) {
+	benchmarkHash(b, func(key string) { _Sink64 = uint64(DoubleHashing_7(key)) })
+}
+
+func BenchmarkTripleHashing(b *testing.B) {
+	benchmarkHash(b, func(key string) { _Sink64 = uint64(TripleHashing_7(key)) })
+}
+
+func BenchmarkXXH64(b *testing.B) {
+	benchmarkHash(b, func(key string) { _Sink64 = XXH64(key, 0) })
+}
+
+func BenchmarkXXH3(b *testing.B) {
+	benchmarkHash(b, func(key string) { _Sink64 = XXH3(key, 0) })
+}
+
+func BenchmarkWYHASH(b *testing.B) {
+	benchmarkHash(b, func(key string) { _Sink64 = WYHASH(key, 0) })
+}
+
+func BenchmarkXXH3Digest(b *testing.B) {
+	data := testInput(64 * 1024)
+	d := NewXXH3Digest(0)
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		d.Reset()
+		d.Write(data) // nolint
+		_Sink64 = d.Sum64()
+	}
+}
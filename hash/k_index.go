@@ -0,0 +1,81 @@
+package hash
+
+import "math/bits"
+
+/*
+	Kirsch and Mitzenmacher showed that a Bloom Filter only needs two independent
+	hash values h1(x) and h2(x) to simulate k hash functions without any loss in the
+	asymptotic false positive rate. Enhanced double hashing adds a cubic term to get
+	rid of the collisions plain double hashing suffers from when h2(x) is a multiple
+	of a small factor of m:
+
+		g_i(x) = h1(x) + i * h2(x) + (i^3 - i) / 6 mod m, i = 0, 1, ..., k-1
+
+	So instead of one function per index (DoubleHashing_N / TripleHashing_N), the key
+	is hashed once into 128 bits and any number of indexes can be derived from it.
+*/
+
+// More info:
+//     1) "Less Hashing, Same Performance: Building a Better Bloom Filter"
+//     2) "Bloom Filters in Probabilistic Verification", section 5.2, enhanced double hashing
+
+// Hash128Func hashes key into two 64-bit values.
+type Hash128Func func(key string) (uint64, uint64)
+
+// DefaultHash128 hashes key with MurmurHash3_x64_128 and seed 0.
+func DefaultHash128(key string) (uint64, uint64) {
+	return murmur_hash_3_x64_128(key, 0)
+}
+
+// KIndexGenerator yields indexes in [0, m) using enhanced double hashing.
+// An m of 0 stands for the full range of 2^64.
+type KIndexGenerator struct {
+	x, y, m uint64
+	i       uint64
+}
+
+// NewKIndexGenerator creates a KIndexGenerator from the two 64-bit hash values of a key.
+func NewKIndexGenerator(h1, h2 uint64, m uint64) KIndexGenerator {
+	if m != 0 {
+		h1 %= m
+		h2 %= m
+	}
+	return KIndexGenerator{x: h1, y: h2, m: m}
+}
+
+// Next returns g_i(x) and advances i.
+func (g *KIndexGenerator) Next() uint64 {
+	idx := g.x
+	g.i++
+	inc := g.i
+	if g.m != 0 {
+		inc %= g.m
+	}
+	g.x = addMod(g.x, g.y, g.m)
+	g.y = addMod(g.y, inc, g.m)
+	return idx
+}
+
+// KIndexes hashes key once with DefaultHash128 and returns k indexes in [0, m).
+func KIndexes(key string, k int, m uint64) []uint64 {
+	h1, h2 := DefaultHash128(key)
+	return AppendKIndexes(make([]uint64, 0, k), h1, h2, k, m)
+}
+
+// AppendKIndexes appends k indexes in [0, m) derived from h1 and h2 to dst and returns the extended slice.
+func AppendKIndexes(dst []uint64, h1, h2 uint64, k int, m uint64) []uint64 {
+	g := NewKIndexGenerator(h1, h2, m)
+	for i := 0; i < k; i++ {
+		dst = append(dst, g.Next())
+	}
+	return dst
+}
+
+// addMod returns (a + b) mod m, given a, b < m. An m of 0 stands for 2^64.
+func addMod(a, b, m uint64) uint64 {
+	s, carry := bits.Add64(a, b, 0)
+	if m != 0 && (carry != 0 || s >= m) {
+		s -= m
+	}
+	return s
+}
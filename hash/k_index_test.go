@@ -0,0 +1,54 @@
+package hash
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// enhancedDoubleHashing computes g_i(x) = h1(x) + i * h2(x) + (i^3 - i) / 6 mod m with big integers.
+func enhancedDoubleHashing(h1, h2 uint64, i int64, m *big.Int) uint64 {
+	bi := big.NewInt(i)
+	g := new(big.Int).SetUint64(h1)
+	g.Add(g, new(big.Int).Mul(bi, new(big.Int).SetUint64(h2)))
+	cube := new(big.Int).Exp(bi, big.NewInt(3), nil)
+	g.Add(g, cube.Sub(cube, bi).Div(cube, big.NewInt(6)))
+	return g.Mod(g, m).Uint64()
+}
+
+func TestKIndexes(t *testing.T) {
+	full := new(big.Int).Lsh(big.NewInt(1), 64)
+	for _, key := range []string{"", "BTC", "ETH", "PHA"} {
+		h1, h2 := DefaultHash128(key)
+		for _, m := range []uint64{1, 7, 1024, 1<<32 + 15, 1<<63 + 25, ^uint64(0), 0} {
+			bm := full
+			if m != 0 {
+				bm = new(big.Int).SetUint64(m)
+			}
+			idx := KIndexes(key, 50, m)
+			assert.Equal(t, 50, len(idx))
+			for i, x := range idx {
+				assert.Equal(t, enhancedDoubleHashing(h1, h2, int64(i), bm), x, "key=%s m=%d i=%d", key, m, i)
+				if m != 0 {
+					assert.True(t, x < m)
+				}
+			}
+		}
+	}
+}
+
+func TestKIndexGenerator(t *testing.T) {
+	h1, h2 := DefaultHash128("BTC")
+	g := NewKIndexGenerator(h1, h2, 1<<20)
+	idx := AppendKIndexes(nil, h1, h2, 7, 1<<20)
+	for i := range idx {
+		assert.Equal(t, idx[i], g.Next())
+	}
+	// the first 7 indexes should not collide in a large range
+	seen := make(map[uint64]struct{})
+	for _, x := range idx {
+		seen[x] = struct{}{}
+	}
+	assert.Equal(t, 7, len(seen))
+}
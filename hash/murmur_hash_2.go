@@ -1,39 +1,36 @@
 package hash
 
-import "unsafe"
-
 /*
 	Forked from Austin Appleby's cpp version.
 
-	!!!Note - this code makes a few assumptions about how your machine behaves:
-
-	1. we can read a 4-byte value from any address without crashing.
-	2. sizeof(int) == 4.
+	The original code reads 4-byte words straight from memory, so it produces
+	different results on little-endian / big-endian machines. Here every word is
+	assembled with explicit little-endian loads, which makes the result identical
+	across architectures and matches the reference little-endian output.
 
-	And it has a few limitations -
+	It still has one limitation -
 
 	1. it will not work incrementally.
-	2. it will not produce the same result on little-endian / big-endian machine.
 */
 
-// More info: https://github.com/aappleby/smhasher/blob/master/src/MurmurHash2.h
+// More info: https://github.com/aappleby/smhasher/blob/master/src/MurmurHash2.cpp
+
+// _Murmur2DefaultSeed is the seed used by MURMUR2, from Jeff Dean's LevelDB.
+const _Murmur2DefaultSeed uint32 = 0xbc9f1d34
 
-func murmur_hash_2(key string) uint32 {
+func murmur_hash_2(key string, seed uint32) uint32 {
 	// 'm' and 'r' are not really magic-number, they just happen to work well here.
 	const m uint32 = 0x5bd1e995
 	const r uint = 24
 
-	// Initialize the hash to a random value.
-	_len := len(key)
-	var len uint32 = *(*uint32)(unsafe.Pointer(&_len))
-	var seed uint32 = 0xbc9f1d34 // from Jeff Dean's LevelDB
-	var h uint32 = seed ^ len
+	// Initialize the hash to a 'random' value.
+	n := len(key)
+	h := seed ^ uint32(n)
 
 	// Mix 4 bytes at a time into the hash.
-	var data []byte = []byte(key)
 	idx := 0
-	for len >= 4 {
-		var k uint32 = *(*uint32)(unsafe.Pointer(&data[idx]))
+	for ; n >= 4; n -= 4 {
+		k := load32(key, idx)
 
 		k *= m
 		k ^= k >> r
@@ -43,22 +40,18 @@ func murmur_hash_2(key string) uint32 {
 		h ^= k
 
 		idx += 4
-		len -= 4
 	}
 
 	// Handle the last few bytes of the input array.
-	switch len {
+	switch n {
 	case 3:
-		x := uint(data[idx+2]) << 16
-		h ^= *(*uint32)(unsafe.Pointer(&x))
+		h ^= uint32(key[idx+2]) << 16
 		fallthrough
 	case 2:
-		x := uint(data[idx+1]) << 8
-		h ^= *(*uint32)(unsafe.Pointer(&x))
+		h ^= uint32(key[idx+1]) << 8
 		fallthrough
 	case 1:
-		x := uint(data[idx])
-		h ^= *(*uint32)(unsafe.Pointer(&x))
+		h ^= uint32(key[idx])
 		h *= m
 	}
 
@@ -70,6 +63,12 @@ func murmur_hash_2(key string) uint32 {
 	return h
 }
 
+// MURMUR2 hashes key with MurmurHash2 and the default seed.
 func MURMUR2(key string) uint32 {
-	return murmur_hash_2(key)
+	return murmur_hash_2(key, _Murmur2DefaultSeed)
+}
+
+// MURMUR2WithSeed hashes key with MurmurHash2 and a caller-provided seed.
+func MURMUR2WithSeed(key string, seed uint32) uint32 {
+	return murmur_hash_2(key, seed)
 }
@@ -0,0 +1,52 @@
+package hash
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// smhasherVerification mirrors SMHasher's VerificationTest: hash keys of the form
+// {0}, {0, 1}, ..., {0, 1, ..., 254} with seed 256-len, then hash the concatenation
+// of all the results with seed 0 and return the first 4 bytes as a little-endian word.
+func smhasherVerification(hashBytes int, h func(key string, seed uint32) []byte) uint32 {
+	key := make([]byte, 256)
+	hashes := make([]byte, 0, hashBytes*256)
+	for i := 0; i < 256; i++ {
+		key[i] = byte(i)
+		hashes = append(hashes, h(string(key[:i]), uint32(256-i))...)
+	}
+	final := h(string(hashes), 0)
+	return binary.LittleEndian.Uint32(final)
+}
+
+func TestMurmurHash2Verification(t *testing.T) {
+	v := smhasherVerification(4, func(key string, seed uint32) []byte {
+		out := make([]byte, 4)
+		binary.LittleEndian.PutUint32(out, MURMUR2WithSeed(key, seed))
+		return out
+	})
+	assert.Equal(t, uint32(0x27864c1e), v)
+}
+
+func TestMurmurHash2(t *testing.T) {
+	cases := []struct {
+		key     string
+		seed0   uint32
+		seedDef uint32
+	}{
+		{"", 0x00000000, 0x471a8188},
+		{"a", 0x92685f5e, 0xe27a35ff},
+		{"ab", 0x1aa14063, 0xde53bea1},
+		{"abc", 0x13577c9b, 0x1ba31e2f},
+		{"abcd", 0x26873021, 0x07375115},
+		{"hello", 0xe56129cb, 0x057bea7f},
+		{"hello, world", 0x4b4c9d80, 0x3d236ea3},
+		{"The quick brown fox jumps over the lazy dog", 0x212729d0, 0xa46603b3},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.seed0, MURMUR2WithSeed(c.key, 0), c.key)
+		assert.Equal(t, c.seedDef, MURMUR2(c.key), c.key)
+	}
+}
@@ -0,0 +1,210 @@
+package hash
+
+import "math/bits"
+
+/*
+	Forked from Austin Appleby's cpp version.
+
+	MurmurHash3 comes in two flavors here -
+
+	1. x86_32, produces a 32-bit hash value, same as Guava's murmur3_32.
+	2. x64_128, produces a 128-bit hash value, same as Guava's murmur3_128, Cassandra's partitioner
+	   and most Bloom Filter implementations out there.
+
+	Both of them read the key with explicit little-endian loads, so they produce the same result
+	on little-endian / big-endian machine.
+*/
+
+// More info: https://github.com/aappleby/smhasher/blob/master/src/MurmurHash3.cpp
+
+func murmur_hash_3_x86_32(key string, seed uint32) uint32 {
+	const c1 uint32 = 0xcc9e2d51
+	const c2 uint32 = 0x1b873593
+
+	n := len(key)
+	h1 := seed
+
+	// body
+	nblocks := n / 4
+	for i := 0; i < nblocks; i++ {
+		k1 := load32(key, i*4)
+
+		k1 *= c1
+		k1 = bits.RotateLeft32(k1, 15)
+		k1 *= c2
+
+		h1 ^= k1
+		h1 = bits.RotateLeft32(h1, 13)
+		h1 = h1*5 + 0xe6546b64
+	}
+
+	// tail
+	tail := nblocks * 4
+	var k1 uint32
+	switch n & 3 {
+	case 3:
+		k1 ^= uint32(key[tail+2]) << 16
+		fallthrough
+	case 2:
+		k1 ^= uint32(key[tail+1]) << 8
+		fallthrough
+	case 1:
+		k1 ^= uint32(key[tail])
+		k1 *= c1
+		k1 = bits.RotateLeft32(k1, 15)
+		k1 *= c2
+		h1 ^= k1
+	}
+
+	// finalization
+	h1 ^= uint32(n)
+	return fmix32(h1)
+}
+
+func murmur_hash_3_x64_128(key string, seed uint32) (uint64, uint64) {
+	const c1 uint64 = 0x87c37b91114253d5
+	const c2 uint64 = 0x4cf5ad432745937f
+
+	n := len(key)
+	h1 := uint64(seed)
+	h2 := uint64(seed)
+
+	// body
+	nblocks := n / 16
+	for i := 0; i < nblocks; i++ {
+		k1 := load64(key, i*16)
+		k2 := load64(key, i*16+8)
+
+		k1 *= c1
+		k1 = bits.RotateLeft64(k1, 31)
+		k1 *= c2
+		h1 ^= k1
+
+		h1 = bits.RotateLeft64(h1, 27)
+		h1 += h2
+		h1 = h1*5 + 0x52dce729
+
+		k2 *= c2
+		k2 = bits.RotateLeft64(k2, 33)
+		k2 *= c1
+		h2 ^= k2
+
+		h2 = bits.RotateLeft64(h2, 31)
+		h2 += h1
+		h2 = h2*5 + 0x38495ab5
+	}
+
+	// tail
+	tail := nblocks * 16
+	var k1, k2 uint64
+	switch n & 15 {
+	case 15:
+		k2 ^= uint64(key[tail+14]) << 48
+		fallthrough
+	case 14:
+		k2 ^= uint64(key[tail+13]) << 40
+		fallthrough
+	case 13:
+		k2 ^= uint64(key[tail+12]) << 32
+		fallthrough
+	case 12:
+		k2 ^= uint64(key[tail+11]) << 24
+		fallthrough
+	case 11:
+		k2 ^= uint64(key[tail+10]) << 16
+		fallthrough
+	case 10:
+		k2 ^= uint64(key[tail+9]) << 8
+		fallthrough
+	case 9:
+		k2 ^= uint64(key[tail+8])
+		k2 *= c2
+		k2 = bits.RotateLeft64(k2, 33)
+		k2 *= c1
+		h2 ^= k2
+		fallthrough
+	case 8:
+		k1 ^= uint64(key[tail+7]) << 56
+		fallthrough
+	case 7:
+		k1 ^= uint64(key[tail+6]) << 48
+		fallthrough
+	case 6:
+		k1 ^= uint64(key[tail+5]) << 40
+		fallthrough
+	case 5:
+		k1 ^= uint64(key[tail+4]) << 32
+		fallthrough
+	case 4:
+		k1 ^= uint64(key[tail+3]) << 24
+		fallthrough
+	case 3:
+		k1 ^= uint64(key[tail+2]) << 16
+		fallthrough
+	case 2:
+		k1 ^= uint64(key[tail+1]) << 8
+		fallthrough
+	case 1:
+		k1 ^= uint64(key[tail])
+		k1 *= c1
+		k1 = bits.RotateLeft64(k1, 31)
+		k1 *= c2
+		h1 ^= k1
+	}
+
+	// finalization
+	h1 ^= uint64(n)
+	h2 ^= uint64(n)
+
+	h1 += h2
+	h2 += h1
+
+	h1 = fmix64(h1)
+	h2 = fmix64(h2)
+
+	h1 += h2
+	h2 += h1
+
+	return h1, h2
+}
+
+// fmix32 forces all bits of a hash block to avalanche.
+func fmix32(h uint32) uint32 {
+	h ^= h >> 16
+	h *= 0x85ebca6b
+	h ^= h >> 13
+	h *= 0xc2b2ae35
+	h ^= h >> 16
+	return h
+}
+
+// fmix64 forces all bits of a hash block to avalanche.
+func fmix64(k uint64) uint64 {
+	k ^= k >> 33
+	k *= 0xff51afd7ed558ccd
+	k ^= k >> 33
+	k *= 0xc4ceb9fe1a85ec53
+	k ^= k >> 33
+	return k
+}
+
+// MURMUR3X86_32 hashes key with MurmurHash3_x86_32.
+func MURMUR3X86_32(key string, seed uint32) uint32 {
+	return murmur_hash_3_x86_32(key, seed)
+}
+
+// MURMUR3X86_32Bytes is the byte-slice version of MURMUR3X86_32.
+func MURMUR3X86_32Bytes(data []byte, seed uint32) uint32 {
+	return murmur_hash_3_x86_32(bytes2String(data), seed)
+}
+
+// MURMUR3X64_128 hashes key with MurmurHash3_x64_128, the two 64-bit halves are returned
+// in the same order as the reference implementation writes them out.
+func MURMUR3X64_128(key string, seed uint32) (uint64, uint64) {
+	return murmur_hash_3_x64_128(key, seed)
+}
+
+// MURMUR3X64_128Bytes is the byte-slice version of MURMUR3X64_128.
+func MURMUR3X64_128Bytes(data []byte, seed uint32) (uint64, uint64) {
+	return murmur_hash_3_x64_128(bytes2String(data), seed)
+}
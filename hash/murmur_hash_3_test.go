@@ -0,0 +1,58 @@
+package hash
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMurmurHash3Verification(t *testing.T) {
+	v32 := smhasherVerification(4, func(key string, seed uint32) []byte {
+		out := make([]byte, 4)
+		binary.LittleEndian.PutUint32(out, MURMUR3X86_32(key, seed))
+		return out
+	})
+	assert.Equal(t, uint32(0xb0f57ee3), v32)
+
+	v128 := smhasherVerification(16, func(key string, seed uint32) []byte {
+		out := make([]byte, 16)
+		h1, h2 := MURMUR3X64_128(key, seed)
+		binary.LittleEndian.PutUint64(out[:8], h1)
+		binary.LittleEndian.PutUint64(out[8:], h2)
+		return out
+	})
+	assert.Equal(t, uint32(0x6384ba69), v128)
+}
+
+func TestMurmurHash3(t *testing.T) {
+	const seed uint32 = 0x9747b28c
+	cases := []struct {
+		key   string
+		x86   uint32
+		x86s  uint32
+		x64lo uint64
+		x64hi uint64
+	}{
+		{"", 0x00000000, 0xebb6c228, 0x392b208a1daabbb3, 0x93b0608fe302957a},
+		{"a", 0x3c2569b2, 0x7fa09ea6, 0x5ce8d8512db25a1d, 0x9e6dab0f9208f004},
+		{"ab", 0x9bbfd75f, 0x74875592, 0x8434eead1a44280b, 0x7eb933e763ce372b},
+		{"abc", 0xb3dd93fa, 0xc84a62dd, 0x3743630dbfc3cedc, 0xcde0a23420b504bf},
+		{"abcd", 0x43ed676a, 0xf0478627, 0x49b4709eac553791, 0x8a7e67e7e9d3a7bb},
+		{"hello", 0x248bfa47, 0x5d7f56e8, 0x8c23d6856f071a2e, 0x2a905546b3c1cb83},
+		{"hello, world", 0x149bbb7f, 0x9a933e00, 0xe6723010086c5b1e, 0x2f3637061e4d8932},
+		{"The quick brown fox jumps over the lazy dog", 0x2e4ff723, 0x2fa826cd, 0x738a7f3bd2633121, 0xf94573727ec016e5},
+		{"0123456789abcdef0123456789abcdefX", 0x9d5fe99f, 0x5489b511, 0x2dd9b8bbb21ca894, 0x295e72578353605e},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.x86, MURMUR3X86_32(c.key, 0), c.key)
+		assert.Equal(t, c.x86s, MURMUR3X86_32(c.key, seed), c.key)
+		assert.Equal(t, c.x86s, MURMUR3X86_32Bytes([]byte(c.key), seed), c.key)
+		h1, h2 := MURMUR3X64_128(c.key, seed)
+		assert.Equal(t, c.x64lo, h1, c.key)
+		assert.Equal(t, c.x64hi, h2, c.key)
+		h1, h2 = MURMUR3X64_128Bytes([]byte(c.key), seed)
+		assert.Equal(t, c.x64lo, h1, c.key)
+		assert.Equal(t, c.x64hi, h2, c.key)
+	}
+}
@@ -0,0 +1,223 @@
+package quality
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+
+	"github.com/amazingchow/photon-dance-bigdata-toolkit/hash"
+)
+
+/*
+	A small subset of SMHasher's tests, enough to catch the usual mistakes in the
+	hash functions and in the way a data structure consumes their bits:
+
+	1. avalanche: flipping any single input bit should flip every output bit with
+	   probability 1/2, the bias of cell (i, j) is |2 * P(output j flips | input i flips) - 1|.
+	2. bucket uniformity: hashing sequential keys into buckets taken from the lowest and
+	   the highest bits of the output should pass a chi-square test.
+	3. collisions: sequential keys and sparse keys (keys with only a few bits set)
+	   should not collide more than a random function would.
+*/
+
+// More info: https://github.com/aappleby/smhasher/wiki
+
+// Func is a hash function under test, whose output has Bits significant bits.
+type Func func(key string) uint64
+
+// From32 adapts a 32-bit hash.HashFunc to Func.
+func From32(fn hash.HashFunc) Func {
+	return func(key string) uint64 { return uint64(fn(key)) }
+}
+
+// Options configures Evaluate, zero values are replaced by the defaults.
+type Options struct {
+	// AvalancheKeyLen is the length of the random keys used by the avalanche test, 16 by default.
+	AvalancheKeyLen int
+	// AvalancheSamples is the number of random keys used by the avalanche test, 2000 by default.
+	AvalancheSamples int
+	// BucketBits is log2 of the number of buckets used by the uniformity test, 10 by default.
+	BucketBits int
+	// Keys is the number of sequential keys used by the uniformity and collision tests, 200000 by default.
+	Keys int
+	// SparseKeyLen is the length of the sparse keys, which have up to 3 bits set, 16 by default.
+	SparseKeyLen int
+	// Seed seeds the random keys, so reports are reproducible.
+	Seed int64
+}
+
+func (opts *Options) fill() {
+	if opts.AvalancheKeyLen <= 0 {
+		opts.AvalancheKeyLen = 16
+	}
+	if opts.AvalancheSamples <= 0 {
+		opts.AvalancheSamples = 2000
+	}
+	if opts.BucketBits <= 0 {
+		opts.BucketBits = 10
+	}
+	if opts.Keys <= 0 {
+		opts.Keys = 200000
+	}
+	if opts.SparseKeyLen <= 0 {
+		opts.SparseKeyLen = 16
+	}
+}
+
+// Report is the result of Evaluate.
+type Report struct {
+	Name string
+	Bits int
+
+	// AvalancheBias[i][j] is the bias of output bit j when input bit i is flipped.
+	AvalancheBias    [][]float64
+	MaxAvalancheBias float64
+
+	// ChiSquareLow and ChiSquareHigh are the chi-square statistics of the buckets taken from
+	// the lowest and the highest bits, with ChiSquareDoF degrees of freedom.
+	ChiSquareLow  float64
+	ChiSquareHigh float64
+	ChiSquareDoF  int
+
+	SequentialKeys       int
+	SequentialCollisions int
+	SparseKeys           int
+	SparseCollisions     int
+}
+
+// ChiSquareZ returns the worst of the two chi-square statistics as a z-score, using the normal
+// approximation (chi2 - k) / sqrt(2k). A uniform hash stays within a few units of 0.
+func (r *Report) ChiSquareZ() float64 {
+	dof := float64(r.ChiSquareDoF)
+	zLow := (r.ChiSquareLow - dof) / math.Sqrt(2*dof)
+	zHigh := (r.ChiSquareHigh - dof) / math.Sqrt(2*dof)
+	return math.Max(zLow, zHigh)
+}
+
+// ExpectedCollisions returns the number of collisions a random function with the same output
+// width is expected to produce among n keys.
+func (r *Report) ExpectedCollisions(n int) float64 {
+	return float64(n) * float64(n-1) / math.Pow(2, float64(r.Bits+1))
+}
+
+func (r *Report) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "[%s] %d-bit\n", r.Name, r.Bits)
+	fmt.Fprintf(&sb, "  avalanche  : max bias %.4f over %dx%d bits\n", r.MaxAvalancheBias, len(r.AvalancheBias), r.Bits)
+	fmt.Fprintf(&sb, "  uniformity : chi2 low bits %.1f, high bits %.1f, dof %d, z %.2f\n", r.ChiSquareLow, r.ChiSquareHigh, r.ChiSquareDoF, r.ChiSquareZ())
+	fmt.Fprintf(&sb, "  collisions : sequential %d/%d (expected %.2f), sparse %d/%d (expected %.2f)\n",
+		r.SequentialCollisions, r.SequentialKeys, r.ExpectedCollisions(r.SequentialKeys),
+		r.SparseCollisions, r.SparseKeys, r.ExpectedCollisions(r.SparseKeys))
+	return sb.String()
+}
+
+// Evaluate runs all tests against fn, whose output has bits (32 or 64) significant bits.
+func Evaluate(name string, fn Func, bits int, opts Options) *Report {
+	opts.fill()
+	r := &Report{Name: name, Bits: bits}
+	r.avalanche(fn, opts)
+	r.uniformity(fn, opts)
+	r.collisions(fn, opts)
+	return r
+}
+
+func (r *Report) avalanche(fn Func, opts Options) {
+	inBits := opts.AvalancheKeyLen * 8
+	flips := make([][]int, inBits)
+	for i := range flips {
+		flips[i] = make([]int, r.Bits)
+	}
+
+	rnd := rand.New(rand.NewSource(opts.Seed))
+	key := make([]byte, opts.AvalancheKeyLen)
+	for s := 0; s < opts.AvalancheSamples; s++ {
+		rnd.Read(key) // nolint
+		h := fn(string(key))
+		for i := 0; i < inBits; i++ {
+			key[i/8] ^= 1 << uint(i%8)
+			d := h ^ fn(string(key))
+			key[i/8] ^= 1 << uint(i%8)
+			for j := 0; j < r.Bits; j++ {
+				flips[i][j] += int(d >> uint(j) & 1)
+			}
+		}
+	}
+
+	r.AvalancheBias = make([][]float64, inBits)
+	for i := range flips {
+		r.AvalancheBias[i] = make([]float64, r.Bits)
+		for j, cnt := range flips[i] {
+			bias := math.Abs(2*float64(cnt)/float64(opts.AvalancheSamples) - 1)
+			r.AvalancheBias[i][j] = bias
+			r.MaxAvalancheBias = math.Max(r.MaxAvalancheBias, bias)
+		}
+	}
+}
+
+func (r *Report) uniformity(fn Func, opts Options) {
+	buckets := 1 << uint(opts.BucketBits)
+	low := make([]int, buckets)
+	high := make([]int, buckets)
+	for i := 0; i < opts.Keys; i++ {
+		h := fn(strconv.Itoa(i))
+		low[h&uint64(buckets-1)]++
+		high[h>>uint(r.Bits-opts.BucketBits)&uint64(buckets-1)]++
+	}
+	r.ChiSquareLow = chiSquare(low, opts.Keys)
+	r.ChiSquareHigh = chiSquare(high, opts.Keys)
+	r.ChiSquareDoF = buckets - 1
+}
+
+func chiSquare(counts []int, total int) float64 {
+	expected := float64(total) / float64(len(counts))
+	var chi2 float64
+	for _, c := range counts {
+		d := float64(c) - expected
+		chi2 += d * d / expected
+	}
+	return chi2
+}
+
+func (r *Report) collisions(fn Func, opts Options) {
+	seen := make(map[uint64]struct{}, opts.Keys)
+	for i := 0; i < opts.Keys; i++ {
+		h := fn(strconv.Itoa(i))
+		if _, ok := seen[h]; ok {
+			r.SequentialCollisions++
+		}
+		seen[h] = struct{}{}
+	}
+	r.SequentialKeys = opts.Keys
+
+	seen = make(map[uint64]struct{})
+	key := make([]byte, opts.SparseKeyLen)
+	add := func() {
+		h := fn(string(key))
+		if _, ok := seen[h]; ok {
+			r.SparseCollisions++
+		}
+		seen[h] = struct{}{}
+		r.SparseKeys++
+	}
+	n := opts.SparseKeyLen * 8
+	flip := func(i int) { key[i/8] ^= 1 << uint(i%8) }
+
+	add()
+	for a := 0; a < n; a++ {
+		flip(a)
+		add()
+		for b := a + 1; b < n; b++ {
+			flip(b)
+			add()
+			for c := b + 1; c < n; c++ {
+				flip(c)
+				add()
+				flip(c)
+			}
+			flip(b)
+		}
+		flip(a)
+	}
+}
@@ -0,0 +1,58 @@
+package quality
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/amazingchow/photon-dance-bigdata-toolkit/hash"
+)
+
+var _SipKey = hash.SipKey{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+var _Algorithms = []struct {
+	name string
+	fn   Func
+	bits int
+	// strong hash functions are expected to pass every test, the legacy ones are only reported.
+	strong bool
+}{
+	{"BKDR", From32(hash.BKDR), 32, false},
+	{"FNV1A32", From32(hash.FNV1A32), 32, false},
+	{"FNV1A64", hash.FNV1A64, 64, false},
+	{"MURMUR2", From32(hash.MURMUR2), 32, true},
+	{"MURMUR3X86_32", func(key string) uint64 { return uint64(hash.MURMUR3X86_32(key, 0)) }, 32, true},
+	{"MURMUR3X64_128", func(key string) uint64 { h, _ := hash.MURMUR3X64_128(key, 0); return h }, 64, true},
+	{"XXH64", func(key string) uint64 { return hash.XXH64(key, 0) }, 64, true},
+	{"XXH3", func(key string) uint64 { return hash.XXH3(key, 0) }, 64, true},
+	{"WYHASH", func(key string) uint64 { return hash.WYHASH(key, 0) }, 64, true},
+	{"SIPHASH24", func(key string) uint64 { return hash.SIPHASH24(key, _SipKey) }, 64, true},
+	//lint:ignore SA1019 the deprecated double hashing is measured against the others.
+	{"DoubleHashing_7", From32(hash.DoubleHashing_7), 32, false},
+}
+
+func TestHashQuality(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping hash quality harness in short mode")
+	}
+	for _, a := range _Algorithms {
+		r := Evaluate(a.name, a.fn, a.bits, Options{})
+		t.Log(r.String())
+		if !a.strong {
+			continue
+		}
+		assert.True(t, r.MaxAvalancheBias < 0.15, "%s: avalanche bias %.4f", a.name, r.MaxAvalancheBias)
+		assert.True(t, r.ChiSquareZ() < 6, "%s: chi-square z %.2f", a.name, r.ChiSquareZ())
+		assert.True(t, float64(r.SequentialCollisions) <= 4*r.ExpectedCollisions(r.SequentialKeys)+3, "%s: %d sequential collisions", a.name, r.SequentialCollisions)
+		assert.True(t, float64(r.SparseCollisions) <= 4*r.ExpectedCollisions(r.SparseKeys)+3, "%s: %d sparse collisions", a.name, r.SparseCollisions)
+	}
+}
+
+func TestHashQualityCatchesBadHash(t *testing.T) {
+	// a hash that only uses the lower 32 bits of a 64-bit output, like shifting a 32-bit hash
+	// by 32 bits, must fail the high-bits uniformity test.
+	bad := func(key string) uint64 { return uint64(hash.MURMUR2(key)) }
+	r := Evaluate("truncated", bad, 64, Options{Keys: 20000, AvalancheSamples: 200})
+	assert.True(t, r.ChiSquareZ() > 100)
+	assert.True(t, r.MaxAvalancheBias > 0.99)
+}
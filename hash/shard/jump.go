@@ -0,0 +1,51 @@
+package shard
+
+// More info: "A Fast, Minimal Memory, Consistent Hash Algorithm", by John Lamping and Eric Veach.
+
+// JumpHash maps key to a bucket in [0, numBuckets), numBuckets must be positive.
+// When numBuckets grows by one, only ~1/numBuckets of the keys move, all to the new bucket.
+func JumpHash(key uint64, numBuckets int) int {
+	var b, j int64 = -1, 0
+	for j < int64(numBuckets) {
+		b = j
+		key = key*2862933555777941757 + 1
+		j = int64(float64(b+1) * (float64(int64(1)<<31) / float64((key>>33)+1)))
+	}
+	return int(b)
+}
+
+// Jump implements Picker with jump consistent hashing over numbered shards. Shards can only be
+// added or removed at the end of the list without moving keys between the remaining shards.
+type Jump struct {
+	shards   []string
+	hashFunc HashFunc
+}
+
+var _ Picker = (*Jump)(nil)
+
+// NewJump creates a Jump picker, shards[i] is the name of shard i. If fn is nil, XXH3-64 is used.
+func NewJump(shards []string, fn HashFunc) *Jump {
+	if fn == nil {
+		fn = defaultHashFunc
+	}
+	return &Jump{
+		shards:   append([]string(nil), shards...),
+		hashFunc: fn,
+	}
+}
+
+// Pick returns the shard which owns key.
+func (j *Jump) Pick(key string) (string, bool) {
+	if len(j.shards) == 0 {
+		return "", false
+	}
+	return j.shards[JumpHash(j.hashFunc(key), len(j.shards))], true
+}
+
+// PickIndex returns the index of the shard which owns key, -1 if there is no shard.
+func (j *Jump) PickIndex(key string) int {
+	if len(j.shards) == 0 {
+		return -1
+	}
+	return JumpHash(j.hashFunc(key), len(j.shards))
+}
@@ -0,0 +1,106 @@
+package shard
+
+import "fmt"
+
+/*
+	Maglev hashing gives every backend a permutation of the lookup table positions, the
+	backends then take turns to claim their next preferred free position until the table
+	is full. Every backend owns almost exactly M/n positions, and a lookup is a single
+	array access. Changing the backends moves a bit more keys than the minimum, in exchange
+	for the even balance.
+*/
+
+// More info: "Maglev: A Fast and Reliable Software Network Load Balancer", section 3.4.
+
+// DefaultMaglevTableSize is a prime much larger than the number of backends, as the paper suggests.
+const DefaultMaglevTableSize = 65537
+
+// Maglev implements Picker with a Maglev lookup table.
+type Maglev struct {
+	backends []string
+	table    []int32
+	hashFunc HashFunc
+}
+
+var _ Picker = (*Maglev)(nil)
+
+// NewMaglev creates a Maglev picker, tableSize must be a prime no less than the number of backends,
+// if it is 0, DefaultMaglevTableSize is used. If fn is nil, XXH3-64 is used.
+func NewMaglev(backends []string, tableSize int, fn HashFunc) (*Maglev, error) {
+	if tableSize == 0 {
+		tableSize = DefaultMaglevTableSize
+	}
+	if !isPrime(tableSize) {
+		return nil, fmt.Errorf("table size %d is not a prime", tableSize)
+	}
+	if tableSize < len(backends) {
+		return nil, fmt.Errorf("table size %d is less than the number of backends %d", tableSize, len(backends))
+	}
+	if fn == nil {
+		fn = defaultHashFunc
+	}
+
+	m := &Maglev{
+		backends: append([]string(nil), backends...),
+		hashFunc: fn,
+	}
+	m.populate(tableSize)
+	return m, nil
+}
+
+func (m *Maglev) populate(tableSize int) {
+	m.table = make([]int32, tableSize)
+	for i := range m.table {
+		m.table[i] = -1
+	}
+	n := len(m.backends)
+	if n == 0 {
+		return
+	}
+
+	size := uint64(tableSize)
+	offsets := make([]uint64, n)
+	skips := make([]uint64, n)
+	next := make([]uint64, n)
+	for i, b := range m.backends {
+		h := m.hashFunc(b)
+		offsets[i] = h % size
+		skips[i] = mix64(h)%(size-1) + 1
+	}
+
+	for filled := 0; ; {
+		for i := 0; i < n; i++ {
+			c := (offsets[i] + next[i]*skips[i]) % size
+			for m.table[c] >= 0 {
+				next[i]++
+				c = (offsets[i] + next[i]*skips[i]) % size
+			}
+			m.table[c] = int32(i)
+			next[i]++
+			filled++
+			if filled == tableSize {
+				return
+			}
+		}
+	}
+}
+
+// Pick returns the backend which owns key.
+func (m *Maglev) Pick(key string) (string, bool) {
+	if len(m.backends) == 0 {
+		return "", false
+	}
+	return m.backends[m.table[m.hashFunc(key)%uint64(len(m.table))]], true
+}
+
+func isPrime(n int) bool {
+	if n < 2 {
+		return false
+	}
+	for i := 2; i*i <= n; i++ {
+		if n%i == 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,101 @@
+package shard
+
+import (
+	"math"
+	"sort"
+)
+
+/*
+	Rendezvous hashing, a.k.a. highest random weight (HRW) hashing, scores every backend
+	for a key and picks the highest one. Removing a backend only moves the keys it owned,
+	adding one only takes the keys it now scores highest for.
+
+	Weights use the logarithmic method: score = -w / ln(u), where u is uniform in (0, 1),
+	so a backend owns a share of the keys proportional to its weight.
+*/
+
+// More info: "Weighted Distributed Hash Tables", by Christian Schindelhauer and Gunnar Schomaker.
+
+// Backend is a named backend with a weight.
+type Backend struct {
+	Name   string
+	Weight float64
+}
+
+type scoredBackend struct {
+	Backend
+	h uint64
+}
+
+// Rendezvous implements Picker with weighted rendezvous hashing.
+type Rendezvous struct {
+	backends []scoredBackend
+	hashFunc HashFunc
+}
+
+var _ Picker = (*Rendezvous)(nil)
+
+// NewRendezvous creates a Rendezvous picker, a weight <= 0 is treated as 1. If fn is nil, XXH3-64 is used.
+func NewRendezvous(backends []Backend, fn HashFunc) *Rendezvous {
+	if fn == nil {
+		fn = defaultHashFunc
+	}
+	r := &Rendezvous{
+		backends: make([]scoredBackend, len(backends)),
+		hashFunc: fn,
+	}
+	for i, b := range backends {
+		if b.Weight <= 0 {
+			b.Weight = 1
+		}
+		r.backends[i] = scoredBackend{Backend: b, h: fn(b.Name)}
+	}
+	return r
+}
+
+func (r *Rendezvous) score(b *scoredBackend, hk uint64) float64 {
+	// take the top 53 bits as a float in (0, 1)
+	u := (float64(mix64(hk^b.h)>>11) + 0.5) / (1 << 53)
+	return -b.Weight / math.Log(u)
+}
+
+// Pick returns the backend with the highest score for key.
+func (r *Rendezvous) Pick(key string) (string, bool) {
+	if len(r.backends) == 0 {
+		return "", false
+	}
+	hk := r.hashFunc(key)
+	best, bestScore := 0, math.Inf(-1)
+	for i := range r.backends {
+		if s := r.score(&r.backends[i], hk); s > bestScore {
+			best, bestScore = i, s
+		}
+	}
+	return r.backends[best].Name, true
+}
+
+// PickN returns up to n distinct backends for key ordered by score, highest first.
+// The first one is the same as Pick, the rest can be used as replicas.
+func (r *Rendezvous) PickN(key string, n int) []string {
+	if n > len(r.backends) {
+		n = len(r.backends)
+	}
+	if n <= 0 {
+		return nil
+	}
+	hk := r.hashFunc(key)
+	type candidate struct {
+		name  string
+		score float64
+	}
+	candidates := make([]candidate, len(r.backends))
+	for i := range r.backends {
+		candidates[i] = candidate{name: r.backends[i].Name, score: r.score(&r.backends[i], hk)}
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].score > candidates[j].score })
+	names := make([]string, n)
+	for i := range names {
+		names[i] = candidates[i].name
+	}
+	return names
+}
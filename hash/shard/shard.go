@@ -0,0 +1,28 @@
+package shard
+
+import "github.com/amazingchow/photon-dance-bigdata-toolkit/hash"
+
+// Picker picks the backend a key should be routed to.
+type Picker interface {
+	// Pick returns the backend which owns key, false if there is no backend at all.
+	Pick(key string) (string, bool)
+}
+
+// HashFunc hashes a key into 64 bits.
+type HashFunc func(key string) uint64
+
+// defaultHashFunc hashes key with XXH3-64 and seed 0.
+func defaultHashFunc(key string) uint64 {
+	return hash.XXH3(key, 0)
+}
+
+// mix64 is the finalizer of SplitMix64, it turns a combination of two hash values into a well
+// distributed one without hashing the concatenation of both keys again.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
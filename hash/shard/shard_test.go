@@ -0,0 +1,178 @@
+package shard
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func names(n int) []string {
+	ns := make([]string, n)
+	for i := range ns {
+		ns[i] = fmt.Sprintf("backend-%d", i)
+	}
+	return ns
+}
+
+func keys(n int) []string {
+	ks := make([]string, n)
+	for i := range ks {
+		ks[i] = fmt.Sprintf("key-%d", i)
+	}
+	return ks
+}
+
+// assertBalanced checks every backend owns about the same share of the keys.
+func assertBalanced(t *testing.T, p Picker, backends []string, ks []string, tolerance float64) {
+	load := make(map[string]int)
+	for _, k := range ks {
+		b, ok := p.Pick(k)
+		assert.True(t, ok)
+		load[b]++
+	}
+	mean := float64(len(ks)) / float64(len(backends))
+	for _, b := range backends {
+		assert.True(t, math.Abs(float64(load[b])-mean)/mean < tolerance, "%s owns %d keys, expected ~%.0f", b, load[b], mean)
+	}
+}
+
+// movement returns the fraction of keys picked differently by p1 and p2.
+func movement(p1, p2 Picker, ks []string) float64 {
+	moved := 0
+	for _, k := range ks {
+		b1, _ := p1.Pick(k)
+		b2, _ := p2.Pick(k)
+		if b1 != b2 {
+			moved++
+		}
+	}
+	return float64(moved) / float64(len(ks))
+}
+
+func TestEmptyPickers(t *testing.T) {
+	m, err := NewMaglev(nil, 0, nil)
+	assert.Empty(t, err)
+	for _, p := range []Picker{NewJump(nil, nil), NewRendezvous(nil, nil), m} {
+		_, ok := p.Pick("BTC")
+		assert.False(t, ok)
+	}
+	assert.Equal(t, -1, NewJump(nil, nil).PickIndex("BTC"))
+}
+
+func TestJumpHash(t *testing.T) {
+	for key := uint64(0); key < 1000; key++ {
+		assert.Equal(t, 0, JumpHash(key, 1))
+		for n := 1; n < 50; n++ {
+			b1 := JumpHash(key, n)
+			b2 := JumpHash(key, n+1)
+			assert.True(t, b1 >= 0 && b1 < n)
+			// a key either stays or moves to the new bucket
+			assert.True(t, b1 == b2 || b2 == n)
+		}
+	}
+}
+
+func TestJump(t *testing.T) {
+	ks := keys(100000)
+	p10 := NewJump(names(10), nil)
+	p11 := NewJump(names(11), nil)
+	assertBalanced(t, p10, names(10), ks, 0.05)
+	assert.InDelta(t, 1.0/11, movement(p10, p11, ks), 0.01)
+}
+
+func TestRendezvous(t *testing.T) {
+	ks := keys(100000)
+	backends := make([]Backend, 10)
+	for i, n := range names(10) {
+		backends[i] = Backend{Name: n, Weight: 1}
+	}
+	p10 := NewRendezvous(backends, nil)
+	assertBalanced(t, p10, names(10), ks, 0.05)
+
+	// removing a backend only moves the keys it owned
+	p9 := NewRendezvous(backends[:9], nil)
+	for _, k := range ks {
+		b10, _ := p10.Pick(k)
+		b9, _ := p9.Pick(k)
+		if b10 != backends[9].Name {
+			assert.Equal(t, b10, b9)
+		}
+	}
+	assert.InDelta(t, 1.0/10, movement(p10, p9, ks), 0.01)
+
+	// weights
+	weighted := NewRendezvous([]Backend{{"a", 1}, {"b", 3}}, nil)
+	load := map[string]int{}
+	for _, k := range ks {
+		b, _ := weighted.Pick(k)
+		load[b]++
+	}
+	assert.InDelta(t, 0.75, float64(load["b"])/float64(len(ks)), 0.01)
+
+	replicas := p10.PickN("BTC", 3)
+	assert.Equal(t, 3, len(replicas))
+	first, _ := p10.Pick("BTC")
+	assert.Equal(t, first, replicas[0])
+	assert.Equal(t, 10, len(p10.PickN("BTC", 20)))
+}
+
+func TestMaglev(t *testing.T) {
+	_, err := NewMaglev(names(3), 100, nil)
+	assert.NotEmpty(t, err)
+	_, err = NewMaglev(names(20), 17, nil)
+	assert.NotEmpty(t, err)
+
+	ks := keys(100000)
+	p10, err := NewMaglev(names(10), 0, nil)
+	assert.Empty(t, err)
+	assertBalanced(t, p10, names(10), ks, 0.05)
+
+	// every backend owns almost exactly M/n entries of the table
+	owned := make([]int, 10)
+	for _, b := range p10.table {
+		owned[b]++
+	}
+	for _, cnt := range owned {
+		assert.InDelta(t, DefaultMaglevTableSize/10, cnt, 2)
+	}
+
+	// removing a backend moves its keys plus a little disruption
+	p9, err := NewMaglev(names(9), 0, nil)
+	assert.Empty(t, err)
+	assert.True(t, movement(p10, p9, ks) < 1.0/10+0.05)
+}
+
+func benchmarkPicker(b *testing.B, newPicker func(backends []string) Picker) {
+	ks := keys(1024)
+	for _, n := range []int{10, 100, 1000} {
+		p := newPicker(names(n))
+		b.Run(fmt.Sprintf("%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				p.Pick(ks[i&1023])
+			}
+		})
+	}
+}
+
+func BenchmarkJump(b *testing.B) {
+	benchmarkPicker(b, func(backends []string) Picker { return NewJump(backends, nil) })
+}
+
+func BenchmarkRendezvous(b *testing.B) {
+	benchmarkPicker(b, func(backends []string) Picker {
+		bs := make([]Backend, len(backends))
+		for i, n := range backends {
+			bs[i] = Backend{Name: n, Weight: 1}
+		}
+		return NewRendezvous(bs, nil)
+	})
+}
+
+func BenchmarkMaglev(b *testing.B) {
+	benchmarkPicker(b, func(backends []string) Picker {
+		m, _ := NewMaglev(backends, 0, nil)
+		return m
+	})
+}
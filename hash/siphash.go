@@ -0,0 +1,158 @@
+package hash
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"math/bits"
+)
+
+/*
+	SipHash is a keyed pseudo-random function, as long as the key stays secret an
+	attacker cannot craft keys that collide on purpose (hash-flooding). Use it for
+	filters that take keys from untrusted input.
+
+	1. SIPHASH24 is SipHash-2-4 with a 128-bit key and a 64-bit output.
+	2. HALFSIPHASH24 is HalfSipHash-2-4, the 32-bit variant, with a 64-bit key and a 32-bit output.
+*/
+
+// More info: https://github.com/veorq/SipHash
+
+// SipKey is a 128-bit secret key for SipHash.
+type SipKey [16]byte
+
+// HalfSipKey is a 64-bit secret key for HalfSipHash.
+type HalfSipKey [8]byte
+
+// NewSipKey returns a SipKey read from crypto/rand.
+func NewSipKey() (SipKey, error) {
+	var k SipKey
+	_, err := rand.Read(k[:])
+	return k, err
+}
+
+// NewHalfSipKey returns a HalfSipKey read from crypto/rand.
+func NewHalfSipKey() (HalfSipKey, error) {
+	var k HalfSipKey
+	_, err := rand.Read(k[:])
+	return k, err
+}
+
+func sip_round(v0, v1, v2, v3 uint64) (uint64, uint64, uint64, uint64) {
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 13)
+	v1 ^= v0
+	v0 = bits.RotateLeft64(v0, 32)
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 16)
+	v3 ^= v2
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 21)
+	v3 ^= v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 17)
+	v1 ^= v2
+	v2 = bits.RotateLeft64(v2, 32)
+	return v0, v1, v2, v3
+}
+
+func siphash_2_4(key string, k0, k1 uint64) uint64 {
+	v0 := k0 ^ 0x736f6d6570736575
+	v1 := k1 ^ 0x646f72616e646f6d
+	v2 := k0 ^ 0x6c7967656e657261
+	v3 := k1 ^ 0x7465646279746573
+
+	n := len(key)
+	idx := 0
+	for ; n-idx >= 8; idx += 8 {
+		m := load64(key, idx)
+		v3 ^= m
+		v0, v1, v2, v3 = sip_round(v0, v1, v2, v3)
+		v0, v1, v2, v3 = sip_round(v0, v1, v2, v3)
+		v0 ^= m
+	}
+
+	b := uint64(n) << 56
+	for i := n - 1; i >= idx; i-- {
+		b |= uint64(key[i]) << (8 * uint(i-idx))
+	}
+	v3 ^= b
+	v0, v1, v2, v3 = sip_round(v0, v1, v2, v3)
+	v0, v1, v2, v3 = sip_round(v0, v1, v2, v3)
+	v0 ^= b
+
+	v2 ^= 0xff
+	for i := 0; i < 4; i++ {
+		v0, v1, v2, v3 = sip_round(v0, v1, v2, v3)
+	}
+	return v0 ^ v1 ^ v2 ^ v3
+}
+
+func half_sip_round(v0, v1, v2, v3 uint32) (uint32, uint32, uint32, uint32) {
+	v0 += v1
+	v1 = bits.RotateLeft32(v1, 5)
+	v1 ^= v0
+	v0 = bits.RotateLeft32(v0, 16)
+	v2 += v3
+	v3 = bits.RotateLeft32(v3, 8)
+	v3 ^= v2
+	v0 += v3
+	v3 = bits.RotateLeft32(v3, 7)
+	v3 ^= v0
+	v2 += v1
+	v1 = bits.RotateLeft32(v1, 13)
+	v1 ^= v2
+	v2 = bits.RotateLeft32(v2, 16)
+	return v0, v1, v2, v3
+}
+
+func half_siphash_2_4(key string, k0, k1 uint32) uint32 {
+	v0 := k0
+	v1 := k1
+	v2 := k0 ^ 0x6c796765
+	v3 := k1 ^ 0x74656462
+
+	n := len(key)
+	idx := 0
+	for ; n-idx >= 4; idx += 4 {
+		m := load32(key, idx)
+		v3 ^= m
+		v0, v1, v2, v3 = half_sip_round(v0, v1, v2, v3)
+		v0, v1, v2, v3 = half_sip_round(v0, v1, v2, v3)
+		v0 ^= m
+	}
+
+	b := uint32(n) << 24
+	for i := n - 1; i >= idx; i-- {
+		b |= uint32(key[i]) << (8 * uint(i-idx))
+	}
+	v3 ^= b
+	v0, v1, v2, v3 = half_sip_round(v0, v1, v2, v3)
+	v0, v1, v2, v3 = half_sip_round(v0, v1, v2, v3)
+	v0 ^= b
+
+	v2 ^= 0xff
+	for i := 0; i < 4; i++ {
+		v0, v1, v2, v3 = half_sip_round(v0, v1, v2, v3)
+	}
+	return v1 ^ v3
+}
+
+// SIPHASH24 hashes key with SipHash-2-4 keyed by sk.
+func SIPHASH24(key string, sk SipKey) uint64 {
+	return siphash_2_4(key, binary.LittleEndian.Uint64(sk[:8]), binary.LittleEndian.Uint64(sk[8:]))
+}
+
+// SIPHASH24Bytes is the byte-slice version of SIPHASH24.
+func SIPHASH24Bytes(data []byte, sk SipKey) uint64 {
+	return SIPHASH24(bytes2String(data), sk)
+}
+
+// HALFSIPHASH24 hashes key with HalfSipHash-2-4 keyed by sk.
+func HALFSIPHASH24(key string, sk HalfSipKey) uint32 {
+	return half_siphash_2_4(key, binary.LittleEndian.Uint32(sk[:4]), binary.LittleEndian.Uint32(sk[4:]))
+}
+
+// HALFSIPHASH24Bytes is the byte-slice version of HALFSIPHASH24.
+func HALFSIPHASH24Bytes(data []byte, sk HalfSipKey) uint32 {
+	return HALFSIPHASH24(bytes2String(data), sk)
+}
@@ -0,0 +1,66 @@
+package hash
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSIPHASH24(t *testing.T) {
+	// key = 00 01 02 ... 0f, message = 00 01 02 ... (n-1), same as the reference vectors.h
+	var sk SipKey
+	for i := range sk {
+		sk[i] = byte(i)
+	}
+	cases := []struct {
+		n int
+		h uint64
+	}{
+		{0, 0x726fdb47dd0e0e31},
+		{1, 0x74f839c593dc67fd},
+		{7, 0xab0200f58b01d137},
+		{8, 0x93f5f5799a932462},
+		{15, 0xa129ca6149be45e5},
+		{16, 0x3f2acc7f57c29bdb},
+		{63, 0x958a324ceb064572},
+	}
+	for _, c := range cases {
+		msg := make([]byte, c.n)
+		for i := range msg {
+			msg[i] = byte(i)
+		}
+		assert.Equal(t, c.h, SIPHASH24Bytes(msg, sk), "n=%d", c.n)
+		assert.Equal(t, c.h, SIPHASH24(string(msg), sk), "n=%d", c.n)
+	}
+}
+
+func TestHALFSIPHASH24(t *testing.T) {
+	// key = 00 01 02 ... 07, message = 00 01 02 ... (n-1), same as the reference vectors_hsip32
+	var sk HalfSipKey
+	for i := range sk {
+		sk[i] = byte(i)
+	}
+	want := []uint32{0x5b9f35a9, 0xb85a4727, 0x03a662fa, 0x04e7fe8a}
+	for n, h := range want {
+		msg := make([]byte, n)
+		for i := range msg {
+			msg[i] = byte(i)
+		}
+		assert.Equal(t, h, HALFSIPHASH24Bytes(msg, sk), "n=%d", n)
+	}
+}
+
+func TestSipDoubleHashing(t *testing.T) {
+	var k1, k2 SipKey
+	for i := range k1 {
+		k1[i] = byte(i)
+		k2[i] = byte(15 - i)
+	}
+
+	// SIPHASH24("BTC", k1) = 0x9f1358587aa47ad0, h1 = 0x7aa47ad0, h2 = 0x9f135858.
+	h1 := SipDoubleHashing(k1, 7)
+	assert.Equal(t, uint32(0xed5863a8), h1("BTC"))
+	assert.Equal(t, uint32(0x7e69cce8), SipDoubleHashing(k1, 13)("BTC"))
+	assert.Equal(t, uint32(0xd22aaa9d), SipDoubleHashing(k2, 7)("BTC"))
+	assert.Equal(t, h1("BTC"), h1("BTC"))
+}
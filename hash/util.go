@@ -0,0 +1,34 @@
+package hash
+
+import "unsafe"
+
+// load32 reads a little-endian uint32 from key at offset i.
+func load32(key string, i int) uint32 {
+	_ = key[i+3] // bounds check hint to compiler
+	return uint32(key[i]) | uint32(key[i+1])<<8 | uint32(key[i+2])<<16 | uint32(key[i+3])<<24
+}
+
+// load64 reads a little-endian uint64 from key at offset i.
+func load64(key string, i int) uint64 {
+	_ = key[i+7] // bounds check hint to compiler
+	return uint64(key[i]) | uint64(key[i+1])<<8 | uint64(key[i+2])<<16 | uint64(key[i+3])<<24 |
+		uint64(key[i+4])<<32 | uint64(key[i+5])<<40 | uint64(key[i+6])<<48 | uint64(key[i+7])<<56
+}
+
+// bytes2String fast type conversion from byte array to string, both share the same mem pointer.
+// The returned string must not outlive any later modification of buf.
+func bytes2String(buf []byte) string {
+	return *(*string)(unsafe.Pointer(&buf))
+}
+
+// appendUint64 appends x to b in big-endian order, the way hash.Hash's Sum does.
+func appendUint64(b []byte, x uint64) []byte {
+	return append(b, byte(x>>56), byte(x>>48), byte(x>>40), byte(x>>32), byte(x>>24), byte(x>>16), byte(x>>8), byte(x))
+}
+
+// putUint64LE writes x into b[:8] in little-endian order.
+func putUint64LE(b []byte, x uint64) {
+	_ = b[7] // bounds check hint to compiler
+	b[0], b[1], b[2], b[3] = byte(x), byte(x>>8), byte(x>>16), byte(x>>24)
+	b[4], b[5], b[6], b[7] = byte(x>>32), byte(x>>40), byte(x>>48), byte(x>>56)
+}
@@ -0,0 +1,180 @@
+package hash
+
+import (
+	stdhash "hash"
+	"math/bits"
+)
+
+/*
+	Ported from Wang Yi's wyhash, version final4, with the default secret and
+	WYHASH_CONDOM=1. Reads are explicit little-endian loads, so it produces the
+	same result on little-endian / big-endian machine.
+*/
+
+// More info: https://github.com/wangyi-fudan/wyhash/blob/master/wyhash.h
+
+var _WyhashSecret = [4]uint64{0x2d358dccaa6c78a5, 0x8bb84b93962eacc9, 0x4b33a62ed433d4a3, 0x4d5a2da51de1aa47}
+
+func wymum(a, b uint64) (uint64, uint64) {
+	hi, lo := bits.Mul64(a, b)
+	return lo, hi
+}
+
+func wymix(a, b uint64) uint64 {
+	lo, hi := wymum(a, b)
+	return lo ^ hi
+}
+
+func wyr3(key string, off int, k int) uint64 {
+	return uint64(key[off])<<16 | uint64(key[off+k>>1])<<8 | uint64(key[off+k-1])
+}
+
+// wyhash_48 consumes as many 48-byte blocks of key as possible into the three lanes.
+func wyhash_48(seed, see1, see2 uint64, key string) (uint64, uint64, uint64, int) {
+	idx := 0
+	for ; len(key)-idx >= 48; idx += 48 {
+		seed = wymix(load64(key, idx)^_WyhashSecret[1], load64(key, idx+8)^seed)
+		see1 = wymix(load64(key, idx+16)^_WyhashSecret[2], load64(key, idx+24)^see1)
+		see2 = wymix(load64(key, idx+32)^_WyhashSecret[3], load64(key, idx+40)^see2)
+	}
+	return seed, see1, see2, idx
+}
+
+// wyhash_tail mixes the remaining (< 48) bytes of key into seed, key[:off] may hold the
+// previously consumed bytes since the last 16 bytes are always read as a whole.
+func wyhash_tail(seed uint64, key string, off int, total uint64) uint64 {
+	i := len(key) - off
+	for ; i > 16; i -= 16 {
+		seed = wymix(load64(key, off)^_WyhashSecret[1], load64(key, off+8)^seed)
+		off += 16
+	}
+	a := load64(key, off+i-16)
+	b := load64(key, off+i-8)
+	return wyhash_final(a, b, seed, total)
+}
+
+func wyhash_final(a, b, seed, total uint64) uint64 {
+	a ^= _WyhashSecret[1]
+	b ^= seed
+	a, b = wymum(a, b)
+	return wymix(a^_WyhashSecret[0]^total, b^_WyhashSecret[1])
+}
+
+func wyhash(key string, seed uint64) uint64 {
+	n := len(key)
+	seed ^= wymix(seed^_WyhashSecret[0], _WyhashSecret[1])
+
+	var a, b uint64
+	if n <= 16 {
+		if n >= 4 {
+			a = uint64(load32(key, 0))<<32 | uint64(load32(key, (n>>3)<<2))
+			b = uint64(load32(key, n-4))<<32 | uint64(load32(key, n-4-((n>>3)<<2)))
+		} else if n > 0 {
+			a = wyr3(key, 0, n)
+		}
+		return wyhash_final(a, b, seed, uint64(n))
+	}
+
+	off := 0
+	if n >= 48 {
+		var see1, see2 uint64
+		seed, see1, see2, off = wyhash_48(seed, seed, seed, key)
+		seed ^= see1 ^ see2
+	}
+	return wyhash_tail(seed, key, off, uint64(n))
+}
+
+// WYHASH hashes key with wyhash.
+func WYHASH(key string, seed uint64) uint64 {
+	return wyhash(key, seed)
+}
+
+// WYHASHBytes is the byte-slice version of WYHASH.
+func WYHASHBytes(data []byte, seed uint64) uint64 {
+	return wyhash(bytes2String(data), seed)
+}
+
+// WYHASHDigest is the streaming version of WYHASH, it implements hash.Hash64.
+// It only buffers the last block, plus the 16 bytes before it which the final
+// round of wyhash may read again.
+type WYHASHDigest struct {
+	seed uint64
+
+	lanes   [3]uint64
+	started bool // whether a 48-byte block has been consumed
+	buf     [16 + 48]byte
+	n       int
+	total   uint64
+}
+
+var _ stdhash.Hash64 = (*WYHASHDigest)(nil)
+
+// NewWYHASHDigest creates a streaming wyhash digest with the given seed.
+func NewWYHASHDigest(seed uint64) *WYHASHDigest {
+	d := &WYHASHDigest{seed: seed}
+	d.Reset()
+	return d
+}
+
+// Reset resets the digest to its initial state.
+func (d *WYHASHDigest) Reset() {
+	s := d.seed ^ wymix(d.seed^_WyhashSecret[0], _WyhashSecret[1])
+	d.lanes = [3]uint64{s, s, s}
+	d.started = false
+	d.n = 0
+	d.total = 0
+}
+
+// Size always returns 8 bytes.
+func (d *WYHASHDigest) Size() int { return 8 }
+
+// BlockSize always returns 48 bytes.
+func (d *WYHASHDigest) BlockSize() int { return 48 }
+
+// Write adds more data to the running hash, it never returns an error.
+func (d *WYHASHDigest) Write(p []byte) (int, error) {
+	return d.WriteString(bytes2String(p))
+}
+
+// WriteString adds more data to the running hash, it never returns an error.
+func (d *WYHASHDigest) WriteString(s string) (int, error) {
+	n := len(s)
+	d.total += uint64(n)
+
+	// buf[:16] holds the 16 bytes before the pending data, buf[16:16+d.n] the pending data.
+	for len(s) > 0 {
+		c := copy(d.buf[16+d.n:], s)
+		d.n += c
+		s = s[c:]
+		if d.n < 48 {
+			break
+		}
+		d.lanes[0], d.lanes[1], d.lanes[2], _ = wyhash_48(d.lanes[0], d.lanes[1], d.lanes[2], bytes2String(d.buf[16:]))
+		d.started = true
+		copy(d.buf[:16], d.buf[48:])
+		d.n = 0
+
+		// consume whole blocks straight from s
+		if len(s) >= 48 {
+			var idx int
+			d.lanes[0], d.lanes[1], d.lanes[2], idx = wyhash_48(d.lanes[0], d.lanes[1], d.lanes[2], s)
+			copy(d.buf[:16], s[idx-16:idx])
+			s = s[idx:]
+		}
+	}
+	return n, nil
+}
+
+// Sum64 returns the current hash.
+func (d *WYHASHDigest) Sum64() uint64 {
+	if !d.started {
+		return wyhash(string(d.buf[16:16+d.n]), d.seed)
+	}
+	seed := d.lanes[0] ^ d.lanes[1] ^ d.lanes[2]
+	return wyhash_tail(seed, string(d.buf[:16+d.n]), 16, d.total)
+}
+
+// Sum appends the current hash to b in big-endian order and returns the resulting slice.
+func (d *WYHASHDigest) Sum(b []byte) []byte {
+	return appendUint64(b, d.Sum64())
+}
@@ -0,0 +1,37 @@
+package hash
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWYHASH(t *testing.T) {
+	// test vectors of wyhash final4, the seed is the index of the message.
+	cases := []struct {
+		key string
+		h   uint64
+	}{
+		{"", 0x93228a4de0eec5a2},
+		{"a", 0xc5bac3db178713c4},
+		{"abc", 0xa97f2f7b1d9b3314},
+		{"message digest", 0x786d1f1df3801df4},
+		{"abcdefghijklmnopqrstuvwxyz", 0xdca5a8138ad37c87},
+		{"ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789", 0xb9e734f117cfaf70},
+		{"12345678901234567890123456789012345678901234567890123456789012345678901234567890", 0x6cc5eab49a92d617},
+	}
+	for i, c := range cases {
+		assert.Equal(t, c.h, WYHASH(c.key, uint64(i)), c.key)
+		assert.Equal(t, c.h, WYHASHBytes([]byte(c.key), uint64(i)), c.key)
+	}
+}
+
+func TestWYHASHDigest(t *testing.T) {
+	for _, n := range []int{0, 3, 16, 17, 47, 48, 49, 64, 95, 96, 97, 1000, 4096} {
+		data := testInput(n)
+		want := WYHASHBytes(data, _TestSeed)
+		for _, chunk := range []int{1, 5, 16, 48, 49, 1000} {
+			assert.Equal(t, want, streamSum64(NewWYHASHDigest(_TestSeed), data, chunk), "n=%d chunk=%d", n, chunk)
+		}
+	}
+}
@@ -0,0 +1,382 @@
+package hash
+
+import (
+	stdhash "hash"
+	"math/bits"
+)
+
+/*
+	XXH3 is the 64-bit variant of xxHash's 3rd generation. It picks a different
+	routine for short (0-16, 17-128, 129-240 bytes) and long inputs, and mixes the
+	input with a 192-byte secret. A non-zero seed is folded into the short-input
+	routines directly and derives a custom secret for long inputs.
+*/
+
+// More info: https://github.com/Cyan4973/xxHash/blob/dev/doc/xxhash_spec.md
+
+const (
+	_XXH3SecretSize       = 192
+	_XXH3SecretSizeMin    = 136
+	_XXH3StripeLen        = 64
+	_XXH3SecretConsume    = 8
+	_XXH3StripesPerBlock  = (_XXH3SecretSize - _XXH3StripeLen) / _XXH3SecretConsume
+	_XXH3BlockLen         = _XXH3StripeLen * _XXH3StripesPerBlock
+	_XXH3MidSizeMax       = 240
+	_XXH3InternalBufSize  = 256
+	_XXH3SecretLastAccOff = 7
+	_XXH3SecretMergeOff   = 11
+	_XXH3MidSizeStartOff  = 3
+	_XXH3MidSizeLastOff   = 17
+
+	_XXH3PrimeMx1 uint64 = 0x165667919e3779f9
+	_XXH3PrimeMx2 uint64 = 0x9fb21c651e98df25
+)
+
+// _XXH3Secret is the default secret, taken directly from FARSH.
+const _XXH3Secret = "" +
+	"\xb8\xfe\x6c\x39\x23\xa4\x4b\xbe\x7c\x01\x81\x2c\xf7\x21\xad\x1c" +
+	"\xde\xd4\x6d\xe9\x83\x90\x97\xdb\x72\x40\xa4\xa4\xb7\xb3\x67\x1f" +
+	"\xcb\x79\xe6\x4e\xcc\xc0\xe5\x78\x82\x5a\xd0\x7d\xcc\xff\x72\x21" +
+	"\xb8\x08\x46\x74\xf7\x43\x24\x8e\xe0\x35\x90\xe6\x81\x3a\x26\x4c" +
+	"\x3c\x28\x52\xbb\x91\xc3\x00\xcb\x88\xd0\x65\x8b\x1b\x53\x2e\xa3" +
+	"\x71\x64\x48\x97\xa2\x0d\xf9\x4e\x38\x19\xef\x46\xa9\xde\xac\xd8" +
+	"\xa8\xfa\x76\x3f\xe3\x9c\x34\x3f\xf9\xdc\xbb\xc7\xc7\x0b\x4f\x1d" +
+	"\x8a\x51\xe0\x4b\xcd\xb4\x59\x31\xc8\x9f\x7e\xc9\xd9\x78\x73\x64" +
+	"\xea\xc5\xac\x83\x34\xd3\xeb\xc3\xc5\x81\xa0\xff\xfa\x13\x63\xeb" +
+	"\x17\x0d\xdd\x51\xb7\xf0\xda\x49\xd3\x16\x55\x26\x29\xd4\x68\x9e" +
+	"\x2b\x16\xbe\x58\x7d\x47\xa1\xfc\x8f\xf8\xb8\xd1\x7a\xd0\x31\xce" +
+	"\x45\xcb\x3a\x8f\x95\x16\x04\x28\xaf\xd7\xfb\xca\xbb\x4b\x40\x7e"
+
+func xxh3_mul128_fold64(lhs, rhs uint64) uint64 {
+	hi, lo := bits.Mul64(lhs, rhs)
+	return hi ^ lo
+}
+
+func xxh3_avalanche(h uint64) uint64 {
+	h ^= h >> 37
+	h *= _XXH3PrimeMx1
+	h ^= h >> 32
+	return h
+}
+
+func xxh3_rrmxmx(h uint64, n uint64) uint64 {
+	h ^= bits.RotateLeft64(h, 49) ^ bits.RotateLeft64(h, 24)
+	h *= _XXH3PrimeMx2
+	h ^= (h >> 35) + n
+	h *= _XXH3PrimeMx2
+	h ^= h >> 28
+	return h
+}
+
+func xxh3_len_1to3(key string, secret string, seed uint64) uint64 {
+	n := len(key)
+	c1 := uint32(key[0])
+	c2 := uint32(key[n>>1])
+	c3 := uint32(key[n-1])
+	combined := c1<<16 | c2<<24 | c3 | uint32(n)<<8
+	bitflip := uint64(load32(secret, 0)^load32(secret, 4)) + seed
+	return xxh64_avalanche(uint64(combined) ^ bitflip)
+}
+
+func xxh3_len_4to8(key string, secret string, seed uint64) uint64 {
+	n := len(key)
+	seed ^= uint64(bits.ReverseBytes32(uint32(seed))) << 32
+	input1 := load32(key, 0)
+	input2 := load32(key, n-4)
+	bitflip := (load64(secret, 8) ^ load64(secret, 16)) - seed
+	input64 := uint64(input2) + uint64(input1)<<32
+	return xxh3_rrmxmx(input64^bitflip, uint64(n))
+}
+
+func xxh3_len_9to16(key string, secret string, seed uint64) uint64 {
+	n := len(key)
+	bitflip1 := (load64(secret, 24) ^ load64(secret, 32)) + seed
+	bitflip2 := (load64(secret, 40) ^ load64(secret, 48)) - seed
+	inputLo := load64(key, 0) ^ bitflip1
+	inputHi := load64(key, n-8) ^ bitflip2
+	acc := uint64(n) + bits.ReverseBytes64(inputLo) + inputHi + xxh3_mul128_fold64(inputLo, inputHi)
+	return xxh3_avalanche(acc)
+}
+
+func xxh3_len_0to16(key string, secret string, seed uint64) uint64 {
+	n := len(key)
+	switch {
+	case n > 8:
+		return xxh3_len_9to16(key, secret, seed)
+	case n >= 4:
+		return xxh3_len_4to8(key, secret, seed)
+	case n > 0:
+		return xxh3_len_1to3(key, secret, seed)
+	}
+	return xxh64_avalanche(seed ^ load64(secret, 56) ^ load64(secret, 64))
+}
+
+func xxh3_mix16B(key string, off int, secret string, secOff int, seed uint64) uint64 {
+	return xxh3_mul128_fold64(
+		load64(key, off)^(load64(secret, secOff)+seed),
+		load64(key, off+8)^(load64(secret, secOff+8)-seed),
+	)
+}
+
+func xxh3_len_17to128(key string, secret string, seed uint64) uint64 {
+	n := len(key)
+	acc := uint64(n) * _XXHPrime64_1
+	if n > 32 {
+		if n > 64 {
+			if n > 96 {
+				acc += xxh3_mix16B(key, 48, secret, 96, seed)
+				acc += xxh3_mix16B(key, n-64, secret, 112, seed)
+			}
+			acc += xxh3_mix16B(key, 32, secret, 64, seed)
+			acc += xxh3_mix16B(key, n-48, secret, 80, seed)
+		}
+		acc += xxh3_mix16B(key, 16, secret, 32, seed)
+		acc += xxh3_mix16B(key, n-32, secret, 48, seed)
+	}
+	acc += xxh3_mix16B(key, 0, secret, 0, seed)
+	acc += xxh3_mix16B(key, n-16, secret, 16, seed)
+	return xxh3_avalanche(acc)
+}
+
+func xxh3_len_129to240(key string, secret string, seed uint64) uint64 {
+	n := len(key)
+	acc := uint64(n) * _XXHPrime64_1
+	nbRounds := n / 16
+	for i := 0; i < 8; i++ {
+		acc += xxh3_mix16B(key, 16*i, secret, 16*i, seed)
+	}
+	accEnd := xxh3_mix16B(key, n-16, secret, _XXH3SecretSizeMin-_XXH3MidSizeLastOff, seed)
+	acc = xxh3_avalanche(acc)
+	for i := 8; i < nbRounds; i++ {
+		accEnd += xxh3_mix16B(key, 16*i, secret, 16*(i-8)+_XXH3MidSizeStartOff, seed)
+	}
+	return xxh3_avalanche(acc + accEnd)
+}
+
+func xxh3_init_acc() [8]uint64 {
+	return [8]uint64{
+		uint64(_XXHPrime32_3), _XXHPrime64_1, _XXHPrime64_2, _XXHPrime64_3,
+		_XXHPrime64_4, uint64(_XXHPrime32_2), _XXHPrime64_5, uint64(_XXHPrime32_1),
+	}
+}
+
+func xxh3_accumulate_512(acc *[8]uint64, key string, off int, secret string, secOff int) {
+	for i := 0; i < 8; i++ {
+		dataVal := load64(key, off+8*i)
+		dataKey := dataVal ^ load64(secret, secOff+8*i)
+		acc[i^1] += dataVal // swap adjacent lanes
+		acc[i] += (dataKey & 0xffffffff) * (dataKey >> 32)
+	}
+}
+
+func xxh3_accumulate(acc *[8]uint64, key string, off int, secret string, secOff int, nbStripes int) {
+	for s := 0; s < nbStripes; s++ {
+		xxh3_accumulate_512(acc, key, off+s*_XXH3StripeLen, secret, secOff+s*_XXH3SecretConsume)
+	}
+}
+
+func xxh3_scramble(acc *[8]uint64, secret string, secOff int) {
+	for i := 0; i < 8; i++ {
+		a := acc[i]
+		a ^= a >> 47
+		a ^= load64(secret, secOff+8*i)
+		a *= uint64(_XXHPrime32_1)
+		acc[i] = a
+	}
+}
+
+func xxh3_merge_accs(acc *[8]uint64, secret string, secOff int, start uint64) uint64 {
+	result := start
+	for i := 0; i < 4; i++ {
+		result += xxh3_mul128_fold64(
+			acc[2*i]^load64(secret, secOff+16*i),
+			acc[2*i+1]^load64(secret, secOff+16*i+8),
+		)
+	}
+	return xxh3_avalanche(result)
+}
+
+func xxh3_hash_long(key string, secret string) uint64 {
+	n := len(key)
+	acc := xxh3_init_acc()
+
+	nbBlocks := (n - 1) / _XXH3BlockLen
+	for b := 0; b < nbBlocks; b++ {
+		xxh3_accumulate(&acc, key, b*_XXH3BlockLen, secret, 0, _XXH3StripesPerBlock)
+		xxh3_scramble(&acc, secret, _XXH3SecretSize-_XXH3StripeLen)
+	}
+
+	// last partial block
+	nbStripes := ((n - 1) - _XXH3BlockLen*nbBlocks) / _XXH3StripeLen
+	xxh3_accumulate(&acc, key, nbBlocks*_XXH3BlockLen, secret, 0, nbStripes)
+
+	// last stripe
+	xxh3_accumulate_512(&acc, key, n-_XXH3StripeLen, secret, _XXH3SecretSize-_XXH3StripeLen-_XXH3SecretLastAccOff)
+
+	return xxh3_merge_accs(&acc, secret, _XXH3SecretMergeOff, uint64(n)*_XXHPrime64_1)
+}
+
+// xxh3_custom_secret derives the secret used by long inputs from a non-zero seed.
+func xxh3_custom_secret(seed uint64) string {
+	secret := make([]byte, _XXH3SecretSize)
+	for i := 0; i < _XXH3SecretSize/16; i++ {
+		lo := load64(_XXH3Secret, 16*i) + seed
+		hi := load64(_XXH3Secret, 16*i+8) - seed
+		putUint64LE(secret[16*i:], lo)
+		putUint64LE(secret[16*i+8:], hi)
+	}
+	return bytes2String(secret)
+}
+
+func xxh3_64(key string, seed uint64) uint64 {
+	n := len(key)
+	switch {
+	case n <= 16:
+		return xxh3_len_0to16(key, _XXH3Secret, seed)
+	case n <= 128:
+		return xxh3_len_17to128(key, _XXH3Secret, seed)
+	case n <= _XXH3MidSizeMax:
+		return xxh3_len_129to240(key, _XXH3Secret, seed)
+	}
+	if seed == 0 {
+		return xxh3_hash_long(key, _XXH3Secret)
+	}
+	return xxh3_hash_long(key, xxh3_custom_secret(seed))
+}
+
+// XXH3 hashes key with XXH3-64.
+func XXH3(key string, seed uint64) uint64 {
+	return xxh3_64(key, seed)
+}
+
+// XXH3Bytes is the byte-slice version of XXH3.
+func XXH3Bytes(data []byte, seed uint64) uint64 {
+	return xxh3_64(bytes2String(data), seed)
+}
+
+// XXH3Digest is the streaming version of XXH3, it implements hash.Hash64.
+type XXH3Digest struct {
+	seed   uint64
+	secret string
+
+	acc            [8]uint64
+	buf            [_XXH3InternalBufSize]byte
+	n              int
+	nbStripesSoFar int
+	total          uint64
+}
+
+var _ stdhash.Hash64 = (*XXH3Digest)(nil)
+
+// NewXXH3Digest creates a streaming XXH3-64 digest with the given seed.
+func NewXXH3Digest(seed uint64) *XXH3Digest {
+	d := &XXH3Digest{seed: seed, secret: _XXH3Secret}
+	if seed != 0 {
+		d.secret = xxh3_custom_secret(seed)
+	}
+	d.Reset()
+	return d
+}
+
+// Reset resets the digest to its initial state.
+func (d *XXH3Digest) Reset() {
+	d.acc = xxh3_init_acc()
+	d.n = 0
+	d.nbStripesSoFar = 0
+	d.total = 0
+}
+
+// Size always returns 8 bytes.
+func (d *XXH3Digest) Size() int { return 8 }
+
+// BlockSize always returns 64 bytes.
+func (d *XXH3Digest) BlockSize() int { return _XXH3StripeLen }
+
+// consumeStripes accumulates nbStripes stripes of key starting at off, scrambling whenever a
+// block is complete, and returns the offset right after the last consumed stripe.
+func (d *XXH3Digest) consumeStripes(acc *[8]uint64, soFar *int, key string, off int, nbStripes int) int {
+	secOff := *soFar * _XXH3SecretConsume
+	if nbStripes >= _XXH3StripesPerBlock-*soFar {
+		thisIter := _XXH3StripesPerBlock - *soFar
+		for {
+			xxh3_accumulate(acc, key, off, d.secret, secOff, thisIter)
+			xxh3_scramble(acc, d.secret, _XXH3SecretSize-_XXH3StripeLen)
+			off += thisIter * _XXH3StripeLen
+			nbStripes -= thisIter
+			thisIter = _XXH3StripesPerBlock
+			secOff = 0
+			if nbStripes < _XXH3StripesPerBlock {
+				break
+			}
+		}
+		*soFar = 0
+	}
+	if nbStripes > 0 {
+		xxh3_accumulate(acc, key, off, d.secret, secOff, nbStripes)
+		off += nbStripes * _XXH3StripeLen
+		*soFar += nbStripes
+	}
+	return off
+}
+
+// Write adds more data to the running hash, it never returns an error.
+func (d *XXH3Digest) Write(p []byte) (int, error) {
+	return d.WriteString(bytes2String(p))
+}
+
+// WriteString adds more data to the running hash, it never returns an error.
+func (d *XXH3Digest) WriteString(s string) (int, error) {
+	n := len(s)
+	d.total += uint64(n)
+
+	if n <= _XXH3InternalBufSize-d.n {
+		d.n += copy(d.buf[d.n:], s)
+		return n, nil
+	}
+
+	off := 0
+	if d.n > 0 {
+		off = copy(d.buf[d.n:], s)
+		d.consumeStripes(&d.acc, &d.nbStripesSoFar, bytes2String(d.buf[:]), 0, _XXH3InternalBufSize/_XXH3StripeLen)
+		d.n = 0
+	}
+
+	// always keep some input buffered, the last stripe is handled by Sum64
+	if n-off > _XXH3InternalBufSize {
+		nbStripes := (n - 1 - off) / _XXH3StripeLen
+		off = d.consumeStripes(&d.acc, &d.nbStripesSoFar, s, off, nbStripes)
+		// keep the last consumed stripe around, in case it is needed as part of the last stripe
+		copy(d.buf[_XXH3InternalBufSize-_XXH3StripeLen:], s[off-_XXH3StripeLen:off])
+	}
+
+	d.n = copy(d.buf[:], s[off:])
+	return n, nil
+}
+
+// Sum64 returns the current hash.
+func (d *XXH3Digest) Sum64() uint64 {
+	if d.total <= _XXH3MidSizeMax {
+		return xxh3_64(string(d.buf[:d.n]), d.seed)
+	}
+
+	acc := d.acc
+	buf := bytes2String(d.buf[:])
+	var lastStripe string
+	if d.n >= _XXH3StripeLen {
+		nbStripes := (d.n - 1) / _XXH3StripeLen
+		soFar := d.nbStripesSoFar
+		d.consumeStripes(&acc, &soFar, buf, 0, nbStripes)
+		lastStripe = buf[d.n-_XXH3StripeLen : d.n]
+	} else {
+		catchup := _XXH3StripeLen - d.n
+		lastStripe = buf[_XXH3InternalBufSize-catchup:] + buf[:d.n]
+	}
+	xxh3_accumulate_512(&acc, lastStripe, 0, d.secret, _XXH3SecretSize-_XXH3StripeLen-_XXH3SecretLastAccOff)
+
+	return xxh3_merge_accs(&acc, d.secret, _XXH3SecretMergeOff, d.total*_XXHPrime64_1)
+}
+
+// Sum appends the current hash to b in big-endian order and returns the resulting slice.
+func (d *XXH3Digest) Sum(b []byte) []byte {
+	return appendUint64(b, d.Sum64())
+}
@@ -0,0 +1,187 @@
+package hash
+
+import (
+	stdhash "hash"
+	"math/bits"
+)
+
+// More info: https://github.com/Cyan4973/xxHash/blob/dev/doc/xxhash_spec.md
+
+const (
+	_XXHPrime32_1 uint32 = 0x9e3779b1
+	_XXHPrime32_2 uint32 = 0x85ebca77
+	_XXHPrime32_3 uint32 = 0xc2b2ae3d
+
+	_XXHPrime64_1 uint64 = 0x9e3779b185ebca87
+	_XXHPrime64_2 uint64 = 0xc2b2ae3d27d4eb4f
+	_XXHPrime64_3 uint64 = 0x165667b19e3779f9
+	_XXHPrime64_4 uint64 = 0x85ebca77c2b2ae63
+	_XXHPrime64_5 uint64 = 0x27d4eb2f165667c5
+)
+
+func xxh64_round(acc, input uint64) uint64 {
+	acc += input * _XXHPrime64_2
+	acc = bits.RotateLeft64(acc, 31)
+	acc *= _XXHPrime64_1
+	return acc
+}
+
+func xxh64_merge_round(acc, val uint64) uint64 {
+	val = xxh64_round(0, val)
+	acc ^= val
+	acc = acc*_XXHPrime64_1 + _XXHPrime64_4
+	return acc
+}
+
+func xxh64_avalanche(h uint64) uint64 {
+	h ^= h >> 33
+	h *= _XXHPrime64_2
+	h ^= h >> 29
+	h *= _XXHPrime64_3
+	h ^= h >> 32
+	return h
+}
+
+// xxh64_stripes consumes as many 32-byte stripes of key as possible into v.
+func xxh64_stripes(v *[4]uint64, key string) int {
+	idx := 0
+	for ; len(key)-idx >= 32; idx += 32 {
+		v[0] = xxh64_round(v[0], load64(key, idx))
+		v[1] = xxh64_round(v[1], load64(key, idx+8))
+		v[2] = xxh64_round(v[2], load64(key, idx+16))
+		v[3] = xxh64_round(v[3], load64(key, idx+24))
+	}
+	return idx
+}
+
+// xxh64_finalize mixes the remaining (< 32) bytes of tail into h.
+func xxh64_finalize(h uint64, tail string) uint64 {
+	idx := 0
+	for ; len(tail)-idx >= 8; idx += 8 {
+		h ^= xxh64_round(0, load64(tail, idx))
+		h = bits.RotateLeft64(h, 27)*_XXHPrime64_1 + _XXHPrime64_4
+	}
+	if len(tail)-idx >= 4 {
+		h ^= uint64(load32(tail, idx)) * _XXHPrime64_1
+		h = bits.RotateLeft64(h, 23)*_XXHPrime64_2 + _XXHPrime64_3
+		idx += 4
+	}
+	for ; idx < len(tail); idx++ {
+		h ^= uint64(tail[idx]) * _XXHPrime64_5
+		h = bits.RotateLeft64(h, 11) * _XXHPrime64_1
+	}
+	return xxh64_avalanche(h)
+}
+
+func xxh64_init(seed uint64) [4]uint64 {
+	return [4]uint64{seed + _XXHPrime64_1 + _XXHPrime64_2, seed + _XXHPrime64_2, seed, seed - _XXHPrime64_1}
+}
+
+func xxh64_converge(v *[4]uint64) uint64 {
+	h := bits.RotateLeft64(v[0], 1) + bits.RotateLeft64(v[1], 7) + bits.RotateLeft64(v[2], 12) + bits.RotateLeft64(v[3], 18)
+	h = xxh64_merge_round(h, v[0])
+	h = xxh64_merge_round(h, v[1])
+	h = xxh64_merge_round(h, v[2])
+	h = xxh64_merge_round(h, v[3])
+	return h
+}
+
+func xxhash_64(key string, seed uint64) uint64 {
+	var h uint64
+	idx := 0
+	if len(key) >= 32 {
+		v := xxh64_init(seed)
+		idx = xxh64_stripes(&v, key)
+		h = xxh64_converge(&v)
+	} else {
+		h = seed + _XXHPrime64_5
+	}
+	h += uint64(len(key))
+	return xxh64_finalize(h, key[idx:])
+}
+
+// XXH64 hashes key with xxHash64.
+func XXH64(key string, seed uint64) uint64 {
+	return xxhash_64(key, seed)
+}
+
+// XXH64Bytes is the byte-slice version of XXH64.
+func XXH64Bytes(data []byte, seed uint64) uint64 {
+	return xxhash_64(bytes2String(data), seed)
+}
+
+// XXH64Digest is the streaming version of XXH64, it implements hash.Hash64.
+type XXH64Digest struct {
+	seed  uint64
+	v     [4]uint64
+	total uint64
+	mem   [32]byte
+	n     int
+}
+
+var _ stdhash.Hash64 = (*XXH64Digest)(nil)
+
+// NewXXH64Digest creates a streaming xxHash64 digest with the given seed.
+func NewXXH64Digest(seed uint64) *XXH64Digest {
+	d := &XXH64Digest{seed: seed}
+	d.Reset()
+	return d
+}
+
+// Reset resets the digest to its initial state.
+func (d *XXH64Digest) Reset() {
+	d.v = xxh64_init(d.seed)
+	d.total = 0
+	d.n = 0
+}
+
+// Size always returns 8 bytes.
+func (d *XXH64Digest) Size() int { return 8 }
+
+// BlockSize always returns 32 bytes.
+func (d *XXH64Digest) BlockSize() int { return 32 }
+
+// Write adds more data to the running hash, it never returns an error.
+func (d *XXH64Digest) Write(p []byte) (int, error) {
+	return d.WriteString(bytes2String(p))
+}
+
+// WriteString adds more data to the running hash, it never returns an error.
+func (d *XXH64Digest) WriteString(s string) (int, error) {
+	n := len(s)
+	d.total += uint64(n)
+
+	if d.n+n < 32 {
+		d.n += copy(d.mem[d.n:], s)
+		return n, nil
+	}
+
+	if d.n > 0 {
+		c := copy(d.mem[d.n:], s)
+		xxh64_stripes(&d.v, bytes2String(d.mem[:]))
+		s = s[c:]
+		d.n = 0
+	}
+
+	idx := xxh64_stripes(&d.v, s)
+	d.n = copy(d.mem[:], s[idx:])
+	return n, nil
+}
+
+// Sum64 returns the current hash.
+func (d *XXH64Digest) Sum64() uint64 {
+	var h uint64
+	if d.total >= 32 {
+		v := d.v
+		h = xxh64_converge(&v)
+	} else {
+		h = d.seed + _XXHPrime64_5
+	}
+	h += d.total
+	return xxh64_finalize(h, string(d.mem[:d.n]))
+}
+
+// Sum appends the current hash to b in big-endian order and returns the resulting slice.
+func (d *XXH64Digest) Sum(b []byte) []byte {
+	return appendUint64(b, d.Sum64())
+}
@@ -0,0 +1,119 @@
+package hash
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const _TestSeed uint64 = 0x9e3779b97f4a7c15
+
+// testInput returns a deterministic pseudo-random input of n bytes.
+func testInput(n int) []byte {
+	data := make([]byte, n)
+	for i := range data {
+		data[i] = byte(i * 131 % 251)
+	}
+	return data
+}
+
+// streamSum64 feeds data into d chunk by chunk and returns the resulting hash.
+func streamSum64(d interface {
+	Write([]byte) (int, error)
+	Sum64() uint64
+}, data []byte, chunk int) uint64 {
+	for i := 0; i < len(data); i += chunk {
+		e := i + chunk
+		if e > len(data) {
+			e = len(data)
+		}
+		d.Write(data[i:e]) // nolint
+	}
+	return d.Sum64()
+}
+
+// Reference values are generated with xxhash.h v0.8.2, XXH64() and XXH3_64bits_withSeed().
+var _XXHashVectors = []struct {
+	n     int
+	seed  uint64
+	xxh64 uint64
+	xxh3  uint64
+}{
+	{0, 0, 0xef46db3751d8e999, 0x2d06800538d394c2},
+	{0, _TestSeed, 0xc4349fc93c010000, 0x602b0e2cd6662c8b},
+	{1, 0, 0xe934a84adb052768, 0xc44bdff4074eecdb},
+	{1, _TestSeed, 0x126bb57a12364aa5, 0x062b185e4e01441a},
+	{3, 0, 0x7c8ab8c33e4872c9, 0xc3abf7ae2e250b5a},
+	{3, _TestSeed, 0xda70140315308ced, 0x2503160ce7c516d1},
+	{4, 0, 0x82fe87d9f1a6f212, 0x6e5c9679d43c1e41},
+	{4, _TestSeed, 0x89851231bbbbb126, 0xb9a9ba4c27a57305},
+	{8, 0, 0x74fdb7a8e7c76e92, 0x2c2127bbb99b325e},
+	{8, _TestSeed, 0x01123f380b56348f, 0xff7979db1a554b8a},
+	{9, 0, 0x196256d1a4fb2b5e, 0x8436430b381f7fd6},
+	{9, _TestSeed, 0x01fa1c9fdf17a9ec, 0x802e664e45173142},
+	{16, 0, 0xa4bc34545c4b5bd3, 0xbea42f62eac454d0},
+	{16, _TestSeed, 0xc600889ce601b25a, 0x8641467c384234b5},
+	{17, 0, 0xd60c3acf329e0cd4, 0xc5bc95c990fc83b4},
+	{17, _TestSeed, 0x720e720600d803da, 0x97c7a42d1d38c042},
+	{32, 0, 0x2841e325bd8b8a6e, 0x270fc40a309c23ad},
+	{32, _TestSeed, 0xa67de3a515e98d74, 0x8071b944318515b3},
+	{33, 0, 0x8d6a7345e04b1636, 0x4de9193ef7fabcc1},
+	{33, _TestSeed, 0x2a8ffd867edb9368, 0x43ddd4587d5713d6},
+	{64, 0, 0x74fd6bd23d8e0470, 0xd0bdb3e3c36a32e8},
+	{64, _TestSeed, 0xa60d3672649ce0e0, 0xaaa0e3d88fa8a24a},
+	{65, 0, 0xa2bf7e49826aaa39, 0x60b706ea92d6e22e},
+	{65, _TestSeed, 0x1bbd890a457c97c7, 0x7e20802828b73d99},
+	{128, 0, 0xc347d958e8fb55f9, 0x3d41caa0b80a4385},
+	{128, _TestSeed, 0xbf1f547d324faaa9, 0x244221bee3eab0bb},
+	{129, 0, 0x14b7a3cfcc910446, 0x287795aec899cb57},
+	{129, _TestSeed, 0xaaf2ff65ce069604, 0xfe820b985c6fff85},
+	{240, 0, 0x4d917010d4ac8ddb, 0x4da2ab7921d06c5e},
+	{240, _TestSeed, 0x608de4a7c4ea1e4a, 0xfe6f36fe65e97721},
+	{241, 0, 0x3f74f7e88da28610, 0x179ead905bf75a05},
+	{241, _TestSeed, 0x85b42fed8446051d, 0xde60fb92c872eda1},
+	{256, 0, 0x1ae68e34eb31782a, 0x4a0bf471528a187b},
+	{256, _TestSeed, 0x472a6baf69f27a95, 0x1f8cd6742d7779f4},
+	{1024, 0, 0x51c76ff0152bbc86, 0x89afebe614a926d7},
+	{1024, _TestSeed, 0x1d153ebe86e8a120, 0x38b1f5f586689da0},
+	{1025, 0, 0xc9b4740421c9530e, 0x6d6273cffcf616f7},
+	{1025, _TestSeed, 0x470c08a34ec016b2, 0x652768d370f48c51},
+	{2048, 0, 0xf685da7aad9ec7ad, 0x2ef1df256302e71b},
+	{2048, _TestSeed, 0x186c99cdecb7fba3, 0xc8cd4947276f690c},
+	{4096, 0, 0xba539c8a36751002, 0x1f0d35f134374e5d},
+	{4096, _TestSeed, 0x66fe047c2655775b, 0xf8574dc067f97e57},
+}
+
+func TestXXH64(t *testing.T) {
+	for _, v := range _XXHashVectors {
+		data := testInput(v.n)
+		assert.Equal(t, v.xxh64, XXH64(string(data), v.seed), "n=%d seed=%x", v.n, v.seed)
+		assert.Equal(t, v.xxh64, XXH64Bytes(data, v.seed), "n=%d seed=%x", v.n, v.seed)
+		for _, chunk := range []int{1, 5, 32, 33, 1000} {
+			assert.Equal(t, v.xxh64, streamSum64(NewXXH64Digest(v.seed), data, chunk), "n=%d seed=%x chunk=%d", v.n, v.seed, chunk)
+		}
+	}
+}
+
+func TestXXH3(t *testing.T) {
+	for _, v := range _XXHashVectors {
+		data := testInput(v.n)
+		assert.Equal(t, v.xxh3, XXH3(string(data), v.seed), "n=%d seed=%x", v.n, v.seed)
+		assert.Equal(t, v.xxh3, XXH3Bytes(data, v.seed), "n=%d seed=%x", v.n, v.seed)
+		for _, chunk := range []int{1, 5, 64, 255, 256, 257, 1000} {
+			assert.Equal(t, v.xxh3, streamSum64(NewXXH3Digest(v.seed), data, chunk), "n=%d seed=%x chunk=%d", v.n, v.seed, chunk)
+		}
+	}
+}
+
+func TestXXHDigestReset(t *testing.T) {
+	data := testInput(3000)
+	d64 := NewXXH64Digest(_TestSeed)
+	d3 := NewXXH3Digest(_TestSeed)
+	d64.Write(data) // nolint
+	d3.Write(data)  // nolint
+	assert.Equal(t, 8, len(d64.Sum(nil)))
+	d64.Reset()
+	d3.Reset()
+	assert.Equal(t, XXH64Bytes(data[:100], _TestSeed), streamSum64(d64, data[:100], 7))
+	assert.Equal(t, XXH3Bytes(data[:100], _TestSeed), streamSum64(d3, data[:100], 7))
+}
@@ -0,0 +1,392 @@
+package hyperloglog
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/bits"
+	"sort"
+	"sync"
+
+	"github.com/amazingchow/photon-dance-bigdata-toolkit/hash"
+)
+
+/*
+	HyperLogLog estimates the number of distinct items with m = 2^p registers: the first
+	p bits of the 64-bit hash of an item select a register, which keeps the maximum rank
+	(position of the first 1 bit) of the remaining bits. The standard error is about
+	1.04 / sqrt(m), 0.81% for the default precision 14 which takes 16KB.
+
+	HLL++ improves it in 3 ways:
+
+	1. 64-bit hashes, so large cardinalities need no correction.
+	2. Bias correction: the raw estimate overestimates cardinalities below 5m, the bias is
+	   looked up by k-nearest-neighbor interpolation in empirical tables (hyperloglog_bias.go),
+	   and linear counting is used below a threshold depending on p.
+	3. Sparse representation: while few registers are set, the (index, rank) pairs are kept
+	   at precision p' = 25 in a sorted, delta and varint encoded list, which is far more
+	   accurate and smaller than 2^p registers. It turns dense once it would be larger.
+*/
+
+// More info: https://research.google/pubs/pub40671/
+
+const (
+	// MinPrecision and MaxPrecision bound the precision of a HyperLogLog.
+	MinPrecision = 4
+	MaxPrecision = 18
+	// DefaultPrecision is a good trade-off between accuracy and size.
+	DefaultPrecision = 14
+
+	// precision of the sparse representation.
+	_SparsePrecision = 25
+	// nearest neighbors averaged by the bias correction.
+	_BiasNeighbors = 6
+)
+
+var (
+	// linear counting is used below these estimates, for every precision from MinPrecision.
+	_Thresholds = [MaxPrecision - MinPrecision + 1]float64{
+		10, 20, 40, 80, 220, 400, 900, 1800, 3100, 6500, 11500, 20000, 50000, 120000, 350000,
+	}
+)
+
+// HyperLogLog implements the HLL++ cardinality estimator described by
+// "HyperLogLog in Practice: Algorithmic Engineering of a State of The Art Cardinality Estimation Algorithm".
+type HyperLogLog struct {
+	mu sync.RWMutex
+
+	p uint8
+
+	// sparse representation, used while registers is nil.
+	sparse    []byte   // sorted encoded entries, delta and varint encoded
+	sparseLen int      // number of entries of sparse
+	tmp       []uint32 // encoded entries not merged into sparse yet
+
+	registers []uint8
+}
+
+// NewHyperLogLog creates a HyperLogLog with 2^p registers, it panics if p is not in [MinPrecision, MaxPrecision].
+func NewHyperLogLog(p uint8) *HyperLogLog {
+	if p < MinPrecision || p > MaxPrecision {
+		panic("unsupported precision")
+	}
+	return &HyperLogLog{p: p}
+}
+
+// Precision returns the precision of hll.
+func (hll *HyperLogLog) Precision() uint8 {
+	return hll.p
+}
+
+// Insert adds x, hashed with hash.XXH3.
+func (hll *HyperLogLog) Insert(x string) {
+	hll.InsertHash(hash.XXH3(x, 0))
+}
+
+// InsertHash adds an item by its 64-bit hash, which must be uniformly distributed.
+func (hll *HyperLogLog) InsertHash(h uint64) {
+	hll.mu.Lock()
+	defer hll.mu.Unlock()
+
+	if hll.registers != nil {
+		hll.insertDense(h)
+		return
+	}
+	hll.tmp = append(hll.tmp, encodeHash(h, hll.p))
+	if len(hll.tmp) >= hll.tmpCap() {
+		hll.flush()
+	}
+}
+
+func (hll *HyperLogLog) insertDense(h uint64) {
+	idx := h >> (64 - hll.p)
+	// the guard bit caps the rank at 64 - p + 1.
+	rank := uint8(bits.LeadingZeros64(h<<hll.p|1<<(hll.p-1))) + 1
+	if rank > hll.registers[idx] {
+		hll.registers[idx] = rank
+	}
+}
+
+// tmpCap returns the number of entries buffered before they are merged into the sparse list.
+func (hll *HyperLogLog) tmpCap() int {
+	if n := 1 << hll.p >> 4; n > 16 {
+		return n
+	}
+	return 16
+}
+
+// flush merges tmp into the sparse list, and turns hll dense if the list gets larger than the registers.
+func (hll *HyperLogLog) flush() {
+	if len(hll.tmp) == 0 {
+		return
+	}
+	entries := mergeEntries(decodeList(hll.sparse, hll.sparseLen), hll.tmp)
+	hll.tmp = hll.tmp[:0]
+	hll.sparse, hll.sparseLen = encodeList(entries), len(entries)
+	if len(hll.sparse) > 1<<hll.p {
+		hll.toDense()
+	}
+}
+
+// toDense moves the sparse entries into registers.
+func (hll *HyperLogLog) toDense() {
+	registers := make([]uint8, 1<<hll.p)
+	for _, k := range mergeEntries(decodeList(hll.sparse, hll.sparseLen), hll.tmp) {
+		idx, rank := decodeEntry(k, hll.p)
+		if rank > registers[idx] {
+			registers[idx] = rank
+		}
+	}
+	hll.registers = registers
+	hll.sparse, hll.sparseLen, hll.tmp = nil, 0, nil
+}
+
+// Count returns the estimated number of distinct items added to hll.
+func (hll *HyperLogLog) Count() uint64 {
+	hll.mu.Lock()
+	defer hll.mu.Unlock()
+
+	if hll.registers == nil {
+		hll.flush()
+	}
+	if hll.registers == nil {
+		// linear counting over the 2^p' registers of the sparse representation.
+		m := float64(uint64(1) << _SparsePrecision)
+		return uint64(math.Round(linearCounting(m, m-float64(hll.sparseLen))))
+	}
+
+	m := float64(len(hll.registers))
+	var sum float64
+	var zeros int
+	for _, r := range hll.registers {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+	e := alpha(len(hll.registers)) * m * m / sum
+	if e <= 5*m {
+		e -= estimateBias(e, hll.p)
+	}
+	h := e
+	if zeros != 0 {
+		h = linearCounting(m, float64(zeros))
+	}
+	if h <= _Thresholds[hll.p-MinPrecision] {
+		e = h
+	}
+	return uint64(math.Round(math.Max(e, 0)))
+}
+
+func linearCounting(m, zeros float64) float64 {
+	return m * math.Log(m/zeros)
+}
+
+func alpha(m int) float64 {
+	switch m {
+	case 16:
+		return 0.673
+	case 32:
+		return 0.697
+	case 64:
+		return 0.709
+	}
+	return 0.7213 / (1 + 1.079/float64(m))
+}
+
+// estimateBias averages the bias of the raw estimates closest to e.
+func estimateBias(e float64, p uint8) float64 {
+	estimates := _RawEstimates[p-MinPrecision]
+	biases := _Biases[p-MinPrecision]
+
+	// estimates is sorted, grow the window [lo, hi) of the nearest neighbors around e.
+	hi := sort.SearchFloat64s(estimates, e)
+	lo := hi
+	for hi-lo < _BiasNeighbors && (lo > 0 || hi < len(estimates)) {
+		if lo == 0 || hi < len(estimates) && estimates[hi]-e < e-estimates[lo-1] {
+			hi++
+		} else {
+			lo--
+		}
+	}
+	var sum float64
+	for _, b := range biases[lo:hi] {
+		sum += b
+	}
+	return sum / float64(hi-lo)
+}
+
+// Merge adds the items of other to hll, both must have the same precision.
+func (hll *HyperLogLog) Merge(other *HyperLogLog) error {
+	if hll == other {
+		return nil
+	}
+	if hll.p != other.p {
+		return fmt.Errorf("mismatched precisions %d and %d", hll.p, other.p)
+	}
+
+	// copy other first, so that merging 2 HyperLogLogs into each other concurrently can not deadlock.
+	other.mu.RLock()
+	var registers []uint8
+	var entries []uint32
+	if other.registers != nil {
+		registers = append(registers, other.registers...)
+	} else {
+		entries = mergeEntries(decodeList(other.sparse, other.sparseLen), other.tmp)
+	}
+	other.mu.RUnlock()
+
+	hll.mu.Lock()
+	defer hll.mu.Unlock()
+
+	if registers != nil {
+		if hll.registers == nil {
+			hll.toDense()
+		}
+		for i, r := range registers {
+			if r > hll.registers[i] {
+				hll.registers[i] = r
+			}
+		}
+		return nil
+	}
+	if hll.registers != nil {
+		for _, k := range entries {
+			idx, rank := decodeEntry(k, hll.p)
+			if rank > hll.registers[idx] {
+				hll.registers[idx] = rank
+			}
+		}
+		return nil
+	}
+	hll.tmp = append(hll.tmp, entries...)
+	hll.flush()
+	return nil
+}
+
+// Reset removes all items from hll, it turns sparse again.
+func (hll *HyperLogLog) Reset() {
+	hll.mu.Lock()
+	defer hll.mu.Unlock()
+
+	hll.sparse, hll.sparseLen, hll.tmp, hll.registers = nil, 0, nil, nil
+}
+
+const (
+	_SerializeVersion byte = 1
+
+	_FormatSparse byte = 0
+	_FormatDense  byte = 1
+)
+
+// Serialize returns a byte slice representing a HyperLogLog.
+/*
+	layout:
+		version     1 byte
+		precision   1 byte
+		format      1 byte, sparse or dense
+		sparse:
+			n       uvarint, number of entries
+			entries sorted, as varints of the differences between consecutive entries
+		dense:
+			registers  6 bits per register, most significant bit first, 3 * 2^p / 4 bytes
+*/
+func Serialize(hll *HyperLogLog) []byte {
+	hll.mu.Lock()
+	defer hll.mu.Unlock()
+
+	if hll.registers == nil {
+		hll.flush()
+	}
+	bytes := []byte{_SerializeVersion, hll.p, _FormatSparse}
+	if hll.registers == nil {
+		bytes = appendUvarint(bytes, uint64(hll.sparseLen))
+		return append(bytes, hll.sparse...)
+	}
+
+	bytes[2] = _FormatDense
+	bytes = append(bytes, make([]byte, len(hll.registers)*6/8)...)
+	packed := bytes[3:]
+	for i, r := range hll.registers {
+		// a register spans at most 2 bytes.
+		bit := i * 6
+		v := uint16(r) << (10 - bit%8)
+		packed[bit/8] |= byte(v >> 8)
+		if bit%8 > 2 {
+			packed[bit/8+1] |= byte(v)
+		}
+	}
+	return bytes
+}
+
+// Deserialize returns a HyperLogLog from a byte slice.
+func Deserialize(bytes []byte) (*HyperLogLog, error) {
+	if len(bytes) < 3 {
+		return nil, fmt.Errorf("expected at least 3 bytes, got %d", len(bytes))
+	}
+	if bytes[0] != _SerializeVersion {
+		return nil, fmt.Errorf("unsupported version %d", bytes[0])
+	}
+	p := bytes[1]
+	if p < MinPrecision || p > MaxPrecision {
+		return nil, fmt.Errorf("unsupported precision %d", p)
+	}
+	hll := NewHyperLogLog(p)
+	maxRank := 64 - p + 1
+
+	switch bytes[2] {
+	case _FormatSparse:
+		n, size := binary.Uvarint(bytes[3:])
+		if size <= 0 {
+			return nil, fmt.Errorf("invalid number of entries")
+		}
+		list := bytes[3+size:]
+		// every entry takes at least 1 byte.
+		entries := make([]uint32, 0, len(list))
+		var prev uint64
+		for len(list) > 0 {
+			d, size := binary.Uvarint(list)
+			if size <= 0 {
+				return nil, fmt.Errorf("invalid entry %d", len(entries))
+			}
+			list = list[size:]
+			k := prev + d
+			if d > math.MaxUint32 || k > math.MaxUint32 || len(entries) > 0 && k>>7 <= prev>>7 {
+				return nil, fmt.Errorf("entry %d is not sorted", len(entries))
+			}
+			if !validEntry(uint32(k), p) {
+				return nil, fmt.Errorf("invalid entry %d", len(entries))
+			}
+			entries = append(entries, uint32(k))
+			prev = k
+		}
+		if uint64(len(entries)) != n {
+			return nil, fmt.Errorf("expected %d entries, got %d", n, len(entries))
+		}
+		hll.sparse, hll.sparseLen = encodeList(entries), len(entries)
+
+	case _FormatDense:
+		packed := bytes[3:]
+		m := 1 << p
+		if len(packed) != m*6/8 {
+			return nil, fmt.Errorf("expected %d bytes of registers, got %d", m*6/8, len(packed))
+		}
+		hll.registers = make([]uint8, m)
+		for i := range hll.registers {
+			bit := i * 6
+			v := uint16(packed[bit/8]) << 8
+			if bit%8 > 2 {
+				v |= uint16(packed[bit/8+1])
+			}
+			r := uint8(v>>(10-bit%8)) & 0x3f
+			if r > maxRank {
+				return nil, fmt.Errorf("invalid register %d", i)
+			}
+			hll.registers[i] = r
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown format %d", bytes[2])
+	}
+	return hll, nil
+}
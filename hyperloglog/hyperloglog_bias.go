@@ -0,0 +1,817 @@
+// Code generated by hyperloglog_bias_gen.go; DO NOT EDIT.
+
+package hyperloglog
+
+var (
+	// mean raw estimates of cardinalities up to 5m, sorted, for every precision from MinPrecision.
+	_RawEstimates = [MaxPrecision - MinPrecision + 1][]float64{
+		// precision 4
+		{
+			10.7680, 11.2379, 11.7242, 12.2202, 12.7307, 13.2614, 13.8107, 14.3795,
+			14.9502, 15.5506, 16.1589, 16.7910, 17.4274, 18.0856, 18.7638, 19.4438,
+			20.1387, 20.8701, 21.5818, 22.3362, 23.1022, 23.8813, 24.6666, 25.4827,
+			26.2654, 27.0611, 27.9298, 28.7636, 29.5709, 30.4503, 31.3231, 32.2166,
+			33.0671, 33.9687, 34.8230, 35.7407, 36.6332, 37.6205, 38.5129, 39.4443,
+			40.3571, 41.2787, 42.2525, 43.2027, 44.2198, 45.2199, 46.1380, 47.0898,
+			48.0543, 48.9898, 49.8815, 50.8524, 51.9237, 52.9190, 53.9148, 54.9660,
+			55.9596, 56.9916, 58.0146, 59.0204, 59.9542, 60.9308, 61.9326, 62.9381,
+			63.9653, 64.9510, 65.9401, 66.9411, 68.0030, 69.0544, 70.0739, 71.1072,
+			72.0912, 73.0680, 74.1452, 75.1517, 76.2034, 77.1980, 78.1714, 79.1414,
+			80.1758,
+		},
+		// precision 5
+		{
+			22.3040, 22.7836, 23.2647, 23.7565, 24.2565, 24.7618, 25.2765, 25.7958,
+			26.3163, 26.8504, 27.3870, 27.9340, 28.4922, 29.0499, 29.6144, 30.1842,
+			30.7669, 31.3700, 31.9624, 32.5638, 33.1735, 33.7889, 34.4228, 35.0635,
+			35.7134, 36.3798, 37.0434, 37.7233, 38.4094, 39.0961, 39.7890, 40.4923,
+			41.1900, 41.9066, 42.6418, 43.3785, 44.1039, 44.8433, 45.5943, 46.3552,
+			47.0988, 47.8434, 48.6013, 49.3682, 50.1560, 50.9456, 51.7399, 52.5304,
+			53.3290, 54.1217, 54.9731, 55.8283, 56.6573, 57.4822, 58.3439, 59.1991,
+			60.0584, 60.8968, 61.7500, 62.6336, 63.4872, 64.3402, 65.2261, 66.1167,
+			67.0266, 67.9563, 68.8497, 69.7567, 70.6331, 71.5394, 72.4789, 73.3637,
+			74.3011, 75.2628, 76.1900, 77.1205, 78.0073, 78.9549, 79.8634, 80.7978,
+			81.7875, 82.7323, 83.6306, 84.6659, 85.6494, 86.5870, 87.4923, 88.4247,
+			89.3906, 90.3075, 91.2295, 92.2092, 93.1606, 94.1497, 95.1512, 96.1402,
+			97.1142, 98.1158, 99.0695, 100.0955, 101.0182, 101.9855, 102.9093, 103.8544,
+			104.8853, 105.8861, 106.8320, 107.8333, 108.8457, 109.8192, 110.8174, 111.7603,
+			112.7891, 113.8061, 114.8004, 115.7691, 116.7352, 117.6664, 118.6691, 119.6292,
+			120.6342, 121.6177, 122.5806, 123.4926, 124.5221, 125.5589, 126.5502, 127.6054,
+			128.6038, 129.5776, 130.5570, 131.5295, 132.5211, 133.4504, 134.4785, 135.4598,
+			136.5002, 137.4660, 138.4551, 139.4569, 140.3785, 141.4403, 142.5019, 143.4805,
+			144.6065, 145.5684, 146.6127, 147.6783, 148.6527, 149.6681, 150.6372, 151.5710,
+			152.5259, 153.4835, 154.4690, 155.4953, 156.5465, 157.5220, 158.3918, 159.4082,
+			160.4528,
+		},
+		// precision 6
+		{
+			45.8517, 46.8173, 47.3045, 48.2901, 49.2871, 49.7947, 50.8224, 51.3430,
+			52.4004, 53.4677, 53.9990, 55.0945, 55.6462, 56.7492, 57.8695, 58.4399,
+			59.5810, 60.1636, 61.3359, 62.5015, 63.0897, 64.2984, 64.9010, 66.1347,
+			67.3755, 67.9916, 69.2759, 69.9140, 71.2141, 72.5018, 73.1491, 74.4641,
+			75.1246, 76.4599, 77.7750, 78.4527, 79.8247, 80.5093, 81.8879, 83.3060,
+			84.0105, 85.4377, 86.1502, 87.6119, 89.0773, 89.8326, 91.3076, 92.0395,
+			93.5277, 95.0410, 95.7717, 97.2790, 98.0498, 99.6125, 101.1845, 101.9682,
+			103.5639, 104.3597, 105.9522, 107.5329, 108.3332, 109.9106, 110.7365, 112.3727,
+			113.9858, 114.8008, 116.4385, 117.2922, 118.9211, 120.6294, 121.4981, 123.1682,
+			124.0503, 125.7560, 127.4525, 128.2765, 130.0258, 130.9197, 132.6440, 134.3619,
+			135.2442, 137.0038, 137.8875, 139.6190, 141.3737, 142.2590, 143.9999, 144.8782,
+			146.6279, 148.4835, 149.3742, 151.2386, 152.1295, 154.0132, 155.8469, 156.8017,
+			158.6899, 159.6400, 161.4724, 163.3362, 164.2523, 166.0625, 167.0384, 168.9365,
+			170.7976, 171.7612, 173.5998, 174.5163, 176.4444, 178.3807, 179.3499, 181.2522,
+			182.1909, 184.0384, 185.9280, 186.9241, 188.8312, 189.7649, 191.6971, 193.6376,
+			194.6512, 196.5518, 197.4965, 199.3758, 201.3323, 202.3057, 204.2620, 205.2606,
+			207.2566, 209.2443, 210.2188, 212.1227, 213.1884, 215.1120, 217.0729, 218.0369,
+			220.0087, 221.0360, 222.9583, 225.0293, 226.0859, 228.0173, 229.0014, 230.9091,
+			232.9517, 233.9551, 235.9010, 236.8875, 238.7533, 240.7777, 241.7987, 243.7130,
+			244.7174, 246.6779, 248.6016, 249.5857, 251.6590, 252.6545, 254.5092, 256.5333,
+			257.5438, 259.5021, 260.4948, 262.5145, 264.4881, 265.5093, 267.4128, 268.3985,
+			270.3787, 272.3860, 273.4185, 275.3654, 276.3437, 278.4094, 280.4325, 281.4456,
+			283.4323, 284.4467, 286.4372, 288.4983, 289.5236, 291.5795, 292.6178, 294.6462,
+			296.5123, 297.5586, 299.5317, 300.5018, 302.4787, 304.4594, 305.4158, 307.4132,
+			308.4586, 310.4777, 312.4957, 313.4784, 315.4598, 316.4478, 318.4254, 320.4777,
+		},
+		// precision 7
+		{
+			92.9997, 94.4550, 95.9364, 97.4239, 99.4364, 100.9697, 102.5164, 104.0789,
+			105.6655, 107.7987, 109.4204, 111.0620, 112.7177, 114.3818, 116.6412, 118.3388,
+			120.0736, 121.8202, 123.5693, 125.9192, 127.6991, 129.5253, 131.3383, 133.1835,
+			135.6674, 137.5460, 139.4087, 141.3280, 143.2739, 145.8757, 147.8301, 149.8052,
+			151.8051, 153.8116, 156.5495, 158.6021, 160.6777, 162.7400, 164.8398, 167.6291,
+			169.7342, 171.8913, 174.0471, 176.2187, 179.1074, 181.3187, 183.5290, 185.7530,
+			188.0139, 191.0543, 193.3399, 195.6761, 197.9622, 200.2792, 203.3431, 205.6954,
+			208.0363, 210.3500, 212.7180, 215.9131, 218.2650, 220.6527, 223.1261, 225.6039,
+			228.8643, 231.3289, 233.8001, 236.2994, 238.7825, 242.1151, 244.6379, 247.2181,
+			249.7792, 252.3232, 255.7235, 258.3030, 260.9756, 263.5197, 266.0947, 269.5796,
+			272.2549, 274.8654, 277.5286, 280.1873, 283.6874, 286.3596, 289.0435, 291.7157,
+			294.4647, 298.0509, 300.7313, 303.4784, 306.2804, 308.9403, 312.6401, 315.3659,
+			318.1548, 320.8892, 323.7566, 327.4381, 330.2198, 333.0631, 335.8849, 338.6982,
+			342.4108, 345.1895, 347.9851, 350.7985, 353.4933, 357.3499, 360.1553, 362.9621,
+			365.7161, 368.5629, 372.4686, 375.3421, 378.2930, 381.1222, 384.0003, 387.8335,
+			390.8904, 393.7773, 396.6260, 399.4673, 403.3487, 406.2019, 409.1126, 411.9498,
+			414.8304, 418.6922, 421.5290, 424.4170, 427.3804, 430.3769, 434.2936, 437.1885,
+			440.1302, 443.0139, 445.9967, 449.8775, 452.8035, 455.8215, 458.7746, 461.6521,
+			465.5659, 468.4380, 471.3617, 474.3538, 477.2694, 481.2316, 484.1844, 487.1621,
+			490.0632, 493.1244, 497.1451, 500.1265, 502.9753, 505.8621, 508.6926, 512.6342,
+			515.5116, 518.3869, 521.2993, 524.2589, 528.2611, 531.1734, 534.2697, 537.1717,
+			540.1467, 544.2586, 547.2860, 550.2454, 553.3120, 556.2862, 560.3071, 563.4879,
+			566.6068, 569.6310, 572.6574, 576.6381, 579.6378, 582.6578, 585.6838, 588.7091,
+			592.6666, 595.6376, 598.6811, 601.5496, 604.5087, 608.5049, 611.4058, 614.3996,
+			617.4313, 620.4196, 624.4570, 627.4032, 630.4296, 633.4297, 636.4271, 640.3557,
+		},
+		// precision 8
+		{
+			186.7720, 189.6868, 193.1346, 196.1278, 199.6545, 202.7316, 205.8324, 209.4883,
+			212.6571, 216.3989, 219.6412, 222.9079, 226.7710, 230.1090, 234.0577, 237.4511,
+			240.9030, 244.9771, 248.4827, 252.6000, 256.2044, 259.8148, 264.0832, 267.7638,
+			272.0789, 275.8428, 279.6403, 284.0560, 287.9217, 292.4315, 296.3697, 300.2755,
+			304.9217, 308.9180, 313.6445, 317.7521, 321.8555, 326.6387, 330.7959, 335.6963,
+			339.8976, 344.1691, 349.2211, 353.5808, 358.6888, 363.0432, 367.4730, 372.6383,
+			377.1611, 382.4889, 387.0419, 391.6249, 397.0479, 401.6746, 407.0640, 411.6822,
+			416.4316, 421.9281, 426.7030, 432.2907, 437.1320, 441.9391, 447.6288, 452.5086,
+			458.2260, 463.0951, 467.9916, 473.8150, 478.8630, 484.6647, 489.6848, 494.7384,
+			500.6122, 505.6475, 511.6864, 516.8772, 522.0610, 528.0820, 533.2434, 539.4159,
+			544.6879, 549.9738, 556.0823, 561.4906, 567.6526, 573.0001, 578.3672, 584.5694,
+			589.9680, 596.2169, 601.5701, 607.0341, 613.3288, 618.9133, 625.2949, 630.6755,
+			636.1720, 642.5803, 648.1624, 654.5431, 660.1218, 665.5904, 672.2206, 677.8143,
+			684.4092, 690.0866, 695.6772, 702.1368, 707.8460, 714.5361, 720.2593, 725.9355,
+			732.4671, 738.1686, 744.7971, 750.4979, 756.1411, 762.9004, 768.7349, 775.5392,
+			781.3837, 787.1910, 794.0031, 799.7414, 806.5314, 812.3293, 818.1644, 824.9067,
+			830.7203, 837.7043, 843.4662, 849.3296, 856.1705, 861.9681, 868.7842, 874.6761,
+			880.3706, 887.2418, 893.2712, 900.0481, 905.8257, 911.6684, 918.6791, 924.5932,
+			931.4336, 937.3087, 943.1456, 950.0018, 956.0693, 963.0774, 968.9994, 974.8060,
+			981.6790, 987.7256, 994.5247, 1000.4291, 1006.3594, 1013.1112, 1019.1291, 1026.1914,
+			1032.1498, 1037.9432, 1044.8186, 1050.6543, 1057.5526, 1063.4191, 1069.5274, 1076.3246,
+			1082.2655, 1089.0320, 1095.0972, 1101.1145, 1108.0420, 1114.0389, 1121.1190, 1126.9589,
+			1132.9204, 1139.7156, 1145.9009, 1152.9251, 1159.0687, 1164.9648, 1171.8571, 1177.7899,
+			1184.7780, 1190.7521, 1196.6295, 1203.4022, 1209.3470, 1216.4163, 1222.6121, 1228.7087,
+			1235.8827, 1241.9811, 1248.8755, 1255.0191, 1260.8801, 1267.7441, 1273.6841, 1280.8287,
+		},
+		// precision 9
+		{
+			374.3289, 380.6787, 387.1077, 393.6137, 400.1916, 406.3263, 413.0755, 419.8926,
+			426.7731, 433.7409, 440.2351, 447.3538, 454.5410, 461.8178, 469.1259, 475.9383,
+			483.4416, 491.0312, 498.7019, 506.4278, 513.5777, 521.4212, 529.3772, 537.4141,
+			545.4617, 552.9572, 561.1832, 569.4557, 577.8074, 586.1518, 594.0726, 602.6320,
+			611.3234, 620.0220, 628.8121, 636.9616, 645.8755, 654.8403, 663.8729, 672.9705,
+			681.4865, 690.7140, 700.0387, 709.4509, 718.8841, 727.6730, 737.2530, 746.9216,
+			756.5839, 766.2600, 775.3463, 785.3049, 795.3158, 805.3686, 815.4680, 824.8691,
+			835.0244, 845.2817, 855.6036, 865.9998, 875.5364, 885.9313, 896.4788, 907.0493,
+			917.5928, 927.4898, 938.2391, 949.0713, 959.8575, 970.7256, 980.7239, 991.7151,
+			1002.7704, 1013.6591, 1024.6217, 1034.9459, 1046.1902, 1057.3507, 1068.7118, 1079.9654,
+			1090.4120, 1101.7230, 1113.1443, 1124.3562, 1136.0747, 1146.6749, 1158.2040, 1169.9246,
+			1181.4985, 1193.2651, 1204.1429, 1215.8809, 1227.6454, 1239.4452, 1251.2245, 1262.1284,
+			1274.1092, 1286.0997, 1298.1328, 1310.0057, 1321.2735, 1333.3366, 1345.3155, 1357.3108,
+			1369.4007, 1380.5404, 1392.6659, 1404.8018, 1416.7855, 1429.1669, 1440.3932, 1452.7120,
+			1465.0608, 1477.3633, 1489.7519, 1501.0605, 1513.5313, 1525.8393, 1538.2404, 1550.7388,
+			1562.1955, 1574.9141, 1587.3869, 1599.8622, 1612.3886, 1624.0268, 1636.4777, 1649.1217,
+			1661.7617, 1674.3669, 1685.8907, 1698.4818, 1710.9831, 1723.6662, 1736.4993, 1748.2302,
+			1760.7514, 1773.3809, 1786.0964, 1798.6580, 1810.3029, 1823.2112, 1836.2519, 1848.8142,
+			1861.8348, 1873.4473, 1886.1556, 1898.8377, 1911.3848, 1924.2372, 1936.0197, 1948.8835,
+			1961.7303, 1974.4961, 1987.3399, 1999.1305, 2012.1655, 2025.0938, 2038.0551, 2050.9826,
+			2062.7561, 2075.7092, 2088.6260, 2101.3400, 2114.3443, 2126.1331, 2138.8082, 2151.5774,
+			2164.5134, 2177.4918, 2189.1739, 2202.0023, 2215.0015, 2228.1550, 2241.0154, 2252.9752,
+			2265.7256, 2278.5300, 2291.6426, 2304.5132, 2316.6570, 2329.4410, 2342.6231, 2355.0819,
+			2368.0834, 2380.1647, 2392.9695, 2406.0894, 2418.9103, 2432.1932, 2444.2845, 2457.4458,
+			2470.6274, 2483.3969, 2496.3195, 2508.1986, 2521.0151, 2533.9396, 2546.9834, 2559.8722,
+		},
+		// precision 10
+		{
+			749.9142, 762.6202, 774.9858, 788.0077, 801.1729, 813.9911, 827.4243, 840.5173,
+			854.2572, 868.1604, 881.6892, 895.9176, 909.7224, 924.2376, 938.9411, 953.1639,
+			968.1353, 982.6481, 997.8824, 1013.2289, 1028.2057, 1043.8772, 1059.1260, 1075.1957,
+			1091.3890, 1107.0662, 1123.4038, 1139.4001, 1156.0903, 1172.9035, 1189.2549, 1206.3615,
+			1222.9960, 1240.4108, 1258.0244, 1275.0384, 1292.8609, 1310.2239, 1328.3542, 1346.6486,
+			1364.3449, 1382.9794, 1400.9684, 1419.7269, 1438.6875, 1456.9477, 1475.9718, 1494.6855,
+			1514.0643, 1533.5037, 1552.3767, 1572.1333, 1591.3494, 1611.3768, 1631.6150, 1651.1493,
+			1671.4679, 1691.2859, 1711.8003, 1732.3400, 1752.4762, 1773.4044, 1793.6923, 1814.8244,
+			1836.0759, 1856.5262, 1878.0064, 1898.7888, 1920.4860, 1942.0770, 1962.9323, 1984.7366,
+			2005.7214, 2027.8879, 2050.1223, 2071.5146, 2093.9882, 2115.6286, 2138.1400, 2160.7642,
+			2182.5379, 2205.3826, 2227.5976, 2250.6975, 2273.7599, 2295.7404, 2318.9214, 2341.3000,
+			2364.5178, 2387.8241, 2410.5192, 2434.0039, 2456.6078, 2480.2699, 2503.9802, 2526.9321,
+			2550.6605, 2573.6425, 2597.5353, 2621.6776, 2644.6207, 2668.5613, 2691.8516, 2716.0128,
+			2740.4166, 2763.8176, 2788.3714, 2811.8351, 2836.4187, 2860.9738, 2884.7413, 2909.5554,
+			2933.2909, 2957.7565, 2982.6209, 3006.4153, 3031.2864, 3055.2737, 3080.1597, 3104.9855,
+			3128.8723, 3153.9872, 3178.1209, 3203.1959, 3228.3065, 3252.4925, 3277.7543, 3301.7223,
+			3326.6495, 3351.8532, 3376.0467, 3401.3694, 3425.2843, 3450.6361, 3476.1670, 3500.6155,
+			3526.0126, 3550.7061, 3575.8687, 3601.5092, 3625.9561, 3651.3522, 3675.8486, 3701.4056,
+			3726.9470, 3751.2077, 3776.8777, 3801.3186, 3826.9340, 3852.3640, 3876.8613, 3902.1223,
+			3927.1359, 3953.1065, 3978.4772, 4003.0359, 4028.4536, 4053.1827, 4078.7474, 4104.7958,
+			4129.6345, 4155.3219, 4180.1651, 4205.6421, 4231.3878, 4256.1911, 4282.0774, 4306.8617,
+			4332.7305, 4358.5918, 4383.5331, 4409.1220, 4433.9832, 4459.8202, 4485.3533, 4510.2698,
+			4535.8595, 4560.9774, 4586.5674, 4612.3658, 4637.4839, 4663.2947, 4688.0186, 4713.7958,
+			4739.3856, 4764.0509, 4789.5483, 4814.3199, 4840.5394, 4866.4307, 4891.3513, 4917.1298,
+			4942.1951, 4968.0358, 4994.1813, 5019.2409, 5044.8953, 5070.0225, 5095.8803, 5122.1588,
+		},
+		// precision 11
+		{
+			1501.1187, 1526.0836, 1551.3055, 1576.8692, 1603.2132, 1629.3487, 1655.7721, 1682.4825,
+			1709.4832, 1737.3900, 1764.9958, 1792.8846, 1821.0671, 1849.5584, 1878.8599, 1907.9210,
+			1937.2747, 1966.8943, 1996.8886, 2027.6570, 2058.1508, 2089.0257, 2120.0969, 2151.4951,
+			2183.7647, 2215.7182, 2247.9636, 2280.5532, 2313.4259, 2347.1817, 2380.6435, 2414.3082,
+			2448.2768, 2482.5613, 2517.6495, 2552.4294, 2587.5449, 2622.7607, 2658.2028, 2694.6519,
+			2730.8185, 2767.0177, 2803.5115, 2840.3797, 2878.3865, 2915.8693, 2953.3906, 2991.2029,
+			3029.3026, 3068.2569, 3106.7508, 3145.4772, 3184.3087, 3223.4520, 3263.6106, 3303.2204,
+			3343.1845, 3383.4229, 3423.7059, 3465.0537, 3505.8937, 3546.9963, 3588.1902, 3629.8330,
+			3672.1977, 3714.1450, 3756.1517, 3798.3411, 3840.8415, 3884.3184, 3927.0360, 3970.1219,
+			4013.3055, 4056.7014, 4101.2113, 4145.0026, 4189.0334, 4233.3052, 4277.5837, 4322.9125,
+			4367.3372, 4412.0439, 4457.0794, 4501.7664, 4547.8468, 4593.0247, 4638.5421, 4684.1012,
+			4730.0879, 4776.9385, 4822.6782, 4868.7055, 4915.0621, 4961.3316, 5008.6600, 5055.4257,
+			5102.2433, 5148.9357, 5195.7006, 5243.7118, 5290.8303, 5338.3031, 5385.4301, 5432.5333,
+			5480.6862, 5528.4319, 5576.2663, 5624.2042, 5671.9272, 5720.9021, 5768.8525, 5817.3172,
+			5865.4274, 5913.7699, 5963.3653, 6011.9503, 6060.0778, 6108.4404, 6156.9526, 6206.4999,
+			6255.4641, 6304.4726, 6353.2026, 6402.2638, 6452.4433, 6501.7053, 6551.0875, 6600.4522,
+			6649.7709, 6700.0193, 6749.0543, 6798.7385, 6848.3997, 6897.9239, 6949.0559, 6998.2815,
+			7047.6794, 7097.3333, 7147.1314, 7198.2611, 7248.5141, 7298.4829, 7348.7807, 7398.8637,
+			7449.8815, 7500.5481, 7550.2481, 7600.5257, 7650.5206, 7701.9186, 7752.1225, 7802.4107,
+			7852.8351, 7903.5634, 7954.5150, 8005.0571, 8055.4627, 8105.2058, 8155.5411, 8207.5531,
+			8257.8305, 8308.3723, 8358.8332, 8409.7543, 8461.3819, 8511.3889, 8561.4181, 8612.1449,
+			8662.6220, 8714.3264, 8764.4603, 8814.7401, 8865.4836, 8916.4364, 8968.2340, 9018.7547,
+			9070.0337, 9120.6552, 9171.6516, 9223.3549, 9274.3254, 9325.0844, 9375.3848, 9426.3152,
+			9478.3151, 9528.8134, 9579.1995, 9629.7701, 9680.9193, 9733.3854, 9784.3508, 9835.3656,
+			9886.3517, 9936.6977, 9988.2659, 10039.4192, 10090.4401, 10141.1653, 10191.4720, 10244.3530,
+		},
+		// precision 12
+		{
+			3003.0054, 3052.9380, 3103.9552, 3155.0211, 3207.2454, 3259.5726, 3312.4085, 3366.3780,
+			3420.3348, 3475.5186, 3530.6942, 3586.4507, 3643.3272, 3700.2932, 3758.3201, 3816.3973,
+			3875.1657, 3935.1080, 3995.1615, 4056.2589, 4117.3723, 4178.9489, 4241.9424, 4304.7583,
+			4368.8775, 4432.7457, 4497.2588, 4563.0435, 4628.6965, 4695.4517, 4762.1567, 4829.4464,
+			4897.7928, 4966.2231, 5035.9854, 5105.4799, 5175.6032, 5246.7482, 5317.6793, 5389.8760,
+			5462.0708, 5534.6673, 5608.2932, 5681.9627, 5756.8724, 5831.5397, 5906.9150, 5983.2679,
+			6059.3975, 6136.9725, 6214.1970, 6291.6264, 6370.4297, 6448.8566, 6528.6727, 6607.7881,
+			6687.6569, 6768.9239, 6849.7227, 6931.6972, 7013.1232, 7094.9585, 7178.4364, 7261.4472,
+			7345.4657, 7429.4327, 7513.5905, 7598.9539, 7683.5960, 7769.7937, 7855.6466, 7941.6867,
+			8028.8415, 8115.4322, 8203.2518, 8290.6246, 8378.2639, 8466.9070, 8555.2473, 8645.1411,
+			8734.1277, 8823.2699, 8913.9517, 9003.8784, 9095.1244, 9185.5156, 9276.3979, 9367.8173,
+			9458.7970, 9551.4104, 9643.1859, 9735.3393, 9829.0230, 9921.5413, 10015.3597, 10108.5194,
+			10201.7918, 10296.5635, 10390.1444, 10485.2498, 10579.3045, 10673.8693, 10769.8794, 10864.1170,
+			10959.8345, 11055.6868, 11151.2913, 11247.9973, 11344.0972, 11441.5468, 11537.5390, 11633.4733,
+			11731.3106, 11828.4017, 11926.0588, 12022.9591, 12120.2064, 12219.0578, 12316.5346, 12415.2831,
+			12512.8412, 12610.7735, 12709.7283, 12807.7903, 12907.2637, 13005.2186, 13103.6111, 13203.3619,
+			13302.2084, 13401.8232, 13500.7906, 13599.4578, 13699.8072, 13798.8048, 13899.5033, 13998.0400,
+			14097.4297, 14197.9921, 14296.8597, 14397.9853, 14497.3683, 14597.4096, 14697.7913, 14797.3434,
+			14898.3618, 14998.3781, 15098.4860, 15200.1036, 15300.0426, 15401.0076, 15501.0050, 15601.2739,
+			15703.2937, 15804.4512, 15905.2640, 16006.0063, 16106.5419, 16207.9959, 16308.5106, 16409.8385,
+			16510.6659, 16611.2955, 16713.0891, 16814.2653, 16916.3076, 17017.9398, 17118.8485, 17221.3598,
+			17321.8463, 17425.1445, 17526.1614, 17627.7429, 17730.5853, 17831.5843, 17934.4888, 18035.6420,
+			18137.1785, 18239.8732, 18341.1355, 18443.4573, 18544.4999, 18646.0294, 18748.8977, 18851.3530,
+			18953.9666, 19055.7412, 19157.3062, 19260.6112, 19361.5780, 19464.4277, 19566.3664, 19667.4109,
+			19770.0368, 19871.3177, 19974.1061, 20075.8890, 20177.4840, 20280.7003, 20382.4570, 20484.9293,
+		},
+		// precision 13
+		{
+			6006.7687, 6107.1391, 6208.6342, 6311.2623, 6415.0513, 6519.6004, 6625.8290, 6733.2373,
+			6841.8259, 6951.6590, 7062.0344, 7174.2452, 7287.7127, 7402.0915, 7517.8885, 7634.0992,
+			7752.1218, 7871.3760, 7991.8667, 8113.4895, 8235.7048, 8359.6354, 8484.8199, 8610.9281,
+			8738.3121, 8866.1900, 8995.7343, 9126.5749, 9258.6131, 9391.6073, 9524.9741, 9660.0008,
+			9796.2259, 9933.7825, 10072.5047, 10211.2511, 10352.0249, 10493.4249, 10636.0602, 10779.6160,
+			10923.9165, 11069.8884, 11216.8486, 11364.8283, 11513.9120, 11663.6234, 11814.8582, 11966.9266,
+			12119.7955, 12274.0309, 12427.9025, 12583.6157, 12740.0451, 12897.8887, 13056.5845, 13215.3313,
+			13375.4701, 13537.1385, 13699.7289, 13862.6524, 14025.9849, 14191.2338, 14356.4828, 14522.8009,
+			14690.5266, 14857.4410, 15026.4830, 15196.1486, 15366.6103, 15538.2839, 15710.1115, 15883.2918,
+			16056.8220, 16230.8879, 16405.9803, 16580.0431, 16756.7960, 16934.1354, 17112.2012, 17290.3756,
+			17468.0820, 17647.7188, 17827.9182, 18008.3200, 18189.6374, 18370.3870, 18553.1760, 18736.0489,
+			18919.8978, 19104.3055, 19288.1880, 19473.0848, 19658.9257, 19845.4672, 20032.3326, 20218.4623,
+			20405.8226, 20593.1658, 20781.7220, 20970.5229, 21159.3052, 21349.2183, 21539.3642, 21730.8969,
+			21921.4154, 22112.2416, 22304.3901, 22496.7310, 22689.8021, 22882.1497, 23074.7069, 23268.1174,
+			23461.6977, 23655.5667, 23849.6054, 24043.4098, 24239.1319, 24435.3150, 24631.7259, 24827.6696,
+			25023.2267, 25220.3837, 25417.4147, 25612.9416, 25810.6046, 26006.5269, 26204.5492, 26403.2515,
+			26602.1081, 26800.7234, 26997.3996, 27196.6048, 27396.1148, 27595.6933, 27794.3975, 27992.7827,
+			28192.6397, 28393.8962, 28593.7307, 28793.4099, 28993.2546, 29193.5276, 29395.9824, 29597.5446,
+			29798.0895, 29998.7512, 30199.6269, 30401.3502, 30603.4472, 30804.6416, 31005.9204, 31207.3210,
+			31410.6227, 31612.6444, 31815.1100, 32016.1146, 32217.7919, 32420.2260, 32621.5543, 32823.5729,
+			33025.4349, 33228.5488, 33431.8604, 33634.4077, 33837.8956, 34040.2434, 34243.3674, 34446.0634,
+			34649.0491, 34852.5986, 35055.1237, 35258.8575, 35461.4546, 35664.3854, 35868.1469, 36071.5512,
+			36274.7061, 36478.1480, 36681.8770, 36886.2166, 37088.8054, 37293.3073, 37496.6506, 37700.8412,
+			37904.5147, 38108.4155, 38312.5825, 38515.9047, 38720.1813, 38924.9294, 39128.0621, 39331.5923,
+			39536.8856, 39741.1764, 39945.4676, 40148.7505, 40354.1936, 40559.8815, 40764.7174, 40970.2472,
+		},
+		// precision 14
+		{
+			12014.8033, 12215.5232, 12418.0251, 12623.3676, 12831.1799, 13040.7577, 13253.2236, 13467.6398,
+			13684.7681, 13904.3244, 14125.8571, 14350.0276, 14576.2835, 14805.2808, 15036.9448, 15270.4743,
+			15506.6296, 15744.5877, 15985.5168, 16228.5566, 16473.7141, 16721.1453, 16970.9510, 17223.3482,
+			17478.8061, 17735.0507, 17994.1356, 18255.1539, 18518.6386, 18783.9414, 19051.4875, 19322.0141,
+			19594.0401, 19868.9392, 20145.8750, 20424.5415, 20705.7919, 20988.8328, 21274.4667, 21561.9626,
+			21850.6835, 22142.0817, 22434.8644, 22731.1349, 23029.3000, 23328.5686, 23630.8958, 23934.1908,
+			24240.3169, 24548.0691, 24857.3340, 25170.2555, 25482.9426, 25798.6425, 26116.6048, 26435.3468,
+			26756.8660, 27078.7373, 27403.3215, 27729.2725, 28057.0559, 28386.9775, 28717.1827, 29050.3909,
+			29386.3050, 29721.4665, 30059.6219, 30399.3563, 30740.0185, 31083.8737, 31428.6674, 31775.2542,
+			32121.4511, 32470.3277, 32820.0410, 33171.4059, 33524.1251, 33876.1771, 34231.7586, 34588.3720,
+			34946.3982, 35306.1282, 35665.1778, 36026.8016, 36389.4544, 36752.9488, 37118.1027, 37482.5283,
+			37849.4850, 38217.7281, 38586.7054, 38956.8829, 39328.0712, 39700.9622, 40075.0264, 40449.6562,
+			40825.5705, 41200.5978, 41577.4128, 41955.1315, 42333.5551, 42713.6967, 43095.1216, 43476.3141,
+			43857.9901, 44239.4865, 44624.5939, 45007.9047, 45392.3829, 45777.7039, 46164.3200, 46550.3418,
+			46935.7033, 47324.8011, 47714.9509, 48105.6454, 48496.4994, 48887.8384, 49278.9958, 49670.0094,
+			50060.9589, 50452.8620, 50843.6408, 51236.2920, 51628.7164, 52023.6622, 52418.3883, 52811.8225,
+			53207.7076, 53604.4944, 54002.6641, 54398.8234, 54795.9276, 55194.6711, 55595.3445, 55992.0471,
+			56390.4007, 56792.0973, 57191.8718, 57594.6526, 57993.1997, 58396.3641, 58797.0533, 59197.4024,
+			59598.6366, 59998.4766, 60399.5357, 60800.7322, 61204.0780, 61605.5754, 62007.3242, 62411.5678,
+			62814.9059, 63218.9905, 63622.7396, 64027.6501, 64431.5001, 64835.0195, 65239.8969, 65646.7036,
+			66050.3740, 66458.3700, 66864.0602, 67267.8448, 67675.0948, 68079.1963, 68486.7433, 68891.2342,
+			69298.1329, 69708.7258, 70114.1579, 70520.6204, 70927.7806, 71332.7384, 71741.5071, 72150.1033,
+			72555.8049, 72964.9194, 73373.7136, 73781.0428, 74187.1201, 74593.5847, 74999.0810, 75408.0487,
+			75814.1279, 76221.9013, 76627.4934, 77035.0554, 77444.4999, 77855.8435, 78262.3903, 78669.7839,
+			79075.7335, 79486.1989, 79895.3947, 80303.0180, 80711.3494, 81119.6569, 81527.2921, 81935.5526,
+		},
+		// precision 15
+		{
+			24031.0034, 24431.8179, 24837.6149, 25248.2071, 25663.7857, 26083.4821, 26507.6151, 26936.9110,
+			27371.0581, 27810.6237, 28254.0555, 28702.3110, 29155.3336, 29613.0948, 30076.5515, 30543.7911,
+			31016.0094, 31492.3630, 31972.8420, 32458.7194, 32948.6097, 33443.3906, 33943.7691, 34447.8930,
+			34957.0858, 35469.4479, 35987.0035, 36509.8260, 37036.4891, 37568.6337, 38106.1820, 38646.3720,
+			39191.2947, 39740.0043, 40295.7913, 40853.2409, 41415.9264, 41982.9258, 42554.3594, 43130.9601,
+			43709.9999, 44292.7291, 44880.8201, 45473.4706, 46068.3730, 46668.3716, 47274.3499, 47882.2941,
+			48494.4071, 49111.3507, 49731.0514, 50354.4648, 50981.1493, 51610.4910, 52243.9299, 52883.5902,
+			53524.3692, 54168.6964, 54817.3013, 55471.1872, 56125.1281, 56784.1935, 57446.9053, 58112.8036,
+			58781.6132, 59453.7863, 60127.8576, 60804.5774, 61485.6391, 62170.5216, 62857.3501, 63544.8861,
+			64236.6782, 64933.2385, 65631.6176, 66330.6509, 67034.1570, 67743.6604, 68453.3098, 69165.9191,
+			69880.3174, 70599.4642, 71317.8994, 72038.4838, 72767.2319, 73495.2881, 74222.4713, 74953.1104,
+			75686.4444, 76422.4969, 77164.5633, 77904.1256, 78645.7189, 79390.8711, 80138.3842, 80885.9851,
+			81635.6495, 82387.2900, 83139.9466, 83897.3982, 84655.6324, 85414.2973, 86173.4741, 86932.8419,
+			87695.5372, 88456.2285, 89221.2137, 89986.8890, 90754.8917, 91528.2674, 92300.1062, 93073.4103,
+			93847.4428, 94623.5566, 95401.3430, 96181.5432, 96958.9744, 97739.6874, 98519.0238, 99303.6719,
+			100087.1746, 100874.1781, 101658.3653, 102450.4129, 103240.9365, 104028.3582, 104821.0715, 105612.8956,
+			106403.1430, 107191.6725, 107982.8085, 108775.8594, 109571.6247, 110368.7777, 111170.1083, 111963.6734,
+			112754.7609, 113557.5729, 114359.2126, 115160.2320, 115953.2538, 116759.2767, 117563.6009, 118363.4510,
+			119168.5104, 119979.2686, 120782.0253, 121592.8246, 122399.2274, 123203.8404, 124004.4280, 124809.3256,
+			125619.8193, 126424.2402, 127232.0439, 128039.8699, 128848.3597, 129661.2910, 130470.1214, 131279.3483,
+			132093.1540, 132901.0338, 133706.6317, 134516.4072, 135330.9376, 136140.7846, 136948.9066, 137761.7481,
+			138573.6469, 139388.3938, 140201.0166, 141015.7633, 141834.3996, 142649.1499, 143470.9057, 144287.1296,
+			145104.3935, 145923.0152, 146735.6306, 147554.1912, 148369.6271, 149183.0932, 149996.3207, 150812.4329,
+			151629.9688, 152447.2446, 153267.7307, 154084.0182, 154901.3778, 155718.1129, 156534.9453, 157348.9841,
+			158167.9990, 158983.3015, 159802.3143, 160620.8927, 161440.3259, 162257.8226, 163077.8393, 163899.0363,
+		},
+		// precision 16
+		{
+			48062.4654, 48864.1849, 49676.2276, 50496.9848, 51328.1862, 52167.8527, 53016.4329, 53874.8242,
+			54743.0517, 55621.1039, 56507.8895, 57403.1798, 58308.5621, 59223.5799, 60148.2036, 61080.7384,
+			62023.0062, 62976.1142, 63937.8347, 64910.7500, 65890.9102, 66880.9331, 67881.2254, 68890.8137,
+			69910.3405, 70937.2404, 71973.9376, 73019.2679, 74072.7148, 75136.2255, 76207.2725, 77287.9994,
+			78380.7677, 79476.7180, 80583.7412, 81699.0301, 82822.6122, 83955.7501, 85097.1676, 86250.0229,
+			87410.2604, 88576.6707, 89754.9505, 90935.6492, 92127.6345, 93326.1399, 94534.1968, 95750.2642,
+			96974.1324, 98203.2730, 99440.4091, 100688.0844, 101941.4713, 103202.4926, 104470.7760, 105747.0323,
+			107029.0287, 108318.5652, 109616.5352, 110923.5809, 112232.5009, 113552.7963, 114878.6072, 116210.6947,
+			117548.1986, 118892.3739, 120242.5524, 121599.2780, 122960.6744, 124332.5161, 125704.7414, 127082.0571,
+			128471.1066, 129861.3959, 131255.7027, 132657.7872, 134071.8797, 135490.2329, 136917.6364, 138341.5849,
+			139773.4873, 141213.2088, 142649.2622, 144087.8317, 145536.6608, 146982.5301, 148436.4537, 149903.2898,
+			151367.7271, 152837.7931, 154311.4383, 155790.9211, 157279.7033, 158773.0575, 160269.4292, 161754.4293,
+			163255.6504, 164766.9678, 166271.8892, 167786.7275, 169298.8703, 170819.0319, 172342.8258, 173864.2882,
+			175392.8278, 176922.2205, 178457.4081, 179996.8377, 181539.7086, 183080.9752, 184625.5839, 186174.9901,
+			187731.4985, 189279.7984, 190841.0646, 192399.1312, 193956.5045, 195514.1866, 197076.8615, 198643.6098,
+			200214.1849, 201795.2708, 203371.7169, 204948.1552, 206528.5829, 208106.0989, 209684.9294, 211273.0052,
+			212859.5025, 214443.4699, 216031.2226, 217617.8837, 219212.6776, 220804.9700, 222399.0080, 223990.7394,
+			225587.6278, 227193.9538, 228795.1556, 230392.8811, 231989.2461, 233596.4061, 235202.6508, 236821.7838,
+			238432.9036, 240046.2557, 241654.3709, 243265.1410, 244873.5291, 246485.3614, 248103.4901, 249714.7699,
+			251319.7677, 252934.4097, 254547.7439, 256162.8394, 257778.0633, 259398.8319, 261027.0258, 262643.2926,
+			264268.5609, 265891.7574, 267510.5031, 269135.5926, 270752.2719, 272369.3243, 274001.6674, 275621.2358,
+			277245.2621, 278867.7066, 280492.9542, 282115.2646, 283729.8911, 285354.4070, 286981.9620, 288608.5189,
+			290234.3940, 291865.2709, 293494.5843, 295132.1514, 296754.3875, 298378.3698, 300010.6756, 301634.3586,
+			303268.0422, 304898.1593, 306523.4835, 308154.8511, 309790.3245, 311435.0142, 313071.4879, 314705.7646,
+			316351.9744, 317982.5853, 319611.8818, 321253.3593, 322887.6946, 324521.9725, 326155.7729, 327788.9736,
+		},
+		// precision 17
+		{
+			96125.8692, 97730.0424, 99353.4511, 100996.0342, 102655.6495, 104334.8803, 106032.5458, 107750.0287,
+			109486.2430, 111243.5418, 113016.5758, 114809.6519, 116622.9505, 118452.8616, 120303.4036, 122171.4255,
+			124057.6925, 125964.8459, 127890.0886, 129833.8428, 131797.2048, 133775.6144, 135775.2832, 137789.9019,
+			139828.5241, 141883.7451, 143954.8348, 146045.3720, 148153.1782, 150279.4877, 152428.1175, 154590.0356,
+			156769.2068, 158966.0038, 161177.6862, 163408.9012, 165661.0527, 167929.3600, 170212.3139, 172514.7748,
+			174830.5274, 177161.6101, 179511.8992, 181870.0976, 184255.6844, 186654.3183, 189068.2611, 191499.1705,
+			193939.2626, 196401.6531, 198874.5676, 201370.4425, 203890.2308, 206412.0570, 208945.5975, 211498.2551,
+			214064.4298, 216639.2023, 219232.3104, 221840.1235, 224462.4079, 227096.7461, 229743.9833, 232409.5099,
+			235089.0928, 237779.4502, 240476.6985, 243195.8294, 245930.2294, 248668.7522, 251417.1570, 254176.8218,
+			256957.0068, 259753.0525, 262547.8082, 265357.6067, 268176.9934, 271001.3044, 273839.0262, 276693.4874,
+			279551.9590, 282419.5860, 285306.7360, 288202.9659, 291098.5472, 294007.5662, 296923.1161, 299849.8494,
+			302779.4320, 305721.7751, 308680.4145, 311640.4845, 314614.0563, 317586.9774, 320566.2198, 323563.1803,
+			326557.7142, 329562.6907, 332567.7083, 335597.2145, 338630.0631, 341657.9407, 344683.5902, 347718.2669,
+			350779.5299, 353837.4466, 356911.5400, 359985.3778, 363075.6066, 366158.8313, 369236.1843, 372326.8740,
+			375412.6286, 378503.6524, 381618.5427, 384735.6638, 387844.1685, 390978.8207, 394095.8284, 397244.2328,
+			400387.4456, 403521.4491, 406669.9272, 409813.9300, 412974.2687, 416127.9465, 419293.4766, 422461.0607,
+			425638.8864, 428811.1484, 431973.0591, 435167.7740, 438351.6602, 441538.7801, 444717.9878, 447927.8007,
+			451123.8943, 454315.3115, 457520.5033, 460735.6349, 463931.1441, 467123.8996, 470326.1931, 473519.8689,
+			476735.0590, 479940.5681, 483158.9732, 486374.3138, 489599.4948, 492845.7672, 496057.7046, 499286.9181,
+			502511.3169, 505737.3763, 508983.2761, 512217.0672, 515463.8989, 518701.3920, 521945.6990, 525178.6047,
+			528419.9803, 531674.9975, 534930.9700, 538195.1756, 541438.5156, 544693.8394, 547924.2452, 551182.4468,
+			554445.2985, 557708.0492, 560974.7424, 564240.2207, 567480.5966, 570716.0943, 573963.3007, 577212.6808,
+			580470.3967, 583747.4646, 587008.6709, 590274.7203, 593516.6126, 596782.7746, 600047.0974, 603304.0418,
+			606564.7676, 609840.3904, 613097.0380, 616362.0075, 619610.3720, 622877.8139, 626125.6154, 629381.4764,
+			632643.8008, 635902.5936, 639150.3767, 642444.4439, 645708.5814, 648988.1832, 652232.6063, 655487.6470,
+		},
+		// precision 18
+		{
+			192254.0191, 195462.5965, 198707.8687, 201991.0511, 205311.4327, 208668.3050, 212065.3975, 215499.0366,
+			218972.0408, 222483.5905, 226032.1834, 229616.4785, 233236.5331, 236896.0747, 240594.6234, 244329.1600,
+			248108.2199, 251918.9008, 255764.2168, 259652.5074, 263576.5981, 267536.6004, 271539.2736, 275576.0871,
+			279648.1734, 283764.8046, 287906.6941, 292087.5566, 296303.3897, 300553.3307, 304844.4968, 309177.2730,
+			313534.3055, 317932.7138, 322366.1209, 326832.0291, 331324.3274, 335857.9886, 340421.7945, 345032.7318,
+			349663.7723, 354338.6990, 359034.6870, 363765.9995, 368533.9310, 373335.7032, 378163.1177, 383019.4221,
+			387911.7741, 392826.8251, 397795.6248, 402791.3043, 407804.6961, 412865.7414, 417948.8203, 423060.6007,
+			428203.2611, 433359.2524, 438552.6091, 443761.4696, 449009.6107, 454286.7742, 459579.4845, 464908.3973,
+			470269.6177, 475649.1591, 481035.8076, 486460.7933, 491914.3817, 497385.4526, 502876.9137, 508386.0966,
+			513933.0140, 519529.0885, 525131.8574, 530738.7912, 536383.0205, 542035.1143, 547710.3460, 553410.5435,
+			559134.5867, 564870.6722, 570620.6003, 576392.4651, 582207.8160, 588027.4552, 593857.1871, 599727.2298,
+			605586.5867, 611474.6622, 617372.5563, 623278.6595, 629220.2045, 635171.5464, 641145.0058, 647130.0000,
+			653108.1019, 659131.0507, 665162.2118, 671224.0586, 677285.1060, 683350.8311, 689425.7030, 695521.8120,
+			701633.9963, 707738.0642, 713890.0603, 720047.6722, 726208.8290, 732369.4979, 738550.5408, 744756.7497,
+			750968.4337, 757187.6555, 763400.1882, 769641.8100, 775865.1702, 782111.2697, 788377.5248, 794654.4493,
+			800937.1101, 807229.7918, 813540.6861, 819835.6709, 826144.0569, 832463.2957, 838784.2810, 845124.0780,
+			851427.5091, 857769.8128, 864134.5708, 870510.2011, 876847.8076, 883215.9099, 889598.5261, 895990.2041,
+			902389.0500, 908768.7820, 915190.7869, 921581.0420, 928003.7156, 934417.7372, 940850.8950, 947276.3412,
+			953687.1003, 960121.2980, 966536.4939, 972982.0581, 979431.4119, 985864.5124, 992328.3939, 998779.6224,
+			1005222.1050, 1011669.1942, 1018111.8045, 1024556.8670, 1030993.7272, 1037469.6399, 1043945.0914, 1050441.4211,
+			1056902.4556, 1063388.4672, 1069857.9889, 1076368.5706, 1082835.1654, 1089337.9908, 1095835.3557, 1102337.6265,
+			1108851.3923, 1115383.8695, 1121893.2676, 1128390.4848, 1134905.2906, 1141418.2431, 1147948.8340, 1154475.8003,
+			1160949.7789, 1167472.9771, 1173995.2315, 1180508.2550, 1187045.0906, 1193535.0039, 1200040.7810, 1206561.5618,
+			1213082.5519, 1219591.6313, 1226124.4618, 1232693.6981, 1239174.5565, 1245714.0313, 1252235.6768, 1258781.2039,
+			1265317.4837, 1271885.4507, 1278410.1330, 1284954.6662, 1291499.7830, 1298032.5552, 1304552.4216, 1311099.0033,
+		},
+	}
+
+	// _Biases[p][i] is the mean raw estimate _RawEstimates[p][i] minus the cardinality.
+	_Biases = [MaxPrecision - MinPrecision + 1][]float64{
+		// precision 4
+		{
+			10.7680, 10.2379, 9.7242, 9.2202, 8.7307, 8.2614, 7.8107, 7.3795,
+			6.9502, 6.5506, 6.1589, 5.7910, 5.4274, 5.0856, 4.7638, 4.4438,
+			4.1387, 3.8701, 3.5818, 3.3362, 3.1022, 2.8813, 2.6666, 2.4827,
+			2.2654, 2.0611, 1.9298, 1.7636, 1.5709, 1.4503, 1.3231, 1.2166,
+			1.0671, 0.9687, 0.8230, 0.7407, 0.6332, 0.6205, 0.5129, 0.4443,
+			0.3571, 0.2787, 0.2525, 0.2027, 0.2198, 0.2199, 0.1380, 0.0898,
+			0.0543, -0.0102, -0.1185, -0.1476, -0.0763, -0.0810, -0.0852, -0.0340,
+			-0.0404, -0.0084, 0.0146, 0.0204, -0.0458, -0.0692, -0.0674, -0.0619,
+			-0.0347, -0.0490, -0.0599, -0.0589, 0.0030, 0.0544, 0.0739, 0.1072,
+			0.0912, 0.0680, 0.1452, 0.1517, 0.2034, 0.1980, 0.1714, 0.1414,
+			0.1758,
+		},
+		// precision 5
+		{
+			22.3040, 21.7836, 21.2647, 20.7565, 20.2565, 19.7618, 19.2765, 18.7958,
+			18.3163, 17.8504, 17.3870, 16.9340, 16.4922, 16.0499, 15.6144, 15.1842,
+			14.7669, 14.3700, 13.9624, 13.5638, 13.1735, 12.7889, 12.4228, 12.0635,
+			11.7134, 11.3798, 11.0434, 10.7233, 10.4094, 10.0961, 9.7890, 9.4923,
+			9.1900, 8.9066, 8.6418, 8.3785, 8.1039, 7.8433, 7.5943, 7.3552,
+			7.0988, 6.8434, 6.6013, 6.3682, 6.1560, 5.9456, 5.7399, 5.5304,
+			5.3290, 5.1217, 4.9731, 4.8283, 4.6573, 4.4822, 4.3439, 4.1991,
+			4.0584, 3.8968, 3.7500, 3.6336, 3.4872, 3.3402, 3.2261, 3.1167,
+			3.0266, 2.9563, 2.8497, 2.7567, 2.6331, 2.5394, 2.4789, 2.3637,
+			2.3011, 2.2628, 2.1900, 2.1205, 2.0073, 1.9549, 1.8634, 1.7978,
+			1.7875, 1.7323, 1.6306, 1.6659, 1.6494, 1.5870, 1.4923, 1.4247,
+			1.3906, 1.3075, 1.2295, 1.2092, 1.1606, 1.1497, 1.1512, 1.1402,
+			1.1142, 1.1158, 1.0695, 1.0955, 1.0182, 0.9855, 0.9093, 0.8544,
+			0.8853, 0.8861, 0.8320, 0.8333, 0.8457, 0.8192, 0.8174, 0.7603,
+			0.7891, 0.8061, 0.8004, 0.7691, 0.7352, 0.6664, 0.6691, 0.6292,
+			0.6342, 0.6177, 0.5806, 0.4926, 0.5221, 0.5589, 0.5502, 0.6054,
+			0.6038, 0.5776, 0.5570, 0.5295, 0.5211, 0.4504, 0.4785, 0.4598,
+			0.5002, 0.4660, 0.4551, 0.4569, 0.3785, 0.4403, 0.5019, 0.4805,
+			0.6065, 0.5684, 0.6127, 0.6783, 0.6527, 0.6681, 0.6372, 0.5710,
+			0.5259, 0.4835, 0.4690, 0.4953, 0.5465, 0.5220, 0.3918, 0.4082,
+			0.4528,
+		},
+		// precision 6
+		{
+			44.8517, 43.8173, 43.3045, 42.2901, 41.2871, 40.7947, 39.8224, 39.3430,
+			38.4004, 37.4677, 36.9990, 36.0945, 35.6462, 34.7492, 33.8695, 33.4399,
+			32.5810, 32.1636, 31.3359, 30.5015, 30.0897, 29.2984, 28.9010, 28.1347,
+			27.3755, 26.9916, 26.2759, 25.9140, 25.2141, 24.5018, 24.1491, 23.4641,
+			23.1246, 22.4599, 21.7750, 21.4527, 20.8247, 20.5093, 19.8879, 19.3060,
+			19.0105, 18.4377, 18.1502, 17.6119, 17.0773, 16.8326, 16.3076, 16.0395,
+			15.5277, 15.0410, 14.7717, 14.2790, 14.0498, 13.6125, 13.1845, 12.9682,
+			12.5639, 12.3597, 11.9522, 11.5329, 11.3332, 10.9106, 10.7365, 10.3727,
+			9.9858, 9.8008, 9.4385, 9.2922, 8.9211, 8.6294, 8.4981, 8.1682,
+			8.0503, 7.7560, 7.4525, 7.2765, 7.0258, 6.9197, 6.6440, 6.3619,
+			6.2442, 6.0038, 5.8875, 5.6190, 5.3737, 5.2590, 4.9999, 4.8782,
+			4.6279, 4.4835, 4.3742, 4.2386, 4.1295, 4.0132, 3.8469, 3.8017,
+			3.6899, 3.6400, 3.4724, 3.3362, 3.2523, 3.0625, 3.0384, 2.9365,
+			2.7976, 2.7612, 2.5998, 2.5163, 2.4444, 2.3807, 2.3499, 2.2522,
+			2.1909, 2.0384, 1.9280, 1.9241, 1.8312, 1.7649, 1.6971, 1.6376,
+			1.6512, 1.5518, 1.4965, 1.3758, 1.3323, 1.3057, 1.2620, 1.2606,
+			1.2566, 1.2443, 1.2188, 1.1227, 1.1884, 1.1120, 1.0729, 1.0369,
+			1.0087, 1.0360, 0.9583, 1.0293, 1.0859, 1.0173, 1.0014, 0.9091,
+			0.9517, 0.9551, 0.9010, 0.8875, 0.7533, 0.7777, 0.7987, 0.7130,
+			0.7174, 0.6779, 0.6016, 0.5857, 0.6590, 0.6545, 0.5092, 0.5333,
+			0.5438, 0.5021, 0.4948, 0.5145, 0.4881, 0.5093, 0.4128, 0.3985,
+			0.3787, 0.3860, 0.4185, 0.3654, 0.3437, 0.4094, 0.4325, 0.4456,
+			0.4323, 0.4467, 0.4372, 0.4983, 0.5236, 0.5795, 0.6178, 0.6462,
+			0.5123, 0.5586, 0.5317, 0.5018, 0.4787, 0.4594, 0.4158, 0.4132,
+			0.4586, 0.4777, 0.4957, 0.4784, 0.4598, 0.4478, 0.4254, 0.4777,
+		},
+		// precision 7
+		{
+			89.9997, 88.4550, 86.9364, 85.4239, 83.4364, 81.9697, 80.5164, 79.0789,
+			77.6655, 75.7987, 74.4204, 73.0620, 71.7177, 70.3818, 68.6412, 67.3388,
+			66.0736, 64.8202, 63.5693, 61.9192, 60.6991, 59.5253, 58.3383, 57.1835,
+			55.6674, 54.5460, 53.4087, 52.3280, 51.2739, 49.8757, 48.8301, 47.8052,
+			46.8051, 45.8116, 44.5495, 43.6021, 42.6777, 41.7400, 40.8398, 39.6291,
+			38.7342, 37.8913, 37.0471, 36.2187, 35.1074, 34.3187, 33.5290, 32.7530,
+			32.0139, 31.0543, 30.3399, 29.6761, 28.9622, 28.2792, 27.3431, 26.6954,
+			26.0363, 25.3500, 24.7180, 23.9131, 23.2650, 22.6527, 22.1261, 21.6039,
+			20.8643, 20.3289, 19.8001, 19.2994, 18.7825, 18.1151, 17.6379, 17.2181,
+			16.7792, 16.3232, 15.7235, 15.3030, 14.9756, 14.5197, 14.0947, 13.5796,
+			13.2549, 12.8654, 12.5286, 12.1873, 11.6874, 11.3596, 11.0435, 10.7157,
+			10.4647, 10.0509, 9.7313, 9.4784, 9.2804, 8.9403, 8.6401, 8.3659,
+			8.1548, 7.8892, 7.7566, 7.4381, 7.2198, 7.0631, 6.8849, 6.6982,
+			6.4108, 6.1895, 5.9851, 5.7985, 5.4933, 5.3499, 5.1553, 4.9621,
+			4.7161, 4.5629, 4.4686, 4.3421, 4.2930, 4.1222, 4.0003, 3.8335,
+			3.8904, 3.7773, 3.6260, 3.4673, 3.3487, 3.2019, 3.1126, 2.9498,
+			2.8304, 2.6922, 2.5290, 2.4170, 2.3804, 2.3769, 2.2936, 2.1885,
+			2.1302, 2.0139, 1.9967, 1.8775, 1.8035, 1.8215, 1.7746, 1.6521,
+			1.5659, 1.4380, 1.3617, 1.3538, 1.2694, 1.2316, 1.1844, 1.1621,
+			1.0632, 1.1244, 1.1451, 1.1265, 0.9753, 0.8621, 0.6926, 0.6342,
+			0.5116, 0.3869, 0.2993, 0.2589, 0.2611, 0.1734, 0.2697, 0.1717,
+			0.1467, 0.2586, 0.2860, 0.2454, 0.3120, 0.2862, 0.3071, 0.4879,
+			0.6068, 0.6310, 0.6574, 0.6381, 0.6378, 0.6578, 0.6838, 0.7091,
+			0.6666, 0.6376, 0.6811, 0.5496, 0.5087, 0.5049, 0.4058, 0.3996,
+			0.4313, 0.4196, 0.4570, 0.4032, 0.4296, 0.4297, 0.4271, 0.3557,
+		},
+		// precision 8
+		{
+			180.7720, 177.6868, 174.1346, 171.1278, 167.6545, 164.7316, 161.8324, 158.4883,
+			155.6571, 152.3989, 149.6412, 146.9079, 143.7710, 141.1090, 138.0577, 135.4511,
+			132.9030, 129.9771, 127.4827, 124.6000, 122.2044, 119.8148, 117.0832, 114.7638,
+			112.0789, 109.8428, 107.6403, 105.0560, 102.9217, 100.4315, 98.3697, 96.2755,
+			93.9217, 91.9180, 89.6445, 87.7521, 85.8555, 83.6387, 81.7959, 79.6963,
+			77.8976, 76.1691, 74.2211, 72.5808, 70.6888, 69.0432, 67.4730, 65.6383,
+			64.1611, 62.4889, 61.0419, 59.6249, 58.0479, 56.6746, 55.0640, 53.6822,
+			52.4316, 50.9281, 49.7030, 48.2907, 47.1320, 45.9391, 44.6288, 43.5086,
+			42.2260, 41.0951, 39.9916, 38.8150, 37.8630, 36.6647, 35.6848, 34.7384,
+			33.6122, 32.6475, 31.6864, 30.8772, 30.0610, 29.0820, 28.2434, 27.4159,
+			26.6879, 25.9738, 25.0823, 24.4906, 23.6526, 23.0001, 22.3672, 21.5694,
+			20.9680, 20.2169, 19.5701, 19.0341, 18.3288, 17.9133, 17.2949, 16.6755,
+			16.1720, 15.5803, 15.1624, 14.5431, 14.1218, 13.5904, 13.2206, 12.8143,
+			12.4092, 12.0866, 11.6772, 11.1368, 10.8460, 10.5361, 10.2593, 9.9355,
+			9.4671, 9.1686, 8.7971, 8.4979, 8.1411, 7.9004, 7.7349, 7.5392,
+			7.3837, 7.1910, 7.0031, 6.7414, 6.5314, 6.3293, 6.1644, 5.9067,
+			5.7203, 5.7043, 5.4662, 5.3296, 5.1705, 4.9681, 4.7842, 4.6761,
+			4.3706, 4.2418, 4.2712, 4.0481, 3.8257, 3.6684, 3.6791, 3.5932,
+			3.4336, 3.3087, 3.1456, 3.0018, 3.0693, 3.0774, 2.9994, 2.8060,
+			2.6790, 2.7256, 2.5247, 2.4291, 2.3594, 2.1112, 2.1291, 2.1914,
+			2.1498, 1.9432, 1.8186, 1.6543, 1.5526, 1.4191, 1.5274, 1.3246,
+			1.2655, 1.0320, 1.0972, 1.1145, 1.0420, 1.0389, 1.1190, 0.9589,
+			0.9204, 0.7156, 0.9009, 0.9251, 1.0687, 0.9648, 0.8571, 0.7899,
+			0.7780, 0.7521, 0.6295, 0.4022, 0.3470, 0.4163, 0.6121, 0.7087,
+			0.8827, 0.9811, 0.8755, 1.0191, 0.8801, 0.7441, 0.6841, 0.8287,
+		},
+		// precision 9
+		{
+			362.3289, 355.6787, 349.1077, 342.6137, 336.1916, 330.3263, 324.0755, 317.8926,
+			311.7731, 305.7409, 300.2351, 294.3538, 288.5410, 282.8178, 277.1259, 271.9383,
+			266.4416, 261.0312, 255.7019, 250.4278, 245.5777, 240.4212, 235.3772, 230.4141,
+			225.4617, 220.9572, 216.1832, 211.4557, 206.8074, 202.1518, 198.0726, 193.6320,
+			189.3234, 185.0220, 180.8121, 176.9616, 172.8755, 168.8403, 164.8729, 160.9705,
+			157.4865, 153.7140, 150.0387, 146.4509, 142.8841, 139.6730, 136.2530, 132.9216,
+			129.5839, 126.2600, 123.3463, 120.3049, 117.3158, 114.3686, 111.4680, 108.8691,
+			106.0244, 103.2817, 100.6036, 97.9998, 95.5364, 92.9313, 90.4788, 88.0493,
+			85.5928, 83.4898, 81.2391, 79.0713, 76.8575, 74.7256, 72.7239, 70.7151,
+			68.7704, 66.6591, 64.6217, 62.9459, 61.1902, 59.3507, 57.7118, 55.9654,
+			54.4120, 52.7230, 51.1443, 49.3562, 48.0747, 46.6749, 45.2040, 43.9246,
+			42.4985, 41.2651, 40.1429, 38.8809, 37.6454, 36.4452, 35.2245, 34.1284,
+			33.1092, 32.0997, 31.1328, 30.0057, 29.2735, 28.3366, 27.3155, 26.3108,
+			25.4007, 24.5404, 23.6659, 22.8018, 21.7855, 21.1669, 20.3932, 19.7120,
+			19.0608, 18.3633, 17.7519, 17.0605, 16.5313, 15.8393, 15.2404, 14.7388,
+			14.1955, 13.9141, 13.3869, 12.8622, 12.3886, 12.0268, 11.4777, 11.1217,
+			10.7617, 10.3669, 9.8907, 9.4818, 8.9831, 8.6662, 8.4993, 8.2302,
+			7.7514, 7.3809, 7.0964, 6.6580, 6.3029, 6.2112, 6.2519, 5.8142,
+			5.8348, 5.4473, 5.1556, 4.8377, 4.3848, 4.2372, 4.0197, 3.8835,
+			3.7303, 3.4961, 3.3399, 3.1305, 3.1655, 3.0938, 3.0551, 2.9826,
+			2.7561, 2.7092, 2.6260, 2.3400, 2.3443, 2.1331, 1.8082, 1.5774,
+			1.5134, 1.4918, 1.1739, 1.0023, 1.0015, 1.1550, 1.0154, 0.9752,
+			0.7256, 0.5300, 0.6426, 0.5132, 0.6570, 0.4410, 0.6231, 0.0819,
+			0.0834, 0.1647, -0.0305, 0.0894, -0.0897, 0.1932, 0.2845, 0.4458,
+			0.6274, 0.3969, 0.3195, 0.1986, 0.0151, -0.0604, -0.0166, -0.1278,
+		},
+		// precision 10
+		{
+			724.9142, 711.6202, 698.9858, 686.0077, 673.1729, 660.9911, 648.4243, 636.5173,
+			624.2572, 612.1604, 600.6892, 588.9176, 577.7224, 566.2376, 554.9411, 544.1639,
+			533.1353, 522.6481, 511.8824, 501.2289, 491.2057, 480.8772, 471.1260, 461.1957,
+			451.3890, 442.0662, 432.4038, 423.4001, 414.0903, 404.9035, 396.2549, 387.3615,
+			378.9960, 370.4108, 362.0244, 354.0384, 345.8609, 338.2239, 330.3542, 322.6486,
+			315.3449, 307.9794, 300.9684, 293.7269, 286.6875, 279.9477, 272.9718, 266.6855,
+			260.0643, 253.5037, 247.3767, 241.1333, 235.3494, 229.3768, 223.6150, 218.1493,
+			212.4679, 207.2859, 201.8003, 196.3400, 191.4762, 186.4044, 181.6923, 176.8244,
+			172.0759, 167.5262, 163.0064, 158.7888, 154.4860, 150.0770, 145.9323, 141.7366,
+			137.7214, 133.8879, 130.1223, 126.5146, 122.9882, 119.6286, 116.1400, 112.7642,
+			109.5379, 106.3826, 103.5976, 100.6975, 97.7599, 94.7404, 91.9214, 89.3000,
+			86.5178, 83.8241, 81.5192, 79.0039, 76.6078, 74.2699, 71.9802, 69.9321,
+			67.6605, 65.6425, 63.5353, 61.6776, 59.6207, 57.5613, 55.8516, 54.0128,
+			52.4166, 50.8176, 49.3714, 47.8351, 46.4187, 44.9738, 43.7413, 42.5554,
+			41.2909, 39.7565, 38.6209, 37.4153, 36.2864, 35.2737, 34.1597, 32.9855,
+			31.8723, 30.9872, 30.1209, 29.1959, 28.3065, 27.4925, 26.7543, 25.7223,
+			24.6495, 23.8532, 23.0467, 22.3694, 21.2843, 20.6361, 20.1670, 19.6155,
+			19.0126, 18.7061, 17.8687, 17.5092, 16.9561, 16.3522, 15.8486, 15.4056,
+			14.9470, 14.2077, 13.8777, 13.3186, 12.9340, 12.3640, 11.8613, 11.1223,
+			11.1359, 11.1065, 10.4772, 10.0359, 9.4536, 9.1827, 8.7474, 8.7958,
+			8.6345, 8.3219, 8.1651, 7.6421, 7.3878, 7.1911, 7.0774, 6.8617,
+			6.7305, 6.5918, 6.5331, 6.1220, 5.9832, 5.8202, 5.3533, 5.2698,
+			4.8595, 4.9774, 4.5674, 4.3658, 4.4839, 4.2947, 4.0186, 3.7958,
+			3.3856, 3.0509, 2.5483, 2.3199, 2.5394, 2.4307, 2.3513, 2.1298,
+			2.1951, 2.0358, 2.1813, 2.2409, 1.8953, 2.0225, 1.8803, 2.1588,
+		},
+		// precision 11
+		{
+			1450.1187, 1424.0836, 1398.3055, 1372.8692, 1347.2132, 1322.3487, 1297.7721, 1273.4825,
+			1249.4832, 1225.3900, 1201.9958, 1178.8846, 1156.0671, 1133.5584, 1110.8599, 1088.9210,
+			1067.2747, 1045.8943, 1024.8886, 1003.6570, 983.1508, 963.0257, 943.0969, 923.4951,
+			903.7647, 884.7182, 865.9636, 847.5532, 829.4259, 811.1817, 793.6435, 776.3082,
+			759.2768, 742.5613, 725.6495, 709.4294, 693.5449, 677.7607, 662.2028, 646.6519,
+			631.8185, 617.0177, 602.5115, 588.3797, 574.3865, 560.8693, 547.3906, 534.2029,
+			521.3026, 508.2569, 495.7508, 483.4772, 471.3087, 459.4520, 447.6106, 436.2204,
+			425.1845, 414.4229, 403.7059, 393.0537, 382.8937, 372.9963, 363.1902, 353.8330,
+			344.1977, 335.1450, 326.1517, 317.3411, 308.8415, 300.3184, 292.0360, 284.1219,
+			276.3055, 268.7014, 261.2113, 254.0026, 247.0334, 240.3052, 233.5837, 226.9125,
+			220.3372, 214.0439, 208.0794, 201.7664, 195.8468, 190.0247, 184.5421, 179.1012,
+			174.0879, 168.9385, 163.6782, 158.7055, 154.0621, 149.3316, 144.6600, 140.4257,
+			136.2433, 131.9357, 127.7006, 123.7118, 119.8303, 116.3031, 112.4301, 108.5333,
+			104.6862, 101.4319, 98.2663, 95.2042, 91.9272, 88.9021, 85.8525, 83.3172,
+			80.4274, 77.7699, 75.3653, 72.9503, 70.0778, 67.4404, 64.9526, 62.4999,
+			60.4641, 58.4726, 56.2026, 54.2638, 52.4433, 50.7053, 49.0875, 47.4522,
+			45.7709, 44.0193, 42.0543, 40.7385, 39.3997, 37.9239, 37.0559, 35.2815,
+			33.6794, 32.3333, 31.1314, 30.2611, 29.5141, 28.4829, 27.7807, 26.8637,
+			25.8815, 25.5481, 24.2481, 23.5257, 22.5206, 21.9186, 21.1225, 20.4107,
+			19.8351, 19.5634, 18.5150, 18.0571, 17.4627, 16.2058, 15.5411, 15.5531,
+			14.8305, 14.3723, 13.8332, 13.7543, 13.3819, 12.3889, 11.4181, 11.1449,
+			10.6220, 10.3264, 9.4603, 8.7401, 8.4836, 8.4364, 8.2340, 7.7547,
+			8.0337, 7.6552, 7.6516, 7.3549, 7.3254, 7.0844, 6.3848, 6.3152,
+			6.3151, 5.8134, 5.1995, 4.7701, 4.9193, 5.3854, 5.3508, 5.3656,
+			5.3517, 4.6977, 4.2659, 4.4192, 4.4401, 4.1653, 3.4720, 4.3530,
+		},
+		// precision 12
+		{
+			2901.0054, 2848.9380, 2796.9552, 2746.0211, 2695.2454, 2645.5726, 2596.4085, 2547.3780,
+			2499.3348, 2451.5186, 2404.6942, 2358.4507, 2312.3272, 2267.2932, 2222.3201, 2178.3973,
+			2135.1657, 2092.1080, 2050.1615, 2008.2589, 1967.3723, 1926.9489, 1886.9424, 1847.7583,
+			1808.8775, 1770.7457, 1733.2588, 1696.0435, 1659.6965, 1623.4517, 1588.1567, 1553.4464,
+			1518.7928, 1485.2231, 1451.9854, 1419.4799, 1387.6032, 1355.7482, 1324.6793, 1293.8760,
+			1264.0708, 1234.6673, 1205.2932, 1176.9627, 1148.8724, 1121.5397, 1094.9150, 1068.2679,
+			1042.3975, 1016.9725, 992.1970, 967.6264, 943.4297, 919.8566, 896.6727, 873.7881,
+			851.6569, 829.9239, 808.7227, 787.6972, 767.1232, 746.9585, 727.4364, 708.4472,
+			689.4657, 671.4327, 653.5905, 635.9539, 618.5960, 601.7937, 585.6466, 569.6867,
+			553.8415, 538.4322, 523.2518, 508.6246, 494.2639, 479.9070, 466.2473, 453.1411,
+			440.1277, 427.2699, 414.9517, 402.8784, 391.1244, 379.5156, 368.3979, 356.8173,
+			345.7970, 335.4104, 325.1859, 315.3393, 306.0230, 296.5413, 287.3597, 278.5194,
+			269.7918, 261.5635, 253.1444, 245.2498, 237.3045, 229.8693, 222.8794, 215.1170,
+			207.8345, 201.6868, 195.2913, 188.9973, 183.0972, 177.5468, 171.5390, 165.4733,
+			160.3106, 155.4017, 150.0588, 144.9591, 140.2064, 136.0578, 131.5346, 127.2831,
+			122.8412, 118.7735, 114.7283, 110.7903, 107.2637, 103.2186, 99.6111, 96.3619,
+			93.2084, 89.8232, 86.7906, 83.4578, 80.8072, 77.8048, 75.5033, 72.0400,
+			69.4297, 66.9921, 63.8597, 61.9853, 59.3683, 57.4096, 54.7913, 52.3434,
+			50.3618, 48.3781, 46.4860, 45.1036, 43.0426, 41.0076, 39.0050, 37.2739,
+			36.2937, 35.4512, 33.2640, 32.0063, 30.5419, 28.9959, 27.5106, 25.8385,
+			24.6659, 23.2955, 22.0891, 21.2653, 20.3076, 19.9398, 18.8485, 18.3598,
+			16.8463, 17.1445, 16.1614, 15.7429, 15.5853, 14.5843, 14.4888, 13.6420,
+			13.1785, 12.8732, 12.1355, 11.4573, 10.4999, 10.0294, 9.8977, 10.3530,
+			9.9666, 9.7412, 9.3062, 9.6112, 8.5780, 8.4277, 8.3664, 7.4109,
+			7.0368, 6.3177, 6.1061, 5.8890, 5.4840, 5.7003, 5.4570, 4.9293,
+		},
+		// precision 13
+		{
+			5802.7687, 5698.1391, 5594.6342, 5492.2623, 5391.0513, 5291.6004, 5192.8290, 5095.2373,
+			4998.8259, 4903.6590, 4810.0344, 4717.2452, 4625.7127, 4535.0915, 4445.8885, 4358.0992,
+			4271.1218, 4185.3760, 4100.8667, 4017.4895, 3935.7048, 3854.6354, 3774.8199, 3695.9281,
+			3618.3121, 3542.1900, 3466.7343, 3392.5749, 3319.6131, 3247.6073, 3176.9741, 3107.0008,
+			3038.2259, 2970.7825, 2904.5047, 2839.2511, 2775.0249, 2711.4249, 2649.0602, 2587.6160,
+			2527.9165, 2468.8884, 2410.8486, 2353.8283, 2297.9120, 2243.6234, 2189.8582, 2136.9266,
+			2084.7955, 2034.0309, 1983.9025, 1934.6157, 1886.0451, 1838.8887, 1792.5845, 1747.3313,
+			1702.4701, 1659.1385, 1616.7289, 1574.6524, 1533.9849, 1494.2338, 1454.4828, 1415.8009,
+			1378.5266, 1341.4410, 1305.4830, 1270.1486, 1235.6103, 1202.2839, 1170.1115, 1138.2918,
+			1106.8220, 1075.8879, 1045.9803, 1016.0431, 987.7960, 960.1354, 933.2012, 906.3756,
+			880.0820, 854.7188, 829.9182, 805.3200, 781.6374, 758.3870, 736.1760, 714.0489,
+			692.8978, 672.3055, 652.1880, 632.0848, 612.9257, 594.4672, 576.3326, 558.4623,
+			540.8226, 523.1658, 506.7220, 490.5229, 475.3052, 460.2183, 445.3642, 431.8969,
+			417.4154, 404.2416, 391.3901, 378.7310, 366.8021, 354.1497, 342.7069, 331.1174,
+			319.6977, 308.5667, 297.6054, 287.4098, 278.1319, 269.3150, 260.7259, 251.6696,
+			243.2267, 235.3837, 227.4147, 217.9416, 210.6046, 202.5269, 195.5492, 189.2515,
+			183.1081, 176.7234, 169.3996, 163.6048, 158.1148, 152.6933, 146.3975, 140.7827,
+			135.6397, 131.8962, 126.7307, 121.4099, 117.2546, 112.5276, 109.9824, 106.5446,
+			102.0895, 98.7512, 94.6269, 91.3502, 88.4472, 84.6416, 81.9204, 78.3210,
+			76.6227, 73.6444, 71.1100, 68.1146, 64.7919, 62.2260, 58.5543, 55.5729,
+			53.4349, 51.5488, 49.8604, 47.4077, 45.8956, 44.2434, 42.3674, 40.0634,
+			38.0491, 36.5986, 35.1237, 33.8575, 31.4546, 29.3854, 28.1469, 27.5512,
+			25.7061, 24.1480, 22.8770, 22.2166, 20.8054, 20.3073, 18.6506, 17.8412,
+			16.5147, 16.4155, 15.5825, 13.9047, 13.1813, 12.9294, 12.0621, 10.5923,
+			10.8856, 10.1764, 9.4676, 8.7505, 9.1936, 9.8815, 9.7174, 10.2472,
+		},
+		// precision 14
+		{
+			11605.8033, 11396.5232, 11190.0251, 10985.3676, 10783.1799, 10583.7577, 10386.2236, 10191.6398,
+			9998.7681, 9808.3244, 9620.8571, 9435.0276, 9252.2835, 9071.2808, 8892.9448, 8717.4743,
+			8543.6296, 8372.5877, 8203.5168, 8036.5566, 7872.7141, 7710.1453, 7550.9510, 7393.3482,
+			7238.8061, 7086.0507, 6935.1356, 6787.1539, 6640.6386, 6495.9414, 6354.4875, 6215.0141,
+			6078.0401, 5942.9392, 5809.8750, 5679.5415, 5550.7919, 5424.8328, 5300.4667, 5177.9626,
+			5057.6835, 4939.0817, 4822.8644, 4709.1349, 4597.3000, 4487.5686, 4379.8958, 4274.1908,
+			4170.3169, 4068.0691, 3968.3340, 3871.2555, 3774.9426, 3680.6425, 3588.6048, 3498.3468,
+			3409.8660, 3322.7373, 3237.3215, 3153.2725, 3072.0559, 2991.9775, 2913.1827, 2836.3909,
+			2762.3050, 2688.4665, 2616.6219, 2547.3563, 2478.0185, 2411.8737, 2347.6674, 2284.2542,
+			2221.4511, 2160.3277, 2100.0410, 2042.4059, 1985.1251, 1928.1771, 1873.7586, 1820.3720,
+			1769.3982, 1719.1282, 1669.1778, 1620.8016, 1573.4544, 1527.9488, 1483.1027, 1438.5283,
+			1395.4850, 1353.7281, 1313.7054, 1273.8829, 1236.0712, 1198.9622, 1163.0264, 1128.6562,
+			1094.5705, 1060.5978, 1027.4128, 995.1315, 964.5551, 934.6967, 907.1216, 878.3141,
+			849.9901, 822.4865, 797.5939, 771.9047, 746.3829, 721.7039, 699.3200, 675.3418,
+			651.7033, 630.8011, 610.9509, 592.6454, 573.4994, 555.8384, 536.9958, 518.0094,
+			499.9589, 481.8620, 463.6408, 446.2920, 428.7164, 414.6622, 399.3883, 383.8225,
+			369.7076, 356.4944, 345.6641, 331.8234, 319.9276, 308.6711, 299.3445, 287.0471,
+			275.4007, 268.0973, 257.8718, 250.6526, 240.1997, 233.3641, 225.0533, 215.4024,
+			206.6366, 197.4766, 188.5357, 180.7322, 174.0780, 165.5754, 158.3242, 152.5678,
+			146.9059, 140.9905, 134.7396, 130.6501, 124.5001, 119.0195, 113.8969, 110.7036,
+			105.3740, 103.3700, 100.0602, 93.8448, 91.0948, 86.1963, 83.7433, 79.2342,
+			76.1329, 76.7258, 73.1579, 69.6204, 67.7806, 62.7384, 61.5071, 61.1033,
+			56.8049, 56.9194, 55.7136, 53.0428, 50.1201, 46.5847, 43.0810, 42.0487,
+			38.1279, 36.9013, 32.4934, 31.0554, 30.4999, 31.8435, 29.3903, 26.7839,
+			23.7335, 24.1989, 23.3947, 22.0180, 20.3494, 19.6569, 17.2921, 15.5526,
+		},
+		// precision 15
+		{
+			23212.0034, 22793.8179, 22380.6149, 21972.2071, 21567.7857, 21168.4821, 20773.6151, 20383.9110,
+			19999.0581, 19618.6237, 19243.0555, 18872.3110, 18506.3336, 18145.0948, 17788.5515, 17436.7911,
+			17090.0094, 16747.3630, 16408.8420, 16074.7194, 15745.6097, 15421.3906, 15102.7691, 14787.8930,
+			14477.0858, 14170.4479, 13869.0035, 13572.8260, 13280.4891, 12992.6337, 12711.1820, 12432.3720,
+			12158.2947, 11888.0043, 11623.7913, 11362.2409, 11105.9264, 10853.9258, 10606.3594, 10362.9601,
+			10122.9999, 9886.7291, 9655.8201, 9429.4706, 9204.3730, 8985.3716, 8772.3499, 8561.2941,
+			8354.4071, 8151.3507, 7952.0514, 7756.4648, 7564.1493, 7374.4910, 7187.9299, 7008.5902,
+			6830.3692, 6655.6964, 6485.3013, 6319.1872, 6154.1281, 5994.1935, 5837.9053, 5684.8036,
+			5533.6132, 5386.7863, 5241.8576, 5099.5774, 4961.6391, 4826.5216, 4694.3501, 4562.8861,
+			4435.6782, 4313.2385, 4191.6176, 4071.6509, 3956.1570, 3846.6604, 3737.3098, 3629.9191,
+			3525.3174, 3425.4642, 3324.8994, 3226.4838, 3135.2319, 3044.2881, 2952.4713, 2864.1104,
+			2778.4444, 2694.4969, 2617.5633, 2538.1256, 2460.7189, 2386.8711, 2314.3842, 2242.9851,
+			2173.6495, 2106.2900, 2039.9466, 1977.3982, 1916.6324, 1856.2973, 1796.4741, 1736.8419,
+			1679.5372, 1621.2285, 1567.2137, 1513.8890, 1462.8917, 1416.2674, 1369.1062, 1323.4103,
+			1278.4428, 1235.5566, 1193.3430, 1154.5432, 1112.9744, 1074.6874, 1035.0238, 999.6719,
+			964.1746, 932.1781, 897.3653, 870.4129, 840.9365, 809.3582, 783.0715, 755.8956,
+			727.1430, 695.6725, 667.8085, 641.8594, 618.6247, 596.7777, 578.1083, 552.6734,
+			524.7609, 508.5729, 491.2126, 472.2320, 446.2538, 433.2767, 418.6009, 399.4510,
+			384.5104, 376.2686, 360.0253, 351.8246, 339.2274, 323.8404, 305.4280, 291.3256,
+			282.8193, 268.2402, 256.0439, 244.8699, 234.3597, 228.2910, 218.1214, 207.3483,
+			202.1540, 191.0338, 177.6317, 168.4072, 162.9376, 153.7846, 142.9066, 136.7481,
+			129.6469, 124.3938, 118.0166, 113.7633, 113.3996, 109.1499, 110.9057, 108.1296,
+			106.3935, 106.0152, 99.6306, 98.1912, 94.6271, 89.0932, 83.3207, 80.4329,
+			77.9688, 76.2446, 77.7307, 75.0182, 73.3778, 70.1129, 67.9453, 62.9841,
+			62.9990, 59.3015, 58.3143, 57.8927, 58.3259, 56.8226, 57.8393, 59.0363,
+		},
+		// precision 16
+		{
+			46424.4654, 45588.1849, 44761.2276, 43943.9848, 43136.1862, 42337.8527, 41548.4329, 40767.8242,
+			39998.0517, 39237.1039, 38485.8895, 37743.1798, 37009.5621, 36286.5799, 35572.2036, 34866.7384,
+			34171.0062, 33485.1142, 32808.8347, 32142.7500, 31484.9102, 30836.9331, 30198.2254, 29569.8137,
+			28950.3405, 28339.2404, 27737.9376, 27144.2679, 26559.7148, 25984.2255, 25417.2725, 24859.9994,
+			24313.7677, 23771.7180, 23239.7412, 22717.0301, 22202.6122, 21696.7501, 21200.1676, 20714.0229,
+			20236.2604, 19764.6707, 19303.9505, 18846.6492, 18399.6345, 17960.1399, 17530.1968, 17107.2642,
+			16693.1324, 16283.2730, 15882.4091, 15492.0844, 15106.4713, 14729.4926, 14358.7760, 13997.0323,
+			13641.0287, 13291.5652, 12951.5352, 12619.5809, 12290.5009, 11972.7963, 11659.6072, 11353.6947,
+			11052.1986, 10758.3739, 10470.5524, 10188.2780, 9911.6744, 9644.5161, 9378.7414, 9118.0571,
+			8868.1066, 8620.3959, 8375.7027, 8139.7872, 7915.8797, 7695.2329, 7484.6364, 7269.5849,
+			7063.4873, 6865.2088, 6662.2622, 6462.8317, 6272.6608, 6080.5301, 5896.4537, 5724.2898,
+			5550.7271, 5381.7931, 5217.4383, 5058.9211, 4908.7033, 4764.0575, 4621.4292, 4468.4293,
+			4331.6504, 4203.9678, 4070.8892, 3946.7275, 3820.8703, 3703.0319, 3587.8258, 3471.2882,
+			3360.8278, 3252.2205, 3149.4081, 3049.8377, 2954.7086, 2856.9752, 2763.5839, 2674.9901,
+			2592.4985, 2502.7984, 2425.0646, 2345.1312, 2264.5045, 2183.1866, 2107.8615, 2035.6098,
+			1968.1849, 1911.2708, 1848.7169, 1787.1552, 1728.5829, 1668.0989, 1608.9294, 1558.0052,
+			1506.5025, 1451.4699, 1401.2226, 1349.8837, 1305.6776, 1259.9700, 1215.0080, 1168.7394,
+			1127.6278, 1094.9538, 1058.1556, 1016.8811, 975.2461, 944.4061, 911.6508, 892.7838,
+			864.9036, 840.2557, 810.3709, 782.1410, 752.5291, 725.3614, 705.4901, 678.7699,
+			644.7677, 621.4097, 595.7439, 572.8394, 550.0633, 531.8319, 522.0258, 499.2926,
+			486.5609, 471.7574, 451.5031, 438.5926, 416.2719, 395.3243, 389.6674, 370.2358,
+			356.2621, 339.7066, 326.9542, 311.2646, 286.8911, 273.4070, 261.9620, 250.5189,
+			238.3940, 230.2709, 221.5843, 220.1514, 204.3875, 190.3698, 183.6756, 169.3586,
+			164.0422, 156.1593, 143.4835, 135.8511, 133.3245, 139.0142, 137.4879, 133.7646,
+			140.9744, 133.5853, 123.8818, 127.3593, 123.6946, 118.9725, 114.7729, 108.9736,
+		},
+		// precision 17
+		{
+			92849.8692, 91177.0424, 89523.4511, 87889.0342, 86271.6495, 84674.8803, 83095.5458, 81536.0287,
+			79995.2430, 78475.5418, 76972.5758, 75488.6519, 74024.9505, 72577.8616, 71151.4036, 69743.4255,
+			68352.6925, 66982.8459, 65631.0886, 64297.8428, 62985.2048, 61686.6144, 60409.2832, 59146.9019,
+			57908.5241, 56687.7451, 55481.8348, 54295.3720, 53126.1782, 51975.4877, 50848.1175, 49733.0356,
+			48635.2068, 47555.0038, 46489.6862, 45444.9012, 44420.0527, 43411.3600, 42417.3139, 41442.7748,
+			40482.5274, 39536.6101, 38609.8992, 37691.0976, 36799.6844, 35922.3183, 35059.2611, 34213.1705,
+			33376.2626, 32561.6531, 31758.5676, 30977.4425, 30220.2308, 29465.0570, 28721.5975, 27998.2551,
+			27287.4298, 26585.2023, 25901.3104, 25232.1235, 24578.4079, 23935.7461, 23305.9833, 22694.5099,
+			22097.0928, 21511.4502, 20931.6985, 20373.8294, 19831.2294, 19292.7522, 18765.1570, 18247.8218,
+			17751.0068, 17270.0525, 16787.8082, 16321.6067, 15863.9934, 15411.3044, 14972.0262, 14549.4874,
+			14131.9590, 13722.5860, 13332.7360, 12951.9659, 12570.5472, 12203.5662, 11842.1161, 11491.8494,
+			11144.4320, 10809.7751, 10492.4145, 10175.4845, 9872.0563, 9567.9774, 9270.2198, 8991.1803,
+			8708.7142, 8436.6907, 8164.7083, 7917.2145, 7674.0631, 7424.9407, 7173.5902, 6931.2669,
+			6715.5299, 6497.4466, 6294.5400, 6091.3778, 5904.6066, 5710.8313, 5512.1843, 5325.8740,
+			5134.6286, 4948.6524, 4786.5427, 4627.6638, 4459.1685, 4316.8207, 4156.8284, 4028.2328,
+			3895.4456, 3752.4491, 3623.9272, 3490.9300, 3374.2687, 3251.9465, 3140.4766, 3031.0607,
+			2931.8864, 2827.1484, 2713.0591, 2630.7740, 2537.6602, 2447.7801, 2349.9878, 2283.8007,
+			2202.8943, 2117.3115, 2045.5033, 1983.6349, 1903.1441, 1818.8996, 1744.1931, 1660.8689,
+			1599.0590, 1528.5681, 1469.9732, 1408.3138, 1356.4948, 1325.7672, 1261.7046, 1213.9181,
+			1161.3169, 1110.3763, 1079.2761, 1037.0672, 1006.8989, 967.3920, 934.6990, 890.6047,
+			855.9803, 833.9975, 812.9700, 800.1756, 766.5156, 745.8394, 699.2452, 680.4468,
+			666.2985, 652.0492, 642.7424, 631.2207, 594.5966, 553.0943, 523.3007, 496.6808,
+			477.3967, 477.4646, 461.6709, 450.7203, 416.6126, 405.7746, 393.0974, 373.0418,
+			356.7676, 356.3904, 336.0380, 324.0075, 295.3720, 285.8139, 257.6154, 236.4764,
+			221.8008, 203.5936, 174.3767, 192.4439, 179.5814, 182.1832, 149.6063, 127.6470,
+		},
+		// precision 18
+		{
+			185701.0191, 182355.5965, 179047.8687, 175777.0511, 172543.4327, 169347.3050, 166190.3975, 163071.0366,
+			159990.0408, 156947.5905, 153943.1834, 150973.4785, 148040.5331, 145146.0747, 142290.6234, 139472.1600,
+			136697.2199, 133954.9008, 131246.2168, 128580.5074, 125951.5981, 123357.6004, 120807.2736, 118290.0871,
+			115808.1734, 113371.8046, 110959.6941, 108587.5566, 106249.3897, 103945.3307, 101683.4968, 99462.2730,
+			97266.3055, 95110.7138, 92990.1209, 90903.0291, 88841.3274, 86821.9886, 84831.7945, 82888.7318,
+			80966.7723, 79087.6990, 77230.6870, 75407.9995, 73621.9310, 71870.7032, 70144.1177, 68447.4221,
+			66785.7741, 65146.8251, 63562.6248, 62004.3043, 60464.6961, 58971.7414, 57500.8203, 56059.6007,
+			54648.2611, 53251.2524, 51890.6091, 50545.4696, 49240.6107, 47963.7742, 46703.4845, 45478.3973,
+			44285.6177, 43112.1591, 41944.8076, 40816.7933, 39716.3817, 38633.4526, 37571.9137, 36527.0966,
+			35521.0140, 34563.0885, 33611.8574, 32665.7912, 31756.0205, 30855.1143, 29976.3460, 29122.5435,
+			28293.5867, 27475.6722, 26672.6003, 25890.4651, 25151.8160, 24418.4552, 23694.1871, 23011.2298,
+			22316.5867, 21650.6622, 20995.5563, 20347.6595, 19736.2045, 19133.5464, 18553.0058, 17985.0000,
+			17409.1019, 16879.0507, 16356.2118, 15864.0586, 15372.1060, 14883.8311, 14405.7030, 13947.8120,
+			13505.9963, 13057.0642, 12655.0603, 12259.6722, 11866.8290, 11473.4979, 11101.5408, 10753.7497,
+			10412.4337, 10077.6555, 9736.1882, 9424.8100, 9094.1702, 8787.2697, 8499.5248, 8222.4493,
+			7952.1101, 7690.7918, 7448.6861, 7189.6709, 6944.0569, 6710.2957, 6477.2810, 6264.0780,
+			6013.5091, 5801.8128, 5613.5708, 5435.2011, 5219.8076, 5033.9099, 4862.5261, 4701.2041,
+			4546.0500, 4372.7820, 4240.7869, 4077.0420, 3946.7156, 3806.7372, 3686.8950, 3558.3412,
+			3415.1003, 3296.2980, 3157.4939, 3050.0581, 2945.4119, 2824.5124, 2735.3939, 2632.6224,
+			2522.1050, 2415.1942, 2303.8045, 2195.8670, 2078.7272, 2001.6399, 1923.0914, 1865.4211,
+			1773.4556, 1705.4672, 1621.9889, 1578.5706, 1491.1654, 1440.9908, 1384.3557, 1333.6265,
+			1293.3923, 1271.8695, 1228.2676, 1171.4848, 1133.2906, 1092.2431, 1068.8340, 1042.8003,
+			962.7789, 932.9771, 901.2315, 860.2550, 844.0906, 780.0039, 732.7810, 699.5618,
+			666.5519, 622.6313, 601.4618, 617.6981, 544.5565, 530.0313, 498.6768, 490.2039,
+			473.4837, 487.4507, 458.1330, 449.6662, 440.7830, 420.5552, 386.4216, 379.0033,
+		},
+	}
+)
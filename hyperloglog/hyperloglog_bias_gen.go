@@ -0,0 +1,149 @@
+//go:build ignore
+// +build ignore
+
+// This program generates hyperloglog_bias.go, run it with
+//
+//	go run hyperloglog_bias_gen.go
+//
+// For every precision, it inserts random hashes into the registers of many HyperLogLogs
+// and records the mean raw estimate and its bias at _Samples cardinalities up to 5m.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"math"
+	"math/bits"
+	"sort"
+)
+
+const (
+	_MinPrecision = 4
+	_MaxPrecision = 18
+	// cardinalities sampled for every precision.
+	_Samples = 200
+	// hashes inserted for every precision, bounding the number of trials.
+	_Budget = 1 << 27
+)
+
+// splitmix64 is a fast, seeded and well distributed generator of hashes.
+type splitmix64 uint64
+
+func (s *splitmix64) next() uint64 {
+	*s += 0x9e3779b97f4a7c15
+	z := uint64(*s)
+	z = (z ^ z>>30) * 0xbf58476d1ce4e5b9
+	z = (z ^ z>>27) * 0x94d049bb133111eb
+	return z ^ z>>31
+}
+
+func alpha(m int) float64 {
+	switch m {
+	case 16:
+		return 0.673
+	case 32:
+		return 0.697
+	case 64:
+		return 0.709
+	}
+	return 0.7213 / (1 + 1.079/float64(m))
+}
+
+type sample struct {
+	n        int
+	estimate float64
+}
+
+func generate(p uint8) []sample {
+	m := 1 << p
+	// cardinalities 5m * i / _Samples, every one of them if 5m is smaller.
+	var ns []int
+	for i := 1; i <= _Samples; i++ {
+		n := 5 * m * i / _Samples
+		if len(ns) == 0 || n > ns[len(ns)-1] {
+			ns = append(ns, n)
+		}
+	}
+	trials := _Budget / (5 * m)
+	if trials < 200 {
+		trials = 200
+	}
+	if trials > 5000 {
+		trials = 5000
+	}
+
+	samples := make([]sample, len(ns))
+	for i, n := range ns {
+		samples[i].n = n
+	}
+	rng := splitmix64(p)
+	registers := make([]uint8, m)
+	for t := 0; t < trials; t++ {
+		for i := range registers {
+			registers[i] = 0
+		}
+		// sum of 2^-register, updated on every change of a register.
+		sum := float64(m)
+		inserted := 0
+		for i, n := range ns {
+			for ; inserted < n; inserted++ {
+				h := rng.next()
+				idx := h >> (64 - p)
+				rank := uint8(bits.LeadingZeros64(h<<p|1<<(p-1))) + 1
+				if rank > registers[idx] {
+					sum += math.Ldexp(1, -int(rank)) - math.Ldexp(1, -int(registers[idx]))
+					registers[idx] = rank
+				}
+			}
+			samples[i].estimate += alpha(m) * float64(m) * float64(m) / sum
+		}
+	}
+	for i := range samples {
+		samples[i].estimate /= float64(trials)
+	}
+	// estimateBias binary searches the estimates.
+	sort.SliceStable(samples, func(i, j int) bool { return samples[i].estimate < samples[j].estimate })
+	return samples
+}
+
+func main() {
+	var estimates, biases bytes.Buffer
+	for p := uint8(_MinPrecision); p <= _MaxPrecision; p++ {
+		samples := generate(p)
+		fmt.Fprintf(&estimates, "\t// precision %d\n\t{", p)
+		fmt.Fprintf(&biases, "\t// precision %d\n\t{", p)
+		for i, s := range samples {
+			if i%8 == 0 {
+				estimates.WriteString("\n\t\t")
+				biases.WriteString("\n\t\t")
+			}
+			fmt.Fprintf(&estimates, "%.4f, ", s.estimate)
+			fmt.Fprintf(&biases, "%.4f, ", s.estimate-float64(s.n))
+		}
+		estimates.WriteString("\n\t},\n")
+		biases.WriteString("\n\t},\n")
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by hyperloglog_bias_gen.go; DO NOT EDIT.\n\n")
+	buf.WriteString("package hyperloglog\n\n")
+	buf.WriteString("var (\n")
+	buf.WriteString("\t// mean raw estimates of cardinalities up to 5m, sorted, for every precision from MinPrecision.\n")
+	buf.WriteString("\t_RawEstimates = [MaxPrecision - MinPrecision + 1][]float64{\n")
+	buf.Write(estimates.Bytes())
+	buf.WriteString("\t}\n\n")
+	buf.WriteString("\t// _Biases[p][i] is the mean raw estimate _RawEstimates[p][i] minus the cardinality.\n")
+	buf.WriteString("\t_Biases = [MaxPrecision - MinPrecision + 1][]float64{\n")
+	buf.Write(biases.Bytes())
+	buf.WriteString("\t}\n)\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		panic(err)
+	}
+	if err := ioutil.WriteFile("hyperloglog_bias.go", src, 0644); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,95 @@
+package hyperloglog
+
+import (
+	"encoding/binary"
+	"math/bits"
+	"sort"
+)
+
+/*
+	An entry of the sparse representation packs a hash into 32 bits:
+
+	    | index at precision p' (25 bits) | rank (6 bits) | flag (1 bit) |
+
+	The index at precision p is the first p bits of the index at precision p'. When the
+	p' - p bits in between are not all zero, they already give the rank at precision p,
+	so only the index is kept and the flag is 0. Otherwise the rank of the bits after the
+	first p' ones is kept and the flag is 1. Entries sort by index, and a greater entry
+	with the same index has a greater rank.
+*/
+
+// encodeHash returns the sparse entry of h.
+func encodeHash(h uint64, p uint8) uint32 {
+	idx := uint32(h >> (64 - _SparsePrecision))
+	if idx&(1<<(_SparsePrecision-p)-1) != 0 {
+		return idx << 7
+	}
+	// the guard bit caps the rank at 64 - p' + 1.
+	rank := uint32(bits.LeadingZeros64(h<<_SparsePrecision|1<<(_SparsePrecision-1))) + 1
+	return idx<<7 | rank<<1 | 1
+}
+
+// decodeEntry returns the register index and rank at precision p of the sparse entry k.
+func decodeEntry(k uint32, p uint8) (uint32, uint8) {
+	idx := k >> 7
+	if k&1 == 1 {
+		return idx >> (_SparsePrecision - p), uint8(k>>1&0x3f) + _SparsePrecision - p
+	}
+	between := idx & (1<<(_SparsePrecision-p) - 1)
+	return idx >> (_SparsePrecision - p), _SparsePrecision - p - uint8(bits.Len32(between)) + 1
+}
+
+// validEntry reports whether k can be returned by encodeHash at precision p.
+func validEntry(k uint32, p uint8) bool {
+	between := k >> 7 & (1<<(_SparsePrecision-p) - 1)
+	if k&1 == 0 {
+		return between != 0 && k&0x7f == 0
+	}
+	rank := uint8(k >> 1 & 0x3f)
+	return between == 0 && rank >= 1 && rank <= 64-_SparsePrecision+1
+}
+
+// mergeEntries returns the sorted union of the sorted entries list and the unsorted entries tmp,
+// keeping only the greatest entry of every index. list may be reused, tmp is not modified.
+func mergeEntries(list, tmp []uint32) []uint32 {
+	all := append(list, tmp...)
+	sort.Slice(all, func(i, j int) bool { return all[i] < all[j] })
+	out := all[:0]
+	for i, k := range all {
+		if i+1 < len(all) && all[i+1]>>7 == k>>7 {
+			continue
+		}
+		out = append(out, k)
+	}
+	return out
+}
+
+// encodeList encodes sorted entries as varints of the differences between consecutive entries.
+func encodeList(entries []uint32) []byte {
+	buf := make([]byte, 0, len(entries)*2)
+	var prev uint32
+	for _, k := range entries {
+		buf = appendUvarint(buf, uint64(k-prev))
+		prev = k
+	}
+	return buf
+}
+
+// decodeList decodes the n entries encoded by encodeList.
+func decodeList(buf []byte, n int) []uint32 {
+	entries := make([]uint32, 0, n)
+	var prev uint32
+	for len(buf) > 0 {
+		d, size := binary.Uvarint(buf)
+		buf = buf[size:]
+		prev += uint32(d)
+		entries = append(entries, prev)
+	}
+	return entries
+}
+
+func appendUvarint(buf []byte, x uint64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(tmp[:], x)
+	return append(buf, tmp[:n]...)
+}
@@ -0,0 +1,42 @@
+package hyperloglog
+
+import (
+	"math/bits"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeHash(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for p := uint8(MinPrecision); p <= MaxPrecision; p++ {
+		for i := 0; i < 1000; i++ {
+			h := rng.Uint64()
+			if i%2 == 0 {
+				// zero the p' - p bits after the first p, so that the rank is kept in the entry.
+				h = h>>(64-p)<<(64-p) | rng.Uint64()>>(_SparsePrecision+uint(rng.Intn(40)))
+			}
+			k := encodeHash(h, p)
+			assert.True(t, validEntry(k, p))
+
+			idx, rank := decodeEntry(k, p)
+			assert.Equal(t, uint32(h>>(64-p)), idx)
+			assert.Equal(t, uint8(bits.LeadingZeros64(h<<p|1<<(p-1)))+1, rank)
+		}
+	}
+	assert.Equal(t, uint32(64-_SparsePrecision+1)<<1|1, encodeHash(0, DefaultPrecision))
+	assert.False(t, validEntry(0, DefaultPrecision))
+	assert.False(t, validEntry(1, DefaultPrecision))
+	assert.False(t, validEntry(1<<7|1<<1|1, DefaultPrecision))
+}
+
+func TestMergeEntries(t *testing.T) {
+	list := []uint32{1<<7 | 3, 2 << 7, 5<<7 | 1<<1 | 1}
+	entries := mergeEntries(list, []uint32{5<<7 | 4<<1 | 1, 1<<7 | 1<<1 | 1, 3 << 7, 3 << 7})
+	assert.Equal(t, []uint32{1<<7 | 3, 2 << 7, 3 << 7, 5<<7 | 4<<1 | 1}, entries)
+
+	buf := encodeList(entries)
+	assert.Equal(t, entries, decodeList(buf, len(entries)))
+	assert.Empty(t, decodeList(encodeList(nil), 0))
+}
@@ -0,0 +1,217 @@
+package hyperloglog
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func relativeError(count uint64, n int) float64 {
+	return math.Abs(float64(count)-float64(n)) / float64(n)
+}
+
+func TestHyperLogLog(t *testing.T) {
+	hll := NewHyperLogLog(DefaultPrecision)
+	assert.Equal(t, uint64(0), hll.Count())
+	hll.Insert("BTC")
+	hll.Insert("ETH")
+	hll.Insert("BTC")
+	assert.Equal(t, uint64(2), hll.Count())
+
+	hll.Reset()
+	n := 0
+	for _, next := range []int{100, 1000, 10000, 100000, 1000000} {
+		for ; n < next; n++ {
+			hll.Insert(fmt.Sprintf("item-%d", n))
+		}
+		count := hll.Count()
+		t.Logf("n=%d count=%d", n, count)
+		// about 3 standard errors.
+		assert.True(t, relativeError(count, n) < 0.025, "n=%d count=%d", n, count)
+	}
+
+	// duplicates do not change the count.
+	count := hll.Count()
+	for i := 0; i < 10000; i++ {
+		hll.Insert(fmt.Sprintf("item-%d", i))
+	}
+	assert.Equal(t, count, hll.Count())
+}
+
+func TestSparse(t *testing.T) {
+	hll := NewHyperLogLog(DefaultPrecision)
+	m := 1 << DefaultPrecision
+	for i := 0; i < m/4; i++ {
+		hll.Insert(fmt.Sprintf("item-%d", i))
+	}
+	// while sparse, the count is far more accurate than the standard error of the registers.
+	assert.True(t, relativeError(hll.Count(), m/4) < 0.002)
+	assert.Nil(t, hll.registers)
+	assert.True(t, len(hll.sparse) <= m)
+
+	for i := m / 4; i < 4*m; i++ {
+		hll.Insert(fmt.Sprintf("item-%d", i))
+	}
+	assert.Nil(t, hll.sparse)
+	assert.Len(t, hll.registers, m)
+	assert.True(t, relativeError(hll.Count(), 4*m) < 0.025)
+}
+
+func TestBiasCorrection(t *testing.T) {
+	// the raw estimate overestimates cardinalities below 5m, and linear counting gets worse
+	// as registers fill up: at 3m, both errors are several standard errors.
+	const p = 10
+	m := 1 << p
+	n := 3 * m
+	var bias, squares float64
+	trials := 50
+	for trial := 0; trial < trials; trial++ {
+		rng := rand.New(rand.NewSource(int64(trial)))
+		hll := NewHyperLogLog(p)
+		for i := 0; i < n; i++ {
+			hll.InsertHash(rng.Uint64())
+		}
+		e := (float64(hll.Count()) - float64(n)) / float64(n)
+		bias += e
+		squares += e * e
+	}
+	bias /= float64(trials)
+	rmse := math.Sqrt(squares / float64(trials))
+	t.Logf("bias=%.4f rmse=%.4f", bias, rmse)
+	assert.True(t, math.Abs(bias) < 0.01)
+	assert.True(t, rmse < 1.04/math.Sqrt(float64(m)))
+}
+
+func TestMerge(t *testing.T) {
+	fill := func(from, to int) *HyperLogLog {
+		hll := NewHyperLogLog(DefaultPrecision)
+		for i := from; i < to; i++ {
+			hll.Insert(fmt.Sprintf("item-%d", i))
+		}
+		return hll
+	}
+	sparse, dense := 1000, 100000
+
+	for _, c := range []struct {
+		lhs, rhs int
+	}{{sparse, sparse}, {sparse, dense}, {dense, sparse}, {dense, dense}} {
+		// the halves overlap.
+		lhs, rhs := fill(0, c.lhs), fill(c.lhs/2, c.lhs/2+c.rhs)
+		n := c.lhs/2 + c.rhs
+		if c.lhs > n {
+			n = c.lhs
+		}
+
+		assert.Nil(t, lhs.Merge(rhs))
+		assert.Equal(t, fill(0, n).Count(), lhs.Count(), "lhs=%d rhs=%d", c.lhs, c.rhs)
+		assert.True(t, relativeError(lhs.Count(), n) < 0.025)
+	}
+
+	hll := fill(0, sparse)
+	count := hll.Count()
+	assert.Nil(t, hll.Merge(hll))
+	assert.Equal(t, count, hll.Count())
+	assert.NotNil(t, hll.Merge(NewHyperLogLog(DefaultPrecision-1)))
+}
+
+func TestConcurrentMerge(t *testing.T) {
+	lhs, rhs := NewHyperLogLog(DefaultPrecision), NewHyperLogLog(DefaultPrecision)
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				lhs.Insert(fmt.Sprintf("item-%d", i))
+				rhs.Insert(fmt.Sprintf("item-%d", i+500))
+				if i%100 == 0 {
+					// merging 2 HyperLogLogs into each other must not deadlock.
+					if w%2 == 0 {
+						assert.Nil(t, lhs.Merge(rhs))
+					} else {
+						assert.Nil(t, rhs.Merge(lhs))
+					}
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+	assert.Nil(t, lhs.Merge(rhs))
+	assert.True(t, relativeError(lhs.Count(), 1500) < 0.01)
+}
+
+func TestSerialization(t *testing.T) {
+	for _, n := range []int{0, 1000, 100000} {
+		hll := NewHyperLogLog(DefaultPrecision)
+		for i := 0; i < n; i++ {
+			hll.Insert(fmt.Sprintf("item-%d", i))
+		}
+		bytes := Serialize(hll)
+		nhll, err := Deserialize(bytes)
+		assert.Empty(t, err)
+		assert.Equal(t, hll.Precision(), nhll.Precision())
+		assert.Equal(t, hll.Count(), nhll.Count())
+		assert.Equal(t, bytes, Serialize(nhll))
+
+		nhll.Insert("BTC")
+		hll.Insert("BTC")
+		assert.Equal(t, hll.Count(), nhll.Count())
+	}
+
+	// the sparse representation is far smaller than the registers.
+	hll := NewHyperLogLog(DefaultPrecision)
+	hll.Insert("BTC")
+	assert.True(t, len(Serialize(hll)) < 16)
+	for i := 0; i < 100000; i++ {
+		hll.Insert(fmt.Sprintf("item-%d", i))
+	}
+	assert.Len(t, Serialize(hll), 3+(1<<DefaultPrecision)*6/8)
+
+	// registers are packed across byte boundaries.
+	hll = NewHyperLogLog(MinPrecision)
+	hll.registers = []uint8{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 61}
+	nhll, err := Deserialize(Serialize(hll))
+	assert.Empty(t, err)
+	assert.Equal(t, hll.registers, nhll.registers)
+}
+
+func TestDeserializeCorrupted(t *testing.T) {
+	hll := NewHyperLogLog(DefaultPrecision)
+	hll.Insert("BTC")
+	hll.Insert("ETH")
+	sparse := Serialize(hll)
+	for i := 0; i < 100000; i++ {
+		hll.Insert(fmt.Sprintf("item-%d", i))
+	}
+	dense := Serialize(hll)
+
+	corrupt := func(bytes []byte, i int, b byte) []byte {
+		bytes = append([]byte(nil), bytes...)
+		bytes[i] = b
+		return bytes
+	}
+	for name, bytes := range map[string][]byte{
+		"empty":             nil,
+		"version":           corrupt(sparse, 0, 2),
+		"precision":         corrupt(sparse, 1, MaxPrecision+1),
+		"format":            corrupt(sparse, 2, 2),
+		"entries":           corrupt(sparse, 3, 3),
+		"truncated entries": sparse[:len(sparse)-1],
+		"unsorted entries":  append(append([]byte(nil), sparse...), 0),
+		"truncated dense":   dense[:len(dense)-1],
+		"register":          corrupt(dense, 3, 0xff),
+	} {
+		_, err := Deserialize(bytes)
+		assert.NotNil(t, err, name)
+	}
+}
+
+func TestUnsupportedPrecision(t *testing.T) {
+	assert.Panics(t, func() { NewHyperLogLog(MinPrecision - 1) })
+	assert.Panics(t, func() { NewHyperLogLog(MaxPrecision + 1) })
+	assert.NotPanics(t, func() { NewHyperLogLog(MaxPrecision) })
+}
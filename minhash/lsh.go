@@ -0,0 +1,237 @@
+package minhash
+
+import (
+	"encoding/binary"
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/amazingchow/photon-dance-bigdata-toolkit/hash"
+)
+
+/*
+	Locality-sensitive hashing splits every signature into b bands of r rows and hashes
+	each band into a bucket, two signatures become a candidate pair as soon as they
+	share a bucket in any band. For two sets with Jaccard similarity s the probability
+	of that is
+
+		P(s) = 1 - (1 - s^r)^b
+
+	an S-curve whose steepest point sits around (1/b)^(1/r). Given a threshold t, b and
+	r are picked to minimize the weighted sum of the area under P below t (false
+	positives) and the area above P beyond t (false negatives).
+*/
+
+// More info: "Mining of Massive Datasets", section 3.4
+
+// Pair is a candidate pair of near-duplicates, A < B.
+type Pair struct {
+	A, B    uint64
+	Jaccard float64
+}
+
+// LSH implements the banding index for MinHash signatures.
+type LSH struct {
+	mu sync.RWMutex
+
+	b, r       int
+	buckets    []map[uint64][]uint64
+	signatures map[uint64]Signature
+}
+
+// OptimalBands returns the b and r (b * r <= k) minimizing the false positive and false negative
+// probabilities around threshold, weighted equally.
+func OptimalBands(threshold float64, k int) (int, int) {
+	return OptimalBandsWeighted(threshold, k, 0.5, 0.5)
+}
+
+// OptimalBandsWeighted is OptimalBands with custom weights of false positives and false negatives,
+// raise fnWeight to miss fewer near-duplicates at the cost of more candidates to verify.
+func OptimalBandsWeighted(threshold float64, k int, fpWeight, fnWeight float64) (int, int) {
+	bestB, bestR := 1, 1
+	bestErr := math.Inf(1)
+	for b := 1; b <= k; b++ {
+		for r := 1; b*r <= k; r++ {
+			fp := integrate(func(s float64) float64 { return 1 - math.Pow(1-math.Pow(s, float64(r)), float64(b)) }, 0, threshold)
+			fn := integrate(func(s float64) float64 { return math.Pow(1-math.Pow(s, float64(r)), float64(b)) }, threshold, 1)
+			if e := fpWeight*fp + fnWeight*fn; e < bestErr {
+				bestB, bestR, bestErr = b, r, e
+			}
+		}
+	}
+	return bestB, bestR
+}
+
+// integrate integrates f over [a, b] with the midpoint rule.
+func integrate(f func(float64) float64, a, b float64) float64 {
+	const steps = 100
+	h := (b - a) / steps
+	area := 0.0
+	for i := 0; i < steps; i++ {
+		area += f(a + (float64(i)+0.5)*h)
+	}
+	return area * h
+}
+
+// NewLSH creates a LSH for signatures of length k, picking b and r with OptimalBands.
+func NewLSH(threshold float64, k int) *LSH {
+	b, r := OptimalBands(threshold, k)
+	return NewLSHWithBands(b, r)
+}
+
+// NewLSHWithBands creates a LSH with b bands of r rows, signatures must have at least b * r minimums.
+func NewLSHWithBands(b, r int) *LSH {
+	if b < 1 {
+		b = 1
+	}
+	if r < 1 {
+		r = 1
+	}
+	l := &LSH{
+		b:          b,
+		r:          r,
+		buckets:    make([]map[uint64][]uint64, b),
+		signatures: make(map[uint64]Signature),
+	}
+	for i := range l.buckets {
+		l.buckets[i] = make(map[uint64][]uint64)
+	}
+	return l
+}
+
+// Bands returns b and r.
+func (l *LSH) Bands() (int, int) {
+	return l.b, l.r
+}
+
+// Len returns the number of signatures inside the LSH.
+func (l *LSH) Len() int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return len(l.signatures)
+}
+
+// bandKey hashes the i-th band of sig.
+func (l *LSH) bandKey(sig Signature, i int) uint64 {
+	buf := make([]byte, 8*l.r)
+	for j := 0; j < l.r; j++ {
+		binary.LittleEndian.PutUint64(buf[8*j:], sig[i*l.r+j])
+	}
+	return hash.XXH3Bytes(buf, uint64(i))
+}
+
+func (l *LSH) check(sig Signature) {
+	if len(sig) < l.b*l.r {
+		panic("signature shorter than b * r")
+	}
+}
+
+// Insert adds the signature of document id, replacing its previous signature if any.
+func (l *LSH) Insert(id uint64, sig Signature) {
+	l.check(sig)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if old, ok := l.signatures[id]; ok {
+		l.remove(id, old)
+	}
+	l.signatures[id] = append(Signature(nil), sig...)
+	for i := range l.buckets {
+		key := l.bandKey(sig, i)
+		l.buckets[i][key] = append(l.buckets[i][key], id)
+	}
+}
+
+// Remove removes document id from the LSH, it returns false if id is not inside the LSH.
+func (l *LSH) Remove(id uint64) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	sig, ok := l.signatures[id]
+	if !ok {
+		return false
+	}
+	delete(l.signatures, id)
+	l.remove(id, sig)
+	return true
+}
+
+func (l *LSH) remove(id uint64, sig Signature) {
+	for i := range l.buckets {
+		key := l.bandKey(sig, i)
+		ids := l.buckets[i][key]
+		for j := range ids {
+			if ids[j] == id {
+				ids[j] = ids[len(ids)-1]
+				ids = ids[:len(ids)-1]
+				break
+			}
+		}
+		if len(ids) == 0 {
+			delete(l.buckets[i], key)
+		} else {
+			l.buckets[i][key] = ids
+		}
+	}
+}
+
+// Query returns the ids of the candidates sharing at least one band with sig, in ascending order.
+func (l *LSH) Query(sig Signature) []uint64 {
+	l.check(sig)
+
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	seen := make(map[uint64]struct{})
+	var ids []uint64
+	for i := range l.buckets {
+		for _, id := range l.buckets[i][l.bandKey(sig, i)] {
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// AllPairs returns every candidate pair whose estimated Jaccard similarity is at least minJaccard,
+// sorted by A then B. A minJaccard of 0 returns all candidate pairs unverified.
+func (l *LSH) AllPairs(minJaccard float64) []Pair {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	seen := make(map[[2]uint64]struct{})
+	var pairs []Pair
+	for i := range l.buckets {
+		for _, ids := range l.buckets[i] {
+			for x := 0; x < len(ids); x++ {
+				for y := x + 1; y < len(ids); y++ {
+					a, b := ids[x], ids[y]
+					if a > b {
+						a, b = b, a
+					}
+					if _, ok := seen[[2]uint64{a, b}]; ok {
+						continue
+					}
+					seen[[2]uint64{a, b}] = struct{}{}
+					j := Jaccard(l.signatures[a], l.signatures[b])
+					if j >= minJaccard {
+						pairs = append(pairs, Pair{A: a, B: b, Jaccard: j})
+					}
+				}
+			}
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].A != pairs[j].A {
+			return pairs[i].A < pairs[j].A
+		}
+		return pairs[i].B < pairs[j].B
+	})
+	return pairs
+}
@@ -0,0 +1,79 @@
+package minhash
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptimalBands(t *testing.T) {
+	for _, threshold := range []float64{0.3, 0.5, 0.8, 0.9} {
+		b, r := OptimalBands(threshold, 128)
+		assert.Equal(t, true, b*r <= 128)
+		// the steepest point of the S-curve stays close to the threshold.
+		knee := math.Pow(1/float64(b), 1/float64(r))
+		t.Logf("threshold %.1f: b %d, r %d, knee %.3f", threshold, b, r, knee)
+		assert.InDelta(t, threshold, knee, 0.15)
+	}
+
+	// weighting false negatives more uses more, shorter bands.
+	b1, r1 := OptimalBandsWeighted(0.8, 128, 0.5, 0.5)
+	b2, r2 := OptimalBandsWeighted(0.8, 128, 0.1, 0.9)
+	assert.Equal(t, true, math.Pow(1/float64(b2), 1/float64(r2)) <= math.Pow(1/float64(b1), 1/float64(r1)))
+}
+
+// corpus returns groups of near-duplicate sets, the sets of one group share 95 of their 100 elements
+// (a Jaccard similarity of 95/105), sets of different groups share nothing.
+func corpus(groups, perGroup int) map[uint64][]string {
+	sets := make(map[uint64][]string)
+	for g := 0; g < groups; g++ {
+		for d := 0; d < perGroup; d++ {
+			var set []string
+			for i := 0; i < 100; i++ {
+				if i < 95 {
+					set = append(set, "g"+strconv.Itoa(g)+"-"+strconv.Itoa(i))
+				} else {
+					set = append(set, "g"+strconv.Itoa(g)+"-d"+strconv.Itoa(d)+"-"+strconv.Itoa(i))
+				}
+			}
+			sets[uint64(g*perGroup+d)] = set
+		}
+	}
+	return sets
+}
+
+func TestLSH(t *testing.T) {
+	mh := NewMinHash(128, 1)
+	l := NewLSH(0.7, mh.K())
+	sets := corpus(50, 3)
+	for id, set := range sets {
+		l.Insert(id, mh.Signature(set))
+	}
+	assert.Equal(t, 150, l.Len())
+
+	for id, set := range sets {
+		g := id / 3 * 3
+		assert.Equal(t, []uint64{g, g + 1, g + 2}, l.Query(mh.Signature(set)))
+	}
+
+	pairs := l.AllPairs(0.7)
+	assert.Equal(t, 150, len(pairs))
+	for _, p := range pairs {
+		assert.Equal(t, p.A/3, p.B/3)
+		assert.Equal(t, true, p.A < p.B)
+		assert.Equal(t, true, p.Jaccard >= 0.7)
+	}
+
+	assert.Equal(t, true, l.Remove(0))
+	assert.Equal(t, false, l.Remove(0))
+	assert.Equal(t, []uint64{1, 2}, l.Query(mh.Signature(sets[0])))
+	assert.Equal(t, 148, len(l.AllPairs(0)))
+
+	// re-inserting replaces the signature.
+	l.Insert(1, mh.Signature(sets[3]))
+	assert.Equal(t, []uint64{1, 3, 4, 5}, l.Query(mh.Signature(sets[3])))
+
+	assert.Panics(t, func() { l.Insert(0, Signature{1, 2, 3}) })
+}
@@ -0,0 +1,133 @@
+package minhash
+
+import (
+	"math"
+
+	"github.com/amazingchow/photon-dance-bigdata-toolkit/hash"
+)
+
+/*
+	MinHash estimates the Jaccard similarity |A ∩ B| / |A ∪ B| of two sets. For a random
+	hash function h, the probability that min h(A) == min h(B) is exactly the Jaccard
+	similarity of A and B, so a signature of k minimums under k independent hash
+	functions estimates it as the fraction of positions where the two signatures agree,
+	with a standard error of sqrt(J(1-J)/k).
+
+	Every element is hashed once with XXH3, the k hash functions are derived from it by
+	mixing in k different seeds.
+*/
+
+// More info:
+//     1) "On the resemblance and containment of documents"
+//     2) "Mining of Massive Datasets", chapter 3
+
+// Signature is a MinHash signature, one minimum per hash function.
+type Signature []uint64
+
+// Tokenizer turns a text into its set of tokens, *simhash.SimHash is one, which applies
+// its tokenizer and its stopwords.
+type Tokenizer interface {
+	Tokens(text []byte) []string
+}
+
+// MinHash builds signatures of a fixed length.
+type MinHash struct {
+	seeds     []uint64
+	tokenizer Tokenizer
+}
+
+// NewMinHash creates a MinHash building signatures of k minimums, signatures are only comparable if
+// they come from MinHash with the same k and seed. tokenizerOpts[0] is used by SignatureText.
+func NewMinHash(k int, seed uint64, tokenizerOpts ...Tokenizer) *MinHash {
+	if k < 1 {
+		k = 1
+	}
+	mh := &MinHash{seeds: make([]uint64, k)}
+	s := seed
+	for i := range mh.seeds {
+		s += 0x9e3779b97f4a7c15
+		mh.seeds[i] = mix64(s)
+	}
+	if len(tokenizerOpts) > 0 {
+		mh.tokenizer = tokenizerOpts[0]
+	}
+	return mh
+}
+
+// K returns the length of the signatures.
+func (mh *MinHash) K() int {
+	return len(mh.seeds)
+}
+
+// Signature builds the signature of a set of strings, repeated elements make no difference.
+// The signature of the empty set has all its minimums set to math.MaxUint64.
+func (mh *MinHash) Signature(set []string) Signature {
+	sig := make(Signature, len(mh.seeds))
+	for i := range sig {
+		sig[i] = math.MaxUint64
+	}
+	for _, x := range set {
+		mh.Push(sig, x)
+	}
+	return sig
+}
+
+// Push adds x to sig in place, sig can be built incrementally from the output of Signature(nil).
+func (mh *MinHash) Push(sig Signature, x string) {
+	h := hash.XXH3(x, 0)
+	for i, s := range mh.seeds {
+		if v := mix64(h ^ s); v < sig[i] {
+			sig[i] = v
+		}
+	}
+}
+
+// SignatureText builds the signature of the tokens of text, it panics if mh was created without a Tokenizer.
+func (mh *MinHash) SignatureText(text []byte) Signature {
+	if mh.tokenizer == nil {
+		panic("no tokenizer")
+	}
+	return mh.Signature(mh.tokenizer.Tokens(text))
+}
+
+// Jaccard estimates the Jaccard similarity of the two sets behind lhs and rhs.
+func Jaccard(lhs, rhs Signature) float64 {
+	if len(lhs) != len(rhs) {
+		panic("mismatched signature lengths")
+	}
+	if len(lhs) == 0 {
+		return 0
+	}
+	same := 0
+	for i := range lhs {
+		if lhs[i] == rhs[i] {
+			same++
+		}
+	}
+	return float64(same) / float64(len(lhs))
+}
+
+// Merge returns the signature of the union of the two sets behind lhs and rhs.
+func Merge(lhs, rhs Signature) Signature {
+	if len(lhs) != len(rhs) {
+		panic("mismatched signature lengths")
+	}
+	sig := make(Signature, len(lhs))
+	for i := range lhs {
+		sig[i] = lhs[i]
+		if rhs[i] < sig[i] {
+			sig[i] = rhs[i]
+		}
+	}
+	return sig
+}
+
+// mix64 is the finalizer of SplitMix64.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
@@ -0,0 +1,95 @@
+package minhash
+
+import "fmt"
+
+/*
+	b-bit MinHash only keeps the lowest b bits of every minimum, cutting the signature
+	from 64 bits per hash function down to b. Two different minimums still agree on
+	their lowest b bits with probability about C = 2^-b, so the fraction P of agreeing
+	positions overestimates the similarity, the unbiased estimate (for sets small
+	relative to the universe of hash values) is:
+
+		J = (P - C) / (1 - C)
+
+	With b = 1 or 2 a signature needs several times more positions for the same
+	accuracy, but still takes far less space than the full one.
+*/
+
+// More info: "b-Bit Minwise Hashing"
+
+// BBitSignature is a Signature compressed to its lowest B bits per minimum, packed into 64-bit words.
+type BBitSignature struct {
+	B     uint
+	K     int
+	Words []uint64
+}
+
+// Compress keeps the lowest b bits (1 <= b <= 64) of every minimum of sig.
+func Compress(sig Signature, b uint) BBitSignature {
+	if b < 1 || b > 64 {
+		panic(fmt.Sprintf("unsupported b %d", b))
+	}
+	bbs := BBitSignature{
+		B:     b,
+		K:     len(sig),
+		Words: make([]uint64, (uint(len(sig))*b+63)/64),
+	}
+	mask := ^uint64(0) >> (64 - b)
+	for i, v := range sig {
+		bbs.set(i, v&mask)
+	}
+	return bbs
+}
+
+func (bbs BBitSignature) set(i int, v uint64) {
+	pos := uint(i) * bbs.B
+	w, off := pos/64, pos%64
+	bbs.Words[w] |= v << off
+	if off+bbs.B > 64 {
+		bbs.Words[w+1] |= v >> (64 - off)
+	}
+}
+
+// Get returns the i-th compressed minimum.
+func (bbs BBitSignature) Get(i int) uint64 {
+	pos := uint(i) * bbs.B
+	w, off := pos/64, pos%64
+	v := bbs.Words[w] >> off
+	if off+bbs.B > 64 {
+		v |= bbs.Words[w+1] << (64 - off)
+	}
+	return v & (^uint64(0) >> (64 - bbs.B))
+}
+
+// BBitJaccard estimates the Jaccard similarity of the two sets behind lhs and rhs, clamped to [0, 1].
+func BBitJaccard(lhs, rhs BBitSignature) float64 {
+	if lhs.B != rhs.B || lhs.K != rhs.K {
+		panic("mismatched signatures")
+	}
+	if lhs.K == 0 {
+		return 0
+	}
+
+	same := 0
+	for i := 0; i < lhs.K; i++ {
+		if lhs.Get(i) == rhs.Get(i) {
+			same++
+		}
+	}
+
+	p := float64(same) / float64(lhs.K)
+	if lhs.B >= 64 {
+		return p
+	}
+	c := 1 / float64(uint64(1)<<lhs.B)
+	j := (p - c) / (1 - c)
+	if j < 0 {
+		j = 0
+	}
+	return j
+}
+
+// Bytes returns the size of the packed minimums in bytes.
+func (bbs BBitSignature) Bytes() int {
+	return 8 * len(bbs.Words)
+}
@@ -0,0 +1,88 @@
+package minhash
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/amazingchow/photon-dance-bigdata-toolkit/simhash"
+)
+
+// overlappingSets returns two sets of n elements each sharing shared elements.
+func overlappingSets(n, shared int) ([]string, []string) {
+	lhs := make([]string, 0, n)
+	rhs := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		lhs = append(lhs, "x"+strconv.Itoa(i))
+		if i < shared {
+			rhs = append(rhs, "x"+strconv.Itoa(i))
+		} else {
+			rhs = append(rhs, "y"+strconv.Itoa(i))
+		}
+	}
+	return lhs, rhs
+}
+
+func TestJaccard(t *testing.T) {
+	mh := NewMinHash(512, 42)
+	assert.Equal(t, 512, mh.K())
+
+	for _, shared := range []int{0, 250, 500, 750, 1000} {
+		lhs, rhs := overlappingSets(1000, shared)
+		expected := float64(shared) / float64(2000-shared)
+		// 4 standard errors.
+		tolerance := 4*math.Sqrt(expected*(1-expected)/512) + 1e-9
+		actual := Jaccard(mh.Signature(lhs), mh.Signature(rhs))
+		assert.InDelta(t, expected, actual, tolerance, "shared %d", shared)
+	}
+
+	// duplicates and order do not matter.
+	assert.Equal(t, mh.Signature([]string{"a", "b", "c"}), mh.Signature([]string{"c", "a", "b", "a"}))
+	// different seeds give different hash functions.
+	assert.NotEqual(t, mh.Signature([]string{"a"}), NewMinHash(512, 43).Signature([]string{"a"}))
+}
+
+func TestPushAndMerge(t *testing.T) {
+	mh := NewMinHash(64, 0)
+	lhs, rhs := overlappingSets(100, 30)
+
+	sig := mh.Signature(nil)
+	for _, x := range lhs {
+		mh.Push(sig, x)
+	}
+	assert.Equal(t, mh.Signature(lhs), sig)
+	assert.Equal(t, mh.Signature(append(lhs, rhs...)), Merge(mh.Signature(lhs), mh.Signature(rhs)))
+}
+
+func TestBBitMinHash(t *testing.T) {
+	mh := NewMinHash(2048, 7)
+	lhs, rhs := overlappingSets(1000, 500)
+	expected := 500.0 / 1500.0
+	sigL, sigR := mh.Signature(lhs), mh.Signature(rhs)
+
+	for _, b := range []uint{1, 2, 3, 8, 13, 64} {
+		cl, cr := Compress(sigL, b), Compress(sigR, b)
+		for i := range sigL {
+			assert.Equal(t, sigL[i]&(^uint64(0)>>(64-b)), cl.Get(i))
+		}
+		assert.Equal(t, 8*((2048*int(b)+63)/64), cl.Bytes())
+		assert.InDelta(t, expected, BBitJaccard(cl, cr), 0.05, "b %d", b)
+	}
+	assert.Equal(t, 1.0, BBitJaccard(Compress(sigL, 1), Compress(sigL, 1)))
+}
+
+func TestSignatureText(t *testing.T) {
+	sh, err := simhash.NewSimHashWithDict(simhash.ENGLISH, "")
+	assert.Nil(t, err)
+	mh := NewMinHash(256, 0, sh)
+	s1 := mh.SignatureText([]byte("The quick brown fox jumps over the lazy dog."))
+	s2 := mh.SignatureText([]byte("the QUICK brown fox jumps over the lazy dog"))
+	s3 := mh.SignatureText([]byte("the quick brown fox leaps over the sleepy dog"))
+	// case and stopwords are dropped by simhash.
+	assert.Equal(t, 1.0, Jaccard(s1, s2))
+	assert.InDelta(t, 4.0/8.0, Jaccard(s1, s3), 0.15)
+
+	assert.Panics(t, func() { NewMinHash(8, 0).SignatureText([]byte("a")) })
+}
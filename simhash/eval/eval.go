@@ -0,0 +1,199 @@
+package eval
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/amazingchow/photon-dance-bigdata-toolkit/simhash"
+)
+
+/*
+	Evaluates SimHash configurations on a corpus of pairs of documents labeled as
+	duplicates or not. Two documents are predicted to be duplicates when their
+	fingerprints are at most threshold bits apart, for every threshold in [0, 64]:
+
+	1. precision = TP / (TP + FP), 1 when no pair is predicted to be a duplicate.
+	2. recall, or true positive rate = TP / (TP + FN).
+	3. false positive rate = FP / (FP + TN).
+
+	The ROC curve goes through (FPR, TPR) for every threshold, starting at (0, 0), and its
+	area (AUC) is the probability that a random duplicate pair is closer than a random
+	non-duplicate pair, ties counting for 1/2. Best is the threshold maximizing F1.
+
+	The corpus is stored as JSON lines: {"a": "...", "b": "...", "duplicate": true}.
+*/
+
+// More info: https://en.wikipedia.org/wiki/Receiver_operating_characteristic
+
+// Pair is a labeled pair of documents.
+type Pair struct {
+	A         string `json:"a"`
+	B         string `json:"b"`
+	Duplicate bool   `json:"duplicate"`
+}
+
+// LoadPairs reads pairs from JSON lines, empty lines are skipped.
+func LoadPairs(r io.Reader) ([]Pair, error) {
+	var pairs []Pair
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+		var p Pair
+		if err := json.Unmarshal(scanner.Bytes(), &p); err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		pairs = append(pairs, p)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return pairs, nil
+}
+
+// LoadPairsFromFile is LoadPairs reading from the file at path.
+func LoadPairsFromFile(path string) ([]Pair, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return LoadPairs(f)
+}
+
+// Config is a SimHash configuration under evaluation.
+type Config struct {
+	Name    string
+	SimHash *simhash.SimHash
+	// TopN is passed to Fingerprint, 0 uses every token.
+	TopN uint32
+}
+
+// Point is the confusion matrix of a threshold.
+type Point struct {
+	Threshold      int
+	TP, FP, TN, FN int
+}
+
+// Precision returns TP / (TP + FP), 1 when no pair is predicted to be a duplicate.
+func (p Point) Precision() float64 {
+	if p.TP+p.FP == 0 {
+		return 1
+	}
+	return float64(p.TP) / float64(p.TP+p.FP)
+}
+
+// Recall returns TP / (TP + FN), the true positive rate.
+func (p Point) Recall() float64 {
+	if p.TP+p.FN == 0 {
+		return 0
+	}
+	return float64(p.TP) / float64(p.TP+p.FN)
+}
+
+// FPR returns FP / (FP + TN), the false positive rate.
+func (p Point) FPR() float64 {
+	if p.FP+p.TN == 0 {
+		return 0
+	}
+	return float64(p.FP) / float64(p.FP+p.TN)
+}
+
+// F1 returns the harmonic mean of precision and recall.
+func (p Point) F1() float64 {
+	precision, recall := p.Precision(), p.Recall()
+	if precision+recall == 0 {
+		return 0
+	}
+	return 2 * precision * recall / (precision + recall)
+}
+
+// Result is the evaluation of a Config.
+type Result struct {
+	Config Config
+	// Distances[i] is the distance between the fingerprints of the i-th pair.
+	Distances []int
+	// Curve holds a Point for every threshold in [0, 64].
+	Curve [65]Point
+	AUC   float64
+	Best  Point
+}
+
+// String returns a one-line summary of r.
+func (r *Result) String() string {
+	return fmt.Sprintf("%-24s topN=%-4d AUC=%.4f best: threshold=%-2d precision=%.4f recall=%.4f F1=%.4f",
+		r.Config.Name, r.Config.TopN, r.AUC, r.Best.Threshold, r.Best.Precision(), r.Best.Recall(), r.Best.F1())
+}
+
+// WriteROC writes the curve of r as CSV: threshold, TP, FP, TN, FN, precision, recall, FPR and F1.
+func (r *Result) WriteROC(w io.Writer) error {
+	if _, err := fmt.Fprintln(w, "threshold,tp,fp,tn,fn,precision,recall,fpr,f1"); err != nil {
+		return err
+	}
+	for _, p := range r.Curve {
+		if _, err := fmt.Fprintf(w, "%d,%d,%d,%d,%d,%.6f,%.6f,%.6f,%.6f\n",
+			p.Threshold, p.TP, p.FP, p.TN, p.FN, p.Precision(), p.Recall(), p.FPR(), p.F1()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Evaluate fingerprints every pair with cfg and computes the curves.
+func Evaluate(cfg Config, pairs []Pair) *Result {
+	r := &Result{Config: cfg, Distances: make([]int, len(pairs))}
+	var topNOpts []uint32
+	if cfg.TopN > 0 {
+		topNOpts = []uint32{cfg.TopN}
+	}
+
+	// histograms of the distances of duplicate and non-duplicate pairs.
+	var dups, nonDups [65]int
+	var nDups, nNonDups int
+	for i, p := range pairs {
+		d := simhash.Distance(cfg.SimHash.Fingerprint([]byte(p.A), topNOpts...), cfg.SimHash.Fingerprint([]byte(p.B), topNOpts...))
+		r.Distances[i] = d
+		if p.Duplicate {
+			dups[d]++
+			nDups++
+		} else {
+			nonDups[d]++
+			nNonDups++
+		}
+	}
+
+	var tp, fp int
+	prevTPR, prevFPR := 0.0, 0.0
+	for t := 0; t <= 64; t++ {
+		tp += dups[t]
+		fp += nonDups[t]
+		p := Point{Threshold: t, TP: tp, FP: fp, TN: nNonDups - fp, FN: nDups - tp}
+		r.Curve[t] = p
+		r.AUC += (p.FPR() - prevFPR) * (p.Recall() + prevTPR) / 2
+		prevTPR, prevFPR = p.Recall(), p.FPR()
+		if t == 0 || p.F1() > r.Best.F1() {
+			r.Best = p
+		}
+	}
+	return r
+}
+
+// Sweep evaluates every config with every topN, 0 using every token.
+func Sweep(configs []Config, topNs []uint32, pairs []Pair) []*Result {
+	results := make([]*Result, 0, len(configs)*len(topNs))
+	for _, cfg := range configs {
+		for _, topN := range topNs {
+			cfg.TopN = topN
+			results = append(results, Evaluate(cfg, pairs))
+		}
+	}
+	return results
+}
@@ -0,0 +1,76 @@
+package eval
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/amazingchow/photon-dance-bigdata-toolkit/simhash"
+)
+
+func TestLoadPairs(t *testing.T) {
+	pairs, err := LoadPairs(strings.NewReader(`{"a": "x", "b": "y", "duplicate": true}` + "\n\n" + `{"a": "x", "b": "z"}` + "\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, []Pair{{"x", "y", true}, {"x", "z", false}}, pairs)
+
+	_, err = LoadPairs(strings.NewReader(`{"a": "x", "b": "y"}` + "\n" + `{"a": "x",`))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "line 2")
+	_, err = LoadPairsFromFile("../fixtures/missing.jsonl")
+	assert.NotNil(t, err)
+}
+
+func newSimHash(t *testing.T, language simhash.LanguageType) *simhash.SimHash {
+	sh, err := simhash.NewSimHashWithDict(language, "")
+	assert.Nil(t, err)
+	return sh
+}
+
+func TestEvaluate(t *testing.T) {
+	sh := newSimHash(t, simhash.ENGLISH)
+	pairs := []Pair{
+		{"the quick brown fox jumps over the lazy dog", "the quick brown fox jumps over the lazy dog", true},
+		{"a stitch in time saves nine", "a stitch in time saves nine", true},
+		{"the quick brown fox jumps over the lazy dog", "a stitch in time saves nine", false},
+	}
+	r := Evaluate(Config{Name: "english", SimHash: sh}, pairs)
+	assert.Equal(t, 0, r.Distances[0])
+	assert.Equal(t, 0, r.Distances[1])
+	d := r.Distances[2]
+	assert.True(t, d > 0)
+	assert.Equal(t, 1.0, r.AUC)
+	assert.Equal(t, Point{Threshold: 0, TP: 2, FP: 0, TN: 1, FN: 0}, r.Best)
+	assert.Equal(t, Point{Threshold: d, TP: 2, FP: 1, TN: 0, FN: 0}, r.Curve[d])
+	assert.Equal(t, Point{Threshold: 64, TP: 2, FP: 1, TN: 0, FN: 0}, r.Curve[64])
+	assert.Equal(t, 2.0/3, r.Curve[64].Precision())
+	assert.Equal(t, 1.0, r.Curve[64].FPR())
+
+	var buf bytes.Buffer
+	assert.Nil(t, r.WriteROC(&buf))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Len(t, lines, 66)
+	assert.Equal(t, "0,2,0,1,0,1.000000,1.000000,0.000000,1.000000", lines[1])
+}
+
+func TestLabeledCorpus(t *testing.T) {
+	pairs, err := LoadPairsFromFile("../fixtures/pairs.jsonl")
+	assert.Nil(t, err)
+	assert.Len(t, pairs, 60)
+
+	results := Sweep([]Config{{Name: "mixed", SimHash: newSimHash(t, simhash.MIXED)}}, []uint32{0, 1}, pairs)
+	for _, r := range results {
+		t.Log(r)
+	}
+	all, one := results[0], results[1]
+	assert.True(t, all.AUC >= 0.99, all.String())
+	assert.True(t, all.Best.F1() >= 0.95, all.String())
+	// a fingerprint made of a single token, as when Fingerprint only filled the first
+	// hash/weight pair, tells duplicates apart worse.
+	assert.True(t, one.AUC < all.AUC, one.String())
+
+	// ENGLISH can not tokenize the Chinese pairs.
+	english := Evaluate(Config{Name: "english", SimHash: newSimHash(t, simhash.ENGLISH)}, pairs)
+	assert.True(t, english.AUC < all.AUC, english.String())
+}
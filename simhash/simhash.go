@@ -2,12 +2,7 @@ package simhash
 
 import (
 	"fmt"
-	"regexp"
 	"sort"
-	"strings"
-	"unicode"
-
-	"github.com/huichen/sego"
 
 	"github.com/amazingchow/photon-dance-bigdata-toolkit/hash"
 )
@@ -15,181 +10,243 @@ import (
 type LanguageType int8
 
 const (
-	ENGLISH LanguageType = 0
-	CHINESE LanguageType = 1
-)
-
-type HashWeightPair struct {
-	h uint64
-	w float32
-}
-
-var (
-	// https://stackoverflow.com/questions/14682641/count-number-of-1s-in-binary-format-of-decimal-number/14682688#14682688
-	_PopcountLookUpTable = [256]uint8{
-		0, 1, 1, 2, 1, 2, 2, 3, 1, 2, 2, 3, 2, 3, 3, 4,
-		1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5,
-		1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5,
-		2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6,
-		1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5,
-		2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6,
-		2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6,
-		3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7,
-		1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5,
-		2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6,
-		2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6,
-		3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7,
-		2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6,
-		3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7,
-		3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7,
-		4, 5, 5, 6, 5, 6, 6, 7, 5, 6, 6, 7, 6, 7, 7, 8,
-	}
+	ENGLISH  LanguageType = 0
+	CHINESE  LanguageType = 1
+	JAPANESE LanguageType = 2
+	KOREAN   LanguageType = 3
+	// AUTO detects the language of every document, see DetectLanguage, and removes its stopwords.
+	AUTO LanguageType = 4
+	// MIXED tokenizes every script run of a document in its own language, see MixedTokenizer.
+	MIXED LanguageType = 5
 )
 
-// SimHash implements the Standard-Cuckoo-Filter mentioned by
-// "Detecting Near-Duplicates for Web Crawling".
+// SimHash implements the fingerprint described by "Detecting Near-Duplicates for Web Crawling",
+// its version is AlgorithmVersion.
+//
+// A SimHash is safe for concurrent use by multiple goroutines once configured: SetIDF, SetStopWords,
+// AddStopWords, SetNormalization and changes to the StopWords it returns must not run concurrently
+// with any other method. The IDF it uses may keep learning with AddDocument meanwhile.
 type SimHash struct {
-	language    LanguageType
-	chSegmenter *sego.Segmenter
-	chRegExp    *regexp.Regexp
+	language      LanguageType
+	normalization Normalization
+	tokenizer     Tokenizer
+	stopwords     StopWords
+	// stopwords of the language detected in every document, for AUTO only.
+	languageStopWords map[LanguageType]StopWords
+	idf               *IDF
 }
 
-func NewSimHash(language LanguageType, dict string) *SimHash {
-	sh := &SimHash{language: language}
-	if language == CHINESE {
-		sh.chSegmenter = new(sego.Segmenter)
-		sh.chSegmenter.LoadDictionary(dict)
-		sh.chRegExp = regexp.MustCompile("[\u4E00-\u9FA5]+")
+// NewSimHash is NewSimHashWithDict panicking on error, when dict can not be loaded or language
+// is not supported.
+//
+// Deprecated: dict is usually user input, use NewSimHashWithDict and handle its error.
+func NewSimHash(language LanguageType, dict string, tokenizerOpts ...Tokenizer) *SimHash {
+	sh, err := NewSimHashWithDict(language, dict, tokenizerOpts...)
+	if err != nil {
+		panic(err)
 	}
 	return sh
 }
 
-// text --> concordance
-func (sh *SimHash) processTokenize(text []byte) map[string]float32 {
-	concordance := make(map[string]float32)
-	switch sh.language {
+// NewSimHashWithDict creates a SimHash, language selects the default stopwords and the default
+// Tokenizer, dict is only used by the default Tokenizers of CHINESE, AUTO and MIXED, empty for the
+// built-in dictionary. Pass tokenizerOpts to tokenize the text some other way. It returns an error
+// if dict can not be loaded or language is not supported.
+func NewSimHashWithDict(language LanguageType, dict string, tokenizerOpts ...Tokenizer) (*SimHash, error) {
+	sh := &SimHash{language: language, stopwords: DefaultStopWords(language)}
+	if language == AUTO {
+		sh.languageStopWords = languageStopWords()
+	}
+	if len(tokenizerOpts) > 0 && tokenizerOpts[0] != nil {
+		sh.tokenizer = tokenizerOpts[0]
+		return sh, nil
+	}
+	var err error
+	switch language {
 	case ENGLISH:
-		{
-			fc := func(r rune) bool { return !unicode.IsLetter(r) }
-			words := strings.FieldsFunc(Bytes2String(text), fc)
-			for _, w := range words {
-				concordance[strings.ToLower(w)] += 1.0
-			}
-			total := float32(len(words))
-			for k := range concordance {
-				concordance[k] /= total
-			}
-		}
+		sh.tokenizer = EnglishTokenizer{}
 	case CHINESE:
-		{
-			segments := sh.chSegmenter.Segment(text)
-			words := sh.chRegExp.FindAllString(sego.SegmentsToString(segments, false), -1)
-			for _, w := range words {
-				concordance[w] += 1.0
-			}
-			total := float32(len(words))
-			for k := range concordance {
-				concordance[k] /= total
-			}
-		}
+		sh.tokenizer, err = NewChineseTokenizer(dict)
+	case JAPANESE, KOREAN:
+		sh.tokenizer = CJKBigramTokenizer{}
+	case AUTO:
+		sh.tokenizer, err = NewAutoTokenizer(dict)
+	case MIXED:
+		sh.tokenizer, err = NewMixedTokenizer(dict)
 	default:
-		{
-			panic("unsupported language")
-		}
+		return nil, fmt.Errorf("unsupported language %d", language)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return sh, nil
+}
+
+// SetIDF plugs idf into sh, the weight of every token becomes its relative frequency times its idf.
+// A nil idf restores the plain relative frequency.
+func (sh *SimHash) SetIDF(idf *IDF) {
+	sh.idf = idf
+}
+
+// BuildIDF counts the document frequency of the tokens of corpus, after removing the stopwords.
+func (sh *SimHash) BuildIDF(corpus [][]byte) *IDF {
+	idf := NewIDF()
+	for _, text := range corpus {
+		idf.AddDocument(sh.Tokens(text))
+	}
+	return idf
+}
+
+// Tokens returns the distinct tokens of text after removing the stopwords, in sorted order.
+func (sh *SimHash) Tokens(text []byte) []string {
+	concordance, language := sh.processTokenize(text)
+	sh.processStopwords(concordance, language)
+	tokens := make([]string, 0, len(concordance))
+	for k := range concordance {
+		tokens = append(tokens, k)
+	}
+	sort.Strings(tokens)
+	return tokens
+}
+
+// text --> concordance, language of text
+func (sh *SimHash) processTokenize(text []byte) (map[string]float32, LanguageType) {
+	concordance := make(map[string]float32)
+	words, language := sh.tokenize(text)
+	for _, w := range words {
+		concordance[w] += 1.0
+	}
+	total := float32(len(words))
+	for k := range concordance {
+		concordance[k] /= total
+	}
+	return concordance, language
+}
+
+// StopWords returns the stopwords of sh, they can be extended or trimmed in place, but not
+// while sh is computing fingerprints. With AUTO, the stopwords of the detected language are
+// removed as well, until SetStopWords replaces them all.
+func (sh *SimHash) StopWords() StopWords {
+	return sh.stopwords
+}
+
+// SetStopWords replaces the stopwords of sh, whatever the detected language with AUTO, a nil or
+// empty sw disables stopword removal.
+func (sh *SimHash) SetStopWords(sw StopWords) {
+	sh.stopwords = sw
+	sh.languageStopWords = nil
+}
+
+// AddStopWords adds words to the stopwords of sh.
+func (sh *SimHash) AddStopWords(words ...string) {
+	if sh.stopwords == nil {
+		sh.stopwords = make(StopWords)
 	}
-	return concordance
+	sh.stopwords.Add(words...)
 }
 
 // concordance --> concordance (remove stopwords)
-func (sh *SimHash) processStopwords(concordance map[string]float32) {
-	switch sh.language {
-	case ENGLISH:
-		{
-			for k := range concordance {
-				if _, ok := EnStopWords[k]; ok {
-					delete(concordance, k)
-				} else if _, ok := SpStopWords[k]; ok {
-					delete(concordance, k)
-				}
-			}
-		}
-	case CHINESE:
-		{
-			for k := range concordance {
-				if _, ok := ChStopWords[k]; ok {
-					delete(concordance, k)
-				} else if _, ok := SpStopWords[k]; ok {
-					delete(concordance, k)
-				}
-			}
-		}
-	default:
-		{
-			panic("unsupported language")
+func (sh *SimHash) processStopwords(concordance map[string]float32, language LanguageType) {
+	if len(sh.stopwords) == 0 && sh.languageStopWords == nil {
+		return
+	}
+	for k := range concordance {
+		if sh.isStopWord(k, language) {
+			delete(concordance, k)
 		}
 	}
 }
 
+// isStopWord reports whether token is a stopword of sh in a text of language.
+func (sh *SimHash) isStopWord(token string, language LanguageType) bool {
+	if _, ok := sh.stopwords[token]; ok {
+		return true
+	}
+	_, ok := sh.languageStopWords[language][token]
+	return ok
+}
+
+// Fingerprint computes the fingerprint of text, only the topNOpts[0] heaviest tokens are used if given.
 func (sh *SimHash) Fingerprint(text []byte, topNOpts ...uint32) uint64 {
-	concordance := sh.processTokenize(text)
-	sh.processStopwords(concordance)
+	concordance, language := sh.processTokenize(text)
+	sh.processStopwords(concordance, language)
+	if sh.idf != nil {
+		sh.idf.apply(concordance)
+	}
+	return sh.FingerprintWeighted(concordance, topNOpts...)
+}
 
-	hashWeightPairs := make([]HashWeightPair, len(concordance))
+// FingerprintWeighted computes the fingerprint of the given features and their weights, skipping
+// tokenization, stopwords and idf. Only the topNOpts[0] heaviest features are used if given.
+func (sh *SimHash) FingerprintWeighted(features map[string]float32, topNOpts ...uint32) uint64 {
+	selected, _ := selectFeatures(features, topNOpts...)
+	weights := bitWeights(selected)
+	return weightsToFingerprint(&weights)
+}
+
+// selectFeatures hashes features and splits them into the topNOpts[0] heaviest ones, or all of
+// them, and the rest, both heaviest first and equal weights by token.
+func selectFeatures(features map[string]float32, topNOpts ...uint32) (selected, dropped []Feature) {
+	selected = make([]Feature, len(features))
 	idx := 0
-	for k, v := range concordance {
-		hashWeightPairs[idx] = HashWeightPair{
-			h: hash.FNV1A64(k),
-			w: v,
+	for k, v := range features {
+		selected[idx] = Feature{
+			Token:  k,
+			Weight: v,
+			Hash:   hash.FNV1A64(k),
 		}
+		idx++
 	}
-	// sort the slice by weight, higher first.
-	sort.Slice(hashWeightPairs, func(i, j int) bool {
-		return hashWeightPairs[i].w > hashWeightPairs[j].w
+	// sort the slice by weight, higher first, and equal weights by token, so that both topN and
+	// the order of the float32 sums in bitWeights are deterministic.
+	sort.Slice(selected, func(i, j int) bool {
+		if selected[i].Weight != selected[j].Weight {
+			return selected[i].Weight > selected[j].Weight
+		}
+		return selected[i].Token < selected[j].Token
 	})
-	if len(topNOpts) > 0 && topNOpts[0] < uint32(len(hashWeightPairs)) {
-		hashWeightPairs = hashWeightPairs[:topNOpts[0]]
+	if len(topNOpts) > 0 && topNOpts[0] < uint32(len(selected)) {
+		selected, dropped = selected[:topNOpts[0]], selected[topNOpts[0]:]
 	}
+	return selected, dropped
+}
 
-	weights := make([]float32, 64)
-	for i := 63; i >= 0; i-- {
-		for j := range hashWeightPairs {
-			if hashWeightPairs[j].h>>(64-i-1)&1 == 1 {
-				weights[i] += hashWeightPairs[j].w
+// bitWeights sums the weights of features over every bit, positive if the bit of the hash is set
+// and negative otherwise. weights[i] belongs to bit i, bit 0 being the least significant one.
+func bitWeights(features []Feature) (weights [64]float32) {
+	for i := 0; i < 64; i++ {
+		for j := range features {
+			if features[j].Hash>>i&1 == 1 {
+				weights[i] += features[j].Weight
 			} else {
-				weights[i] -= hashWeightPairs[j].w
+				weights[i] -= features[j].Weight
 			}
 		}
 	}
+	return weights
+}
 
+// weightsToFingerprint sets every bit whose weight is not negative.
+func weightsToFingerprint(weights *[64]float32) uint64 {
 	var fingerprint uint64
-	var base uint64 = 1
-	for i := 63; i >= 0; i-- {
+	for i := 0; i < 64; i++ {
 		if weights[i] >= 0 {
-			fingerprint += base
+			fingerprint |= 1 << i
 		}
-		base <<= 1
 	}
-
 	return fingerprint
 }
 
+// FingerprintToString encodes fingerprint as 64 binary digits, see ParseFingerprint.
 func (sh *SimHash) FingerprintToString(fingerprint uint64) string {
 	return fmt.Sprintf("%064b", fingerprint)
 }
 
+// IsEqual reports whether lhs and rhs are at most nOpts[0] bits apart, 3 by default.
 func (sh *SimHash) IsEqual(lhs uint64, rhs uint64, nOpts ...uint8) bool {
 	var n uint8 = 3
 	if len(nOpts) > 0 {
 		n = nOpts[0]
 	}
-
-	var cnt uint8 = 0
-
-	lhs ^= rhs
-	for (lhs > 0) && (cnt <= n) {
-		cnt += _PopcountLookUpTable[lhs&0xff]
-		lhs >>= 8
-	}
-
-	return cnt <= n
+	return Distance(lhs, rhs) <= int(n)
 }
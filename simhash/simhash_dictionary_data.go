@@ -0,0 +1,64294 @@
+// Code generated by simhash_dictionary_data_gen.go from the dictionary of jieba; DO NOT EDIT.
+
+package simhash
+
+// The words and frequencies of _DefaultChineseDictionary come from the dictionary of jieba
+// (https://github.com/fxsjy/jieba), Copyright (c) 2013 Sun Junyi, released under the MIT
+// license, see the NOTICE file.
+
+// _DefaultChineseDictionary holds one "word frequency" entry per line.
+const _DefaultChineseDictionary = `的 3188252
+了 883634
+是 796991
+在 727915
+和 555815
+有 423765
+他 401339
+不 360331
+我 328841
+人 313209
+也 307851
+为 295952
+就 273122
+这 261791
+上 258101
+年 248559
+中 243191
+你 234587
+说 219817
+一 217830
+到 205341
+都 202780
+等 195934
+着 188584
+对 184674
+来 161501
+与 160984
+地 160541
+还 157058
+要 156581
+又 150749
+大 144099
+而 143233
+一个 142747
+之 140957
+道 140545
+以 136106
+得 134479
+她 134035
+中国 129470
+个 125538
+后 124793
+去 123402
+将 122305
+那 111550
+但 110709
+从 110435
+月 110207
+下 108294
+把 108066
+被 106845
+于 106176
+时 103735
+只 101442
+多 98900
+我们 98740
+过 97817
+可 95892
+他们 93969
+并 93868
+能 93096
+好 92543
+会 92091
+自己 90933
+没有 87597
+出 85847
+国家 79520
+或 78942
+日 78695
+由 78203
+里 77054
+用 76586
+所 76462
+向 75979
+已 72638
+其 71322
+可以 70958
+给 69480
+很 69103
+发展 68664
+看 66641
+工作 66367
+使 64655
+前 62779
+新 62626
+想 61904
+却 61348
+这个 61310
+它 60864
+最 60450
+什么 59317
+见 58965
+起 58684
+主要 57991
+小 57969
+高 57483
+更 56478
+如 56065
+问题 55563
+再 55507
+才 55415
+便 55339
+进行 54355
+地方 52641
+没 52407
+已经 51289
+走 50437
+做 50331
+让 50310
+内 50204
+及 49988
+这样 48926
+全国 48874
+经济 48718
+这些 47400
+不是 46856
+听 45776
+公司 45604
+成 44880
+各 44807
+事 44769
+号 44621
+人民 43719
+至 43708
+叫 43431
+社会 43401
+两 43011
+知道 42780
+当 42694
+三 42542
+本 42207
+无 42181
+此 41979
+们 41212
+家 41022
+这种 40923
+长 40281
+市 40141
+门 39823
+起来 39788
+地区 39590
+如果 38374
+开始 38139
+正 37763
+技术 37664
+同 37578
+重要 37557
+吃 36799
+美国 36089
+天 35979
+成为 35966
+因为 35698
+比 35305
+米 35136
+外 35084
+通过 35063
+研究 35029
+文化 34860
+企业 34826
+即 34677
+分 34660
+北京 34488
+历史 34460
+世界 34387
+问 34296
+话 34240
+其中 34173
+现在 34145
+天安门 34010
+不能 33939
+打 33853
+一些 33468
+老 33423
+时间 33288
+自 33152
+副 33113
+生产 32898
+情况 32833
+代表 32777
+决定 32770
+湖北 32652
+跟 32393
+笑 32256
+则 32160
+关系 32105
+像 31874
+领导 31739
+生活 31550
+时候 31521
+一种 31355
+可能 31213
+出来 31017
+学生 30776
+以及 30775
+石首 30746
+较 30431
+一般 30311
+同时 30245
+认为 30204
+由于 30181
+国 29996
+死 29983
+省 29951
+住 29609
+所以 29396
+万 29391
+不同 29383
+这里 29358
+学院 29249
+因 29186
+曾 29107
+两个 28947
+元 28837
+呢 28623
+作为 28567
+手 28466
+会议 28363
+达 28255
+头 28177
+但是 28055
+作 28016
+该 27977
+女 27951
+开 27900
+路 27626
+部分 27619
+约 27535
+活动 27526
+需要 27430
+怎么 27339
+军 27200
+管理 27191
+方面 26963
+市场 26927
+组织 26922
+工业 26775
+建设 26381
+称 26275
+名 26255
+二 26135
+每 26048
+属 26010
+形成 25854
+谁 25842
+受 25796
+其他 25753
+带 25733
+进 25668
+出现 25633
+许多 25601
+先 25558
+应 25537
+吧 25526
+县 25322
+日本 25307
+之间 25306
+一定 25293
+心 25236
+具有 25225
+占 25139
+城市 25084
+人民代表大会 25030
+以上 24969
+处 24967
+区 24952
+太 24899
+政治 24866
+人们 24841
+委员会 24831
+发现 24826
+点 24685
+记者 24649
+国际 24601
+规定 24568
+机关 24504
+水 24314
+武汉 24302
+南 24296
+虽然 24267
+根据 24221
+法律 24213
+影响 24144
+包括 24052
+发生 24052
+使用 24035
+张 23973
+中心 23969
+教育 23961
+要求 23944
+今 23913
+间 23632
+总 23585
+回 23572
+山 23539
+请 23523
+者 23521
+政府 23452
+党 23403
+段 23395
+法 23361
+连 23315
+因此 23294
+人口 23243
+站 23194
+建立 23118
+第 23112
+共 22996
+参加 22828
+产品 22801
+武汉市 22783
+而且 22638
+另 22635
+生 22579
+一样 22569
+面积 22455
+各种 22183
+全 22165
+提出 22139
+行 22128
+我国 22114
+作用 22078
+皇帝 22050
+倒 21994
+快 21973
+必须 21884
+行政 21860
+派 21853
+啊 21810
+编辑 21691
+职业 21581
+制度 21517
+以后 21386
+你们 21386
+相 21292
+吗 21245
+说道 21168
+世纪 21100
+为了 21073
+应该 21067
+之一 21053
+经 21042
+按 21008
+之后 20879
+主席 20859
+找 20856
+口 20778
+均 20776
+系统 20602
+有关 20573
+组成 20572
+种 20538
+民族 20524
+基本 20479
+增长 20465
+字 20380
+自然 20269
+入 20209
+大学 20025
+真 19988
+拿 19956
+城 19953
+马 19918
+如何 19871
+政体 19865
+各级 19839
+干 19811
+人员 19810
+计划 19799
+王 19704
+不会 19515
+产生 19495
+时期 19421
+据 19409
+最后 19355
+一次 19249
+表示 19238
+机构 19209
+当时 19195
+大家 19177
+特别 19119
+四 19090
+子 19089
+改革 19018
+书 18993
+长江 18930
+那么 18923
+这么 18886
+东西 18877
+能力 18874
+湖北省 18868
+革命 18856
+仍 18627
+一直 18596
+基础 18510
+难 18505
+还有 18487
+得到 18464
+进入 18436
+运动 18435
+目前 18396
+西 18324
+东 18279
+少 18155
+对于 18058
+过程 18051
+方法 18045
+次 17947
+些 17919
+举行 17900
+钱 17871
+北 17860
+群众 17849
+气 17826
+第一 17725
+近 17557
+杀 17552
+单位 17532
+清 17519
+如此 17518
+学 17482
+孩子 17465
+所有 17464
+资源 17453
+过去 17444
+不过 17372
+强 17342
+条件 17290
+写 17024
+学校 17020
+既 17016
+若 17000
+往 16974
+台 16964
+原 16926
+提高 16882
+工程 16869
+发 16840
+任 16831
+环境 16811
+美 16809
+未 16803
+提供 16799
+方式 16797
+百科 16728
+报告 16715
+杨 16649
+或者 16633
+下来 16620
+兵 16574
+军事 16552
+部门 16543
+分别 16537
+十分 16428
+上海 16377
+完成 16365
+一切 16361
+那些 16360
+非 16318
+除 16316
+最大 16299
+边 16283
+位 16243
+然后 16239
+农业 16233
+经过 16218
+专业 16214
+准备 16199
+增加 16195
+鱼 16190
+获得 16128
+旅游 16124
+明 16120
+设 16042
+一声 16017
+觉得 15995
+一起 15976
+理论 15973
+今天 15960
+非常 15958
+中央 15954
+州 15951
+直 15928
+比较 15910
+定 15882
+表现 15867
+越 15864
+身 15789
+价值 15777
+国务院 15768
+重 15718
+声 15713
+科技 15691
+五 15665
+完全 15627
+亿元 15600
+有些 15584
+存在 15579
+低 15504
+达到 15471
+内容 15468
+坐 15447
+儿 15411
+实行 15400
+思想 15387
+事情 15354
+极 15314
+实现 15301
+甚至 15239
+人民政府 15227
+任务 15213
+选举 15208
+形式 15188
+放 15161
+原来 15084
+金 15074
+有人 15007
+突然 14998
+不断 14972
+咱们 14946
+变化 14935
+红 14915
+心中 14906
+直接 14906
+朝 14885
+爱 14878
+知 14870
+主 14838
+战争 14823
+可是 14820
+不知 14806
+政策 14792
+不要 14786
+您 14737
+资本 14732
+继续 14690
+年代 14659
+期间 14656
+位于 14654
+报 14647
+任何 14635
+权力 14581
+那个 14550
+类 14536
+执行 14504
+先生 14470
+解决 14468
+早 14429
+建筑 14397
+能够 14382
+自治区 14344
+虽 14338
+面 14337
+称为 14328
+结构 14200
+一点 14165
+别 14132
+成立 14079
+初 14072
+英国 14049
+社会主义 13995
+统一 13986
+先进 13966
+结果 13963
+投资 13943
+后来 13932
+一下 13924
+水平 13880
+保护 13874
+主任 13853
+性 13847
+关于 13762
+型 13750
+负责 13745
+制定 13662
+艺术 13626
+讲 13587
+部 13579
+利用 13559
+送 13539
+于是 13536
+远 13523
+反 13500
+学习 13482
+合作 13478
+科学 13460
+看到 13411
+指 13375
+乡 13348
+项目 13257
+不敢 13208
+襄阳 13196
+方 13166
+几个 13134
+那里 13132
+甚 13115
+公里 13083
+完 13042
+常 13042
+服务 13036
+意 12995
+队 12982
+精神 12961
+涓 12958
+人大 12955
+式 12938
+全省 12895
+当然 12865
+左 12823
+拉 12817
+个人 12744
+超过 12732
+花 12710
+电 12701
+重点 12694
+很多 12687
+原因 12682
+及其 12663
+公民 12636
+锛 12605
+希望 12597
+条 12583
+新闻 12562
+剑 12553
+神 12550
+动 12509
+设计 12474
+湖 12466
+相关 12463
+国内 12450
+分布 12435
+最高 12430
+部队 12400
+河 12374
+它们 12359
+集团 12298
+白 12266
+信息 12256
+官 12197
+师 12194
+仅 12193
+采用 12193
+重大 12193
+教 12172
+哪 12138
+回来 12128
+变 12121
+图 12112
+范围 12101
+平 12100
+引起 12074
+实际 12010
+其实 11950
+不仅 11895
+取得 11882
+丰富 11867
+农民 11866
+整个 11839
+为主 11807
+了解 11774
+瞭解 11774
+价格 11762
+府 11760
+热 11755
+制 11750
+望 11746
+岁 11719
+甚么 11717
+地位 11660
+这时 11628
+转 11622
+建 11608
+命 11603
+劳动 11598
+按照 11557
+加 11537
+控制 11537
+目标 11527
+基地 11481
+农村 11478
+传统 11445
+管 11444
+场 11435
+受到 11428
+跑 11414
+全部 11398
+法国 11361
+穿 11359
+众 11353
+比赛 11336
+么 11322
+军队 11317
+黑 11296
+土地 11240
+规模 11239
+怕 11213
+荆州 11210
+风 11195
+眼睛 11190
+信 11188
+左右 11169
+选择 11160
+批准 11157
+置 11145
+急 11126
+身上 11123
+专家 11094
+分析 11086
+统治 11085
+加强 11080
+不到 11072
+石 11067
+铁路 11065
+黄 11062
+镇 11036
+百度 11008
+每年 10986
+取 10965
+告诉 10953
+支持 10928
+交通 10908
+光 10895
+明白 10875
+竟 10875
+处理 10840
+本级 10813
+作战 10812
+时代 10799
+力 10777
+皇上 10776
+实施 10713
+数 10689
+只要 10675
+曰 10675
+亲 10670
+传 10664
+深 10646
+成功 10638
+办法 10633
+随着 10614
+之中 10597
+进一步 10588
+心里 10571
+脸 10566
+终于 10542
+大量 10535
+买 10529
+人物 10520
+接 10515
+共同 10510
+全国人民代表大会 10505
+茶 10497
+下去 10474
+且 10470
+多少 10457
+来说 10451
+严重 10445
+词条 10441
+接受 10415
+收入 10403
+以来 10402
+城邦 10341
+声音 10340
+意见 10329
+办 10314
+过来 10268
+保持 10261
+今日 10258
+待 10253
+文 10253
+一条 10251
+发言人 10241
+另外 10239
+提 10223
+坚持 10215
+工人 10209
+系 10196
+汽车 10193
+而是 10185
+量 10182
+敌人 10175
+商品 10160
+忙 10154
+说话 10146
+改变 10142
+尽 10117
+先后 10115
+力量 10069
+喝 10053
+事业 10025
+开发 10022
+看见 10022
+战略 10013
+标准 10012
+是否 9996
+中华人民共和国 9989
+郡 9988
+车 9985
+出去 9979
+今年 9959
+报道 9955
+程度 9953
+国民党 9949
+平均 9932
+消息 9926
+还是 9922
+安全 9921
+独立 9907
+啦 9891
+刚 9881
+注意 9876
+机会 9875
+韦小宝 9863
+级 9853
+背 9853
+父亲 9846
+此外 9832
+这次 9832
+经验 9803
+采取 9791
+喜欢 9783
+根本 9780
+领域 9771
+具体 9769
+联系 9767
+几乎 9754
+离 9736
+故 9727
+干部 9717
+印度 9701
+言 9691
+如今 9684
+大会 9681
+海 9676
+不可 9674
+体 9670
+座 9662
+酒 9653
+兄弟 9644
+通 9628
+德国 9604
+满 9603
+呀 9580
+阶段 9574
+李 9566
+令 9565
+飞 9563
+为什么 9561
+感到 9561
+流 9559
+无法 9557
+宪法 9553
+德 9549
+似乎 9544
+原则 9541
+真正 9540
+法规 9539
+斗争 9529
+足 9485
+瞧 9467
+人类 9464
+合 9453
+右 9451
+目的 9440
+造成 9428
+罢 9424
+一位 9387
+反对 9366
+儿子 9364
+相同 9364
+亦 9343
+以前 9343
+众人 9329
+建议 9327
+迅速 9323
+全面 9321
+正是 9307
+明显 9296
+改 9286
+就是 9283
+刀 9271
+欧洲 9256
+破 9250
+作品 9248
+首先 9248
+集中 9232
+正式 9212
+朋友 9200
+龙 9197
+需 9183
+第二 9146
+召开 9138
+材料 9137
+帮助 9128
+飞机 9091
+宽 9081
+委员 9071
+行动 9066
+必 9063
+保证 9062
+现象 9054
+病 9053
+特点 9047
+随 9036
+现代 9035
+实际上 9032
+资料 9021
+须 8997
+毛泽东 8994
+同志 8992
+居 8992
+呈 8976
+行为 8963
+结束 8944
+直辖市 8933
+介绍 8926
+汉 8921
+只见 8906
+胜利 8904
+认识 8901
+群 8891
+算 8888
+战 8864
+眼 8863
+人数 8857
+姑娘 8853
+积极 8844
+安 8837
+家庭 8831
+靠 8829
+之前 8828
+更加 8824
+回答 8822
+藏 8819
+武器 8812
+那样 8812
+应用 8796
+市长 8782
+来到 8779
+落 8776
+设立 8773
+项 8766
+产业 8756
+公路 8748
+首 8747
+人才 8742
+意义 8729
+掌 8714
+台湾 8683
+街 8680
+容易 8676
+长期 8664
+铁 8654
+一句 8647
+物 8620
+大道 8614
+扩大 8594
+官员 8591
+调整 8590
+考虑 8585
+林 8581
+联合 8569
+并且 8563
+土 8560
+自由 8558
+菜 8544
+现 8541
+率 8539
+仍然 8526
+拥有 8523
+火 8518
+民主 8513
+专门 8512
+桥 8499
+附近 8499
+股 8493
+象 8475
+一天 8470
+师父 8468
+古 8464
+结合 8462
+跳 8462
+清楚 8451
+说明 8446
+某 8444
+稳定 8439
+古代 8438
+惊 8417
+搞 8414
+三个 8409
+药 8404
+冲 8402
+双 8395
+六 8392
+此时 8381
+情 8363
+物质 8354
+电话 8354
+网络 8352
+利益 8351
+然而 8350
+很大 8348
+鄂 8347
+掉 8344
+不少 8340
+中学 8338
+立 8334
+开放 8332
+调查 8332
+乱 8329
+周 8326
+几 8320
+人家 8314
+承 8308
+村 8299
+重新 8297
+广泛 8276
+分为 8259
+知识 8254
+别人 8252
+综合 8250
+出口 8237
+收 8235
+动物 8230
+速度 8218
+半 8201
+总理 8200
+脸上 8188
+用于 8181
+设备 8176
+女人 8175
+股东 8174
+作出 8169
+展开 8163
+措施 8163
+通常 8161
+电子 8154
+方向 8151
+导弹 8148
+小时 8143
+供 8136
+平民 8135
+行业 8127
+特色 8092
+货币 8092
+完善 8085
+地理 8066
+功能 8056
+香港 8044
+巨大 8034
+发表 8028
+性质 8018
+母亲 8010
+质量 8009
+九 8003
+命令 8003
+活 8000
+和平 7998
+监督 7969
+一年 7966
+敢 7965
+身体 7962
+余 7959
+获 7931
+兼 7927
+十 7926
+特 7898
+面前 7896
+武功 7887
+位置 7886
+体系 7876
+越来越 7867
+逐渐 7853
+指出 7847
+安排 7836
+权利 7829
+训练 7829
+船 7819
+考试 7815
+指挥 7805
+却是 7797
+顶 7797
+指导 7794
+发布 7785
+除了 7785
+从而 7775
+断 7773
+感觉 7767
+似 7763
+努力 7757
+毛 7753
+机场 7741
+业务 7737
+植物 7735
+同样 7733
+调 7728
+状态 7715
+人民代表大会常务委员会 7713
+吴 7711
+二人 7705
+形 7698
+线 7688
+银行 7684
+忽 7683
+书记 7681
+治疗 7678
+七 7675
+一阵 7671
+所谓 7657
+这位 7656
+反应 7652
+语言 7647
+久 7645
+忽然 7645
+史 7637
+当年 7633
+以下 7611
+找到 7607
+青年 7606
+宣布 7604
+恢复 7600
+离开 7600
+困难 7599
+辖 7589
+英 7579
+事件 7578
+卖 7578
+哪里 7571
+血 7567
+画 7559
+轻 7559
+实在 7557
+见到 7522
+装备 7520
+某些 7518
+皆 7511
+治 7500
+其它 7498
+问道 7484
+权 7482
+哭 7478
+万元 7476
+空 7470
+检察院 7469
+记 7460
+平原 7457
+经营 7456
+多年 7450
+往往 7443
+苏联 7443
+男 7434
+广场 7424
+八 7422
+解释 7421
+因素 7420
+队伍 7420
+教授 7419
+喜 7380
+论 7378
+医院 7376
+伤 7363
+架 7352
+归 7299
+怎样 7294
+男人 7291
+双方 7289
+退 7280
+减少 7275
+考生 7274
+当地 7272
+游 7272
+实 7258
+多种 7255
+差 7254
+经常 7253
+各地 7252
+姓 7240
+生物 7238
+平方千米 7232
+南京 7228
+海军 7228
+外交部 7223
+真是 7219
+朱 7218
+网 7209
+心想 7205
+外国 7204
+财产 7202
+皮 7184
+全市 7178
+刺 7172
+睡 7171
+本来 7161
+两种 7154
+有效 7151
+尤其 7135
+选 7132
+一边 7114
+用户 7103
+科 7098
+方案 7097
+进攻 7091
+常委会 7079
+机械 7077
+关 7068
+化 7067
+浜 7066
+参与 7060
+充分 7052
+骂 7047
+从事 7036
+尽管 7018
+特殊 7015
+又称 7008
+紫文 7008
+底 7005
+一块 7001
+手段 6991
+生命 6986
+銆 6982
+便是 6978
+体育 6976
+院 6957
+含 6950
+居民 6948
+洞 6944
+天安门广场 6940
+生长 6940
+内部 6937
+机 6937
+立即 6937
+简单 6935
+一片 6931
+全国人大常委会 6930
+数量 6927
+有时 6917
+开展 6910
+色 6908
+阿 6905
+装 6904
+文学 6890
+理 6890
+进来 6878
+决议 6873
+显示 6872
+属于 6871
+驻 6848
+也许 6846
+产 6838
+中国共产党 6832
+气候 6832
+一只 6814
+铺 6811
+来自 6801
+领 6800
+还要 6790
+相信 6789
+确定 6779
+投入 6776
+一面 6773
+普通 6768
+看着 6766
+块 6749
+公元 6748
+怎 6736
+去年 6730
+文章 6728
+封 6721
+手中 6721
+心理 6715
+谈 6712
+诸 6711
+觉 6705
+团 6703
+女儿 6700
+封建 6694
+图片 6689
+创造 6687
+肉 6683
+期 6681
+总统 6679
+岛 6677
+促进 6674
+大型 6672
+带来 6670
+代 6666
+短 6662
+旧 6655
+嘴 6654
+挂 6653
+留 6648
+教师 6642
+民 6640
+竟然 6640
+较大 6637
+看来 6636
+若干 6633
+反映 6618
+每个 6618
+每天 6612
+敌 6610
+食品 6610
+坐在 6601
+文明 6584
+京 6583
+相当 6568
+诗 6567
+变成 6555
+资金 6555
+放在 6549
+毫米 6548
+中间 6547
+夫人 6539
+愿 6538
+墓 6535
+相对 6532
+世 6523
+大陆 6521
+职权 6518
+西方 6517
+不再 6513
+第一次 6509
+举 6506
+殿 6506
+导致 6498
+帮 6493
+抓 6491
+换 6483
+件 6482
+对方 6479
+应当 6476
+人民法院 6465
+求 6465
+讨论 6464
+即使 6462
+脚 6457
+金融 6455
+爱国 6444
+证明 6425
+制造 6418
+构成 6416
+老师 6415
+担任 6409
+房 6407
+区域 6406
+郭靖 6406
+一座 6401
+殑 6399
+院长 6397
+计算机 6396
+家里 6390
+错误 6379
+曾经 6371
+宫 6360
+云 6353
+食 6350
+错 6340
+牛 6339
+拜 6338
+保障 6335
+饭 6331
+推 6324
+天安门城楼 6323
+创作 6322
+强调 6318
+连续 6317
+状 6316
+运输 6308
+复 6297
+看看 6297
+句 6294
+分钟 6285
+主张 6284
+商业 6280
+高速 6279
+有限公司 6278
+真的 6277
+克 6270
+救 6268
+只能 6263
+天下 6255
+贸易 6255
+不得 6248
+境内 6247
+楼 6245
+体制 6243
+胜 6242
+因而 6235
+例如 6232
+具 6228
+财政 6228
+肯定 6227
+成绩 6217
+一件 6216
+音乐 6216
+建成 6215
+斗 6215
+回去 6209
+层 6209
+难道 6207
+接着 6206
+集 6174
+片 6168
+正确 6167
+现代化 6157
+石首市 6148
+奔 6140
+以为 6133
+章 6128
+处于 6122
+大臣 6120
+届 6120
+高度 6119
+复杂 6117
+社区 6115
+木 6114
+身子 6107
+回到 6103
+媒体 6101
+俄罗斯 6099
+怪 6097
+地面 6096
+分子 6094
+总是 6089
+意思 6089
+江 6083
+东北 6082
+销售 6078
+弟子 6071
+未来 6069
+等等 6063
+重视 6063
+压力 6044
+抱 6044
+良好 6044
+到底 6043
+职 6037
+拍 6035
+各个 6029
+最终 6028
+战斗 6027
+交 6026
+航空 6021
+表 6017
+降 6014
+之下 6012
+田 6010
+营 6010
+修 6007
+一级 6006
+里面 5994
+数学 5993
+全国人民代表大会常务委员会 5988
+毕业 5988
+竞争 5985
+宗教 5982
+比例 5973
+考 5973
+健康 5971
+适应 5971
+员 5970
+她们 5966
+出版 5962
+银 5956
+故事 5953
+责任 5946
+欲 5942
+何 5940
+面对 5937
+将军 5935
+停 5934
+界 5931
+粮食 5923
+解 5923
+政权 5919
+草 5919
+各国 5912
+类型 5899
+发挥 5889
+听说 5887
+遗址 5882
+南北 5877
+立刻 5871
+亚洲 5863
+剉 5860
+正常 5860
+女子 5858
+培养 5850
+就业 5847
+紫 5847
+大小 5841
+刘 5839
+善 5835
+香 5826
+单 5823
+晚 5823
+秘书长 5821
+明确 5812
+优势 5811
+吨 5810
+亚 5789
+抢 5785
+弄 5784
+好像 5782
+一场 5781
+列 5778
+至少 5775
+人士 5774
+晚上 5770
+特征 5767
+特徵 5767
+确实 5767
+贡献 5762
+质 5760
+实验 5742
+铜 5739
+高级 5737
+想到 5735
+词 5735
+厂 5728
+暖 5727
+感 5722
+关键 5721
+凭 5717
+一眼 5692
+周围 5681
+难以 5681
+样子 5676
+本报 5675
+追 5675
+帝国主义 5674
+紧 5672
+题 5668
+自治州 5667
+臣 5666
+一张 5665
+油 5665
+相互 5664
+读 5660
+是不是 5655
+部长 5654
+球 5650
+守 5648
+俄 5645
+功 5641
+身边 5641
+广州 5640
+端 5639
+三年 5637
+之外 5635
+散 5634
+蒙古 5631
+义 5628
+公 5628
+发出 5628
+只有 5622
+比如 5621
+理解 5620
+刚才 5617
+不足 5615
+南部 5612
+攻 5612
+程序 5610
+陈 5608
+加上 5605
+礼 5605
+西北 5598
+父母 5593
+一中 5590
+适当 5584
+青 5581
+留下 5578
+李自成 5577
+研制 5567
+省级 5563
+发达 5557
+东部 5551
+全国人大 5551
+引 5550
+品种 5542
+愿意 5538
+道路 5532
+投 5530
+类似 5527
+空军 5522
+行使 5519
+目 5518
+细胞 5517
+树 5516
+寺 5512
+空间 5511
+形势 5510
+西部 5505
+小说 5501
+妇女 5485
+股份 5479
+维护 5477
+公布 5476
+岗 5473
+名称 5468
+只好 5466
+检查 5464
+墙 5461
+化学 5457
+任期 5456
+儿童 5456
+矛盾 5456
+颇 5456
+本身 5446
+规划 5445
+状况 5444
+一家 5441
+实践 5441
+尚 5434
+成长 5432
+凡 5431
+需求 5424
+邦 5417
+根 5413
+分配 5410
+多次 5410
+末 5409
+一致 5406
+西南 5401
+一时 5389
+既然 5389
+贵族 5389
+高兴 5387
+全体 5382
+枪 5382
+美元 5382
+统计 5381
+事务 5380
+体现 5372
+运 5366
+值 5361
+名字 5359
+不了 5358
+产量 5358
+始终 5358
+最近 5355
+长安 5355
+英雄 5354
+港 5353
+从此 5348
+同意 5348
+一步 5346
+响 5346
+海拔 5345
+掌握 5342
+普遍 5341
+宜昌 5336
+招生 5330
+攻击 5330
+天然 5328
+不错 5322
+势 5320
+亲自 5315
+鬼 5314
+一旦 5312
+相应 5312
+观点 5306
+实力 5304
+中部 5299
+批 5299
+听到 5294
+再次 5293
+更是 5290
+包 5289
+突出 5289
+全球 5288
+条例 5288
+人大代表 5284
+替 5281
+司 5271
+公元前 5270
+卷 5269
+经历 5266
+进步 5265
+上述 5261
+抗日 5260
+旁 5260
+绝对 5260
+修改 5259
+夏 5257
+优秀 5256
+至于 5254
+河流 5249
+发动 5248
+招 5248
+武 5241
+审议 5238
+计算 5235
+数据 5232
+冒 5231
+关注 5229
+黄蓉 5221
+族 5215
+女性 5214
+职工 5213
+贴 5204
+硬 5193
+资本主义 5188
+必要 5175
+朝廷 5174
+利 5167
+不肯 5166
+表明 5166
+坛 5158
+委员长 5156
+主持 5153
+常常 5147
+冷 5144
+遇到 5143
+在于 5141
+玉 5140
+腿 5139
+答 5137
+消费 5133
+欢迎 5132
+保 5119
+想起 5118
+日子 5113
+条约 5111
+协议 5108
+公开 5094
+行政区划 5093
+限制 5089
+现实 5080
+武昌 5077
+强烈 5076
+照 5076
+早已 5070
+的话 5054
+衣服 5052
+假 5051
+板 5049
+太阳 5044
+御 5044
+河南 5044
+广大 5043
+易 5042
+正在 5041
+胖子 5038
+很快 5033
+态度 5033
+贼 5032
+不好 5027
+交流 5027
+万吨 5026
+前面 5024
+森林 5024
+显然 5015
+妈 5014
+有所 5013
+闯王 5004
+一部分 4998
+撤销 4998
+度 4995
+千米 4992
+破坏 4991
+双手 4990
+江南 4986
+阅读 4979
+上面 4976
+宋 4976
+工资 4976
+专 4975
+熟 4974
+纷纷 4973
+计 4965
+北部 4963
+忘 4957
+种类 4950
+赴 4943
+无论 4936
+深入 4933
+推进 4932
+石油 4930
+进去 4929
+射 4926
+地上 4925
+效果 4924
+团体 4923
+倘若 4919
+年轻 4919
+核心 4919
+电影 4918
+纺织 4914
+形象 4911
+页 4911
+果然 4909
+意识 4907
+常务 4906
+乃 4905
+垸 4893
+显得 4888
+规律 4888
+巴 4887
+平等 4885
+咱 4884
+支 4883
+山东 4881
+厚 4878
+加工 4877
+只是 4875
+洪山区 4872
+烧 4867
+两侧 4863
+列为 4856
+加入 4855
+大部分 4855
+解放 4855
+新华社 4854
+微 4852
+逐步 4848
+虎 4845
+担心 4839
+下降 4833
+电视 4833
+表面 4833
+布 4827
+节 4822
+工具 4819
+第三 4818
+网站 4817
+升 4813
+制作 4804
+设施 4803
+天津 4801
+成果 4800
+各项 4798
+依 4797
+预算 4797
+苦 4796
+绝 4795
+寡头 4793
+紧张 4790
+手机 4789
+翻 4788
+令狐冲 4787
+一系列 4786
+势力 4786
+记载 4784
+外交 4781
+逃 4781
+搜狐 4777
+众多 4769
+唐 4769
+一把 4755
+春 4753
+年底 4750
+文物 4746
+宣传 4745
+闻 4745
+奇 4743
+略 4740
+日常 4737
+检察长 4735
+一名 4733
+甲 4733
+高中 4732
+严格 4728
+令人 4728
+不管 4727
+领导人 4726
+满足 4725
+推动 4715
+楚 4713
+下午 4712
+喊 4712
+地下 4708
+昨天 4707
+随后 4706
+认真 4703
+大声 4698
+生于 4690
+成员 4686
+明代 4686
+创新 4681
+不久 4679
+遭到 4679
+培训 4676
+持续 4676
+份 4674
+班 4674
+数字 4670
+广 4658
+怒 4653
+游客 4650
+试验 4648
+孙 4645
+绣 4645
+阶级 4640
+似的 4637
+发射 4636
+文字 4631
+天门 4627
+版 4626
+坏 4624
+组 4621
+来看 4619
+叶 4617
+城楼 4615
+轮 4615
+公园 4609
+哥 4609
+空中 4608
+软件 4601
+习惯 4593
+事实 4593
+地球 4590
+决 4589
+浠 4587
+一半 4586
+压 4586
+刚刚 4585
+手里 4583
+弹 4578
+武装 4577
+不但 4575
+显 4574
+任免 4568
+张居正 4564
+不想 4563
+语 4563
+室 4562
+意大利 4556
+距离 4556
+永远 4554
+业 4553
+年间 4552
+摸 4552
+互相 4546
+人大常委会 4544
+庙 4541
+稍 4540
+素 4533
+一段 4532
+妻子 4532
+妈妈 4525
+扑 4520
+眼前 4518
+赶 4518
+元年 4512
+对象 4511
+迎 4509
+听见 4507
+四个 4507
+变得 4499
+彻底 4498
+宝 4496
+毕竟 4492
+快速 4491
+朝鲜 4488
+失败 4487
+临时 4483
+果 4482
+贵 4478
+一批 4476
+炒 4473
+利润 4472
+多数 4471
+那时 4465
+路线 4463
+闹 4458
+抬 4456
+擡 4456
+其余 4454
+雨 4450
+齐 4450
+划分 4449
+唯一 4448
+坦克 4443
+尚书 4437
+骑 4437
+概念 4435
+来源 4434
+盐 4433
+充满 4432
+降低 4432
+卒 4428
+四大 4428
+慢慢 4427
+星 4424
+老人 4424
+哩 4421
+符合 4421
+发布会 4417
+客 4415
+小姐 4414
+摆 4413
+哲学 4412
+借 4411
+折 4410
+负 4410
+轻轻 4408
+北平 4403
+老板 4402
+击 4400
+这件 4399
+戴 4398
+同学 4396
+校名 4391
+细 4388
+山地 4384
+美军 4384
+呆 4383
+那种 4377
+奉 4375
+办公室 4373
+率领 4373
+录取 4370
+观 4368
+唱 4365
+华 4364
+矿产 4364
+当下 4358
+毒 4357
+别的 4354
+泥 4354
+味 4348
+咨询 4342
+小龙 4342
+几天 4341
+伸手 4337
+五年 4330
+各自 4330
+自身 4326
+渐 4325
+判断 4324
+哥哥 4323
+始 4321
+横 4321
+失去 4317
+危机 4310
+后面 4308
+亮 4307
+配合 4307
+大事 4301
+增强 4300
+套 4300
+兴 4294
+卫生 4290
+金属 4290
+成本 4289
+文件 4288
+加以 4286
+步 4285
+仙 4284
+雪 4284
+丈夫 4283
+倍 4282
+必然 4280
+财富 4280
+首都 4278
+此后 4276
+购买 4275
+汗 4274
+东南 4271
+公共 4271
+医生 4263
+素质 4263
+打击 4262
+改善 4262
+生态 4260
+广东 4256
+人生 4255
+一带 4254
+改为 4253
+方针 4251
+耳 4244
+红色 4243
+承认 4242
+格 4237
+忌 4236
+英语 4236
+象征 4233
+象徵 4233
+少数 4232
+设置 4230
+核 4229
+爆发 4227
+飞行 4226
+具备 4224
+纵 4220
+器 4219
+藕 4215
+学者 4212
+否则 4209
+遂 4209
+对外 4207
+性命 4207
+玩 4207
+故道 4199
+练 4197
+疾病 4194
+随即 4194
+不禁 4190
+大明 4182
+罪 4177
+百姓 4176
+盖 4175
+缺乏 4171
+院校 4170
+功夫 4168
+距 4166
+成分 4165
+寨 4159
+吓 4156
+乘 4150
+伟大 4150
+投票 4148
+厅 4146
+叹 4144
+闭会 4143
+完整 4142
+恨 4139
+祭 4139
+机制 4138
+起义 4138
+几年 4137
+国民经济 4137
+打开 4137
+屽 4132
+村委会 4129
+考察 4128
+皇城 4127
+诏 4127
+答应 4126
+演出 4125
+相比 4125
+最早 4124
+僭 4123
+斯 4120
+吹 4119
+观念 4115
+营养 4114
+虚 4112
+运用 4112
+行者 4111
+汤 4099
+职务 4099
+本人 4096
+面临 4096
+第一个 4092
+吸收 4089
+够 4088
+讯 4086
+流通 4082
+现场 4077
+夫 4074
+弦 4072
+协调 4071
+抓住 4069
+海洋 4068
+环 4066
+精 4066
+对手 4064
+承担 4063
+交易 4060
+原料 4055
+自治县 4055
+邓小平 4055
+是从 4054
+户 4053
+针对 4053
+外面 4049
+争取 4048
+发育 4047
+桃花 4047
+挥 4046
+爬 4046
+事物 4044
+岂 4042
+风险 4042
+奴隶 4041
+少年 4039
+奏 4034
+召集 4032
+叫做 4030
+推出 4028
+持 4025
+教学 4025
+鏄 4025
+作者 4024
+亿 4023
+化工 4022
+夜 4020
+源 4016
+围 4010
+论坛 4009
+出席 4001
+局 4001
+贷款 4000
+圣 3999
+懂 3998
+东方 3993
+再也 3990
+理想 3988
+游行 3983
+存 3981
+秒 3981
+究竟 3981
+下面 3980
+危险 3979
+念 3978
+年均 3972
+娘 3971
+上来 3970
+平衡 3970
+清代 3969
+大规模 3965
+寻找 3960
+非洲 3960
+和尚 3959
+不必 3955
+兴趣 3955
+温度 3953
+骨 3946
+团结 3941
+转移 3941
+国民 3939
+插 3939
+省长 3936
+献 3934
+患者 3932
+机器 3932
+中共中央 3917
+境 3916
+病人 3916
+宝玉 3911
+密 3911
+竹 3911
+威胁 3909
+僧 3908
+而言 3906
+两次 3903
+酸 3899
+奇怪 3898
+相反 3895
+厘米 3894
+烟 3894
+估计 3893
+情绪 3892
+密切 3889
+出发 3887
+招聘 3885
+一道 3879
+大学生 3879
+传播 3877
+合理 3870
+超 3870
+苏 3869
+民间 3867
+主人 3865
+点头 3864
+蛋 3862
+隔 3859
+大约 3858
+长江大桥 3858
+依据 3856
+放心 3856
+商 3853
+国外 3852
+排 3852
+江夏区 3852
+北方 3850
+厉害 3850
+本行政区域 3850
+堂 3849
+改造 3846
+盘 3846
+明朝 3845
+固定 3844
+皇 3844
+太监 3843
+停止 3842
+争 3841
+圆 3841
+移动 3838
+一支 3837
+强大 3837
+偏 3833
+秘密 3833
+前提 3829
+避免 3827
+休息 3825
+街道 3824
+高校 3824
+推荐 3823
+顾 3823
+夏天 3821
+伊朗 3820
+产值 3813
+并非 3812
+模式 3809
+年龄 3808
+性能 3808
+演习 3808
+感情 3806
+审判 3805
+维持 3803
+费用 3802
+志愿 3801
+狗 3801
+太庙 3798
+客户 3798
+尚未 3798
+已有 3798
+极大 3796
+适合 3795
+王朝 3792
+一页 3791
+校长 3791
+一口 3789
+更新 3789
+尔 3788
+这儿 3784
+首次 3783
+宫廷 3782
+整体 3781
+自治 3780
+四川 3779
+鸡 3779
+湘 3778
+脱 3778
+区别 3776
+撞 3773
+工 3771
+资产阶级 3764
+及时 3762
+结 3761
+地方性 3760
+门外 3759
+左手 3758
+风格 3758
+巴黎 3756
+帝 3756
+江陵 3756
+早期 3755
+篇 3750
+员工 3748
+上升 3747
+版本 3743
+成熟 3742
+挺 3742
+犯 3742
+波 3739
+几句 3737
+告 3737
+南方 3736
+涉及 3734
+两年 3732
+空气 3732
+罗 3730
+软 3730
+千 3728
+国防 3728
+旁边 3728
+操作 3727
+纸 3727
+药物 3727
+试 3723
+近年来 3723
+负责人 3722
+然 3720
+梦 3719
+方便 3718
+松 3715
+全身 3714
+辽 3713
+门口 3713
+候选人 3708
+有点 3706
+不在 3705
+局面 3704
+旗 3704
+银子 3704
+绿 3701
+突破 3699
+由此 3692
+建造 3683
+各类 3682
+士兵 3681
+痛 3680
+一份 3677
+康熙 3674
+两位 3673
+转化 3673
+准 3672
+议会 3669
+乐 3667
+开发区 3667
+志 3667
+槸 3667
+心情 3662
+失 3661
+回头 3656
+帝国 3655
+害 3654
+陪 3654
+啥 3651
+十年 3649
+县级 3647
+致 3645
+基 3644
+独特 3638
+湖南 3637
+公斤 3635
+依然 3628
+证 3628
+躲 3628
+微笑 3625
+标志 3624
+确 3623
+棉花 3619
+逼 3617
+战士 3613
+十堰 3611
+大多数 3609
+丁 3607
+政 3607
+烤 3606
+规范 3602
+平台 3601
+舰 3600
+成就 3599
+炮 3599
+升级 3593
+新型 3591
+交换 3579
+拒绝 3577
+证券 3575
+大人 3573
+社 3573
+合同 3572
+直播 3572
+日期 3571
+钟 3570
+战场 3566
+高考 3565
+统治者 3561
+咬 3558
+喝道 3557
+崇祯 3557
+股权 3556
+渐渐 3555
+军警 3554
+明清 3554
+一番 3553
+废除 3551
+糖 3550
+走向 3550
+从来 3548
+反而 3547
+平时 3547
+劝 3543
+多个 3543
+举办 3541
+算是 3540
+简称 3534
+装置 3534
+宜 3533
+腰 3532
+公主 3531
+此次 3529
+沿海 3527
+痛苦 3520
+重庆 3518
+加速 3517
+不行 3515
+主动 3515
+损失 3510
+转变 3510
+共产党 3506
+市民 3506
+杩 3505
+灭 3504
+箭 3504
+之上 3503
+平方公里 3503
+侧 3498
+劳动力 3498
+发行 3498
+接近 3498
+寻 3495
+备案 3491
+重建 3491
+一生 3488
+医疗 3488
+使得 3483
+国有 3482
+湖泊 3482
+躺 3481
+加快 3480
+审查 3478
+襄 3478
+角 3477
+生存 3476
+评价 3476
+恩 3475
+规则 3473
+哦 3470
+记录 3466
+跪 3466
+遇 3465
+澶 3462
+谷 3461
+工厂 3460
+猛 3459
+印 3458
+脸色 3458
+累计 3457
+大大 3455
+异 3455
+拖 3454
+粮 3454
+祖国 3452
+阳光 3451
+右手 3450
+时刻 3448
+韩 3448
+客人 3444
+查 3443
+嘛 3437
+以外 3436
+医学 3433
+给予 3433
+原始 3432
+学科 3432
+典型 3430
+仪式 3429
+灯 3427
+电脑 3427
+抗 3426
+上去 3421
+伸 3417
+医药 3417
+店 3417
+满意 3417
+到处 3414
+做法 3412
+立场 3407
+阳 3406
+阵 3405
+害怕 3404
+进口 3404
+兄 3402
+补充 3399
+运行 3393
+北京市 3392
+地址 3386
+支付 3382
+资格 3379
+优良 3378
+日军 3377
+帐 3376
+韩国 3375
+国家级 3374
+编 3374
+博士 3373
+传说 3372
+少数民族 3371
+居住 3370
+采访 3369
+目光 3368
+食物 3368
+动力 3367
+占领 3365
+另一方面 3365
+防止 3364
+太太 3362
+土壤 3360
+异常 3360
+依照 3359
+智 3359
+作家 3358
+民族乡 3357
+行情 3357
+沿 3356
+恐怕 3355
+某种 3355
+人马 3354
+操 3353
+载 3349
+接触 3348
+暗 3348
+总结 3346
+有着 3345
+动作 3344
+种种 3344
+幸福 3342
+背景 3342
+灵 3339
+原种 3337
+江苏 3337
+百 3336
+死亡 3335
+遭 3334
+理由 3333
+部署 3333
+集体 3332
+大概 3331
+移 3331
+同一 3327
+毕业生 3323
+至今 3323
+年纪 3320
+砍 3318
+郭 3318
+人体 3316
+武当山 3316
+国王 3312
+年度 3312
+养 3311
+观察 3309
+吸引 3307
+所说 3307
+胡 3307
+做出 3306
+呼 3306
+般 3306
+手术 3305
+案件 3305
+道理 3304
+全年 3302
+初步 3302
+解放军 3298
+最佳 3297
+小学 3296
+总人口 3295
+相似 3295
+大桥 3288
+极为 3287
+仅仅 3286
+可惜 3283
+托 3283
+总额 3282
+宗 3281
+衣 3280
+男子 3274
+仗 3272
+博物馆 3272
+签订 3272
+不由得 3271
+此刻 3271
+很少 3268
+原理 3267
+点亮 3266
+艘 3265
+引用 3264
+明天 3264
+高原 3264
+大门 3262
+关心 3261
+盛 3260
+补 3259
+等于 3258
+邀请 3258
+转身 3256
+幺 3254
+授予 3254
+陆军 3253
+两者 3252
+吾 3250
+水产 3249
+笔 3249
+一大 3248
+允许 3247
+角度 3247
+费 3247
+大多 3246
+早就 3244
+上级 3243
+资产 3243
+赶快 3242
+道德 3241
+黄河 3241
+剩下 3240
+眼见 3240
+柄 3239
+改进 3234
+瓦 3228
+说法 3227
+春秋 3226
+电力 3225
+败 3221
+亿美元 3217
+弟 3217
+拱 3217
+值得 3214
+海上 3213
+岭 3212
+真实 3212
+职能 3212
+改称 3210
+毕 3209
+吩咐 3208
+学术 3206
+品牌 3205
+交给 3204
+床 3204
+科研 3204
+低声 3202
+洗 3202
+分类 3201
+季节 3201
+一会儿 3200
+上市 3199
+系列 3199
+回家 3197
+日益 3196
+止 3196
+色彩 3195
+房子 3194
+河北 3194
+饮 3189
+牌 3184
+微微 3182
+爹 3182
+设有 3182
+放弃 3181
+之所以 3180
+一会 3178
+劲 3175
+碗 3174
+冠军 3173
+居然 3172
+爷 3172
+魔 3172
+此事 3171
+万亩 3169
+选民 3169
+坚决 3168
+研究所 3162
+远远 3159
+同年 3154
+打算 3154
+君 3153
+登时 3150
+依靠 3149
+皇后 3146
+协会 3145
+这场 3143
+孔 3142
+适用 3142
+谈判 3141
+消费者 3140
+云南 3139
+队员 3137
+中共 3136
+恶 3136
+太子 3132
+通知 3130
+洲 3128
+当即 3126
+个别 3125
+仿佛 3124
+奖 3122
+术 3122
+仙桃 3117
+命运 3117
+小组 3116
+涔 3116
+棣 3115
+基金 3114
+永乐 3114
+哼 3113
+夺 3112
+猪 3108
+到达 3107
+搭 3107
+扶 3105
+颜色 3100
+不得不 3096
+头发 3096
+三峡 3095
+投降 3095
+第四 3092
+分享 3091
+陕西 3091
+十堰市 3089
+脉 3089
+上午 3088
+表演 3088
+访问 3086
+黄金 3086
+地图 3085
+而已 3085
+种植 3084
+赵 3084
+建国 3083
+一股 3082
+动手 3082
+吃饭 3082
+荆州市 3082
+联 3081
+佛 3080
+华北 3074
+有利于 3074
+保存 3073
+走出 3073
+罗马 3071
+穴 3068
+虫 3068
+周恩来 3067
+消失 3067
+抗战 3066
+网页 3066
+再说 3065
+莫 3065
+追求 3065
+大哥 3062
+价 3061
+造 3060
+露出 3058
+卫星 3057
+登 3055
+增 3054
+动态 3053
+熟悉 3053
+取消 3052
+人均 3051
+今后 3051
+流行 3049
+取出 3048
+合法 3048
+戏 3048
+竴 3048
+鸟 3048
+趋势 3047
+地势 3045
+本次 3039
+歌 3039
+用来 3037
+绕 3037
+毁 3036
+美丽 3036
+过渡 3036
+屾 3031
+第二次 3031
+蒙 3031
+深刻 3029
+向前 3028
+切 3026
+繁殖 3026
+风味 3024
+浙江 3022
+防 3021
+奶奶 3018
+地质 3014
+每次 3011
+这般 3009
+小子 3004
+犯罪 3002
+午门 3000
+老婆 3000
+尖 2999
+表达 2999
+家长 2998
+等待 2998
+通信 2998
+仔细 2997
+最初 2996
+遗产 2995
+老百姓 2994
+踢 2993
+八年 2991
+卡 2991
+父 2991
+头上 2988
+塔 2987
+鱼类 2987
+要是 2986
+听取 2984
+弱 2984
+一部 2982
+雕 2981
+风景 2981
+羊 2980
+鍙 2979
+将领 2977
+联合国 2976
+肯 2976
+母 2975
+衙门 2974
+批评 2972
+脑 2971
+侵略 2969
+人民币 2968
+结婚 2967
+正义 2965
+所在地 2962
+社稷 2962
+后者 2961
+门前 2960
+前进 2957
+提名 2957
+视 2957
+枚 2954
+决策 2953
+爹爹 2952
+初期 2951
+挡 2950
+官兵 2948
+顺利 2948
+一方面 2945
+最为 2942
+公众 2941
+婚 2941
+袁承志 2941
+首页 2940
+高速公路 2939
+包含 2938
+演员 2938
+礼部 2937
+秩序 2935
+自动 2935
+滑 2933
+根据地 2932
+沙 2932
+鍦 2932
+雄 2932
+吐 2930
+称号 2930
+雷达 2930
+广告 2928
+扎 2928
+含量 2926
+编制 2926
+客观 2924
+股票 2923
+更为 2922
+贯彻 2922
+输 2922
+一路 2921
+只得 2921
+合并 2921
+秘书 2921
+许 2921
+闯 2921
+捧 2920
+福 2920
+当选 2919
+前来 2917
+游戏 2917
+案 2916
+注重 2916
+顺 2916
+两岸 2911
+议案 2909
+激烈 2907
+一类 2906
+塞 2906
+太后 2906
+闪 2906
+港口 2904
+震 2904
+迁 2901
+主意 2900
+课 2900
+多么 2899
+尾 2899
+文艺 2892
+据说 2890
+模型 2890
+埃及 2888
+西班牙 2887
+挖 2886
+银鱼 2886
+主管 2884
+潜艇 2884
+药品 2884
+反复 2882
+反覆 2882
+或是 2882
+混 2882
+航线 2882
+大军 2880
+累 2878
+荣誉 2877
+观众 2877
+夹 2875
+一项 2874
+无人 2874
+刺激 2873
+灰 2873
+未必 2872
+前后 2870
+自然保护区 2870
+袁 2870
+黄冈 2870
+搬 2869
+申请 2869
+摇头 2864
+宋江 2862
+最新 2862
+扯 2860
+丢 2859
+独 2859
+宜昌市 2858
+跨 2858
+施 2857
+曲 2857
+含有 2856
+大师 2856
+秋 2855
+笔架 2855
+陵 2855
+本科 2853
+主体 2851
+绿色 2851
+浅 2850
+参 2849
+哪些 2849
+授 2848
+糕 2846
+斜 2842
+得名 2838
+忍不住 2838
+一套 2837
+秦 2837
+挑 2835
+擦 2833
+防御 2833
+瞎 2831
+气温 2830
+不用 2829
+亭 2829
+商人 2829
+有限 2828
+扇 2826
+中原 2825
+流域 2825
+露 2822
+注 2820
+老河 2820
+镑 2820
+大清 2819
+尼 2819
+红军 2819
+热情 2818
+将来 2817
+自我 2817
+人工 2816
+蒋介石 2812
+临 2810
+护 2810
+山区 2809
+各部 2808
+味道 2808
+想着 2808
+丝 2807
+杭州 2806
+制成 2805
+推广 2801
+深圳 2801
+片刻 2801
+立法 2801
+途径 2801
+公安 2800
+屼 2800
+所在 2800
+好吃 2799
+笉 2798
+温 2797
+次数 2795
+省内 2795
+诗人 2795
+识 2793
+醒 2793
+享受 2791
+积累 2791
+演 2790
+科学家 2789
+流动 2788
+四周 2785
+战役 2785
+授权 2785
+没想到 2783
+赛 2783
+一招 2780
+一根 2778
+抽 2778
+其次 2777
+成都 2770
+一齐 2769
+屋 2769
+氨 2769
+预计 2768
+享有 2766
+地处 2766
+治理 2766
+课程 2765
+暂时 2763
+皮肤 2763
+一层 2761
+旨 2761
+经理 2761
+马克思主义 2759
+创 2757
+发明 2757
+白色 2756
+例 2753
+战术 2753
+事实上 2752
+县长 2752
+执政 2751
+番 2751
+对付 2750
+江湖 2750
+跃 2750
+校 2749
+君主 2747
+料 2747
+牙 2746
+廊 2745
+出于 2743
+透 2743
+姐姐 2742
+走进 2742
+万公顷 2740
+内阁 2739
+出身 2739
+党中央 2737
+冲突 2737
+排列 2737
+滚 2737
+蓝 2736
+战国 2735
+骑兵 2735
+夫妇 2729
+军人 2728
+少女 2727
+袁世凯 2727
+亩 2726
+舞 2725
+审 2723
+旅 2722
+全世界 2721
+太平洋 2721
+配 2720
+之际 2719
+投诉 2719
+报纸 2719
+婚姻 2714
+校园 2714
+人人 2713
+落后 2713
+基本上 2711
+薄 2710
+物理 2709
+黄石 2709
+就算 2708
+长沙 2708
+商量 2706
+摇 2706
+路上 2706
+只怕 2702
+不许 2701
+激动 2700
+大街 2697
+怔 2697
+山西 2696
+古老 2695
+政协 2695
+杯 2695
+读者 2695
+企图 2694
+工艺 2692
+污染 2692
+能量 2692
+身份 2691
+声明 2689
+做到 2688
+协定 2687
+大典 2685
+富 2682
+纪念 2681
+这话 2680
+总面积 2679
+狼 2679
+现有 2679
+荆 2679
+音 2679
+养殖 2675
+鼓 2674
+主题 2673
+新疆 2672
+海外 2672
+伊拉克 2671
+简介 2671
+扩展 2670
+准确 2668
+珠 2668
+袭 2667
+到来 2665
+汉白玉 2665
+盆地 2664
+附 2664
+地震 2663
+正好 2661
+慢 2660
+降水量 2660
+天气 2657
+睁 2657
+四年 2655
+联盟 2655
+一度 2653
+探索 2653
+富有 2652
+不够 2651
+函数 2648
+圈 2645
+主力 2644
+即将 2643
+图案 2643
+胡秉宸 2643
+万岁 2642
+面试 2642
+废 2641
+愈 2641
+中华民族 2640
+好好 2640
+容 2640
+学会 2639
+颁 2636
+保险 2635
+学位 2634
+指示 2632
+王国 2631
+信心 2629
+储量 2628
+眼泪 2627
+要素 2627
+俄国 2626
+调节 2626
+嘉 2623
+情形 2621
+感受 2620
+气氛 2619
+呼吸 2618
+思 2618
+半天 2617
+李鸿章 2617
+修建 2616
+供应 2615
+挑战 2615
+赤 2612
+建有 2610
+钢铁 2610
+气体 2609
+组合 2609
+征 2607
+徵 2607
+拔 2607
+爱情 2606
+蜀 2606
+自主 2605
+尤 2604
+顿 2604
+丝毫 2603
+佸 2603
+赶紧 2603
+地带 2601
+抵触 2601
+提醒 2601
+紧急 2600
+粗 2598
+透露 2598
+参考 2597
+俺 2596
+西藏 2596
+丹 2594
+佛教 2594
+江西 2594
+洞庭湖 2593
+严 2591
+吉 2590
+坐下 2589
+测量 2589
+进程 2589
+延伸 2586
+文化大革命 2586
+十五 2584
+发动机 2584
+热带 2584
+禁止 2582
+继承 2580
+有力 2579
+偷 2578
+第二天 2578
+领袖 2578
+寄 2577
+西安 2576
+兴奋 2575
+循环 2575
+资本家 2575
+武昌区 2573
+氏 2573
+事项 2572
+沟通 2572
+近代 2572
+叔 2571
+初中 2570
+表决 2569
+友好 2568
+可见 2567
+两只 2566
+做好 2565
+希腊 2565
+总数 2565
+足够 2565
+鲜 2563
+歇 2562
+男性 2562
+锅 2561
+常见 2560
+形态 2559
+想法 2558
+后期 2557
+吸 2556
+大叫 2556
+无不 2556
+讲话 2555
+连接 2554
+收费 2553
+煤 2553
+提到 2552
+差不多 2548
+截止 2547
+症状 2547
+辆 2547
+吴三桂 2546
+记得 2545
+闭 2544
+检察 2542
+东京 2541
+哊 2541
+钢 2541
+颁布 2537
+高新技术 2537
+几次 2534
+详细 2532
+前往 2528
+返回 2528
+司法 2527
+大力 2526
+优质 2525
+开国 2525
+怀疑 2525
+战斗机 2525
+刻 2524
+酒店 2524
+与其 2523
+性格 2521
+影片 2519
+不如 2518
+大批 2518
+快乐 2516
+手指 2516
+浣 2516
+划 2513
+司令 2511
+总量 2510
+提升 2510
+俩 2508
+码头 2508
+总体 2506
+构造 2506
+认 2506
+妖 2505
+缺 2504
+谢 2504
+煎 2503
+脊 2503
+吻 2502
+最高人民法院 2498
+场所 2496
+尸 2496
+柳 2495
+网上 2495
+朱元璋 2494
+税 2494
+聚 2492
+一回 2491
+看法 2491
+房间 2489
+不让 2486
+说完 2484
+豆 2483
+哈 2482
+毫无 2482
+顺治 2479
+黑暗 2479
+职位 2478
+丘陵 2477
+自从 2476
+随时 2476
+之类 2475
+临床 2472
+妃 2472
+赢 2471
+之内 2470
+堆 2470
+正门 2470
+政党 2469
+任职 2468
+指标 2466
+留在 2466
+额 2464
+悠久 2461
+更好 2461
+董事会 2461
+市区 2460
+县市 2458
+轿 2458
+爸爸 2456
+抵 2455
+俘 2454
+芙 2453
+静 2453
+兵力 2452
+总产值 2452
+辐射 2452
+限 2452
+杂志 2451
+确立 2450
+光绪 2449
+总督 2447
+中华 2446
+鸿 2446
+保留 2445
+测试 2444
+监 2444
+效率 2443
+相继 2443
+私人 2443
+变革 2441
+小心 2441
+局部 2441
+摔 2440
+沉重 2440
+积 2440
+棒 2439
+景点 2436
+谈话 2436
+乾隆 2435
+木材 2435
+中央人民政府 2434
+所属 2434
+针 2434
+当前 2433
+胡斐 2433
+予以 2431
+最低 2431
+蛇 2430
+士 2429
+合适 2426
+当真 2426
+此人 2424
+镇压 2422
+伏 2420
+唉 2420
+灵魂 2420
+扩张 2419
+赏 2419
+避 2419
+分裂 2418
+选手 2418
+两天 2417
+主义 2416
+呵 2416
+生气 2416
+江泽民 2415
+将士 2414
+出售 2413
+结论 2413
+佳 2412
+密度 2412
+朕 2412
+忘记 2410
+曹操 2410
+轨道 2410
+一对 2409
+无数 2408
+个体 2407
+李高成 2407
+姑姑 2406
+决心 2405
+高手 2405
+草原 2404
+出入 2403
+信号 2402
+受伤 2402
+无产阶级 2401
+品德 2399
+岩 2399
+役 2398
+教主 2396
+沉 2396
+一遍 2395
+阁 2395
+埋 2394
+伸出 2392
+捉 2392
+老爷 2391
+探 2390
+上涨 2389
+共和国 2389
+像是 2388
+迎接 2388
+尊重 2383
+安装 2382
+沟 2382
+腐败 2377
+垂 2376
+引进 2375
+纯 2375
+赐 2375
+斩 2374
+不论 2373
+把握 2371
+鼓励 2370
+人群 2369
+搜索 2369
+最高人民检察院 2369
+钻 2369
+随州 2369
+专题 2367
+幅 2365
+猜 2365
+差别 2364
+外资 2363
+千万 2361
+有没有 2360
+依法 2359
+地点 2357
+终 2357
+命名 2356
+国家机关 2356
+简直 2356
+始建 2355
+何况 2353
+慈禧 2353
+票 2353
+有机 2352
+粉 2351
+较为 2350
+被迫 2348
+泡 2347
+读书 2347
+各省 2345
+行政区 2345
+倾向 2344
+领土 2344
+有权 2343
+英文 2343
+战胜 2342
+继 2342
+频率 2342
+布局 2340
+沪 2340
+病毒 2340
+欧 2338
+传来 2337
+生成 2337
+黑色 2337
+掷 2336
+一处 2335
+言语 2335
+输出 2335
+军阀 2334
+一下子 2333
+荷兰 2333
+怎么样 2332
+黄石市 2332
+洛阳 2331
+想象 2330
+二年 2328
+在内 2328
+就要 2328
+特产 2328
+陛下 2326
+周期 2325
+徐 2325
+自行 2325
+科学技术 2324
+军官 2322
+实验室 2321
+岗位 2321
+休 2320
+样 2320
+彩 2318
+城乡 2316
+办事 2314
+沉默 2313
+退出 2313
+宇宙 2312
+法院 2312
+当中 2311
+指数 2311
+分离 2309
+诏书 2309
+鍒 2309
+嫁 2308
+单独 2307
+剩余价值 2306
+原子 2306
+如同 2306
+工作人员 2306
+消除 2306
+马上 2306
+照片 2305
+意味着 2304
+一顿 2300
+壁 2300
+接待 2300
+鄂西 2300
+阴 2300
+竟是 2299
+组成部分 2299
+胸口 2299
+民政 2298
+牺牲 2298
+穷 2298
+选出 2298
+婆婆 2295
+矿 2295
+文库 2294
+流通股 2294
+身后 2293
+布置 2292
+砸 2292
+一身 2291
+有利 2291
+嘉靖 2290
+他人 2288
+描述 2288
+与此同时 2287
+混合 2286
+原有 2285
+顿时 2285
+备 2284
+矣 2284
+胸 2284
+感染 2282
+递 2282
+障碍 2280
+庆 2279
+桌上 2279
+品 2278
+一日 2277
+扔 2277
+神色 2277
+签署 2276
+基督教 2274
+收拾 2274
+大厅 2273
+加大 2272
+占有 2272
+故意 2271
+下岗 2270
+唐代 2269
+院子 2269
+握 2268
+煮 2268
+亚热带 2267
+合成 2266
+脑袋 2265
+城里 2264
+代替 2262
+五一 2261
+历代 2261
+两条 2260
+挤 2260
+般的 2260
+常用 2258
+正阳门 2258
+不见 2257
+峯 2257
+峰 2257
+拥 2257
+排名 2256
+目录 2256
+示威 2256
+询问 2256
+伦敦 2255
+谱 2254
+璇 2251
+长老 2251
+同事 2249
+哪儿 2244
+住房 2242
+球队 2238
+分成 2237
+半个 2233
+反抗 2233
+反动 2232
+旅行社 2232
+能源 2232
+转过 2232
+批判 2231
+昨日 2229
+讨 2229
+冷笑 2227
+得以 2227
+思维 2227
+好处 2225
+那天 2224
+涌 2219
+落实 2219
+货 2218
+为何 2217
+思考 2216
+议 2216
+嗯 2213
+攻关 2213
+夫妻 2212
+遭受 2212
+抹 2210
+亡 2209
+喂 2208
+教训 2207
+适宜 2206
+大夫 2205
+展示 2204
+武林 2204
+影 2202
+门户 2202
+三分 2201
+执 2201
+投资者 2201
+千步 2200
+盗 2199
+统 2198
+意外 2197
+愤怒 2197
+差异 2196
+灏 2196
+深处 2195
+筑 2195
+起身 2195
+城镇 2194
+当局 2194
+沙漠 2193
+指着 2192
+提前 2191
+郧阳 2191
+紧紧 2190
+青岛 2190
+消灭 2189
+见面 2189
+评论 2189
+效益 2186
+节目 2186
+赋予 2186
+懂得 2185
+水中 2184
+只不过 2183
+物资 2183
+正当 2182
+内地 2181
+宫女 2181
+尽量 2179
+福建 2179
+戏剧 2176
+转向 2176
+一门 2174
+冰 2173
+撤 2173
+土司 2172
+孙中山 2171
+忠 2170
+策略 2170
+中期 2169
+体内 2168
+以便 2167
+曹 2167
+反正 2166
+彼此 2165
+急忙 2165
+碎 2164
+夺取 2163
+普查 2163
+纲 2163
+娶 2162
+江汉平原 2162
+引导 2161
+创办 2160
+尸体 2159
+杂 2159
+麋鹿 2159
+跌 2158
+局长 2157
+照顾 2157
+尊 2156
+暗暗 2156
+模样 2155
+口味 2153
+城门 2153
+如下 2153
+抗日战争 2153
+无疑 2153
+陆续 2153
+荆门 2150
+妙 2149
+妹妹 2149
+拨 2149
+林区 2149
+请求 2149
+不变 2148
+兴建 2148
+拳 2147
+遍 2147
+主持人 2146
+说出 2145
+日前 2144
+陆 2144
+城内 2143
+上下 2142
+争夺 2142
+办理 2142
+汉子 2142
+名叫 2141
+耳朵 2141
+施工 2140
+释放 2139
+徒 2138
+得知 2137
+水面 2137
+同比 2135
+的确 2135
+乡村 2134
+人力 2134
+发起 2134
+鍏 2134
+高等 2134
+开辟 2131
+搜 2131
+口径 2130
+明星 2130
+通道 2130
+主权 2129
+大都 2129
+忍 2129
+战机 2128
+凡是 2127
+助 2127
+群岛 2127
+炸 2119
+老子 2119
+车程 2119
+防空 2119
+刑部 2118
+鎴 2117
+效应 2116
+既有 2115
+黄色 2115
+义和团 2114
+傻 2114
+宫殿 2114
+神农架 2114
+联赛 2113
+减 2112
+捕 2111
+没什么 2111
+侦察 2110
+一方 2109
+神秘 2108
+走上 2107
+京城 2105
+前者 2105
+导演 2105
+磨 2105
+节日 2105
+鏉 2105
+服从 2104
+官军 2103
+官吏 2102
+一代 2101
+国土 2101
+孝感 2101
+以北 2100
+低于 2100
+整顿 2100
+蛋白质 2097
+示范区 2096
+经典 2096
+考研 2095
+考核 2094
+又名 2093
+当初 2093
+脚步 2093
+棋盘 2090
+涨 2090
+渡 2090
+光荣 2088
+阿哥 2088
+南海 2087
+程 2087
+陈家洛 2087
+季度 2086
+蔬菜 2085
+连忙 2085
+也好 2084
+窝 2084
+黑鱼 2084
+测 2083
+内心 2081
+繁荣 2081
+下令 2080
+抛 2080
+印象 2079
+历来 2078
+壳 2078
+缓缓 2078
+覆盖 2078
+爷爷 2077
+鱼肚 2077
+会上 2076
+上年 2075
+即便 2075
+不免 2074
+律 2073
+权威 2073
+进士 2072
+库 2071
+庄严 2070
+荆楚 2070
+规 2070
+长剑 2070
+全都 2068
+聪明 2068
+加拿大 2067
+租 2067
+中华人民共和国中央军事委员会 2066
+特定 2066
+围绕 2065
+本质 2064
+奥 2062
+梁 2062
+小小的 2061
+速 2061
+义务 2059
+逻辑 2059
+麻烦 2059
+患 2058
+平面 2057
+床上 2057
+论述 2057
+航海 2056
+随便 2056
+一个个 2055
+一颗 2055
+嫩 2055
+北京大学 2053
+澳大利亚 2052
+互 2051
+线路 2050
+罚 2050
+代码 2049
+表情 2049
+剅 2048
+精彩 2048
+兽 2047
+桌子 2047
+南京市 2046
+诞生 2046
+收购 2045
+课题 2044
+济 2043
+河道 2042
+足球 2042
+以色列 2041
+缘 2041
+意志 2040
+旅游区 2039
+种子 2039
+概况 2038
+一群 2037
+不停 2035
+档 2035
+万美元 2034
+回族 2034
+瞧瞧 2034
+正要 2033
+生产力 2033
+近日 2033
+仅次于 2032
+想要 2032
+当日 2031
+不下 2030
+湾 2030
+湿 2030
+舞蹈 2030
+中国队 2029
+声响 2029
+干涉 2029
+汉族 2029
+岩石 2028
+入侵 2027
+辛亥革命 2027
+双眼 2026
+职司 2026
+弯 2025
+总部 2024
+缩 2024
+馆 2024
+不住 2023
+并未 2023
+沿革 2023
+派出 2022
+热闹 2022
+进展 2022
+为首 2021
+部落 2021
+烈 2019
+翻译 2019
+创建 2017
+巩固 2017
+预防 2016
+形状 2015
+所长 2015
+巨 2014
+祭祀 2014
+便宜 2013
+改名 2013
+预测 2013
+寒 2011
+各位 2010
+等级 2010
+魏 2010
+相连 2009
+眼光 2009
+取代 2007
+海藻 2007
+东汉 2005
+宣告 2005
+汉口 2005
+评为 2005
+混乱 2004
+列入 2003
+嘴里 2002
+驾 2002
+名牌 2001
+极其 2001
+甜 2001
+庆典 2000
+支援 2000
+老太太 2000
+太空 1999
+基层 1998
+爆炸 1998
+王琦瑶 1997
+神经 1997
+更名 1996
+采 1996
+深深 1995
+业绩 1992
+大致 1992
+刷 1990
+天地 1989
+己 1989
+谋 1989
+历 1988
+信任 1986
+冲击 1986
+基因 1985
+玻璃 1985
+部位 1984
+场合 1982
+平方米 1982
+遵守 1982
+人选 1981
+舞台 1981
+景 1980
+满脸 1980
+颗 1980
+出生 1979
+打破 1979
+轻松 1979
+邪 1979
+参观 1978
+郎 1978
+万平方米 1977
+委托 1977
+悬挂 1977
+盯 1977
+师傅 1976
+研发 1976
+砖 1975
+局势 1974
+孤 1973
+标 1971
+泪 1970
+任命 1969
+牵 1969
+纳 1968
+锦 1968
+赶到 1967
+步兵 1966
+确保 1965
+市委 1964
+膜 1964
+带有 1963
+热烈 1963
+神情 1963
+三级 1962
+光华 1962
+南路 1962
+学说 1962
+航运 1962
+展 1961
+津 1960
+突 1960
+血液 1960
+依次 1959
+精品 1959
+阔 1959
+图册 1957
+城墙 1957
+损害 1957
+鲜血 1956
+付 1954
+李莫愁 1953
+石头 1953
+净 1952
+平日 1952
+集合 1952
+以往 1951
+剑法 1951
+水力 1951
+缺少 1951
+雷 1951
+侍卫 1950
+全长 1950
+兴起 1950
+可怕 1950
+理性 1949
+共和 1948
+负担 1948
+那儿 1947
+代理 1946
+平静 1946
+特性 1946
+直升机 1946
+知识分子 1946
+频繁 1945
+州长 1944
+手臂 1944
+话题 1943
+饿 1943
+人间 1942
+不良 1941
+每日 1941
+会见 1940
+手上 1940
+日月 1940
+配套 1939
+生产总值 1937
+邻 1937
+不该 1936
+号称 1936
+街头 1936
+拿出 1935
+敲 1935
+一口气 1934
+倾 1934
+这时候 1934
+郭祥 1934
+维生素 1933
+鞭 1933
+整理 1932
+边缘 1932
+深度 1930
+城中 1929
+绘 1929
+屋里 1928
+扩 1928
+为止 1927
+大气 1927
+岸 1925
+姓名 1924
+眼下 1924
+原本 1923
+宋代 1923
+年轻人 1923
+扫 1923
+杖 1923
+观看 1923
+却说 1921
+推行 1921
+漂亮 1921
+通往 1921
+对待 1920
+广播 1920
+携带 1920
+画家 1920
+公告 1919
+拟 1919
+迟 1919
+那位 1919
+家族 1918
+帝王 1918
+怀 1918
+担 1918
+本地 1918
+法制 1917
+一副 1916
+一头 1916
+负责制 1916
+明日 1915
+元素 1914
+园 1914
+法庭 1914
+说不定 1914
+威 1913
+山脉 1913
+视为 1913
+侵略者 1912
+澳门 1912
+聚集 1912
+车辆 1912
+支配 1911
+上海市 1910
+信仰 1910
+炮弹 1909
+趁 1909
+猫 1908
+私 1908
+稳 1908
+撒 1907
+可怜 1906
+相当于 1906
+慌 1905
+贾 1901
+陷入 1901
+前辈 1900
+面向 1900
+大米 1898
+无比 1898
+渔业 1898
+经费 1898
+清朝 1896
+肩 1896
+总经理 1895
+省直 1895
+轰 1895
+带领 1893
+关闭 1892
+回国 1892
+抬头 1892
+沿岸 1892
+珊 1892
+请愿 1891
+颈 1891
+伊 1890
+情报 1890
+国内外 1889
+摆脱 1889
+榜 1888
+碰 1888
+外贸 1887
+承诺 1887
+浓 1887
+藏族 1887
+两名 1886
+事儿 1886
+手法 1886
+送到 1885
+宫中 1884
+绘画 1884
+利害 1882
+心头 1882
+装甲 1882
+贫困 1882
+哄 1881
+不满 1880
+农 1880
+纤维 1879
+祝 1878
+世上 1877
+亲兵 1877
+伊斯兰教 1877
+湖南省 1877
+街上 1877
+何必 1876
+服装 1876
+火箭 1876
+论文 1875
+哪个 1874
+射击 1874
+江夏 1874
+党员 1873
+勇 1873
+协助 1873
+媳妇 1871
+那边 1871
+房屋 1870
+赚 1870
+葬 1869
+器官 1868
+悬 1868
+角色 1867
+东湖 1866
+抵抗 1866
+哈哈 1864
+安徽 1864
+二级 1863
+孝 1863
+省委 1863
+不幸 1861
+增加值 1861
+恩施 1861
+纹 1861
+通用 1861
+五个 1860
+工匠 1860
+干净 1860
+改建 1860
+水系 1860
+疼 1860
+阿拉伯 1860
+十八年 1859
+当作 1859
+誉 1858
+天天 1855
+当今 1855
+渠道 1855
+冯保 1854
+遭遇 1854
+我军 1853
+洪 1853
+视频 1853
+剩 1852
+古墓 1852
+接过 1850
+达成 1850
+什么样 1849
+天空 1849
+岃 1849
+或许 1849
+零 1848
+才能 1847
+中外 1846
+名城 1846
+市场经济 1846
+管辖 1846
+子女 1845
+扩建 1845
+能否 1845
+坤 1844
+马朝旭 1844
+采购 1843
+个个 1842
+职责 1842
+兵刃 1841
+议事 1840
+首领 1840
+感动 1839
+舰队 1839
+鱼苗 1839
+光芒 1838
+老者 1838
+封锁 1836
+食用 1836
+可用 1835
+疼痛 1834
+录 1833
+买卖 1832
+尽快 1832
+选拔 1831
+武装力量 1830
+舆论 1830
+人为 1829
+南京长江大桥 1829
+重复 1829
+回过 1827
+大学士 1827
+欣赏 1827
+臭 1827
+割 1826
+律师 1826
+号召 1825
+行省 1825
+棺 1824
+缓 1824
+名单 1823
+定义 1823
+收到 1823
+财务 1822
+一辈子 1821
+瘦 1821
+摩 1820
+不利 1818
+勒 1818
+景观 1818
+十五年 1817
+宣言 1817
+莫斯科 1817
+踏 1817
+鹿 1817
+研究生 1816
+经济特区 1815
+边界 1815
+比重 1814
+互联网 1813
+人民公社 1813
+政治部 1813
+总裁 1812
+群体 1812
+阵地 1812
+一个月 1811
+内外 1811
+内战 1811
+强度 1811
+免费 1810
+启动 1810
+登记 1810
+脖子 1810
+十三 1808
+瓶 1808
+点点头 1807
+司令部 1806
+美术 1806
+孝感市 1805
+立时 1805
+复习 1804
+奠定 1804
+公子 1801
+地形 1801
+对抗 1799
+技艺 1799
+树立 1799
+黄冈市 1799
+匾额 1798
+增多 1798
+自觉 1798
+递增 1798
+伤害 1797
+杀人 1797
+演变 1797
+童 1797
+指挥部 1796
+屋子 1795
+皇家 1795
+民众 1793
+船舶 1793
+乃是 1792
+代表团 1792
+生理 1792
+宁 1791
+查看 1789
+力度 1787
+遣 1787
+鼻子 1787
+免 1786
+出土 1785
+其间 1784
+娃 1783
+忧 1783
+危害 1781
+上游 1780
+列车 1780
+截至 1780
+基于 1777
+呈现 1776
+过于 1775
+领先 1775
+麻 1772
+军民 1771
+尿 1771
+径 1771
+时机 1771
+球员 1771
+情景 1770
+筋 1770
+剧 1769
+工部 1768
+测定 1768
+物体 1768
+译 1768
+野生 1768
+柱 1767
+事故 1766
+后果 1766
+高于 1766
+健全 1765
+兵团 1764
+骗 1764
+永 1763
+鼻 1763
+封闭 1762
+胡同 1762
+决赛 1761
+变动 1761
+治安 1761
+一双 1760
+犯人 1760
+背上 1760
+差距 1759
+拆 1759
+紫禁城 1758
+纽约 1758
+微软 1757
+溜 1757
+狂 1757
+碑 1757
+肿瘤 1757
+低头 1756
+建筑群 1756
+答案 1756
+中文 1755
+月份 1754
+肥 1754
+刘备 1753
+火山 1753
+疏 1753
+蒙古族 1753
+权益 1751
+桥梁 1751
+正中 1750
+棉 1749
+鍑 1749
+初级 1748
+官方 1748
+弟兄 1748
+提议 1748
+添加 1748
+瞪 1748
+全党 1747
+风光 1746
+信用 1745
+家中 1745
+抑制 1745
+施行 1744
+疾 1743
+第二次世界大战 1743
+地主 1742
+辞 1742
+饮食 1742
+开创 1741
+大国 1739
+大将 1739
+胪 1739
+整 1738
+边境 1738
+几种 1737
+境界 1737
+晶体 1737
+禁 1737
+等候 1737
+高大 1737
+罢了 1736
+肚子 1736
+醉 1736
+打倒 1735
+翁 1735
+自成 1735
+五代 1734
+潜 1733
+瀹 1733
+辖区 1733
+险 1733
+峡 1732
+碳 1732
+名额 1731
+旁人 1731
+睡觉 1730
+阻 1730
+商议 1729
+肌肉 1729
+这方面 1729
+层次 1728
+各人 1727
+广西 1727
+此处 1727
+推翻 1726
+半晌 1725
+财 1725
+傚 1724
+固 1724
+模拟 1724
+毫不 1724
+支柱 1723
+收益 1723
+不顾 1722
+周边 1722
+玉米 1722
+奴才 1721
+传递 1719
+次日 1719
+生意 1719
+琴 1718
+波斯 1717
+精华 1717
+出门 1716
+占据 1716
+口号 1716
+川 1716
+南非 1715
+年初 1715
+肝 1715
+误 1715
+公社 1714
+华表 1714
+张无忌 1714
+诊断 1714
+名片 1713
+仪 1711
+铅 1711
+嫌 1710
+感谢 1710
+策 1710
+标语 1709
+堤 1708
+善于 1707
+必定 1706
+景区 1706
+脱离 1706
+万平方公里 1704
+何处 1703
+老人家 1703
+团长 1702
+化石 1701
+寰 1701
+文献 1701
+勾 1700
+师兄 1700
+输入 1700
+会谈 1699
+指定 1699
+沿着 1699
+引发 1697
+无限 1697
+眼里 1697
+顾问 1697
+开封 1696
+各界 1695
+荷 1694
+丧失 1693
+愣 1693
+敏感 1693
+生效 1693
+穷人 1693
+草案 1693
+灌 1692
+新鲜 1691
+盼 1691
+证书 1691
+抬起 1690
+擡起 1690
+润 1690
+运动员 1690
+锻炼 1689
+体验 1688
+臂 1688
+队长 1688
+垄断 1687
+捏 1687
+八戒 1686
+称之为 1685
+维 1685
+姑 1684
+履行 1684
+工商 1684
+城外 1683
+小麦 1683
+直径 1683
+奋斗 1682
+西北部 1681
+道教 1681
+乌 1680
+化合物 1680
+单纯 1679
+送给 1679
+千克 1678
+埃 1678
+正面 1678
+秀 1678
+维修 1678
+议论 1678
+颤 1678
+警察 1677
+凶 1674
+宏观 1673
+消 1673
+名义 1671
+瑜 1671
+述 1671
+一一 1670
+参数 1670
+爸 1670
+统帅 1670
+液 1669
+区长 1668
+蒯祥 1667
+态 1666
+格局 1666
+一手 1665
+教练 1665
+钱谦益 1665
+万历 1664
+古城 1664
+合格 1664
+娱乐 1664
+用力 1664
+前景 1663
+垜 1663
+天子 1663
+一夜 1662
+土家族 1662
+扬 1661
+依赖 1660
+槽 1660
+少林 1659
+艰难 1659
+饶 1659
+上前 1658
+变为 1658
+海底 1658
+行政区域 1658
+佳肴 1657
+动员 1657
+大地 1657
+派遣 1657
+直至 1657
+缁 1657
+党委 1656
+大体 1656
+适才 1656
+预期 1656
+不易 1655
+大队 1655
+挨 1655
+翅 1655
+世界杯 1654
+招呼 1653
+难得 1653
+不难 1652
+丫头 1652
+南湖 1652
+小型 1652
+江汉 1652
+锌 1652
+不算 1651
+手续 1651
+钉 1651
+严肃 1650
+卫 1650
+定位 1650
+流传 1650
+察 1649
+当代 1649
+东南亚 1648
+转换 1648
+总司令 1647
+提起 1647
+清政府 1647
+湁 1647
+窗 1647
+非法 1647
+公平 1646
+淡水鱼 1646
+苏州 1646
+袭击 1646
+潮 1645
+看出 1645
+娥 1643
+总产量 1643
+愿望 1643
+打死 1643
+数目 1643
+款 1642
+院士 1642
+花岗岩 1641
+小人 1640
+父子 1639
+哨 1638
+鸭 1638
+辟 1637
+跪下 1636
+黄宗羲 1636
+火炮 1635
+两边 1634
+产卵 1634
+十四 1634
+洋 1634
+网友 1634
+六年 1633
+辨 1633
+固然 1632
+勫 1631
+装饰 1631
+西汉 1631
+两代 1630
+中等 1630
+隐 1630
+外界 1629
+汉人 1629
+海峡 1629
+产物 1628
+巴西 1628
+活跃 1628
+思路 1627
+打听 1627
+水泥 1627
+交往 1626
+环节 1626
+会长 1625
+唤 1625
+弟弟 1625
+怎地 1625
+画像 1625
+王爷 1624
+八国联军 1623
+妻 1623
+持有 1623
+被捕 1623
+凉 1622
+恐 1622
+柴 1622
+海岸 1622
+半年 1621
+说起 1620
+阻止 1620
+一行 1619
+力学 1619
+十大 1619
+多半 1619
+头顶 1619
+季 1619
+阙 1619
+仍旧 1618
+刑 1618
+历时 1618
+湪 1618
+口中 1617
+岛屿 1617
+煤炭 1617
+当天 1616
+精细 1616
+闲 1616
+剂 1615
+南阳 1615
+摄 1615
+身旁 1615
+典 1614
+披露 1613
+击败 1612
+庆祝 1612
+心思 1612
+情感 1612
+伙伴 1611
+夥伴 1611
+大幅 1611
+汉语 1611
+狠 1611
+证实 1611
+单行 1610
+同期 1610
+重量 1610
+庸 1609
+待遇 1608
+生涯 1608
+贺 1608
+迷 1608
+鄂州 1608
+不怕 1607
+以南 1607
+例子 1607
+包围 1607
+惹 1607
+泉 1607
+諲 1607
+咸宁 1606
+坡 1606
+报名 1606
+温暖 1606
+激素 1605
+联邦 1605
+赛季 1605
+婴儿 1604
+模范 1604
+房地产 1603
+收集 1603
+清晰 1603
+制约 1602
+受命 1602
+事先 1601
+剪 1601
+场面 1601
+腹 1601
+伯 1600
+殖民地 1600
+客运 1599
+资 1599
+壮丽 1596
+杨过 1594
+规矩 1594
+鲁 1594
+庄 1593
+棺材 1593
+爆 1593
+紧密 1591
+半点 1590
+去世 1590
+检验 1590
+海水 1590
+配置 1590
+小孩 1589
+掩 1589
+杀死 1588
+祖 1588
+饱 1587
+北京城 1586
+报考 1586
+墩 1585
+瑞士 1585
+沔 1584
+隆 1584
+炉 1583
+现行 1583
+庞大 1582
+智慧 1581
+亏 1580
+人口数 1580
+以致 1580
+冬季 1580
+北大 1580
+蹇 1580
+远处 1580
+发掘 1579
+建制 1579
+贡献者 1579
+下游 1578
+和谐 1578
+王公 1578
+鞋 1578
+哈哈大笑 1577
+壮 1577
+黄州 1575
+匆匆 1574
+收回 1574
+繖 1574
+记忆 1574
+波兰 1573
+鱼种 1573
+农产品 1572
+可能性 1572
+安陆 1572
+概览 1572
+白雪 1572
+讲究 1572
+防守 1572
+席 1570
+民国 1570
+兵器 1569
+工程师 1569
+柔 1569
+珞 1569
+诉讼 1569
+确认 1568
+伴 1567
+创立 1567
+团子 1567
+往来 1567
+感激 1567
+无奈 1567
+援助 1566
+本事 1566
+欠 1566
+河口 1566
+脚下 1566
+一律 1565
+保卫 1565
+暗中 1565
+镜 1565
+征服 1564
+注入 1564
+深受 1564
+技巧 1563
+汇报 1563
+品质 1562
+墙上 1562
+诗歌 1562
+风景区 1562
+主席团 1561
+召 1561
+不料 1560
+干扰 1559
+脾气 1559
+长城 1559
+北纬 1558
+广阔 1558
+拐 1558
+登上 1558
+内力 1557
+大为 1557
+戒 1557
+誉为 1557
+溶液 1556
+短期 1556
+高峯 1556
+高峰 1556
+丐帮 1555
+仇 1555
+瞒 1555
+旅客 1554
+中华门 1553
+燃烧 1553
+鄂州市 1553
+仙桃市 1552
+协商 1552
+砌 1552
+荆门市 1552
+乾 1551
+凭借 1551
+分支 1551
+图书馆 1551
+木工 1551
+核武器 1551
+郑 1551
+垂直 1550
+屈家岭 1550
+莲 1550
+充 1549
+对面 1549
+一刀 1547
+冰川 1547
+垱 1547
+朗 1547
+碰到 1547
+观测 1547
+卵 1546
+压迫 1546
+可靠 1546
+家伙 1546
+怀里 1546
+描写 1546
+菩萨 1546
+咸宁市 1545
+节度使 1545
+订 1545
+经济学 1544
+阿炳 1544
+齐声 1544
+满洲 1543
+现存 1543
+饼 1543
+命题 1542
+必备 1542
+百年 1542
+衔 1542
+长度 1542
+雾 1542
+增大 1541
+相等 1541
+瞅 1541
+华山 1539
+大胆 1539
+缠 1539
+越是 1539
+息 1538
+湖北省人民政府 1538
+西晋 1538
+勇气 1537
+山上 1537
+汉江 1537
+燕 1537
+璧 1537
+匈奴 1536
+天鹅洲 1536
+综合性 1536
+极端 1535
+码 1535
+胖 1535
+亿立方米 1534
+用作 1534
+周年 1533
+晋 1532
+物品 1532
+越南 1532
+不安 1531
+个性 1531
+写作 1530
+帖 1530
+制品 1529
+可谓 1529
+各族 1529
+伤口 1528
+创业 1528
+甘肃 1528
+鍐 1528
+悄悄 1527
+时辰 1527
+一体 1526
+磁 1526
+祖先 1526
+作业 1525
+文武 1525
+晃 1525
+作风 1524
+大众 1523
+据悉 1523
+大殿 1522
+发言 1521
+手工业 1521
+每当 1521
+海域 1521
+防治 1521
+鲜明 1521
+半岛 1520
+孤立 1520
+幕 1520
+简历 1520
+雕刻 1520
+押 1519
+石英 1519
+要说 1519
+设想 1519
+大西洋 1518
+欧盟 1518
+从未 1517
+付出 1517
+城区 1517
+总之 1517
+熊 1517
+扣 1515
+省会 1515
+精美 1515
+难度 1515
+零部件 1515
+高温 1515
+健 1514
+涌现 1514
+登陆 1513
+气象 1512
+缓慢 1512
+违反 1512
+天主教 1511
+欧阳锋 1511
+眼中 1511
+本月 1510
+水果 1510
+今晚 1509
+完美 1509
+椅子 1509
+第二个 1509
+纠正 1509
+冠 1507
+打败 1507
+飘 1507
+鲁迅 1507
+拆除 1505
+锁 1505
+减轻 1504
+得罪 1504
+水利 1504
+起源 1504
+粒子 1503
+重伤 1503
+冶金 1502
+前去 1502
+支撑 1501
+造型 1501
+全国政协 1500
+县城 1500
+山水 1500
+提问 1500
+穴道 1500
+友谊 1499
+流量 1499
+满族 1499
+这项 1499
+不及 1498
+大使 1498
+长大 1498
+以至 1497
+地貌 1497
+金殿 1497
+三天 1496
+枢纽 1496
+浑身 1496
+精力 1496
+茶叶 1496
+依旧 1495
+国务委员 1495
+委 1495
+烂 1495
+知名 1495
+万一 1494
+想想 1494
+桃 1494
+索 1494
+人民解放军 1492
+劈 1492
+夏季 1492
+泰国 1492
+登基 1492
+钩 1492
+堡 1491
+粑 1491
+纳入 1491
+侍郎 1490
+接到 1490
+本市 1489
+终身 1488
+一向 1487
+冬 1487
+夜晚 1487
+沈阳 1487
+液体 1487
+特有 1487
+优 1486
+勉强 1486
+生产资料 1486
+证据 1486
+额外 1486
+屋顶 1485
+眼看 1485
+强化 1484
+试图 1484
+故里 1483
+顾里 1483
+次年 1482
+货物 1482
+长安街 1482
+不妨 1481
+方言 1480
+用人 1480
+纲领 1480
+转头 1480
+扁 1479
+航班 1479
+离子 1478
+舍 1477
+航母 1477
+周伯通 1476
+积极性 1476
+斯巴达 1475
+滴 1475
+美好 1474
+轻易 1474
+会计 1473
+克服 1473
+通讯 1473
+喀 1472
+缝 1472
+黑龙江 1472
+有趣 1471
+火车 1471
+目的地 1471
+元代 1470
+地域 1470
+手掌 1470
+有益 1470
+这份 1470
+野 1470
+题材 1470
+安慰 1469
+浏览 1469
+萧峰 1469
+出手 1468
+进城 1468
+一本 1467
+督 1467
+开口 1466
+农作物 1465
+晒 1465
+檐 1465
+蒸 1465
+设在 1465
+一杯 1464
+拿到 1464
+认定 1464
+力气 1463
+恐怖 1463
+丑 1462
+增添 1462
+梅 1462
+岛上 1461
+一幅 1460
+井 1460
+促使 1460
+全境 1460
+学历 1460
+禀 1460
+否定 1459
+夜里 1459
+胃 1459
+希 1458
+承受 1458
+粬 1457
+政治家 1456
+白天 1456
+笑容 1456
+司机 1455
+抖 1455
+擒 1455
+未能 1455
+回顾 1454
+得意 1454
+朵 1454
+枝 1453
+武士 1453
+组建 1453
+这天 1453
+莲子 1452
+麦 1452
+淡 1451
+吊 1450
+中午 1449
+明永乐 1449
+涂 1449
+女士 1448
+国会 1447
+借口 1446
+藉口 1446
+视察 1446
+计划生育 1446
+访 1446
+以此 1445
+何以 1445
+清醒 1445
+面貌 1445
+首辅 1445
+出征 1444
+抚 1444
+消化 1444
+灵活 1444
+利率 1443
+师哥 1443
+幅度 1443
+户部 1443
+诺 1442
+弃 1441
+截 1441
+杆 1441
+每人 1441
+汉奸 1441
+稀 1441
+不能不 1440
+人心 1440
+坑 1440
+已然 1440
+皇宫 1440
+尚无 1439
+教材 1439
+先前 1438
+平安 1438
+旧址 1438
+稿 1438
+主导 1437
+喝酒 1437
+夷 1437
+展览 1437
+水质 1437
+穿过 1437
+耕地 1437
+驻地 1437
+利息 1436
+勇敢 1436
+庵 1435
+正文 1435
+英勇 1435
+高潮 1435
+奴 1434
+惧 1434
+对话 1433
+侵入 1432
+分散 1432
+分泌 1432
+学派 1432
+就此 1432
+注册 1431
+也就是说 1430
+尴尬 1430
+解放区 1430
+过分 1430
+龙头 1430
+仪器 1429
+俗 1429
+至此 1429
+菱花 1429
+三层 1428
+婶 1428
+本国 1428
+鳞 1427
+内蒙古 1425
+困 1425
+深化 1425
+裁 1425
+论证 1425
+几何 1424
+支出 1424
+腹部 1424
+营销 1424
+同胞 1423
+检测 1423
+三种 1421
+春天 1421
+制订 1419
+手下 1419
+昆明 1419
+沿用 1419
+笑脸 1419
+评估 1419
+中纺 1418
+别说 1418
+口气 1418
+客气 1418
+连连 1418
+三月 1417
+定律 1417
+实用 1417
+济南 1417
+齐全 1417
+义军 1416
+开采 1416
+北宋 1415
+可不 1415
+水运 1415
+犹如 1415
+声道 1414
+生死 1414
+裂 1413
+郭襄 1412
+俊 1411
+削 1411
+心灵 1411
+欢喜 1410
+答复 1410
+答覆 1410
+位居 1409
+兑换 1409
+咋 1409
+中方 1408
+二十 1408
+回忆 1408
+增设 1408
+揭露 1408
+敬 1408
+綘 1408
+新加坡 1407
+黄金周 1407
+撑 1406
+故乡 1406
+暂 1406
+立法权 1406
+罕见 1406
+起到 1406
+三次 1405
+催 1405
+机动 1405
+江北 1405
+洪承畴 1405
+致使 1405
+开通 1404
+阴谋 1404
+肾 1403
+调动 1403
+处处 1402
+实质 1402
+碧 1402
+叙述 1401
+籍 1401
+肺 1401
+咖啡 1400
+活着 1400
+乎 1399
+刘宗敏 1399
+燕京 1399
+疯狂 1399
+一线 1398
+夜间 1398
+叉 1397
+发放 1397
+添 1397
+肩头 1397
+零售 1397
+两座 1396
+凑 1396
+十二 1396
+少将 1396
+恰好 1396
+拾 1396
+无论如何 1395
+逮捕 1395
+颁发 1395
+怨 1394
+浮 1394
+珍贵 1394
+美洲 1394
+曲折 1393
+窑 1393
+联想 1393
+迫使 1393
+壶 1392
+融合 1392
+女孩 1391
+抄 1391
+残 1391
+第五 1391
+体积 1390
+兰 1390
+笅 1390
+贾母 1390
+定期 1389
+期限 1389
+犹 1389
+用以 1389
+祀 1389
+虾 1389
+伤心 1388
+代表大会 1387
+囊 1387
+电信 1387
+从不 1386
+叙 1386
+外汇 1386
+亲王 1384
+前期 1384
+技能 1384
+日后 1384
+民事 1384
+洪水 1383
+逝世 1383
+公务员 1382
+残酷 1382
+绝不 1382
+名贵 1381
+延安 1381
+支流 1381
+林彪 1381
+中毒 1379
+动静 1379
+慧梅 1379
+新生 1379
+服役 1379
+船长 1379
+营造 1379
+裹 1379
+神话 1378
+脑子 1378
+村里 1377
+琉璃瓦 1377
+轴 1377
+假定 1376
+几位 1376
+气息 1376
+清兵 1376
+人身 1375
+用途 1375
+八月 1374
+本领 1374
+瞬间 1374
+昔日 1373
+晓得 1373
+历任 1372
+寮 1372
+民用 1372
+磷 1372
+绑 1372
+进而 1372
+披 1371
+湖广 1371
+透明 1371
+里程 1371
+舰艇 1370
+返 1370
+延续 1369
+浓厚 1369
+看作 1369
+书写 1368
+任何人 1368
+探讨 1368
+晚期 1368
+矿物 1368
+一流 1366
+填报 1366
+成人 1366
+顾客 1365
+卖国贼 1364
+教堂 1364
+最小 1364
+西域 1364
+分解 1363
+县委 1363
+填 1363
+心态 1363
+赋 1362
+偶尔 1361
+公正 1361
+几十年 1361
+大业 1361
+名胜古迹 1360
+向来 1360
+对外开放 1360
+旗帜 1360
+粘 1360
+国民政府 1359
+征集 1359
+徵集 1359
+看上去 1359
+概括 1358
+沈 1358
+寻求 1357
+揪 1357
+敏 1357
+植被 1357
+船上 1357
+发达国家 1356
+喜爱 1356
+外部 1356
+特种 1356
+阶 1356
+五月 1355
+扭 1355
+方才 1355
+鸡蛋 1355
+分化 1354
+文书 1354
+普 1354
+风波 1354
+傛 1353
+膨胀 1353
+莫非 1353
+温和 1352
+蕲 1352
+师妹 1351
+症 1351
+终究 1351
+宗旨 1350
+携 1350
+理会 1349
+不觉 1348
+信息化 1348
+凤凰 1348
+华夏 1348
+嶅 1348
+民族自治 1348
+占地 1347
+缩小 1347
+赞 1347
+从业 1346
+围墙 1346
+孩儿 1346
+某个 1346
+硕士 1346
+下级 1345
+允 1345
+全军 1345
+军区 1345
+变更 1345
+辅助 1345
+沉吟 1344
+航天 1344
+一小 1343
+奥地利 1343
+瑞典 1343
+务 1342
+西周 1342
+说来 1342
+辛勤 1342
+两类 1341
+哟 1341
+处长 1341
+政务 1341
+斋 1341
+福临 1341
+西南部 1341
+高等教育 1341
+假如 1340
+补偿 1340
+认得 1340
+金银 1340
+含义 1339
+监察 1339
+细节 1339
+金凤 1339
+主场 1338
+人事 1338
+心脏 1338
+李大钊 1338
+茎 1338
+上班 1337
+争论 1337
+就让 1337
+平常 1337
+洪湖 1337
+辈 1337
+威力 1336
+完毕 1336
+激光 1336
+税收 1336
+米粉 1336
+古建筑 1335
+泪水 1335
+溪 1335
+联合会 1335
+逃走 1335
+得出 1334
+苹果 1334
+死刑 1333
+议员 1333
+面子 1333
+候 1332
+兵部 1332
+彼 1332
+滩 1332
+瀛 1332
+便于 1331
+人造 1330
+判 1330
+星期 1330
+阐述 1330
+中叶 1329
+分流 1329
+念头 1329
+挣扎 1329
+中国人民解放军 1328
+几步 1328
+杈 1328
+突击 1328
+逆 1328
+湿润 1327
+炕 1327
+全民 1326
+鐪 1326
+前线 1325
+情节 1325
+扬州 1325
+掘 1325
+期望 1325
+舱 1324
+反击 1323
+多家 1323
+盛产 1323
+三藏 1322
+玉石 1322
+绝大多数 1322
+航行 1322
+剥削 1321
+好生 1321
+惊人 1321
+昆虫 1321
+罢工 1321
+袍 1321
+阐明 1321
+丞相 1320
+篘 1320
+陷 1320
+元大都 1319
+氧 1319
+一派 1318
+搂 1318
+杰出 1318
+章程 1318
+绮 1318
+黄陂 1318
+出台 1317
+刮 1317
+汪 1317
+泰 1317
+古怪 1316
+拍摄 1316
+自信 1316
+资助 1316
+停留 1315
+党内 1315
+灯光 1315
+空前 1315
+精确 1315
+行事 1315
+钦 1315
+少量 1314
+趋 1314
+固体 1313
+大笑 1313
+排除 1313
+水深 1313
+淑 1313
+评 1313
+偶然 1312
+消耗 1311
+近来 1311
+两院制 1310
+从前 1310
+矮 1310
+竞争力 1310
+苗族 1310
+闻名 1310
+乃至 1309
+帮主 1309
+极了 1309
+司令员 1307
+旋转 1307
+每月 1307
+国防部 1306
+笔架山 1306
+获取 1306
+转让 1306
+随意 1306
+打电话 1305
+明明 1305
+法定 1305
+淮 1305
+十八 1304
+杜甫 1304
+求职 1304
+随州市 1304
+头脑 1303
+故宫 1303
+时分 1303
+晕 1303
+优点 1302
+开会 1302
+猴 1302
+乡长 1301
+墨西哥 1301
+姐 1301
+文理学院 1301
+濂 1301
+寻思 1300
+廷 1300
+疯 1300
+高级中学 1300
+八个 1299
+巡抚 1299
+一封 1298
+伙 1298
+夥 1298
+脂肪 1298
+东南部 1297
+勬 1297
+台上 1297
+对立 1297
+细菌 1297
+胡子 1297
+集团公司 1297
+早晨 1296
+罢免 1296
+锅巴 1296
+须弥座 1296
+兑 1295
+尝 1295
+焦 1295
+通航 1295
+高出 1295
+不远 1294
+天门市 1294
+见于 1294
+清军 1293
+金额 1292
+花园 1291
+不宜 1290
+乾脆 1290
+干脆 1290
+格外 1290
+考验 1290
+地中海 1289
+审计长 1289
+宣 1289
+尊严 1289
+春节 1289
+翼 1289
+通车 1289
+应付 1288
+段誉 1288
+六个 1287
+夺得 1287
+爪 1287
+药材 1287
+乾燥 1286
+代价 1286
+园林 1286
+干燥 1286
+有助于 1286
+池河 1286
+蹲 1286
+干线 1285
+戳 1285
+老头 1285
+耐 1285
+近期 1285
+偏偏 1284
+劫 1284
+区分 1284
+太原 1284
+池 1284
+河床 1284
+不准 1283
+失业 1283
+作物 1282
+助理 1282
+呼吁 1282
+哎 1282
+尖椒 1282
+普及 1282
+珍稀 1282
+舌 1282
+名为 1281
+拉开 1281
+暴露 1281
+楚源 1280
+穆斯林 1280
+侵犯 1279
+后勤 1279
+启 1279
+岱 1279
+戏曲 1279
+摄影 1279
+杭 1279
+造反 1279
+原名 1278
+安定 1278
+乡镇 1277
+册 1277
+古迹 1277
+垮 1277
+窄 1277
+自然资源 1277
+道人 1277
+激情 1276
+辞职 1276
+电视台 1275
+身材 1275
+发作 1274
+喷 1274
+大片 1274
+导 1274
+有意 1274
+板块 1274
+潜力 1274
+处罚 1273
+必需 1273
+南宋 1272
+图库 1272
+答道 1272
+东经 1271
+友 1271
+古人 1270
+商城 1270
+回归 1270
+崖 1270
+干什么 1270
+桂林 1270
+长官 1270
+真理 1269
+跟随 1269
+雅典 1269
+大别山 1268
+心理学 1268
+长方形 1268
+所知 1267
+邀 1267
+不然 1266
+放松 1266
+生动 1266
+畅销 1266
+社会学 1266
+蜂 1266
+内河 1265
+血管 1265
+顺序 1265
+二者 1264
+天堂 1264
+推开 1264
+无力 1264
+腾 1264
+储备 1263
+免疫 1263
+前途 1263
+武力 1263
+谈到 1263
+身穿 1263
+河南省 1262
+产业化 1261
+反革命 1261
+欢 1261
+见长 1261
+财物 1261
+严厉 1260
+出色 1260
+切实 1260
+柏林 1260
+氧化 1260
+沉积 1260
+鸣 1260
+市政府 1259
+射线 1258
+流入 1258
+道士 1258
+鏂 1258
+丘 1257
+天上 1256
+皱 1256
+再度 1255
+劳动者 1255
+吏部 1255
+奶 1255
+彩绘 1255
+有名 1255
+苏维埃 1255
+亲戚 1254
+从小 1254
+分明 1254
+提倡 1254
+歪 1253
+身分 1253
+通行 1253
+阶层 1253
+齿 1253
+卞 1252
+战后 1252
+地租 1251
+损伤 1251
+王府 1251
+蔡 1251
+设法 1251
+字形 1250
+矿山 1250
+砰 1250
+专制 1249
+厮 1249
+战线 1249
+胳膊 1249
+饭店 1249
+可不是 1247
+嘴唇 1247
+罩 1247
+运作 1247
+侯 1246
+寻常 1246
+探明 1246
+收藏 1246
+锡 1246
+佩服 1245
+古典 1245
+地下水 1244
+奥运会 1244
+拒 1244
+法学 1244
+中轴线 1243
+墨 1243
+掏出 1243
+整整 1243
+东侧 1242
+此前 1242
+激 1242
+革 1242
+严峻 1241
+主峯 1241
+主峰 1241
+坚定 1241
+水流 1241
+燃料 1241
+现状 1241
+着手 1241
+行走 1241
+贤 1241
+踩 1241
+炸弹 1240
+出任 1238
+大战 1238
+巴基斯坦 1238
+弯曲 1238
+相见 1238
+群雄 1238
+联络 1238
+欧元 1237
+熬 1237
+签字 1237
+一分 1236
+怀中 1236
+政变 1236
+有时候 1236
+瀑布 1236
+军马 1235
+华中 1235
+咳嗽 1235
+水晶 1235
+汇 1235
+符号 1235
+辉煌 1235
+失望 1234
+姆 1234
+遗传 1234
+丁能通 1233
+什 1233
+博览会 1233
+稳步 1233
+中国政府 1232
+印刷 1232
+泊位 1232
+纪录 1232
+阴阳 1232
+兆 1231
+新月 1231
+移民 1231
+菌 1231
+限度 1231
+官家 1230
+恐惧 1230
+西边 1230
+几下 1229
+延长 1229
+筒 1229
+已知 1228
+日报 1228
+赶来 1228
+二十年 1227
+充足 1227
+替代 1227
+浓郁 1227
+嗤 1226
+外地 1226
+擅长 1226
+热点 1226
+老营 1226
+鉴定 1226
+本书 1225
+好汉 1224
+江山 1224
+光辉 1223
+当场 1223
+纪律 1223
+遵循 1223
+青少年 1223
+御道 1222
+抗议 1222
+捣 1222
+探测 1222
+谴责 1222
+跟踪 1222
+闷 1222
+乾旱 1221
+干旱 1221
+气势 1221
+烦 1221
+辛苦 1221
+面粉 1221
+题目 1221
+麪粉 1221
+宋献策 1220
+汇率 1220
+玄 1220
+胆 1220
+高层 1220
+光明 1219
+景象 1219
+酶 1219
+军方 1218
+前列 1218
+向往 1218
+天然气 1218
+好事 1218
+战斗力 1218
+期待 1218
+修缮 1217
+光学 1217
+各校 1217
+天启 1217
+家乡 1217
+巧 1217
+精心 1217
+习 1216
+四月 1216
+总会 1216
+总队 1216
+教育部 1216
+赢得 1216
+多久 1215
+新兴 1215
+牢 1215
+粉碎 1215
+超越 1215
+即位 1214
+国师 1214
+理工学院 1214
+营业 1214
+违法 1214
+半数 1213
+在校 1213
+均匀 1213
+失误 1213
+尚有 1213
+手腕 1213
+村民 1213
+竞赛 1213
+受理 1212
+省份 1212
+磕头 1212
+隐藏 1212
+摇摇头 1211
+早上 1211
+湴 1211
+谓 1211
+一间 1210
+同盟 1210
+荣 1210
+釜 1210
+灞 1209
+苗 1209
+驴 1209
+如是 1207
+政委 1207
+盛行 1207
+随之 1207
+隧道 1207
+魂 1207
+利润率 1206
+良 1206
+谜 1206
+叶子 1205
+契丹 1205
+征求 1205
+徵求 1205
+栽培 1205
+浮雕 1205
+灌溉 1205
+特权 1205
+道具 1205
+优先 1204
+侍 1204
+审计 1204
+屁股 1204
+理念 1204
+省区 1204
+记住 1204
+旅游业 1203
+驰 1203
+下属 1202
+贵州 1202
+高山 1202
+世人 1201
+主编 1201
+操纵 1201
+首席 1201
+以免 1200
+妇 1200
+预付 1200
+安静 1199
+火力 1199
+荡 1199
+赖 1199
+起点 1199
+一剑 1198
+例外 1198
+岀 1198
+常规 1198
+致力 1198
+高低 1198
+官府 1197
+岳 1197
+嶽 1197
+惊讶 1197
+掠 1197
+盈盈 1197
+神龙 1197
+纵然 1197
+耍 1197
+肌 1197
+肠子 1197
+人民大会堂 1196
+会员 1196
+我会 1196
+箱 1196
+肩膀 1196
+风暴 1196
+回事 1195
+忽视 1195
+指点 1195
+激发 1195
+缔结 1195
+住宅 1194
+冬天 1194
+开拓 1194
+拌 1194
+梦想 1194
+湿地 1194
+三面 1193
+凤姐 1193
+型号 1193
+堵 1193
+愉快 1193
+繁 1193
+鐗 1193
+后代 1192
+曼桢 1192
+浆 1192
+狮子 1192
+猛地 1192
+使命 1191
+师范学院 1191
+特务 1191
+纠纷 1191
+考古 1191
+股市 1191
+举动 1190
+犹豫 1190
+董事 1190
+起义军 1190
+少林寺 1189
+工人阶级 1189
+汝 1189
+供奉 1188
+尝试 1188
+牙齿 1188
+电台 1188
+腔 1188
+辩 1188
+难免 1188
+体重 1187
+余种 1187
+侵 1187
+外语 1187
+密集 1187
+执法 1187
+掀起 1187
+一丝 1186
+仰 1186
+图书 1186
+大海 1186
+水库 1186
+祸 1186
+报仇 1185
+竖 1185
+中山公园 1184
+九年 1184
+子口 1184
+小弟 1184
+百万 1184
+十六 1183
+厂商 1183
+合乎 1183
+孔明 1183
+牛肉 1183
+珍 1183
+自杀 1183
+骑马 1183
+崇拜 1182
+机遇 1182
+染 1182
+豪 1182
+转入 1182
+低下 1181
+判决 1181
+摘 1181
+棋 1181
+物理学 1181
+鍗 1181
+县级市 1180
+土耳其 1180
+外长 1180
+描绘 1180
+撰 1180
+旋 1180
+棍 1180
+途中 1180
+愁 1179
+荆江 1179
+门下 1179
+一体化 1178
+两面 1178
+开来 1178
+来不及 1178
+顺着 1178
+名词 1177
+培育 1177
+新增 1177
+俗称 1176
+恭 1176
+汉阳 1176
+专线 1175
+伐 1175
+供给 1175
+如果说 1175
+对比 1175
+李岩 1175
+苏维埃政府 1175
+两地 1174
+球迷 1174
+窗口 1174
+签 1174
+仁 1173
+六部 1173
+军舰 1173
+节奏 1173
+链 1173
+黄依依 1173
+变量 1172
+师长 1172
+档案 1172
+专利 1171
+响应 1171
+惨 1171
+点滴 1171
+上帝 1170
+从中 1170
+加重 1170
+国徽 1170
+夊 1170
+认证 1170
+运河 1170
+先锋 1169
+军中 1169
+对此 1169
+徒弟 1169
+一笔 1168
+奖励 1168
+惯 1168
+横贯 1168
+眉 1168
+傗 1167
+内功 1167
+闸 1167
+优惠 1166
+分工 1166
+商务 1166
+四下 1166
+垃圾 1165
+浪 1165
+山坡 1164
+总算 1164
+打仗 1164
+步伐 1163
+漆 1163
+避开 1163
+娟 1162
+工程学院 1162
+赞成 1162
+一辆 1161
+全力 1161
+危急 1161
+多尔衮 1161
+小声 1161
+级别 1161
+黄土 1161
+不成 1160
+出版社 1160
+指责 1160
+法子 1160
+肚 1160
+中东 1159
+办事处 1159
+坟 1159
+应有 1159
+拦 1159
+公式 1158
+军师 1158
+消费品 1158
+蛮 1158
+遗 1158
+分数 1157
+在外 1157
+收获 1157
+武松 1157
+磁场 1157
+赦 1157
+建筑业 1156
+水陆 1156
+灭亡 1156
+禀报 1156
+遗憾 1156
+分开 1155
+寿命 1155
+专政 1154
+死去 1154
+羽 1154
+音乐堂 1154
+军士 1153
+史料 1153
+覆 1153
+警告 1153
+一篇 1152
+屡 1152
+搞笑 1152
+白嘉轩 1152
+过度 1152
+下载 1151
+召见 1151
+坚 1151
+李逵 1151
+漫长 1151
+窗外 1151
+第一次世界大战 1151
+一刻 1150
+方程 1150
+笔者 1150
+责 1150
+两届 1149
+源于 1149
+习俗 1148
+叩 1148
+固定资产 1148
+想不到 1148
+疲劳 1148
+考证 1148
+难题 1148
+建筑物 1147
+陀 1147
+魦 1147
+坚强 1146
+墨镜 1146
+丽 1145
+惩罚 1145
+激起 1145
+乐器 1144
+倾斜 1144
+国旗 1144
+疑 1144
+监管 1144
+舒服 1144
+隐隐 1144
+拽 1143
+升高 1142
+国防委员会 1142
+深远 1142
+算了 1142
+粒 1142
+过年 1142
+钙 1142
+刊物 1141
+璁 1141
+间接 1141
+一再 1140
+亏损 1140
+岳不群 1140
+船只 1140
+西欧 1140
+主观 1139
+传入 1139
+成效 1139
+艰苦 1139
+高效 1139
+出名 1138
+带动 1138
+底下 1138
+沙市 1138
+深厚 1138
+神仙 1138
+追赶 1138
+上司 1137
+俘虏 1137
+早年 1137
+焖 1137
+结成 1137
+致富 1137
+部类 1137
+刺史 1136
+嚷 1136
+捡 1136
+种族 1136
+脚本 1136
+一团 1135
+不仅仅 1135
+亿吨 1135
+仆 1135
+发展中国家 1135
+当事人 1135
+浪费 1134
+回报 1133
+抱住 1133
+织 1133
+先行 1132
+约束 1132
+不同于 1131
+所用 1131
+扒 1131
+旅行 1131
+泛 1131
+直到 1131
+谨慎 1131
+际 1131
+技 1130
+竞 1130
+自带 1130
+艾滋病 1130
+试点 1130
+太康 1129
+廷杖 1129
+打扮 1129
+洪七公 1129
+西侧 1129
+河谷 1128
+超出 1128
+三位 1127
+办学 1127
+地铁 1127
+自有 1127
+这部 1127
+不等 1126
+娘子 1126
+桂 1126
+舵 1126
+解除 1126
+部属 1126
+子弹 1125
+弹性 1125
+日历 1125
+狄云 1125
+北约 1124
+域 1124
+石破天 1124
+背后 1124
+卿 1123
+称赞 1123
+年份 1122
+渴望 1122
+演奏 1122
+高呼 1122
+大汉 1121
+弹药 1121
+混凝土 1121
+热量 1121
+金钱 1121
+下达 1120
+洪山 1120
+起伏 1120
+射程 1119
+窜 1119
+配备 1119
+首脑 1119
+我家 1118
+精神文明 1118
+缚 1118
+中山 1117
+创建者 1117
+火车站 1117
+粥 1117
+这会儿 1117
+乘机 1116
+内陆 1116
+十一 1116
+削弱 1115
+县政府 1115
+来回 1115
+苕 1115
+上马 1114
+书法 1114
+休闲 1114
+娃娃 1114
+座谈会 1114
+军用 1113
+太平 1113
+好看 1113
+常委 1113
+礼物 1113
+郢 1113
+名人 1112
+吞 1112
+嗗 1112
+法令 1112
+福利 1112
+识别 1112
+响起 1111
+引入 1111
+杀害 1111
+李太后 1111
+研究员 1111
+缓解 1111
+五分 1110
+日志 1110
+梨 1110
+策划 1110
+陆地 1110
+隶属 1110
+飞行员 1110
+倚 1109
+始于 1109
+工业化 1109
+水稻 1109
+经济效益 1109
+华中科技大学 1108
+小小 1108
+革新 1108
+必读 1107
+求知 1107
+中药 1106
+代表作 1106
+劳 1106
+禾 1106
+耶 1106
+争议 1105
+大局 1105
+拼 1105
+甩 1105
+辽东 1105
+一脚 1104
+丽鹃 1104
+亲切 1104
+嘴巴 1104
+总书记 1104
+五间 1103
+几分 1103
+叔叔 1103
+变迁 1103
+团队 1103
+多名 1103
+孔子 1103
+学士 1103
+骨干 1103
+冷静 1102
+容量 1102
+序 1102
+活力 1102
+通称 1102
+鲜花 1102
+元朝 1101
+先是 1101
+全会 1101
+申报 1101
+绝大部分 1101
+内涵 1100
+反射 1100
+太和殿 1100
+炼 1100
+甘泉 1100
+那末 1100
+鞑 1100
+中华人民共和国宪法 1099
+是非 1099
+陕西省 1099
+饲养 1099
+信奉 1098
+清洁 1098
+秦震 1098
+长江流域 1098
+除非 1098
+一碗 1097
+下辖 1097
+妇人 1097
+祖宗 1097
+一八 1096
+后世 1096
+卦 1095
+师弟 1095
+悟 1095
+服 1095
+模糊 1095
+比分 1095
+七年 1094
+地层 1094
+翠 1094
+蓝色 1094
+辽宁 1094
+不便 1093
+保健 1093
+咽 1093
+图像 1093
+尽可能 1093
+水下 1093
+相距 1093
+转动 1093
+预定 1093
+不小 1092
+云集 1092
+同情 1092
+天体 1092
+殿试 1092
+礼仪 1092
+续 1092
+随手 1092
+牌坊 1091
+独自 1091
+落下 1090
+四方 1089
+在场 1089
+奇特 1089
+接连 1089
+水域 1089
+谢谢 1089
+青春 1089
+人民日报 1087
+以东 1087
+对应 1087
+称呼 1087
+笑话 1087
+蛋白 1087
+电器 1086
+盒 1086
+控 1085
+汽水 1085
+原先 1083
+接收 1083
+揭 1083
+等到 1083
+组织法 1083
+下列 1082
+份额 1082
+剧烈 1082
+在线 1082
+处置 1082
+楼上 1082
+欢乐 1082
+科长 1082
+袁崇焕 1082
+谁知 1082
+两句 1081
+兜 1081
+生怕 1081
+崇 1080
+门洞 1080
+宽阔 1079
+山河 1079
+新华网 1079
+歌曲 1079
+生平 1079
+苯 1079
+赌 1079
+首位 1079
+中亚 1078
+招收 1078
+掌管 1078
+直隶 1078
+着重 1078
+豆腐 1078
+这块 1078
+万年 1077
+南岳 1077
+南嶽 1077
+吼 1077
+康 1077
+沼泽 1077
+褰 1077
+鎶 1077
+华侨 1076
+挣 1076
+此间 1076
+装有 1076
+集会 1076
+万家 1075
+东风 1075
+优美 1075
+毛泽东思想 1075
+温带 1075
+激励 1075
+鄂东 1075
+以内 1074
+山谷 1074
+山顶 1074
+文华 1074
+震惊 1074
+鳙 1074
+优越 1073
+侨 1073
+宝贝 1073
+拼命 1073
+退休 1073
+铁矿 1073
+户口 1072
+航 1072
+虽说 1072
+赔偿 1072
+一枚 1071
+不曾 1071
+取决 1071
+地步 1071
+嶆 1071
+白领 1071
+董事长 1071
+通衢 1071
+推送 1070
+死者 1070
+不已 1069
+两家 1069
+整齐 1069
+猛烈 1069
+造林 1069
+予 1068
+国民革命军 1068
+无线电 1068
+着急 1068
+第一批 1068
+遗迹 1068
+拍卖 1067
+整合 1067
+睡眠 1067
+诸葛亮 1067
+上层 1066
+强迫 1066
+曹汝霖 1066
+自称 1066
+各家 1065
+教会 1065
+家属 1064
+物价 1064
+盆 1064
+老公 1064
+三国 1063
+上空 1063
+兘 1063
+兼备 1063
+庭长 1063
+老大 1063
+伞 1062
+师太 1062
+统一战线 1062
+自由人 1062
+行政公署 1062
+市辖区 1061
+影子 1061
+沔阳 1061
+睿 1061
+私信 1061
+编辑者 1061
+防务 1061
+陶器 1061
+定为 1060
+看过 1060
+自治机关 1060
+莲蓬 1060
+不吃 1059
+刑事 1059
+梢 1059
+洛 1059
+运气 1059
+麻城 1059
+一点儿 1058
+训 1058
+首相 1058
+叫作 1057
+往前 1057
+清华 1057
+漏 1057
+爆米花 1057
+睡着 1057
+同步 1056
+改装 1056
+皇太后 1056
+绳 1056
+隋 1056
+书籍 1055
+主流 1054
+娜 1054
+拦截 1054
+珍品 1054
+姜 1053
+淘汰 1053
+现已 1053
+共产国际 1052
+即可 1052
+喇嘛 1052
+对不起 1052
+打着 1052
+相差 1052
+红旗 1052
+绿化 1052
+肿 1052
+进化 1052
+大展宏图 1051
+注视 1051
+神圣 1051
+菲 1051
+进军 1051
+雅 1051
+卧 1050
+狭窄 1050
+镇长 1050
+鹰 1050
+印度洋 1049
+国情 1049
+比利时 1049
+省辖市 1049
+紧闭 1049
+送来 1049
+制止 1048
+菜系 1048
+二路 1047
+伤亡 1047
+子孙 1047
+有害 1047
+电流 1047
+铸 1047
+人文 1046
+壁画 1046
+大爷 1046
+好象 1046
+模 1046
+状元 1046
+监利 1046
+袋 1046
+吵 1045
+哇 1045
+环保 1045
+悲剧 1044
+搜狗 1044
+时尚 1044
+竭力 1044
+诸多 1044
+下马 1043
+浓度 1043
+灾 1043
+珂 1043
+表态 1043
+豪华 1043
+东交民巷 1042
+便利 1042
+学院路 1042
+官职 1042
+匕首 1041
+只管 1041
+台阶 1041
+宝贵 1041
+走来 1041
+传媒 1040
+加紧 1040
+医 1040
+千年 1040
+极少 1040
+荒 1040
+誓 1040
+丈 1039
+大伙儿 1039
+常设 1039
+油料 1039
+熷 1039
+运营 1039
+哪怕 1038
+幻想 1038
+潵 1038
+舆 1038
+鄂南 1038
+向东 1037
+封建王朝 1037
+恩施市 1037
+月亮 1037
+清理 1037
+二月 1036
+同一个 1036
+晚年 1036
+债务 1035
+后人 1035
+很小 1035
+调控 1035
+辅 1035
+鮠 1035
+鹿苑 1035
+专科 1034
+中医 1034
+大连 1034
+官僚 1034
+自幼 1034
+自行车 1034
+诰 1034
+大于 1033
+大吃一惊 1033
+中小学 1032
+体会 1032
+四处 1032
+帽子 1032
+援 1032
+林业 1032
+重重 1032
+一同 1031
+免得 1031
+坊 1031
+康有为 1031
+暗器 1031
+潜江市 1031
+芯片 1031
+这家 1031
+一贯 1030
+九曲回肠 1030
+五四运动 1030
+十月 1030
+彭 1030
+洒 1030
+火炬计划 1030
+瓙 1030
+眼神 1030
+奖金 1029
+审理 1029
+朱翊钧 1029
+温柔 1029
+王室 1029
+现金 1029
+病情 1029
+练习 1029
+叛乱 1028
+树木 1028
+生日 1028
+示范县 1028
+置于 1028
+兀 1027
+共产主义 1027
+刃 1027
+宸 1027
+得分 1027
+撰写 1027
+斑 1027
+灾难 1027
+中年 1026
+假设 1026
+制药 1026
+千公顷 1026
+小孩子 1026
+常有 1026
+烦恼 1026
+留学 1026
+诗文 1026
+万多亩 1025
+刘少奇 1025
+宛如 1025
+李鸿忠 1025
+监视 1025
+纵身 1025
+美食 1025
+腰间 1025
+艺术家 1025
+袖 1025
+丹麦 1024
+南环路 1024
+原种场 1024
+枯 1024
+气味 1024
+烫 1024
+白沙洲 1024
+硅 1024
+菲律宾 1024
+起飞 1024
+辣 1024
+雌 1024
+频道 1024
+体力 1023
+厨房 1023
+妾 1023
+实习 1023
+恰恰 1023
+沾 1023
+西夏 1023
+解开 1023
+进球 1023
+密码 1022
+桥头堡 1022
+自古 1022
+递给 1022
+泄 1021
+中路 1020
+剥 1020
+大赛 1020
+弓 1020
+损 1020
+着实 1020
+中游 1019
+家务 1019
+擅 1019
+欎 1019
+武艺 1019
+科举 1019
+伴随 1018
+康复 1018
+放到 1018
+料到 1018
+跟着 1018
+黛玉 1018
+中央军事委员会 1017
+困境 1017
+小事 1017
+蒋 1017
+在家 1016
+火光 1016
+秘 1016
+类别 1016
+反帝 1015
+强盗 1015
+揭示 1015
+易于 1015
+此举 1015
+交涉 1014
+鲜美 1014
+专用 1013
+丧 1013
+匹 1013
+大炮 1013
+躬身 1013
+鎯 1013
+亲人 1012
+人格 1012
+开支 1012
+张献忠 1012
+据此 1012
+氏族 1012
+菜肴 1012
+中南部 1011
+凿 1011
+实事求是 1011
+担负 1011
+揉 1011
+氢 1011
+笑声 1011
+详 1011
+取胜 1010
+学府 1010
+手脚 1010
+拱手 1010
+接下来 1010
+恶劣 1009
+步枪 1009
+纵横 1009
+垫 1008
+头部 1008
+心上 1008
+捐 1008
+海南 1008
+清晨 1008
+盏 1008
+诧异 1008
+购 1008
+顺便 1008
+淡水 1007
+理智 1007
+鱼雷 1007
+光泽 1006
+审判员 1006
+数学家 1006
+肠 1006
+融资 1006
+一旁 1005
+中日 1005
+加热 1005
+单一 1005
+改编 1005
+暴力 1005
+管道 1005
+萨 1005
+兼任 1004
+勋章 1004
+合金 1004
+惨案 1004
+戟 1004
+所得 1004
+僧人 1003
+勿 1003
+圆形 1003
+挥手 1003
+放开 1003
+月光 1003
+机枪 1003
+磕 1003
+天府 1002
+度假 1002
+殖民 1002
+监测 1002
+一趟 1001
+学员 1001
+攻占 1001
+红墙 1001
+虏 1001
+印尼 1000
+否 1000
+新建 1000
+翻身 1000
+谢逊 1000
+乐园 999
+嘱咐 999
+招数 999
+民兵 999
+波动 999
+移植 999
+董 999
+钟灵 999
+剥夺 998
+变形 998
+叹息 998
+天色 998
+心目 998
+放入 998
+机体 998
+潮流 998
+窟 998
+唯 997
+室内 997
+维吾尔族 997
+自此 997
+魅力 997
+两层 996
+口袋 996
+塑料 996
+恼 996
+战友 996
+姿态 995
+汉中 995
+锰 995
+变换 994
+壮族 994
+灰色 994
+破产 994
+笂 994
+购物 994
+金龙 994
+一周 993
+围攻 993
+恒星 993
+投产 993
+振动 993
+未免 993
+沙滩 993
+缺陷 993
+美女 993
+北上 992
+学问 992
+审核 992
+拥护 992
+钢琴 992
+侄 991
+做饭 991
+帮忙 991
+惟一 991
+药用 991
+趋向 991
+折磨 990
+欺 990
+纯粹 990
+跺 990
+一架 989
+可爱 989
+尘 989
+提示 989
+时报 989
+校区 989
+获奖 989
+远程 989
+后悔 988
+哈尔滨 988
+抵达 988
+简 988
+鼎 988
+鼓舞 988
+不时 987
+遇上 987
+附属 987
+驱逐 987
+一倍 986
+否认 986
+尖锐 986
+科学院 986
+艺 986
+黑人 986
+不尽 985
+平行 985
+庞文 985
+敌军 985
+究 985
+舟 985
+逢 985
+面上 985
+二次 984
+产地 984
+分歧 984
+回避 984
+天文 984
+每周 984
+不堪 983
+奇迹 983
+姝 983
+运营商 983
+马克思 983
+偶 982
+动机 982
+扩散 982
+该市 982
+鹧鸪 982
+不止 981
+侠 981
+儒家 981
+充当 981
+公认 981
+工会 981
+师范 981
+恰 981
+狠狠 981
+真相 981
+示范 981
+笛 981
+连同 981
+万立方米 980
+下跌 980
+世纪末 980
+号码 980
+安徽省 980
+扮 980
+没法 980
+监狱 980
+航道 980
+写下 979
+南岸 979
+撤退 979
+王家 979
+行人 979
+重要性 979
+主办 978
+周刊 978
+要紧 978
+进出 978
+严密 977
+兀自 977
+十个 977
+厉声 977
+赵志敬 977
+赵敏 977
+万万 976
+名胜区 976
+奕 976
+届时 976
+提请 976
+村庄 976
+渝 976
+相貌 976
+缩短 976
+墙壁 975
+宴 975
+建于 975
+碱 975
+资费 975
+九月 974
+仓 974
+揭开 974
+儿女 973
+公使 973
+告别 973
+处分 973
+女孩子 973
+安娜 973
+审批 973
+干预 973
+束 973
+直达 973
+娘娘 972
+惟 972
+汉代 972
+逐 972
+串 971
+呜 971
+山麓 971
+传出 970
+伦 970
+保全 970
+半夜 970
+多于 970
+开门 970
+捞 970
+春季 970
+曲线 970
+两旁 969
+借助 969
+公公 969
+慢性 969
+战区 969
+模仿 969
+泊 969
+藉助 969
+农业部 968
+流经 968
+示 968
+黄药师 968
+画面 967
+西门 967
+诸如 967
+桌 966
+清末 966
+砂 966
+解放战争 966
+进出口 966
+一轮 965
+嘿嘿 965
+富人 965
+昨晚 965
+来源于 965
+缘故 965
+闂 965
+一出 964
+任教 964
+游泳 964
+部委 964
+闄 964
+阿根廷 964
+势头 963
+呼声 963
+指望 963
+降水 963
+两步 962
+剌 962
+封建社会 962
+指南 962
+正桥 962
+焦点 962
+脏 962
+觅 962
+走近 962
+共产党员 961
+委屈 961
+李先念 961
+海面 961
+皇权 961
+东亚 960
+南端 960
+服用 960
+乔峰 959
+子弟 959
+拚 959
+梅花 959
+淮南 959
+立方 959
+经贸 959
+花费 959
+鳌 959
+黔 959
+原材料 958
+大理 958
+嫣 958
+完备 958
+布朗 958
+生育 958
+赶上 958
+发光 957
+所有者 957
+挽 957
+清宫 957
+高尚 957
+世钧 956
+两院 956
+倾听 956
+堪称 956
+妹 956
+玄德 956
+男孩 956
+自动化 956
+东北部 955
+为期 955
+卸 955
+法西斯 955
+跳槽 955
+力求 954
+涨幅 954
+给出 954
+落地 954
+走廊 954
+青青 954
+首要 954
+乙 953
+嫂子 953
+工夫 953
+江苏省 953
+灶 953
+编写 953
+从来不 952
+优化 952
+华盛顿 952
+本土 952
+追加 952
+任意 951
+倡导 951
+参议院 951
+外商 951
+娌 951
+浩 951
+股价 951
+语气 951
+选区 951
+鉴于 951
+门人 951
+学费 950
+急性 950
+性别 950
+花生 950
+谕 950
+铲 950
+青铜 950
+代表性 949
+小伙子 949
+小夥子 949
+橡胶 949
+浼 949
+通商 949
+驼 949
+两级 948
+伯伯 948
+公约 948
+层面 948
+灿烂 948
+京师 947
+储存 947
+包袱 947
+发病 947
+圈子 947
+座位 947
+搁 947
+斧 947
+现今 947
+鐢 947
+阶级斗争 947
+大盘 946
+季风气候 946
+成吉思汗 946
+流派 946
+特区 946
+笼罩 946
+糊涂 946
+艇 946
+见识 946
+车站 946
+靠近 946
+何等 945
+外边 945
+妹子 945
+抱怨 945
+第六次 945
+触 945
+赤壁 945
+饰 945
+与会 944
+明年 944
+火烧 944
+率先 944
+全书 943
+冲动 943
+厦门 943
+地表 943
+孙权 943
+宗室 943
+屏 943
+瑕 943
+站立 943
+笼 943
+精度 943
+金牌 943
+专区 942
+介质 942
+南朝 942
+吃惊 942
+敢于 942
+来往 942
+电压 942
+背心 942
+都督 942
+高声 942
+岄 941
+嵌 941
+民主集中制 941
+猛然 941
+第一部 941
+苏丹 941
+适于 941
+逃跑 941
+华人 940
+摧毁 940
+畔 940
+阻拦 940
+联名 939
+一二 938
+使者 938
+小吃 938
+交替 937
+倘 937
+必将 937
+收取 937
+核潜艇 937
+良久 937
+金水河 937
+北洋 936
+変 936
+海萍 936
+相通 936
+精巧 936
+胶 936
+莱 936
+吕 935
+增长率 935
+大哭 935
+宰相 935
+开设 935
+鹤 935
+叩头 934
+大楼 934
+工商业 934
+渠 934
+眉头 934
+寄托 933
+挖掘 933
+查询 933
+煤矿 933
+进深 933
+佣 932
+傅 932
+岁月 932
+巧妙 932
+强制 932
+收复 932
+表决权 932
+青海 932
+五行 931
+存储 931
+智力 931
+树上 931
+逛 931
+上次 930
+别处 930
+度过 930
+笔记本 930
+被动 930
+额头 930
+万辆 929
+二位 929
+哲学家 929
+壮大 929
+环绕 929
+规格 929
+较量 929
+迫 929
+冻 928
+对称 928
+蜡烛 928
+醇 928
+口子 927
+牢牢 927
+皇室 927
+要么 927
+吉祥 926
+场景 926
+山南 926
+总长 926
+日趋 926
+火焰 926
+纯收入 926
+迈 926
+外来 925
+工作者 925
+统领 925
+范畴 925
+重组 925
+高压 925
+传奇 924
+复合 924
+奸 924
+广东省 924
+提交 924
+滃 924
+老实 924
+七月 923
+东海 923
+以西 923
+伪 923
+何时 923
+偅 923
+功率 923
+奴婢 923
+泼 923
+流体 923
+相公 923
+中旬 922
+制导 922
+布政使 922
+残疾人 922
+清华大学 922
+自然界 922
+饮酒 922
+中华人民共和国国务院 921
+科目 921
+统称 921
+镖 921
+代谢 920
+屠杀 920
+忽略 920
+空白 920
+下手 919
+冲破 919
+参谋 919
+向上 919
+坝 919
+衡量 919
+乡镇企业 918
+完好 918
+招待会 918
+掌门 918
+欲望 918
+毛主席纪念堂 918
+熙 918
+认可 918
+锦标赛 918
+中断 917
+宿舍 917
+水产品 917
+河水 917
+瑰 917
+粤 917
+防范 917
+阵容 917
+隆重 917
+凤 916
+可变资本 916
+安置 916
+打断 916
+晴 916
+樻 916
+出国 915
+包裹 915
+大王 915
+张翠山 915
+野蛮 915
+亲信 914
+叠 914
+打量 914
+羊皮 914
+不语 913
+两湖 913
+书画 913
+手持 913
+此地 913
+相助 913
+色素 913
+霍 913
+预备 913
+撕 912
+水分 912
+汉字 912
+闲暇 912
+交付 911
+交代 911
+多谢 911
+扮演 911
+虹 911
+驾驶 911
+包装 910
+吴为 910
+城北 910
+秀丽 910
+交谈 909
+尺寸 909
+痕迹 909
+离去 909
+南北朝 908
+发觉 908
+家人 908
+心意 908
+挑选 908
+膝 908
+苦笑 908
+霳 908
+佹 907
+豫 907
+使劲 906
+厕所 906
+坦 906
+战车 906
+末年 906
+村子 905
+极力 905
+棱 905
+汇集 905
+迁都 905
+仆人 904
+升起 904
+宅 904
+比如说 904
+葫芦 904
+裘 904
+图形 903
+报上 903
+轻轻地 903
+龟 903
+房中 902
+朱德 902
+焦急 902
+舌头 902
+追究 902
+修行 901
+峡谷 901
+怀着 901
+憋 901
+江淮 901
+清风 901
+这边 901
+铝 901
+黄昏 901
+全新 900
+琢磨 900
+示意 900
+经济学家 900
+羊肉 900
+芯 900
+走私 900
+部下 900
+丐 899
+周转 899
+斡 899
+痰 899
+突厥 899
+存款 898
+宣读 898
+害死 898
+崩溃 898
+巴勒斯坦 898
+童贯 898
+绝望 898
+警惕 898
+丛 897
+人性 897
+制裁 897
+剧本 897
+午 897
+推向 897
+施展 897
+明知 897
+福州 897
+象是 897
+越发 897
+过早 897
+一匹 896
+出面 896
+叶大鹰 896
+塑造 896
+岂能 896
+无声 896
+治所 896
+潭 896
+盈利 896
+全然 895
+台下 895
+征收 895
+徵收 895
+界限 895
+脾 895
+准则 894
+审美 894
+山洞 894
+栖息 894
+萧 894
+薇 894
+钠 894
+一事 893
+全县 893
+兼并 893
+抽出 893
+撮 893
+时常 893
+沐 893
+第五次 893
+诸侯 893
+遮 893
+功力 892
+岳灵 892
+没收 892
+突发 892
+落入 892
+葡萄牙 892
+谈论 892
+传到 891
+小姑娘 891
+第三次 891
+臣民 891
+行李 891
+那条 891
+关节 890
+唇 890
+婚礼 890
+攻克 890
+衣衫 890
+该书 890
+驻华 890
+判处 889
+宁夏 889
+昭 889
+望去 889
+嗭 888
+邻近 888
+饮料 888
+名胜 887
+四肢 887
+总兵 887
+散文 887
+昼夜 887
+胆子 887
+荫 887
+违背 887
+锋 887
+鹿角 887
+名誉 886
+商店 886
+处境 886
+家具 886
+离婚 886
+都城 886
+限于 886
+万千瓦 885
+八路军 885
+地级市 885
+申诉 885
+雕塑 885
+其一 884
+内存 884
+孟 884
+尽力 884
+年产 884
+目睹 884
+羞 884
+面包 884
+麪包 884
+关联 883
+外表 883
+烧烤 883
+矿床 883
+节约 883
+酥 883
+一堆 882
+不惜 882
+匈牙利 882
+和约 882
+收缩 882
+数额 882
+无意 882
+求助 882
+亲眼 881
+出路 881
+左良玉 881
+灯笼 881
+疑问 881
+研究院 881
+上学 880
+场上 879
+督察 879
+钛 879
+双目 878
+幸 878
+径流量 878
+循 878
+旺 878
+寸 877
+瓜 877
+这点 877
+閮 877
+三合 876
+恕 876
+抵挡 876
+挪威 876
+李文秀 876
+树种 876
+节制 876
+高血压 876
+业余 875
+塘 875
+第一届 875
+贱 875
+中级 874
+元帅 874
+北美 874
+宫灯 874
+开工 874
+政治权利 874
+整天 874
+煨 874
+中国科学院 873
+列席 873
+历经 873
+小于 873
+意图 873
+游览 873
+疑惑 873
+通红 873
+邮政 873
+阿朱 873
+颤抖 873
+鸟类 873
+供养 872
+办公 872
+君王 872
+弹道导弹 872
+进门 872
+凝 871
+孙子 871
+日夜 871
+材 871
+杭天醉 871
+直线 871
+缅甸 871
+贪 871
+各市 870
+夸 870
+形容 870
+椒 870
+貌 870
+通报 870
+陈友谅 870
+首府 870
+六月 869
+多样 869
+指令 869
+枕 869
+演练 869
+热爱 869
+趋于 869
+写道 868
+嗕 868
+土家 868
+宿 868
+尺 868
+应聘 868
+点燃 868
+畜牧业 868
+禅 868
+习性 867
+拂 867
+来访 867
+溶 867
+简化 867
+半导体 866
+左侧 866
+样式 866
+清除 866
+赔 866
+信念 865
+听听 865
+语音 865
+长征 865
+驱 865
+卖国 864
+拓展 864
+振 864
+一心 863
+全家 863
+双拥 863
+同等 863
+名茶 863
+唐僧 863
+展现 863
+挽救 863
+杜鹃花 863
+雄壮 863
+雪山 863
+一灯 862
+大脑 862
+拿下 862
+敕 862
+沙发 862
+珍珠 862
+走过 862
+迟疑 862
+韩子奇 862
+产区 861
+决战 861
+叫声 861
+大败 861
+宝剑 861
+明成祖 861
+杨诚 861
+蓉 861
+势必 860
+嗣 860
+定向 860
+建都 860
+有如 860
+相互作用 860
+轰炸机 860
+中世纪 859
+丸 859
+仪仗 859
+会同 859
+传授 859
+出动 859
+反倒 859
+后边 859
+搅 859
+染料 859
+海湾 859
+陆无双 859
+面条 859
+决计 858
+发电 858
+启用 858
+瞄准 858
+花钱 858
+芳 858
+评选 858
+通向 858
+镍 858
+一艘 857
+上半场 857
+传输 857
+急于 857
+想必 857
+战乱 857
+扛 857
+抵御 857
+拳头 857
+摊 857
+稳定性 857
+缓和 857
+老汉 857
+胸前 857
+途 857
+顾秋水 857
+冒险 856
+尤为 856
+幼 856
+广义 856
+果实 856
+海里 856
+清江 856
+湖水 856
+生前 856
+谏 856
+共产党人 855
+劳务 855
+山里 855
+打发 855
+看待 855
+禁区 855
+鏅 855
+一来 854
+围剿 854
+带到 854
+步骤 854
+侗族 853
+偷偷 853
+奖惩 853
+嶈 853
+思索 853
+组长 853
+谨 853
+修订 852
+到位 852
+密布 852
+找出 852
+滋味 852
+盲目 852
+霜 852
+骞 852
+一则 851
+上半年 851
+忍受 851
+成员国 851
+抢救 851
+提取 851
+马扩 851
+骨头 851
+他妈的 850
+大圣 850
+天花 850
+打工 850
+执掌 850
+核桃 850
+虚假 850
+遍布 850
+面目 850
+预先 850
+上网 849
+侵占 849
+单个 849
+汞 849
+狱 849
+生出 849
+获胜 849
+金花 849
+销 849
+频 849
+一举 848
+万物 848
+坪 848
+字母 848
+工农 848
+恋爱 848
+架子 848
+红烧 848
+遥远 848
+闽 848
+一院制 847
+公报 847
+出自 847
+制造业 847
+君子 847
+咀 847
+宁可 847
+快步 847
+梍 847
+请教 847
+不致 846
+做成 846
+剧院 846
+协同 846
+四面 846
+埋伏 846
+延 846
+杨嗣昌 846
+称帝 846
+芦花 846
+记述 846
+掏 845
+昏 845
+更换 845
+析 845
+薄弱 845
+鼠 845
+发扬 844
+猜测 844
+皇位 844
+耳边 844
+防线 844
+一拳 843
+决不能 843
+加剧 843
+弥补 843
+拦住 843
+烘 843
+籗 843
+联军 843
+范 843
+防护 843
+姐妹 842
+明亮 842
+每逢 842
+毒性 842
+白衣 842
+蜿蜒 842
+诛 842
+四人帮 841
+屈原 841
+房县 841
+旅游局 841
+机械化 841
+栽 841
+漫 841
+烩 841
+牛金星 841
+眨 841
+篃 841
+缺口 841
+裙 841
+震动 841
+上山 840
+不一 840
+享 840
+尊敬 840
+手足 840
+方丈 840
+无非 840
+裁判 840
+鲢 840
+公务 839
+兴国 839
+叶莲子 839
+平稳 839
+攀 839
+文人 839
+犲 839
+立体 839
+翌年 839
+胎 839
+自家 839
+骑士 839
+下半年 838
+大名 838
+寒冷 838
+江青 838
+见解 838
+词典 838
+账 838
+起初 838
+阴影 838
+三十年 837
+专项 837
+刘邦 837
+县境 837
+料想 837
+晚会 837
+犳 837
+筹备 837
+身影 837
+高等学校 837
+十一届三中全会 836
+房里 836
+杨溥 836
+火把 836
+贝 836
+还原 836
+乳 835
+入学率 835
+改组 835
+杜 835
+滨 835
+牧 835
+血腥 835
+龙门 835
+冤 834
+尽数 834
+急剧 834
+渗透 834
+蕴藏 834
+重修 834
+仓库 833
+喘 833
+宝钗 833
+对策 833
+怀抱 833
+抵制 833
+江水 833
+郑州 833
+高举 833
+合资 832
+强盛 832
+戝 832
+束缚 832
+浑 832
+纯碱 832
+东北大学 831
+南门 831
+头领 831
+字数 831
+建材 831
+明叔 831
+晚饭 831
+段祺瑞 831
+傝 830
+出境 830
+带走 830
+敝 830
+文集 830
+条款 830
+质地 830
+韦 830
+不好意思 829
+南昌 829
+啪 829
+定居 829
+攻势 829
+来信 829
+书房 828
+夋 828
+妥 828
+新港 828
+无关 828
+机电 828
+繁华 828
+老山 828
+衰 828
+一品 827
+下半场 827
+中段 827
+乐观 827
+决不 827
+向着 827
+地理学 827
+焉 827
+误解 827
+难受 827
+中华民国 826
+了不起 826
+全国性 826
+出卖 826
+唐朝 826
+所致 826
+拟定 826
+机密 826
+棵 826
+河北省 826
+相传 826
+连夜 826
+释 826
+一首 825
+主演 825
+人民英雄纪念碑 825
+国防部长 825
+用品 825
+聊 825
+邓小平理论 825
+顺手 825
+不服 824
+化肥 824
+号令 824
+同义词 824
+圆满 824
+寿 824
+想来 824
+救国 824
+新城 824
+硬件 824
+纯属 824
+远离 824
+咦 823
+增产 823
+奈 823
+屯 823
+我校 823
+斯大林 823
+甘 823
+耐心 823
+富裕 822
+无色 822
+暮 822
+未曾 822
+游击队 822
+重檐 822
+鍜 822
+实物 821
+宠 821
+控股 821
+框架 821
+筹 821
+英格兰 821
+不明 820
+京剧 820
+兵马 820
+染色体 820
+约定 820
+荒漠 820
+遵 820
+长篇小说 820
+马来西亚 820
+东边 819
+借鉴 819
+参谋长 819
+名副其实 819
+汁 819
+风俗 819
+上书 818
+九江 818
+古巴 818
+新石器 818
+清清楚楚 818
+邓 818
+镜子 818
+陌生 818
+万余 817
+例行 817
+剁 817
+加之 817
+同行 817
+来讲 817
+灾害 817
+铸造 817
+只顾 816
+史学 816
+客厅 816
+来临 816
+肥沃 816
+阿富汗 816
+周芷若 815
+报酬 815
+水电站 815
+生殖 815
+语文 815
+遍及 815
+顽强 815
+典礼 814
+噢 814
+接口 814
+政事 814
+筷子 814
+会场 813
+动摇 813
+天赋 813
+娑 813
+法官 813
+海关 813
+眼色 813
+走路 813
+伽 812
+修正 812
+清水 812
+瑙 812
+虘 812
+讽刺 812
+锜 812
+长远 812
+各派 811
+后裔 811
+多万 811
+比武 811
+葡萄 811
+衫 811
+车上 811
+雪白 811
+介于 810
+内讧 810
+卜 810
+堆积 810
+姹 810
+实业 810
+效 810
+爱好 810
+霸 810
+馒头 810
+黄鹤楼 810
+不变资本 809
+佐 809
+养成 809
+大部 809
+山珍 809
+索性 809
+一家人 808
+国家森林公园 808
+巡 808
+戒指 808
+拣 808
+暗示 808
+最长 808
+本性 808
+东岳 807
+他家 807
+毛病 807
+王语 807
+盟 807
+称作 807
+糟 807
+获悉 807
+辣味 807
+雄伟 807
+一圈 806
+一所 806
+世间 806
+乞 806
+何不 806
+咳 806
+嫂 806
+宜都 806
+涉 806
+纪 806
+重庆市 806
+军长 805
+放出 805
+知府 805
+窖 805
+落叶 805
+鹿子霖 805
+万分 804
+万里 804
+心底 804
+打出 804
+数码 804
+肌肤 804
+胜迹 804
+衰落 804
+键 804
+分割 803
+售 803
+富于 803
+序列 803
+散发 803
+无可 803
+有的是 803
+结局 803
+缸 803
+造就 803
+一号 802
+光线 802
+古今 802
+圭 802
+摩擦 802
+有望 802
+来得及 802
+用心 802
+痛快 802
+蟹 802
+卢 801
+外国人 801
+导航 801
+思潮 801
+政治局 801
+旨在 801
+牛奶 801
+珠江 801
+荤 801
+凌辱 800
+喃喃 800
+套餐 800
+背部 800
+这一下 800
+选票 800
+陈水扁 800
+隆中 800
+黄家 800
+做事 799
+本章 799
+洋芋 799
+一脸 798
+两支 798
+头陀 798
+宰 798
+强行 798
+是因为 798
+潜江 798
+炮兵 798
+符 798
+肃 798
+遗留 798
+勮 797
+怀孕 797
+悲 797
+抽象 797
+时光 797
+朝着 797
+聚会 797
+车牌 797
+首长 797
+古希腊 796
+右派 796
+好转 796
+市内 796
+所有人 796
+担保 796
+拔出 796
+改制 796
+杀伤 796
+毁灭 796
+渔 796
+王夫人 796
+鲜卑 796
+不大 795
+单元 795
+奴役 795
+子宫 795
+帅 795
+消极 795
+演化 795
+熸 795
+各方 794
+市中心 794
+希特勒 794
+所指 794
+炮声 794
+藻 794
+进宫 794
+骄傲 794
+大侠 793
+宏 793
+山下 793
+舞美 793
+南面 792
+恒山 792
+惊奇 792
+拿来 792
+皇太极 792
+直言 792
+瞩目 792
+箢 792
+一队 791
+属性 791
+指向 791
+椅 791
+江面 791
+西岸 791
+险些 791
+高明 791
+五保 790
+前锋 790
+柱子 790
+株 790
+超级 790
+间接选举 790
+中华鲟 789
+厂长 789
+守城 789
+工作日 789
+总管 789
+法王 789
+溶剂 789
+相救 789
+藉 789
+襄州 789
+豌豆 789
+鄂西北 789
+陈刚 789
+高涨 789
+公职 788
+司长 788
+吉林 788
+嘴角 788
+四川省 788
+大洋 788
+徙 788
+改良 788
+此类 788
+流血 788
+纱 788
+这回 788
+领地 788
+华中师范大学 787
+复兴 787
+幼虫 787
+自学 787
+解答 787
+产于 786
+大举 786
+强劲 786
+特意 786
+糍粑 786
+美味 786
+菊 786
+较差 786
+长子 786
+餈粑 786
+一早 785
+几声 785
+崛起 785
+开出 785
+总队长 785
+数日 785
+明末 785
+确切 785
+碰上 785
+罗田 785
+阻碍 785
+驱逐舰 785
+丰 784
+侵蚀 784
+安乡 784
+山海关 784
+底部 784
+微生物 784
+现役 784
+经济作物 784
+贫 784
+勪 783
+变态 783
+山峯 783
+山峰 783
+晚辈 783
+月球 783
+根源 783
+联合国教科文组织 783
+贷 783
+远安 783
+验证 783
+前夕 782
+服侍 782
+灭绝 782
+繁忙 782
+落到 782
+连声 782
+集团军 782
+风情 782
+中队 781
+内城 781
+吏 781
+均衡 781
+担忧 781
+捕捞 781
+火药 781
+第七 781
+精英 781
+阳新 781
+风水 781
+首批 781
+七个 780
+嚼 780
+头儿 780
+带上 780
+恰当 780
+接见 780
+照例 780
+秃 780
+缺点 780
+角落 780
+譬如 780
+迹象 780
+勾结 779
+十七年 779
+坐标 779
+庢 779
+报复 779
+星火计划 779
+石桥 779
+辇 779
+卤 778
+国共 778
+嬫 778
+弗 778
+快照 778
+智能 778
+生产方式 778
+留给 778
+站长 778
+竞选 778
+逐年 778
+配件 778
+一等 777
+万公斤 777
+中密度 777
+冈 777
+可知 777
+大厦 777
+差额选举 777
+折腾 777
+接应 777
+揖 777
+江夏郡 777
+猪肉 777
+相处 777
+造纸 777
+其后 776
+北岸 776
+北门 776
+女郎 776
+报刊 776
+栏杆 776
+械 776
+欢呼 776
+汇合 776
+泰山 776
+病变 776
+纸坊 776
+草丛 776
+营建 776
+讲述 776
+钟祥市 776
+静静地 776
+中专 775
+亲征 775
+傍晚 775
+劳动人民文化宫 775
+华东 775
+喇 775
+屋脊 775
+水电 775
+统率 775
+个股 774
+博 774
+敦煌 774
+新华门 774
+桑 774
+清新 774
+请问 774
+国产 773
+天河机场 773
+拾荒 773
+珠宝 773
+中央委员会 772
+中洲 772
+倘使 772
+冷却 772
+华容县 772
+嗓子 772
+宏伟 772
+恩施土家族苗族自治州 772
+掩护 772
+滚滚 772
+项羽 772
+各处 771
+哭声 771
+星系 771
+罗清泉 771
+翰林院 771
+蓦地 771
+贾志杰 771
+赤道 771
+闪电 771
+一世 770
+创始人 770
+南极 770
+城郊 770
+昆 770
+构 770
+终端 770
+英国人 770
+长春 770
+不中 769
+王国生 769
+蒋祝平 769
+贫苦 769
+跟前 769
+身为 769
+逾 769
+随机 769
+使馆 768
+傅德辉 768
+刘子华 768
+北面 768
+张湾区 768
+杨桥湖 768
+棚 768
+焦山河 768
+玩具 768
+珞狮 768
+看成 768
+襄城区 768
+走马岭 768
+选用 768
+透过 768
+陡 768
+隐蔽 768
+预警 768
+高基庙 768
+鸠 768
+催促 767
+向下 767
+堪 767
+朝阳 767
+机能 767
+流露 767
+短信 767
+默默地 767
+下山 766
+企 766
+况且 766
+瑞 766
+行程 766
+被俘 766
+适 766
+风声 766
+亚运会 765
+大刀 765
+徐州 765
+徐阶 765
+拢 765
+正统 765
+中枢 764
+其时 764
+写成 764
+匾 764
+噷 764
+妥协 764
+山头 764
+电报 764
+韵 764
+中将 763
+传达 763
+出兵 763
+场地 763
+宝库 763
+惠 763
+挫折 763
+滇 763
+白帆 763
+观音 763
+转型 763
+长久 763
+陈述 763
+一味 762
+一路上 762
+上台 762
+体质 762
+保守 762
+历程 762
+处在 762
+寂寞 762
+老夫 762
+脱身 762
+荣获 762
+中央政治局 761
+之江 761
+命中 761
+豹 761
+长期以来 761
+阁下 761
+以示 760
+全球化 760
+提拔 760
+编成 760
+转为 760
+大幅度 759
+宦官 759
+对准 759
+山寨 759
+嵋 759
+树枝 759
+正规 759
+话剧 759
+近似 759
+闪烁 759
+问世 759
+促 758
+景色 758
+珊瑚 758
+瓷 758
+繀 758
+绉 758
+阵法 758
+八角 757
+勯 757
+帽 757
+彦 757
+操作系统 757
+无穷 757
+自愿 757
+醋 757
+各路 756
+呻吟 756
+喜悦 756
+平定 756
+断裂 756
+显赫 756
+漕运 756
+耙 756
+膳 756
+迫切 756
+风气 756
+驱动 756
+大字 755
+性情 755
+持久 755
+掌门人 755
+本届 755
+糟糕 755
+芙蓉 755
+这笔 755
+举措 754
+事宜 754
+名将 754
+左边 754
+桩 754
+浪漫 754
+狮 754
+贾政 754
+那日 754
+交叉 753
+今夜 753
+刑罚 753
+大红 753
+慕容 753
+或者说 753
+效力 753
+欺骗 753
+程灵素 753
+素有 753
+诚 753
+催化剂 752
+头目 752
+私营 752
+词汇 752
+中型 751
+天星 751
+搞好 751
+真诚 751
+西洋 751
+会后 750
+军政 750
+啗 750
+躯体 750
+剿 749
+声势 749
+奉命 749
+并入 749
+旱 749
+流出 749
+闀 749
+中央军委 748
+低温 748
+可比 748
+差点 748
+木头 748
+歼 748
+演讲 748
+迎来 748
+三十 747
+亲手 747
+以至于 747
+动弹 747
+地毯 747
+声称 747
+感慨 747
+文武百官 747
+歼灭 747
+疑心 747
+罐子 747
+老鼠 747
+设计师 747
+钞 747
+陈独秀 747
+除去 747
+一响 746
+印第安人 746
+山中 746
+用不着 746
+艳 746
+再有 745
+启发 745
+案例 745
+点儿 745
+相近 745
+老头子 745
+芬兰 745
+讨厌 745
+丛林 744
+决定性 744
+器械 744
+地壳 744
+境外 744
+房门 744
+来历 744
+桥面 744
+禁地 744
+逸 744
+鑳 744
+一株 743
+埌 743
+干活 743
+恶化 743
+狭 743
+畅 743
+继位 743
+贯 743
+不仅如此 742
+严谨 742
+修筑 742
+千里 742
+变质 742
+嘻 742
+暴 742
+月经 742
+森 742
+直属 742
+积分 742
+维也纳 742
+颊 742
+一斤 741
+外出 741
+少爷 741
+捷 741
+电子书 741
+集成 741
+驻外 741
+加深 740
+局限 740
+帐篷 740
+排水 740
+放大 740
+歌剧 740
+活泼 740
+瀵 740
+相交 740
+相接 740
+第三批 740
+门槛 740
+难民 740
+鸳鸯 740
+保定 739
+公顷 739
+大纲 739
+忧虑 739
+悔 739
+段正淳 739
+水量 739
+汉水 739
+沉思 739
+锦衣卫 739
+陷于 739
+受过 738
+梁山 738
+炎 738
+炮塔 738
+薪水 738
+一端 737
+处理器 737
+手势 737
+朝代 737
+楼下 737
+石化 737
+贩卖 737
+共有 736
+态势 736
+洞口 736
+一点点 735
+一组 735
+三日 735
+列宁 735
+奉献 735
+姥 735
+小康 735
+强势 735
+果树 735
+潃 735
+补给 735
+语法 735
+链接 735
+交战 734
+宋朝 734
+尾巴 734
+彝族 734
+形制 734
+放下 734
+斤 734
+栏 734
+牌子 734
+罐 734
+陶 734
+默默 734
+不定 733
+侵略军 733
+参赛 733
+少于 733
+手工 733
+管家 733
+羹 733
+腐蚀 733
+起草 733
+集结 733
+入学 732
+创造性 732
+十足 732
+富户 732
+方位 732
+水位 732
+津贴 732
+王位 732
+老虎 732
+龢 732
+不多时 731
+垬 731
+有用 731
+眼镜 731
+硫 731
+神态 731
+罪恶 731
+迁移 731
+队友 731
+商场 730
+彩色 730
+志愿者 730
+癌症 730
+线索 730
+结晶 730
+驻扎 730
+鸦片 730
+一侧 729
+两件 729
+体育场 729
+南侧 729
+原油 729
+感叹 729
+来得 729
+璺 729
+背影 729
+语族 729
+遇见 729
+铀 729
+三路 728
+匠 728
+反之 728
+大妈 728
+归来 728
+猜想 728
+良心 728
+难怪 728
+面孔 728
+兰州 727
+外人 727
+契约 727
+搴 727
+摆在 727
+水上 727
+马匹 727
+鳄 727
+一共 726
+上方 726
+东欧 726
+义务教育 726
+半空 726
+协作 726
+各种各样 726
+忽地 726
+簿 726
+苍白 726
+行驶 726
+阿里 726
+你好 725
+写出 725
+双喜 725
+实体 725
+幽 725
+斩首 725
+注射 725
+浙 725
+编钟 725
+跃起 725
+重型 725
+全局 724
+内在 724
+北洋军阀 724
+在位 724
+士气 724
+开玩笑 724
+胀 724
+记者会 724
+佑 723
+兴衰 723
+唬 723
+圣地 723
+挡住 723
+看不到 723
+经受 723
+薪 723
+警 723
+谷地 723
+事迹 722
+吸取 722
+塌 722
+大权 722
+常绿 722
+弃权 722
+楞 722
+浙江省 722
+米格 722
+讲求 722
+亲属 721
+入境 721
+单刀 721
+好奇 721
+当做 721
+按时 721
+最好 721
+箱子 721
+茅 721
+赞同 721
+运算 721
+进驻 721
+钥匙 721
+饿死 721
+不由 720
+出击 720
+桶 720
+武警 720
+百分点 720
+致命 720
+往日 719
+捆 719
+沦为 719
+流贼 719
+现任 719
+稍微 719
+立足 719
+酒杯 719
+采集 719
+高产 719
+鼓吹 719
+压缩 718
+参见 718
+宣称 718
+山东省 718
+悉 718
+无霜期 718
+踏上 718
+告辞 717
+扶贫 717
+挥舞 717
+本省 717
+牲畜 717
+诏令 717
+轻工业 717
+中小企业 716
+定然 716
+握住 716
+知识产权 716
+罕 716
+遏制 716
+鏀 716
+功臣 715
+嗘 715
+婆 715
+新人 715
+沉着 715
+申 715
+阅 715
+黑夜 715
+二哥 714
+你家 714
+勭 714
+哑巴 714
+垂体 714
+太和 714
+彬 714
+黎 714
+剧团 713
+柯镇恶 713
+秋天 713
+罗刹 713
+裕 713
+订立 713
+分辨 712
+深夜 712
+瞎子 712
+隐居 712
+义父 711
+外事 711
+女生 711
+孤独 711
+宋思明 711
+层层 711
+总监 711
+沿途 711
+索尼 711
+草地 711
+调解 711
+车子 711
+郑重 711
+金水桥 711
+支队 710
+攻破 710
+梯 710
+葱 710
+首饰 710
+龄 710
+万人次 709
+上将 709
+严嵩 709
+捎 709
+操练 709
+绿豆 709
+董小宛 709
+螺 709
+衙署 709
+还好 709
+也罢 708
+布什 708
+当面 708
+播种 708
+水土 708
+犹太人 708
+皖 708
+红花 708
+身份证 708
+躲避 708
+不理 707
+后宫 707
+直辖 707
+窗户 707
+管理者 707
+紊乱 707
+课堂 707
+负面 707
+勤劳 706
+大伙 706
+大夥 706
+摹 706
+杉 706
+白马 706
+西向东 706
+轻工 706
+凝聚 705
+功劳 705
+澧 705
+脚步声 705
+认同 705
+野外 705
+陕北 705
+骆驼 705
+高档 705
+人影 704
+充实 704
+入口 704
+北侧 704
+大姐 704
+好多 704
+工地 704
+敌后 704
+管制 704
+聚居 704
+至尊 704
+藩 704
+裁决 704
+闪避 704
+鸟瞰 704
+八卦 703
+博客 703
+印度尼西亚 703
+救援 703
+文革 703
+胡说八道 703
+行星 703
+预 703
+一时期 702
+凌晨 702
+几日 702
+利税 702
+器材 702
+大树 702
+幸好 702
+捷克 702
+流失 702
+疗法 702
+联手 702
+饭菜 702
+鹅 702
+仪琳 701
+入市 701
+分公司 701
+双边 701
+哗 701
+夸张 701
+妖精 701
+德军 701
+行礼 701
+迫害 701
+陶瓷 701
+下滑 700
+两端 700
+体操 700
+挟 700
+欐 700
+部件 700
+预报 700
+下落 699
+所有权 699
+无线 699
+来时 699
+紧接着 699
+胡说 699
+阶梯 699
+凶猛 698
+十三年 698
+十二年 698
+可笑 698
+新高 698
+浩浩荡荡 698
+盼望 698
+结算 698
+行径 698
+路桥 698
+金牙 698
+铮 698
+余地 697
+关怀 697
+反动派 697
+后退 697
+呐喊 697
+第六 697
+耽误 697
+赣 697
+路边 697
+进京 697
+乌克兰 696
+仇恨 696
+噁心 696
+年末 696
+恶心 696
+警方 696
+诀 696
+谷物 696
+酱 696
+鐜 696
+锥 696
+震撼 696
+青睐 696
+不对 695
+交错 695
+力图 695
+哀 695
+多用 695
+对了 695
+所有制 695
+担子 695
+攻打 695
+林立 695
+沙僧 695
+涘 695
+牢固 695
+琳 695
+神奇 695
+糯米 695
+细致 695
+脉冲 695
+节省 695
+贝尔 695
+金星 695
+党组织 694
+应届 694
+灾区 694
+轮流 694
+主角 693
+俞 693
+堕 693
+境地 693
+增收 693
+夔 693
+外形 693
+电池 693
+纺织品 693
+终止 693
+绳子 693
+蒿 693
+行列 693
+试试 693
+辱 693
+陵墓 693
+南亚 692
+四项 692
+墓室 692
+姿势 692
+寂静 692
+搜集 692
+浓缩 692
+甘蔗 692
+疗效 692
+碟 692
+自然科学 692
+计较 692
+都市 692
+前所未有 691
+品位 691
+坏人 691
+武汉大学 691
+注定 691
+耕作 691
+茫然 691
+谈谈 691
+难过 691
+驸马 691
+创制 690
+口腔 690
+坚守 690
+曼 690
+法人 690
+言论 690
+压制 689
+吴摩西 689
+国际化 689
+氛围 689
+照射 689
+罚款 689
+运转 689
+迷信 689
+今儿 688
+名列 688
+抗敌 688
+挪 688
+烟草 688
+神农 688
+隔离 688
+一串 687
+乘坐 687
+兼有 687
+可口 687
+慈 687
+朝政 687
+求得 687
+百花 687
+秦岭 687
+竣工 687
+走势 687
+下设 686
+伊斯兰 686
+兄长 686
+加班 686
+山路 686
+御史 686
+敌方 686
+明珠 686
+柔软 686
+洪武 686
+老家 686
+行军 686
+长长的 686
+助手 685
+区划 685
+厚度 685
+告知 685
+外围 685
+师徒 685
+慕容复 685
+王子 685
+芝 685
+衣襟 685
+鄂豫皖 685
+中兴 684
+介入 684
+似地 684
+怡 684
+成化 684
+抛弃 684
+栖 684
+篮 684
+苏格拉底 684
+范蠡 684
+轰炸 684
+远销 684
+镇上 684
+陈瞎子 684
+兔 683
+厄 683
+格式 683
+每股 683
+版图 683
+窃 683
+诚信 683
+门类 683
+驿 683
+大块 682
+察看 682
+惭愧 682
+板栗 682
+游牧 682
+第二年 682
+羡慕 682
+贿赂 682
+过境 682
+选举权 682
+为名 681
+凝视 681
+刊登 681
+地板 681
+天大 681
+导师 681
+正月 681
+永不 681
+洞穴 681
+莎 681
+议程 681
+贡 681
+饵 681
+岳飞 680
+往事 680
+温泉 680
+溶于 680
+激战 680
+煌 680
+牵引 680
+破裂 680
+贫穷 680
+体格 679
+保住 679
+呛 679
+哉 679
+圣旨 679
+天山 679
+小孔 679
+惊呼 679
+未经 679
+榻 679
+生源 679
+荷叶 679
+贬值 679
+轮船 679
+实战 678
+当成 678
+楼梯 678
+水源 678
+注意力 678
+澳 678
+白宫 678
+研讨会 678
+营长 678
+薛 678
+该校 678
+都察院 678
+骡子 678
+上报 677
+农历 677
+出场 677
+拴 677
+热门 677
+生物学 677
+盾 677
+耕 677
+脂 677
+见证 677
+逼近 677
+长治久安 677
+隆庆 677
+静脉 677
+世袭 676
+东晋 676
+乡下 676
+勤 676
+千尺 676
+山石 676
+护士 676
+焦虑 676
+两手 675
+保密 675
+傣族 675
+反潜 675
+尽头 675
+巢 675
+带头 675
+择 675
+李鹏 675
+短暂 675
+蒙古人 675
+赋税 675
+事务所 674
+开办 674
+下旬 673
+书面 673
+双臂 673
+啤酒 673
+排队 673
+绳索 673
+鸭子 673
+九个 672
+右边 672
+坠 672
+广布 672
+店铺 672
+忍耐 672
+慌忙 672
+掠夺 672
+搏斗 672
+无可奈何 672
+生产能力 672
+石门 672
+视觉 672
+身着 672
+马良 672
+二世 671
+刑法 671
+单词 671
+反共 671
+向阳 671
+壁上 671
+头痛 671
+女婿 671
+宾馆 671
+氮 671
+油画 671
+疑难 671
+第三个 671
+西亚 671
+西城 671
+迄今 671
+重用 671
+乘客 670
+传送 670
+南斯拉夫 670
+古都 670
+咽喉 670
+四通八达 670
+复制 670
+幸运 670
+成交 670
+诸位 670
+起兵 670
+黎明 670
+主教练 669
+卫士 669
+历史性 669
+司马 669
+困扰 669
+大大的 669
+察觉 669
+工序 669
+帕 669
+树林 669
+爱尔兰 669
+红外 669
+钾 669
+一棵 668
+亯 668
+党政 668
+咧 668
+大中型 668
+徐天宏 668
+研 668
+秦始皇 668
+腻 668
+舍不得 668
+观赏 668
+造船 668
+郊区 668
+五四 667
+刘秀 667
+刚好 667
+呼唤 667
+圆柱 667
+家电 667
+市级 667
+枉 667
+芦苇 667
+诸国 667
+隐瞒 667
+餐厅 667
+主帅 666
+五十 666
+动荡 666
+同类 666
+嵩山 666
+性子 666
+治权 666
+痛哭 666
+英军 666
+顶端 666
+黑白 666
+以求 665
+共享 665
+喜事 665
+墓葬 665
+当晚 665
+当着 665
+抗击 665
+祐 665
+粪 665
+红娘子 665
+诲 665
+重镇 665
+重阳 665
+陈列 665
+不耐烦 664
+军费 664
+化为 664
+压抑 664
+啃 664
+娉 664
+布满 664
+建工 664
+戜 664
+教室 664
+显示器 664
+望远镜 664
+柜 664
+江西省 664
+破译 664
+裤子 664
+一隅 663
+三名 663
+三角洲 663
+两声 663
+云雾 663
+会晤 663
+宫城 663
+油田 663
+胸膛 663
+通高 663
+邑 663
+雇 663
+首座 663
+不知不觉 662
+举人 662
+先令 662
+双重 662
+嬩 662
+无偿 662
+残疾 662
+流水 662
+理财 662
+省政府 662
+胜地 662
+修炼 661
+债 661
+十一五 661
+屁 661
+打造 661
+日渐 661
+波长 661
+滋 661
+胈 661
+芒 661
+英特尔 661
+魏忠贤 661
+作文 660
+外科 660
+客场 660
+导游 660
+总共 660
+水文 660
+用药 660
+艾 660
+食堂 660
+馔 660
+马路 660
+三元 659
+两下 659
+两道 659
+党派 659
+凹 659
+吐蕃 659
+嘉平 659
+张英才 659
+愈来愈 659
+振兴 659
+敷 659
+汉文 659
+添加剂 659
+港澳 659
+莫名其妙 659
+菑 659
+通达 659
+中下游 658
+发抖 658
+商贸 658
+坚实 658
+岳阳 658
+引人注目 658
+泥沙 658
+畜 658
+胜过 658
+贾琏 658
+钻进 658
+三座门 657
+上线 657
+不当 657
+乐队 657
+互动 657
+侥幸 657
+倒退 657
+力争 657
+器物 657
+外文 657
+寓 657
+导向 657
+巡逻 657
+年级 657
+怒火 657
+洋人 657
+照样 657
+独具 657
+那年 657
+亮相 656
+对外贸易 656
+平生 656
+扳 656
+育 656
+野战军 656
+鞘 656
+不容 655
+乌云 655
+全国代表大会 655
+八一 655
+列强 655
+右倾 655
+四名 655
+增至 655
+天河 655
+常德 655
+房价 655
+拰 655
+玉器 655
+终年 655
+脆 655
+虑 655
+被子 655
+颠 655
+九间 654
+人民军队 654
+厂家 654
+周末 654
+囧 654
+庝 654
+意愿 654
+收支 654
+亲密 653
+体长 653
+余家 653
+名声 653
+四面八方 653
+平坦 653
+打下 653
+敞开 653
+新娘 653
+新浪 653
+木板 653
+畸形 653
+积温 653
+访谈 653
+赶回 653
+运送 653
+门票 653
+世界各地 652
+佷 652
+假装 652
+娇 652
+宁静 652
+往后 652
+攻入 652
+民主主义 652
+皮子 652
+簇拥 652
+饲 652
+倡议 651
+养老 651
+兼顾 651
+卫生部 651
+庡 651
+影响力 651
+杉木 651
+杨林 651
+极度 651
+检 651
+步行 651
+江中 651
+活塞 651
+相遇 651
+美誉 651
+而后 651
+舍得 651
+迈进 651
+锤 651
+防水 651
+顶上 651
+上课 650
+不可避免 650
+吸引力 650
+属下 650
+微波 650
+插入 650
+文官 650
+暗自 650
+李麦 650
+气质 650
+熟练 650
+第一位 650
+风采 650
+债券 649
+券门 649
+前方 649
+动身 649
+天皇 649
+张召重 649
+时时 649
+杰 649
+气愤 649
+涅 649
+甘心 649
+石窟 649
+系数 649
+金轮 649
+一块儿 648
+前身 648
+劝说 648
+善人 648
+巡航导弹 648
+时说 648
+物理学家 648
+降落 648
+霍青桐 648
+专科学校 647
+且说 647
+华南 647
+容貌 647
+放射性 647
+新西兰 647
+枢 647
+假使 646
+冯 646
+击中 646
+别名 646
+变法 646
+城头 646
+天麻 646
+实录 646
+扭转 646
+政治委员 646
+机票 646
+爱心 646
+瑶族 646
+频频 646
+丞 645
+侧面 645
+冲出 645
+尺度 645
+战败 645
+搓 645
+摇晃 645
+杀手 645
+永安 645
+泽 645
+热心 645
+石膏 645
+绢 645
+阻力 645
+三类 644
+不至于 644
+卑 644
+服务器 644
+梁枋 644
+毫克 644
+略有 644
+磅 644
+纵贯 644
+绕过 644
+调和 644
+那次 644
+预言 644
+高空 644
+五色土 643
+叙利亚 643
+增殖 643
+朝鲜族 643
+木婉清 643
+殷 643
+精通 643
+蓝天 643
+部族 643
+一战 642
+上演 642
+亚太地区 642
+几经 642
+唯有 642
+排斥 642
+构件 642
+殖民者 642
+涵盖 642
+綋 642
+舒适 642
+解散 642
+趟 642
+露天 642
+一期 641
+俯身 641
+写信 641
+善良 641
+山口 641
+并肩 641
+弱点 641
+甲板 641
+赏赐 641
+驻足 641
+中书省 640
+公生桥 640
+北魏 640
+太守 640
+左翼 640
+打入 640
+拧 640
+无从 640
+王纬宇 640
+电磁 640
+相识 640
+看清 640
+罗马帝国 640
+辑 640
+金刚 640
+镶 640
+除外 640
+乐于 639
+哑 639
+巾 639
+无处 639
+无效 639
+日记 639
+贪污 639
+轻型 639
+归属 638
+折子 638
+拍拍 638
+暴动 638
+镇定 638
+书生 637
+任用 637
+借款 637
+后方 637
+御驾 637
+摔倒 637
+繁多 637
+纠缠 637
+绍兴 637
+若非 637
+规范化 637
+身形 637
+追溯 637
+里边 637
+两块 636
+军团 636
+利于 636
+播 636
+数年 636
+梁启超 636
+电路 636
+胜负 636
+解体 636
+警官 636
+降价 636
+元首 635
+前边 635
+咸丰 635
+大山 635
+威严 635
+庶 635
+整治 635
+显出 635
+本版 635
+渤海 635
+绾 635
+腊 635
+衣裳 635
+师生 634
+昆仑 634
+映 634
+柬埔寨 634
+武官 634
+渡过 634
+潇湘 634
+炸药 634
+碰撞 634
+篮球 634
+肩上 634
+胡乱 634
+诗篇 634
+赚钱 634
+信贷 633
+内政 633
+再生 633
+冷汗 633
+半径 633
+喜剧 633
+常年 633
+故城 633
+最高峯 633
+最高峰 633
+面色 633
+下次 632
+伤势 632
+侄儿 632
+台湾省 632
+排长 632
+照明 632
+狩猎 632
+獾 632
+痒 632
+祥 632
+轩 632
+使出 631
+假期 631
+台北 631
+成千上万 631
+自卫 631
+航空母舰 631
+输送 631
+选项 631
+都督府 631
+防卫 631
+魔教 631
+一员 630
+三千 630
+五岳 630
+五嶽 630
+伴生 630
+分量 630
+天亮 630
+妨碍 630
+市政 630
+归纳 630
+形似 630
+惯例 630
+战马 630
+爽 630
+精致 630
+趁机 630
+依托 629
+关系人 629
+剩余 629
+医师 629
+合计 629
+嚭 629
+安抚 629
+开业 629
+悬殊 629
+捅 629
+掠过 629
+数次 629
+水生 629
+犱 629
+生产者 629
+署 629
+院内 629
+不忍 628
+关公 628
+再三 628
+农场 628
+教徒 628
+田伯光 628
+白族 628
+神功 628
+细细 628
+胎儿 628
+重任 628
+高僧 628
+修养 627
+全场 627
+几名 627
+匪 627
+十七 627
+喊声 627
+威风 627
+承包 627
+熊廷弼 627
+甲鱼 627
+看望 627
+神气 627
+罪名 627
+赠 627
+赶忙 627
+餐 627
+乖 626
+外观 626
+大选 626
+捕食 626
+两眼 625
+产权 625
+叛 625
+呕吐 625
+固有 625
+奋力 625
+寺院 625
+径直 625
+提防 625
+操心 625
+数据库 625
+比比皆是 625
+瞻仰 625
+突围 625
+站住 625
+通天 625
+金代 625
+陕 625
+黄连 625
+争执 624
+动力学 624
+宝石 624
+客店 624
+往年 624
+扶持 624
+有无 624
+服饰 624
+极限 624
+石器 624
+视野 624
+一月 623
+下班 623
+刀法 623
+剪刀 623
+宽广 623
+忙碌 623
+抚摸 623
+澄 623
+许可 623
+追问 623
+为着 622
+几条 622
+右侧 622
+彩陶 622
+挎 622
+数十年 622
+焕然一新 622
+癌 622
+血压 622
+隶 622
+为民 621
+仅供参考 621
+化解 621
+吆喝 621
+在职 621
+护卫 621
+日照 621
+槦 621
+气流 621
+穿越 621
+紫色 621
+胁 621
+初年 620
+千古 620
+呼叫 620
+圆圆 620
+天下第一 620
+小雨 620
+工场 620
+惊慌 620
+慷慨 620
+政制 620
+流氓 620
+澹 620
+蘑菇 620
+钢材 620
+陆游 620
+八旗 619
+官话 619
+恨不得 619
+旅馆 619
+明教 619
+查处 619
+骨骼 619
+中南 618
+关中 618
+农田 618
+卑职 618
+反攻 618
+围着 618
+奈何 618
+字体 618
+居多 618
+户籍 618
+抗生素 618
+朱红 618
+濮 618
+身心 618
+镜头 618
+三军 617
+不利于 617
+前一天 617
+剧目 617
+原谅 617
+吸烟 617
+尼姑 617
+怯 617
+排出 617
+早晚 617
+望见 617
+漆黑 617
+独立自主 617
+理应 617
+省外 617
+职员 617
+车间 617
+逻 617
+野心 617
+量子 617
+金丝 617
+驰名中外 617
+中国人民政治协商会议 616
+光电 616
+地狱 616
+城堡 616
+奔跑 616
+林丹 616
+泡沫 616
+盛大 616
+赞扬 616
+近年 616
+雍正 616
+驻军 616
+刨 615
+咏 615
+回身 615
+官署 615
+崇高 615
+弹劾 615
+新风 615
+水底 615
+瘤 615
+空运 615
+耳光 615
+请安 615
+销售额 615
+两点 614
+亡国 614
+仿 614
+分水岭 614
+务实 614
+吟 614
+学堂 614
+幽默 614
+推理 614
+摆手 614
+渔民 614
+点评 614
+看好 614
+眠 614
+空调 614
+统治阶级 614
+运输机 614
+里头 614
+金学曾 614
+鐩 614
+三角 613
+交易所 613
+仪表 613
+儒 613
+其他人 613
+勇士 613
+捕鱼 613
+李莲英 613
+洼地 613
+炒菜 613
+羁縻 613
+蕃 613
+邻居 613
+中道 612
+全称 612
+冷冷的 612
+列举 612
+太师 612
+小船 612
+抓紧 612
+淮河 612
+湖面 612
+眉毛 612
+矿冶 612
+薪酬 612
+衣袖 612
+财经 612
+饲料 612
+减弱 611
+可行 611
+惊动 611
+新民主主义 611
+欧洲人 611
+溅 611
+潮湿 611
+牡 611
+王守仁 611
+畏 611
+病因 611
+祭天 611
+自治权 611
+过后 611
+二来 610
+修复 610
+国人 610
+坚固 610
+数十 610
+河段 610
+深山 610
+物流 610
+租界 610
+稍稍 610
+说服 610
+赌博 610
+选定 610
+锦州 610
+农奴 609
+初级阶段 609
+危 609
+城池 609
+好不好 609
+常住 609
+弥漫 609
+忠诚 609
+情势 609
+愈合 609
+棉纺织 609
+歌舞 609
+潜水 609
+炎帝 609
+盛开 609
+两行 608
+取名 608
+媒介 608
+容纳 608
+紧急状态 608
+邢 608
+魏晋 608
+黎族 608
+临近 607
+寄生 607
+小儿 607
+檀 607
+积雪 607
+米兰 607
+被选举权 607
+解读 607
+起码 607
+过剩 607
+迎面 607
+退役 607
+适应性 607
+两头 606
+中介 606
+十五大 606
+园艺场 606
+庄重 606
+恒 606
+海军陆战队 606
+火炬 606
+门楼 606
+陈文洪 606
+一枝 605
+做人 605
+应急 605
+无须 605
+有限责任 605
+望望 605
+浇 605
+爱因斯坦 605
+芥 605
+蒲 605
+调研 605
+野兽 605
+默 605
+仲裁 604
+巨额 604
+感冒 604
+技术开发区 604
+旺盛 604
+犯规 604
+省城 604
+纪念馆 604
+辈出 604
+高等院校 604
+伺候 603
+冷战 603
+增进 603
+官场 603
+定价 603
+猎 603
+禹 603
+第一天 603
+轻声 603
+刺刀 602
+叫卖 602
+可可 602
+四级 602
+心疼 602
+忒 602
+捻 602
+油脂 602
+耗 602
+耗费 602
+规章 602
+觉醒 602
+训练班 602
+颂 602
+夎 601
+大专 601
+崔 601
+所学 601
+整修 601
+水路 601
+江河 601
+球场 601
+石碑 601
+而立 601
+腊肉 601
+诱惑 601
+选任 601
+几百年 600
+奋起 600
+布依族 600
+恭敬 600
+慎重 600
+战俘 600
+控制系统 600
+推测 600
+教导 600
+极好 600
+柴禾 600
+海岸线 600
+牛顿 600
+丘处机 599
+全文 599
+分院 599
+十几个 599
+喉 599
+富贵 599
+柬 599
+海内外 599
+烟雾 599
+知觉 599
+石壁 599
+赛事 599
+跨度 599
+这部分 599
+邮政编码 599
+重申 599
+乍 598
+仙人 598
+国家计划 598
+投机 598
+斟 598
+新河 598
+枕头 598
+潼关 598
+禁不住 598
+致远 598
+詹姆斯 598
+趾 598
+一新 597
+全权代表 597
+公寓 597
+唯独 597
+国立 597
+徘徊 597
+快快 597
+快要 597
+犬 597
+评审 597
+造诣 597
+雇佣 597
+企业家 596
+伦理 596
+修理 596
+卧室 596
+反思 596
+坏事 596
+多余 596
+夷陵 596
+定理 596
+开幕 596
+矿藏 596
+秦汉 596
+绿萼 596
+罗马尼亚 596
+全城 595
+刀子 595
+失利 595
+懒 595
+招商 595
+最先 595
+棰 595
+滀 595
+蜈蚣 595
+转念 595
+铃 595
+事变 594
+会议室 594
+兼职 594
+孙四海 594
+开头 594
+恶人 594
+数值 594
+炯 594
+电源 594
+秀才 594
+蛛 594
+邵大侠 594
+东城 593
+京广铁路 593
+僵尸 593
+功效 593
+小时候 593
+我行 593
+杜绝 593
+武陵 593
+泉水 593
+营地 593
+轿子 593
+通讯社 593
+钧 593
+关头 592
+凝神 592
+半截 592
+卓越 592
+喉咙 592
+坚硬 592
+外援 592
+子公司 592
+屈 592
+惯性 592
+指导员 592
+文责自负 592
+昂 592
+渡江 592
+藤 592
+赵寄客 592
+一台 591
+一模一样 591
+不久前 591
+变异 591
+土特产 591
+掀开 591
+林平 591
+转运 591
+雨水 591
+餐馆 591
+饥饿 591
+公道 590
+动人 590
+协 590
+国务 590
+培训班 590
+增值 590
+工农业 590
+币 590
+开阔 590
+晶 590
+末期 590
+沙丘 590
+票价 590
+紟 590
+阿拉伯人 590
+院中 590
+两方 589
+住院 589
+促成 589
+城垣 589
+展出 589
+心愿 589
+拚命 589
+掩盖 589
+概率 589
+欺侮 589
+殡葬 589
+献策 589
+综合治理 589
+荒凉 589
+被统治者 589
+重力 589
+阔叶树 589
+鲜艳 589
+鹏 589
+外交部长 588
+大顺 588
+季后赛 588
+烧饼 588
+煞 588
+独裁 588
+研讨 588
+移居 588
+群众性 588
+越国 588
+镁 588
+三家 587
+两栖 587
+中小 587
+中途 587
+健身 587
+四位 587
+团团 587
+填补 587
+尸首 587
+屏幕 587
+归于 587
+损坏 587
+数万 587
+无辜 587
+曹禺 587
+替换 587
+林中 587
+果断 587
+泥土 587
+肝脏 587
+蛋壳 587
+问起 587
+黄庭坚 587
+乳房 586
+倒霉 586
+共识 586
+劧 586
+大功 586
+新民主主义革命 586
+权限 586
+永久 586
+瓷器 586
+补贴 586
+赫 586
+退回 586
+黑龙江省 586
+光彩 585
+发送 585
+喊道 585
+嗐 585
+大河 585
+思念 585
+散布 585
+斌 585
+枭 585
+知晓 585
+社长 585
+签名 585
+算术 585
+苦难 585
+野人 585
+风雨 585
+飞舞 585
+公安局 584
+宝刀 584
+归还 584
+扩充 584
+抗争 584
+焚 584
+熶 584
+祠 584
+粮食作物 584
+茨 584
+融 584
+观光 584
+阻挡 584
+乔 583
+体检 583
+奉行 583
+客车 583
+山珍海味 583
+总和 583
+星云 583
+琛 583
+璋 583
+电视剧 583
+睦 583
+胤 583
+身受 583
+辛楣 583
+遗体 583
+长江三峡 583
+信件 582
+刻有 582
+危难 582
+回旋 582
+夏风 582
+字样 582
+幸亏 582
+惊醒 582
+显露 582
+最深 582
+民主党派 582
+罪行 582
+虞 582
+过儿 582
+钴 582
+静止 582
+事例 581
+二氧化碳 581
+宏大 581
+岩浆 581
+巷 581
+平津 581
+拈阄 581
+捕捉 581
+有钱 581
+白莲 581
+紶 581
+腹地 581
+苦苦 581
+说实话 581
+赞赏 581
+遥 581
+二十七年 580
+党组 580
+大湖 580
+完工 580
+幕僚 580
+气派 580
+水泵 580
+牌楼 580
+纬 580
+脑海 580
+苏军 580
+袖子 580
+贫民 580
+迹 580
+乖乖 579
+光谱 579
+剧场 579
+发展观 579
+好不容易 579
+打架 579
+批量 579
+护卫舰 579
+断定 579
+派兵 579
+演唱 579
+爵 579
+狼狈 579
+畜牧 579
+而论 579
+预料 579
+关山 578
+关税 578
+北洋政府 578
+参赞 578
+圣水 578
+多条 578
+大江 578
+好歹 578
+姑妈 578
+州政府 578
+死伤 578
+点着 578
+老兄 578
+肥料 578
+腹中 578
+苔 578
+人民检察院 577
+几家 577
+南口 577
+微弱 577
+德意志 577
+悄悄地 577
+旗下 577
+欺负 577
+正说 577
+武术 577
+灌木 577
+留下来 577
+礼堂 577
+脸颊 577
+赞叹 577
+事后 576
+二战 576
+减小 576
+呰 576
+换上 576
+无异 576
+甚为 576
+田地 576
+背面 576
+蔓延 576
+一粒 575
+人次 575
+人民团体 575
+十二月 575
+十几年 575
+四品 575
+基金会 575
+小将 575
+庄稼 575
+故居 575
+梗 575
+民族主义 575
+讳 575
+诗集 575
+门内 575
+阻挠 575
+中产阶级 574
+争吵 574
+代理人 574
+公然 574
+刘荃 574
+南县 574
+声誉 574
+已成 574
+平地 574
+掩饰 574
+试题 574
+谣言 574
+走访 574
+零星 574
+俗名 573
+务必 573
+按摩 573
+潘 573
+筑城 573
+聚合 573
+西伯利亚 573
+跨越 573
+身高 573
+钢筋 573
+鬼子 573
+三中全会 572
+上周 572
+地里 572
+处死 572
+好几个 572
+守卫 572
+实事 572
+抓起 572
+灯火 572
+着眼 572
+翻修 572
+认出 572
+铬 572
+再生产 571
+十万 571
+发信 571
+大跃进 571
+失调 571
+宴会 571
+慧 571
+救济 571
+本能 571
+楀 571
+水准 571
+治国 571
+滆 571
+烹制 571
+燃 571
+糖尿病 571
+绵延 571
+腑 571
+褪 571
+针对性 571
+鳔 571
+一排 570
+五星红旗 570
+冤枉 570
+名优 570
+安心 570
+师团 570
+排水量 570
+新年 570
+智利 570
+毒蛇 570
+比作 570
+烧毁 570
+申花 570
+看重 570
+郧 570
+顶部 570
+一连 569
+交界 569
+停下 569
+分数线 569
+呼呼 569
+哈尼族 569
+平儿 569
+料理 569
+暂停 569
+浸 569
+看起来 569
+精锐 569
+转而 569
+陷阱 569
+不屈 568
+交锋 568
+厌恶 568
+图谋 568
+孙九爷 568
+怒气 568
+拉萨 568
+求职者 568
+清澈 568
+看似 568
+繁重 568
+职称 568
+要害 568
+跪倒 568
+世俗 567
+中和 567
+丰产 567
+为准 567
+会计师 567
+光谷 567
+同伴 567
+大面积 567
+最快 567
+朦胧 567
+自立 567
+西湖 567
+谦 567
+踁 567
+鍓 567
+高粱 567
+鼓乐 567
+仁兄 566
+兮 566
+剧社 566
+动用 566
+十九年 566
+天地会 566
+急流 566
+悲惨 566
+意味 566
+抉择 566
+晋升 566
+树干 566
+殊 566
+毒品 566
+熔点 566
+甜酒 566
+耸 566
+育才 566
+荆襄 566
+表述 566
+闻一多 566
+下锅 565
+伸展 565
+凸 565
+十一月 565
+家门 565
+宾 565
+整数 565
+早日 565
+未知 565
+特地 565
+石狮 565
+糊 565
+鉴 565
+颗粒 565
+书本 564
+刊 564
+别墅 564
+国家博物馆 564
+娴 564
+婂 564
+槎 564
+海边 564
+纤维素 564
+飞扬 564
+一经 563
+云母 563
+出血 563
+大同 563
+奖学金 563
+持股 563
+烈士 563
+田福堂 563
+砂土 563
+秘鲁 563
+自尽 563
+蒲圻 563
+饷 563
+高新 563
+高高 563
+两根 562
+亲身 562
+住宿 562
+余下 562
+佺 562
+冲积平原 562
+发源地 562
+合力 562
+大革命 562
+天黑 562
+好人 562
+小白 562
+惟有 562
+杭汉 562
+气概 562
+童子 562
+粗大 562
+繁衍 562
+线性 562
+辛 562
+万向 561
+不由自主 561
+京广线 561
+史书 561
+喷出 561
+嗔 561
+天生 561
+学联 561
+繁琐 561
+网民 561
+联队 561
+资讯 561
+问问 561
+香味 561
+不败 560
+中军 560
+亲热 560
+众将 560
+大肆 560
+彲 560
+徐秋斋 560
+心腹 560
+抽屉 560
+搭配 560
+植 560
+流泪 560
+相会 560
+祝贺 560
+秭归 560
+空降 560
+聊天 560
+资深 560
+钱其琛 560
+非同小可 560
+右臂 559
+吃法 559
+国共合作 559
+大方 559
+师母 559
+强力 559
+慈善 559
+活性 559
+涂料 559
+猿 559
+现实主义 559
+甫 559
+眼球 559
+精密 559
+老孙 559
+股本 559
+茫茫 559
+衔接 559
+貜 559
+顽 559
+骇 559
+人学 558
+借以 558
+品级 558
+大冶 558
+奔腾 558
+奔驰 558
+摘要 558
+暴雨 558
+比起 558
+民工 558
+纤维板 558
+胡锦涛 558
+营救 558
+藉以 558
+互助 557
+哽咽 557
+大火 557
+改成 557
+旷 557
+核试验 557
+流亡 557
+清蒸 557
+湖西 557
+财政经济委员会 557
+质疑 557
+马来 557
+业内人士 556
+二中 556
+公积金 556
+呸 556
+外头 556
+夸特 556
+学到 556
+小学生 556
+招聘会 556
+班长 556
+白石 556
+看病 556
+笑嘻嘻 556
+簨 556
+自发 556
+逍 556
+邻国 556
+随身 556
+上边 555
+听众 555
+圣人 555
+孢子 555
+惊喜 555
+武钢 555
+火器 555
+真空 555
+肆意 555
+阮大铖 555
+鸦片战争 555
+史记 554
+扰 554
+放声 554
+昏迷 554
+毒药 554
+法典 554
+电机 554
+短短 554
+被告 554
+领取 554
+东升 553
+参照 553
+失踪 553
+庑 553
+得来 553
+拎 553
+本世纪 553
+李肇星 553
+沿线 553
+深圳市 553
+班子 553
+用意 553
+严禁 552
+大石 552
+天鹅 552
+悲痛 552
+揽 552
+无记名 552
+耽搁 552
+自知 552
+辞去 552
+鏋 552
+驾驶员 552
+下部 551
+不平 551
+会合 551
+傀儡 551
+割据 551
+厌 551
+外皮 551
+工业部门 551
+弧形 551
+护送 551
+新纪元 551
+星宿 551
+有余 551
+森工 551
+河边 551
+注释 551
+混战 551
+独居石 551
+监督权 551
+亚历山大 550
+去过 550
+双腿 550
+周仆 550
+应城 550
+弊 550
+思想家 550
+想像 550
+战事 550
+捶 550
+收敛 550
+李俊 550
+松树 550
+构建 550
+玛 550
+笃 550
+紧迫 550
+蓬 550
+螺旋 550
+采纳 550
+金波 550
+高位 550
+亲近 549
+半决赛 549
+咬牙 549
+宁愿 549
+宽大 549
+州府 549
+左臂 549
+拥挤 549
+探花 549
+推举 549
+新华 549
+昨夜 549
+毛尖 549
+猴子 549
+端门 549
+羌 549
+翅膀 549
+肖 549
+诸州 549
+钟祥 549
+陙 549
+食性 549
+鱼池 549
+一幕 548
+军营 548
+净利润 548
+名家 548
+回应 548
+址 548
+宇 548
+宜城 548
+峨嵋 548
+救人 548
+正值 548
+民航 548
+氨基酸 548
+海南省 548
+詹 548
+迦太基 548
+钦差 548
+霎时 548
+鳍 548
+万股 547
+为数 547
+从没 547
+传世 547
+余万 547
+凶险 547
+劲力 547
+勾当 547
+化疗 547
+口音 547
+喵 547
+居于 547
+恢 547
+明洪武 547
+湅 547
+濡 547
+照料 547
+误会 547
+辽宁省 547
+鏁 547
+陪同 547
+黄陵 547
+中国历史博物馆 546
+兰花 546
+十天 546
+厢房 546
+口里 546
+山道 546
+常数 546
+惨重 546
+甯 546
+辫 546
+边防 546
+金台 546
+黑陶 546
+一发 545
+削减 545
+四中 545
+多媒体 545
+封面 545
+流芳 545
+美学 545
+贫富 545
+到时候 544
+宫门 544
+山前 544
+日元 544
+椂 544
+榨菜 544
+许家 544
+酸性 544
+间谍 544
+集体所有 544
+马骏 544
+书院 543
+俯 543
+傻子 543
+函 543
+哭泣 543
+张大 543
+愚蠢 543
+批次 543
+林冲 543
+消毒 543
+王贵 543
+玩笑 543
+留意 543
+知县 543
+税务 543
+第一名 543
+落成 543
+藻井 543
+调剂 543
+防备 543
+隋唐 543
+鹿兆鹏 543
+三中 542
+不通 542
+丹江口 542
+单板 542
+各州 542
+夌 542
+当先 542
+数千 542
+狐 542
+用水 542
+碘 542
+积蓄 542
+立下 542
+第一种 542
+贵妃 542
+酒家 542
+重心 542
+门窗 542
+上任 541
+中国地质大学 541
+五大 541
+克里特 541
+大自然 541
+接管 541
+政治经济学 541
+明世宗 541
+歌声 541
+滥用 541
+聘 541
+舅舅 541
+荣禄 541
+解脱 541
+谭 541
+逮 541
+靖 541
+顽童 541
+个头 540
+伍 540
+余额 540
+初时 540
+将要 540
+德安 540
+新式 540
+无形 540
+武陵山 540
+民营 540
+汉川 540
+江陵县 540
+狮子山 540
+玫瑰 540
+琵琶 540
+甭 540
+监控 540
+第一步 540
+管理权 540
+绗 540
+翘 540
+长叹 540
+非凡 540
+鞍 540
+佩 539
+发信人 539
+恋 539
+拿破仑 539
+母子 539
+燕王 539
+瓮 539
+箣 539
+苏格兰 539
+见得 539
+讨好 539
+逃出 539
+香料 539
+鲟鱼 539
+两路 538
+剃 538
+宁波 538
+官山 538
+帆 538
+年薪 538
+擅自 538
+此言 538
+氖 538
+流芳百世 538
+确有 538
+第二产业 538
+轻微 538
+陈贞慧 538
+五百 537
+人民解放战争 537
+住处 537
+军事法院 537
+双儿 537
+唐山 537
+团城 537
+困惑 537
+埋怨 537
+大将军 537
+山镇 537
+无能 537
+早早 537
+榜眼 537
+沿江 537
+篮板 537
+边区 537
+长河 537
+鲜红 537
+五里 536
+利息率 536
+张学良 536
+房基 536
+抗拒 536
+掌声 536
+新四军 536
+殷素素 536
+淡淡 536
+溶解 536
+背叛 536
+自卫队 536
+舰船 536
+舵主 536
+药师 536
+阿曼 536
+降临 536
+举报 535
+关上 535
+千张 535
+受不了 535
+总称 535
+愤慨 535
+政局 535
+有毒 535
+服务业 535
+正直 535
+沮丧 535
+激昂 535
+神农架林区 535
+糯米饭 535
+蝉 535
+路过 535
+运力 535
+鲤 535
+先驱 534
+大都城 534
+尸身 534
+揣 534
+明儿 534
+朴实 534
+瞥 534
+结实 534
+耕种 534
+腐 534
+超市 534
+退化 534
+通俗 534
+中南财经政法大学 533
+产能 533
+优异 533
+偏重 533
+关切 533
+军事检察院 533
+勘探 533
+反手 533
+吴汝义 533
+庐山 533
+庭 533
+手帕 533
+指引 533
+故障 533
+文科 533
+暗想 533
+月底 533
+有所不同 533
+洺 533
+清炖 533
+潜在 533
+矿石 533
+章宗祥 533
+群臣 533
+船头 533
+要死 533
+中新网 532
+优于 532
+卢俊义 532
+坎 532
+契 532
+庇 532
+弧 532
+急速 532
+掀 532
+梭 532
+沙湖 532
+瓦屋 532
+逃避 532
+凯旋归来 531
+勋 531
+城下 531
+拈 531
+昂贵 531
+理学 531
+畏惧 531
+肢体 531
+趁着 531
+远征 531
+三户 530
+公有 530
+减肥 530
+北非 530
+土地革命 530
+坐落 530
+委内瑞拉 530
+岳父 530
+工作部门 530
+市容 530
+有益于 530
+楚雁潮 530
+炒米 530
+稀有 530
+解放路 530
+警卫 530
+走狗 530
+起床 530
+起诉 530
+雇主 530
+韬 530
+传染病 529
+内分泌 529
+南唐 529
+卫兵 529
+叮 529
+增高 529
+大奖 529
+我省 529
+池塘 529
+治病 529
+法治 529
+白鳍豚 529
+百官 529
+碾 529
+联络线 529
+踅 529
+领会 529
+高陵 529
+侍候 528
+南下 528
+咱俩 528
+天桥 528
+安理会 528
+宝座 528
+建平 528
+抬高 528
+擡高 528
+汽配 528
+痴 528
+经济社会 528
+聘请 528
+院里 528
+不得已 527
+事态 527
+传令 527
+公安县 527
+关门 527
+刻画 527
+口头 527
+宣扬 527
+宽度 527
+年头 527
+怪物 527
+救助 527
+教义 527
+武汉理工大学 527
+汇票 527
+汉阳府 527
+滋润 527
+神来之笔 527
+第一产业 527
+茬 527
+门上 527
+阵阵 527
+高亢 527
+匹配 526
+听力 526
+回想 526
+回收 526
+大沽口 526
+姘 526
+客商 526
+山西省 526
+崩 526
+工业园 526
+惜 526
+探雷器 526
+概论 526
+河蟹 526
+湘鄂 526
+火灾 526
+班主任 526
+盟主 526
+计量 526
+试用 526
+香蕉 526
+不足以 525
+交配 525
+体表 525
+干干净净 525
+引领 525
+忓 525
+沉淀 525
+漩 525
+竹溪 525
+苗人凤 525
+蕴藏量 525
+赵大明 525
+远东 525
+追逐 525
+鑻 525
+功绩 524
+奏折 524
+将近 524
+心血 524
+推拿 524
+无聊 524
+札 524
+柏拉图 524
+江豚 524
+浠水 524
+淹没 524
+烛 524
+瓦解 524
+电梯 524
+耶律齐 524
+花卉 524
+责备 524
+贯通 524
+边上 524
+首届 524
+何在 523
+史诗 523
+多项 523
+婴 523
+嫔妃 523
+屎 523
+急需 523
+提案 523
+播放 523
+放手 523
+有序 523
+村长 523
+水能 523
+流程 523
+牵制 523
+珰 523
+神往 523
+紫霄 523
+绘制 523
+范子愚 523
+茸 523
+蓬勃 523
+议题 523
+质询 523
+贾朝轩 523
+酒精 523
+阜 523
+风流 523
+世代 522
+东岸 522
+克制 522
+十六年 522
+同工同酬 522
+婚育 522
+岸上 522
+心脏病 522
+恩施州 522
+拯救 522
+法则 522
+法语 522
+湖北省政府 522
+监利县 522
+社会科学 522
+红土 522
+视线 522
+连长 522
+京山县 521
+前沿 521
+土匪 521
+威信 521
+愬 521
+李沅芷 521
+民俗 521
+生产线 521
+盛宣怀 521
+石湖 521
+祖父 521
+自豪 521
+轿车 521
+过敏 521
+青山区 521
+价钱 520
+几万 520
+剧毒 520
+土层 520
+天真 520
+婢 520
+崭新 520
+希腊人 520
+常人 520
+德安府 520
+提及 520
+撬 520
+故宫博物院 520
+民办 520
+福建省 520
+端的 520
+花样 520
+莲花 520
+转发 520
+金平 520
+集成电路 520
+鳇鱼 520
+中山堂 519
+加盟 519
+四纵 519
+大理寺 519
+女王 519
+妄 519
+学府路 519
+寒意 519
+张之洞 519
+拉美 519
+断层 519
+民主革命 519
+淫 519
+清廷 519
+琴台 519
+纺 519
+经济体制 519
+罗田县 519
+谢恩 519
+钱币 519
+领导班子 519
+驮 519
+两项 518
+乱打 518
+二十四年 518
+交界处 518
+十一届 518
+响声 518
+填写 518
+岭子 518
+新堤 518
+沙市区 518
+犁 518
+王晓东 518
+碍 518
+调制 518
+踌躇 518
+身躯 518
+这样一来 518
+争相 517
+互不 517
+亨 517
+何故 517
+几十个 517
+卤鸡 517
+古称 517
+后备 517
+圣母 517
+应聘者 517
+建设路 517
+恼怒 517
+手枪 517
+暂且 517
+桨 517
+汉武帝 517
+沙复明 517
+点缀 517
+特级 517
+登山 517
+绞 517
+自给 517
+苑 517
+读书人 517
+赛后 517
+迟早 517
+上海水产大学 516
+下边 516
+卡车 516
+商用车 516
+囨 516
+国营 516
+多元 516
+对照 516
+尹 516
+应力 516
+弥 516
+径流 516
+成型 516
+截然不同 516
+挠 516
+朝天椒 516
+末端 516
+死亡率 516
+没事 516
+盛华仁 516
+石墨 516
+礼貌 516
+祖师 516
+立方米 516
+缅 516
+贮藏 516
+踏实 516
+选集 516
+锺 516
+驰名 516
+鲨 516
+三品 515
+书目 515
+价值观 515
+保姆 515
+华中农业大学 515
+卫队 515
+县市区 515
+哲 515
+妮 515
+媒 515
+时而 515
+模范县 515
+武当山风景区 515
+瞧见 515
+笔记 515
+老年人 515
+腮 515
+解剖 515
+轨 515
+追击 515
+陈士 515
+龙大 515
+万尾 514
+乔晓阳 514
+反馈 514
+周长 514
+大堤 514
+太祖 514
+学界 514
+小河口 514
+庸俗 514
+汉阳区 514
+江岸区 514
+烹调 514
+玄岳门 514
+玉虚宫 514
+益 514
+轮番 514
+邮电 514
+陈丕显 514
+严寒 513
+以防 513
+俞伯牙 513
+刘郎浦 513
+刘集 513
+小康县 513
+愚 513
+教育科学文化卫生委员会 513
+文化路 513
+文教 513
+晃动 513
+正宗 513
+氧气 513
+汉南区 513
+流下 513
+满清 513
+爱国主义 513
+缴纳 513
+荆州区 513
+说好 513
+过往 513
+退却 513
+郭树言 513
+闻到 513
+中华和钟 512
+农业部渔业局 512
+区雄楚 512
+南岩宫 512
+大湾特 512
+姚师傅 512
+崇阳县 512
+帘 512
+意杨 512
+担当 512
+拉栖第 512
+曾思玉 512
+殷家洲 512
+毅 512
+江纳苗 512
+筑成 512
+纪南 512
+色酚 512
+苦恼 512
+荆东 512
+蒋家冲 512
+袁宗皋 512
+请来 512
+调关镇 512
+赵辛 512
+车厢 512
+车城西 512
+镇江 512
+镐 512
+长江水产研究所 512
+鹃 512
+黄松龄 512
+两会 511
+举止 511
+光滑 511
+升值 511
+反感 511
+发展史 511
+启示 511
+大元帅 511
+审讯 511
+感应 511
+物种 511
+珍惜 511
+细心 511
+颖 511
+分析师 510
+战备 510
+无耻 510
+比不上 510
+油漆 510
+片面 510
+白白 510
+纵深 510
+英才 510
+裁员 510
+辉 510
+郊 510
+一朵 509
+下层 509
+俌 509
+光亮 509
+共计 509
+原子弹 509
+反响 509
+婲 509
+懿 509
+武将 509
+毙 509
+渊源 509
+温差 509
+纳什 509
+褶皱 509
+转折 509
+铭文 509
+陆上 509
+随从 509
+乌龟 508
+代数 508
+兹 508
+券 508
+十一年 508
+南通 508
+历届 508
+听从 508
+大雨 508
+微观 508
+抗原 508
+有色金属 508
+概述 508
+游人 508
+热泪 508
+终点 508
+经理人 508
+肃穆 508
+不合 507
+亾 507
+僵 507
+凌厉 507
+前人 507
+吞吐量 507
+吴升 507
+弹头 507
+晓 507
+木结构 507
+步入 507
+毡 507
+法案 507
+牛爱国 507
+直奔 507
+真心 507
+隔壁 507
+青城 507
+预订 507
+三座 506
+传闻 506
+供求 506
+先秦 506
+八大 506
+多方 506
+岸边 506
+总公司 506
+抓好 506
+捋 506
+本文 506
+沙皇 506
+渴 506
+熟人 506
+电气 506
+纨 506
+食欲 506
+不适 505
+北海 505
+厮杀 505
+委派 505
+娘家 505
+柟 505
+楚王 505
+真个 505
+稻谷 505
+窑洞 505
+第五届 505
+箍 505
+篆 505
+粉红色 505
+纳粹 505
+编队 505
+药店 505
+香烟 505
+不必要 504
+云南省 504
+健壮 504
+剂量 504
+奔走 504
+小路 504
+怪不得 504
+月初 504
+浑厚 504
+灵柩 504
+环球 504
+胚胎 504
+蚌 504
+衰退 504
+警戒 504
+鞭子 504
+一动不动 503
+主子 503
+亨利 503
+亲生 503
+便捷 503
+冲着 503
+击落 503
+初次 503
+唤起 503
+在手 503
+官司 503
+小心翼翼 503
+就是说 503
+忠心 503
+氯 503
+法兰西 503
+照耀 503
+窦 503
+约翰 503
+飞跃 503
+首创 503
+不光 502
+会战 502
+候补委员 502
+制剂 502
+墓地 502
+太湖 502
+安宁 502
+密封 502
+师娘 502
+政治协商会议 502
+敬重 502
+真人 502
+编剧 502
+裤 502
+通话 502
+阴道 502
+隐患 502
+顷 502
+再现 501
+参战 501
+慌乱 501
+护理 501
+案子 501
+盔 501
+盘旋 501
+石子 501
+行进 501
+装甲兵 501
+设计者 501
+起步 501
+蹦 501
+适时 501
+雀 501
+验收 501
+上门 500
+仔 500
+口岸 500
+常识 500
+志愿军 500
+摩洛哥 500
+显现 500
+棘 500
+熏 500
+生息 500
+礁 500
+管理局 500
+薰 500
+一千 499
+丛书 499
+召回 499
+大旗 499
+昌 499
+武昌起义 499
+歼敌 499
+第三代 499
+细看 499
+美貌 499
+财力 499
+轮廓 499
+道长 499
+高端 499
+从容 498
+冢 498
+北伐 498
+半月 498
+大乱 498
+天竺 498
+孕妇 498
+寒风 498
+尾鳍 498
+当儿 498
+毒手 498
+炎热 498
+理事会 498
+病理 498
+着地 498
+细腻 498
+蒸发 498
+西山 498
+诉 498
+足以 498
+通货膨胀 498
+酿成 498
+争端 497
+使节 497
+嗜 497
+山体 497
+意识形态 497
+愤 497
+火星 497
+破旧 497
+程英 497
+精选 497
+责任感 497
+辰 497
+野战 497
+高三 497
+一分钟 496
+一束 496
+下水 496
+伙计 496
+倭寇 496
+匣 496
+十四年 496
+周岁 496
+开局 496
+开车 496
+梳 496
+海南岛 496
+留心 496
+矛 496
+芽 496
+菊花 496
+请示 496
+谋求 496
+豪杰 496
+车体 496
+陆菲青 496
+中央电视台 495
+他用 495
+保管 495
+千斤 495
+天王 495
+家境 495
+小城 495
+平和 495
+拨乱反正 495
+放射 495
+歧视 495
+泄露 495
+深感 495
+皮革 495
+货币资本 495
+贪婪 495
+进发 495
+乐趣 494
+传给 494
+何人 494
+保加利亚 494
+商品经济 494
+四十 494
+坟墓 494
+外号 494
+好比 494
+幼年 494
+怪异 494
+方形 494
+时节 494
+比率 494
+汗水 494
+王八 494
+留学生 494
+路面 494
+黄沙 494
+丁思甜 493
+临死 493
+伴有 493
+土豆 493
+塽 493
+守护 493
+平息 493
+建交 493
+抗日救亡 493
+核电站 493
+桓 493
+炎症 493
+瓜分 493
+稀少 493
+胡闹 493
+艺人 493
+荣耀 493
+下车 492
+东北地区 492
+二爷 492
+做梦 492
+切割 492
+呼喊 492
+回复 492
+回覆 492
+小岛 492
+带回 492
+泛滥 492
+漏洞 492
+精子 492
+耀眼 492
+老弟 492
+腺 492
+蜡 492
+蹬 492
+逝 492
+闪动 492
+鳃 492
+龙袍 492
+一伙 491
+一夥 491
+一角 491
+为重 491
+众议院 491
+侧身 491
+刺客 491
+午饭 491
+南美洲 491
+后卫 491
+嚜 491
+审定 491
+序幕 491
+指明 491
+月薪 491
+波罗的海 491
+涡轮 491
+满怀 491
+立马 491
+笨 491
+红楼 491
+肆 491
+脊椎动物 491
+舒 491
+蛙 491
+解析 491
+闹事 491
+面部 491
+马克 491
+不在乎 490
+不解 490
+之用 490
+入选 490
+几时 490
+功课 490
+可靠性 490
+国家队 490
+奇异 490
+好评 490
+投身 490
+案情 490
+涨停 490
+理工 490
+空虚 490
+等离子体 490
+羟基 490
+享用 489
+娲 489
+悓 489
+扫描 489
+摄政王 489
+攒 489
+早知 489
+橙 489
+牧业 489
+粗糙 489
+美国政府 489
+装甲车 489
+账户 489
+贬 489
+问候 489
+高分子 489
+一晃 488
+保佑 488
+几件 488
+噪声 488
+四维 488
+夜景 488
+工学院 488
+往返 488
+情愿 488
+抢劫 488
+拜见 488
+无人机 488
+洛杉矶 488
+活人 488
+海滨 488
+界岭 488
+皱眉 488
+监护 488
+笙 488
+经营者 488
+航空兵 488
+花瓣 488
+间隔 488
+传感器 487
+偏离 487
+入手 487
+制服 487
+募集 487
+呆子 487
+开心 487
+张宁 487
+挺进 487
+推算 487
+旷野 487
+渔场 487
+课目 487
+跻身 487
+三尺 486
+不妥 486
+井冈山 486
+令狐 486
+傜 486
+冰雪 486
+害虫 486
+开除 486
+战舰 486
+敌手 486
+机翼 486
+楠木 486
+玉音 486
+生猪 486
+神庙 486
+胜任 486
+路径 486
+长假 486
+阎鸿唤 486
+革命家 486
+黄山 486
+人称 485
+名次 485
+因子 485
+奴隶制 485
+工人运动 485
+庄子 485
+悲愤 485
+慕尼黑 485
+无以 485
+标记 485
+绝情 485
+老妇 485
+裘千仞 485
+逃脱 485
+为害 484
+冰冷 484
+外侧 484
+市镇 484
+推迟 484
+揩 484
+标本 484
+眼眶 484
+细长 484
+编纂 484
+缴 484
+融入 484
+趴在 484
+酒楼 484
+鍖 484
+伪装 483
+发给 483
+叔惠 483
+壮观 483
+孝敬 483
+惊叫 483
+捐款 483
+撞击 483
+椭圆形 483
+楁 483
+氟 483
+潇洒 483
+现出 483
+考上 483
+句子 482
+最少 482
+杞 482
+杨百顺 482
+淤积 482
+热水 482
+石板 482
+罪犯 482
+详情 482
+下雨 481
+创意 481
+嘿 481
+多座 481
+大户 481
+寺庙 481
+录用 481
+心念 481
+慰问 481
+手艺 481
+有待 481
+有点儿 481
+栧 481
+植株 481
+毫无疑问 481
+溃 481
+演说 481
+物产 481
+萐 481
+蝴蝶 481
+鳖 481
+一届 480
+佽 480
+偿还 480
+兂 480
+列表 480
+千户 480
+占用 480
+应试 480
+座谈 480
+惊异 480
+戞 480
+残余 480
+涛 480
+深知 480
+激流 480
+特长 480
+皇族 480
+相邻 480
+统辖 480
+蛟 480
+违 480
+长袍 480
+闺女 480
+高程 480
+三角形 479
+不胜 479
+交纳 479
+其父 479
+凛 479
+原地 479
+咬牙切齿 479
+大大小小 479
+大理石 479
+容忍 479
+总局 479
+敛 479
+数百 479
+桂花 479
+烟台 479
+热线 479
+牲口 479
+疙瘩 479
+皮鞋 479
+郑和 479
+面具 479
+主人公 478
+保安 478
+储 478
+几口 478
+剧痛 478
+危及 478
+原子核 478
+多米 478
+寡 478
+战绩 478
+抗体 478
+果真 478
+武装起义 478
+牵扯 478
+疲惫 478
+皇爷 478
+绛 478
+脆弱 478
+至关重要 478
+蒜 478
+全线 477
+同治 477
+戠 477
+投放 477
+新世纪 477
+本部 477
+机身 477
+灼 477
+特种部队 477
+荷花 477
+褐色 477
+蹿 477
+险恶 477
+不快 476
+会试 476
+传动 476
+先天 476
+单人 476
+唔 476
+啄 476
+姚 476
+废墟 476
+德国人 476
+攀升 476
+机床 476
+泻 476
+燕山 476
+继而 476
+读完 476
+边疆 476
+三项 475
+俸 475
+催化 475
+充沛 475
+动植物 475
+压低 475
+嘲笑 475
+小贝 475
+少年儿童 475
+并行 475
+快活 475
+拂尘 475
+接替 475
+日用 475
+毒物 475
+相对论 475
+职官 475
+路子 475
+道光 475
+马蹄 475
+修葺 474
+刀剑 474
+右翼 474
+各别 474
+大礼 474
+批复 474
+批覆 474
+提督 474
+救命 474
+歌颂 474
+海口 474
+熺 474
+精明 474
+系统地 474
+表彰 474
+该当 474
+载体 474
+辩论 474
+食指 474
+麻袋 474
+齐名 474
+人际关系 473
+值班 473
+再行 473
+冠以 473
+冲开 473
+原型 473
+响亮 473
+围棋 473
+天津市 473
+妄图 473
+广州市 473
+指挥所 473
+放过 473
+泄漏 473
+法国人 473
+红薯 473
+芜 473
+蚁 473
+赛场 473
+近乎 473
+郡主 473
+随口 473
+高悬 473
+一百 472
+三者 472
+业已 472
+两翼 472
+五千 472
+仇人 472
+决意 472
+堵塞 472
+夺冠 472
+捂 472
+放火 472
+施主 472
+查出 472
+腕 472
+苏普 472
+解放后 472
+轻视 472
+铁棒 472
+长短 472
+院落 472
+一文 471
+下调 471
+农家 471
+可想而知 471
+国籍 471
+廉 471
+张鼐 471
+彭德怀 471
+悲伤 471
+戚继光 471
+殖 471
+淋 471
+理事长 471
+盘算 471
+莫大 471
+轨迹 471
+辅导 471
+鎷 471
+陡然 471
+靛 471
+马背 471
+不合理 470
+乙醇 470
+乞丐 470
+何事 470
+公私 470
+助学 470
+去处 470
+呼啸 470
+幢 470
+开端 470
+柴油 470
+栎 470
+水温 470
+生母 470
+矗 470
+稻 470
+统筹 470
+议和 470
+酮 470
+闲话 470
+除此之外 470
+一时间 469
+乔木 469
+乡亲 469
+人行道 469
+儴 469
+北向 469
+叶片 469
+扎实 469
+招办 469
+树皮 469
+生病 469
+第二批 469
+络 469
+耶稣 469
+联合政府 469
+蒋丽莉 469
+骚扰 469
+一具 468
+严守 468
+中性 468
+二则 468
+他俩 468
+办公厅 468
+喘息 468
+安放 468
+宫里 468
+开罗 468
+掣 468
+无知 468
+湖区 468
+电荷 468
+论题 468
+试探 468
+谐 468
+鎺 468
+顾及 468
+一枪 467
+一概 467
+争斗 467
+农药 467
+双脚 467
+复辟 467
+天才 467
+忠实 467
+折合 467
+无尘 467
+棂 467
+河西 467
+流向 467
+看守 467
+自来 467
+艰巨 467
+衰老 467
+轻重 467
+输电 467
+遍地 467
+郁闷 467
+郑克 467
+酝酿 467
+镖局 467
+隅 467
+鵞 467
+世家 466
+交易日 466
+余年 466
+依附 466
+关口 466
+南移 466
+叛徒 466
+城南 466
+嬶 466
+小厮 466
+尽早 466
+教科书 466
+无锡 466
+毅然 466
+毛巾 466
+瑶 466
+瓣 466
+电极 466
+磁性 466
+私有 466
+胸部 466
+连锁 466
+顾源 466
+元旦 465
+十多年 465
+博士生 465
+奖章 465
+巡洋舰 465
+成年 465
+投向 465
+掐 465
+斥 465
+标题 465
+檲 465
+活儿 465
+眼皮 465
+试卷 465
+贤良 465
+适中 465
+音乐会 465
+促销 464
+切断 464
+半分 464
+大喜 464
+太阳能 464
+奢侈 464
+容许 464
+巨头 464
+指头 464
+振奋 464
+最强 464
+炮火 464
+烹饪 464
+玫 464
+琼 464
+磋商 464
+神道 464
+科比 464
+考古学 464
+脱落 464
+贩 464
+隙 464
+非但 464
+中央集权 463
+偷袭 463
+全方位 463
+剩余劳动 463
+升降 463
+及至 463
+喝茶 463
+士官 463
+微小 463
+恶性 463
+打交道 463
+抨击 463
+指挥员 463
+搏 463
+槐树 463
+没用 463
+深沉 463
+熔 463
+瘫痪 463
+直通 463
+相望 463
+蒂 463
+裸 463
+贯穿 463
+违规 463
+迭 463
+金嫣 463
+音乐学院 463
+为生 462
+仕 462
+低级 462
+侦察机 462
+北麓 462
+厢 462
+小字 462
+常驻 462
+异议 462
+惺 462
+掳 462
+敌对 462
+暨 462
+机理 462
+横扫 462
+沿河 462
+滥 462
+登录 462
+神学 462
+肉体 462
+航程 462
+课本 462
+醒来 462
+马头 462
+一顶 461
+三代 461
+中央委员 461
+六大 461
+单打 461
+厚厚的 461
+字迹 461
+宴请 461
+昔 461
+木雕 461
+沽 461
+浪潮 461
+涌出 461
+眯 461
+磴 461
+童年 461
+结识 461
+缎 461
+表层 461
+论断 461
+赠送 461
+跳出 461
+马克思列宁主义 461
+一盏 460
+制度化 460
+前行 460
+努 460
+勘 460
+嗅 460
+四次 460
+地雷 460
+大成 460
+年前 460
+往常 460
+斿 460
+普鲁士 460
+未有 460
+本科生 460
+水手 460
+法兰克福 460
+海滩 460
+煶 460
+硫酸 460
+胆敢 460
+饱和 460
+马刺 460
+高地 460
+麦子 460
+乐意 459
+倒下 459
+多样化 459
+对口 459
+工兵 459
+晨 459
+火锅 459
+畜生 459
+答话 459
+经商 459
+统统 459
+膝盖 459
+虚弱 459
+蛾 459
+这本 459
+伪军 458
+但愿 458
+借着 458
+动脉 458
+化妆品 458
+外企 458
+宫墙 458
+少见 458
+慧英 458
+抑 458
+有事 458
+污水 458
+精兵 458
+藉着 458
+西路 458
+踪迹 458
+乳腺癌 457
+名山 457
+商代 457
+啷 457
+坐骑 457
+实实在在 457
+山间 457
+文凭 457
+有理 457
+模块 457
+满腔 457
+牡丹 457
+秋季 457
+诵 457
+踱 457
+道歉 457
+郎中 457
+顾虑 457
+领导者 457
+不动声色 456
+与否 456
+明军 456
+星级 456
+曙光 456
+温州 456
+电视机 456
+突变 456
+缘由 456
+自如 456
+震荡 456
+何应钦 455
+动乱 455
+听话 455
+多元化 455
+定额 455
+巨幅 455
+应变 455
+排放 455
+接纳 455
+族人 455
+梅超风 455
+死活 455
+石块 455
+算法 455
+股份公司 455
+虚拟 455
+迫击炮 455
+不妙 454
+伤人 454
+停战 454
+元世祖 454
+大豆 454
+孵化 454
+实话 454
+庞 454
+招待 454
+枪声 454
+源泉 454
+琉璃 454
+硬是 454
+羊毛 454
+赵家 454
+钻石 454
+制式 453
+吴王 453
+撇 453
+时任 453
+疆 453
+白布 453
+瞻 453
+老天爷 453
+茅屋 453
+身手 453
+送往 453
+遣使 453
+长途 453
+静电 453
+鼻孔 453
+三条 452
+会餐 452
+冥 452
+几千年 452
+右掌 452
+张开 452
+指挥官 452
+新闻司 452
+日方 452
+民生 452
+派别 452
+留守 452
+碱性 452
+考卷 452
+胡萝卜 452
+腌 452
+萼 452
+谅 452
+一国两制 451
+不期 451
+代号 451
+传真 451
+元老 451
+女真 451
+宁远 451
+平米 451
+情人 451
+战火 451
+折断 451
+拍手 451
+新教 451
+术语 451
+汪精卫 451
+湘西 451
+理事 451
+理睬 451
+电子商务 451
+硬度 451
+节点 451
+若干个 451
+褐 451
+贫血 451
+借贷 450
+全区 450
+公文 450
+切除 450
+必不可少 450
+捍卫 450
+掌法 450
+推断 450
+新郎 450
+栏目 450
+淘 450
+淤泥 450
+胞 450
+苏琳 450
+跃上 450
+迅猛 450
+金石 450
+隆起 450
+领导权 450
+食盐 450
+高分 450
+军政府 449
+前部 449
+劝阻 449
+半日 449
+同级 449
+咒 449
+图画 449
+地被 449
+天线 449
+小腹 449
+带给 449
+彩画 449
+技工 449
+深情 449
+着力 449
+经脉 449
+绝技 449
+葡萄酒 449
+西瓜 449
+觉悟 449
+远远地 449
+鐭 449
+铁路线 449
+雄厚 449
+骆冰 449
+中央政府 448
+刘伯承 448
+宽容 448
+屈服 448
+教皇 448
+有功 448
+有意思 448
+梓 448
+牧场 448
+王大夫 448
+生物体 448
+着想 448
+花朵 448
+荒唐 448
+东路 447
+主义者 447
+主公 447
+人情 447
+冶炼 447
+化纤 447
+受益 447
+变质岩 447
+另行 447
+周密 447
+慰 447
+棕 447
+水乡 447
+潮水 447
+生性 447
+级差 447
+讲座 447
+贵宾 447
+跳跃 447
+钒 447
+银杏 447
+黑海 447
+两部 446
+九五 446
+喧哗 446
+天文学 446
+奶粉 446
+妓女 446
+对敌 446
+尽情 446
+投奔 446
+撩 446
+放置 446
+枣 446
+滞 446
+生产率 446
+男女 446
+筋斗 446
+羽毛球 446
+肢 446
+财年 446
+责任制 446
+路灯 446
+迁徙 446
+馋 446
+马力 446
+中星 445
+主机 445
+卫国 445
+叶剑英 445
+君臣 445
+噗 445
+国道 445
+家园 445
+平反 445
+底层 445
+擒拿 445
+方略 445
+朴素 445
+样品 445
+海盗 445
+爱好者 445
+白万剑 445
+缝隙 445
+航速 445
+衡山 445
+赫然 445
+轛 445
+造价 445
+闪闪 445
+事关 444
+单身 444
+围住 444
+在建 444
+埃塞俄比亚 444
+天顺 444
+妊娠 444
+日内瓦 444
+棍子 444
+滚动 444
+独有 444
+监禁 444
+目瞪口呆 444
+第九 444
+签证 444
+绷 444
+颜 444
+骮 444
+黎元洪 444
+一等奖 443
+一节 443
+不论是 443
+亲爱 443
+医学院 443
+含糊 443
+周到 443
+大街小巷 443
+廉价 443
+敏捷 443
+权势 443
+李家 443
+毒素 443
+海运 443
+灰尘 443
+电气化 443
+盒子 443
+私下 443
+穆罕默德 443
+翻过 443
+避暑 443
+陈设 443
+马其顿 443
+马夫 443
+高处 443
+一万 442
+三枚 442
+主席台 442
+人品 442
+俗话说 442
+兴致 442
+出产 442
+少妇 442
+市里 442
+庄家 442
+浅水 442
+白人 442
+羽毛 442
+脱手 442
+蟾 442
+该是 442
+贴金 442
+逊 442
+鉴别 442
+阵势 442
+鞋子 442
+入海 441
+发泄 441
+孙少平 441
+孝子 441
+帝制 441
+心跳 441
+杨康 441
+欣慰 441
+沧海 441
+滨海 441
+痕 441
+省市 441
+素来 441
+细小 441
+翠芝 441
+老总 441
+英俊 441
+要点 441
+进兵 441
+重工业 441
+黑衣 441
+剧作 440
+发源 440
+咸 440
+嘉宾 440
+多所 440
+奥运 440
+就医 440
+损耗 440
+救灾 440
+榜样 440
+樼 440
+武修文 440
+殊死 440
+游击战争 440
+游离 440
+石家庄 440
+签约 440
+绐 440
+苏区 440
+认知 440
+运动会 440
+隐形 440
+验 440
+下乡 439
+分手 439
+匆忙 439
+回升 439
+开去 439
+拇指 439
+气血 439
+汉堡 439
+沸点 439
+矢 439
+螭 439
+译成 439
+过人 439
+领教 439
+上衣 438
+下一代 438
+东面 438
+圣经 438
+夏雨 438
+外貌 438
+奉天 438
+妓 438
+妥善 438
+孔泉 438
+师范大学 438
+惊恐 438
+拖拉机 438
+挫 438
+探春 438
+推销 438
+施加 438
+旨意 438
+暴行 438
+杂种 438
+李娜 438
+次之 438
+短缺 438
+肉类 438
+街坊 438
+西江 438
+跨国公司 438
+迎宾 438
+通讯员 438
+颠倒 438
+驯鹿 438
+一阵子 437
+不可思议 437
+东坡 437
+丢失 437
+内脏 437
+分行 437
+勶 437
+十倍 437
+即日 437
+呭 437
+国门 437
+堂堂 437
+大公 437
+孴 437
+彪 437
+怀念 437
+新婚 437
+椎 437
+楚国 437
+欧阳克 437
+狱中 437
+疯子 437
+神位 437
+粉末 437
+绍 437
+绽 437
+联通 437
+装修 437
+遗漏 437
+采矿 437
+铁木真 437
+马丁 437
+三间 436
+专心 436
+两处 436
+低价 436
+分会 436
+名位 436
+四种 436
+弊端 436
+张三丰 436
+意境 436
+打印 436
+拉丁美洲 436
+拨款 436
+无情 436
+清初 436
+爱护 436
+短短的 436
+突尼斯 436
+窥 436
+第一件 436
+第八 436
+纳闷 436
+舰载 436
+良性 436
+草甸 436
+裸露 436
+西直门 436
+赎 436
+进修 436
+迷恋 436
+邮件 436
+陈近南 436
+高薪 436
+下楼 435
+不负 435
+乌鲁木齐 435
+介 435
+关东 435
+刚毅 435
+剩余价值率 435
+厚重 435
+合营 435
+墠 435
+大丈夫 435
+大作 435
+宾客 435
+寝 435
+对峙 435
+尼摩星 435
+尼日利亚 435
+干流 435
+悲哀 435
+氨基 435
+综合征 435
+臽 435
+迈出 435
+迷惑 435
+酯 435
+零点 435
+飞奔 435
+驻守 435
+黄澍 435
+中年人 434
+为此 434
+亚细亚 434
+公有制 434
+前年 434
+区内 434
+堰 434
+并列 434
+指甲 434
+概 434
+此书 434
+油茶 434
+液晶 434
+硕 434
+联姻 434
+至高无上 434
+逃亡 434
+逃命 434
+遗传学 434
+酬 434
+金色 434
+鑴 434
+万多 433
+三通 433
+上行 433
+冶 433
+动工 433
+华丽 433
+即刻 433
+卷起 433
+四圈 433
+型式 433
+垒 433
+奲 433
+学子 433
+容器 433
+废物 433
+扇子 433
+星球 433
+更何况 433
+更改 433
+朔 433
+柑橘 433
+欧亚大陆 433
+清洗 433
+男生 433
+终生 433
+统治区 433
+零件 433
+黄龙 433
+交货 432
+俄军 432
+南美 432
+同位素 432
+大祸 432
+季风 432
+孤儿 432
+屯田 432
+探险 432
+杏 432
+柴油机 432
+正经 432
+残忍 432
+淇 432
+石林 432
+笼络 432
+膛 432
+轰动 432
+金陵 432
+钨 432
+顽固 432
+香气 432
+军情 431
+几何学 431
+凤姐儿 431
+北段 431
+呐 431
+喇叭 431
+地道 431
+妸 431
+守军 431
+寡妇 431
+引桥 431
+当兵 431
+愕然 431
+敏锐 431
+文体 431
+无用 431
+明末清初 431
+本意 431
+极致 431
+淀粉 431
+灸 431
+肘 431
+船队 431
+被叫 431
+视力 431
+语系 431
+轰轰烈烈 431
+钡 431
+铁道部 431
+长廊 431
+附加 431
+上乘 430
+两艘 430
+兵法 430
+千方百计 430
+妥当 430
+官人 430
+对联 430
+弘扬 430
+得力 430
+成虫 430
+招标 430
+挥动 430
+方可 430
+构筑 430
+架设 430
+炒作 430
+盗版 430
+竞技 430
+要命 430
+言行 430
+载荷 430
+金箔 430
+阿尔及利亚 430
+麻木 430
+一次性 429
+不留 429
+为难 429
+动向 429
+坑道 429
+拆开 429
+括 429
+登台 429
+着陆 429
+笔直 429
+经书 429
+蜜 429
+诘 429
+身法 429
+限定 429
+马车 429
+伤员 428
+侮辱 428
+北端 428
+即时 428
+厘 428
+太平天国 428
+小屋 428
+屏障 428
+工商局 428
+恩师 428
+揭发 428
+杯子 428
+洗礼 428
+瓜子 428
+真菌 428
+祈祷 428
+科索沃 428
+萎缩 428
+见方 428
+财政部 428
+软弱 428
+不乏 427
+判官 427
+围困 427
+恭恭敬敬 427
+挽回 427
+推力 427
+摸索 427
+田见秀 427
+盘子 427
+砥 427
+绝无 427
+萤石 427
+薛姨妈 427
+诈 427
+该车 427
+驳 427
+鲸 427
+京汉 426
+地产 426
+复苏 426
+建起 426
+强有力 426
+惧怕 426
+意旨 426
+成因 426
+报答 426
+燕子 426
+答问 426
+美容 426
+胯 426
+菱 426
+要不是 426
+诡异 426
+该机 426
+贮存 426
+一言不发 425
+两派 425
+中子 425
+亸 425
+人气 425
+优雅 425
+传销 425
+公款 425
+副作用 425
+吱 425
+咯 425
+妆 425
+孕 425
+寇 425
+左派 425
+悗 425
+慎 425
+祠堂 425
+萌芽 425
+视角 425
+连年 425
+金字塔 425
+陪伴 425
+面容 425
+顾不得 425
+饭碗 425
+一两个 424
+保护区 424
+几只 424
+吉林省 424
+巴掌 424
+幌 424
+幓 424
+干吗 424
+摆动 424
+旋律 424
+杂质 424
+桦 424
+永恒 424
+流畅 424
+漂 424
+炉子 424
+狂热 424
+电场 424
+直立 424
+第三产业 424
+纪念品 424
+茯苓 424
+蹂躏 424
+逗 424
+阮 424
+五名 423
+产妇 423
+侧重 423
+六十 423
+内乱 423
+刘翔 423
+前日 423
+匀 423
+园子 423
+地段 423
+夹击 423
+总得 423
+悬崖 423
+抒情 423
+搅拌 423
+旋风 423
+曝光 423
+松软 423
+滑稽 423
+现成 423
+病死 423
+白发 423
+笑笑 423
+练兵 423
+胸脯 423
+詹石 423
+许世友 423
+顷刻间 423
+六角 422
+净化 422
+合一 422
+嗡嗡 422
+四季 422
+多月 422
+大嫂 422
+奏疏 422
+好些 422
+实地 422
+富含 422
+弓箭 422
+归结 422
+急切 422
+摩托车 422
+树脂 422
+狄 422
+甘肃省 422
+神州 422
+谟 422
+酿酒 422
+重兵 422
+院门 422
+驶 422
+世事 421
+六合 421
+制备 421
+携手 421
+期货 421
+椁 421
+福晋 421
+老朋友 421
+迷人 421
+金黄 421
+骂人 421
+公理 420
+劲儿 420
+勃 420
+包机 420
+历年 420
+四分 420
+家畜 420
+尤伯杯 420
+引力 420
+张飞 420
+扭曲 420
+曲调 420
+曹家 420
+林子 420
+栦 420
+氐 420
+汛期 420
+海港 420
+病房 420
+社团 420
+菱形 420
+蹄 420
+阵亡 420
+中间体 419
+先期 419
+凶手 419
+初一 419
+叮嘱 419
+峭壁 419
+拖延 419
+拥戴 419
+按住 419
+时空 419
+档次 419
+知足 419
+石灰 419
+立项 419
+腥 419
+获准 419
+蓄 419
+虔诚 419
+行长 419
+褂 419
+货运 419
+贫寒 419
+践 419
+辫子 419
+风化 419
+停滞 418
+公关 418
+制造商 418
+央视 418
+戣 418
+数丈 418
+曹青 418
+机动性 418
+民歌 418
+测验 418
+淡淡的 418
+烦躁 418
+相机 418
+纹饰 418
+贞 418
+速率 418
+香山 418
+麻布 418
+东征 417
+中国足协 417
+临安 417
+二分 417
+五彩 417
+值钱 417
+分校 417
+加油 417
+压倒 417
+叫化 417
+哈萨克 417
+啊哟 417
+导管 417
+抢先 417
+朱子柳 417
+石刻 417
+肋 417
+脸红 417
+自然而然 417
+角逐 417
+诱 417
+蹭 417
+辣椒 417
+迎战 417
+适度 417
+钓 417
+门诊 417
+阵营 417
+一瞥 416
+上场 416
+专注 416
+军务 416
+几点 416
+器件 416
+大踏步 416
+定型 416
+强占 416
+怦怦 416
+某人 416
+某某 416
+检举 416
+沉浸 416
+激怒 416
+田径 416
+称谓 416
+窍 416
+简易 416
+考取 416
+血缘 416
+表哥 416
+袁紫衣 416
+襄樊 416
+话音 416
+路口 416
+铁链 416
+颐 416
+伤感 415
+入睡 415
+全权 415
+冷漠 415
+凳 415
+头衔 415
+就读 415
+悄 415
+意想不到 415
+木匠 415
+浮肿 415
+深切 415
+狭小 415
+网球 415
+载重 415
+迟迟 415
+鍝 415
+难忘 415
+雁 415
+交汇 414
+兵士 414
+出行 414
+十里 414
+商朝 414
+圆圈 414
+婃 414
+存放 414
+射门 414
+小区 414
+抗衡 414
+控告 414
+政法 414
+柔和 414
+椋 414
+殿下 414
+污 414
+洗澡 414
+白灵 414
+禁忌 414
+第一期 414
+筹建 414
+般地 414
+茶馆 414
+草场 414
+葛 414
+解毒 414
+贤弟 414
+长辈 414
+霞 414
+靶 414
+丝绸 413
+人民网 413
+储蓄 413
+冲锋枪 413
+决不会 413
+凄凉 413
+各异 413
+堟 413
+宜兴 413
+岩洞 413
+崇尚 413
+成排 413
+机动车 413
+林木 413
+源头 413
+独家 413
+狭义 413
+盗墓 413
+花色 413
+蒸汽 413
+贸然 413
+酷 413
+雪梅 413
+黑客 413
+信封 412
+养猪 412
+冀 412
+勇猛 412
+原告 412
+墓道 412
+增速 412
+娴熟 412
+打扰 412
+把头 412
+挑起 412
+校外 412
+渊 412
+簩 412
+终结 412
+老头儿 412
+考场 412
+胡宗宪 412
+蓝小梅 412
+蚀 412
+跳动 412
+七座 411
+专任 411
+又惊又喜 411
+国务卿 411
+壕 411
+多岁 411
+头晕 411
+女工 411
+威武 411
+孙少安 411
+幻 411
+成书 411
+杨柳 411
+横向 411
+横跨 411
+河网 411
+渣 411
+电影院 411
+皱纹 411
+移交 411
+美国国会 411
+肥胖 411
+荐 411
+荒谬 411
+讯息 411
+集装箱 411
+青藏高原 411
+黄花 411
+一阵阵 410
+兴盛 410
+分局 410
+创汇 410
+北欧 410
+卓 410
+反弹 410
+可观 410
+叱 410
+咪 410
+哥伦比亚 410
+好久 410
+安危 410
+总计 410
+无需 410
+梅子 410
+歌唱 410
+油桐 410
+研究会 410
+胸中 410
+蛔 410
+贵人 410
+黄安 410
+万名 409
+不善 409
+与众不同 409
+东门 409
+乏 409
+亦可 409
+傍 409
+出差 409
+北极 409
+十月革命 409
+反驳 409
+唯物主义 409
+国库 409
+垛口 409
+大半 409
+巍峨 409
+战死 409
+撇开 409
+李贵妃 409
+查明 409
+武氏 409
+社会党 409
+结交 409
+苇席 409
+茂密 409
+军械 408
+嘶 408
+固执 408
+城西 408
+声学 408
+备受 408
+外向型 408
+大风 408
+婊子 408
+店里 408
+总统府 408
+数月 408
+毫 408
+濆 408
+火候 408
+相聚 408
+筹划 408
+胡涂 408
+艰苦奋斗 408
+花纹 408
+蟠龙 408
+触及 408
+过滤 408
+邓军 408
+不予 407
+交待 407
+光纤 407
+冨 407
+出言 407
+劻 407
+史册 407
+唐一娜 407
+嘉兴 407
+平板 407
+急促 407
+招架 407
+星光 407
+晶石 407
+李延 407
+澄清 407
+献给 407
+生命力 407
+石柱 407
+神经系统 407
+纯正 407
+英超 407
+蚯蚓 407
+衙 407
+讲解 407
+说明书 407
+赵宏声 407
+近海 407
+追随 407
+通风 407
+骰子 407
+一个多月 406
+三十多年 406
+中立 406
+冀察 406
+厅长 406
+合肥 406
+名师 406
+嘎 406
+在座 406
+地名 406
+夕 406
+大营 406
+威尼斯 406
+家当 406
+工业区 406
+带兵 406
+心神 406
+招手 406
+攻读 406
+明王朝 406
+杜鹃 406
+核电 406
+死尸 406
+湡 406
+版权 406
+禀告 406
+第三卷 406
+组分 406
+老胡 406
+考查 406
+自言自语 406
+设定 406
+误差 406
+身亡 406
+远去 406
+龙亭 406
+不远处 405
+中层 405
+亲临 405
+佛像 405
+反过来 405
+国债 405
+增发 405
+工艺品 405
+巨响 405
+巫山 405
+幼儿园 405
+徽 405
+摸金校尉 405
+未尝 405
+机组 405
+枷 405
+活活 405
+淤 405
+清脆 405
+满地 405
+烈火 405
+燕青 405
+用兵 405
+答题 405
+编织 405
+银两 405
+陈星 405
+专职 404
+五届 404
+传记 404
+公益 404
+共生 404
+北边 404
+嗳 404
+声息 404
+失学 404
+学术界 404
+屑 404
+并购 404
+开挖 404
+忔 404
+折射 404
+文艺复兴 404
+殿内 404
+泉州 404
+相符 404
+禄 404
+穆 404
+粘膜 404
+美国空军 404
+老年 404
+股指 404
+迸 404
+风貌 404
+龙泉 404
+一大早 403
+一季度 403
+一遭 403
+个子 403
+优劣 403
+免除 403
+几千 403
+司礼监 403
+国际法 403
+复仇 403
+天文学家 403
+威风凛凛 403
+尼罗河 403
+常言道 403
+彧 403
+心肠 403
+执政府 403
+抽烟 403
+支部 403
+新政 403
+母本 403
+海区 403
+海瑞 403
+清亮 403
+热潮 403
+物力 403
+瑛 403
+甜蜜 403
+男子汉 403
+盲 403
+红宝石 403
+纤 403
+纬度 403
+耀 403
+腐朽 403
+诡计 403
+赶着 403
+丰厚 402
+人头 402
+农户 402
+北路 402
+原定 402
+后部 402
+实为 402
+徐爵 402
+摊开 402
+断绝 402
+树叶 402
+求解 402
+疆域 402
+石兽 402
+破碎 402
+编号 402
+肯尼亚 402
+话语 402
+谌 402
+邮 402
+阔叶林 402
+不要紧 401
+书信 401
+人权 401
+停顿 401
+厨 401
+国号 401
+图纸 401
+山林 401
+张贴 401
+得不到 401
+戎 401
+扬子江 401
+拓跋 401
+教派 401
+标准化 401
+洁白 401
+热力学 401
+玉娘 401
+磋 401
+竟敢 401
+繬 401
+臀 401
+苦头 401
+观礼台 401
+铺设 401
+领悟 401
+马士英 401
+三招 400
+主战 400
+众星捧月 400
+作曲家 400
+全邦 400
+博雅 400
+发祥地 400
+撤出 400
+撤离 400
+电磁波 400
+米饭 400
+跟上 400
+身披 400
+长虹 400
+一般说来 399
+下过 399
+丛中 399
+丫鬟 399
+主动性 399
+之二 399
+传呼 399
+南航 399
+大漠 399
+天棚 399
+开启 399
+性状 399
+愈发 399
+旁听 399
+牛车 399
+看书 399
+短篇小说 399
+繁茂 399
+西面 399
+辨认 399
+黄海 399
+伟 398
+出发点 398
+名列前茅 398
+周旋 398
+姐夫 398
+山川 398
+巨石 398
+干事 398
+废弃 398
+怠 398
+愭 398
+戒严 398
+斗拱 398
+星星 398
+杆菌 398
+生机 398
+蓄积量 398
+足协 398
+跃出 398
+躬 398
+铁锅 398
+隋朝 398
+一记 397
+东长安街 397
+乘员 397
+九五之尊 397
+乡试 397
+五更 397
+何种 397
+侦查 397
+信息技术 397
+军机 397
+前门 397
+北风 397
+历史学家 397
+台独 397
+呼延 397
+园区 397
+塑 397
+大连市 397
+夺去 397
+宪政 397
+批发 397
+新版 397
+比拟 397
+法拉第 397
+浪漫主义 397
+液压 397
+碎片 397
+簬 397
+精湛 397
+继承人 397
+老衲 397
+蚕豆 397
+西便门 397
+观望 397
+论点 397
+辩护 397
+零食 397
+震天 397
+黯然 397
+一通 396
+严阵 396
+中国移动 396
+产出 396
+出访 396
+小米 396
+开花 396
+息息相关 396
+海产 396
+淌 396
+稀饭 396
+箞 396
+系主任 396
+膏 396
+诗词 396
+败坏 396
+起火 396
+迥 396
+锣 396
+镇静 396
+丰收 395
+信赖 395
+凝固 395
+出租车 395
+史天雄 395
+屏风 395
+巴蜀 395
+年内 395
+性生活 395
+湖边 395
+焚烧 395
+物件 395
+疲 395
+穗 395
+繁体字 395
+觉察 395
+解救 395
+赞美 395
+那大 395
+钀 395
+隐身 395
+雪花 395
+两张 394
+丹田 394
+半殖民地 394
+和解 394
+岩层 394
+帮手 394
+当家作主 394
+影视 394
+心惊 394
+拐杖 394
+掌柜 394
+文化史 394
+日出 394
+柺杖 394
+残留 394
+海战 394
+牵连 394
+病例 394
+矛头 394
+簇 394
+辈子 394
+逞 394
+靠着 394
+面面相觑 394
+首级 394
+上扬 393
+人手 393
+做起 393
+及早 393
+变故 393
+复旦大学 393
+奔波 393
+娆 393
+定量 393
+换取 393
+民警 393
+生产关系 393
+石灰岩 393
+肚里 393
+试飞 393
+运载 393
+迪 393
+预感 393
+飞翔 393
+飞行器 393
+一盘 392
+代主任 392
+会儿 392
+佃 392
+体型 392
+取经 392
+周二 392
+商洛山 392
+在校生 392
+大船 392
+对华 392
+弯腰 392
+拜访 392
+新文化运动 392
+杀掉 392
+田间 392
+离不开 392
+自古以来 392
+裴 392
+记号 392
+负有 392
+进屋 392
+进度 392
+钵 392
+镶嵌 392
+三世 391
+上海证券交易所 391
+下院 391
+人行 391
+信息网 391
+克拉 391
+八届 391
+冰箱 391
+出资 391
+分给 391
+利比亚 391
+威望 391
+小马 391
+感人 391
+换成 391
+描 391
+新村 391
+椾 391
+横穿 391
+橱 391
+理科 391
+田福军 391
+相待 391
+祎 391
+絾 391
+纪要 391
+纵队 391
+练功 391
+诱发 391
+质子 391
+通史 391
+酋长 391
+阿森纳 391
+霉 391
+飞来 391
+麻雀 391
+中国革命博物馆 390
+典雅 390
+前不久 390
+剑术 390
+助攻 390
+和县 390
+国安 390
+堕落 390
+增援 390
+央行 390
+慕 390
+托马斯 390
+抿 390
+改用 390
+新科状元 390
+旅店 390
+无边 390
+村落 390
+汊 390
+波罗 390
+泰来 390
+流星 390
+狂风 390
+白龙 390
+盈 390
+相隔 390
+砖头 390
+边陲 390
+连队 390
+重返 390
+钦佩 390
+锯 390
+一班 389
+举手 389
+亿万 389
+几十 389
+出嫁 389
+列出 389
+刹那间 389
+千元 389
+可说是 389
+周仲英 389
+大堆 389
+天坛 389
+夸大 389
+姣 389
+学业 389
+尹克西 389
+当众 389
+忿 389
+懂事 389
+栫 389
+櫘 389
+湗 389
+田园 389
+界面 389
+白云 389
+礼法 389
+线条 389
+罐头 389
+被窝 389
+说不清 389
+貂 389
+长三角 389
+门扉 389
+音节 389
+高职 389
+五台山 388
+公路网 388
+印度教 388
+发热 388
+商学院 388
+基部 388
+夜色 388
+差额 388
+廖 388
+排挤 388
+杂剧 388
+污染物 388
+淖 388
+渗 388
+竖起 388
+童话 388
+简溪 388
+绿洲 388
+苏淳 388
+谈起 388
+踪 388
+黄帝 388
+业界 387
+伯爵 387
+兄妹 387
+古籍 387
+周期性 387
+地委 387
+墟 387
+墦 387
+大陆架 387
+撤军 387
+普法 387
+界线 387
+疲倦 387
+真主 387
+破败 387
+祖右社 387
+第二位 387
+肆无忌惮 387
+诚意 387
+谥 387
+身处 387
+那双 387
+银行券 387
+骨髓 387
+鲨鱼 387
+两枚 386
+傞 386
+募 386
+城址 386
+大专学校 386
+宣德 386
+总指挥 386
+打扫 386
+晒太阳 386
+波段 386
+濒临 386
+热气 386
+诚恳 386
+足迹 386
+锁定 386
+镇守 386
+露面 386
+魔鬼 386
+不约而同 385
+中学生 385
+分治 385
+大峡谷 385
+孔雀 385
+弩 385
+形体 385
+心怀 385
+憔悴 385
+找寻 385
+放电 385
+数十个 385
+曼谷 385
+极少数 385
+沙河 385
+炲 385
+疏忽 385
+疑虑 385
+盟军 385
+真情 385
+端正 385
+第一代 385
+纲要 385
+蔡信 385
+讨伐 385
+迅捷 385
+钻研 385
+长篇 385
+饥 385
+高耸 385
+严整 384
+云盘 384
+全心全意 384
+分头 384
+双双 384
+可喜 384
+大体上 384
+大城 384
+夺回 384
+巴格达 384
+心境 384
+忠于 384
+恩格斯 384
+把手 384
+拳脚 384
+换届 384
+探头 384
+旦 384
+机器人 384
+民心 384
+玲珑 384
+田震英 384
+瞧不起 384
+祭祖 384
+红细胞 384
+经由 384
+老先生 384
+蚕 384
+谷子 384
+马可 384
+黄瓦飞 384
+侵害 383
+俏 383
+养活 383
+判定 383
+存有 383
+居委会 383
+市场化 383
+总工程师 383
+扰乱 383
+捐赠 383
+探测器 383
+教育厅 383
+断然 383
+构想 383
+梅林 383
+比喻 383
+沉井 383
+涟 383
+眼角 383
+窟窿 383
+背诵 383
+腐烂 383
+衁 383
+麾下 383
+一两 382
+上当 382
+临走 382
+低沉 382
+公路桥 382
+军火 382
+冷淡 382
+出使 382
+副总 382
+十九 382
+卢象升 382
+吭声 382
+多多 382
+大堂 382
+嫉妒 382
+峨 382
+幸而 382
+当地人 382
+彝 382
+推演 382
+朱祁镇 382
+架起 382
+点击 382
+玮 382
+球形 382
+石清 382
+硝烟 382
+组委会 382
+辽阔 382
+逃往 382
+那段 382
+重晶石 382
+销量 382
+锋利 382
+阵线 382
+飞船 382
+上岸 381
+上车 381
+东吴 381
+几内亚 381
+加薪 381
+劳累 381
+升学率 381
+太小 381
+握手 381
+救出 381
+木质 381
+洋行 381
+瓶颈 381
+破灭 381
+秦王 381
+第四次 381
+老乡 381
+超导 381
+退缩 381
+配偶 381
+钯 381
+隋开皇 381
+难看 381
+题型 381
+驻京办 381
+麝 381
+下发 380
+乳白色 380
+亮丽 380
+估 380
+作坊 380
+南平 380
+哆嗦 380
+备战 380
+大团结 380
+天明 380
+失常 380
+好似 380
+姬 380
+存心 380
+小牛 380
+巡视 380
+扑通 380
+掌心 380
+敬业 380
+昧 380
+欢呼声 380
+腰带 380
+难堪 380
+两日 379
+中国国民党 379
+伯父 379
+伸缩 379
+偏向 379
+冒犯 379
+合作社 379
+喷发 379
+大拇指 379
+尘土 379
+开元 379
+推崇 379
+昆仑山 379
+有失 379
+机载 379
+植树 379
+正殿 379
+民意 379
+水资源 379
+沟渠 379
+照相 379
+燥 379
+璘 379
+瓦罐 379
+盟国 379
+纽 379
+胜败 379
+许久 379
+费力 379
+赞助 379
+赵半山 379
+追踪 379
+郭药师 379
+默然 379
+一个多 378
+亲友 378
+兴办 378
+冻结 378
+十余年 378
+哎呀 378
+喜庆 378
+墙角 378
+外交部新闻司 378
+大胜 378
+大雪 378
+好友 378
+师兄弟 378
+并用 378
+张家 378
+忙活 378
+战功 378
+政府部门 378
+斯拉夫 378
+本场 378
+汵 378
+火控 378
+病虫害 378
+眼珠 378
+肖鸿林 378
+远方 378
+选取 378
+逐一 378
+键盘 378
+霸权 378
+饺子 378
+鸣叫 378
+中线 377
+分队 377
+力道 377
+勤奋 377
+南洋 377
+唧唧 377
+头盔 377
+奋 377
+女队 377
+宣战 377
+应为 377
+开枪 377
+得天独厚 377
+拥抱 377
+斐 377
+时日 377
+桃园 377
+桌面 377
+游击 377
+玻 377
+百倍 377
+粘土 377
+舅 377
+舜 377
+芝麻 377
+萱 377
+计策 377
+调集 377
+谎 377
+跌倒 377
+软件工程 377
+邮编 377
+降到 377
+首选 377
+不甘 376
+伍子胥 376
+俞莲舟 376
+军校 376
+出游 376
+北流 376
+十余 376
+含笑 376
+商家 376
+圆锥形 376
+地方官 376
+多处 376
+婚事 376
+官儿 376
+帀 376
+庇护 376
+开发商 376
+旗号 376
+民主党 376
+火热 376
+白家 376
+看中 376
+管理层 376
+粮草 376
+脏腑 376
+航空港 376
+解题 376
+誓死 376
+让步 376
+议长 376
+谒 376
+轮回 376
+严惩 375
+义气 375
+北美洲 375
+十三个 375
+地质学 375
+多长 375
+天池 375
+平凡 375
+搬运 375
+新闻界 375
+水泡 375
+派出所 375
+烧制 375
+牌位 375
+生物学家 375
+生计 375
+痛恨 375
+褚 375
+进行曲 375
+金碧辉煌 375
+雇用 375
+风筝 375
+一成不变 374
+中指 374
+乘势 374
+交织 374
+佛塔 374
+减产 374
+吉利 374
+后续 374
+唯恐 374
+基数 374
+多天 374
+归公 374
+才华 374
+接任 374
+撵 374
+星火 374
+木牌 374
+李白 374
+江东 374
+炭 374
+狡猾 374
+王宫 374
+老爷子 374
+耻辱 374
+肾脏 374
+自考 374
+芸 374
+茶杯 374
+试管 374
+豹子 374
+责成 374
+辗转 374
+馷 374
+鸡汤 374
+农用 373
+华为 373
+口吻 373
+哈萨克斯坦 373
+国力 373
+国民党中央 373
+大宗 373
+宋军 373
+屯兵 373
+工作组 373
+张作霖 373
+抵押 373
+柜台 373
+桥墩 373
+森严 373
+汉城 373
+由此可见 373
+疫苗 373
+矾 373
+自然环境 373
+茂 373
+补助 373
+赶走 373
+金丝猴 373
+雄性 373
+马铃薯 373
+龙王 373
+一应 372
+不休 372
+出力 372
+双向 372
+周身 372
+唱片 372
+四海 372
+大唐 372
+头疼 372
+忠义 372
+意向 372
+房产 372
+检阅 372
+楹 372
+河湖 372
+王安石 372
+矿业 372
+矿区 372
+第三名 372
+葛洲坝 372
+装配 372
+该国 372
+雕像 372
+骤然 372
+两口 371
+债权 371
+农业区 371
+冯玉祥 371
+出马 371
+前殿 371
+寝宫 371
+徐寿辉 371
+得胜 371
+成年人 371
+断断续续 371
+早点 371
+早餐 371
+松弛 371
+果子 371
+榨 371
+焦躁 371
+熄灭 371
+犵 371
+登极 371
+知音 371
+租地 371
+经纪人 371
+花木 371
+蘸 371
+虚实 371
+裂缝 371
+赶路 371
+辟邪 371
+闻讯 371
+中国外交部 370
+交出 370
+偕 370
+八五 370
+凌 370
+医治 370
+叶碧秋 370
+土地革命战争 370
+实情 370
+戈 370
+打招呼 370
+挣脱 370
+搀 370
+杜仲 370
+杵 370
+洪涝 370
+游记 370
+空隙 370
+绝顶 370
+贴着 370
+遮住 370
+雷电 370
+伴奏 369
+低空 369
+入川 369
+台地 369
+史实 369
+吴邦国 369
+哺乳动物 369
+奋勇 369
+小提琴 369
+彩电 369
+征税 369
+悠悠 369
+指使 369
+推辞 369
+数字化 369
+旅游者 369
+更迭 369
+朱砂 369
+来华 369
+火爆 369
+环境保护 369
+硃砂 369
+精制 369
+足足 369
+限额 369
+饮用 369
+丑陋 368
+偏差 368
+出炉 368
+古文 368
+后劲 368
+后院 368
+嗨 368
+大路 368
+大门口 368
+失落 368
+庭院 368
+张嘴 368
+怠慢 368
+戈壁 368
+抖动 368
+杂交 368
+毗 368
+清真寺 368
+澜 368
+独具特色 368
+王明 368
+社会化 368
+织物 368
+肖像 368
+衷 368
+覆盖率 368
+试行 368
+辽宁队 368
+锐 368
+陈瑞 368
+鲁尼 368
+体裁 367
+信徒 367
+偶像 367
+光缆 367
+况 367
+刘瑾 367
+升旗 367
+古物 367
+台海 367
+因故 367
+在意 367
+好手 367
+尚且 367
+尹志平 367
+抚养 367
+摧残 367
+朱熹 367
+梦见 367
+楼房 367
+浴 367
+衬 367
+谋生 367
+费珍 367
+踪影 367
+车身 367
+进取 367
+适龄 367
+铁门 367
+铸铁 367
+题为 367
+两性 366
+佬 366
+免不了 366
+全州 366
+勇于 366
+名气 366
+咸阳 366
+增生 366
+失眠 366
+女尸 366
+御林军 366
+戒备 366
+扫荡 366
+报价 366
+敌机 366
+昙 366
+机型 366
+欑 366
+正比 366
+洞庭 366
+炭火 366
+爆破 366
+珠海 366
+瞄 366
+穿戴 366
+箫 366
+表皮 366
+证见 366
+走走 366
+鍟 366
+闪过 366
+面颊 366
+中常 365
+优胜 365
+光年 365
+凯 365
+划定 365
+剧作家 365
+北齐 365
+华国锋 365
+卑鄙 365
+县府 365
+后任 365
+商标 365
+埋葬 365
+声调 365
+天使 365
+孟子 365
+就任 365
+尼泊尔 365
+尽管如此 365
+带电 365
+干系 365
+摄入 365
+斗志 365
+本轮 365
+楂 365
+特约 365
+稠密 365
+空袭 365
+经济型 365
+罗汝才 365
+腺瘤 365
+荧光 365
+装作 365
+诊 365
+谝 365
+一包 364
+两队 364
+人世 364
+伊尔 364
+使臣 364
+光景 364
+光盘 364
+八七 364
+农行 364
+华瑞 364
+厅堂 364
+合称 364
+奇妙 364
+孳 364
+席位 364
+库存 364
+应邀 364
+手榴弹 364
+招待所 364
+文泰来 364
+断代 364
+根基 364
+母女 364
+点子 364
+牙膏 364
+生父 364
+电影节 364
+禁令 364
+纸币 364
+色泽 364
+芙琳 364
+董必武 364
+衣食 364
+试剂 364
+起因 364
+辩证法 364
+那场 364
+难于 364
+三星 363
+上路 363
+不得了 363
+乙肝 363
+体温 363
+作响 363
+假冒 363
+做生意 363
+儏 363
+元件 363
+剰 363
+劣势 363
+劳役 363
+嗙 363
+巧克力 363
+平平 363
+得失 363
+总值 363
+恃 363
+战局 363
+攻陷 363
+明史 363
+椤 363
+波音 363
+烛光 363
+犺 363
+空战 363
+简陋 363
+箕 363
+经管 363
+美方 363
+舂 363
+足下 363
+隐私 363
+专业化 362
+二十六年 362
+其二 362
+军备 362
+小镇 362
+屯子 362
+师资 362
+幼稚 362
+弄清 362
+心爱 362
+无心 362
+有心 362
+毗邻 362
+炮击 362
+独树一帜 362
+皇陵 362
+笔墨 362
+耳目 362
+良机 362
+苍蝇 362
+诉说 362
+贴身 362
+这样的话 362
+适量 362
+逼迫 362
+采茶 362
+陈毅 362
+魁 362
+东林党 361
+全程 361
+只求 361
+和谈 361
+土坑 361
+天监 361
+奴隶主 361
+张口 361
+忙于 361
+恳 361
+执行官 361
+搜查 361
+无量 361
+木棒 361
+洁 361
+租赁 361
+绊 361
+翻阅 361
+艺术品 361
+诬陷 361
+谁家 361
+集镇 361
+青铜器 361
+顣 361
+骨折 361
+北山 360
+堜 360
+外交官 360
+大庆 360
+子弟兵 360
+实时 360
+开关 360
+开幕式 360
+心事 360
+普通人 360
+有生 360
+柑 360
+汉书 360
+沿袭 360
+田野 360
+空地 360
+粈 360
+绵 360
+羌族 360
+考入 360
+药业 360
+蜜蜂 360
+辜负 360
+遗书 360
+郝摇旗 360
+重臣 360
+阿玛 360
+隐约 360
+马列主义 360
+一曲 359
+中南海 359
+化学家 359
+原则上 359
+参军 359
+增幅 359
+多子 359
+多样性 359
+天津队 359
+尊号 359
+延期 359
+恶意 359
+拉动 359
+散射 359
+新药 359
+早饭 359
+毛笔 359
+水道 359
+矗立 359
+站台 359
+结盟 359
+胚 359
+螅 359
+讲学 359
+讲授 359
+调度 359
+道场 359
+问话 359
+阿尔巴尼亚 359
+高贵 359
+修补 358
+删除 358
+南湘 358
+发酵 358
+回合 358
+国宝 358
+宗族 358
+工事 358
+巨人 358
+庙里 358
+强国 358
+强悍 358
+总参谋长 358
+慈悲 358
+战果 358
+教务 358
+日本政府 358
+明月 358
+构思 358
+活捉 358
+特委 358
+理所当然 358
+生化 358
+礼拜 358
+社会制度 358
+种姓 358
+纯洁 358
+经济林 358
+输给 358
+造化 358
+阿尔 358
+阿紫 358
+雪地 358
+顾不上 358
+主宰 357
+偏见 357
+兴旺 357
+劳动生产率 357
+单体 357
+卵巢 357
+商旅 357
+尖叫 357
+彭总 357
+政治学 357
+沸腾 357
+清算 357
+相持 357
+纳西族 357
+胺 357
+衰竭 357
+西华 357
+要道 357
+误区 357
+跑道 357
+连成一片 357
+长于 357
+阿弥陀佛 357
+集训 357
+饥民 357
+上院 356
+健美 356
+公爵 356
+册立 356
+北伐军 356
+南京城 356
+床单 356
+微分方程 356
+戏称 356
+技法 356
+搜捕 356
+敌我 356
+文中 356
+机械厂 356
+武帝 356
+生理学 356
+直系 356
+知识点 356
+精于 356
+翰林 356
+腹泻 356
+要钱 356
+解放思想 356
+载入 356
+边沿 356
+金子 356
+高山族 356
+黑影 356
+不屑 355
+中场 355
+丰富多彩 355
+久久 355
+云朵 355
+伤痕 355
+军委 355
+十名 355
+发财 355
+婆娘 355
+婏 355
+孕育 355
+宣武门 355
+录音 355
+忌讳 355
+意在 355
+挣钱 355
+改正 355
+无所谓 355
+枯水期 355
+正德 355
+氦 355
+激化 355
+犹太 355
+神韵 355
+筵席 355
+肃清 355
+芳香 355
+草本 355
+葵花 355
+路旁 355
+辅佐 355
+这帮 355
+鲤鱼 355
+鹊 355
+丢下 354
+作法 354
+分辨率 354
+努尔哈 354
+商业银行 354
+坞 354
+山羊 354
+悲壮 354
+愈加 354
+憎恨 354
+拳法 354
+改进型 354
+政区 354
+泥鳅 354
+深海 354
+濇 354
+硬盘 354
+磷矿 354
+神通 354
+种质 354
+稿子 354
+第二代 354
+素养 354
+虎门 354
+谋杀 354
+贮 354
+难当 354
+首轮 354
+黑点 354
+七十 353
+不失 353
+亨特 353
+亮点 353
+体面 353
+倭 353
+半句 353
+县里 353
+发病率 353
+史可法 353
+地盘 353
+坡度 353
+外债 353
+太行 353
+失地 353
+尊贵 353
+恐慌 353
+无论是 353
+桃源 353
+樱桃 353
+毕生 353
+热血 353
+知青 353
+稀疏 353
+绕道 353
+给事中 353
+行至 353
+蹙 353
+遗物 353
+野味 353
+非线性 353
+骗人 353
+高额 353
+一并 352
+三节 352
+为的是 352
+亚军 352
+党委书记 352
+农田水利 352
+冲虚 352
+凳子 352
+创伤 352
+双打 352
+反恐 352
+后天 352
+启蒙 352
+地表水 352
+大碗 352
+妖怪 352
+姨太太 352
+嬬 352
+射箭 352
+小鬼 352
+屈辱 352
+愧 352
+招募 352
+接连不断 352
+文本 352
+斟酌 352
+晏 352
+王平 352
+电缆 352
+皇子 352
+盲人 352
+第一卷 352
+筛选 352
+精妙 352
+纳税 352
+茶水 352
+赏识 352
+远洋 352
+金正日 352
+青竹 352
+五角大楼 351
+人道主义 351
+合葬 351
+唱歌 351
+嗓音 351
+女孩儿 351
+张国焘 351
+慕名 351
+技改 351
+放养 351
+晓霞 351
+李云风 351
+李信 351
+棺椁 351
+海参 351
+海蔘 351
+满载 351
+神像 351
+耀武扬威 351
+耶路撒冷 351
+荒山 351
+触动 351
+许可证 351
+过日子 351
+都护 351
+靴 351
+鞑靼 351
+黄鳝 351
+井口 350
+创刊 350
+大衣 350
+悼念 350
+撕下 350
+新任 350
+有数 350
+本着 350
+梁曙光 350
+欣 350
+洪流 350
+海防 350
+猴头 350
+畜禽 350
+监事会 350
+笺 350
+脸孔 350
+镂 350
+青年人 350
+韩太太 350
+预备役 350
+高铁 350
+丙 349
+两极 349
+会师 349
+传言 349
+劣 349
+台基 349
+坐定 349
+夏玉莲 349
+宗派 349
+年满 349
+总分 349
+按着 349
+有罪 349
+李时珍 349
+炼制 349
+膨 349
+芝加哥 349
+行路 349
+轰击 349
+迦 349
+邮票 349
+重叠 349
+锅炉 349
+颐和园 349
+题词 349
+颤动 349
+风云 349
+骸 349
+一卷 348
+一滴 348
+三百 348
+上部 348
+不凡 348
+中堂 348
+仰天 348
+军种 348
+动不动 348
+单调 348
+同声 348
+嚑 348
+国庆节 348
+并存 348
+手电筒 348
+扫地 348
+文渊阁 348
+新闻网 348
+李子 348
+棉纱 348
+熊掌 348
+熜 348
+落差 348
+身世 348
+难保 348
+馍 348
+鲜嫩 348
+一连串 347
+不规则 347
+中行 347
+五谷 347
+人参 347
+人蔘 347
+兵家必争之地 347
+典章 347
+内设 347
+军心 347
+冰凉 347
+击退 347
+半路 347
+吵架 347
+声势浩大 347
+好容易 347
+婆子 347
+字符 347
+平民百姓 347
+忎 347
+意欲 347
+环岛 347
+登记日 347
+督促 347
+竞相 347
+第二炮兵 347
+绝非 347
+老将 347
+获利 347
+蒸气 347
+袄 347
+调味 347
+财税 347
+贵阳 347
+跳投 347
+错落 347
+雄蕊 347
+马尾松 347
+业内 346
+二十一 346
+何尝 346
+刺杀 346
+副职 346
+动物园 346
+化学工业 346
+发起人 346
+告状 346
+哈萨克族 346
+啸 346
+廉政 346
+情趣 346
+成群 346
+欙 346
+流言 346
+漕 346
+绝缘 346
+职高 346
+西班牙人 346
+转眼 346
+银河 346
+颜洪烈 346
+马尔 346
+业主 345
+两颗 345
+乘车 345
+亚平 345
+入关 345
+决断 345
+化学反应 345
+发电机 345
+受热 345
+名头 345
+吸附 345
+垎 345
+声名 345
+客房 345
+山门 345
+平整 345
+庣 345
+当阳 345
+怜 345
+恍然大悟 345
+数理 345
+时数 345
+时速 345
+柱头 345
+棉纺 345
+水族 345
+沦陷 345
+混淆 345
+湘云 345
+献帝 345
+直觉 345
+窒息 345
+评定 345
+踉跄 345
+重现 345
+间断 345
+万个 344
+二十一条 344
+倦 344
+冬瓜 344
+几块 344
+古田 344
+吊脚楼 344
+夹杂 344
+学艺 344
+开凿 344
+正南 344
+煽动 344
+皮质 344
+禁军 344
+笑吟吟 344
+细嫩 344
+袁时中 344
+褢 344
+远近 344
+金黄色 344
+长矛 344
+魔头 344
+中流砥柱 343
+传球 343
+免于 343
+兔子 343
+再见 343
+堂屋 343
+姨 343
+岭南 343
+巴东 343
+巴比伦 343
+张义民 343
+往回 343
+或多或少 343
+无礼 343
+果园 343
+染色 343
+氧化物 343
+漠 343
+甲骨文 343
+相宜 343
+第一台 343
+编译 343
+网络游戏 343
+萝卜 343
+薄膜 343
+裙子 343
+调用 343
+贝母 343
+负债 343
+迳 343
+隔开 343
+顶住 343
+世界性 342
+乾坤 342
+于明 342
+勺 342
+卡拉 342
+喊叫 342
+嘴边 342
+学期 342
+宝物 342
+害人 342
+岩盐 342
+建华 342
+成品 342
+成色 342
+挫败 342
+李铁映 342
+残暴 342
+泣 342
+老前辈 342
+舔 342
+艰辛 342
+衍射 342
+要不然 342
+质感 342
+贵重 342
+辨别 342
+迎娶 342
+进犯 342
+连带 342
+铁钉 342
+附着 342
+陆羽 342
+骡 342
+三步 341
+义和团运动 341
+停步 341
+减退 341
+副官 341
+受贿 341
+可疑 341
+周六 341
+国企 341
+坚信 341
+实质性 341
+巳 341
+微型 341
+政绩 341
+文昌 341
+暗算 341
+朝野 341
+棕色 341
+棤 341
+气囊 341
+浊 341
+浦 341
+深谷 341
+满身 341
+焦黄 341
+眽 341
+短剑 341
+短线 341
+置身 341
+老佛爷 341
+蜰 341
+都统 341
+钝 341
+银针 341
+面皮 341
+麪皮 341
+世代相传 340
+丢掉 340
+九十 340
+云铮 340
+何用 340
+党风 340
+凌空 340
+台风 340
+唯心主义 340
+大件 340
+婶子 340
+嫌疑 340
+尘埃 340
+应声 340
+效用 340
+昭君 340
+涉足 340
+渚 340
+炫耀 340
+秩 340
+立功 340
+粠 340
+觉着 340
+贵州省 340
+过时 340
+闯进 340
+青蛙 340
+骤 340
+不朽 339
+严刑 339
+主将 339
+于长江 339
+众所周知 339
+刻本 339
+卖出 339
+占优势 339
+可行性 339
+圣彼得堡 339
+大麦 339
+宪兵 339
+山岭 339
+布袋 339
+得手 339
+患有 339
+战船 339
+打动 339
+放纵 339
+旗杆 339
+林黛玉 339
+水性 339
+点心 339
+留有 339
+稳健 339
+穆念慈 339
+第三种 339
+粗壮 339
+编导 339
+考官 339
+膥 339
+补血 339
+诸般 339
+赍 339
+赔款 339
+轧 339
+辩证 339
+钓鱼岛 339
+雨季 339
+义勇军 338
+伊犁 338
+倾心 338
+假日 338
+关押 338
+听觉 338
+吹灯 338
+堑 338
+大巴山 338
+头颅 338
+好办 338
+存亡 338
+愪 338
+打人 338
+抡 338
+拨打 338
+捉住 338
+无缘 338
+晋代 338
+欣喜 338
+气压 338
+沙俄 338
+灰褐色 338
+炼油 338
+牧民 338
+物事 338
+田福贤 338
+电能 338
+私有制 338
+笔试 338
+罪过 338
+英国议会 338
+茶庄 338
+衬衫 338
+万世 337
+两组 337
+临终 337
+为政 337
+偿 337
+党政军 337
+军纪 337
+刘玄 337
+半斤 337
+古时 337
+各门 337
+合影 337
+君权 337
+周日 337
+咀嚼 337
+大帅 337
+太阳系 337
+失陷 337
+安全性 337
+建筑师 337
+戚芳 337
+扫雷 337
+拔剑 337
+文学史 337
+曙 337
+替补 337
+染发 337
+氭 337
+波斯湾 337
+波浪 337
+甲状腺 337
+瘽 337
+胃口 337
+菏泽 337
+迟到 337
+钱财 337
+雷声 337
+一如 336
+不愧 336
+乐曲 336
+乒乓球 336
+仿照 336
+其内 336
+凝结 336
+刘絮云 336
+制糖 336
+刻意 336
+副将 336
+劳力 336
+取材 336
+受损 336
+同盟会 336
+后门 336
+告诫 336
+圪节 336
+姓氏 336
+帆船 336
+彼得 336
+情不自禁 336
+意料之外 336
+拉根尼 336
+散步 336
+无赖 336
+枢密院 336
+樱 336
+款项 336
+武大 336
+澳门特别行政区 336
+焚毁 336
+珪 336
+白嫩 336
+硅谷 336
+英寸 336
+讲习 336
+负荷 336
+赤字 336
+跳舞 336
+过量 336
+近代史 336
+连日 336
+逍遥 336
+遵照 336
+长阳 336
+鱿鱼 336
+中大 335
+何心隐 335
+余脉 335
+假若 335
+军容 335
+冷水 335
+击溃 335
+十次 335
+多种多样 335
+如实 335
+左腿 335
+平壤 335
+影像 335
+微妙 335
+总动员 335
+戚 335
+换句话说 335
+政工 335
+枪弹 335
+榴弹炮 335
+温馨 335
+热带雨林 335
+百货 335
+网吧 335
+艺术类 335
+贡品 335
+走入 335
+邺 335
+金莲 335
+铁掌 335
+镞 335
+阳性 335
+险要 335
+非农业 335
+黑格尔 335
+鼓动 335
+二十七日 334
+五丈 334
+传教士 334
+入库 334
+关照 334
+到期 334
+前哨 334
+升任 334
+卢布 334
+去路 334
+处方 334
+奸贼 334
+威名 334
+嬮 334
+忍心 334
+悄声 334
+悄然 334
+成名 334
+抗病 334
+换来 334
+搜寻 334
+攥 334
+放宽 334
+文化教育 334
+棋子 334
+每每 334
+水军 334
+流速 334
+炉火 334
+用到 334
+白银 334
+破解 334
+禽 334
+稽 334
+突起 334
+窗子 334
+红楼梦 334
+联结 334
+蔗糖 334
+象牙 334
+连任 334
+邸 334
+上风 333
+乌桕 333
+人民政协 333
+养牛 333
+冲锋 333
+去取 333
+哈里 333
+嗟 333
+宣传部 333
+富强 333
+平方厘米 333
+开水 333
+挺身而出 333
+新颖 333
+方解石 333
+晴雯 333
+曲子 333
+术后 333
+杭忆 333
+潜心 333
+环流 333
+筽 333
+绔 333
+绸 333
+缀 333
+自由主义 333
+荃 333
+蓝图 333
+褠 333
+证券报 333
+走开 333
+辏 333
+铁路桥 333
+降雨量 333
+隐秘 333
+预示 333
+风力 333
+且慢 332
+东州 332
+丫环 332
+伪造 332
+体贴 332
+克罗地亚 332
+其子 332
+冒充 332
+卷入 332
+吕布 332
+哀求 332
+唾沫 332
+宪宗 332
+屡次 332
+巴拿马 332
+幽灵 332
+徐霞客 332
+情调 332
+愕 332
+抗震救灾 332
+接壤 332
+木盒 332
+李萍 332
+桥下 332
+歌词 332
+武警部队 332
+殷勤 332
+汀 332
+沁 332
+火柴 332
+熔岩 332
+珮 332
+用材林 332
+畅通 332
+直观 332
+笑意 332
+笢 332
+管事 332
+网点 332
+聚焦 332
+苍 332
+跳水 332
+铁青 332
+高桥 332
+一着 331
+代为 331
+医务 331
+午后 331
+原文 331
+右腿 331
+后台 331
+呼吸道 331
+回扣 331
+回落 331
+复原 331
+并称 331
+床边 331
+引信 331
+挑衅 331
+数里 331
+期刊 331
+朱厚照 331
+枃 331
+沅 331
+注明 331
+深水 331
+灾民 331
+熻 331
+疾驰 331
+瞟 331
+第九届 331
+筛 331
+綔 331
+蛹 331
+蜂蜜 331
+迪斯尼 331
+送礼 331
+金庸 331
+首发 331
+驱赶 331
+万头 330
+中西 330
+了结 330
+产业资本 330
+伯母 330
+体外 330
+倒地 330
+停泊 330
+六届 330
+关外 330
+吓坏 330
+待定 330
+明智 330
+李四光 330
+核算 330
+棩 330
+汹涌 330
+湖中 330
+灵敏 330
+炮台 330
+眼睁睁 330
+第一章 330
+第三章 330
+第十届 330
+经济基础 330
+计划经济 330
+遗嘱 330
+防腐剂 330
+霓 330
+餐饮 330
+三王 329
+三道 329
+三门 329
+不须 329
+信用卡 329
+冲洗 329
+出具 329
+出奇 329
+刘体纯 329
+取缔 329
+埋藏 329
+头颈 329
+妄想 329
+寻觅 329
+左脚 329
+巨型 329
+扁平 329
+打中 329
+扣除 329
+新路 329
+机上 329
+欧鹏 329
+濒 329
+用地 329
+登场 329
+秤 329
+约会 329
+自制 329
+解说 329
+讹 329
+身价 329
+风雪 329
+一定量 328
+一桩 328
+上船 328
+两幅 328
+古人类 328
+只用 328
+哮喘 328
+奥妙 328
+好奇心 328
+岳家 328
+帷幕 328
+延误 328
+惨白 328
+晚清 328
+汇入 328
+河岸 328
+油价 328
+火腿肠 328
+皬 328
+福康安 328
+秦安 328
+秦腔 328
+第二种 328
+肃立 328
+股份制 328
+蟒 328
+血浆 328
+过硬 328
+金钱豹 328
+鍼灸 328
+针灸 328
+鼓声 328
+严正 327
+仕途 327
+会址 327
+保养 327
+军衔 327
+加害 327
+发音 327
+吴仪 327
+周一 327
+大头 327
+大官 327
+废话 327
+录取率 327
+惊心动魄 327
+截断 327
+拭 327
+拱形 327
+撶 327
+攻下 327
+旁观 327
+横行 327
+治愈 327
+滋生 327
+漫步 327
+炼丹 327
+王陵 327
+矮子 327
+矮小 327
+石雕 327
+磷肥 327
+祝福 327
+筵 327
+绚丽 327
+编码 327
+罢休 327
+葡 327
+融化 327
+资财 327
+铅笔 327
+锭 327
+陈旧 327
+静默 327
+鲁智深 327
+龚鼎 327
+一瓶 326
+位子 326
+公事 326
+内侧 326
+分出 326
+前导 326
+剧情 326
+北冰洋 326
+口服 326
+口语 326
+向导 326
+坦然 326
+外力 326
+大牌 326
+岋 326
+平缓 326
+年幼 326
+府上 326
+异性 326
+强弱 326
+归国 326
+恍 326
+所作所为 326
+救治 326
+旧制 326
+正视 326
+每秒 326
+沉香 326
+涧 326
+混杂 326
+游子 326
+特大 326
+男朋友 326
+秘诀 326
+简洁 326
+良药 326
+迫于 326
+酒吧 326
+錧 326
+马鞍山 326
+黄盖 326
+一帮 325
+五道 325
+京西 325
+作曲 325
+借用 325
+僻 325
+几道 325
+出生率 325
+卧薪尝胆 325
+反常 325
+号角 325
+团员 325
+堵住 325
+复发 325
+复线 325
+审视 325
+山势 325
+巧遇 325
+成败 325
+战胜国 325
+扑灭 325
+执教 325
+抢夺 325
+效能 325
+斯密 325
+既定 325
+未及 325
+架桥 325
+棋手 325
+歉 325
+民法 325
+沉静 325
+河湾 325
+涵 325
+渡河 325
+火速 325
+狐狸 325
+生死存亡 325
+简明 325
+终极 325
+编组 325
+草木 325
+蝇 325
+谛 325
+贝壳 325
+鲍 325
+鲥鱼 325
+不在话下 324
+中共中央政治局 324
+会意 324
+余处 324
+几分钟 324
+出乎 324
+嘉乔 324
+外加 324
+大观 324
+委托人 324
+威慑 324
+定制 324
+宠爱 324
+尉 324
+惶 324
+懒得 324
+手表 324
+打鼓 324
+拼音 324
+止住 324
+河里 324
+猎人 324
+磁带 324
+老伴 324
+胡须 324
+蚂蚁 324
+起降 324
+运输量 324
+锏 324
+静悄悄 324
+骚动 324
+高昂 324
+会审 323
+军委会 323
+出奇制胜 323
+受体 323
+吃掉 323
+唐贞观 323
+堡垒 323
+增量 323
+好几 323
+孔道 323
+客机 323
+庄园 323
+归侨 323
+影壁 323
+搔 323
+核动力 323
+椭圆 323
+武装部队 323
+狭长 323
+矶 323
+老龄 323
+股数 323
+胆固醇 323
+莺 323
+蔡瑁 323
+蠢 323
+衣着 323
+要塞 323
+详尽 323
+贴近 323
+部将 323
+鎭 323
+铭 323
+集资 323
+颂扬 323
+黄一彪 323
+下场 322
+乱砍 322
+于城邦 322
+信托 322
+凉拌 322
+刻苦 322
+募捐 322
+吐露 322
+四门 322
+姩 322
+学家 322
+崄 322
+平起平坐 322
+径自 322
+恳求 322
+惊惶 322
+惶恐 322
+新近 322
+日程 322
+显而易见 322
+杠杆 322
+烙 322
+璐 322
+确诊 322
+积水 322
+粽子 322
+糉子 322
+老杨 322
+肥大 322
+致癌 322
+茶几 322
+蟮 322
+襟 322
+见见 322
+览 322
+议定 322
+跨国 322
+金光 322
+隘 322
+顺势 322
+黄豆 322
+鼎立 322
+一清二楚 321
+两晋 321
+争辩 321
+二十多年 321
+你老 321
+停当 321
+叫喊 321
+含蓄 321
+品尝 321
+嗒 321
+奴仆 321
+如意 321
+实习生 321
+幕府 321
+征战 321
+打法 321
+报社 321
+搀扶 321
+撒谎 321
+服务员 321
+此法 321
+此种 321
+涩 321
+清单 321
+渔网 321
+湿度 321
+热忱 321
+爹娘 321
+皮肉 321
+祈求 321
+票据 321
+第三纪 321
+第二级 321
+繁盛 321
+脸面 321
+自负 321
+蝌蚪 321
+证件 321
+迷宫 321
+釉 321
+顾忌 321
+骗子 321
+一周年 320
+专电 320
+依稀 320
+全集 320
+几百 320
+出头 320
+出没 320
+出版物 320
+分管 320
+十几 320
+叔父 320
+各型 320
+喏 320
+失声 320
+小桂子 320
+情欲 320
+执意 320
+浙东 320
+混合物 320
+熔铸 320
+牱 320
+猛攻 320
+省内外 320
+神智 320
+积聚 320
+绒 320
+联谊 320
+脖 320
+蒸汽机 320
+谢家 320
+遗忘 320
+铜器 320
+霹雳 320
+预案 320
+风浪 320
+下沉 319
+侵袭 319
+公安部 319
+刘家 319
+化验 319
+发射器 319
+发脾气 319
+吁 319
+商周 319
+大院 319
+威廉 319
+家产 319
+庞统 319
+打探 319
+方怡 319
+正气 319
+毫升 319
+江流 319
+牌照 319
+王长顺 319
+畲族 319
+眷 319
+纸条 319
+经文 319
+绫 319
+缓步 319
+衍生 319
+衡 319
+警报 319
+许许多多 319
+象山 319
+超前 319
+遗存 319
+锡伯族 319
+长山 319
+间隙 319
+陨石 319
+青衣 319
+风景线 319
+鲜有 319
+丁典 318
+三只 318
+两用 318
+习惯于 318
+从头 318
+再创 318
+凭证 318
+呜咽 318
+外祖母 318
+天性 318
+孙婆婆 318
+安定门 318
+师大 318
+庐江 318
+建安 318
+引来 318
+恁 318
+所到之处 318
+披上 318
+既然如此 318
+李婷 318
+核实 318
+水解 318
+汽油 318
+河东 318
+流放 318
+涗 318
+清明 318
+溃疡 318
+罗斯福 318
+老伯 318
+血清 318
+装束 318
+装甲师 318
+钊 318
+飞架 318
+马里 318
+丁汝昌 317
+三叉 317
+上铺 317
+串珠 317
+乌鸦 317
+交接 317
+从严 317
+从属 317
+仿效 317
+体形 317
+侮 317
+侵华 317
+偏南 317
+公孙止 317
+劳工 317
+吃喝 317
+国事 317
+宵 317
+年后 317
+怜悯 317
+想念 317
+执政党 317
+核查 317
+止血 317
+永和 317
+江醉章 317
+溴 317
+皮毛 317
+石灰石 317
+纷 317
+翻滚 317
+背鳍 317
+苻 317
+茧 317
+蔡元培 317
+车长 317
+逃生 317
+重担 317
+钓鱼 317
+陈富忠 317
+颁奖 317
+飞快 317
+高超 317
+龙宫 317
+专场 316
+二七 316
+估价 316
+元稹 316
+典范 316
+出其不意 316
+划归 316
+功勋 316
+包扎 316
+北周 316
+只当 316
+周瑜 316
+失守 316
+宫阙 316
+射手 316
+履 316
+岛主 316
+应酬 316
+廖化 316
+忠臣 316
+批示 316
+教练机 316
+无数次 316
+日光 316
+智商 316
+柏 316
+棍棒 316
+毋宁 316
+水煮 316
+求见 316
+沐浴 316
+游玩 316
+漆树 316
+潜入 316
+灵感 316
+点点 316
+确信 316
+磨制 316
+竹林 316
+策动 316
+绠 316
+老婆子 316
+膀胱 316
+订购 316
+说到底 316
+走兽 316
+重演 316
+销毁 316
+陌生人 316
+骇然 316
+世界观 315
+仿制 315
+体弱 315
+傲慢 315
+农牧业 315
+前头 315
+卷烟 315
+发誓 315
+吞并 315
+好喝 315
+安庆 315
+岗哨 315
+幡 315
+彻 315
+惨败 315
+成像 315
+曼璐 315
+架构 315
+溯 315
+牧区 315
+独联体 315
+珍宝 315
+砚 315
+蛋糕 315
+血流 315
+袈裟 315
+袱 315
+谢烟客 315
+趣 315
+醒悟 315
+阖 315
+革命战争 315
+颈部 315
+飞身 315
+黑娃 315
+丁春秋 314
+东道 314
+九曲 314
+于谦 314
+五种 314
+仁义 314
+典籍 314
+内务 314
+南宁 314
+大奖赛 314
+头骨 314
+年限 314
+张海丽 314
+恋人 314
+情操 314
+李汉俊 314
+柳如玉 314
+水雷 314
+洛桑 314
+烘烤 314
+蝙蝠 314
+衣物 314
+谢富治 314
+近处 314
+配有 314
+采花 314
+铜牌 314
+非得 314
+一村 313
+上调 313
+不敢当 313
+九阴真经 313
+二十四 313
+亚里士多德 313
+克隆 313
+农夫 313
+刚果 313
+加倍 313
+叹气 313
+后金 313
+喘气 313
+塑性 313
+奏章 313
+姿 313
+宣统 313
+布雷 313
+店小二 313
+延津 313
+弹道 313
+录像 313
+患病 313
+拓宽 313
+挨着 313
+探究 313
+数组 313
+施琅 313
+无机 313
+木棍 313
+汲取 313
+海岛 313
+渗出 313
+漫画 313
+猖獗 313
+红眼 313
+肩负 313
+胡椒 313
+芬 313
+裔 313
+钞票 313
+阴沉 313
+领事 313
+马到成功 313
+上天 312
+余万元 312
+侍女 312
+偏僻 312
+入门 312
+册封 312
+前端 312
+匈 312
+司法部 312
+味儿 312
+夏威夷 312
+大洋洲 312
+委托书 312
+实例 312
+打井 312
+指控 312
+放慢 312
+斯里兰卡 312
+晚报 312
+晶体管 312
+暗道 312
+杀戮 312
+杆子 312
+武备 312
+水体 312
+溂 312
+火花 312
+缰绳 312
+苟 312
+趣味 312
+跋 312
+逗留 312
+骚乱 312
+鱼翅 312
+齐国 312
+东非 311
+交互 311
+保罗 311
+俺们 311
+军训 311
+冲散 311
+凤凰山 311
+刘华清 311
+后头 311
+吻合 311
+售价 311
+商定 311
+喀什 311
+喧嚣 311
+备有 311
+外公 311
+大修 311
+太宗 311
+异样 311
+张罗 311
+当头 311
+扬言 311
+提炼 311
+放肆 311
+断言 311
+时人 311
+暖流 311
+李岚清 311
+歇息 311
+泵 311
+淙淙 311
+渡口 311
+牢房 311
+甸 311
+白俄罗斯 311
+相比之下 311
+知名度 311
+石英砂 311
+纠 311
+编印 311
+肉丝 311
+蜘蛛 311
+行政部门 311
+行辕 311
+谈不上 311
+轮胎 311
+迄今为止 311
+醇厚 311
+铜矿 311
+锄 311
+门将 311
+不知所措 310
+世锦赛 310
+两把 310
+交响乐团 310
+光照 310
+南山 310
+古文化 310
+后背 310
+字型 310
+庐 310
+徐徐 310
+忧郁 310
+承办 310
+数招 310
+旌旗 310
+普通话 310
+本期 310
+榷 310
+熠熠 310
+爽口 310
+碰见 310
+空降兵 310
+篮子 310
+红海 310
+缰 310
+群山 310
+胆怯 310
+计委 310
+诺基亚 310
+谷六仙 310
+迷迷糊糊 310
+阎 310
+面对面 310
+鱼头 310
+三局 309
+下回分解 309
+不畏 309
+人工湖 309
+八中 309
+关内 309
+冯公公 309
+凑巧 309
+制取 309
+匡 309
+千百年 309
+厂房 309
+历法 309
+原址 309
+含意 309
+呮 309
+固守 309
+好听 309
+孙玉亭 309
+定性 309
+宰辅 309
+小摊 309
+尾部 309
+布拉格 309
+心血管 309
+恪 309
+撂 309
+撴 309
+晶莹 309
+有别 309
+标识 309
+梁晴 309
+民主人士 309
+现代人 309
+瓶子 309
+第二节 309
+红火 309
+老道 309
+肚皮 309
+色情 309
+茫 309
+血迹 309
+轻蔑 309
+达西 309
+运到 309
+选派 309
+郎君 309
+顺从 309
+马谡 309
+高雅 309
+上床 308
+不以为然 308
+个性化 308
+中国大学 308
+侨眷 308
+司空 308
+后市 308
+哼哼 308
+土族 308
+坦诚 308
+射电 308
+岳夫人 308
+嶽夫人 308
+庆贺 308
+忌惮 308
+抠 308
+拉扯 308
+挨打 308
+捱打 308
+敲门 308
+文坛 308
+架上 308
+死囚 308
+漫游 308
+研究室 308
+稳固 308
+肝炎 308
+裂纹 308
+踹 308
+过不去 308
+醴陵 308
+金德 308
+鍧 308
+钢板 308
+钻入 308
+闻名中外 308
+面团 308
+革命党 308
+餢 308
+万多公里 307
+严酷 307
+乡土气息 307
+于二龙 307
+传教 307
+做官 307
+决议案 307
+凡事 307
+初等教育 307
+华沙 307
+去向 307
+喉头 307
+喝彩 307
+土城 307
+复杂性 307
+奇珍 307
+女神 307
+尧 307
+工贸 307
+当务之急 307
+微分 307
+微米 307
+悖 307
+披甲 307
+整车 307
+暂行 307
+暖和 307
+殖民主义 307
+汤若望 307
+活佛 307
+深渊 307
+满面 307
+漂浮 307
+琚 307
+础 307
+碌 307
+贰 307
+运输线 307
+造反派 307
+霎时间 307
+三点 306
+僵硬 306
+全球性 306
+冬至 306
+分娩 306
+刘姥姥 306
+十道 306
+厂里 306
+各家各户 306
+四十年 306
+大鲵 306
+头号 306
+孙夫人 306
+开明 306
+强者 306
+恭喜 306
+惊叹 306
+放在眼里 306
+放疗 306
+日本自卫队 306
+民建 306
+水洞 306
+浅层 306
+爱国者 306
+秉 306
+翻开 306
+老太爷 306
+自然灾害 306
+航天器 306
+蒸笼 306
+袁绍 306
+说错 306
+起先 306
+通州 306
+郡县制 306
+铂 306
+陆路 306
+陵区 306
+高坡 306
+中能 305
+半边 305
+南边 305
+四角 305
+外籍 305
+宙斯 305
+宪章 305
+峻 305
+憨 305
+打通 305
+抑郁 305
+振臂 305
+文化部 305
+暇 305
+极小 305
+林平之 305
+溥仪 305
+灵活性 305
+炮轰 305
+瑟 305
+痛心 305
+碑亭 305
+胡风 305
+蠕动 305
+计议 305
+贫道 305
+跌落 305
+邓有米 305
+驷 305
+三下 304
+个儿 304
+中阳 304
+临界 304
+乘着 304
+事前 304
+五峯 304
+五峰 304
+依山傍水 304
+充电 304
+分泌物 304
+叙事 304
+吃酒 304
+器具 304
+国语 304
+女童 304
+子叶 304
+属实 304
+常青 304
+底面 304
+快船 304
+撅 304
+星期天 304
+更深 304
+毫不犹豫 304
+沉积物 304
+河畔 304
+满心 304
+熼 304
+爬行 304
+留神 304
+白旗 304
+皵 304
+等价 304
+老化 304
+胜仗 304
+袜子 304
+车轮 304
+转弯 304
+迁居 304
+钑 304
+银奖 304
+附件 304
+陈潭秋 304
+限期 304
+一寸 303
+一站 303
+万事 303
+五门 303
+京都 303
+傻瓜 303
+八宝 303
+出海 303
+南昌起义 303
+原子能 303
+取决于 303
+同门 303
+国家旅游局 303
+土块 303
+复明 303
+大好 303
+如林 303
+妗 303
+字头 303
+对岸 303
+帘子 303
+帧 303
+张家口 303
+思虑 303
+惟恐 303
+拄 303
+摊子 303
+无言 303
+早些 303
+最后通牒 303
+朱聪 303
+松开 303
+极富 303
+殉 303
+渗入 303
+王兆国 303
+电阻 303
+礼品 303
+累积 303
+约旦 303
+纵向 303
+结为 303
+编程 303
+胆小 303
+葡萄牙人 303
+诱导 303
+迫不及待 303
+铿 303
+难忍 303
+难点 303
+领略 303
+风度 303
+飞速 303
+人民武装 302
+冲杀 302
+出家 302
+分期 302
+剩余产品 302
+北京地区 302
+台前 302
+喝水 302
+在乎 302
+垂帘听政 302
+声望 302
+太重 302
+嬭 302
+富豪 302
+小张 302
+战法 302
+景物 302
+榘 302
+殷正茂 302
+气力 302
+沙包 302
+淳 302
+满头 302
+牛马 302
+特殊教育 302
+王昭君 302
+生产量 302
+科教 302
+第二章 302
+筹集 302
+襄樊市 302
+解缙 302
+郭家 302
+领队 302
+万吨级 301
+三院 301
+上岗 301
+中枢神经 301
+主队 301
+修习 301
+入主 301
+全队 301
+公孙 301
+内蒙古自治区 301
+出栏 301
+古罗马 301
+可言 301
+地空导弹 301
+士卒 301
+大娘 301
+太保 301
+太史 301
+宝宝 301
+对阵 301
+开胃 301
+引得 301
+懡 301
+打猎 301
+拔牙 301
+招致 301
+捷克斯洛伐克 301
+摄氏度 301
+摸摸 301
+无常 301
+时髦 301
+有钱有势 301
+机车 301
+李闯王 301
+油气 301
+游戏机 301
+独立性 301
+玹 301
+用油 301
+电动机 301
+电子邮件 301
+痉挛 301
+真经 301
+神灵 301
+窒 301
+紫文道 301
+纺纱 301
+苏鲁克 301
+袁宗第 301
+警觉 301
+转增 301
+边际 301
+颠覆 301
+黎巴嫩 301
+不计其数 300
+不问 300
+乙烯 300
+合十 300
+回乡 300
+大通道 300
+天文台 300
+奢侈品 300
+完婚 300
+山墙 300
+帻 300
+庆幸 300
+庙宇 300
+所为 300
+承袭 300
+投靠 300
+敬仰 300
+无益 300
+杰作 300
+殷梨亭 300
+汴 300
+激荡 300
+炒面 300
+炒麪 300
+牛筋 300
+狂奔 300
+琅 300
+知情 300
+算盘 300
+粟 300
+红糖 300
+维新 300
+表妹 300
+表扬 300
+衰变 300
+裂变 300
+订单 300
+诱人 300
+调遣 300
+达摩 300
+近现代 300
+邬 300
+钢厂 300
+餐桌 300
+不管怎样 299
+俟 299
+倒塌 299
+兴山 299
+出新 299
+区区 299
+南纬 299
+喻 299
+囚 299
+夕阳 299
+孰 299
+安全局 299
+峙 299
+崚 299
+平底 299
+平素 299
+并发症 299
+应选 299
+开时 299
+恐龙 299
+打成 299
+明太祖 299
+显微镜 299
+景致 299
+框 299
+樊 299
+款待 299
+洟 299
+浸泡 299
+游荡 299
+演示 299
+潜伏 299
+烟火 299
+疗 299
+白果 299
+盛名 299
+破烂 299
+穿透 299
+第一家 299
+粰 299
+美妙 299
+美观 299
+臭豆腐 299
+补救 299
+装卸 299
+钾长石 299
+长条形 299
+随同 299
+需求量 299
+黄原 299
+龅 299
+一丈 298
+丧事 298
+亿年 298
+伞兵 298
+传导 298
+佣工 298
+倔强 298
+偌大 298
+党支部 298
+几处 298
+凶狠 298
+出钱 298
+勤务 298
+南水北调 298
+叭 298
+周家 298
+回转 298
+回鹘 298
+宝藏 298
+寄养 298
+惬意 298
+据称 298
+方以智 298
+斾 298
+死里逃生 298
+潮汐 298
+片刻间 298
+特赦 298
+盛会 298
+禽流感 298
+竟陵 298
+第四纪 298
+等价物 298
+老娘 298
+老李 298
+舒畅 298
+苏宁 298
+赛前 298
+鄙 298
+钼 298
+银河系 298
+长堤 298
+闪身 298
+飚 298
+一夜间 297
+一天到晚 297
+中国共产党中央委员会 297
+九章 297
+五天 297
+亿多元 297
+付给 297
+凯旋 297
+初始 297
+到头来 297
+县域 297
+听候 297
+哒 297
+契机 297
+定下 297
+实权 297
+寓意 297
+幇 297
+意料 297
+控诉 297
+撒拉族 297
+教书 297
+星夜 297
+有机物 297
+次要 297
+江城 297
+江段 297
+浮动 297
+痛楚 297
+登陆舰 297
+皓 297
+破土动工 297
+科普 297
+脊髓 297
+英美 297
+螨 297
+要旨 297
+路程 297
+蹲下 297
+防腐 297
+陷害 297
+隔绝 297
+鲇鱼 297
+佛罗伦萨 296
+保和殿 296
+倾覆 296
+兼容 296
+办公楼 296
+发愁 296
+发自 296
+可望 296
+各司其职 296
+向忠发 296
+地基 296
+墨鱼 296
+奥斯曼帝国 296
+屡屡 296
+巧慧 296
+年老 296
+扭头 296
+护法 296
+捕获 296
+棱形 296
+樊一翁 296
+正副 296
+歼击机 296
+清静 296
+灶台 296
+电工 296
+睡梦 296
+简史 296
+纤夫 296
+约莫 296
+纯白 296
+绅士 296
+脓 296
+自由化 296
+苏门羚 296
+西装 296
+贾珍 296
+蹄子 296
+转达 296
+过关 296
+逐出 296
+郭中姚 296
+青草 296
+韵律 296
+高家 296
+一拥而上 295
+一款 295
+万斤 295
+三件 295
+上万元 295
+事事 295
+人民共和国 295
+先例 295
+克林顿 295
+军装 295
+出缺 295
+前程 295
+卫冕 295
+卷宗 295
+可取 295
+士大夫 295
+太郎 295
+安哥拉 295
+展望 295
+心地 295
+成天 295
+打伤 295
+拘 295
+插手 295
+放行 295
+散货 295
+敬畏 295
+文史 295
+更替 295
+极点 295
+栨 295
+楚文化 295
+概貌 295
+此起彼伏 295
+河沟 295
+炸毁 295
+真切 295
+紑 295
+紧缩 295
+繁殖力 295
+翡翠 295
+耻 295
+芭蕾 295
+蚊 295
+训练团 295
+退位 295
+银元 295
+锑 295
+马场 295
+麻痹 295
+七届 294
+三十六 294
+三部曲 294
+不祥 294
+两场 294
+中西部 294
+书店 294
+五座 294
+京族 294
+便当 294
+元气 294
+八十年代 294
+减免 294
+刘芳亮 294
+加勒比海 294
+千亩 294
+半封闭 294
+县令 294
+变色 294
+古董 294
+吉普车 294
+奋战 294
+子房 294
+实惠 294
+快捷 294
+性别比 294
+手忙脚乱 294
+报表 294
+拼搏 294
+揹包 294
+整风运动 294
+棕黄色 294
+永固 294
+献身 294
+疏松 294
+第二卷 294
+米芾 294
+背包 294
+自居 294
+苞 294
+范文程 294
+补选 294
+装载 294
+观察员 294
+译本 294
+诗句 294
+躲开 294
+远古 294
+违纪 294
+邓飞 294
+郝 294
+铜钱 294
+锣鼓 294
+音乐厅 294
+鱼尾 294
+万户 293
+两三个 293
+中国工农红军 293
+中美洲 293
+买者 293
+京山 293
+估算 293
+光彩夺目 293
+兵权 293
+历次 293
+发生地 293
+取向 293
+合葬墓 293
+国贼 293
+地坛 293
+外衣 293
+宅子 293
+我心 293
+戴尔 293
+招牌 293
+拥入 293
+挖出 293
+李为民 293
+桐柏山 293
+正午 293
+毛冠鹿 293
+深层 293
+熟知 293
+爽快 293
+珊瑚礁 293
+疮 293
+祭司 293
+簧 293
+编入 293
+网址 293
+英亩 293
+蚊子 293
+评判 293
+谋反 293
+远距离 293
+配殿 293
+金鲁生 293
+镏 293
+陈云 293
+馐 293
+两样 292
+买来 292
+俅 292
+分付 292
+升至 292
+反叛 292
+品格 292
+因特网 292
+塔吉克族 292
+多公里 292
+大熊猫 292
+定时 292
+宝马 292
+审计署 292
+少不了 292
+帖子 292
+徭役 292
+挤出 292
+插图 292
+效忠 292
+教法 292
+有史以来 292
+李永波 292
+枪杀 292
+栋 292
+核弹头 292
+求情 292
+演绎 292
+熄 292
+用功 292
+白纸 292
+监事 292
+矿物质 292
+绰号 292
+美术馆 292
+而今 292
+肽 292
+莎士比亚 292
+葛洪 292
+蜜枣 292
+行刑 292
+衰亡 292
+陡壁 292
+高利贷 292
+中建 291
+九宫山 291
+二十二年 291
+互补 291
+仡佬族 291
+低廉 291
+儿媳 291
+农民起义 291
+凤英 291
+出品 291
+加息 291
+动能 291
+千卡 291
+厥 291
+咚 291
+土地改革 291
+好端端 291
+姑苏 291
+婚后 291
+孙传庭 291
+宗人府 291
+定名 291
+宫内 291
+家庙 291
+家里人 291
+封建主义 291
+履带 291
+德行 291
+怜惜 291
+抗日民族统一战线 291
+抱拳 291
+挤压 291
+攸 291
+文化层 291
+施政 291
+有色 291
+松散 291
+棘手 291
+武昌鱼 291
+测绘 291
+火堆 291
+猎物 291
+真话 291
+磨损 291
+礼服 291
+种群 291
+紫外线 291
+考前 291
+耗资 291
+肠道 291
+船舱 291
+草坪 291
+葡萄糖 291
+行刺 291
+转折点 291
+金山 291
+附中 291
+驴子 291
+二龙 290
+呼喝 290
+喽 290
+声援 290
+外经贸 290
+多边 290
+大增 290
+头皮 290
+审判权 290
+导体 290
+总成 290
+报警 290
+拇 290
+指路 290
+排灌 290
+支行 290
+方城 290
+李明 290
+检讨 290
+棚里 290
+港湾 290
+犁头 290
+私自 290
+空姐 290
+第一分 290
+绿松石 290
+罗力 290
+肉质 290
+肛门 290
+肮脏 290
+胰岛素 290
+虎山 290
+血色 290
+谦虚 290
+趴 290
+转机 290
+银川 290
+雌雄 290
+三万 289
+两对 289
+产销率 289
+亲情 289
+亲政 289
+光临 289
+全天 289
+刷刷 289
+反倾销 289
+可恶 289
+各条 289
+呕 289
+唐太宗 289
+垚 289
+奇才 289
+宇航员 289
+宽敞 289
+岂非 289
+帛 289
+强敌 289
+悦 289
+惊疑 289
+折扇 289
+教员 289
+新春 289
+杂草丛生 289
+权分立 289
+槌 289
+江浙 289
+渲染 289
+煡 289
+盯住 289
+睛 289
+胡自皋 289
+自转 289
+英雄好汉 289
+藏书 289
+逆转 289
+遥感 289
+郊外 289
+郑成功 289
+陛 289
+与会者 288
+书桌 288
+乳腺 288
+二环 288
+供电 288
+党章 288
+六路 288
+反省 288
+吊舱 288
+咸蛋 288
+嗛 288
+回味无穷 288
+失传 288
+山影 288
+岘 288
+工信处 288
+张三 288
+数千年 288
+最高点 288
+本校 288
+民盟 288
+清凉 288
+火电站 288
+眼界 288
+睙 288
+结合部 288
+花儿 288
+花山 288
+菜汤 288
+萧条 288
+装扮 288
+词语 288
+诚实 288
+轶 288
+辐射量 288
+郑家 288
+阿昌族 288
+陪审员 288
+陷落 288
+风向 288
+不知去向 287
+中山路 287
+低估 287
+何苦 287
+侀 287
+全军覆没 287
+凤山 287
+后魏 287
+吸入 287
+唱名 287
+四散 287
+圃 287
+坦率 287
+宪 287
+小山 287
+小王 287
+工程局 287
+徐家 287
+急救 287
+慷慨激昂 287
+按说 287
+新桥 287
+明朗 287
+极地 287
+樵 287
+气恼 287
+求救 287
+沙湾 287
+活生生 287
+溢 287
+牵涉 287
+甲午战争 287
+祥和 287
+简便 287
+绕城 287
+编修 287
+药物学 287
+荷枪实弹 287
+覆灭 287
+访华 287
+转战 287
+金元宝 287
+金刚石 287
+铁甲 287
+银票 287
+阅历 287
+髓 287
+龙船 287
+不详 286
+任意球 286
+倒转 286
+儒学 286
+公交 286
+兴高采烈 286
+化妆 286
+单向 286
+后主 286
+后生 286
+告终 286
+嚣张气焰 286
+复印件 286
+大喊 286
+天宇 286
+女兵 286
+妓院 286
+娃娃鱼 286
+字眼 286
+巡航 286
+巡视员 286
+摄氏 286
+数十万 286
+整风 286
+文职 286
+极目 286
+查阅 286
+欣然 286
+武英殿 286
+汇编 286
+油菜 286
+熊猫 286
+独具一格 286
+玉儿 286
+用电量 286
+私利 286
+老挝 286
+耶律大石 286
+苏打 286
+蕲春 286
+西宁 286
+解围 286
+贪官 286
+通宵 286
+顽固派 286
+飞刀 286
+万台 285
+下垂 285
+丝绸之路 285
+人代会 285
+住所 285
+佛经 285
+使用者 285
+决非 285
+几十斤 285
+劳作 285
+反对派 285
+叫好 285
+台站 285
+商船 285
+国美 285
+在城镇 285
+外校 285
+奸臣 285
+屋内 285
+性病 285
+怨恨 285
+恶毒 285
+排成 285
+斑竹 285
+新兵 285
+方圆 285
+无理 285
+明文 285
+本分 285
+杀毒 285
+毙命 285
+江民 285
+沉寂 285
+沧州 285
+涉外 285
+点火 285
+煤气 285
+王莽 285
+生来 285
+电子战 285
+癸 285
+皂 285
+相异 285
+真假 285
+红卫兵 285
+缺失 285
+落得 285
+螾 285
+西北地区 285
+认输 285
+议政 285
+送走 285
+邪恶 285
+钟声 285
+鞯 285
+音响 285
+麦加 285
+东至 284
+使馆区 284
+光源 284
+出人意料 284
+发怒 284
+取暖 284
+古墓群 284
+吴应熊 284
+哨兵 284
+堢 284
+墩台 284
+大乘 284
+好坏 284
+实德 284
+张家湾 284
+强硬 284
+感知 284
+慕瑾 284
+扁圆形 284
+操场 284
+教养 284
+新陈代谢 284
+施洋 284
+旀 284
+板凳 284
+楚天 284
+模特 284
+欧亚 284
+水产业 284
+湘北 284
+火箭炮 284
+特技 284
+督查 284
+硝酸 284
+种植业 284
+继任 284
+胡人 284
+诞辰 284
+语言学 284
+霸主 284
+青葱 284
+顶级 284
+领导人员 284
+不自禁 283
+乔治 283
+交响曲 283
+京九铁路 283
+亿千瓦时 283
+兵火 283
+出山 283
+古诗 283
+各色 283
+吴淞口 283
+嘈杂 283
+嘱 283
+大内 283
+大叔 283
+央 283
+失手 283
+市县 283
+幽幽 283
+徐海东 283
+惊诧 283
+惰 283
+战略性 283
+斑斑 283
+旅途 283
+旧闻 283
+杬 283
+松滋 283
+沈国放 283
+测评 283
+石棉 283
+石武 283
+社交 283
+秦基伟 283
+第一线 283
+管教 283
+老兵 283
+肉鸡 283
+鄙薄 283
+长寿 283
+青海省 283
+鞈 283
+预见 283
+马蹄声 283
+高高的 283
+鸽子 283
+麓 283
+齿轮 283
+一尺 282
+一把手 282
+不可一世 282
+东安门 282
+为啥 282
+九尺 282
+二门 282
+亚科 282
+人类学 282
+价值量 282
+传染 282
+伴侣 282
+侯方域 282
+僧侣 282
+养鸡场 282
+分子式 282
+单薄 282
+发难 282
+可信 282
+吃亏 282
+周鸿 282
+堂上 282
+多出 282
+大杨树 282
+寒气 282
+封口 282
+小品 282
+少有 282
+思绪 282
+托福 282
+拉古 282
+掖 282
+敷衍 282
+来京 282
+椰子 282
+楠竹 282
+欠缺 282
+此项 282
+波及 282
+混蛋 282
+温热 282
+爱国人士 282
+电站 282
+相间 282
+碾子 282
+竖立 282
+筹措 282
+纪委 282
+苏北 282
+蒋氏 282
+血统 282
+规程 282
+触角 282
+试射 282
+该项 282
+载人 282
+退后 282
+邱 282
+鄂豫 282
+钱塘江 282
+鸟儿 282
+三声 281
+下巴 281
+东宫 281
+两广 281
+丰满 281
+主任委员 281
+人声 281
+休克 281
+充军 281
+几张 281
+北城 281
+回游 281
+基本工资 281
+外电 281
+孟浩然 281
+安稳 281
+宋玉 281
+峯峯 281
+峰峰 281
+布尔 281
+惨遭 281
+所部 281
+接入 281
+撤回 281
+文工团 281
+机智 281
+松花 281
+枣阳 281
+柯 281
+楚庄王 281
+欺凌 281
+求和 281
+湿热 281
+牧师 281
+王者 281
+白带 281
+破绽 281
+神经元 281
+竿 281
+纪年 281
+细作 281
+统制 281
+绵羊 281
+翂 281
+自由自在 281
+茶树 281
+蒽醌 281
+规章制度 281
+财团 281
+辽河 281
+远眺 281
+透出 281
+醛 281
+闭幕 281
+陆军大学 281
+陶醉 281
+青木 281
+领到 281
+马道 281
+麒 281
+一动 280
+上旬 280
+专程 280
+东西方 280
+中华书局 280
+举世闻名 280
+交警 280
+以备 280
+冰山 280
+分区 280
+印度人 280
+周伯 280
+外层 280
+导引 280
+尝尝 280
+局限性 280
+幼时 280
+收录 280
+放牧 280
+文学家 280
+朝鲜战争 280
+毁灭性 280
+滋补品 280
+漆器 280
+热泪盈眶 280
+猇 280
+石秀 280
+秒钟 280
+粡 280
+西门子 280
+西陵 280
+认错 280
+谷底 280
+豆类 280
+通城 280
+长满 280
+隆隆 280
+韦香主 280
+一章 279
+三民主义 279
+中医药大学 279
+付款 279
+侍从 279
+偏要 279
+储藏量 279
+先帝 279
+共用 279
+军力 279
+分枝 279
+前任 279
+北伐战争 279
+启程 279
+嘉庆 279
+大使馆 279
+家家 279
+小史 279
+峣 279
+延庆 279
+开战 279
+悲观 279
+成亲 279
+我市 279
+指指 279
+捣乱 279
+文教卫生 279
+新婚燕尔 279
+无影无踪 279
+旧城 279
+朱光亚 279
+果蔬 279
+根本性 279
+梁子湖 279
+民办教师 279
+王强 279
+王海 279
+盘中 279
+竹园 279
+竹山 279
+粪便 279
+编排 279
+耕作业 279
+致病 279
+药膳 279
+该科 279
+该院 279
+说不得 279
+豪强 279
+购置 279
+选料 279
+遵从 279
+闯入 279
+陈政委 279
+随行 279
+鼎盛 279
+主旋律 278
+体能 278
+何鲁丽 278
+光合作用 278
+全盘 278
+凄然 278
+凶恶 278
+利物浦 278
+卖掉 278
+参展 278
+多达 278
+失衡 278
+峁 278
+巧合 278
+抱负 278
+散开 278
+文汇 278
+施用量 278
+曲酒 278
+最重 278
+朋 278
+朝鲜人 278
+棺木 278
+橡树 278
+毒气 278
+永新 278
+永福 278
+汉英 278
+热呼呼 278
+牛头山 278
+玩意儿 278
+甲醚 278
+痊愈 278
+矿产地 278
+童装 278
+第四章 278
+粗细 278
+细微 278
+肾上腺 278
+芰 278
+荷兰人 278
+西口 278
+贞观 278
+递减 278
+配制 278
+配色 278
+铁证 278
+陈世美 278
+陡峭 278
+饮誉 278
+高一 278
+魔王 278
+鳜鱼 278
+麴酒 278
+鼓掌 278
+丁卯 277
+不均 277
+乱七八糟 277
+二里头 277
+余叔岩 277
+佚 277
+倏地 277
+农家乐 277
+创下 277
+加速器 277
+动词 277
+十五道 277
+华容 277
+名曰 277
+吴香香 277
+墓穴 277
+实德队 277
+客货轮 277
+小石桥 277
+得体 277
+微不足道 277
+悬念 277
+截住 277
+扇形 277
+投掷 277
+抗美援朝 277
+抽搐 277
+招式 277
+捕获量 277
+敞 277
+数百年 277
+斯洛伐克 277
+晨报 277
+朝鲜半岛 277
+朱厚 277
+李如松 277
+枝叶 277
+椹 277
+欧拉 277
+残废 277
+江底 277
+燕京大学 277
+特派员 277
+略微 277
+砂岩 277
+祝愿 277
+福星 277
+第一座 277
+篇幅 277
+绌 277
+胡杨林 277
+西非 277
+语种 277
+贱人 277
+轩冰 277
+送入 277
+遁 277
+道姑 277
+阿拉 277
+附和 277
+一月份 276
+中土 276
+书城 276
+二氯 276
+五县 276
+产业园 276
+仙女 276
+作对 276
+印记 276
+原产 276
+原来如此 276
+取笑 276
+叛变 276
+古镇 276
+否决 276
+呼应 276
+唆 276
+唐宋 276
+囚禁 276
+回廊 276
+围城 276
+土台子 276
+地球化学 276
+塑像 276
+大厨 276
+大腿 276
+太公 276
+太极 276
+必要条件 276
+恍惚 276
+恭候 276
+托人 276
+挂牌 276
+放屁 276
+文昌市 276
+无名 276
+星际 276
+晌 276
+期满 276
+木儿 276
+朱邦造 276
+杀出 276
+松花江 276
+柳大华 276
+样本 276
+梯度 276
+櫙 276
+次序 276
+比试 276
+浮现 276
+消亡 276
+涙 276
+温家宝 276
+父女 276
+牒 276
+界定 276
+皇太子 276
+盐酸 276
+硅灰石 276
+糖果 276
+紫鹃 276
+緢 276
+结尾 276
+育种 276
+语句 276
+逃离 276
+避寒 276
+铃声 276
+阿姨 276
+雕栏 276
+鬼神 276
+鲌 276
+鲍春来 276
+默契 276
+鼻涕 276
+齹 276
+上阵 275
+东莞 275
+中统 275
+举例 275
+倒闭 275
+即令 275
+吓唬 275
+吴楚 275
+啰 275
+外事委员会 275
+多功能 275
+多变 275
+多期 275
+天神 275
+太尉 275
+女友 275
+学分 275
+宏达 275
+尖端 275
+尹吉甫 275
+引诱 275
+必胜 275
+情意 275
+搬迁 275
+攸县 275
+教士 275
+教诲 275
+昏暗 275
+月均 275
+未了 275
+欧共体 275
+汪洋 275
+汶川 275
+泥灰岩 275
+温饱 275
+湘军 275
+照相机 275
+王树声 275
+生活资料 275
+疄 275
+皮日休 275
+省籍 275
+粳稻 275
+绱 275
+老二 275
+肥皂 275
+能耐 275
+质点 275
+超额 275
+酒席 275
+铁器 275
+闲着 275
+陆宗 275
+颜料 275
+马越 275
+两门 274
+严加 274
+丹麦队 274
+仲 274
+停产 274
+僵局 274
+冲天 274
+十二五 274
+厦 274
+名闻遐迩 274
+后湖 274
+圈套 274
+基本建设 274
+堵截 274
+壤 274
+大洪山 274
+安葬 274
+家丁 274
+对内 274
+岑参 274
+师伯 274
+庵堂 274
+式样 274
+引着 274
+德语 274
+怡悦 274
+惩治 274
+扬起 274
+抽调 274
+按捺不住 274
+放逐 274
+新字 274
+明器 274
+此行 274
+水草 274
+法律委员会 274
+洄 274
+洪凌波 274
+滤 274
+炬 274
+焰 274
+电饭煲 274
+百亿元 274
+第一面 274
+绯 274
+翔 274
+联苯 274
+节能 274
+要冲 274
+谢军 274
+递交 274
+针叶林 274
+黄骅 274
+一缕 273
+不无 273
+企盼 273
+何方 273
+先于 273
+全家福 273
+公人 273
+几百斤 273
+分属 273
+分担 273
+包金 273
+包银 273
+医保 273
+南港 273
+喙 273
+国宾 273
+垣 273
+多瑙河 273
+大兴安岭 273
+天安 273
+太行山 273
+夫人道 273
+奇观 273
+引出 273
+情怀 273
+折扣 273
+接上 273
+救护 273
+有幸 273
+有所作为 273
+李济 273
+杨大哥 273
+杨建华 273
+查理 273
+楼阁 273
+母体 273
+水肿 273
+烤鱼 273
+牙口 273
+王任重 273
+珈 273
+甜菜 273
+矫 273
+石龙 273
+稷 273
+第一节 273
+红安 273
+经济带 273
+艺术节 273
+董家 273
+规划师 273
+评级 273
+辍学率 273
+退兵 273
+防火 273
+颁行 273
+风沙 273
+齐达内 273
+三峡大坝 272
+上议院 272
+不失为 272
+丹江口市 272
+举出 272
+买买提 272
+人杰地灵 272
+伦比 272
+保荐 272
+俨然 272
+倒好 272
+冷落 272
+出世 272
+利刃 272
+加装 272
+卖国求荣 272
+印证 272
+厉 272
+受访者 272
+名士 272
+名望 272
+启德 272
+外婆 272
+尯 272
+山庄 272
+常遇春 272
+幽深 272
+彩印 272
+排练 272
+接轨 272
+敬轩 272
+晾 272
+杨威 272
+楃 272
+槐 272
+横沟 272
+武汉队 272
+水源地 272
+洞窟 272
+浃 272
+湖北省委 272
+烤鸡 272
+王庙 272
+申包胥 272
+畅达 272
+福气 272
+绝招 272
+维和 272
+群落 272
+聋 272
+致电 272
+航天飞机 272
+花木兰 272
+莼菜 272
+蓴菜 272
+薪资 272
+觅食 272
+认识论 272
+跨过 272
+邯郸 272
+金俊武 272
+陆冠英 272
+饴糖 272
+骨架 272
+魄 272
+傻蛋 271
+冒烟 271
+出家人 271
+前天 271
+区委 271
+十八日 271
+华中地区 271
+博得 271
+发票 271
+受害者 271
+嗽 271
+嘤 271
+大象 271
+妇科 271
+安顿 271
+宜黄 271
+富水 271
+工农兵 271
+师师 271
+席卷 271
+惦记 271
+抗辐射 271
+救亡运动 271
+晚餐 271
+曹营 271
+李乐 271
+标签 271
+树丛 271
+棠 271
+楠 271
+汉朝 271
+沙子 271
+猛禽 271
+留情 271
+直管 271
+简短 271
+糯米粉 271
+纯净 271
+自旋 271
+藻类 271
+誓言 271
+许嘉璐 271
+调蓄 271
+超音速 271
+转载 271
+辩解 271
+退让 271
+郢州 271
+采伐量 271
+饔 271
+驿站 271
+鲛 271
+一桌 270
+互为 270
+五中 270
+人种 270
+他杀 270
+任凭 270
+供应商 270
+公案 270
+军工 270
+冰期 270
+列位 270
+别扭 270
+割断 270
+北湖 270
+卢森堡 270
+双桥 270
+吴伟 270
+哇哇 270
+嗅觉 270
+声波 270
+复活 270
+外国语 270
+大红门 270
+头球 270
+安内 270
+张裕 270
+归州 270
+思量 270
+成文 270
+成方 270
+抱歉 270
+探望 270
+推选 270
+掰 270
+放流 270
+放进 270
+整日 270
+新洲 270
+有意识 270
+朝臣 270
+朴 270
+标的 270
+毕升 270
+江海 270
+沙特阿拉伯 270
+涞 270
+温室 270
+火箭弹 270
+甄志丙 270
+白玉兰 270
+皇岗 270
+眰 270
+石榴石 270
+研讨班 270
+租金 270
+纷争 270
+纸张 270
+老马 270
+耳机 270
+苛 270
+葬礼 270
+融洽 270
+衍 270
+西方人 270
+试用期 270
+轰然 270
+送回 270
+郧县 270
+铜镜 270
+长阳土家族自治县 270
+高新区 270
+鸱吻 270
+上身 269
+不懈 269
+主料 269
+丽江 269
+乏力 269
+五项 269
+京九线 269
+任免权 269
+伊利 269
+便秘 269
+信息产业部 269
+入伍 269
+共同体 269
+分封 269
+分部 269
+刘志军 269
+刘震 269
+华师 269
+南漳 269
+卫校 269
+古琴台 269
+史方 269
+吭 269
+周五 269
+喝采 269
+域名 269
+奥斯卡 269
+平方 269
+思科 269
+手指头 269
+打响 269
+拦河坝 269
+日本国会 269
+春风 269
+晦 269
+杨维 269
+殆尽 269
+汤化龙 269
+流动性 269
+涉嫌 269
+渐近 269
+游历 269
+滑雪 269
+炒锅 269
+生态学 269
+白洋 269
+盐度 269
+瞬 269
+秭归县 269
+空投 269
+立陶宛 269
+第一峯 269
+第一峰 269
+第一条 269
+精魂 269
+缺席 269
+聘用 269
+苏娅 269
+觑 269
+讼 269
+读物 269
+赋役 269
+还给 269
+阅卷 269
+阵前 269
+障 269
+难关 269
+集散 269
+雍 269
+音像 269
+马口 269
+高能 269
+龋 269
+一九六二年 268
+七日 268
+三等 268
+上品 268
+乾重 268
+人流 268
+冲积 268
+划一 268
+刺伤 268
+变废为宝 268
+可怖 268
+司马义 268
+唷 268
+因果 268
+大寨 268
+大湾 268
+太阳年 268
+奋发进取 268
+如愿 268
+嫳 268
+干重 268
+张扬 268
+彭真 268
+微软公司 268
+抗逆性 268
+报知 268
+掌上 268
+摇手 268
+教众 268
+新奇 268
+无尽 268
+无能为力 268
+日落 268
+明玉珍 268
+曲面 268
+毁坏 268
+泰州 268
+焊接 268
+玉女 268
+第三天 268
+简体字 268
+繘 268
+腹痛 268
+英山 268
+莫过于 268
+血型 268
+认购 268
+评议 268
+贪图 268
+路甬祥 268
+遥控 268
+铺筑 268
+错觉 268
+阁楼 268
+阳郡 268
+陈锡联 268
+雅虎 268
+霏 268
+霸王 268
+领头 268
+驱使 268
+高速公路网 268
+鲶鱼 268
+黄永胜 268
+三顾茅庐 267
+两个凡是 267
+主教 267
+京广 267
+伏明霞 267
+八字 267
+切块 267
+午餐 267
+半头 267
+半部 267
+南郊 267
+吠 267
+喜讯 267
+器材厂 267
+城东 267
+姜恩柱 267
+婄 267
+媚 267
+小林 267
+巴西队 267
+惩办 267
+散水 267
+新颜 267
+梯子 267
+欺诈 267
+浇注 267
+浦东 267
+涢 267
+渔船 267
+珩 267
+瘾 267
+石膏矿 267
+空洞 267
+篇章 267
+红莲 267
+级数 267
+织布 267
+经络 267
+罗家 267
+自给自足 267
+草泥马 267
+虾子 267
+谑 267
+谬误 267
+豫西南 267
+赐予 267
+连环 267
+闅 267
+鱼汛 267
+鲩 267
+龙乐豪 267
+下放 266
+乡政府 266
+乳头 266
+二郎庙 266
+俞正声 266
+修正主义 266
+候补 266
+储油构造 266
+公安部队 266
+凝聚力 266
+刘鹤 266
+别有风味 266
+动植物园 266
+匣子 266
+千吨级 266
+古山 266
+合兴 266
+商用 266
+固城 266
+嫡 266
+季风性 266
+学海 266
+守备 266
+宣誓 266
+宽松 266
+择优 266
+操持 266
+收下 266
+方面军 266
+星座 266
+枝江 266
+气球 266
+汤用彤 266
+河鱼 266
+淹 266
+玩耍 266
+田秉毅 266
+电网 266
+百花园 266
+知己 266
+穿行 266
+立案 266
+簯 266
+纪念日 266
+老河口市 266
+聂海胜 266
+舀 266
+荆沙市 266
+莱比锡 266
+虚伪 266
+见闻 266
+调皮 266
+资质 266
+轴瓦 266
+运往 266
+运载火箭 266
+近郊 266
+通山县 266
+邰丽华 266
+鄂皖 266
+雌性 266
+颍 266
+驾车 266
+鹤峰 266
+黄土高原 266
+三子 265
+三鲜 265
+下功夫 265
+中国证监会 265
+中止 265
+丹江口水库 265
+任新民 265
+余平方米 265
+兴山县 265
+准许 265
+判刑 265
+北斗 265
+原意 265
+同居 265
+回锅 265
+复查 265
+外甥 265
+多万平方米 265
+多胎 265
+大冶市 265
+奔赴 265
+媚外 265
+岔 265
+广度 265
+庤 265
+引见 265
+当官 265
+悉尼 265
+手下人 265
+拗 265
+挂念 265
+捉拿 265
+探听 265
+攻坚 265
+敬意 265
+无条件 265
+春游 265
+曾宪梓 265
+本色 265
+来势 265
+梅雨期 265
+氧吧 265
+江南西 265
+浚 265
+游击战 265
+炒制 265
+猜疑 265
+玩家 265
+百头 265
+盖茨 265
+盗贼 265
+科威特 265
+突破口 265
+红安县 265
+缠绕 265
+老同志 265
+胡戈 265
+英山县 265
+融为一体 265
+袁宏道 265
+触犯 265
+论语 265
+追兵 265
+里程碑 265
+预警机 265
+香辣 265
+高喊 265
+丘岗 264
+东盟 264
+两口子 264
+何椿霖 264
+佛山 264
+保康县 264
+僵化 264
+公办 264
+冲刺 264
+几根 264
+刀工 264
+北京市政协 264
+印度河 264
+厉无畏 264
+反面 264
+叛军 264
+周逸群 264
+商贾 264
+回老家 264
+堰口 264
+多吉才让 264
+嫘祖 264
+宗朱厚 264
+官位 264
+小白菜 264
+崎岖 264
+巴东县 264
+布鲁塞尔 264
+念道 264
+戯 264
+撬棍 264
+整洁 264
+日语 264
+曲艺 264
+武穴市 264
+气节 264
+水利工程 264
+江道 264
+河势 264
+清白 264
+煤田 264
+照亮 264
+燃油 264
+王亚南 264
+瓦工 264
+盖子 264
+端坐 264
+第三届 264
+羽毛扇 264
+耽 264
+联邦院 264
+胡春华 264
+脑筋 264
+自律 264
+襄渝铁路 264
+谭嗣同 264
+谷城县 264
+车票 264
+辣椒酱 264
+金锣 264
+鉴定会 264
+集市 264
+青色 264
+麻醉 264
+万民 263
+万镑 263
+下颌 263
+东风汽车公司 263
+二十五 263
+亲口 263
+余亩 263
+俑 263
+六期 263
+共同纲领 263
+分外 263
+副教授 263
+加筑 263
+化身 263
+啼 263
+四十五里 263
+基准 263
+如其 263
+委婉 263
+密密麻麻 263
+寻访 263
+小女孩 263
+峞 263
+干贝 263
+幼儿 263
+广布政 263
+庙街 263
+强壮 263
+当阳市 263
+招来 263
+捣毁 263
+提包 263
+插口 263
+改动 263
+无暇 263
+星罗棋布 263
+月刊 263
+月湖 263
+李焕 263
+李青 263
+杜审言 263
+杨雪 263
+杨青 263
+枣阳市 263
+检察员 263
+武康 263
+永久性 263
+汉军 263
+油砂 263
+洋溢 263
+澳洲 263
+爵士 263
+王承恩 263
+王李 263
+王英凡 263
+玛瑙 263
+生长量 263
+直指 263
+真味 263
+硼 263
+突如其来 263
+竹山县 263
+纺织厂 263
+结核 263
+耸立 263
+自已 263
+董允 263
+蒸馏 263
+被评 263
+诅咒 263
+越王勾 263
+这就是说 263
+透明度 263
+邻邦 263
+鄂豫陕 263
+钟子期 263
+闭目 263
+飞去 263
+骨肉 263
+高法 263
+鲜品 263
+麻糖 263
+黄明生 263
+一扇 262
+丁石孙 262
+上校 262
+下棋 262
+乌鱼 262
+九线 262
+乾麪 262
+云梦县 262
+五峰土家族自治县 262
+任性 262
+保康 262
+傅志寰 262
+初衷 262
+利亚 262
+利器 262
+刮水器 262
+动画 262
+募款 262
+单兵 262
+发疯 262
+古隆中 262
+只此一家 262
+吉佩定 262
+周继红 262
+和丰 262
+和平共处 262
+嘶哑 262
+园林式 262
+土耳其人 262
+垦 262
+备考 262
+奠基人 262
+奥秘 262
+她家 262
+好莱坞 262
+宗庙 262
+实效 262
+巨柱 262
+干面 262
+开垦 262
+弄堂 262
+户外 262
+执法权 262
+接待日 262
+文峯 262
+文峰 262
+斥责 262
+新沙 262
+晁 262
+晏明 262
+曹化淳 262
+杨守敬 262
+氨化 262
+氽 262
+沙口 262
+沙鱼 262
+河头 262
+洋务 262
+洗脸 262
+深造 262
+炖 262
+牢记 262
+猎头 262
+王宏 262
+王群 262
+现身 262
+用具 262
+砖瓦 262
+社会保障 262
+箐 262
+罪人 262
+翠峰 262
+胭脂 262
+自动步枪 262
+自尊 262
+芜湖 262
+花灯 262
+花粉 262
+花菇 262
+莲肉 262
+蕲春县 262
+襄渝线 262
+西天 262
+贡茶 262
+赖以 262
+远安县 262
+迟缓 262
+造像 262
+陈静 262
+顶多 262
+黄焖 262
+不怎么 261
+乔红 261
+交货值 261
+俘获 261
+六中 261
+几杯 261
+刘和珍 261
+刘大响 261
+刘醒龙 261
+创业园 261
+南襄 261
+双湖 261
+吉新鹏 261
+名特优 261
+吴国伦 261
+吴定富 261
+周全 261
+国务院令 261
+型谱 261
+塞尔维亚 261
+夏斗寅 261
+大本营 261
+夸奖 261
+妇孺 261
+市郊 261
+幽州 261
+应城市 261
+开发型 261
+拓 261
+摆放 261
+晚间 261
+朱丽兰 261
+李建明 261
+杨幺 261
+杭嘉 261
+榭 261
+步子 261
+残存 261
+氱 261
+水产局 261
+汉口站 261
+江汉区 261
+海军工程大学 261
+熊召政 261
+程志 261
+竭 261
+素材 261
+网具 261
+聚合物 261
+舟曲 261
+药库 261
+葛洲坝水电站 261
+蝶 261
+车城 261
+过招 261
+送行 261
+郃 261
+郑功成 261
+长衫 261
+阳新县 261
+陈再道 261
+靡 261
+魁梧 261
+黄土岗 261
+黄陂区 261
+东坡肉 260
+中国政协 260
+乐舞 260
+二十万 260
+任兵 260
+传人 260
+侵权 260
+信春鹰 260
+停放 260
+光子 260
+全村 260
+八成 260
+农林牧渔业 260
+刀美兰 260
+北河口 260
+叫嚷 260
+后河 260
+周代 260
+周正庆 260
+周礼 260
+培 260
+大悟县 260
+夫人城 260
+头等 260
+妖魔 260
+小女 260
+建始县 260
+归附 260
+徐天川 260
+徐帆 260
+成思危 260
+改装车 260
+放眼 260
+文东武 260
+时不时 260
+曼联 260
+木业 260
+权威性 260
+松滋市 260
+桌椅 260
+楚卿 260
+正色 260
+此话 260
+死守 260
+比达 260
+油饰 260
+洁净 260
+流淌 260
+涌入 260
+满口 260
+点火器 260
+焉耆 260
+熊十力 260
+牧刘表 260
+猴头菇 260
+王聪儿 260
+白夜 260
+科技园区 260
+竹乡 260
+竹溪县 260
+筋骨 260
+肺癌 260
+脂肪酸 260
+脉搏 260
+脚跟 260
+苦练 260
+药房 260
+袁行霈 260
+谭家 260
+软件园 260
+道教协会 260
+金瑞 260
+针刺 260
+阙门 260
+陈章良 260
+陶驷驹 260
+雨量 260
+雷军 260
+顾秀莲 260
+高检 260
+鳊鱼 260
+黛 260
+一步步 259
+一盆 259
+万线 259
+三自爱国 259
+东西湖区 259
+中程 259
+买房 259
+令狐大哥 259
+任贤齐 259
+体虚 259
+余米 259
+佛教协会 259
+侠义 259
+倡 259
+兑现 259
+全神贯注 259
+冯之浚 259
+冲撞 259
+凸出 259
+刘积斌 259
+利川市 259
+北京市劳动人民文化宫 259
+升迁 259
+卢瑞华 259
+发布权 259
+史籍 259
+叶君健 259
+地毯厂 259
+堤垸 259
+奥林匹克 259
+子文 259
+孙金龙 259
+安息 259
+宰牲亭 259
+宽带 259
+导出 259
+山羊肉 259
+嶯 259
+店主 259
+庞安 259
+庞德公 259
+弘治 259
+恶鬼 259
+成交量 259
+扎伊尔 259
+扫除 259
+把持 259
+抗日救亡运动 259
+振动器 259
+措手不及 259
+搬出 259
+救国团 259
+文选 259
+春义 259
+权贵 259
+李小双 259
+李志高 259
+李树文 259
+杜铁环 259
+杨国庆 259
+杨景宇 259
+杨献珍 259
+林文漪 259
+梓树 259
+气旋 259
+江猪 259
+池莉 259
+沉船 259
+洪湖市 259
+浓重 259
+消防 259
+深重 259
+清早 259
+湖北省物价局 259
+湖北美术学院 259
+灰陶 259
+热能 259
+焦枝线 259
+玉米棒 259
+王必成 259
+王立平 259
+王茂林 259
+王鑫 259
+瘫 259
+盗窃 259
+石象生 259
+磷肥厂 259
+神农溪 259
+程贻举 259
+糠 259
+终日 259
+美术字 259
+考究 259
+腾龙洞 259
+荆沙 259
+荔枝 259
+菱湖 259
+蒋正华 259
+许智宏 259
+贴现 259
+贾亦斌 259
+金利 259
+钟子 259
+陇 259
+陈宜瑜 259
+陈超 259
+隔河岩 259
+韩先楚 259
+香炉 259
+马池 259
+鱼松 259
+鱼花 259
+黄埔军校武汉分校 259
+七八个 258
+上篮 258
+不列颠 258
+不计 258
+东宝区 258
+东湖开发区 258
+东连吴 258
+两万 258
+中国人民解放军军事科学院 258
+停车 258
+入党 258
+内务司法委员会 258
+军需 258
+农场主 258
+出事 258
+别国 258
+办好 258
+十四所 258
+十字军 258
+千秋 258
+升腾 258
+南口镇 258
+南振中 258
+南漳县 258
+南邻 258
+古月 258
+叶盛 258
+合唱团 258
+吨粮田 258
+周强 258
+咸甜 258
+哲理 258
+嘉木样 258
+埋头 258
+城郊型 258
+基督教协会 258
+堰桥 258
+备降 258
+太晖观 258
+好日子 258
+孝感人 258
+孝昌县 258
+季梁 258
+完成率 258
+宗敏 258
+宣恩县 258
+家门口 258
+崇敬 258
+嶉 258
+广水市 258
+廖辉 258
+引水 258
+张国光 258
+彩虹 258
+彭克玉 258
+彭桓武 258
+志军 258
+惋惜 258
+慌张 258
+戎昱 258
+抽签 258
+斜眼 258
+易贤忠 258
+显陵 258
+本体 258
+李佳 258
+李珊 258
+李琼 258
+杜宜瑾 258
+桂鱼 258
+植物体 258
+歌手 258
+武广 258
+殷海光 258
+毛发 258
+汉阳军 258
+沙咀 258
+沙市市 258
+活性染料 258
+流产 258
+涂长望 258
+湖北省财政厅 258
+激光器 258
+玉泉路 258
+王佐书 258
+王怀远 258
+王梦奎 258
+田原 258
+石广生 258
+科林斯 258
+算作 258
+红光 258
+统治权 258
+联网 258
+胡光宝 258
+胡德平 258
+自力更生 258
+舒惠国 258
+良知 258
+苏轼 258
+若山 258
+蔡琴 258
+袁宗道 258
+袁泉 258
+西塞山 258
+西德 258
+让给 258
+谢皇恩 258
+贡士 258
+迁入 258
+过错 258
+速生丰产林 258
+道家 258
+邢世忠 258
+郧西县 258
+郭建 258
+钱运录 258
+银海 258
+陈圆圆 258
+陈昌智 258
+陶伯钧 258
+高台 258
+麻城市 258
+黄侃 258
+黄梅县 258
+黄进 258
+上爬 257
+下陆区 257
+严义埙 257
+主治 257
+久远 257
+九州 257
+二七路 257
+二等奖 257
+于汉初 257
+人形 257
+亿株 257
+任克礼 257
+优孟 257
+何铁 257
+侯义斌 257
+傅振邦 257
+关广富 257
+划出 257
+刘宝 257
+刘明祖 257
+刘精松 257
+化学元素 257
+十多起 257
+单果 257
+南岳山 257
+南嶽山 257
+卢邦正 257
+原籍 257
+可比价 257
+吉尼玛 257
+吴基传 257
+周坤仁 257
+周纯全 257
+咸丰县 257
+嘉鱼县 257
+壬子年 257
+夏勇 257
+夏赞忠 257
+大寿 257
+天主教爱国会 257
+天心洲 257
+夷陵区 257
+如来 257
+姚期智 257
+安邦治国 257
+宋教仁 257
+官绅 257
+定点 257
+定论 257
+宜城市 257
+小鹏 257
+山自西 257
+差遣 257
+干椒含 257
+干粮 257
+庄公惠 257
+庞丽 257
+张志坚 257
+张新宝 257
+张明楷 257
+张毓茂 257
+张继禹 257
+张鹤 257
+徐复观 257
+徐志纯 257
+悬挑 257
+愉 257
+感性 257
+承接 257
+振荡 257
+捐献 257
+敬佩 257
+新洲区 257
+时会 257
+时装 257
+曲目 257
+曹燕珍 257
+望君 257
+朱相远 257
+机主 257
+李元正 257
+李新良 257
+李重庵 257
+来凤县 257
+杨业功 257
+杨可世 257
+杨国屏 257
+杨摩西 257
+杨晓波 257
+枝江市 257
+桑国卫 257
+梅新育 257
+欢快 257
+欧阳 257
+武汉职业技术学院 257
+武连元 257
+汉川市 257
+汉江全 257
+沈思 257
+河子 257
+济南市 257
+熊有伦 257
+燕耳 257
+王利芬 257
+王学萍 257
+王宁生 257
+王家耀 257
+王尚荣 257
+王建安 257
+王新亭 257
+王永炎 257
+王玉珍 257
+王维城 257
+环境保护部 257
+白昼 257
+皈依 257
+矩阵 257
+石戈 257
+石牌岭 257
+礼节 257
+祝铭山 257
+禁捕 257
+科教兴市 257
+秦国 257
+立于 257
+立法者 257
+第一罐 257
+第十七期 257
+等额选举 257
+米公祠 257
+粉蒸 257
+糖色 257
+维系 257
+羞愧 257
+老母 257
+老莱子 257
+聂凤智 257
+肄业生 257
+背负 257
+胡贤生 257
+范晓 257
+茄汁 257
+装卸量 257
+西朝东 257
+解放公园 257
+谌家矶 257
+谢伏瞻 257
+谢佑卿 257
+谭元春 257
+谭家渊 257
+谷建芬 257
+质朴 257
+赤壁市 257
+赵可铭 257
+赵斌 257
+赵谦 257
+起始 257
+超声 257
+转速 257
+辜胜阻 257
+迂回 257
+连续性 257
+通城县 257
+邓城 257
+郭凤莲 257
+郭振乾 257
+郭超人 257
+郭锡章 257
+酒保 257
+金炳华 257
+钱瑛 257
+铁山区 257
+铁戟 257
+长江三峡水利枢纽工程 257
+阉党 257
+阿雅 257
+陈迪 257
+陶飞 257
+隗福临 257
+韦家能 257
+马春花 257
+高家岭 257
+魏斌 257
+鹤峰县 257
+黄瓦朱 257
+黄石港区 257
+丁大旺 256
+七星鱼 256
+万学文 256
+万学远 256
+万寿羹 256
+三千粒 256
+上官洲 256
+东升镇 256
+东湖新技术开发区 256
+东湖高新技术开发区 256
+严晓频 256
+严智泽 256
+久美 256
+乌云其木格 256
+乌拉圭 256
+乌日图 256
+九阳 256
+九龙 256
+习家池 256
+二柱 256
+二楼 256
+五横 256
+产业链 256
+任希 256
+任晗 256
+任茂东 256
+伊斯兰教协会 256
+伍修 256
+伍增荣 256
+伍家岗区 256
+何晔晖 256
+何晟铭 256
+余少群 256
+侯张信 256
+侯长安 256
+保贞堂 256
+俞小凡 256
+修承天 256
+倪家塔 256
+倪岳峰 256
+倪嶽峯 256
+傅传 256
+傅晶 256
+傅自应 256
+充斥 256
+八万斤 256
+公众网 256
+公凸 256
+兰世立 256
+兰湖 256
+关虹 256
+关路圻 256
+军令 256
+农业与农村委员会 256
+冯云乔 256
+凉爽 256
+分团 256
+刘亦菲 256
+刘冬冬 256
+刘卫东 256
+刘合炳 256
+刘国正 256
+刘子厚 256
+刘应明 256
+刘振伟 256
+刘湛恩 256
+刘石泉 256
+刘道玉 256
+刘雁飞 256
+刘雪荣 256
+刘黎敏 256
+别必雄 256
+制动阀 256
+剖 256
+化军 256
+区四园 256
+区政 256
+十一五首年 256
+十八岁 256
+华容区 256
+华辰春 256
+单果鲜 256
+南岳尖 256
+南嶽尖 256
+南河头 256
+南河洲 256
+南洲子 256
+卜学亮 256
+卫铁 256
+危品 256
+厉山 256
+古三国 256
+古华容 256
+古长堤 256
+叫唤 256
+叶如棠 256
+叶海峰 256
+吉象 256
+吉象牌 256
+吕忠梅 256
+吴婷 256
+吴少勋 256
+吴德馨 256
+吴燕生 256
+周传雄 256
+周培公 256
+周家湖 256
+周少波 256
+周森锋 256
+周玉清 256
+周莉 256
+命中率 256
+咸安区 256
+唐一菲 256
+唐良智 256
+喻家碑 256
+喻林祥 256
+团风县 256
+图丹 256
+图道多吉 256
+地板厂 256
+垛 256
+声乐 256
+夏佐全 256
+夜蝉 256
+夹攻 256
+奉恒高 256
+姚来泉 256
+姚湘成 256
+姜声扬 256
+子庵 256
+子童阁 256
+孔祥复 256
+孙家拐 256
+孙必干 256
+孙晓群 256
+孝南区 256
+孟凯 256
+孟尝湖 256
+孟庆南 256
+季家嘴 256
+学苑 256
+孽 256
+安七州 256
+安陆市 256
+宋照肃 256
+官田湖 256
+宜都市 256
+宝业 256
+宝海 256
+审问 256
+容水量 256
+尉沐昕 256
+小河口镇 256
+尹汉宁 256
+尹集镇 256
+居文沛 256
+山寺镇 256
+山粟林 256
+岑拯 256
+岳佳 256
+左传 256
+左宏 256
+广东核电集团 256
+庶民 256
+庹宗华 256
+庹宗康 256
+张业遂 256
+张佑才 256
+张培刚 256
+张学东 256
+张振仕 256
+张昌尔 256
+张昌平 256
+张智华 256
+张桂华 256
+张润斌 256
+张维国 256
+张美兰 256
+张舒媛 256
+张蓉蓉 256
+张通 256
+张麟 256
+张龙俊 256
+彭坦 256
+彭清华 256
+彭红艳 256
+徐克俊 256
+徐兴 256
+徐家岗 256
+徐小凤 256
+徐永清 256
+徐立华 256
+徐高栋 256
+恶狠狠 256
+成清波 256
+成钧 256
+戴证良 256
+掇刀区 256
+援引 256
+改由 256
+改观 256
+政协常委会 256
+新开铺 256
+新江 256
+方小顿 256
+方李邦 256
+施南 256
+明太子太 256
+明文禁 256
+明武英 256
+明礼部 256
+普圣寺 256
+曾为楚 256
+曾令良 256
+曾子墨 256
+曾都区 256
+朱家渡 256
+朱桦 256
+朱涤 256
+朱邦复 256
+朱阳湖 256
+朱雅琼 256
+李三春 256
+李从军 256
+李作荣 256
+李儒雄 256
+李大双 256
+李宗翰 256
+李家墩 256
+李建群 256
+李念 256
+李慎明 256
+李新觉 256
+李明豫 256
+李春亭 256
+李桓 256
+李烈 256
+李绪鄂 256
+李翊君 256
+李行亮 256
+李连宁 256
+李金早 256
+杨兴 256
+杨兴富 256
+杨叶湖 256
+杨同嘴 256
+杨国梁 256
+杨家台 256
+杨家潭 256
+杨德清 256
+杨晨晖 256
+杨柏龄 256
+杨波坦 256
+杨臣刚 256
+杨苗洲 256
+杨铁心 256
+杨长槐 256
+杨闻孙 256
+松林山 256
+松水桥 256
+松江 256
+松祚 256
+林亭 256
+林兆枢 256
+林江 256
+柯俊 256
+柯约瑟 256
+柱洞 256
+柳湖坝 256
+标准计 256
+栗林嘴 256
+桂质廷 256
+梁子湖区 256
+梁孝宣 256
+梦鸽 256
+楚源牌 256
+楚纪南 256
+楼式木 256
+楼阳生 256
+樊启鹏 256
+樊城区 256
+樊魏 256
+橹 256
+欧阳祥 256
+武敦儒 256
+武昌站 256
+武汉东湖新技术开发区 256
+武汉东湖高新技术开发区 256
+武汉站 256
+武汉经济技术开发区 256
+武麻 256
+段思思 256
+比划 256
+毕家塘 256
+毕家荡 256
+毛如柏 256
+民院路 256
+水果湖 256
+汉丹线 256
+汉施路 256
+汉蓉 256
+江夏治 256
+江家岔 256
+江波渡 256
+池宇峯 256
+池宇峰 256
+池月堤 256
+池际尚 256
+汤洪高 256
+汪习根 256
+汪潮涌 256
+汪爱群 256
+沈春耀 256
+沈辛荪 256
+沙洋县 256
+油榨 256
+津南 256
+浠水县 256
+涂黎曼 256
+滕刚 256
+潘粤 256
+潘粤明 256
+潹 256
+点军区 256
+点穴 256
+焦柳线 256
+照应 256
+熊秉 256
+牛掌 256
+牛皮 256
+玉田寺 256
+玉皇剑 256
+王云龙 256
+王以铭 256
+王伊锋 256
+王作荣 256
+王冬生 256
+王利明 256
+王太岚 256
+王宋大 256
+王家墩 256
+王家岗 256
+王梅祥 256
+王祖训 256
+王祥喜 256
+王若涵 256
+王茂润 256
+王诚汉 256
+环境与资源保护委员会 256
+珞珈 256
+生物圈 256
+田承忠 256
+田玉科 256
+田镇 256
+田长焯 256
+田长霖 256
+由藕池 256
+电离 256
+白云村 256
+相逢 256
+瞿家湖 256
+石华堰 256
+石安 256
+石寿永 256
+石瑛 256
+石静宜 256
+硚口区 256
+磐 256
+神皇洲 256
+福银 256
+秋审 256
+科隆 256
+秦克湖 256
+秦家洲 256
+秦属南 256
+秦祥 256
+秦置南 256
+秦钟英 256
+程丽 256
+程菲 256
+童庆禧 256
+第三十一次 256
+米业 256
+粟田湖 256
+纳孙夫人 256
+经江波 256
+综 256
+罗亚蒙 256
+罗家墩 256
+罗汉 256
+罢官 256
+羊子庙 256
+老张 256
+老洲岭 256
+聘为 256
+肖家岭 256
+肖海亮 256
+胡佳 256
+胡厚昆 256
+胡康生 256
+胡雅歆 256
+胡黎明 256
+自营权 256
+艾春荣 256
+芦菲 256
+花坛 256
+苏慧伦 256
+范恒山 256
+范蠡庙 256
+范锐 256
+茅林口 256
+茅箭区 256
+茅草街 256
+荆南府 256
+荆宜 256
+荆宜菜 256
+荆府 256
+莫艳琳 256
+董青 256
+蒋方舟 256
+蒋树声 256
+蒋硕杰 256
+蒋红星 256
+蒋远华 256
+蒯祥同 256
+蒯鲁班 256
+蔡宏柱 256
+蔡家潭 256
+蔡文静 256
+蔡甸区 256
+袁姗姗 256
+袁弘 256
+袁思怡 256
+袁汉民 256
+裁军 256
+裴高才 256
+西塞山区 256
+西陵区 256
+计划生育率 256
+许志琴 256
+调味品厂 256
+谢丹阳 256
+谭子拐 256
+谭家洲 256
+费胜潮 256
+贺一诚 256
+贺炳炎 256
+贺龙来 256
+贾玲 256
+赵家条 256
+赵家湾 256
+赵钟泉 256
+蹊跷 256
+边孤石 256
+连心桥 256
+道特 256
+邓子 256
+邓家岭 256
+邢慕 256
+邮科院 256
+邱四豪 256
+邹远东 256
+邹高阳 256
+郑李辉 256
+郑肖庄 256
+郭天民 256
+郭有明 256
+郭柯宇 256
+郭永宏 256
+郭燕 256
+郭生练 256
+郭进 256
+鄂三州 256
+鄂城区 256
+鄂菜鱼 256
+鄂钢 256
+里海 256
+钟朋荣 256
+钟阳阳 256
+长岭岗 256
+闟 256
+闻世震 256
+阮成发 256
+防洪 256
+阳三蒸 256
+阳府 256
+陈东升 256
+陈佳贵 256
+陈兴瑜 256
+陈大民 256
+陈家湖 256
+陈家铺 256
+陈币桥 256
+陈建生 256
+陈木森 256
+陈潮 256
+陈秀榕 256
+陈艺戈 256
+陈难先 256
+隆平 256
+雁湖 256
+雷家沟 256
+雷虹 256
+青木河 256
+韩宁夫 256
+韩超 256
+韩高湖 256
+颜邦屏 256
+飞往 256
+马家棚 256
+马庙 256
+马林工 256
+马湖村 256
+骆 256
+高凌风 256
+高枫 256
+高陵镇 256
+魏兴 256
+魏兴郡 256
+魏复盛 256
+魏敏 256
+鱼原 256
+鱼氽元 256
+鲁桂成 256
+鲁磨路 256
+鸽子花 256
+麻辣酱 256
+黄二州 256
+黄古蒿 256
+黄嘉 256
+黄家湖 256
+黄家潭 256
+黄州区 256
+黄州菜 256
+黄康生 256
+黄晖 256
+黄月英 256
+黄格选 256
+黄楚 256
+黄橙子 256
+黄知真 256
+黄莲湖 256
+黄金湾 256
+黄锷 256
+龙合玺 256
+龚泽艺 256
+专员 255
+东华门 255
+两倍 255
+任弼时 255
+伦理学 255
+供水 255
+全岛 255
+加纳 255
+司马懿 255
+吞噬 255
+呗 255
+喽罗 255
+嘲讽 255
+器皿 255
+坑洞 255
+坚定不移 255
+夐 255
+大德 255
+大步 255
+太医 255
+婊 255
+学风 255
+定于 255
+封建主 255
+小河 255
+帝位 255
+心动 255
+扔下 255
+抑或 255
+拜谢 255
+收买 255
+村里人 255
+梳理 255
+樿 255
+淋巴 255
+港元 255
+突击队 255
+署名 255
+胡青牛 255
+艳丽 255
+茶园 255
+衍生物 255
+识字 255
+迄 255
+透镜 255
+颇具 255
+万千米 254
+为所欲为 254
+主干 254
+作好 254
+俄国人 254
+信誉 254
+全过程 254
+公立 254
+关爱 254
+兵丁 254
+十五日 254
+各具特色 254
+呬 254
+咚咚 254
+堣 254
+夯 254
+巧玲 254
+快点 254
+恶斗 254
+戎装 254
+抢占 254
+政治经济 254
+敦 254
+期盼 254
+滈 254
+狂妄 254
+王侯 254
+王重阳 254
+电解质 254
+砖石 254
+种属 254
+经略 254
+船体 254
+苛刻 254
+蝗 254
+装机容量 254
+负伤 254
+逃难 254
+陈新甲 254
+顽皮 254
+不可不 253
+乡里 253
+公开赛 253
+冷禅 253
+出外 253
+出示 253
+加固 253
+各县 253
+各式各样 253
+嘀咕 253
+国势 253
+国民收入 253
+国际性 253
+大病 253
+宽恕 253
+富农 253
+小手 253
+就职 253
+巫 253
+开平 253
+弽 253
+惩处 253
+拖欠 253
+撞见 253
+改写 253
+施礼 253
+楼顶 253
+正版 253
+沫 253
+海盆 253
+混沌 253
+満 253
+滞后 253
+照旧 253
+爱惜 253
+玉带 253
+盟友 253
+看样子 253
+短命 253
+称道 253
+第一道 253
+羞辱 253
+老老实实 253
+衷心 253
+裁减 253
+麻将 253
+不力 252
+书上 252
+书法家 252
+亲笔 252
+优越性 252
+信道 252
+北半球 252
+哈佛大学 252
+嗓门 252
+囚犯 252
+国政 252
+多日 252
+奉旨 252
+孛 252
+居中 252
+恕罪 252
+愣住 252
+投票权 252
+拍马屁 252
+接班人 252
+援军 252
+正向 252
+洿 252
+炙 252
+祖母 252
+第七章 252
+第二名 252
+约翰逊 252
+缮 252
+肋骨 252
+至正 252
+苷 252
+请客 252
+谢杏芳 252
+足见 252
+踊跃 252
+过节 252
+退路 252
+酣 252
+钢刀 252
+阴茎 252
+飞过 252
+高价 252
+高官 252
+鸭绿江 252
+下意识 251
+买办 251
+全景 251
+决无 251
+出租 251
+千金 251
+可否 251
+呆呆地 251
+商丘 251
+城市化 251
+好消息 251
+层出不穷 251
+平庸 251
+庄稼人 251
+拍打 251
+时隔 251
+显见 251
+楷书 251
+次子 251
+欧几里得 251
+沉沉 251
+流浪 251
+涨价 251
+漠北 251
+猛虎 251
+王小兰 251
+王道 251
+琼斯 251
+睬 251
+社员 251
+秽 251
+空旷 251
+第二条 251
+胃肠 251
+荒原 251
+药方 251
+藏身 251
+订货 251
+认清 251
+达标 251
+追寻 251
+退去 251
+鐏 251
+长相 251
+门边 251
+高昌 251
+九大 250
+五尺 250
+体育馆 250
+公差 250
+创造力 250
+剥落 250
+劳动资料 250
+原始社会 250
+发亮 250
+合伙 250
+合夥 250
+同日 250
+吐鲁番 250
+咕 250
+响动 250
+唆使 250
+喷气 250
+奥巴马 250
+客流 250
+庞丽娜 250
+忽悠 250
+惊骇 250
+所得税 250
+政客 250
+无权 250
+昨儿 250
+画派 250
+禅杖 250
+美德 250
+肾功能 250
+胡适 250
+评论家 250
+诚然 250
+越冬 250
+边远 250
+迟钝 250
+选购 250
+鸾 250
+黄纸 250
+万方 249
+下官 249
+临头 249
+二十五年 249
+从政 249
+便士 249
+公众股 249
+原野 249
+发烧 249
+地主阶级 249
+坚韧 249
+夜幕 249
+失业率 249
+巴不得 249
+带宽 249
+张望 249
+彩票 249
+彭其 249
+微粒 249
+恩人 249
+恰恰相反 249
+想见 249
+愉悦 249
+愶 249
+成行 249
+扼 249
+担架 249
+摄像机 249
+栅 249
+核能 249
+欢庆 249
+武则天 249
+流落 249
+混入 249
+激进 249
+烧香 249
+玩意 249
+瘀 249
+省里 249
+称得上 249
+端详 249
+胡思乱想 249
+致死 249
+藏有 249
+行当 249
+误导性 249
+谦逊 249
+贝海石 249
+过河 249
+道谢 249
+门派 249
+雷霆 249
+马钰 249
+鳎 249
+二十一年 248
+仰慕 248
+伶 248
+侄女 248
+光速 248
+六位 248
+击毙 248
+刘正风 248
+功名 248
+十二个 248
+十余丈 248
+向量 248
+四五 248
+大气层 248
+尼日尔 248
+开进 248
+归入 248
+征兆 248
+徵兆 248
+总而言之 248
+惊吓 248
+懊悔 248
+拘留 248
+掉头 248
+推移 248
+播出 248
+攻略 248
+文臣 248
+新生儿 248
+易经 248
+朱仝 248
+朱漆 248
+杀入 248
+李中堂 248
+柳树 248
+残破 248
+比方 248
+求学 248
+河内 248
+清查 248
+牛市 248
+牵动 248
+王庆 248
+甩开 248
+白细胞 248
+白血病 248
+白酒 248
+肺炎 248
+萝 248
+西双版纳 248
+谅解 248
+资历 248
+鄱阳湖 248
+酸麻 248
+马林 248
+驾驭 248
+黄河流域 248
+下丘脑 247
+丹青 247
+全天候 247
+农民工 247
+剧照 247
+北郊 247
+吴佩孚 247
+孙悟空 247
+官制 247
+小车 247
+心满意足 247
+总工会 247
+扎营 247
+拜仁 247
+指针 247
+旗袍 247
+旧石器时代 247
+检索 247
+榴弹 247
+汤姆斯杯 247
+油灯 247
+浓烈 247
+炴 247
+琐 247
+瓒 247
+甘愿 247
+瘟疫 247
+破口大骂 247
+破门 247
+社会学家 247
+绩效 247
+自私 247
+苦于 247
+该党 247
+追杀 247
+邢夫人 247
+里昂 247
+闪光 247
+音译 247
+顶峰 247
+顺应 247
+风速 247
+上皮 246
+中科院 246
+位移 246
+入狱 246
+参政 246
+受刑 246
+吃苦 246
+合唱 246
+吓人 246
+商会 246
+善恶 246
+国际队 246
+基督 246
+境况 246
+处以 246
+复试 246
+娓 246
+小妹 246
+底座 246
+彗星 246
+我辈 246
+把守 246
+拍照 246
+旧社会 246
+构图 246
+柿 246
+激活 246
+火龙 246
+照看 246
+特此 246
+狠毒 246
+省得 246
+稳妥 246
+突袭 246
+絮 246
+组装 246
+老爸 246
+考古学家 246
+联邦德国 246
+西柏坡 246
+许诺 246
+谩 246
+赤手空拳 246
+钳 246
+陕甘宁边区 246
+非正式 246
+鸦 246
+三甲 245
+中国画 245
+人质 245
+体液 245
+免去 245
+公司法 245
+准噶尔 245
+几项 245
+刀枪 245
+前列腺 245
+剖析 245
+十岁 245
+变幻 245
+古尸 245
+启奏 245
+嚫 245
+地底 245
+大便 245
+失效 245
+字号 245
+存储器 245
+小队 245
+常州 245
+庯 245
+得逞 245
+念书 245
+怒不可遏 245
+想方设法 245
+手头 245
+敓 245
+旋涡 245
+晕倒 245
+梵 245
+棉袄 245
+榛 245
+法宝 245
+清人 245
+渎 245
+游坦 245
+眷属 245
+稠 245
+绝境 245
+统考 245
+缓存 245
+缺氧 245
+胡耀邦 245
+脚踏 245
+脸庞 245
+议事会 245
+走动 245
+郡县 245
+里里外外 245
+高加索 245
+万震山 244
+下方 244
+两列 244
+丰盛 244
+二十岁 244
+债权人 244
+几十万 244
+分隔 244
+刑场 244
+创设 244
+前胸 244
+十日 244
+单项 244
+原子量 244
+受众 244
+吼声 244
+善行 244
+回击 244
+家喻户晓 244
+岂可 244
+年产量 244
+怀有 244
+总能 244
+恒河 244
+拙 244
+排泄 244
+明明白白 244
+本科段 244
+杂草 244
+李瑞环 244
+条子 244
+杨尚昆 244
+枪口 244
+欠身 244
+牦牛 244
+特异 244
+留住 244
+社论 244
+第三世界 244
+纸烟 244
+经得起 244
+绝灭 244
+萌发 244
+言辞 244
+训斥 244
+详见 244
+醒目 244
+重围 244
+量子力学 244
+阴暗 244
+降雨 244
+除掉 244
+雇员 244
+鬓 244
+上上下下 243
+二中全会 243
+交情 243
+伍廷芳 243
+侧耳 243
+做客 243
+公孙胜 243
+六中全会 243
+内侍 243
+内膜 243
+几眼 243
+分立 243
+劲风 243
+协奏曲 243
+卓有成效 243
+反贼 243
+响彻 243
+囦 243
+圣上 243
+多重 243
+孟加拉国 243
+宦 243
+开赴 243
+张顺 243
+恍然 243
+惊惧 243
+指教 243
+插嘴 243
+支书 243
+攻取 243
+无敌 243
+条文 243
+梦境 243
+沥青 243
+湵 243
+烧杀 243
+特制 243
+猛扑 243
+现阶段 243
+电讯 243
+皇马 243
+稳住 243
+空荡荡 243
+立宪 243
+第二轮 243
+紧要关头 243
+红绸 243
+缇 243
+老哥 243
+自主权 243
+蕉 243
+薛传薪 243
+讲演 243
+许昌 243
+论战 243
+话费 243
+贝勒 243
+转眼间 243
+金元 243
+阻塞 243
+阿波罗 243
+饭后 243
+黄蓉笑 243
+一百个 242
+上层建筑 242
+两千 242
+个数 242
+二十三年 242
+人力资源部 242
+冻土 242
+几遍 242
+勒马 242
+南麓 242
+发兵 242
+君士坦丁堡 242
+唤醒 242
+夺目 242
+字典 242
+宗法 242
+实则 242
+岳母 242
+性欲 242
+手心 242
+护照 242
+拆迁 242
+搬家 242
+收音机 242
+暮色 242
+暴徒 242
+林泉 242
+染发剂 242
+正派 242
+海河 242
+烟叶 242
+玉亭 242
+王妃 242
+电磁场 242
+白痴 242
+硬着头皮 242
+精绝 242
+纠葛 242
+美国国务院 242
+脑门 242
+膳食 242
+行署 242
+观摩 242
+近在咫尺 242
+通缉 242
+铁骑 242
+长诗 242
+门阀 242
+阳台 242
+颠簸 242
+饭馆 242
+香精 242
+魏巍 242
+龙井 242
+乌黑 241
+人丛 241
+伊万 241
+俸禄 241
+僧道 241
+内参 241
+刘玉尺 241
+双层 241
+听罢 241
+吵闹 241
+呼和浩特 241
+咕咕 241
+嘘 241
+嚎 241
+天命 241
+宛 241
+山野 241
+底盘 241
+建设性 241
+弱势 241
+忆 241
+恶贼 241
+折叠 241
+换装 241
+摇摇晃晃 241
+暴躁 241
+有赖于 241
+楄 241
+液态 241
+滴定 241
+点中 241
+用布 241
+眼圈 241
+穿梭 241
+竹子 241
+第一颗 241
+第二步 241
+粗暴 241
+精神病 241
+织造 241
+经销商 241
+老式 241
+脾胃 241
+自刎 241
+衢 241
+该行 241
+诬 241
+车夫 241
+逼真 241
+避难 241
+郠 241
+顷刻之间 241
+须知 241
+一开 240
+不为过 240
+专权 240
+二道 240
+从容不迫 240
+伤痛 240
+假说 240
+关岛 240
+冷酷 240
+凝重 240
+升华 240
+升空 240
+单方面 240
+印欧语 240
+变压器 240
+古玩 240
+史前 240
+后唐 240
+啀 240
+啐 240
+噩梦 240
+基岩 240
+塘沽 240
+复旦 240
+多年生 240
+天宫 240
+姥姥 240
+密林 240
+导线 240
+彰 240
+性感 240
+拜年 240
+据点 240
+摆摆 240
+支气管 240
+支线 240
+敌意 240
+晦气 240
+河西走廊 240
+泪珠 240
+混交林 240
+王后 240
+疾苦 240
+目不转睛 240
+窍门 240
+立国 240
+舍人 240
+花白 240
+花鸟 240
+衙役 240
+裁定 240
+迷糊 240
+造福 240
+遮掩 240
+重创 240
+锐气 240
+闵柔 240
+骄 240
+不顾一切 239
+两匹 239
+中生代 239
+五中全会 239
+任由 239
+借机 239
+充裕 239
+公家 239
+军事家 239
+农具 239
+准备金 239
+几率 239
+出入境 239
+出息 239
+友人 239
+品味 239
+坐镇 239
+壮士 239
+委任 239
+寓言 239
+小可 239
+居士 239
+帜 239
+平遥 239
+幻觉 239
+张宗琪 239
+弹簧 239
+徽州 239
+怪人 239
+戒律 239
+挂钩 239
+改元 239
+暗杀 239
+杨志 239
+横幅 239
+步兵师 239
+水师 239
+浐 239
+海湾战争 239
+煎熬 239
+物业 239
+王秋 239
+珠子 239
+病床 239
+矮胖 239
+第四节 239
+纭 239
+综合国力 239
+考题 239
+耕耘 239
+脱水 239
+苍老 239
+荡然无存 239
+萍 239
+践踏 239
+连结 239
+遇事 239
+闲置 239
+隐隐约约 239
+题写 239
+马德里 239
+七八 238
+七里沟 238
+买方 238
+五指 238
+何如 238
+公用 238
+出题 238
+包天剑 238
+去掉 238
+坦桑尼亚 238
+外流 238
+天鹰 238
+婉转 238
+字画 238
+容积 238
+小肠 238
+开朗 238
+当之无愧 238
+情结 238
+投射 238
+收起 238
+新编 238
+旧金山 238
+晋级 238
+暗淡 238
+李纲 238
+洗劫 238
+洗手 238
+流通领域 238
+源自 238
+班级 238
+短刀 238
+笁 238
+第二届 238
+精良 238
+纪念碑 238
+缙 238
+缠住 238
+老太婆 238
+耗子 238
+胁迫 238
+苦闷 238
+西南地区 238
+诗经 238
+起居 238
+迷茫 238
+通告 238
+野菜 238
+音乐家 238
+高一功 238
+一纸 237
+上古 237
+专人 237
+五星 237
+休养 237
+侗 237
+便衣 237
+修正案 237
+入土 237
+内里 237
+农副产品 237
+前秦 237
+剧中 237
+印第安 237
+危亡 237
+回忆录 237
+围观 237
+土木 237
+外壳 237
+大酒店 237
+好感 237
+妫 237
+孟加拉 237
+差错 237
+床头 237
+度量 237
+座舱 237
+异同 237
+恼火 237
+手提 237
+攻击机 237
+故人 237
+文帝 237
+旅游胜地 237
+无畏 237
+暑假 237
+暗藏 237
+欓 237
+气喘吁吁 237
+没关系 237
+男士 237
+皮球 237
+睾丸 237
+禅师 237
+空空导弹 237
+窗前 237
+第六章 237
+粘性 237
+精简 237
+苫 237
+英明 237
+荒地 237
+螺旋桨 237
+配位 237
+铁匠 237
+韦一笑 237
+三维 236
+上房 236
+不要脸 236
+不见得 236
+世界大战 236
+中国联通 236
+主动权 236
+二百 236
+充任 236
+光是 236
+几招 236
+压根儿 236
+厌烦 236
+受苦 236
+君主制 236
+哎哟 236
+喝醉 236
+喧 236
+土改 236
+大专院校 236
+天井 236
+女排 236
+嫂嫂 236
+宜人 236
+展览会 236
+悍 236
+悖论 236
+情谊 236
+憳 236
+挑剔 236
+撤走 236
+敦促 236
+方法论 236
+标明 236
+梁子翁 236
+步步 236
+殿堂 236
+涂抹 236
+溪水 236
+王瑞芬 236
+甲醛 236
+电灯 236
+砷 236
+稻米 236
+粉身碎骨 236
+糯 236
+紧缺 236
+缉 236
+老一辈 236
+背脊 236
+自小 236
+至极 236
+获得者 236
+菠萝 236
+谈何容易 236
+赵云 236
+过失 236
+鍢 236
+静静 236
+鞭炮 236
+骏马 236
+髯 236
+一肚子 235
+上街 235
+下决心 235
+不济 235
+凤天南 235
+分列 235
+到手 235
+十几岁 235
+参差 235
+口诀 235
+可贵 235
+吃药 235
+哈密 235
+壮烈 235
+多一些 235
+夜空 235
+大振 235
+大捷 235
+婅 235
+对得起 235
+常规赛 235
+张勋 235
+打赢 235
+搭载 235
+擢 235
+斺 235
+本钱 235
+栗 235
+汇兑 235
+画廊 235
+窡 235
+缕 235
+脸皮 235
+躲闪 235
+辨证 235
+送达 235
+鑵 235
+钗 235
+附有 235
+雅克 235
+顶点 235
+黑豆 235
+一整套 234
+不经意 234
+东北军 234
+也门 234
+于阗 234
+五万 234
+亚麻 234
+交通工具 234
+何者 234
+冷门 234
+分批 234
+刺激性 234
+加油机 234
+助长 234
+升温 234
+大中 234
+嫉 234
+密谋 234
+寨主 234
+尿道 234
+己方 234
+师爷 234
+心得 234
+惨叫 234
+战利品 234
+挥发 234
+摆布 234
+敌国 234
+旗子 234
+月季 234
+杂技 234
+林震南 234
+桥身 234
+棉衣 234
+椿 234
+止不住 234
+溃败 234
+满文 234
+瀃 234
+瑧 234
+电动 234
+相称 234
+矩形 234
+矿井 234
+矿工 234
+破损 234
+缅怀 234
+缠绵 234
+贫乏 234
+资源管理 234
+路途 234
+选举法 234
+通货 234
+顿足 234
+马三俊 234
+鳞片 234
+一目 233
+不法 233
+两份 233
+互通 233
+低潮 233
+倾诉 233
+僵持 233
+六级 233
+出境游 233
+出过 233
+受用 233
+商品生产 233
+墍 233
+委实 233
+抢险 233
+摄取 233
+改型 233
+数步 233
+有期徒刑 233
+杀敌 233
+此案 233
+比尔 233
+气管 233
+沸 233
+渤 233
+玄武岩 233
+环顾 233
+甬道 233
+田归农 233
+盅 233
+短小 233
+离职 233
+红晕 233
+纽带 233
+罗斯 233
+聊聊 233
+艿 233
+苦战 233
+蛓 233
+裇 233
+西沙 233
+要领 233
+谕旨 233
+赵一荻 233
+违者 233
+郝大通 233
+金瓶梅 233
+铁丝 233
+银行家 233
+阐释 233
+险峻 233
+髦 233
+鹄 233
+一瞬 232
+交易会 232
+亭子 232
+伤寒 232
+何太冲 232
+使唤 232
+全球通 232
+兴隆 232
+军部 232
+剐 232
+劝告 232
+加利福尼亚 232
+加蓬 232
+喜好 232
+奉承 232
+奴家 232
+守御 232
+害羞 232
+寄主 232
+巍 232
+巴拉圭 232
+德化 232
+总编辑 232
+惩 232
+战斗部 232
+手续费 232
+打狗棒法 232
+投篮 232
+报销 232
+披挂 232
+捂住 232
+摩尔 232
+新房 232
+旇 232
+有效性 232
+朝服 232
+杨雄 232
+汇聚 232
+流体力学 232
+浩劫 232
+滔滔 232
+牵挂 232
+皮层 232
+缴获 232
+肤色 232
+舍命 232
+花草 232
+英镑 232
+讪 232
+讲课 232
+质问 232
+超群 232
+轻盈 232
+进食 232
+选中 232
+金针 232
+钢管 232
+防汛 232
+雷锋 232
+领衔 232
+饭桌 232
+一心一意 231
+三重 231
+不相干 231
+中央政治局常委 231
+人际 231
+会面 231
+出狱 231
+列传 231
+劲敌 231
+千瓦 231
+午夜 231
+咒骂 231
+坃 231
+填充 231
+夏商周 231
+天堑 231
+奸淫 231
+姑且 231
+山后 231
+师范学校 231
+徐以显 231
+德里 231
+忘却 231
+情状 231
+战战兢兢 231
+手套 231
+手稿 231
+搠 231
+正常人 231
+消化道 231
+漂流 231
+珍藏 231
+白鹿原 231
+石室 231
+碎屑 231
+端庄 231
+纯熟 231
+纳米 231
+美分 231
+臣子 231
+蛤蟆 231
+这一来 231
+遥望 231
+酚 231
+金蛇 231
+鐞 231
+隼 231
+青春期 231
+青石 231
+马鞭 231
+黄道 231
+下地 230
+中人 230
+中国人民银行 230
+乘马 230
+作证 230
+佟 230
+全副武装 230
+全歼 230
+关羽 230
+冲入 230
+参预 230
+叛逆 230
+商务部 230
+噶 230
+四中全会 230
+士族 230
+大发 230
+女朋友 230
+妇联 230
+学制 230
+小朋友 230
+屋外 230
+屠 230
+平淡 230
+忽而 230
+打乱 230
+授课 230
+放映 230
+无与伦比 230
+晴天 230
+有加 230
+木制 230
+松林 230
+汕头 230
+海尔 230
+濅 230
+火枪 230
+特异性 230
+献出 230
+玺 230
+离散 230
+美的 230
+耕田 230
+莫不 230
+虚构 230
+蟸 230
+衰弱 230
+装填 230
+西西里 230
+触发 230
+讫 230
+财主 230
+贵金属 230
+迷失 230
+透视 230
+道义 230
+那马 230
+阉 230
+驱除 230
+鼠标 230
+龙舟 230
+不一会儿 229
+丧生 229
+主营业务 229
+久而久之 229
+云中鹤 229
+倪 229
+做工 229
+儿媳妇 229
+分身 229
+刺绣 229
+劳德诺 229
+千家万户 229
+司徒 229
+名录 229
+呯 229
+咆哮 229
+品行 229
+嘉轩 229
+堬 229
+宋青书 229
+家禽 229
+富饶 229
+就近 229
+岛内 229
+开学 229
+强忍 229
+彂 229
+心理学家 229
+手册 229
+抗洪 229
+排序 229
+木星 229
+海报 229
+海浪 229
+浸透 229
+玉兰 229
+男孩子 229
+瘦子 229
+百般 229
+硬化 229
+祸害 229
+索马里 229
+绻 229
+老太 229
+薛蟠 229
+设宴 229
+请假 229
+谚语 229
+贫僧 229
+鐑 229
+钉子 229
+隆冬 229
+七天 228
+上限 228
+书记处 228
+五百年 228
+佛法 228
+八名 228
+共建 228
+分析家 228
+券商 228
+刹 228
+剧种 228
+匮乏 228
+卫春华 228
+厌倦 228
+名校 228
+后妃 228
+吸毒 228
+坏死 228
+多亏 228
+娣 228
+媲美 228
+安歇 228
+完蛋 228
+宣赞 228
+寺里 228
+巡捕 228
+工党 228
+庋 228
+怒吼 228
+成婚 228
+招安 228
+拦阻 228
+无私 228
+旭 228
+朗诵 228
+束手无策 228
+枪响 228
+核试 228
+毋 228
+汉王 228
+沉没 228
+淹死 228
+濓 228
+烃 228
+狭隘 228
+猴儿 228
+王国光 228
+男友 228
+祖上 228
+第二道 228
+繁复 228
+缩回 228
+聚众 228
+致敬 228
+英国政府 228
+虻 228
+西藏自治区 228
+证人 228
+询 228
+诸事 228
+跺脚 228
+轮式 228
+辅导班 228
+这伙 228
+野猪 228
+鎻 228
+钱之江 228
+门板 228
+阿拉伯语 228
+阿拉斯加 228
+高丽 228
+鬼鬼祟祟 228
+鬼魂 228
+一中全会 227
+不正 227
+丫 227
+举子 227
+乙醚 227
+九卿 227
+互利 227
+休假 227
+全真 227
+写给 227
+军售 227
+冰块 227
+半封建 227
+印章 227
+哥儿俩 227
+嗦 227
+国际货币基金组织 227
+大顺军 227
+姨妈 227
+宋庆龄 227
+忏悔 227
+急躁 227
+恶性肿瘤 227
+想象力 227
+扣押 227
+接踵而来 227
+斓 227
+无为 227
+未定 227
+梦幻 227
+楋 227
+樟 227
+波涛 227
+洗净 227
+深邃 227
+清史 227
+灵机一动 227
+特困 227
+环形 227
+疏通 227
+社会民主党 227
+竷 227
+笑眯眯 227
+羚羊 227
+脸蛋 227
+舒展 227
+褶 227
+诗意 227
+谷城 227
+责令 227
+超标 227
+过长 227
+进山 227
+酒壶 227
+锯齿 227
+长成 227
+队里 227
+高炮 227
+一例 226
+三岁 226
+严明 226
+中式 226
+中欧 226
+主旨 226
+书影 226
+了得 226
+五卅运动 226
+人迹 226
+公证 226
+六朝 226
+共存 226
+准时 226
+吓倒 226
+告示 226
+四条 226
+大乐 226
+大帝 226
+大惊失色 226
+头绪 226
+孙承宗 226
+季节性 226
+审时度势 226
+巴结 226
+悄悄的 226
+慈禧太后 226
+报国 226
+拉拢 226
+择业 226
+揍 226
+教育局 226
+新疆维吾尔自治区 226
+方始 226
+无异于 226
+晁盖 226
+曹丕 226
+有误 226
+枸杞 226
+次生 226
+殖民主义者 226
+测算 226
+热血沸腾 226
+玉山 226
+琉 226
+申花队 226
+相容 226
+真实性 226
+碧绿 226
+纪晓 226
+终南山 226
+肉眼 226
+规律性 226
+角质 226
+说了算 226
+遭逢 226
+门生 226
+降温 226
+韧性 226
+三个代表 225
+上吊 225
+丑恶 225
+九品 225
+伏兵 225
+优待 225
+传承 225
+侠客 225
+停靠 225
+光顾 225
+全校 225
+变相 225
+后堂 225
+商品房 225
+啼哭 225
+回首 225
+安阳 225
+宫颈 225
+密室 225
+对答 225
+工件 225
+心甘情愿 225
+必要性 225
+排行榜 225
+接种 225
+摇篮 225
+曞 225
+曹云奇 225
+核弹 225
+每块 225
+河间 225
+沼泽地 225
+海地 225
+深思 225
+潻 225
+狼群 225
+王希烈 225
+皎 225
+看得出 225
+眺望 225
+禽兽 225
+稀罕 225
+索取 225
+缂 225
+美联社 225
+群众运动 225
+老区 225
+自个儿 225
+莱茵河 225
+营业部 225
+营生 225
+蟋蟀 225
+贸 225
+迎合 225
+那会儿 225
+锚 225
+锦旗 225
+饭铺 225
+香火 225
+骡马 225
+魏兵 225
+一本正经 224
+专栏 224
+中国区 224
+亲家 224
+仓皇 224
+令尊 224
+伎 224
+信息系统 224
+俾 224
+光波 224
+光绪帝 224
+冒险主义 224
+割裂 224
+十四大 224
+土丘 224
+坤宁宫 224
+垂危 224
+墓碑 224
+天象 224
+妙计 224
+室外 224
+尽心 224
+屠龙刀 224
+带状 224
+延缓 224
+愚昧 224
+承载 224
+掂 224
+摇动 224
+普普通通 224
+杞县 224
+横亘 224
+毅力 224
+毛皮 224
+涚 224
+珟 224
+由衷 224
+相干 224
+章启月 224
+管线 224
+系统工程 224
+纣 224
+脱险 224
+花厅 224
+装药 224
+近前 224
+违抗 224
+邓肯 224
+金华 224
+长处 224
+顷刻 224
+默认 224
+万公里 223
+不顺 223
+两架 223
+两片 223
+为官 223
+二千 223
+亚美尼亚 223
+先天性 223
+凭着 223
+双星 223
+反对党 223
+发号施令 223
+发审委 223
+叞 223
+名言 223
+喝问 223
+嚬 223
+官兵们 223
+宣抚司 223
+察哈尔 223
+导电 223
+很早 223
+很难说 223
+性急 223
+抵抗力 223
+新军 223
+武职 223
+汇成 223
+消遣 223
+淋巴结 223
+王九思 223
+王权 223
+疏远 223
+考点 223
+联接 223
+聪明人 223
+脏器 223
+臀部 223
+花铁干 223
+营运 223
+蕴含 223
+行家 223
+诊治 223
+跳板 223
+还击 223
+遂行 223
+金沙江 223
+铣 223
+阻隔 223
+青州 223
+靠山 223
+风尚 223
+饬 223
+骇人听闻 223
+骷髅 223
+龛 223
+不俗 222
+东家 222
+争气 222
+交还 222
+侊 222
+先师 222
+公国 222
+凛然 222
+功德 222
+加州 222
+半步 222
+去除 222
+吸纳 222
+嗡 222
+园中 222
+增补 222
+大伯 222
+大抵 222
+安南 222
+尾翼 222
+山沟 222
+幼小 222
+建桥 222
+张永红 222
+强暴 222
+彭连虎 222
+慈宁宫 222
+拷 222
+景山 222
+林伯渠 222
+楝 222
+武当 222
+注目 222
+源源不断 222
+白洁 222
+相伴 222
+神父 222
+稀释 222
+稚 222
+空手 222
+篱笆 222
+糟蹋 222
+缩减 222
+肤 222
+虐待 222
+蝎 222
+血红蛋白 222
+许多年 222
+购房 222
+躯 222
+转正 222
+输血 222
+迎风 222
+邢台 222
+郭沫若 222
+镉 222
+集散地 222
+雷阵雨 222
+领海 222
+骨质 222
+黑洞 222
+两周 221
+亮度 221
+但凡 221
+借助于 221
+出师 221
+初三 221
+单单 221
+原件 221
+双亲 221
+召唤 221
+右脚 221
+吉米 221
+吕擎 221
+哈哈哈 221
+嗬 221
+噪音 221
+四爷 221
+地区性 221
+复出 221
+天龙 221
+客栈 221
+尤氏 221
+幕后 221
+当夜 221
+恐怖主义 221
+悠闲 221
+惊天动地 221
+慛 221
+提携 221
+教官 221
+木柱 221
+核准 221
+歇歇 221
+武林中 221
+汉唐 221
+沙洲 221
+沙特 221
+炵 221
+燃气 221
+牟 221
+独到 221
+白菜 221
+百科全书 221
+着眼于 221
+神医 221
+私事 221
+精光 221
+苗若兰 221
+茗 221
+蓝军 221
+西风 221
+赌场 221
+轮换 221
+轻装 221
+遗弃 221
+避孕 221
+饱满 221
+三丈 220
+上交 220
+上楼 220
+中央纪律检查委员会 220
+五次 220
+代表人 220
+传遍 220
+体育运动 220
+使用权 220
+侦 220
+儒生 220
+几层 220
+力主 220
+单于 220
+园内 220
+圜 220
+增减 220
+大个子 220
+大法 220
+孝顺 220
+宣抚使 220
+将令 220
+尚可 220
+广为 220
+庄上 220
+康德 220
+徒步 220
+战前 220
+手工业者 220
+托管 220
+拍马 220
+持刀 220
+攫取 220
+收盘 220
+斯文 220
+无政府主义 220
+果品 220
+枫 220
+毛细血管 220
+水声 220
+燃起 220
+玩儿 220
+看准 220
+磁化 220
+种师道 220
+空缺 220
+端倪 220
+第三位 220
+第十一 220
+绿林 220
+美国国防部 220
+考试院 220
+莫名 220
+蔽 220
+血糖 220
+行踪 220
+行车 220
+诗作 220
+超额利润 220
+跟进 220
+陕甘 220
+雾气 220
+青藏 220
+顾杲 220
+香水 220
+一席 219
+一百年 219
+一瞬间 219
+中曾 219
+主食 219
+买下 219
+二元 219
+二老 219
+余部 219
+佛祖 219
+光度 219
+党人 219
+党籍 219
+公孙谷主 219
+共鸣 219
+几岁 219
+分红 219
+半球 219
+听懂 219
+呼救 219
+喷射 219
+四尺 219
+圣火 219
+岬 219
+左岸 219
+开放式 219
+张君宝 219
+徐世昌 219
+心肌 219
+怕人 219
+悜 219
+愤恨 219
+抒发 219
+攘 219
+文廷式 219
+旅行家 219
+昨 219
+本子 219
+灌丛 219
+疲软 219
+直流 219
+竦 219
+组织者 219
+胆量 219
+苍天 219
+行宫 219
+被告人 219
+谷主 219
+责怪 219
+赌气 219
+辂 219
+锂 219
+问及 219
+阎王 219
+非金属 219
+马达加斯加 219
+鼻梁 219
+一无所知 218
+不公 218
+伸长 218
+低调 218
+关东军 218
+前朝 218
+友情 218
+受阻 218
+叨 218
+各式 218
+后山 218
+周公 218
+唐家璇 218
+四壁 218
+国名 218
+图腾 218
+坦白 218
+外戚 218
+孙女 218
+寒暄 218
+寝室 218
+尸骨 218
+开场白 218
+待人 218
+微量 218
+心旷神怡 218
+忘掉 218
+执着 218
+扶植 218
+探亲 218
+撤职 218
+星期六 218
+朱笔 218
+朱衡 218
+机缘 218
+江边 218
+法国队 218
+淡化 218
+玩弄 218
+理想国 218
+祖坟 218
+笔法 218
+第五章 218
+粘液 218
+练武 218
+罢市 218
+聪 218
+街巷 218
+说谎 218
+谋划 218
+责难 218
+赫鲁晓夫 218
+起用 218
+轻薄 218
+长白山 218
+闭塞 218
+随军 218
+黄牌 218
+黄牛 218
+不单 217
+严严实实 217
+代言人 217
+仰望 217
+佩戴 217
+先人 217
+公鸡 217
+军饷 217
+凑合 217
+凝望 217
+出汗 217
+剿灭 217
+南开大学 217
+卡特 217
+双刀 217
+名堂 217
+呷 217
+喜出望外 217
+国大党 217
+大惊小怪 217
+安史之乱 217
+寒光 217
+岑春煊 217
+帕克 217
+弱者 217
+拨开 217
+推倒 217
+提供商 217
+散落 217
+斜坡 217
+新生代 217
+旗舰 217
+杀头 217
+松手 217
+海豹 217
+淞 217
+清香 217
+潜能 217
+片名 217
+物理量 217
+狠心 217
+理论家 217
+甘薯 217
+生活费 217
+眉目 217
+瞳孔 217
+研习 217
+窗帘 217
+罗小梅 217
+美术史 217
+脊背 217
+自民党 217
+英联邦 217
+行贿 217
+被褥 217
+越野 217
+进退 217
+鄙视 217
+酸软 217
+长长 217
+陈名夏 217
+难逃 217
+靠拢 217
+面板 217
+须臾 217
+鼐 217
+上班族 216
+个人崇拜 216
+五官 216
+人氏 216
+保重 216
+克里 216
+免疫力 216
+占卜 216
+叼 216
+商宝震 216
+商讨 216
+喀麦隆 216
+如期 216
+妹儿 216
+始祖 216
+孩 216
+得当 216
+怎么着 216
+成都市 216
+扔掉 216
+技术性 216
+捆绑 216
+无罪 216
+明晃晃 216
+朱仙镇 216
+朱祁钰 216
+杀气 216
+林肯 216
+毛文龙 216
+沉降 216
+河滩 216
+法度 216
+洖 216
+洪亮 216
+浓烟 216
+海宁 216
+游街 216
+湖州 216
+濈 216
+炳 216
+焦耳 216
+甲基 216
+男儿 216
+穴位 216
+简略 216
+算命 216
+缩写 216
+网队 216
+美国队 216
+臻 216
+虎口 216
+载有 216
+辜鸿铭 216
+迎春 216
+银行卡 216
+门路 216
+雄姿 216
+集群 216
+顾金标 216
+马鞍 216
+一声不吭 215
+上朝 215
+不二 215
+两间 215
+义愤填膺 215
+二品 215
+低落 215
+假话 215
+傲 215
+利用率 215
+动辄 215
+回声 215
+城里人 215
+多一点 215
+大兴土木 215
+大意 215
+太岳 215
+奢华 215
+女方 215
+宀 215
+宋远桥 215
+山脚下 215
+建树 215
+引向 215
+德育 215
+忚 215
+恪守 215
+悊 215
+悬浮 215
+惨烈 215
+战犯 215
+扫帚 215
+排行 215
+摄政 215
+擂台 215
+放大器 215
+故事片 215
+日寇 215
+机灵 215
+杨真 215
+枪支 215
+柏树 215
+柯达 215
+法门 215
+洛克希德 215
+津巴布韦 215
+流利 215
+浅海 215
+溪流 215
+灭火 215
+烧成 215
+热衷 215
+猴王 215
+疏散 215
+盎 215
+秦桧 215
+緰 215
+经常性 215
+胸怀 215
+脱颖而出 215
+自个 215
+舍弃 215
+舱内 215
+调来 215
+谢若萍 215
+豢 215
+败仗 215
+赡 215
+较之 215
+述说 215
+遇难 215
+闲谈 215
+非典 215
+领导层 215
+髻 215
+黄埔军校 215
+龟兹 215
+两碗 214
+五位 214
+亲率 214
+从未有过 214
+价位 214
+入阁 214
+兵书 214
+创维 214
+动武 214
+匪夷所思 214
+原创 214
+命脉 214
+国境 214
+备忘录 214
+外传 214
+大中城市 214
+天宝 214
+定都 214
+小钢炮 214
+工钱 214
+年长 214
+张翠 214
+当家 214
+征伐 214
+征讨 214
+慕容公子 214
+收藏家 214
+文联 214
+权衡 214
+殉国 214
+渥 214
+猓 214
+电离层 214
+略带 214
+白丽娜 214
+睫毛 214
+耗尽 214
+肇 214
+自我批评 214
+般若 214
+蒙古国 214
+表征 214
+表徵 214
+触怒 214
+谋略 214
+费时 214
+跃入 214
+逼人 214
+魏清慧 214
+上映 213
+不配 213
+与非 213
+专卖 213
+丛生 213
+两米 213
+买入 213
+借给 213
+光复 213
+公馆 213
+兴致勃勃 213
+剑桥 213
+双水村 213
+发布厅 213
+取食 213
+可好 213
+台大 213
+地下室 213
+基座 213
+大会堂 213
+大坝 213
+头子 213
+嫦娥 213
+字符串 213
+孤单 213
+小贩 213
+小鱼 213
+归宿 213
+念念不忘 213
+打捞 213
+拉丁文 213
+排球 213
+摆设 213
+斩断 213
+无望 213
+更具 213
+核反应 213
+民权 213
+沙土 213
+游动 213
+特使 213
+犯罪分子 213
+玄武 213
+用语 213
+电厂 213
+皇朝 213
+短促 213
+礼乐 213
+第十 213
+簺 213
+组织部 213
+细细的 213
+老高 213
+胶体 213
+能级 213
+自助 213
+苦心 213
+莫桑比克 213
+血泊 213
+衬衣 213
+裁缝 213
+谶 213
+赢利 213
+辉映 213
+那根 213
+长足 213
+闸门 213
+雷击 213
+颜面 213
+风韵 213
+一刹那 212
+上访 212
+两河 212
+九天 212
+乱石 212
+今儿个 212
+仿真 212
+低微 212
+先烈 212
+光束 212
+加速度 212
+动量 212
+华美 212
+南疆 212
+噙 212
+夏日 212
+天仙 212
+小店 212
+尖刀 212
+山丘 212
+开场 212
+成功率 212
+拳击 212
+掌印 212
+掩埋 212
+揭晓 212
+散乱 212
+时至今日 212
+晩 212
+松山 212
+栬 212
+横冲直撞 212
+歌功颂德 212
+法名 212
+洞开 212
+物理化学 212
+理直气壮 212
+祖大寿 212
+神明 212
+筷 212
+肥力 212
+致密 212
+蔑视 212
+蔓 212
+要犯 212
+豪迈 212
+贼人 212
+车队 212
+辽西 212
+金冠 212
+锐利 212
+闵 212
+闽西 212
+阿香 212
+陈永福 212
+预备队 212
+饮宴 212
+下半叶 211
+不言而喻 211
+中央书记处 211
+主攻 211
+云梯 211
+俭朴 211
+倒像 211
+倒腾 211
+兵制 211
+冷气 211
+协约国 211
+叓 211
+取舍 211
+受罚 211
+可逆 211
+周三 211
+咱家 211
+唐玄宗 211
+头巾 211
+字儿 211
+寂 211
+封存 211
+小觑 211
+就诊 211
+巴塞罗那 211
+帷 211
+庄客 211
+廉洁 211
+强奸 211
+恤 211
+慑 211
+房舍 211
+手书 211
+执事 211
+折回 211
+抚慰 211
+报业 211
+报应 211
+抬手 211
+擡手 211
+敬酒 211
+斑点 211
+新旧 211
+无济于事 211
+根系 211
+歌谣 211
+沈绿爱 211
+洛阳城 211
+渔人 211
+热衷于 211
+燂 211
+球门 211
+留恋 211
+百里 211
+盛世 211
+真挚 211
+禁毒 211
+绥 211
+藐视 211
+蛊 211
+血肉之躯 211
+街市 211
+豁 211
+踏进 211
+躁 211
+躲过 211
+通知书 211
+霸道 211
+鲁莽 211
+三杯 210
+中小学生 210
+书架 210
+五世 210
+分派 210
+前台 210
+北坡 210
+危险性 210
+反导 210
+叶二娘 210
+叺 210
+同名 210
+名利 210
+含泪 210
+嗖 210
+宣武 210
+宥 210
+屋檐 210
+恒定 210
+憧憬 210
+未婚 210
+本周 210
+柔情 210
+格斗 210
+检疫 210
+江阴 210
+清热 210
+清秀 210
+潕 210
+焕 210
+爪哇 210
+牧草 210
+狂欢 210
+独裁者 210
+率众 210
+现时 210
+玻利维亚 210
+留出 210
+税率 210
+站点 210
+筐 210
+管子 210
+绝无仅有 210
+维吾尔 210
+缃 210
+舰炮 210
+苦涩 210
+英格兰银行 210
+荡漾 210
+荷马 210
+行之有效 210
+行政院 210
+西学 210
+西安事变 210
+诞 210
+谎言 210
+跪拜 210
+跳伞 210
+轻纺 210
+造船厂 210
+避风 210
+重病 210
+鐖 210
+钦定 210
+长发 210
+长生 210
+陆承伟 210
+随心所欲 210
+雌激素 210
+魏延 210
+一如既往 209
+一尊 209
+一幢 209
+中用 209
+丹阳 209
+举足轻重 209
+二号 209
+于今 209
+伽利略 209
+俄共 209
+停牌 209
+全能 209
+冠心病 209
+升职 209
+厄瓜多尔 209
+厨师 209
+同心 209
+唱戏 209
+噎 209
+国军 209
+地带性 209
+基团 209
+姏 209
+学识 209
+实务 209
+小说家 209
+岛国 209
+帮帮 209
+废水 209
+弹壳 209
+德黑兰 209
+情由 209
+成形 209
+拼死 209
+接通 209
+措 209
+掺 209
+撰文 209
+收留 209
+教头 209
+普遍性 209
+景帝 209
+有伤 209
+本该 209
+毁掉 209
+气孔 209
+浗 209
+涡 209
+深信 209
+深陷 209
+烹 209
+爵位 209
+玄慈 209
+甜味剂 209
+甲烷 209
+甲级 209
+石料 209
+站稳 209
+红肿 209
+纪事 209
+茶碗 209
+菜篮子 209
+葑 209
+诚心 209
+贝类 209
+账目 209
+轩然大波 209
+轻度 209
+达赖 209
+选择性 209
+附带 209
+震颤 209
+飧 209
+一米 208
+一统 208
+上万 208
+上海交通大学 208
+两半 208
+二十八年 208
+从今 208
+倘或 208
+偏将 208
+做为 208
+偣 208
+农牧 208
+冯锡范 208
+凤阳 208
+击沉 208
+刂 208
+分神 208
+史学家 208
+同僚 208
+后场 208
+嚷嚷 208
+国界 208
+备用 208
+家业 208
+家教 208
+封冻 208
+常任 208
+忧愁 208
+愈演愈烈 208
+感受器 208
+手足无措 208
+揣摩 208
+斯谟 208
+日日 208
+日耳曼 208
+旬 208
+本区 208
+没底 208
+河源 208
+消瘦 208
+濞 208
+牵头 208
+独立国家 208
+瑁 208
+病逝 208
+白骨 208
+省悟 208
+石阶 208
+私心 208
+笖 208
+管柱 208
+羯 208
+翻腾 208
+肿胀 208
+胸襟 208
+腊月 208
+自尊心 208
+节假日 208
+贺人龙 208
+近些年 208
+连累 208
+配方 208
+酒泉 208
+陈宝柱 208
+霍地 208
+马赛 208
+高频 208
+上个月 207
+上月 207
+主政 207
+乳汁 207
+军港 207
+刚体 207
+删 207
+剑桥大学 207
+功过 207
+叠加 207
+同归于尽 207
+周瑞 207
+善意 207
+地宫 207
+奖项 207
+小村 207
+应得 207
+弁 207
+引路 207
+弹射 207
+心眼 207
+忧心忡忡 207
+惊呆 207
+戏剧性 207
+投影 207
+搭建 207
+斋戒 207
+服药 207
+机会主义 207
+此物 207
+毒辣 207
+气魄 207
+泪花 207
+海淀区 207
+烧死 207
+独一无二 207
+玉女心经 207
+王振 207
+畲 207
+祖师爷 207
+筏 207
+纂 207
+绒毛 207
+羞涩 207
+茶楼 207
+西海岸 207
+译文 207
+试制 207
+该处 207
+轮子 207
+过目 207
+进化论 207
+选择题 207
+郑晓京 207
+闭合 207
+陆希荣 207
+飞升 207
+馆长 207
+首个 207
+鲁能 207
+龙骨 207
+七十二 206
+丈量 206
+乡下人 206
+书包 206
+二三十 206
+交际 206
+仁宗 206
+供职 206
+俯冲 206
+儚 206
+克什米尔 206
+决策者 206
+南迁 206
+史密斯 206
+吴县 206
+噬 206
+坦言 206
+大家庭 206
+大潮 206
+妩媚 206
+守恒 206
+官僚主义 206
+宣讲会 206
+富丽堂皇 206
+建康 206
+强加 206
+微臣 206
+心里话 206
+患难 206
+抄家 206
+抽查 206
+撒哈拉 206
+擂 206
+族长 206
+暖暖的 206
+朝阳区 206
+样样 206
+棉布 206
+榨油 206
+此时此刻 206
+氢气 206
+水银 206
+沉稳 206
+淡淡地 206
+漳州 206
+炰 206
+疤 206
+白色恐怖 206
+磷酸盐 206
+稻田 206
+纰 206
+缔造 206
+缱 206
+老婆婆 206
+腋 206
+腹面 206
+舞动 206
+英尺 206
+荒芜 206
+莱茵 206
+蛭 206
+裂开 206
+警示 206
+诺言 206
+跳进 206
+辅政 206
+过问 206
+迤 206
+酱油 206
+采摘 206
+铸成 206
+长年 206
+闪亮 206
+阴毒 206
+龙山 206
+七零八落 205
+下述 205
+义务人 205
+伏尔加河 205
+内科 205
+农耕 205
+凉州 205
+华莱士 205
+咃 205
+唐诗 205
+圣贤 205
+堥 205
+妞 205
+宕 205
+居留 205
+师叔 205
+广西壮族自治区 205
+底线 205
+彩云 205
+影射 205
+微量元素 205
+掌柜的 205
+擂鼓 205
+星团 205
+映照 205
+柜子 205
+水溶液 205
+特殊性 205
+畼 205
+疾患 205
+眩晕 205
+秋水 205
+第四卷 205
+管理员 205
+索引 205
+纪录片 205
+老外 205
+自强 205
+舞剧 205
+营寨 205
+薪金 205
+虚名 205
+裸体 205
+警备 205
+讲义 205
+诺贝尔 205
+调配 205
+谋士 205
+赏心 205
+赶往 205
+郡王 205
+钟头 205
+铸币 205
+一举成名 204
+一叠 204
+交手 204
+会馆 204
+佛门 204
+出乎意料 204
+初冬 204
+化成 204
+原委 204
+周四 204
+四家 204
+图表 204
+坎坷 204
+大兵 204
+完颜萍 204
+实数 204
+富翁 204
+封闭式 204
+差役 204
+微光 204
+心绪 204
+忧伤 204
+念珠 204
+急匆匆 204
+拆解 204
+排外 204
+搜索引擎 204
+支架 204
+无忧 204
+曾国藩 204
+枪炮 204
+查找 204
+桌前 204
+每家 204
+渭 204
+灙 204
+疫情 204
+盖德 204
+盛京 204
+祖籍 204
+红利 204
+胎盘 204
+莽 204
+落户 204
+衣冠 204
+负重 204
+躯干 204
+静态 204
+飞马 204
+高龄 204
+不做声 203
+不悦 203
+不消 203
+东正教 203
+乐团 203
+二期 203
+仁慈 203
+介意 203
+伊藤博文 203
+作弊 203
+几代 203
+前场 203
+功业 203
+勘测 203
+友爱 203
+发行量 203
+口口声声 203
+四只 203
+圆圆的 203
+大洲 203
+孙大圣 203
+宠物 203
+宿主 203
+封爵 203
+小便 203
+少儿 203
+崆峒 203
+师门 203
+干尸 203
+引爆 203
+形形色色 203
+德胜门 203
+愮 203
+挤满 203
+文盲 203
+晫 203
+月色 203
+朝霞 203
+杜勋 203
+檄 203
+沧桑 203
+洗衣机 203
+浅色 203
+湖畔 203
+湰 203
+演戏 203
+漫天 203
+熏陶 203
+特鲁西埃 203
+白鹿 203
+皊 203
+瞎说 203
+离退休 203
+称王 203
+立志 203
+筝 203
+绸缎 203
+股子 203
+胆大 203
+腓力 203
+苏木 203
+蓝飞 203
+蓟 203
+薰陶 203
+血泪 203
+行医 203
+话筒 203
+蹄声 203
+身长 203
+那般 203
+领主 203
+饰演 203
+驯 203
+黑压压 203
+下文 202
+不慌不忙 202
+两三年 202
+中信 202
+低压 202
+低洼 202
+借钱 202
+养分 202
+冉冉 202
+冩 202
+冰岛 202
+减去 202
+凑近 202
+压强 202
+吊桥 202
+名目 202
+基辅 202
+多位 202
+大岛 202
+大胡子 202
+天边 202
+实属 202
+家主 202
+就业率 202
+年收入 202
+应考 202
+庨 202
+感悟 202
+戏台 202
+打分 202
+掳掠 202
+撒手 202
+教委 202
+格调 202
+格鲁吉亚 202
+榆 202
+气死 202
+水壶 202
+法师 202
+洗衣 202
+派系 202
+液化 202
+清净 202
+湍 202
+满腹 202
+火山口 202
+百分比 202
+皮下 202
+盔甲 202
+睢 202
+破坏性 202
+稳重 202
+第四届 202
+编造 202
+翰 202
+胚珠 202
+茶壶 202
+药丸 202
+蒙古语 202
+血性 202
+袍子 202
+西班牙语 202
+训练场 202
+语调 202
+过夜 202
+退还 202
+锐减 202
+错综复杂 202
+长乐 202
+陀螺 202
+韩信 202
+飓风 202
+饼干 202
+三端 201
+下定决心 201
+专管 201
+交换价值 201
+亲事 201
+从军 201
+你死我活 201
+元宝 201
+公安部门 201
+公牛 201
+兴修 201
+兼用 201
+切合 201
+刷新 201
+剥蚀 201
+十三届 201
+哥伦布 201
+大半个 201
+奚 201
+奸细 201
+学徒 201
+宝塔 201
+客体 201
+尉健行 201
+小看 201
+屠刀 201
+庄周 201
+建行 201
+开幕词 201
+心声 201
+文化局 201
+槑 201
+没完没了 201
+河套 201
+海沟 201
+温青 201
+渺茫 201
+滕一雷 201
+烟囱 201
+球星 201
+电解 201
+登记在册 201
+石匠 201
+社会关系 201
+私家 201
+竹竿 201
+素不相识 201
+美景 201
+老爹 201
+评说 201
+诈骗 201
+过上 201
+鄙夷 201
+钟万仇 201
+银牌 201
+饱受 201
+黄瓜 201
+一抹 200
+不为人知 200
+两段 200
+丧命 200
+举起 200
+乐府 200
+人命 200
+依恋 200
+共和党 200
+养生 200
+区域性 200
+医疗保险 200
+古朴 200
+可见光 200
+和田 200
+哧 200
+四库 200
+培植 200
+声东击西 200
+太极拳 200
+如若 200
+孙行者 200
+孜 200
+富集 200
+射速 200
+尔后 200
+尾声 200
+开往 200
+弹匣 200
+徐海 200
+徒手 200
+恻隐之心 200
+指战员 200
+捷径 200
+推论 200
+攻守 200
+枪法 200
+殊荣 200
+民主派 200
+用电 200
+磁矩 200
+磷酸 200
+离别 200
+称颂 200
+算计 200
+粉红 200
+纵容 200
+绣花 200
+翻来覆去 200
+航向 200
+蓝玉 200
+蕨 200
+西单 200
+赞誉 200
+近况 200
+配子 200
+鎹 200
+音调 200
+马刀 200
+骨灰 200
+默不作声 200
+万无一失 199
+世界市场 199
+五品 199
+亲日派 199
+亲眼目睹 199
+倏 199
+假山 199
+光大 199
+办公桌 199
+劾 199
+包头 199
+化名 199
+北爱 199
+发射架 199
+发来 199
+吼叫 199
+咕哝 199
+商品流通 199
+囩 199
+圩 199
+多罗 199
+多部 199
+大声疾呼 199
+姜维 199
+娘儿 199
+孙玉厚 199
+实有 199
+将信将疑 199
+小楼 199
+差使 199
+彼岸 199
+忕 199
+成份 199
+打点 199
+摆开 199
+最美 199
+有情 199
+木耳 199
+死战 199
+水田 199
+河堤 199
+派生 199
+生人 199
+督办 199
+笔下 199
+脱口而出 199
+艰险 199
+观战 199
+角膜 199
+贺龙 199
+过重 199
+近程 199
+进逼 199
+邮局 199
+门道 199
+队形 199
+雒 199
+鹿杖客 199
+不息 198
+不符 198
+两度 198
+久仰 198
+倾倒 198
+划时代 198
+别提 198
+到头 198
+劲头 198
+匀称 198
+匝 198
+县上 198
+双人 198
+反对票 198
+发球 198
+变种 198
+合为 198
+后移 198
+周详 198
+唐初 198
+喂养 198
+埂 198
+增长点 198
+壮阔 198
+夏至 198
+夏言 198
+夛 198
+大连实德 198
+学年 198
+平房 198
+废止 198
+强制性 198
+心酸 198
+提早 198
+摧 198
+支持者 198
+木屋 198
+歪曲 198
+每种 198
+民间文学 198
+水兵 198
+汤杯 198
+泾 198
+消散 198
+湖上 198
+灵敏度 198
+炊 198
+热闹非凡 198
+画展 198
+瘦削 198
+盘问 198
+相加 198
+磨难 198
+空域 198
+章节 198
+第一任 198
+管理系统 198
+管用 198
+纠集 198
+范遥 198
+薄薄的 198
+要务 198
+规整 198
+规避 198
+讲台 198
+识破 198
+过意不去 198
+酉 198
+铁锤 198
+面纱 198
+香主 198
+高宗 198
+高寒 198
+鼾声 198
+一跃而起 197
+三口 197
+丘吉尔 197
+中国银行 197
+为辅 197
+亮光 197
+仓促 197
+佟大雷 197
+兴冲冲 197
+南瓜 197
+合理性 197
+吕调阳 197
+吞没 197
+吹牛 197
+喜马拉雅山 197
+四世 197
+大神 197
+大笔 197
+姐儿 197
+威猛 197
+宣泄 197
+宽裕 197
+就学 197
+就绪 197
+幻影 197
+延迟 197
+必然性 197
+必经 197
+快感 197
+怂 197
+总参 197
+恰到好处 197
+意大利人 197
+承运 197
+挽留 197
+挽联 197
+接力 197
+敲打 197
+暖阁 197
+木料 197
+染上 197
+柳若晨 197
+欢声雷动 197
+泰勒 197
+洪秀全 197
+满城 197
+牙买加 197
+特许 197
+狰狞 197
+用法 197
+用量 197
+田赋 197
+皯 197
+督战 197
+石棺 197
+程式 197
+等同 197
+翟 197
+膏药 197
+节庆 197
+花冠 197
+花圃 197
+苏菲亚 197
+英雄豪杰 197
+西站 197
+见状 197
+诠释 197
+该地 197
+货车 197
+蹚 197
+近于 197
+透彻 197
+重赏 197
+鐣 197
+长石 197
+闺 197
+阅兵 197
+阶段性 197
+雏形 197
+高歌 197
+一朝 196
+下狱 196
+主线 196
+乾涸 196
+亚纲 196
+休整 196
+保级 196
+全力以赴 196
+净资产 196
+剪子 196
+化作 196
+单层 196
+反应堆 196
+古书 196
+向日葵 196
+嘉定 196
+囊中 196
+垢 196
+娃儿 196
+封师古 196
+小巷 196
+小鸟 196
+市值 196
+带入 196
+干涸 196
+心法 196
+心痛 196
+心胸 196
+情侣 196
+戴宗 196
+掓 196
+摩托罗拉 196
+来者 196
+林地 196
+死地 196
+每位 196
+江州 196
+沉痛 196
+沮 196
+海平面 196
+涌动 196
+烛台 196
+焦宛儿 196
+百戏 196
+称霸 196
+笛子 196
+第三者 196
+箭头 196
+纤细 196
+结结巴巴 196
+缭绕 196
+老远 196
+聪明才智 196
+胸鳍 196
+花正芳 196
+草药 196
+董鄂 196
+裂隙 196
+计数 196
+记忆力 196
+讷 196
+诃 196
+调任 196
+跛 196
+轻而易举 196
+辛丑 196
+违约 196
+遍体鳞伤 196
+阻击 196
+陈达海 196
+风寒 196
+饕 196
+上头 195
+不复存在 195
+中国男篮 195
+五日 195
+亥 195
+傣 195
+光环 195
+入住 195
+八哥 195
+兵变 195
+别有用心 195
+制胜 195
+刺耳 195
+効 195
+卯 195
+史事 195
+名门 195
+哂 195
+孙子兵法 195
+宽慰 195
+屋面 195
+山系 195
+并举 195
+庄主 195
+庖 195
+恰巧 195
+情理 195
+抵消 195
+挪用 195
+提纲 195
+收兵 195
+散居 195
+施恩 195
+早先 195
+李顺 195
+枪械 195
+桌边 195
+次于 195
+殊不知 195
+淋漓 195
+渶 195
+滑坡 195
+片断 195
+特派 195
+玄学 195
+目视 195
+看台 195
+禁运 195
+穿孔 195
+等同于 195
+绦 195
+膊 195
+至上 195
+良民 195
+蛮子 195
+血小板 195
+裂谷 195
+豁然 195
+赞比亚 195
+迪士尼 195
+银钱 195
+闪开 195
+阿拉伯半岛 195
+雷雨 195
+驾崩 195
+高婕 195
+高迎祥 195
+一大半 194
+一把抓 194
+一阵风 194
+三国演义 194
+三方 194
+上城 194
+不致于 194
+两汉 194
+中小型 194
+以待 194
+以期 194
+估量 194
+全家人 194
+内疚 194
+分子量 194
+制造厂 194
+劳动量 194
+勘察 194
+化学性质 194
+区间 194
+十几分钟 194
+华裔 194
+厛 194
+受精 194
+名不虚传 194
+员外 194
+咄咄逼人 194
+墙头 194
+大陆性气候 194
+大难 194
+天一 194
+失当 194
+奥尼尔 194
+妒 194
+宁海 194
+工业品 194
+应运而生 194
+引申 194
+懦 194
+我方 194
+戒备森严 194
+撸 194
+攻防 194
+放假 194
+故作 194
+敲响 194
+文化产业 194
+无谓 194
+早起 194
+星期五 194
+星空 194
+木筏 194
+李世民 194
+李公子 194
+条令 194
+林藕初 194
+某部 194
+梵文 194
+殉葬 194
+殷墟 194
+汗珠 194
+湍流 194
+灵堂 194
+王治郅 194
+王牌 194
+现世 194
+琢 194
+画册 194
+瞿 194
+祭器 194
+缓冲 194
+脑壳 194
+脚印 194
+若干年 194
+血战 194
+车尔库 194
+轻敌 194
+输送车 194
+辛辛苦苦 194
+鍕 194
+针锋相对 194
+锦衣 194
+门墙 194
+青霉素 194
+风靡 194
+飘荡 194
+上攻 193
+专业课 193
+专访 193
+中弹 193
+丰美 193
+串联 193
+二等 193
+云头 193
+依从 193
+假象 193
+偏远 193
+全套 193
+农机 193
+减速 193
+南城 193
+台湾海峡 193
+合约 193
+同仇敌忾 193
+品性 193
+唱腔 193
+唱起 193
+器重 193
+四合院 193
+场内 193
+堆满 193
+太平军 193
+失明 193
+奸人 193
+好心 193
+妄为 193
+婉 193
+宽心 193
+对头 193
+封地 193
+小国 193
+开张 193
+张家界 193
+念经 193
+性交 193
+感触 193
+截然 193
+戮 193
+才子 193
+拉丁 193
+拉姆 193
+斩钉截铁 193
+朱丹臣 193
+朱长龄 193
+杂志社 193
+枯燥 193
+残害 193
+段落 193
+沉睡 193
+海信 193
+渭河 193
+滞留 193
+满满 193
+灵气 193
+熊熊 193
+版画 193
+牟春光 193
+王氏 193
+私立 193
+稻草 193
+突兀 193
+窘 193
+粗鲁 193
+粟裕 193
+红润 193
+红灯 193
+维多利亚 193
+老三 193
+臣妾 193
+色谱 193
+英国海军 193
+萨满 193
+蔷 193
+蝎子 193
+讥讽 193
+该团 193
+误导 193
+责任人 193
+赈 193
+起哄 193
+跋涉 193
+跷 193
+连绵 193
+铏 193
+长者 193
+阿尔泰山 193
+陈眉 193
+雉 193
+霉菌 193
+韩小莹 193
+骚 193
+一列 192
+不灵 192
+中山大学 192
+举荐 192
+乱叫 192
+交由 192
+人民战争 192
+住户 192
+体例 192
+侄子 192
+做主 192
+军国主义 192
+劫掠 192
+单性 192
+南巡 192
+南越 192
+吏治 192
+咯咯 192
+善战 192
+喜色 192
+坐坐 192
+墨索里尼 192
+外间 192
+大总统 192
+如常 192
+容颜 192
+峪 192
+巴尔干 192
+带头人 192
+急事 192
+性爱 192
+懵 192
+挨次 192
+未成年人 192
+条纹 192
+歌剧院 192
+武学 192
+每晚 192
+求饶 192
+消退 192
+淮安 192
+濊 192
+濠 192
+物质文明 192
+狠抓 192
+玩玩 192
+璞 192
+白日 192
+皨 192
+瞭望 192
+石榴 192
+秦书田 192
+穿插 192
+签发 192
+繛 192
+结构性 192
+罪状 192
+联动 192
+肯尼迪 192
+肿大 192
+茂盛 192
+趁早 192
+身下 192
+迷雾 192
+逃窜 192
+遗言 192
+酒菜 192
+金融市场 192
+银行业 192
+镰 192
+门缝 192
+闪耀 192
+阀 192
+隽 192
+青山 192
+高太尉 192
+魏学曾 192
+黑马 192
+鼓乐声 192
+一氧化碳 191
+万安 191
+不孝 191
+不意 191
+专长 191
+义士 191
+乱世 191
+云中 191
+代理商 191
+佯 191
+傅宗龙 191
+公祭 191
+其三 191
+养育 191
+冤家 191
+冲向 191
+出战 191
+利剑 191
+劝降 191
+南向 191
+发声 191
+取而代之 191
+可变 191
+吹响 191
+周易 191
+失色 191
+奢 191
+妃嫔 191
+威胁论 191
+存活 191
+屏蔽 191
+巴尔干半岛 191
+带队 191
+并排 191
+异物 191
+异端 191
+径向 191
+报告书 191
+救兵 191
+方剂 191
+方砖 191
+旅顺 191
+时钟 191
+旺季 191
+星等 191
+有步骤 191
+末了 191
+李嘉图 191
+校正 191
+椈 191
+欢心 191
+气急败坏 191
+浑浊 191
+清幽 191
+湍急 191
+溶洞 191
+炽 191
+热火 191
+盘缠 191
+相声 191
+矢量 191
+祁连山 191
+笨重 191
+算不得 191
+紧贴 191
+编辑部 191
+自恃 191
+荷包 191
+董卓 191
+蔷薇 191
+藏语 191
+藩镇 191
+虬髯 191
+血书 191
+补缺 191
+表格 191
+语声 191
+转交 191
+金国 191
+金轮法 191
+金鸡 191
+铡 191
+门卫 191
+阵脚 191
+领带 191
+饥荒 191
+高性能 191
+魄力 191
+鸽 191
+一举一动 190
+一二九 190
+三处 190
+世界纪录 190
+举杯 190
+休想 190
+会展 190
+兵营 190
+内向 190
+剑锋 190
+动情 190
+南朝鲜 190
+印发 190
+叶挺 190
+呵护 190
+喷泉 190
+四句 190
+国君 190
+好玩 190
+巷战 190
+平价 190
+彀 190
+形而上学 190
+忠心耿耿 190
+扑鼻 190
+找回 190
+挥霍 190
+掸 190
+政界 190
+斫 190
+昂然 190
+有违 190
+机舱 190
+样儿 190
+沉闷 190
+消费资料 190
+滑冰 190
+澡 190
+环状 190
+甦醒 190
+百岁 190
+直抵 190
+瞄准镜 190
+硅酸盐 190
+碳水化合物 190
+祖父母 190
+程先生 190
+笈 190
+第四个 190
+索额图 190
+结下 190
+绰 190
+缄默 190
+缩水 190
+老王 190
+老祖宗 190
+自卑 190
+苏醒 190
+萧中慧 190
+西魏 190
+见效 190
+诫 190
+责无旁贷 190
+达尔文 190
+选人 190
+醉心 190
+闳 190
+阜成门 190
+革命派 190
+骨子里 190
+黄埔 190
+一溜 189
+万块 189
+三五成群 189
+不慎 189
+专机 189
+主裁判 189
+佃户 189
+例证 189
+俗语 189
+假意 189
+偷看 189
+党校 189
+公愤 189
+内含 189
+击打 189
+分赃 189
+前移 189
+力不从心 189
+取士 189
+名扬 189
+吞吞吐吐 189
+告发 189
+塞外 189
+夏代 189
+多寡 189
+大婶 189
+大计 189
+守法 189
+定睛 189
+对接 189
+对生 189
+小德子 189
+巴天石 189
+平江 189
+张士诚 189
+想得到 189
+接听 189
+敌视 189
+教职工 189
+数论 189
+整座 189
+新股 189
+无菌 189
+晋察冀军区 189
+机头 189
+杀气腾腾 189
+李师师 189
+松懈 189
+槃 189
+汤加 189
+法新社 189
+灭菌 189
+炉火纯青 189
+煽 189
+熵 189
+特质 189
+独资 189
+珅 189
+班上 189
+皮带 189
+直流电 189
+红梅 189
+给与 189
+缒 189
+美化 189
+花丛 189
+获释 189
+解法 189
+触目惊心 189
+该死 189
+说不上 189
+豁出 189
+象征性 189
+象徵性 189
+轻武器 189
+避孕药 189
+里间 189
+金军 189
+鍂 189
+鐤 189
+集体经济 189
+霍婆子 189
+革职 189
+顶棚 189
+高伯 189
+高唱 189
+魅 189
+一任 188
+世界银行 188
+东段 188
+丢人 188
+企事业 188
+何为 188
+侨民 188
+先导 188
+写字楼 188
+分送 188
+别致 188
+刺痛 188
+升学 188
+变性 188
+吹嘘 188
+嗜好 188
+复方 188
+多只 188
+宝鸡 188
+将校 188
+小罗 188
+居里 188
+常态 188
+开封府 188
+怂恿 188
+怒涛 188
+恩公 188
+恩德 188
+成规 188
+把门 188
+抽身 188
+推介 188
+改任 188
+教条主义 188
+整改 188
+整编 188
+无话可说 188
+昂首 188
+普天之下 188
+朝见 188
+果汁 188
+枝条 188
+枪管 188
+查问 188
+武威 188
+毒虫 188
+水池 188
+求生 188
+波士顿 188
+溶解度 188
+滔滔不绝 188
+烧伤 188
+由来 188
+痛打 188
+盂 188
+直说 188
+矩 188
+种植园 188
+突破性 188
+素描 188
+素日 188
+绁 188
+缉捕 188
+罡 188
+苦衷 188
+荆棘 188
+蒙哥 188
+袷 188
+西医 188
+西红柿 188
+辛辣 188
+辽阳 188
+迫不得已 188
+迫在眉睫 188
+都尉 188
+鉙 188
+鉴赏 188
+长脚 188
+门闩 188
+闲人 188
+阊 188
+领军 188
+高下 188
+黑纱 188
+三宝 187
+上诉 187
+东林 187
+东洋 187
+书名 187
+互联 187
+公会 187
+军兵种 187
+刘裕 187
+十字 187
+反问 187
+吹奏 187
+复合材料 187
+太白 187
+奥斯曼 187
+威尔逊 187
+婿 187
+富庶 187
+寒流 187
+尽忠 187
+巡回 187
+巫师 187
+心切 187
+惑 187
+成其为 187
+招考 187
+挺直 187
+换下 187
+援兵 187
+搭救 187
+攀登 187
+敬献 187
+整形 187
+文天祥 187
+时政 187
+朴刀 187
+枢密使 187
+枯竭 187
+正如 187
+死罪 187
+气动 187
+没落 187
+海啸 187
+烛火 187
+皱眉头 187
+盘踞 187
+真伪 187
+研究者 187
+硝 187
+祭坛 187
+突然袭击 187
+竹筒 187
+第二类 187
+罂粟 187
+罗城 187
+职业病 187
+腼腆 187
+自费 187
+船坞 187
+苏南 187
+葡萄园 187
+虫子 187
+诀窍 187
+轻柔 187
+逊色 187
+途经 187
+酸痛 187
+镇痛 187
+静坐 187
+项链 187
+高斯 187
+黎曼 187
+三叉戟 186
+三支 186
+三部 186
+中国台湾 186
+主产 186
+二个 186
+伟人 186
+何止 186
+光耀 186
+典故 186
+前路 186
+匙 186
+反党 186
+发射管 186
+周镳 186
+坠落 186
+塬 186
+大义 186
+大陆性 186
+奖杯 186
+对白 186
+尊姓 186
+小红 186
+尝到 186
+师法 186
+徃 186
+徐向前 186
+微风 186
+必经之路 186
+打折 186
+探照灯 186
+提心吊胆 186
+收成 186
+无声无息 186
+更进一步 186
+歔 186
+毒死 186
+水井 186
+治水 186
+洪胜海 186
+浮游 186
+浮躁 186
+游坦之 186
+漏斗 186
+片子 186
+瓜果 186
+直射 186
+碍事 186
+第一句 186
+筹备会 186
+粉状 186
+精髓 186
+约合 186
+缔约国 186
+羡 186
+聘礼 186
+肺部 186
+自重 186
+舰只 186
+船厂 186
+良种 186
+衅 186
+衮 186
+西线 186
+起疑 186
+轴承 186
+输液 186
+过期 186
+迷惘 186
+郅 186
+酿 186
+首任 186
+马赫 186
+不亚于 185
+不育 185
+亍 185
+五年计划 185
+人事部 185
+伏击 185
+债务人 185
+偏爱 185
+六项 185
+再者 185
+分界线 185
+切削 185
+制革 185
+参演 185
+司马迁 185
+各个击破 185
+听信 185
+唐通 185
+商州 185
+回城 185
+团圆 185
+团聚 185
+固态 185
+坍塌 185
+坐标系 185
+埠 185
+夺权 185
+如初 185
+安上 185
+对视 185
+尊驾 185
+岳乐 185
+开赛 185
+弹丸 185
+彩图 185
+徊 185
+御制 185
+志气 185
+恸 185
+撤除 185
+教化 185
+新闻稿 185
+无视 185
+明摆着 185
+服气 185
+杨杏 185
+欺压 185
+测距 185
+潜望镜 185
+灵动 185
+炼钢 185
+爱戴 185
+牛津大学 185
+独占 185
+玛利亚 185
+环抱 185
+留待 185
+百余 185
+看上 185
+硬币 185
+礼炮 185
+第一流 185
+第八章 185
+细则 185
+细密 185
+罢手 185
+肠胃 185
+腋下 185
+船身 185
+茄 185
+荀子 185
+菩提 185
+营房 185
+螃蟹 185
+讨价还价 185
+谋取 185
+赈济 185
+起落 185
+趸 185
+路段 185
+身负 185
+辎重 185
+迅 185
+银色 185
+闭关 185
+额度 185
+风范 185
+飞檐 185
+一摊 184
+三分球 184
+三句 184
+不堪设想 184
+东魏 184
+丢脸 184
+为数不多 184
+五十年 184
+交叉点 184
+伤病 184
+体态 184
+使眼色 184
+先民 184
+养子 184
+刘爷 184
+前仆后继 184
+劝解 184
+勒索 184
+卵形 184
+发芽 184
+叾 184
+吃水 184
+吹风会 184
+咎 184
+咖啡馆 184
+商务印书馆 184
+围追 184
+地热 184
+孤零零 184
+孩童 184
+左首 184
+常量 184
+平局 184
+平西王 184
+年事已高 184
+年号 184
+应对 184
+异地 184
+情境 184
+护航 184
+昆曲 184
+杀伤力 184
+李秋水 184
+村干部 184
+极化 184
+极左 184
+林嫂 184
+桅杆 184
+气功 184
+气度 184
+沙场 184
+灌输 184
+独尊 184
+琴声 184
+电波 184
+疲乏 184
+神志 184
+秋风 184
+种菜 184
+称做 184
+纳谏 184
+细胞核 184
+统帅部 184
+缓刑 184
+老天 184
+联合体 184
+自由党 184
+营养素 184
+蓄电池 184
+解剖学 184
+警卫队 184
+说不清楚 184
+赔率 184
+轻描淡写 184
+轻飘飘 184
+辨明 184
+辩证唯物主义 184
+进贡 184
+透析 184
+镗 184
+间距 184
+陈正德 184
+雁雁 184
+雅致 184
+革命性 184
+鼓噪 184
+一命呜呼 183
+一天天 183
+一色 183
+丁霸 183
+下台 183
+中央红军 183
+五色 183
+仗义 183
+伺 183
+使不得 183
+使团 183
+侍立 183
+养心殿 183
+冷冻 183
+冷空气 183
+刘建超 183
+制片厂 183
+前厅 183
+劝慰 183
+办成 183
+包厢 183
+合编 183
+合金钢 183
+售票 183
+士人 183
+奢望 183
+守候 183
+小偷 183
+小分队 183
+州郡 183
+希奇 183
+形貌 183
+徐达 183
+怨气 183
+恶行 183
+惊险 183
+措辞 183
+根治 183
+欧美 183
+武德 183
+水边 183
+求援 183
+注解 183
+洗涤 183
+派驻 183
+济州 183
+深层次 183
+渐次 183
+温水 183
+溺 183
+火气 183
+照常 183
+王府井 183
+瓢 183
+生铁 183
+第一轮 183
+简要 183
+篷 183
+紧凑 183
+紧跟 183
+细分 183
+绝迹 183
+绿叶 183
+置之不理 183
+联合国安理会 183
+设防 183
+诡 183
+诳 183
+说服力 183
+逆流 183
+那晚 183
+铁栅栏 183
+阳子 183
+雅思 183
+顶尖 183
+一年一度 182
+下人 182
+下肢 182
+丑闻 182
+中央研究院 182
+丹东 182
+乔石 182
+二十个 182
+二十里 182
+亏得 182
+亟 182
+交通部 182
+代数学 182
+低迷 182
+作答 182
+入场 182
+共通 182
+出鞘 182
+击破 182
+分布区 182
+办到 182
+卫生院 182
+古生代 182
+周朝 182
+品类 182
+哲学史 182
+喷气式 182
+圈定 182
+基本面 182
+大洞 182
+定远 182
+小孩儿 182
+小溪 182
+崇光 182
+嵩 182
+巨轮 182
+幔 182
+异化 182
+当值 182
+微积分 182
+恚 182
+惊慌失措 182
+惟独 182
+感恩 182
+戴维 182
+支票 182
+斧头 182
+新学 182
+晋冀鲁豫 182
+有损 182
+李达 182
+柩 182
+校舍 182
+横竖 182
+死难 182
+每场 182
+波音公司 182
+泥泞 182
+浮出 182
+湘赣 182
+灰白 182
+甚至于 182
+百合 182
+碎裂 182
+神威 182
+种地 182
+稀薄 182
+策应 182
+簲 182
+红娘 182
+经不起 182
+绑架 182
+绮丽 182
+胃癌 182
+视而不见 182
+读写 182
+象棋 182
+贝宁 182
+败退 182
+贩子 182
+赛区 182
+造假 182
+酒肉 182
+金兵 182
+铐 182
+锻 182
+高强 182
+鳄鱼 182
+一声不响 181
+一辈 181
+下毒手 181
+世面 181
+中原地区 181
+主见 181
+二炮 181
+付海峯 181
+付海峰 181
+仙器 181
+作揖 181
+保健品 181
+六品 181
+内线 181
+写实 181
+冷清 181
+凸显 181
+勾引 181
+北安 181
+医科 181
+十余万 181
+卓玛 181
+原生 181
+古柏 181
+可耻 181
+右岸 181
+吴越 181
+员额 181
+喀斯特 181
+四起 181
+复印 181
+大不了 181
+大凡 181
+大虫 181
+妍 181
+安全感 181
+宣旨 181
+寄存 181
+小李 181
+小腿 181
+屋角 181
+峰顶 181
+怪事 181
+总线 181
+惉 181
+惠普 181
+抽样 181
+撇下 181
+文档 181
+斯图加特 181
+杂乱 181
+李国香 181
+汉高祖 181
+流连忘返 181
+浮桥 181
+深藏 181
+漩涡 181
+灵巧 181
+熊赐履 181
+玄宗 181
+玉帝 181
+男方 181
+畏怯 181
+白孝文 181
+盘膝 181
+看做 181
+离家 181
+第一年 181
+线圈 181
+翘首 181
+翻转 181
+老四 181
+老臣 181
+胰 181
+节律 181
+蟾蜍 181
+血肉 181
+袋子 181
+视作 181
+谈恋爱 181
+贫农 181
+赣南 181
+路透 181
+踏入 181
+退走 181
+邵 181
+郁 181
+酰 181
+酷刑 181
+醯 181
+间歇 181
+雄风 181
+革命者 181
+页岩 181
+食管 181
+驶进 181
+鬼怪 181
+三期 180
+两套 180
+两股 180
+严重者 180
+中华人民共和国中央人民政府 180
+亚当 180
+付之一炬 180
+仰头 180
+侍奉 180
+凌迟 180
+减缓 180
+分值 180
+割让 180
+劳模 180
+北朝 180
+发落 180
+受骗 180
+吊唁 180
+同乡 180
+商机 180
+国子监 180
+基本法 180
+大字报 180
+孙文 180
+审案 180
+宾主 180
+寥寥 180
+对流 180
+嵌入 180
+年轻力壮 180
+归顺 180
+微机 180
+怨言 180
+惊觉 180
+抓获 180
+拜佛 180
+插话 180
+插页 180
+教职员 180
+无序 180
+昼 180
+晴朗 180
+有朝一日 180
+杨博 180
+检察官 180
+橄榄 180
+歉意 180
+毁伤 180
+法郎 180
+洋枪 180
+洪夫人 180
+漂移 180
+父系 180
+物化 180
+琥珀 180
+畅游 180
+皢 180
+看吧 180
+矜 180
+笔名 180
+第四代 180
+策马 180
+纵观 180
+绺 180
+耶律洪基 180
+胆囊 180
+能比 180
+能见度 180
+营帐 180
+衰败 180
+西郊 180
+计谋 180
+论辩 180
+证监会 180
+谊 180
+跨世纪 180
+车道 180
+进谏 180
+逃荒 180
+通晓 180
+通缉令 180
+铁矿石 180
+闹市区 180
+队列 180
+隐含 180
+雄浑 180
+顺理成章 180
+首演 180
+万能 179
+三亚 179
+两城 179
+中国电信 179
+中庸 179
+了不得 179
+于斯 179
+五岁 179
+五条 179
+伤者 179
+低矮 179
+依仗 179
+僚 179
+光头 179
+入股 179
+公主道 179
+军器 179
+击球 179
+列队 179
+务须 179
+卖方 179
+历史学 179
+发麻 179
+同种 179
+呴 179
+哥伦比亚大学 179
+四天 179
+基建 179
+墒 179
+壑 179
+处决 179
+好意 179
+宇航 179
+尺子 179
+岂敢 179
+左冷禅 179
+帮会 179
+应答 179
+引致 179
+患上 179
+慈祥 179
+成仁 179
+抛掷 179
+抢走 179
+护佑 179
+护理液 179
+摄制 179
+擎 179
+敌情 179
+期末 179
+案卷 179
+櫉 179
+沼 179
+泛指 179
+游艇 179
+灯塔 179
+热切 179
+焙 179
+父老 179
+珠穆朗玛峰 179
+痋 179
+白玉 179
+百十 179
+看得见 179
+石像 179
+空空 179
+笠 179
+组件 179
+绷带 179
+老大难 179
+老祖 179
+臀鳍 179
+色调 179
+药剂 179
+蒲团 179
+表率 179
+评奖 179
+赌钱 179
+达尔巴 179
+遵义 179
+酌 179
+酒食 179
+陈伯达 179
+飘飘 179
+驭 179
+黍 179
+龙山文化 179
+三万多 178
+两三天 178
+中央银行 178
+乌拉尔 178
+二十九年 178
+交到 178
+人生观 178
+伶俐 178
+信函 178
+修道 178
+值得一提的是 178
+克里特岛 178
+兵种 178
+内核 178
+内燃机 178
+凹陷 178
+分发 178
+分层 178
+前缘 178
+南征 178
+原由 178
+口才 178
+各行各业 178
+商谈 178
+回流 178
+国泰民安 178
+国耻 178
+地质学家 178
+多千米 178
+大兴 178
+太学 178
+女篮 178
+好孩子 178
+嫌疑人 178
+子嗣 178
+宜于 178
+小艇 178
+屠宰 178
+山村 178
+帇 178
+心悸 178
+性腺 178
+成才 178
+抗氧化剂 178
+捺 178
+撕毁 178
+数十名 178
+整党 178
+旁门 178
+无动于衷 178
+无所畏惧 178
+时下 178
+晌午 178
+有价证券 178
+有机质 178
+本州 178
+李杰 178
+杨春 178
+桐 178
+槛 178
+樱花 178
+止痛 178
+毫毛 178
+法政 178
+泗 178
+流民 178
+流沙 178
+海陆 178
+爱沙尼亚 178
+狻 178
+王锡爵 178
+瘦肉精 178
+百个 178
+相提并论 178
+真谛 178
+睳 178
+瞬时 178
+租佃 178
+笼子 178
+簌簌 178
+糜烂 178
+红霞 178
+聚落 178
+能手 178
+草拟 178
+草草 178
+荷兰队 178
+菠菜 178
+葵 178
+视网膜 178
+让位 178
+轻身 178
+输赢 178
+违章 178
+连发 178
+逆贼 178
+通奸 178
+重者 178
+金秋 178
+长安城 178
+阅兵式 178
+颌 178
+香兰 178
+高楼 178
+鲜活 178
+一败涂地 177
+中国女队 177
+中国日报 177
+乘船 177
+估值 177
+侵扰 177
+保有 177
+俞大猷 177
+倾注 177
+光明磊落 177
+共振 177
+冧 177
+凉水 177
+动听 177
+包揽 177
+千余 177
+古堡 177
+名医 177
+吴新刚 177
+国旅 177
+外用 177
+多层次 177
+大华 177
+孔庙 177
+审判官 177
+客队 177
+家常 177
+射中 177
+小民 177
+属地 177
+布尔什维克 177
+师承 177
+年画 177
+张虎 177
+归根结底 177
+必要劳动 177
+成全 177
+执政者 177
+指南针 177
+摒弃 177
+教练员 177
+旅交会 177
+无妨 177
+暴风雨 177
+曹帅 177
+李铁 177
+楷模 177
+款式 177
+气泡 177
+氢原子 177
+汉墓 177
+沈士柱 177
+沓 177
+法人股 177
+波恩 177
+渗水 177
+港区 177
+烷 177
+焚香 177
+猛兽 177
+畅快 177
+病菌 177
+登门 177
+盾牌 177
+督军 177
+禅宗 177
+私塾 177
+纳税人 177
+缓缓的 177
+置换 177
+老公公 177
+胡言乱语 177
+角楼 177
+言谈 177
+调试 177
+赵世炎 177
+软软 177
+辍 177
+造血 177
+都江堰 177
+里外 177
+镖师 177
+阿尔卡特 177
+难说 177
+预审 177
+颅 177
+高尔基 177
+鹦鹉 177
+龙眼 177
+一千多年 176
+一字排开 176
+专案组 176
+东海岸 176
+主观主义 176
+亚种 176
+低速 176
+偃 176
+僮 176
+元音 176
+充血 176
+净土 176
+创出 176
+利落 176
+加利福尼亚州 176
+卧龙 176
+原型机 176
+去年底 176
+司拉绪 176
+同化 176
+唰 176
+国别 176
+圭亚那 176
+坚持不懈 176
+墨西哥城 176
+士绅 176
+壬 176
+多根 176
+大关 176
+天德 176
+奋不顾身 176
+好几年 176
+婚配 176
+安详 176
+宋元 176
+小娃 176
+少许 176
+尼亚 176
+山水画 176
+差一点 176
+心性 176
+总价值 176
+恩怨 176
+打消 176
+把戏 176
+招降 176
+措置 176
+新意 176
+旂 176
+显示屏 176
+晚生 176
+木兰 176
+本纪 176
+朱先生 176
+朱雀 176
+正中间 176
+气象学 176
+泰山队 176
+海洋性气候 176
+浸润 176
+湿漉漉 176
+灾祸 176
+狙击 176
+玄奘 176
+用血 176
+白垩纪 176
+石狮子 176
+祭文 176
+秘书处 176
+筹码 176
+紧随 176
+红外线 176
+美梦 176
+胡萝卜素 176
+自持 176
+跃迁 176
+辔 176
+过户 176
+邓百川 176
+鄙俗 176
+集中营 176
+高尔夫 176
+龚 176
+三等奖 175
+不景气 175
+东安 175
+两岁 175
+事发 175
+传单 175
+佳作 175
+像样 175
+免职 175
+军粮 175
+几样 175
+剜 175
+加工业 175
+医护 175
+参与者 175
+参议员 175
+双曲拱桥 175
+吉普 175
+咄 175
+基础教育 175
+境遇 175
+复位 175
+外侨 175
+外墙 175
+外省 175
+大老爷 175
+妖道 175
+宫室 175
+小兄弟 175
+崇文门 175
+嶏 175
+工业革命 175
+市井 175
+底子 175
+徒然 175
+戍 175
+护身 175
+收养 175
+敬礼 175
+数尺 175
+整整齐齐 175
+斗篷 175
+新娘子 175
+无害 175
+旧时 175
+旭日 175
+明斯克 175
+明英宗 175
+有种 175
+有缘 175
+朱高煦 175
+林小芳 175
+枰 175
+武夷山 175
+毛骨悚然 175
+汪直 175
+漳 175
+牢骚 175
+独生子女 175
+瑜伽 175
+病症 175
+矫正 175
+科室 175
+租用 175
+称职 175
+老健 175
+自始至终 175
+自打 175
+舟山 175
+苏俄 175
+莫不是 175
+蓟州 175
+西平 175
+触手 175
+评委 175
+试问 175
+贩毒 175
+逾越 175
+郑洁 175
+都行 175
+酰胺 175
+醯胺 175
+采煤 175
+重炮 175
+铺子 175
+霸权主义 175
+革命军 175
+鞠躬 175
+颈椎 175
+额娘 175
+高深 175
+高祖 175
+东江 174
+丧权辱国 174
+乌桓 174
+九重 174
+传译 174
+伤疤 174
+修饰 174
+先知 174
+兰香 174
+冉 174
+凉气 174
+利好 174
+劝谏 174
+加勒比 174
+务农 174
+千千万万 174
+反比 174
+受灾 174
+同济大学 174
+后周 174
+吴国 174
+嘭 174
+回音 174
+大名鼎鼎 174
+天涯 174
+太仓 174
+学武 174
+学究 174
+宽厚 174
+小刀 174
+居室 174
+干警 174
+廓 174
+心律 174
+性功能 174
+总路线 174
+戏弄 174
+手无寸铁 174
+扣留 174
+推想 174
+曳 174
+杭得茶 174
+核对 174
+植物园 174
+水浒传 174
+沃 174
+洲际 174
+海岭 174
+海鲜 174
+渌 174
+温度计 174
+游艺 174
+滕 174
+烟尘 174
+热河 174
+病重 174
+皮开肉绽 174
+目送 174
+眼力 174
+石存山 174
+神通广大 174
+秘笈 174
+端口 174
+绿地 174
+肿块 174
+胸腔 174
+腧穴 174
+自保 174
+自觉性 174
+蕴 174
+诉苦 174
+说唱 174
+豫西 174
+负载 174
+走人 174
+赵匡胤 174
+转告 174
+转接 174
+远见 174
+遵义会议 174
+附录 174
+隋代 174
+预后 174
+香菇 174
+鹗 174
+上届 173
+专属 173
+且不说 173
+世界卫生组织 173
+世道 173
+乌烟瘴气 173
+乡间 173
+交会 173
+以人为本 173
+倒数 173
+假借 173
+冠名 173
+冰雹 173
+利尿 173
+刺中 173
+功用 173
+十六岁 173
+华北平原 173
+卤素 173
+反应物 173
+哭笑不得 173
+国联 173
+大势 173
+大敌 173
+娡 173
+宋文富 173
+将官 173
+小农 173
+居住地 173
+居庸关 173
+居高临下 173
+常胜军 173
+幼体 173
+张炜 173
+影踪 173
+得寸进尺 173
+惨痛 173
+执导 173
+抚顺 173
+抽取 173
+振作 173
+提成 173
+数个 173
+敲击 173
+文说 173
+新德里 173
+无误 173
+末日 173
+柴草 173
+桅 173
+汇总 173
+沧 173
+泥石流 173
+海绵 173
+清河 173
+港人 173
+看守所 173
+磨练 173
+神力 173
+章大郎 173
+笔画 173
+缺水 173
+翻脸 173
+老妈 173
+良策 173
+苔藓 173
+蜜月 173
+观礼 173
+诙谐 173
+话儿 173
+谈及 173
+走投无路 173
+赶赴 173
+车里 173
+连成 173
+酷似 173
+锢 173
+锦绣 173
+闪现 173
+陆战 173
+饮茶 173
+驻京 173
+高科技 173
+黼 173
+齐备 173
+七星 172
+三十岁 172
+上半叶 172
+上成 172
+不争 172
+世界反法西斯战争 172
+中宣部 172
+亿日元 172
+伞盖 172
+俄罗斯联邦 172
+借力 172
+冭 172
+分社 172
+前卫 172
+北极星 172
+匦 172
+千军万马 172
+古兰经 172
+吃力 172
+合二为一 172
+哺 172
+唠叨 172
+唾液 172
+国营企业 172
+大钱 172
+天花板 172
+太平洋战争 172
+奄奄一息 172
+奇人 172
+好气 172
+嫁人 172
+安东尼 172
+小生 172
+尔巴 172
+履带式 172
+屿 172
+年报 172
+弗格森 172
+张闻天 172
+归降 172
+感官 172
+手动 172
+招惹 172
+拟订 172
+挶 172
+擅于 172
+旅长 172
+日均 172
+明快 172
+有线 172
+树龄 172
+棉被 172
+泪痕 172
+涎 172
+猪八戒 172
+甘地 172
+疟疾 172
+直取 172
+相视 172
+破除 172
+硥 172
+秦代 172
+移送 172
+粗略 172
+糖类 172
+绪 172
+群英 172
+胶囊 172
+虼 172
+贸易额 172
+跌宕 172
+车马 172
+辍学 172
+迈向 172
+逻辑学 172
+金融业 172
+锄头 172
+锈 172
+降级 172
+限量 172
+韩宝驹 172
+顾炎武 172
+骑车 172
+一打 171
+上卷 171
+为先 171
+乌龙驹 171
+人民党 171
+代课 171
+八十年 171
+兼营 171
+冬眠 171
+几颗 171
+出众 171
+卢比 171
+发言权 171
+含沙量 171
+呃 171
+园艺 171
+复数 171
+宋公明 171
+宴会厅 171
+寻死 171
+小舟 171
+屏息 171
+巨星 171
+巩 171
+幸灾乐祸 171
+开销 171
+弱小 171
+心肝 171
+忧国忧民 171
+忿怒 171
+怕死 171
+总收入 171
+恭维 171
+悬吊 171
+抄本 171
+抗癌 171
+拐弯 171
+招抚 171
+指派 171
+振幅 171
+挺身 171
+故而 171
+昌平 171
+普京 171
+最低点 171
+机警 171
+材质 171
+来日 171
+梳洗 171
+正像 171
+民选 171
+沦丧 171
+洓 171
+演进 171
+灰烬 171
+灾荒 171
+烧火 171
+照搬 171
+物料 171
+独孤 171
+王元章 171
+王婆 171
+玻尔 171
+相互之间 171
+禁锢 171
+稍加 171
+算不上 171
+红包 171
+编后语 171
+编者 171
+缺憾 171
+罗虎 171
+腹胀 171
+臼 171
+节俭 171
+蒴果 171
+蟆 171
+裁判员 171
+谢天谢地 171
+赟 171
+踞 171
+重逢 171
+钟表 171
+链条 171
+镰刀 171
+靴子 171
+鞋匠 171
+音律 171
+香菱 171
+马歇尔 171
+马虎 171
+三十里 170
+中央大学 170
+主儿 170
+井下 170
+伏侍 170
+先主 170
+兵役 170
+军令状 170
+凭空 170
+医术 170
+十字路口 170
+卓绝 170
+卡片 170
+友善 170
+叮当 170
+同性恋 170
+和睦 170
+哭叫 170
+唱道 170
+啤 170
+器乐 170
+垮台 170
+多路 170
+大卫 170
+大水 170
+大脑皮层 170
+天师 170
+太阳穴 170
+妃子 170
+威势 170
+宋体 170
+工人党 170
+已婚 170
+建军 170
+张国庆 170
+拿手 170
+挺拔 170
+接地 170
+摇曳 170
+撕裂 170
+撹 170
+斟酒 170
+断送 170
+斯特拉 170
+旗人 170
+旷开田 170
+星期日 170
+晋国 170
+李毅 170
+氢弹 170
+氲 170
+沉入 170
+泛起 170
+泥炭 170
+流转 170
+浴血 170
+海流 170
+海轮 170
+涳 170
+淋巴细胞 170
+焊 170
+玉林 170
+生产队 170
+男篮 170
+略略 170
+病态 170
+白眼 170
+石屋 170
+硌 170
+硫磺 170
+祖冲之 170
+程序员 170
+笙歌 170
+笛声 170
+簤 170
+纪实 170
+织机 170
+编撰 170
+罗盘 170
+脖颈 170
+腰部 170
+腺体 170
+自足 170
+舷 170
+莅临 170
+萃取 170
+蒙面 170
+蕊 170
+蚧 170
+血症 170
+表白 170
+费解 170
+走时 170
+远景 170
+通例 170
+酒后 170
+重地 170
+鑸 170
+闭门 170
+阻断 170
+香港特区 170
+马超 170
+驰骋 170
+骆清 170
+魔术 170
+一两天 169
+一案 169
+万寿 169
+世宗 169
+乌斯 169
+九门 169
+产业工人 169
+亲吻 169
+佃农 169
+何妨 169
+佳节 169
+侨胞 169
+元宵 169
+先河 169
+冀州 169
+冮 169
+冲刷 169
+冷风 169
+分析法 169
+分段 169
+刑事诉讼法 169
+动心 169
+叫醒 169
+后辈 169
+吴六奇 169
+周延儒 169
+啾 169
+四川盆地 169
+围栏 169
+圆盘 169
+地铺 169
+夏秋 169
+家眷 169
+小行星 169
+少不得 169
+山坳 169
+山崖 169
+峨眉山 169
+常理 169
+常见于 169
+弗吉尼亚 169
+张璁 169
+弹琴 169
+归档 169
+待见 169
+恶战 169
+懈 169
+按劳分配 169
+掌控 169
+掑 169
+撼 169
+曦 169
+木梁 169
+李哥 169
+极性 169
+柔嘉 169
+查尔斯 169
+栈 169
+梅剑 169
+梳头 169
+楼盘 169
+榴 169
+歌舞团 169
+清淡 169
+犯法 169
+狐疑 169
+病程 169
+痛骂 169
+登月 169
+盼儿 169
+祭礼 169
+科学性 169
+筹委会 169
+管辖权 169
+红衣 169
+肉食 169
+臣服 169
+节后 169
+若无其事 169
+荞麦 169
+菜单 169
+角球 169
+记性 169
+诚挚 169
+诛杀 169
+谬 169
+转马 169
+软体动物 169
+轻举妄动 169
+辕门 169
+追查 169
+采石 169
+里特 169
+金文 169
+难以置信 169
+鞍山 169
+魑 169
+鸡肉 169
+一元 168
+东进 168
+两招 168
+中医药 168
+中锋 168
+余孽 168
+全日制 168
+剥削阶级 168
+医用 168
+十三大 168
+厷 168
+去势 168
+变招 168
+口渴 168
+合围 168
+同盟国 168
+哀悼 168
+喜酒 168
+圆明园 168
+地幔 168
+埃里克森 168
+塞内加尔 168
+处事 168
+外销 168
+大校 168
+天人 168
+姿式 168
+孟冲 168
+实证 168
+小昭 168
+小西 168
+山花 168
+嵈 168
+工科 168
+工艺美术 168
+布衣 168
+康宁 168
+建文帝 168
+张永 168
+张鲸 168
+心道 168
+慕容氏 168
+懦弱 168
+战阵 168
+手铐 168
+担纲 168
+掩映 168
+斑纹 168
+无端 168
+映射 168
+有形 168
+木构 168
+木炭 168
+本名 168
+机敏 168
+杜鲁门 168
+松动 168
+林场 168
+正方 168
+正规军 168
+死神 168
+比值 168
+汉学 168
+流光溢彩 168
+涜 168
+涣 168
+渔猎 168
+滑行 168
+潞 168
+球状 168
+畹 168
+瘸 168
+益州 168
+神剑 168
+稀缺 168
+稿件 168
+章法 168
+粗犷 168
+络绎不绝 168
+能工巧匠 168
+脸谱 168
+臭味 168
+节节败退 168
+苍茫 168
+衡阳 168
+装入 168
+西街 168
+解放前 168
+謇 168
+记下 168
+货舱 168
+贵客 168
+过头 168
+道旁 168
+酒宴 168
+鎽 168
+铁道 168
+镜片 168
+障碍物 168
+预留 168
+驻防 168
+鲁有脚 168
+鸦雀无声 168
+鹫 168
+不依 167
+专业性 167
+两扇 167
+乱说 167
+传染性 167
+伸向 167
+信阳 167
+先进性 167
+党内外 167
+兵家 167
+内需 167
+冲绳 167
+分级 167
+刘彻 167
+华东野战军 167
+参量 167
+合体 167
+因地制宜 167
+土特产品 167
+墨西哥湾 167
+夸克 167
+客源 167
+岸线 167
+崇文 167
+平手 167
+平阳 167
+并发 167
+幸得 167
+当红 167
+徐宁 167
+总司令部 167
+悠扬 167
+截面 167
+托付 167
+拷打 167
+捏造 167
+换人 167
+推卸 167
+摇摇 167
+擎天 167
+收容 167
+敖 167
+数倍 167
+旋即 167
+旧日 167
+景气 167
+暗喜 167
+服色 167
+李伟 167
+松下 167
+柳敬亭 167
+格律 167
+欧罗巴人种 167
+武清伯 167
+汪延 167
+洋楼 167
+洹 167
+活命 167
+洽谈 167
+海棠 167
+深浅 167
+猎鹰 167
+琏 167
+用餐 167
+眼花缭乱 167
+碎石 167
+稍后 167
+答辩 167
+算数 167
+紧要 167
+统计学 167
+缥缈 167
+肝病 167
+自救 167
+莆 167
+补课 167
+见证人 167
+诸王 167
+责罚 167
+费城 167
+赦免 167
+赵钱孙 167
+车窗 167
+轻快 167
+轻率 167
+追捧 167
+通体 167
+长工 167
+门帘 167
+问答 167
+闹腾 167
+阵风 167
+颏 167
+飘逸 167
+高峯期 167
+高峰期 167
+三枝 166
+下身 166
+中上游 166
+中奖 166
+中正 166
+中青年 166
+二氧化硫 166
+信用社 166
+内壁 166
+出院 166
+击出 166
+划入 166
+十二大 166
+卷子 166
+口舌 166
+困苦 166
+国民军 166
+圆柱形 166
+圣诞节 166
+块状 166
+大蒜 166
+天道 166
+夭 166
+女家 166
+安好 166
+宋家寨 166
+尊师 166
+小道 166
+小院 166
+展会 166
+工效 166
+幸免 166
+开店 166
+感光 166
+才干 166
+才智 166
+扎扎实实 166
+扰动 166
+招贤 166
+指摘 166
+提审 166
+放箭 166
+救护车 166
+施工队 166
+施舍 166
+旅行者 166
+时间表 166
+是夜 166
+晋见 166
+最远 166
+李光昭 166
+来宾 166
+梁冰玉 166
+槟榔 166
+残骸 166
+水灾 166
+汽 166
+活化 166
+涝 166
+淮北 166
+湘江 166
+濉 166
+灰白色 166
+炫 166
+焕发 166
+犀利 166
+玉檀 166
+电话机 166
+痢 166
+直译 166
+相联 166
+硒 166
+碗筷 166
+空心 166
+穿衣 166
+第六届 166
+筫 166
+红儿 166
+罗秀竹 166
+耦合 166
+藓 166
+血淋淋 166
+血肉横飞 166
+血脉 166
+觊觎 166
+谴 166
+贴切 166
+超人 166
+转世 166
+轻便 166
+重金 166
+钢梁 166
+铀矿 166
+问卷 166
+阴性 166
+雅鲁藏布江 166
+雨来 166
+韧 166
+飞利浦 166
+馅 166
+马尼拉 166
+高通 166
+一目了然 165
+一目瞭然 165
+一道道 165
+不降 165
+中坚 165
+临汾 165
+争霸 165
+云云 165
+份儿 165
+佣人 165
+六七万 165
+内伤 165
+决算 165
+出水 165
+切勿 165
+北大西洋 165
+博爱 165
+印制 165
+另类 165
+吴之荣 165
+和善 165
+咒语 165
+唢呐 165
+唾 165
+喜马拉雅 165
+嚣张 165
+圣诞 165
+如愿以偿 165
+宣和 165
+尼斯 165
+展品 165
+山壁 165
+巡警 165
+巷子 165
+平均值 165
+平直 165
+并重 165
+张掖 165
+彨 165
+待会儿 165
+思忖 165
+憿 165
+抗清 165
+抛开 165
+挑水 165
+推敲 165
+收益率 165
+是否是 165
+本位 165
+李飞黄 165
+杨士奇 165
+析出 165
+柴火 165
+栭 165
+榆林 165
+樊城 165
+正蓝旗 165
+水滴 165
+没准 165
+河面 165
+泸州 165
+游说 165
+潜意识 165
+炆 165
+炼油厂 165
+版面 165
+犹太教 165
+王吉元 165
+王族 165
+疏浚 165
+病倒 165
+病故 165
+痣 165
+白羊 165
+磺 165
+祭奠 165
+禀明 165
+笨拙 165
+第一架 165
+第一艘 165
+篡 165
+粉刷 165
+纾 165
+老家伙 165
+肝癌 165
+脱贫 165
+臂膀 165
+船员 165
+药厂 165
+营业执照 165
+落马 165
+蜕 165
+血红 165
+西安市 165
+觥 165
+许配 165
+论及 165
+该病 165
+诸侯国 165
+调侃 165
+贾蓉 165
+车型 165
+迈步 165
+这么着 165
+远祖 165
+追述 165
+送信 165
+通则 165
+酋 165
+酸碱 165
+铁板 165
+长啸 165
+驳斥 165
+骑射 165
+高音 165
+魏国 165
+鹪 165
+麻绳 165
+一两年 164
+三四个 164
+不可或缺 164
+不折不扣 164
+丝线 164
+中国社会科学院 164
+中长期 164
+传播者 164
+体谅 164
+佳人 164
+倒计时 164
+准确性 164
+到来之际 164
+包办 164
+南风 164
+合法性 164
+壁垒 164
+天平 164
+天时 164
+奉养 164
+女足 164
+奶子 164
+姑爷 164
+对数 164
+封顶 164
+康亲王 164
+开山 164
+当政 164
+心不在焉 164
+忘不了 164
+恳切 164
+房租 164
+放学 164
+文艺工作者 164
+暴涨 164
+替身 164
+李双喜 164
+查获 164
+树根 164
+棿 164
+正规化 164
+死死 164
+殷天正 164
+毛利率 164
+水墨 164
+水桶 164
+淑女 164
+渊博 164
+漫漫 164
+灿 164
+爽朗 164
+献血 164
+獐 164
+生擒 164
+田亩 164
+疗伤 164
+盈余 164
+监护人 164
+盖章 164
+看管 164
+瞥见 164
+秃头 164
+空想 164
+竭尽全力 164
+篝火 164
+类群 164
+精微 164
+累累 164
+结石 164
+舰长 164
+芷 164
+被子植物 164
+譥 164
+评述 164
+诊所 164
+译员 164
+语录 164
+诸神 164
+调停 164
+豪爽 164
+购买力 164
+赛迪网 164
+跑步 164
+遗诏 164
+针线 164
+钤 164
+银幕 164
+闭锁 164
+陪审 164
+青菜 164
+青龙 164
+音色 164
+风头 164
+飘扬 164
+上流 163
+不怀好意 163
+世世代代 163
+东暖阁 163
+中秋 163
+买家 163
+五粮液 163
+些许 163
+亵渎 163
+人造卫星 163
+优种 163
+低垂 163
+保守党 163
+偷听 163
+党羽 163
+共青团 163
+农林 163
+劳动模范 163
+北京政府 163
+十余里 163
+协和 163
+卢梭 163
+句句 163
+叶利钦 163
+吮 163
+四根 163
+国策 163
+塔吉克斯坦 163
+奔流 163
+如云 163
+嫖 163
+寨里 163
+封号 163
+弘 163
+张松溪 163
+恐吓 163
+戊 163
+扁担 163
+拉丁语 163
+拿走 163
+捱 163
+擒获 163
+时事 163
+暧昧 163
+杀伤性 163
+梁亦清 163
+梾 163
+欤 163
+死命 163
+死期 163
+民居 163
+源流 163
+满满的 163
+疏忽大意 163
+疤痕 163
+疼爱 163
+等级制 163
+精干 163
+精灵 163
+纷繁 163
+给养 163
+罗伯特 163
+罗布泊 163
+花序 163
+苏伊士运河 163
+药价 163
+萌 163
+蓦然 163
+蔚 163
+诠 163
+赘 163
+赶制 163
+躲藏 163
+遥遥 163
+遮蔽 163
+重重地 163
+铎 163
+铺天盖地 163
+防渗 163
+降龙十八掌 163
+陶菲克 163
+隔阂 163
+霍都 163
+高差 163
+高教 163
+黑子 163
+黑熊 163
+七分 162
+两台 162
+主要矛盾 162
+争奇斗艳 162
+人儿 162
+令狐冲笑 162
+价值规律 162
+伯罗奔尼撒 162
+伺机 162
+何来 162
+供需 162
+信息中心 162
+儿时 162
+关宁 162
+关相云 162
+包子 162
+十五岁 162
+南极洲 162
+反差 162
+口号声 162
+司令官 162
+吉凶 162
+商贩 162
+图样 162
+大肠 162
+失控 162
+女单 162
+嫣然一笑 162
+小刘 162
+少数几个 162
+巧匠 162
+常温 162
+库克 162
+张国荣 162
+当机立断 162
+录制 162
+律例 162
+得益 162
+总的来说 162
+总领事 162
+恰似 162
+慈禧太 162
+手绢 162
+折射率 162
+拉丁字母 162
+捉弄 162
+搞定 162
+救火 162
+救生 162
+文艺界 162
+早报 162
+有变 162
+李侔 162
+枌 162
+枪手 162
+核酸 162
+格子 162
+欧阳修 162
+母鸡 162
+毒害 162
+法文 162
+浅滩 162
+浮式 162
+海豚 162
+深圳队 162
+清爽 162
+澜沧江 162
+点名 162
+熙宁 162
+牛津 162
+特等 162
+特长生 162
+狗日 162
+现名 162
+玲 162
+瓷瓶 162
+疾风 162
+痒痒 162
+盐湖 162
+稀土 162
+窦美仪 162
+类人 162
+耆 162
+耐寒 162
+职业道德 162
+肉制品 162
+腐化 162
+苦楚 162
+莉 162
+萨沙 162
+虚心 162
+货币量 162
+资治通鉴 162
+辛酸 162
+连线 162
+郭样 162
+锦江 162
+陌 162
+隐退 162
+难以想象 162
+难熬 162
+饮水 162
+驰援 162
+黑社会 162
+齐放 162
+丁敏君 161
+下行 161
+不减 161
+云层 161
+伙食 161
+会员国 161
+住持 161
+依存 161
+信息安全 161
+偈 161
+充饥 161
+军旅 161
+农奴制 161
+冷冰冰 161
+凄惨 161
+刘子政 161
+前额 161
+剑鞘 161
+加成 161
+勘查 161
+卡尔 161
+发型 161
+吉达 161
+吴金贵 161
+咨 161
+喧闹 161
+嗫嚅 161
+园里 161
+地理学家 161
+多则 161
+太师椅 161
+失修 161
+寒武纪 161
+寡人 161
+小节 161
+巨浪 161
+幙 161
+异构体 161
+微薄 161
+怒潮 161
+怪罪 161
+情急之下 161
+惊愕 161
+憎 161
+拘谨 161
+收割 161
+斐济 161
+旌 161
+期内 161
+样车 161
+母系 161
+汹涌澎湃 161
+浓密 161
+游泳池 161
+滚烫 161
+满天 161
+烯 161
+熬夜 161
+玄机 161
+王小波 161
+电子学 161
+石亨 161
+矿体 161
+祖庙 161
+租借地 161
+箯 161
+红四方面军 161
+红木 161
+结伴 161
+罚球 161
+翻看 161
+聆听 161
+脂粉 161
+营业额 161
+蒿草 161
+衬托 161
+袁营 161
+计入 161
+计时 161
+诡秘 161
+该部 161
+超级大国 161
+跨入 161
+转业 161
+轭 161
+软化 161
+软骨 161
+辱骂 161
+退守 161
+通政 161
+铠甲 161
+长辛店 161
+阻滞 161
+陈芳 161
+险情 161
+需用 161
+面料 161
+面谈 161
+须要 161
+飞弹 161
+飞驰 161
+食物中毒 161
+馨 161
+高雄 161
+三场 160
+三轮车 160
+不怕死 160
+东街 160
+中篇小说 160
+京沪 160
+仙子 160
+俪 160
+免受 160
+党政机关 160
+兽类 160
+军事基地 160
+决裂 160
+分毫 160
+划破 160
+别动 160
+剖面 160
+动物群 160
+勾勒 160
+卫生局 160
+即兴 160
+发白 160
+史家 160
+吸气 160
+哥斯达黎加 160
+四十岁 160
+回信 160
+国歌 160
+圈地 160
+埃伏尔 160
+外套 160
+小赵 160
+小静 160
+崩塌 160
+引擎 160
+张力 160
+徐有贞 160
+必需品 160
+惆怅 160
+憩息 160
+懊恼 160
+扎根 160
+投保 160
+探视 160
+攒动 160
+方程式 160
+时段 160
+有志于 160
+格格 160
+桃根 160
+梁柱 160
+武丁 160
+沙龙 160
+油炸 160
+油菜籽 160
+法家 160
+活该 160
+浸染 160
+点球 160
+煚 160
+爱琴海 160
+狄欧尼 160
+甘草 160
+相思 160
+眼珠子 160
+神经病 160
+票子 160
+穆人清 160
+第一篇 160
+简朴 160
+纵使 160
+纹丝不动 160
+花絮 160
+苏共 160
+苗条 160
+袁冠南 160
+西施 160
+讥笑 160
+超声波 160
+跨进 160
+连通 160
+迫近 160
+通明 160
+邪气 160
+鑺 160
+闩 160
+问明 160
+阳极 160
+陈小炮 160
+隐匿 160
+飞天 160
+首尾 160
+騗 160
+驹 160
+高官厚禄 160
+黑沉沉 160
+黑红 160
+黑黝黝 160
+黯淡 160
+三四千 159
+上溯 159
+下毒 159
+东单 159
+东站 159
+义门 159
+乐章 159
+二成 159
+亏了 159
+从头到尾 159
+付费 159
+众口一词 159
+供货 159
+假想 159
+八级 159
+出界 159
+别管 159
+前述 159
+包惜弱 159
+卖场 159
+厦门队 159
+同仁 159
+四道 159
+国旗班 159
+大夏 159
+套路 159
+奠基 159
+娃子 159
+孤身 159
+官服 159
+小包 159
+居住区 159
+岣 159
+弊病 159
+张自烈 159
+当心 159
+志向 159
+忧患 159
+恋情 159
+惨死 159
+房贷 159
+找上门 159
+探险家 159
+敱 159
+斛 159
+李友 159
+李强 159
+杠 159
+棋局 159
+殴 159
+沏 159
+浅薄 159
+浏览器 159
+演算 159
+物色 159
+犀牛 159
+狱卒 159
+献上 159
+瓑 159
+瓦罗加 159
+生产商 159
+生产国 159
+看透 159
+着装 159
+瞪视 159
+碳酸盐 159
+示众 159
+秆 159
+箭楼 159
+粮饷 159
+给钱 159
+网易 159
+翻翻 159
+老翁 159
+范尼 159
+荒野 159
+落花流水 159
+衣冠楚楚 159
+袜 159
+轰鸣 159
+通婚 159
+酒精灯 159
+铜陵 159
+销售量 159
+闰 159
+间或 159
+雄心 159
+顺差 159
+预赛 159
+高伯年 159
+一无所有 158
+严重性 158
+中国空军 158
+中非 158
+临行 158
+乌干达 158
+九鼎 158
+云板 158
+享誉 158
+仙草 158
+佛寺 158
+依稀可见 158
+保镖 158
+先发制人 158
+光阴 158
+免遭 158
+八路 158
+几倍 158
+击穿 158
+刀刃 158
+功利 158
+反剪 158
+台州 158
+吆 158
+名列榜首 158
+吐血 158
+嚓 158
+回民 158
+地平线 158
+埆 158
+基底 158
+处所 158
+外伤 158
+大栅栏 158
+天朝 158
+实况 158
+客堂 158
+客官 158
+封疆 158
+小册子 158
+尼奥 158
+岫 158
+峇 158
+左眼 158
+巴林 158
+帙 158
+应战 158
+异国 158
+归功 158
+征服者 158
+得意洋洋 158
+心智 158
+恶魔 158
+成问题 158
+抒情诗 158
+拐子 158
+搜刮 158
+摆好 158
+摩托化 158
+摸不着头脑 158
+攘外必先安内 158
+收编 158
+攻击型 158
+数十丈 158
+旓 158
+曟 158
+期望值 158
+条石 158
+松鼠 158
+柔弱 158
+柺子 158
+栓 158
+此等 158
+民主化 158
+泪流满面 158
+浩大 158
+消逝 158
+满汉 158
+激增 158
+炷 158
+片状 158
+画风 158
+留言 158
+白花花 158
+眼科 158
+瞿秋白 158
+碉堡 158
+示弱 158
+私下里 158
+私产 158
+窥探 158
+笆 158
+第一类 158
+纷乱 158
+编者按 158
+胆汁 158
+脸部 158
+艰 158
+药品监督管理局 158
+蓝牙 158
+虱 158
+诸子 158
+调子 158
+购销 158
+赵紫阳 158
+身居 158
+远征军 158
+遇险 158
+部级 158
+韩国队 158
+飞溅 158
+高傲 158
+一局 157
+一盒 157
+不可分割 157
+不靠 157
+专司 157
+乡土 157
+二维 157
+亲昵 157
+会通 157
+余名 157
+八十 157
+兴兵 157
+分辩 157
+剂型 157
+前敌 157
+劳苦 157
+十届 157
+厚实 157
+口令 157
+叫苦 157
+吴立身 157
+嗵 157
+团伙 157
+坚贞不屈 157
+大儿子 157
+安道尔 157
+宜宾 157
+密云 157
+尊崇 157
+小儿子 157
+少奶奶 157
+巅 157
+左路 157
+异姓 157
+心中有数 157
+快艇 157
+惶急 157
+意味深长 157
+感染力 157
+慧剑 157
+战列舰 157
+捕杀 157
+掌中 157
+摇摆 157
+撕开 157
+收受 157
+敤 157
+无头 157
+暗地里 157
+有效期 157
+木乃伊 157
+木偶 157
+木卓伦 157
+本病 157
+朱允 157
+李金羽 157
+村坊 157
+村镇 157
+林乐丰 157
+校尉 157
+歌德 157
+歹毒 157
+泌尿 157
+浙江大学 157
+海洋生物 157
+海潮 157
+清扫 157
+满头大汗 157
+炮响 157
+点卯 157
+烙印 157
+生殖器 157
+用笔 157
+畜产品 157
+瘦弱 157
+直截了当 157
+相位 157
+真言 157
+石中玉 157
+破例 157
+笋 157
+第八届 157
+细胞壁 157
+罄 157
+胜利者 157
+腰刀 157
+落水 157
+蝗虫 157
+行不通 157
+表姐 157
+衰减 157
+被害 157
+被调查者 157
+解密 157
+誊写 157
+训诂 157
+跟不上 157
+转子 157
+追捕 157
+逊尼派 157
+重振 157
+野马 157
+钟楼 157
+顶替 157
+驾到 157
+骏 157
+鱼虾 157
+鸣枪 157
+鸿才 157
+黝黑 157
+鼋 157
+一程 156
+专卖店 156
+丰田 156
+了事 156
+互换 156
+任总 156
+传中 156
+低端 156
+倍增 156
+倏忽 156
+光闪闪 156
+兖州 156
+准考证 156
+出错 156
+劲旅 156
+参拜 156
+合格率 156
+咔嚓 156
+国家教委 156
+圪 156
+大族 156
+奎 156
+奖赏 156
+娇嫩 156
+孨 156
+安西 156
+峭 156
+巅峰 156
+年岁 156
+年表 156
+床沿 156
+征途 156
+急急忙忙 156
+悦耳 156
+扮作 156
+拉脱维亚 156
+拜倒 156
+控制器 156
+散热 156
+方方面面 156
+方阵 156
+施放 156
+暴虐 156
+未眠 156
+来意 156
+杭州市 156
+树梢 156
+根深蒂固 156
+桥头 156
+欧佩克 156
+歹徒 156
+水利枢纽 156
+沸沸扬扬 156
+测出 156
+湖岸 156
+溃散 156
+煇 156
+王保保 156
+电灯泡 156
+疆土 156
+痘 156
+相约 156
+眼窝 156
+短文 156
+离休 156
+科特迪瓦 156
+立意 156
+第二件 156
+糜 156
+花边 156
+苏黎世 156
+茅盾 156
+萨达姆 156
+蒙古人种 156
+蛆 156
+详述 156
+贫下中农 156
+赞许 156
+路易 156
+这根 156
+道袍 156
+郭大侠 156
+酒会 156
+采矿业 156
+钦差大臣 156
+锗 156
+防虫 156
+集贸市场 156
+雪妍 156
+风吹日晒 156
+一次次 155
+一里 155
+丁启 155
+万国 155
+上缴 155
+下榻 155
+下流 155
+中产 155
+中新社 155
+举重 155
+九成 155
+享乐 155
+京杭运河 155
+企求 155
+伤残 155
+体委 155
+信箱 155
+党项 155
+养病 155
+凄 155
+几座 155
+刀具 155
+刀锋 155
+刈 155
+初具规模 155
+利禄 155
+努尔 155
+北戴河 155
+南开 155
+历史唯物主义 155
+厍 155
+口齿 155
+吞食 155
+吴用 155
+嘴脸 155
+垂泪 155
+塞浦路斯 155
+外延 155
+多伦多 155
+多普勒 155
+寤 155
+对症 155
+尊称 155
+小狗 155
+展览馆 155
+山腰 155
+岁数 155
+幌子 155
+废黜 155
+心口 155
+志在 155
+意甲 155
+执政官 155
+拉拉 155
+拘束 155
+拦腰 155
+拼装 155
+效法 155
+教育家 155
+斯德哥尔摩 155
+日隆 155
+昏昏沉沉 155
+晋察冀 155
+曹魏 155
+权证 155
+杯酒 155
+枪托 155
+栈道 155
+概率论 155
+槝 155
+比降 155
+氯化 155
+江岸 155
+沉积岩 155
+油箱 155
+沿路 155
+流逝 155
+浆果 155
+涑 155
+混血种人 155
+渔村 155
+渗透压 155
+潟 155
+烤烟 155
+父兄 155
+独奏 155
+猎头公司 155
+电价 155
+电位 155
+畴 155
+白居易 155
+白面 155
+白麪 155
+睹 155
+礼数 155
+祈 155
+神殿 155
+租借 155
+竟自 155
+绝伦 155
+美索不达米亚 155
+羞耻 155
+肃然 155
+胶片 155
+脉络 155
+艺术团 155
+芍药 155
+草堂 155
+虔 155
+西北角 155
+西服 155
+解珍 155
+设计图 155
+评分 155
+诵经 155
+购进 155
+费心 155
+转悠 155
+辨析 155
+进水 155
+远航 155
+迷路 155
+遮盖 155
+部长会议 155
+野花 155
+钱粮 155
+铁塔 155
+锹 155
+门牌 155
+闪存 155
+阴山 155
+青砖 155
+静听 155
+黄金水道 155
+东郊 154
+两遍 154
+今人 154
+仛 154
+企鹅 154
+余公里 154
+作主 154
+使领馆 154
+全胜 154
+八点 154
+写字 154
+刘唐 154
+十两 154
+十几条 154
+半透明 154
+单子 154
+右路 154
+吞咽 154
+呦 154
+咽下 154
+哥儿 154
+回购 154
+团体赛 154
+圣僧 154
+多发 154
+大员 154
+好战 154
+威尔士 154
+存入 154
+孙仲君 154
+宝星 154
+家事 154
+尼格罗 154
+屹立 154
+希罕 154
+帐子 154
+幸存 154
+当铺 154
+成法 154
+拼凑 154
+排尿 154
+握有 154
+摊位 154
+救活 154
+文笔 154
+有别于 154
+木箱 154
+棕榈 154
+横梁 154
+正常化 154
+正道 154
+残杀 154
+段时间 154
+毯 154
+气化 154
+沙沙 154
+沦落 154
+河川 154
+法兰克 154
+浪花 154
+浴室 154
+消解 154
+深奥 154
+港币 154
+游船 154
+满分 154
+演唱会 154
+濋 154
+燕麦 154
+爪子 154
+珠江三角洲 154
+瑗 154
+甘休 154
+疗养 154
+病害 154
+短程 154
+砍伐 154
+窃听 154
+绝壁 154
+绲 154
+老朽 154
+脚底 154
+自信心 154
+药王 154
+荼 154
+落败 154
+表象 154
+装饰品 154
+评比 154
+货款 154
+足球队 154
+踢球 154
+躬身行礼 154
+连珠 154
+连贯 154
+通信卫星 154
+造影 154
+那不勒斯 154
+邵时信 154
+铳 154
+闵子华 154
+随机应变 154
+青壮年 154
+顺畅 154
+预选 154
+驾驶舱 154
+骁骑 154
+黏 154
+鼻息 154
+一年生 153
+万千 153
+不起眼 153
+不锈钢 153
+世界杯赛 153
+东线 153
+中关村 153
+乐声 153
+交感神经 153
+传神 153
+便携式 153
+俄语 153
+信条 153
+偎 153
+僧众 153
+入口处 153
+八路军总部 153
+公章 153
+决口 153
+冷杉 153
+净空 153
+几类 153
+切尔西 153
+切忌 153
+刘玉翠 153
+半尺 153
+南缘 153
+反悔 153
+只许 153
+哈佛 153
+喷嚏 153
+囊括 153
+坐车 153
+基质 153
+壕沟 153
+大四 153
+太阳光 153
+奠 153
+女将 153
+妒忌 153
+子粒 153
+孰不可忍 153
+宝盒 153
+寄生虫 153
+富家 153
+巨资 153
+带子 153
+干练 153
+库房 153
+开导 153
+徐治功 153
+心虚 153
+急于求成 153
+成套 153
+戦 153
+打印机 153
+持卡人 153
+挚 153
+挪移 153
+搬进 153
+无碍 153
+日见 153
+时局 153
+旷古 153
+更年期 153
+本法 153
+柿子 153
+核力量 153
+橐 153
+残缺 153
+沂 153
+沙地 153
+洼 153
+琐碎 153
+町 153
+盘龙 153
+直肠 153
+看不起 153
+秦皇岛 153
+竹筏 153
+繁育 153
+缨 153
+臭氧 153
+至死 153
+舐 153
+艳遇 153
+茶道 153
+虚幻 153
+被盗 153
+诗体 153
+谢罪 153
+豁达 153
+贿 153
+赴宴 153
+赵隆 153
+起事 153
+通畅 153
+郝一标 153
+金发 153
+金奖 153
+银币 153
+闭眼 153
+闻名于世 153
+闾 153
+陆战队 153
+随处可见 153
+非洲人 153
+革委会 153
+香港特别行政区 153
+高招 153
+高点 153
+黑板 153
+黑虎星 153
+一户 152
+一桶 152
+三十一年 152
+三四万 152
+不值 152
+专横跋扈 152
+两分 152
+两辆 152
+丹墀 152
+书刊 152
+五花八门 152
+人均收入 152
+仇敌 152
+伎俩 152
+体坛 152
+停车场 152
+克星 152
+共产 152
+军报 152
+决胜 152
+划船 152
+初二 152
+初生 152
+包容 152
+十二届 152
+协议书 152
+卓天雄 152
+卖弄 152
+博古 152
+同属 152
+后肢 152
+嘀 152
+土质 152
+基业 152
+大中学校 152
+大队人马 152
+存货 152
+官僚资本 152
+宠信 152
+家用 152
+密使 152
+小径 152
+山城 152
+市政厅 152
+异族 152
+往昔 152
+待客 152
+心惊肉跳 152
+怒江 152
+悲凉 152
+愁眉苦脸 152
+打假 152
+扫射 152
+挑拨 152
+收尾 152
+无法无天 152
+无计可施 152
+显眼 152
+木船 152
+构造运动 152
+榨取 152
+此役 152
+气焰 152
+水气 152
+沉醉 152
+法商 152
+波黑 152
+深不可测 152
+满嘴 152
+滴水 152
+火腿 152
+父王 152
+狗屁 152
+瘦小 152
+眼睑 152
+瞌睡 152
+矮墙 152
+祸患 152
+福拉多 152
+空话 152
+篮板球 152
+绋 152
+经销 152
+聘任 152
+胄 152
+胸有成竹 152
+能带 152
+膻 152
+自缢 152
+草书 152
+虐 152
+血吸虫病 152
+衣领 152
+裁断 152
+讨债 152
+诸葛 152
+起程 152
+进关 152
+选入 152
+通行证 152
+酒量 152
+野兔 152
+长效 152
+鞭毛 152
+飘然 152
+鱿 152
+麦克斯韦 152
+一番话 151
+不得而知 151
+不正之风 151
+东道主 151
+五六千 151
+交集 151
+人工智能 151
+以太 151
+何物 151
+侯通海 151
+全班 151
+六门 151
+关键字 151
+兴奋剂 151
+农工 151
+冤假错案 151
+决一死战 151
+冻死 151
+凄厉 151
+分组 151
+刘宗周 151
+别称 151
+前段 151
+千里迢迢 151
+华北军区 151
+华尔街 151
+卸任 151
+受害 151
+口说 151
+台湾岛 151
+右丞相 151
+售后服务 151
+四百 151
+国父 151
+国资委 151
+场次 151
+基隆 151
+壮语 151
+大港 151
+天下人 151
+威震 151
+宋敏 151
+官名 151
+容情 151
+封信 151
+小建 151
+少校 151
+岩石圈 151
+工作站 151
+已故 151
+康明逊 151
+征召 151
+徵召 151
+恩典 151
+把关 151
+抢攻 151
+接二连三 151
+数声 151
+数百名 151
+星期一 151
+普天下 151
+最最 151
+柳州 151
+核爆炸 151
+梅毒 151
+沉甸甸 151
+海牙 151
+炼铁 151
+狗肉 151
+留存 151
+百眼 151
+积压 151
+窝棚 151
+管理处 151
+綇 151
+结冰 151
+绿化带 151
+罪孽 151
+老牌 151
+老韩 151
+联城 151
+腾空 151
+自忖 151
+蒙受 151
+西宫 151
+西沙群岛 151
+谦恭 151
+賨 151
+贩运 151
+路透社 151
+过敏性 151
+远行 151
+退色 151
+通气 151
+重回 151
+鐥 151
+钢笔 151
+锦缎 151
+阿尔卑斯山 151
+陈皇后 151
+隋炀帝 151
+风尘 151
+首家 151
+骨气 151
+鬼脸 151
+鹿兆海 151
+一家子 150
+一席话 150
+三山 150
+中央顾问委员会 150
+亚述 150
+人民解放军总部 150
+以图 150
+余个 150
+作画 150
+供不应求 150
+修订稿 150
+党性 150
+全美 150
+公交车 150
+六篇 150
+减慢 150
+凤鸣 150
+刀痕 150
+刘一舟 150
+制动 150
+刻薄 150
+前前后后 150
+前肢 150
+动物界 150
+勃然大怒 150
+十字架 150
+单打独斗 150
+卫生间 150
+印象主义 150
+口角 150
+后撤 150
+墙边 150
+多层 150
+大庙 150
+天意 150
+天翻地覆 150
+太常寺 150
+奏报 150
+媳妇儿 150
+宁为玉碎 150
+宝瓶 150
+家用电器 150
+小巧 150
+小跑 150
+屋后 150
+工程处 150
+开恩 150
+引种 150
+忙乱 150
+念佛 150
+憎恶 150
+戴高乐 150
+抗美援朝战争 150
+挺起 150
+捉摸 150
+放映师 150
+教廷 150
+数寸 150
+最高法院 150
+有生力量 150
+殴打 150
+沙尘暴 150
+洋流 150
+海风 150
+涪陵 150
+混浊 150
+清偿 150
+漓 150
+灌区 150
+烧酒 150
+环境污染 150
+珗 150
+球面 150
+瑷 150
+用处 150
+畻 150
+皝 150
+盛情 150
+相合 150
+着落 150
+瞪眼 150
+磁盘 150
+祖千秋 150
+祷 150
+秉性 150
+第一门 150
+粍 150
+精兵强将 150
+红牌 150
+红线 150
+纪检 150
+组阁 150
+翼展 150
+胡惟 150
+臭气 150
+致仕 150
+落叶松 150
+袁士霄 150
+要挟 150
+词句 150
+诓 150
+语义 150
+调理 150
+责任心 150
+资本论 150
+赋有 150
+跨上 150
+车门 150
+近亲 150
+邹燕 150
+酿造 150
+金矿 150
+鍪 150
+鎼 150
+长枪 150
+阴极 150
+雨点 150
+靶子 150
+马尾 150
+一生一世 149
+七品 149
+三边 149
+不惟 149
+两杯 149
+中雨 149
+之流 149
+互访 149
+五类 149
+亚硝酸盐 149
+京兆尹 149
+人不知 149
+什叶派 149
+今番 149
+体征 149
+佛陀 149
+偷盗 149
+入籍 149
+八百万 149
+冲掉 149
+助教 149
+北塘 149
+北平市 149
+十七岁 149
+十分之一 149
+取悦 149
+后患 149
+吴茶清 149
+咔 149
+哵 149
+喧闹声 149
+四五个 149
+坏话 149
+坐地 149
+垓 149
+大姑娘 149
+奴性 149
+嫁接 149
+孢 149
+家常便饭 149
+尊荣 149
+小口径 149
+居庸 149
+征询 149
+总政治部 149
+愧疚 149
+扶助 149
+拼写 149
+搭乘 149
+支点 149
+政见 149
+救亡图存 149
+无双 149
+暴乱 149
+曼彻斯特 149
+有性生殖 149
+朝觐 149
+木刻 149
+条目 149
+杨继盛 149
+树冠 149
+模具 149
+气势恢宏 149
+活埋 149
+清凉寺 149
+滑动 149
+煤层 149
+爱慕 149
+王庄 149
+痴呆 149
+百花齐放 149
+盗墓贼 149
+相撞 149
+硕大 149
+祺 149
+私生子 149
+秧歌 149
+税法 149
+素数 149
+细胞膜 149
+细胞质 149
+绞盘 149
+自由度 149
+舱门 149
+苦思 149
+莽撞 149
+葬身 149
+蒋燕 149
+触摸 149
+警犬 149
+讲师 149
+豪气 149
+足坛 149
+还手 149
+透支 149
+鄀 149
+金俊山 149
+金币 149
+金门 149
+雕花 149
+雪崩 149
+震慑 149
+风潮 149
+鼻腔 149
+一个半 148
+三根 148
+三轮 148
+下海 148
+与世隔绝 148
+世子 148
+丽人 148
+二十余 148
+人本 148
+人面 148
+低谷 148
+余实 148
+倚重 148
+傕 148
+元宵节 148
+劣质 148
+勤王 148
+北京市政府 148
+升降台 148
+半场 148
+及格 148
+受精卵 148
+受辱 148
+召集人 148
+同堂 148
+同姓 148
+呵斥 148
+善哉 148
+喝令 148
+四届 148
+土色 148
+垄 148
+多功能厅 148
+大呼小叫 148
+大礼堂 148
+天水 148
+失事 148
+奏效 148
+好运 148
+姑奶奶 148
+嬖 148
+家数 148
+对称性 148
+小命 148
+岢 148
+布条 148
+平民主义 148
+并进 148
+店家 148
+康克俭 148
+开盘 148
+当权 148
+形式主义 148
+惨象 148
+慧琼 148
+成片 148
+排场 148
+推上 148
+搽 148
+收摊 148
+故地 148
+新址 148
+施用 148
+无定 148
+旱灾 148
+昆山 148
+晶系 148
+曚 148
+本原 148
+李可秀 148
+杨文岳 148
+杨百利 148
+栏板 148
+格言 148
+毛驴 148
+汉魏 148
+沈萍 148
+泄气 148
+洒脱 148
+火种 148
+独创 148
+理事国 148
+田里 148
+申时行 148
+电容 148
+畨 148
+疗程 148
+皮炎 148
+相像 148
+石梁 148
+祖传 148
+竞争者 148
+等离子 148
+糕点 148
+索要 148
+经久不息 148
+罚金 148
+耐用 148
+脸盆 148
+致哀 148
+芙蓉镇 148
+花瓶 148
+花荣 148
+荚 148
+荣幸 148
+落空 148
+讲讲 148
+转给 148
+轻巧 148
+过热 148
+遵命 148
+金朝 148
+钙质 148
+锋芒 148
+阔叶 148
+阮黑 148
+防毒面具 148
+阿塞拜疆 148
+陆高轩 148
+陪葬 148
+雨淋 148
+非理性 148
+顺风 148
+首场 148
+鱼儿 148
+鸩 148
+一杆 147
+不定期 147
+不容忽视 147
+丕 147
+中文系 147
+丹尼尔 147
+主任医师 147
+争得 147
+二极管 147
+五角星 147
+伊万诺夫 147
+住口 147
+作怪 147
+作成 147
+依赖性 147
+保险公司 147
+免税 147
+兰芝 147
+共事 147
+农学 147
+出点 147
+出逃 147
+剥离 147
+剿匪 147
+功底 147
+包产到户 147
+华里 147
+博学 147
+厜 147
+古道 147
+哈赤 147
+商品粮 147
+善用 147
+四点 147
+团结一心 147
+垂头丧气 147
+大包 147
+大戏 147
+大政 147
+奶油 147
+婴幼儿 147
+孙嘉绩 147
+孟买 147
+孵 147
+宴席 147
+家父 147
+山峦 147
+崧 147
+张爱玲 147
+彛 147
+彩绸 147
+彷徨 147
+心花怒放 147
+总务处 147
+愈益 147
+戒刀 147
+截击 147
+扼杀 147
+拿捏 147
+持平 147
+掠走 147
+接收机 147
+断面 147
+无味 147
+无故 147
+明媚 147
+暴君 147
+本县 147
+李成梁 147
+枟 147
+枪毙 147
+核苷酸 147
+栽植 147
+段延庆 147
+没命 147
+沦亡 147
+淼 147
+渍 147
+灯市口 147
+熔化 147
+熔融 147
+猛击 147
+甘露 147
+电光 147
+痈 147
+知名人士 147
+石栏 147
+私情 147
+稀奇 147
+签定 147
+篡夺 147
+米诺斯 147
+粮仓 147
+约法 147
+经纪 147
+胶质 147
+苗子 147
+萌生 147
+观景 147
+詈 147
+训导 147
+记事 147
+话头 147
+谐振 147
+赵秉钧 147
+输球 147
+辽东半岛 147
+违拗 147
+重整 147
+铺上 147
+门面 147
+陈东 147
+随笔 147
+韦伯 147
+预想 147
+麦克拉伦 147
+一叶 146
+一声令下 146
+一般来说 146
+三江 146
+不切实际 146
+不饱和 146
+丢弃 146
+丰硕 146
+乱成一团 146
+二万五千里长征 146
+亏待 146
+保长 146
+倦怠 146
+入世 146
+公转 146
+减低 146
+刀丹 146
+午时 146
+单位制 146
+参议 146
+吕之悦 146
+吸着 146
+哺乳 146
+商业区 146
+四顾 146
+囷 146
+土伦杯 146
+地台 146
+坚毅 146
+埽 146
+外币 146
+多疑 146
+大三 146
+天底下 146
+天机 146
+天理 146
+婇 146
+守门 146
+宓 146
+家居 146
+小费 146
+布卢 146
+常熟 146
+开门见山 146
+微处理器 146
+德州 146
+德意志银行 146
+报告会 146
+抬升 146
+拓扑 146
+挪动 146
+掏钱 146
+撒哈拉沙漠 146
+擡升 146
+收看 146
+整夜 146
+方程组 146
+易溶 146
+未成年 146
+机炮 146
+李义河 146
+杓 146
+枣核 146
+某地 146
+楚楚 146
+此生 146
+消磨 146
+温暖如春 146
+滚雪球 146
+王满银 146
+王维扬 146
+珀 146
+理顺 146
+瓦块 146
+田舍 146
+画画 146
+症结 146
+监察院 146
+砖场 146
+程瑶迦 146
+税款 146
+第一副 146
+籽 146
+经学 146
+结义 146
+缙绅 146
+美联储 146
+老本 146
+老板娘 146
+膀子 146
+自来水 146
+蓬莱 146
+蜥 146
+蟠 146
+西直门外 146
+西经 146
+论者 146
+败露 146
+贵国 146
+连皇帝 146
+铁轨 146
+阿姆斯特丹 146
+陶冶 146
+霜降 146
+鞋底 146
+风趣 146
+飘动 146
+一千两 145
+下雪 145
+不明不白 145
+二话不说 145
+五星级 145
+伊始 145
+侧线 145
+储藏 145
+催眠 145
+内应 145
+冒进 145
+写明 145
+军机处 145
+冰层 145
+冲击力 145
+凯撒 145
+凸现 145
+分设 145
+初等 145
+初速 145
+判别 145
+到场 145
+加密 145
+加工厂 145
+十分钟 145
+十点 145
+卖力 145
+南堡 145
+南安 145
+危地马拉 145
+历史剧 145
+古力 145
+可恨 145
+可悲 145
+合伙人 145
+合夥人 145
+后腰 145
+吴建民 145
+告急 145
+哆 145
+哪位 145
+善后 145
+围歼 145
+土木工程 145
+城砖 145
+基石 145
+壊 145
+声纳 145
+大张旗鼓 145
+好笑 145
+妞儿 145
+审慎 145
+客票 145
+寒潮 145
+工程浩大 145
+巽 145
+市场部 145
+布拉 145
+建构 145
+御史大夫 145
+房管 145
+掌权 145
+接送 145
+支系 145
+数控 145
+文雅 145
+新区 145
+无缘无故 145
+易趣 145
+晖 145
+有条不紊 145
+朱宸濠 145
+李太 145
+李宗仁 145
+李梅亭 145
+李氏 145
+柠檬 145
+校勘 145
+栩栩如生 145
+根部 145
+步履 145
+毓 145
+污秽 145
+洪峯 145
+洪峰 145
+海德堡 145
+淋漓尽致 145
+混进 145
+源远流长 145
+漫不经心 145
+煤油 145
+爱人 145
+特工 145
+猎户 145
+皖南 145
+破格 145
+社会效益 145
+福报 145
+福特 145
+穷苦 145
+穿甲弹 145
+管带 145
+精辟 145
+群情激奋 145
+聂荣臻 145
+胡乔木 145
+荒废 145
+董建华 145
+蕾 145
+蛤 145
+血衣 145
+表露 145
+装机 145
+视察员 145
+诧 145
+谀 145
+跌入 145
+重症 145
+金田起义 145
+铁跃 145
+阴险 145
+除夕 145
+随和 145
+难言 145
+集合论 145
+霸占 145
+革命化 145
+风闻 145
+餐具 145
+饩 145
+饰物 145
+黄蜂 145
+龙脉 145
+一成 144
+三联 144
+不为瓦全 144
+中药材 144
+举世 144
+事不宜迟 144
+二十余年 144
+传话 144
+伤及 144
+信心百倍 144
+倒把 144
+公车 144
+写诗 144
+击毁 144
+切开 144
+剥皮 144
+副刊 144
+勾践 144
+匍匐 144
+升官 144
+反向 144
+变星 144
+吉尔吉斯斯坦 144
+周遭 144
+国教 144
+圣地亚哥 144
+地安门 144
+坍 144
+大功率 144
+大忌 144
+大江南北 144
+大赦 144
+失意 144
+奇功 144
+嫍 144
+安设 144
+小叶 144
+少时 144
+就位 144
+居民区 144
+山脊 144
+巴里 144
+市场占有率 144
+幽静 144
+应从 144
+庶人 144
+弥勒 144
+彭长老 144
+心室 144
+性激素 144
+感伤 144
+房管局 144
+打退 144
+抗御 144
+拉齐 144
+掍 144
+搭档 144
+操演 144
+支队长 144
+改口 144
+改换 144
+新世界 144
+方正 144
+方西冷 144
+普朗克 144
+有空 144
+朱镕基 144
+杀菌 144
+松柏 144
+查清 144
+柱状 144
+标语牌 144
+格杀 144
+桂冠 144
+梁思成 144
+永平 144
+汉儿 144
+津津有味 144
+灵性 144
+烧造 144
+狂喜 144
+琳娜 144
+瑟瑟 144
+生物界 144
+画卷 144
+百家争鸣 144
+盆景 144
+监管部门 144
+睦邻 144
+磁铁 144
+神妙 144
+竻 144
+第三条 144
+算账 144
+红星 144
+约瑟夫 144
+纪检组 144
+绅 144
+羽箭 144
+联邦政府 144
+肾小管 144
+肾炎 144
+脊柱 144
+腹腔 144
+英国皇家学会 144
+荣辱 144
+营业员 144
+萧皇后 144
+薯类 144
+蛮横无理 144
+表现力 144
+表达式 144
+西段 144
+要职 144
+让开 144
+许多种 144
+豪门 144
+豫东 144
+跌跌撞撞 144
+辉石 144
+迈阿密 144
+送交 144
+逛逛 144
+酗酒 144
+铮铮 144
+阴冷 144
+阴雨连绵 144
+雅高 144
+零下 144
+韭菜 144
+韵味 144
+顺口 144
+领空 144
+颠沛 144
+风湿 144
+魂魄 144
+一个劲儿 143
+一堵 143
+一年四季 143
+丈人 143
+上谕 143
+下腰 143
+不可收拾 143
+不衰 143
+争抢 143
+亢 143
+交通员 143
+产销 143
+人民广场 143
+仇视 143
+修辞 143
+养护 143
+军法 143
+冲淡 143
+几场 143
+凸起 143
+刘霖 143
+初见 143
+劅 143
+劯 143
+华立 143
+华贵 143
+南岛 143
+受挫 143
+各科 143
+后遗症 143
+命丧 143
+国史 143
+大司马 143
+大盗 143
+天经地义 143
+威海 143
+嬨 143
+宁夏回族自治区 143
+完颜康 143
+寚 143
+小丫头 143
+工矿企业 143
+广岛 143
+建党 143
+开裂 143
+录音机 143
+律令 143
+徐桐 143
+得心应手 143
+徽号 143
+念及 143
+念叨 143
+怀塔布 143
+总成绩 143
+息怒 143
+惭 143
+扫兴 143
+批驳 143
+拳术 143
+撒娇 143
+教育学 143
+日间 143
+本来面目 143
+梆子 143
+槾 143
+欢娱 143
+气喘 143
+水仙 143
+水缸 143
+求之不得 143
+汉诺威 143
+沦 143
+泌 143
+泥浆 143
+溢出 143
+满月 143
+满眼 143
+灼热 143
+烟袋 143
+燕都 143
+猖狂 143
+王剑英 143
+生子 143
+生辰 143
+皮包 143
+盐类 143
+盐铁 143
+眩 143
+砾石 143
+穿刺 143
+紫外 143
+肤浅 143
+脊梁 143
+舞曲 143
+花被 143
+苍凉 143
+苏星河 143
+茅草 143
+补涨 143
+西暖阁 143
+贝尔格莱德 143
+贻误 143
+赛车 143
+起家 143
+转手 143
+转播 143
+遥测 143
+遮拦 143
+重提 143
+钻井 143
+铜像 143
+铺盖 143
+防身 143
+阴气森 143
+除以 143
+青瓷 143
+韧带 143
+预约 143
+领班 143
+食肉 143
+马耳他 143
+黄忠 143
+黏膜 143
+齐怀远 143
+三讲 142
+上涌 142
+上策 142
+上表 142
+下限 142
+两排 142
+中成药 142
+乍得 142
+书呆子 142
+五六个 142
+僻静 142
+先农坛 142
+关节炎 142
+几十张 142
+几回 142
+分清 142
+刺猬 142
+化合 142
+匠人 142
+南半球 142
+卡洛斯 142
+压根 142
+古史 142
+句号 142
+台湾地区 142
+叵 142
+同业 142
+同时代 142
+后汉 142
+咝 142
+哀伤 142
+囚徒 142
+回路 142
+坐船 142
+壒 142
+大城市 142
+大渡河 142
+大石头 142
+大黑 142
+天街 142
+失职 142
+头头 142
+好受 142
+姑息 142
+守门员 142
+安居 142
+安生 142
+尊卑 142
+尼加拉瓜 142
+差价 142
+巴士 142
+平西 142
+年华 142
+应允 142
+开奖 142
+强人 142
+怔住 142
+您老 142
+惶惑 142
+意见书 142
+战地 142
+托勒密 142
+把酒 142
+撒尿 142
+教练组 142
+文风 142
+旧货 142
+明人 142
+智者 142
+曹文诏 142
+李景隆 142
+柔道 142
+档期 142
+横额 142
+气馁 142
+水珠 142
+江都 142
+浉 142
+浮游生物 142
+涉水 142
+润滑 142
+涵义 142
+清泉 142
+湿透 142
+灾情 142
+炽热 142
+物理性质 142
+猝不及防 142
+王洪文 142
+白垩 142
+白莲教 142
+直刺 142
+相仿 142
+瞳 142
+石经 142
+碑文 142
+祷告 142
+程青竹 142
+穆巴拉克 142
+胃肠道 142
+胎生 142
+能耗 142
+脱掉 142
+若何 142
+茶花 142
+荟萃 142
+蓬松 142
+赤裸 142
+赫拉 142
+轿夫 142
+辟疆 142
+过瘾 142
+配额 142
+钽 142
+销路 142
+锻造 142
+长史 142
+闲时 142
+静静的 142
+高跟鞋 142
+鲇 142
+黑水 142
+齐整 142
+一百多年 141
+不同寻常 141
+东山 141
+东来 141
+中石化 141
+之三 141
+乏味 141
+九点 141
+习以为常 141
+乳酸 141
+五连冠 141
+人脑 141
+仆役 141
+住宅区 141
+体细胞 141
+余鱼 141
+偶然性 141
+党建 141
+八仙 141
+养殖业 141
+养鱼 141
+兼管 141
+切入 141
+刘七爹 141
+利索 141
+前次 141
+北三环 141
+北汉 141
+北海道 141
+占领区 141
+厕 141
+厨子 141
+叫住 141
+同一天 141
+同知 141
+启迪 141
+和声 141
+啵 141
+噗哧 141
+回师 141
+国姓 141
+国计民生 141
+土星 141
+均等 141
+多得多 141
+多篇 141
+大梁 141
+大碍 141
+大车 141
+夺走 141
+奖牌 141
+奥立佛 141
+娇艳 141
+季婷婷 141
+安民 141
+宣讲 141
+宣诏官 141
+富兰克林 141
+尚存 141
+尤文 141
+展台 141
+市面 141
+带路 141
+序言 141
+强于 141
+彜 141
+忙不迭 141
+性行为 141
+怪兽 141
+恺 141
+恻 141
+恼恨 141
+情爱 141
+感想 141
+懒惰 141
+战将 141
+扣发 141
+投资额 141
+接济 141
+提法 141
+放走 141
+斁 141
+无关紧要 141
+明治 141
+暑 141
+曹锟 141
+李立 141
+杏仁 141
+条理 141
+杰克逊 141
+构架 141
+林之孝 141
+标注 141
+桔 141
+椟 141
+止咳 141
+沙尘 141
+湛江 141
+炶 141
+焦炭 141
+熟识 141
+爱立信 141
+爹妈 141
+猕猴桃 141
+生疏 141
+电动势 141
+白蚁 141
+矜持 141
+硷 141
+禁用 141
+第一场 141
+红袖 141
+老实人 141
+职分 141
+芦 141
+蓄水 141
+计算器 141
+议定书 141
+评语 141
+诸城 141
+调教 141
+谢绝 141
+贵胄 141
+过道 141
+近岸 141
+逛街 141
+遏止 141
+都灵 141
+鍎 141
+鑫 141
+钢丝 141
+陪嫁 141
+靶场 141
+马光佐 141
+魂飞魄散 141
+魐 141
+麻光佐 141
+鼓楼 141
+龙凤 141
+一致性 140
+上峯 140
+上峰 140
+久违 140
+五经 140
+交通运输业 140
+人丁 140
+人缘 140
+传开 140
+何月华 140
+余姚 140
+供应链 140
+依我看 140
+倒不如 140
+借宿 140
+内衣 140
+冲击波 140
+减数 140
+列宁格勒 140
+励 140
+卮 140
+古典文学 140
+叫化子 140
+名子 140
+吞吐 140
+吨位 140
+吱声 140
+味精 140
+咭 140
+善待 140
+四季常青 140
+回心转意 140
+回荡 140
+国庆日 140
+土城子 140
+在世 140
+坠毁 140
+基础性 140
+塔里木盆地 140
+壮举 140
+处世 140
+外滩 140
+大顺朝 140
+夺路 140
+妻儿 140
+孔隙 140
+字条 140
+宋人 140
+宋兵 140
+宫殿式 140
+尤文图斯 140
+岁尾 140
+左右分 140
+帴 140
+年少 140
+应征 140
+张华 140
+张成仁 140
+张朝唐 140
+徐长老 140
+德国队 140
+心烦 140
+怡和 140
+总攻 140
+愯 140
+懒洋洋 140
+执拗 140
+数据链 140
+文宗 140
+新民学会 140
+无形中 140
+日用品 140
+昏黄 140
+李闯 140
+杨二人 140
+槭 140
+檄文 140
+水晶棺 140
+水蒸气 140
+汽轮机 140
+泰晤士报 140
+涣散 140
+淡水湖 140
+深圳证券交易所 140
+煲 140
+爱德华 140
+爿 140
+狡 140
+王兵 140
+王府井大街 140
+珍珠港 140
+留校 140
+疏导 140
+疣 140
+登陆艇 140
+眼红 140
+福州市 140
+私有化 140
+称臣 140
+突入 140
+系统性 140
+红树林 140
+练就 140
+绞刑架 140
+绩 140
+维新派 140
+耻笑 140
+联合国大会 140
+肾上腺素 140
+胡作非为 140
+臧 140
+舍身 140
+舱盖 140
+艺术性 140
+莲花池 140
+袁大头 140
+袁相公 140
+规范性 140
+讥刺 140
+賍 140
+责骂 140
+赣州 140
+赵争争 140
+起劲 140
+辞别 140
+过半 140
+通牒 140
+金日成 140
+雇佣劳动 140
+雪线 140
+鞣 140
+食宿 140
+食料 140
+驯养 140
+三公 139
+上党 139
+不以为意 139
+不失时机 139
+世钧道 139
+东北侧 139
+东北角 139
+中国人民大学 139
+中风 139
+主妇 139
+低分 139
+侣 139
+俣 139
+倾轧 139
+内幕 139
+写照 139
+军医 139
+减压 139
+凶相毕露 139
+前些年 139
+剧变 139
+割地 139
+动漫 139
+化学武器 139
+十几万 139
+口粮 139
+古典主义 139
+可口可乐 139
+吴县人 139
+周济 139
+哈尔滨市 139
+四日 139
+因而是 139
+圣保罗 139
+坯 139
+基德 139
+头人 139
+套装 139
+婆罗门 139
+安然 139
+家传 139
+家庭教育 139
+展翅 139
+屡见不鲜 139
+山歌 139
+崂 139
+工作量 139
+平滑 139
+应承 139
+张宗昌 139
+心肺 139
+忛 139
+总参谋部 139
+恩情 139
+恩爱 139
+战鼓 139
+手背 139
+托盘 139
+投枪 139
+控制权 139
+提笔 139
+搁置 139
+搭理 139
+收缴 139
+攻击力 139
+文言 139
+斋宫 139
+无功 139
+明宪宗 139
+明示 139
+暂缓 139
+杂文 139
+杂物 139
+李来亨 139
+杨过笑 139
+核反应堆 139
+楚辞 139
+次第 139
+死于非命 139
+毒液 139
+气色 139
+泰利斯 139
+涿州 139
+温体仁 139
+渲 139
+湖里 139
+火线 139
+烧掉 139
+热力 139
+犯下 139
+电介质 139
+留念 139
+硎 139
+离京 139
+秀美 139
+笔迹 139
+等量 139
+筹办 139
+纳西 139
+纷扰 139
+纸片 139
+结缔组织 139
+绵绵 139
+绿茶 139
+缌 139
+罅 139
+老小 139
+耳语 139
+聊以自慰 139
+背地里 139
+胫 139
+自上而下 139
+节拍 139
+芭蕉 139
+苏林 139
+范例 139
+草本植物 139
+落座 139
+血水 139
+行将 139
+课外 139
+贡举 139
+转轨 139
+转轴 139
+辅音 139
+通话费 139
+郑元勋 139
+都司 139
+重机枪 139
+钍 139
+银匠 139
+院墙 139
+隐伏 139
+颉 139
+骈 139
+鸿沟 139
+黑斑 139
+齐化门 139
+一担 138
+一点多 138
+万种 138
+上京 138
+不菲 138
+专一 138
+中古 138
+乌兹别克斯坦 138
+于雷 138
+交椅 138
+伏牛山 138
+住宿费 138
+佐证 138
+侏罗纪 138
+党外 138
+全额 138
+公仆 138
+六点 138
+兵工厂 138
+几番 138
+列国 138
+判罚 138
+动感 138
+勉 138
+十四届 138
+发火 138
+司马光 138
+合奏 138
+君主专制 138
+呆滞 138
+响彻云霄 138
+噪 138
+围堰 138
+国际羽联 138
+地缘 138
+外感 138
+大加 138
+失灵 138
+失败者 138
+好戏 138
+姤 138
+婚前 138
+嬉 138
+孙立 138
+守恒定律 138
+安乐 138
+安禄山 138
+定局 138
+家家户户 138
+富商 138
+导数 138
+山东半岛 138
+山里红 138
+岩画 138
+师院 138
+怒骂 138
+思想解放 138
+怼 138
+恭谨 138
+戛纳 138
+报效 138
+拜师 138
+探寻 138
+摇身一变 138
+放量 138
+政令 138
+旧事 138
+时时刻刻 138
+朗讯 138
+权位 138
+来电 138
+此理 138
+比价 138
+氯气 138
+沁源 138
+没入 138
+流寇 138
+潮剧 138
+灰岩 138
+特里 138
+瓜葛 138
+甲午 138
+电子管 138
+疑团 138
+痹 138
+百分之百 138
+盐水 138
+相劝 138
+知不知道 138
+硫化 138
+红枣 138
+约束力 138
+置之度外 138
+老少 138
+联席会议 138
+胥德章 138
+腔调 138
+营垒 138
+蔡和森 138
+螺纹 138
+装满 138
+解宝 138
+讵 138
+诺贝尔奖 138
+起落架 138
+身价百倍 138
+车祸 138
+迁就 138
+连胜 138
+通胀 138
+遣送 138
+邵飘萍 138
+醚 138
+采伐 138
+铝合金 138
+锅炉房 138
+镀 138
+镏金 138
+閰 138
+闷热 138
+阴森 138
+阿格布 138
+阿联酋 138
+隐形眼镜 138
+雷庆 138
+颇感 138
+香甜 138
+高卢 138
+一袋 137
+三位一体 137
+三成 137
+上浮 137
+下款 137
+严素 137
+中华人民共和国全国人民代表大会 137
+中后期 137
+主从 137
+乞求 137
+事端 137
+云彩 137
+人多势众 137
+他国 137
+何梅 137
+佛珠 137
+作别 137
+佥 137
+侨居 137
+先头 137
+分毫不差 137
+十之八九 137
+卑劣 137
+南关 137
+博弈 137
+卫视 137
+双道 137
+受尽 137
+叮叮当当 137
+后发 137
+后秦 137
+哭喊 137
+商品量 137
+啸声 137
+四国 137
+国家大剧院 137
+墨迹 137
+多孔 137
+大通 137
+娘舅 137
+将帅 137
+小太监 137
+山民 137
+崇仁 137
+年终 137
+心病 137
+快慢 137
+愰 137
+慊 137
+成群结队 137
+扬长而去 137
+抚恤 137
+拒人千里 137
+拦路 137
+控制论 137
+搦 137
+搭架 137
+撑腰 137
+攀援 137
+新秀 137
+日本防卫厅 137
+星期二 137
+有意无意 137
+朱武 137
+来之不易 137
+椅背 137
+榜首 137
+橀 137
+正事 137
+死因 137
+毛奇龄 137
+毛色 137
+沟谷 137
+波茨坦 137
+溜走 137
+火球 137
+爱美 137
+片长 137
+牲 137
+犹豫不决 137
+甲骨 137
+癌细胞 137
+百日 137
+盘桓 137
+相辅相成 137
+眨眼 137
+硫化物 137
+礼记 137
+秋收 137
+窝囊 137
+篇篇 137
+精深 137
+缡 137
+肥肉 137
+背囊 137
+腿脚 137
+臁 137
+致以 137
+英特尔公司 137
+蒙在鼓里 137
+蓄意 137
+藏品 137
+藏画 137
+蜂拥 137
+蠹 137
+血栓 137
+表弟 137
+计划数 137
+谡 137
+财宝 137
+起病 137
+起色 137
+跋扈 137
+车载 137
+连云港 137
+铅笔头 137
+长沙市 137
+难听 137
+领袖像 137
+风洞 137
+飘忽 137
+饶命 137
+馀 137
+马行空 137
+一品红 136
+一岁 136
+上尉 136
+不如意 136
+专列 136
+严家 136
+中国人民志愿军 136
+中海 136
+二两 136
+伯明翰 136
+作罢 136
+供销 136
+俊美 136
+俨 136
+修道院 136
+假扮 136
+关明梅 136
+兼具 136
+兽医 136
+再拜 136
+军服 136
+分心 136
+切片 136
+刮目相看 136
+办案 136
+勉励 136
+北方局 136
+区位 136
+十年寒窗 136
+占上风 136
+印度支那 136
+发毛 136
+口罩 136
+古往今来 136
+可汗 136
+名教 136
+咂 136
+嘉奖 136
+复叶 136
+天主教会 136
+天敌 136
+天球 136
+央求 136
+娼 136
+小心谨慎 136
+工农联盟 136
+左近 136
+差事 136
+巴山 136
+常事 136
+并州 136
+幷州 136
+庚 136
+度日 136
+徐渭 136
+御用 136
+心裁 136
+忘恩负义 136
+惨然 136
+技术员 136
+授意 136
+斯基 136
+旧部 136
+昏庸 136
+暑期 136
+最高级 136
+本职工作 136
+极品 136
+梅津美 136
+模块化 136
+横卧 136
+殿后 136
+气虚 136
+水母 136
+汇文 136
+油锅 136
+法学家 136
+淘气 136
+滚开 136
+漂泊 136
+火山岩 136
+火炉 136
+爰 136
+爷们 136
+甩掉 136
+申明 136
+白圭 136
+白花 136
+百家 136
+盎然 136
+眼巴巴 136
+砍掉 136
+禛 136
+秦明 136
+窘迫 136
+竹简 136
+简练 136
+紧跟着 136
+绝妙 136
+统计局 136
+聚敛 136
+芬芳 136
+草稿 136
+蠕虫 136
+西北风 136
+言说 136
+订婚 136
+讥 136
+转口 136
+近世 136
+连续剧 136
+铌 136
+长进 136
+门子 136
+闹着玩 136
+陇西 136
+陈国强 136
+陈建 136
+预览 136
+领受 136
+首播 136
+鲜血淋漓 136
+一秒 135
+丁国宝 135
+不动产 135
+中尉 135
+九叶 135
+二尺 135
+人脸 135
+体腔 135
+何日 135
+何足道 135
+何门 135
+保卫战 135
+傅里叶 135
+光熙 135
+六种 135
+写入 135
+凌驾 135
+切磋 135
+刊行 135
+加压 135
+加工机 135
+助力 135
+北京卫戍区 135
+北京市人民政府 135
+升入 135
+半身 135
+卣 135
+发红 135
+口吃 135
+和议 135
+咬紧牙关 135
+四路 135
+团费 135
+坐立不安 135
+处女 135
+多方面 135
+大功告成 135
+太冲 135
+嫁妆 135
+宁王 135
+官邸 135
+小韩 135
+小额 135
+屈膝 135
+属国 135
+嵌入式 135
+巢穴 135
+布宜诺斯艾利斯 135
+平安无事 135
+开动 135
+得悉 135
+忠告 135
+总厂 135
+我俩 135
+扶栏 135
+投中 135
+押解 135
+抽泣 135
+拆毁 135
+拜会 135
+推托 135
+搞活 135
+擦拭 135
+救命之恩 135
+教研室 135
+无毒 135
+无穷的 135
+日头 135
+暗笑 135
+朝向 135
+木材厂 135
+柊 135
+标榜 135
+欢笑 135
+武三通 135
+武侠小说 135
+毒剂 135
+治法 135
+泥塑 135
+洪都 135
+清明节 135
+湖盆 135
+烧着 135
+焦公礼 135
+珠峯 135
+珠峰 135
+琼楼玉宇 135
+甜美 135
+甬钟 135
+盘古 135
+知会 135
+石墙 135
+研究生院 135
+碑座 135
+祖福临 135
+祖辈 135
+祸事 135
+稽查 135
+立论 135
+第一重 135
+第二重 135
+篮球场 135
+纵横交错 135
+纹理 135
+经院 135
+脱贫致富 135
+船闸 135
+艺文 135
+草帽 135
+虱子 135
+融水 135
+警钟 135
+让利 135
+诤 135
+说教 135
+谷口 135
+走遍 135
+跃居 135
+转回 135
+轻声道 135
+过世 135
+配用 135
+钱学礼 135
+铰 135
+隷 135
+霞光 135
+青石板 135
+非同寻常 135
+靠不住 135
+面红耳赤 135
+预科 135
+骇异 135
+鹰爪 135
+黄岛 135
+黄老 135
+黄麻 135
+黎满庚 135
+龇 135
+一整天 134
+上下水 134
+不堪一击 134
+不外 134
+不孕 134
+中国男队 134
+乘法 134
+二娘 134
+五十岁 134
+交上 134
+以利 134
+企业主 134
+伊丽莎白 134
+会标 134
+俄方 134
+倚天剑 134
+儿戏 134
+先辈 134
+入侵者 134
+再战 134
+凝思 134
+切身 134
+剃头 134
+劳资 134
+匹敌 134
+千余件 134
+华北地区 134
+博大 134
+厄运 134
+发黑 134
+口水 134
+听任 134
+吴坎 134
+告退 134
+周颠 134
+和蔼 134
+处理机 134
+大势已去 134
+大观园 134
+失态 134
+奥托 134
+媳 134
+家奴 134
+小女儿 134
+己任 134
+巴洛克 134
+布里 134
+庞然大物 134
+弟妹 134
+张春桥 134
+当量 134
+徒刑 134
+忐忑不安 134
+恶作剧 134
+恶性循环 134
+想当年 134
+战栗 134
+批评家 134
+承欢 134
+护国 134
+故土 134
+断肠 134
+施肥 134
+无恙 134
+明哲 134
+明晰 134
+景德镇 134
+标枪 134
+校方 134
+核物理 134
+水势 134
+水汽 134
+法权 134
+波澜 134
+淫妇 134
+淬 134
+清冷 134
+湖底 134
+滋长 134
+滩涂 134
+火势 134
+煤电 134
+牙关 134
+玛雅 134
+玻璃窗 134
+瑞金 134
+生灵 134
+电车 134
+畅行 134
+癫痫 134
+白杨 134
+笞 134
+第三方 134
+第二层 134
+粒细胞 134
+紧迫感 134
+红脸 134
+纳米比亚 134
+结节 134
+继发 134
+美籍 134
+耆老 134
+耐磨 134
+脚色 134
+航天员 134
+航电 134
+荒诞 134
+蓝田 134
+蛀 134
+行凶 134
+装潢 134
+训练有素 134
+谦让 134
+贫困县 134
+赵良嗣 134
+蹈 134
+道观 134
+金箍棒 134
+金边 134
+鏆 134
+钮钟 134
+雅龙 134
+零碎 134
+青稞 134
+鞋袜 134
+鞍马 134
+颜大怒 134
+马政 134
+马脚 134
+驼背 134
+黄浦江 134
+黄疸 134
+一点一点 133
+三九 133
+下跪 133
+东海舰队 133
+中秋节 133
+二层 133
+二龙戏珠 133
+互联网络 133
+人道 133
+伫立 133
+使然 133
+公费 133
+冬日 133
+凡百 133
+分界点 133
+分类法 133
+创始 133
+别出心裁 133
+制空权 133
+劳动强度 133
+勒令 133
+匆匆忙忙 133
+吃紧 133
+吟诗 133
+和慈禧 133
+哺乳类 133
+唐人 133
+嘱托 133
+团里 133
+壮汉 133
+复员 133
+多万元 133
+太上皇 133
+奏本 133
+姻缘 133
+娈 133
+婆家 133
+嫌弃 133
+守旧 133
+安龙 133
+官衔 133
+宝典 133
+富丽 133
+寐 133
+寡不敌众 133
+封赏 133
+局域网 133
+布设 133
+布阵 133
+干湿 133
+广博 133
+废品 133
+张国栋 133
+强健 133
+徐芳 133
+得主 133
+戒烟 133
+抗旱 133
+抢修 133
+拷贝 133
+政企 133
+断续 133
+无性 133
+早春 133
+晋南 133
+有机化学 133
+服输 133
+机关干部 133
+核燃料 133
+模模糊糊 133
+武进士 133
+死去活来 133
+没法子 133
+沣 133
+海带 133
+淄博 133
+淡黄 133
+淮海战役 133
+溶质 133
+犀 133
+电影厅 133
+电线 133
+畿 133
+瘙痒 133
+白鹿村 133
+相随 133
+眶 133
+碉 133
+祧 133
+空闲 133
+签注 133
+红十字会 133
+红壤 133
+组团 133
+缘分 133
+翻建 133
+肾病 133
+胚乳 133
+脓包 133
+腌制 133
+腹鳍 133
+臊 133
+致意 133
+蒸腾 133
+蚂 133
+西西里岛 133
+解雇 133
+论据 133
+该城 133
+调入 133
+豆科 133
+贫瘠 133
+软禁 133
+辞典 133
+运动战 133
+邀请赛 133
+酸楚 133
+金属矿 133
+金铺 133
+链子 133
+门联 133
+问号 133
+雮 133
+露水 133
+韩木林 133
+韬光养晦 133
+风蚀 133
+骸骨 133
+鲈 133
+麦田 133
+黄水 133
+黄褐色 133
+一些则 132
+一班人 132
+丁香 132
+不破 132
+不足为奇 132
+中东地区 132
+中旅 132
+临沂 132
+主仆 132
+九日 132
+买主 132
+井亭 132
+亚运 132
+令狐公子 132
+佛学 132
+偿付 132
+公孙绿 132
+公害 132
+关乎 132
+农作 132
+冷不防 132
+刘延庆 132
+功能性 132
+动画片 132
+劫持 132
+劳伦斯 132
+化装 132
+千真万确 132
+华筝 132
+南段 132
+南池子 132
+南沙 132
+卸下 132
+受罪 132
+可乘之机 132
+后晋 132
+喻示 132
+嘲弄 132
+国奥队 132
+地峡 132
+坐南朝北 132
+坚果 132
+外地人 132
+外挂 132
+夜深 132
+天威 132
+夭折 132
+奸计 132
+好色 132
+婷 132
+岂肯 132
+左右两座 132
+左右门 132
+幼女 132
+德文 132
+托叶 132
+承乾宫 132
+承德 132
+投递 132
+指尖 132
+摸清 132
+斗殴 132
+斥革 132
+旱地 132
+旱烟 132
+昔年 132
+曲牌 132
+木薯 132
+机关报 132
+机务 132
+杀虫剂 132
+枷锁 132
+标价 132
+框框 132
+梯田 132
+楼前 132
+段子 132
+污泥 132
+深湛 132
+清点 132
+游牧民 132
+滋养 132
+满不在乎 132
+激越 132
+灵芝 132
+环线 132
+瓦斯 132
+疏勒 132
+登山队 132
+白玉石 132
+盛怒 132
+睡熟 132
+礼遇 132
+籍贯 132
+经籍 132
+绥远 132
+缜密 132
+自大 132
+自然数 132
+舒张 132
+芝加哥大学 132
+英宗 132
+荒草 132
+螯 132
+衣袋 132
+见不得人 132
+视听 132
+解困 132
+诗书 132
+谢秋思 132
+蹲坐 132
+转转 132
+辨识 132
+遇刺 132
+遮挡 132
+重重的 132
+银光 132
+门店 132
+陨 132
+青岛队 132
+青筋 132
+音韵 132
+饵料 132
+一车 131
+七米 131
+万死一生 131
+不一会 131
+两具 131
+中国式 131
+久已 131
+乌头 131
+九丈 131
+争先恐后 131
+五十两 131
+五建 131
+京官 131
+京报 131
+从大清 131
+假货 131
+做不了 131
+八角帽 131
+八部 131
+兵员 131
+减员 131
+分寸 131
+刚性 131
+制法 131
+前奏 131
+前门大街 131
+前院 131
+加进 131
+北京中山公园 131
+十三点 131
+单科 131
+单细胞 131
+南国 131
+印度政府 131
+双核 131
+发散 131
+叔伯 131
+口感 131
+史婆婆 131
+号筒 131
+各区 131
+吸水 131
+喷火 131
+嘟 131
+国庆 131
+在读 131
+培根 131
+填满 131
+夜视 131
+大作文章 131
+大半年 131
+太傅 131
+妖法 131
+姞 131
+娶妻 131
+安德 131
+对偶 131
+对决 131
+尾随 131
+平滑肌 131
+并作 131
+幸存者 131
+序号 131
+底蕴 131
+建筑学 131
+强光 131
+征兵 131
+徐援朝 131
+徵兵 131
+惊喜交集 131
+扩招 131
+推定 131
+支取 131
+放任 131
+教给 131
+敬请 131
+数千只 131
+斜面 131
+新法 131
+无助 131
+曹宅 131
+校门 131
+桥本 131
+横标 131
+残部 131
+水星 131
+求婚 131
+治军 131
+法勒 131
+消沉 131
+淡漠 131
+淮阴 131
+漱 131
+瀑 131
+烈焰 131
+由不得 131
+甲醇 131
+男排 131
+百米 131
+相让 131
+眼花 131
+砖混 131
+碳酸锂 131
+社会保险 131
+神坛 131
+神舟 131
+积攒 131
+称雄 131
+秸秆 131
+窃国大盗 131
+竵 131
+第二部 131
+箫声 131
+紫红色 131
+纺织业 131
+细说 131
+美人儿 131
+美酒 131
+考察团 131
+自理 131
+自相残杀 131
+花香 131
+营区 131
+虚荣 131
+蚂蜂 131
+行会 131
+袖口 131
+裘丽丽 131
+裨 131
+褂子 131
+西药 131
+该剧 131
+误认为 131
+调拨 131
+豁免 131
+豪格 131
+送料 131
+逐级 131
+郑贵妃 131
+金城 131
+鎾 131
+铝土矿 131
+银白色 131
+镦 131
+长崎 131
+阑 131
+陆庄主 131
+集聚 131
+音频 131
+顾眉 131
+风行 131
+马六甲海峡 131
+高邮 131
+鱼肉 131
+黄鼠狼 131
+七成 130
+万英镑 130
+东平 130
+东朝西 130
+东西向 130
+两季 130
+两盘 130
+中加 130
+中国电影集团公司 130
+今朝 130
+从今以后 130
+仡 130
+何曾 130
+儿孙 130
+儿童文学 130
+写字台 130
+农民战争 130
+几行 130
+凡尔赛 130
+出口国 130
+出迎 130
+初夏 130
+制动器 130
+剔 130
+力帆 130
+北婆罗 130
+华辰 130
+卫国之 130
+压榨 130
+双拳 130
+叏 130
+发情 130
+叙事诗 130
+叫骂 130
+台商 130
+合群 130
+吊死 130
+嘻笑 130
+四溅 130
+圣明 130
+大全 130
+天灾 130
+嫔 130
+安贞 130
+将才 130
+小老婆 130
+尿液 130
+左祖右 130
+巫术 130
+巴顿 130
+币制 130
+布达佩斯 130
+庞德 130
+弑 130
+役使 130
+心眼儿 130
+怒喝 130
+怒目 130
+急驰 130
+恶气 130
+恶臭 130
+惨状 130
+愤懑 130
+技师 130
+收场 130
+数列 130
+文右武 130
+旅程 130
+时值 130
+曲线美 130
+未知数 130
+机群 130
+板子 130
+栵 130
+根子 130
+武斗 130
+死心塌地 130
+民情 130
+氧化铝 130
+水花 130
+治学 130
+泪眼 130
+泳 130
+洌 130
+清帝逊 130
+滑翔 130
+燧 130
+爱怜 130
+特命 130
+玉峰 130
+现代主义 130
+班机 130
+生物碱 130
+男女老少 130
+界河 130
+病原体 130
+瘟 130
+百级 130
+监察官 130
+目下 130
+矿泉水 130
+种田 130
+笼统 130
+繁杂 130
+约旦河 130
+纪念室 130
+纪念展 130
+纯度 130
+经济危机 130
+网状 130
+罗列 130
+罗雪 130
+耳鸣 130
+耿 130
+自然人 130
+若干份 130
+蒲绶昌 130
+衣裤 130
+袁锡藩 130
+西川 130
+词儿 130
+诩 130
+语意 130
+诺贝尔和平奖 130
+贬低 130
+赤裸裸 130
+超乎 130
+辐 130
+输卵管 130
+边塞 130
+近视 130
+迟浩田 130
+迸发 130
+避让 130
+郭柯 130
+重于 130
+镇子 130
+雅加达 130
+青天 130
+韩林儿 130
+驱散 130
+骗取 130
+鲧 130
+三十三年 129
+下葬 129
+不确定性 129
+业务员 129
+中国科学院自然科学史研究所 129
+主办方 129
+乡民 129
+五点 129
+交换机 129
+优厚 129
+余暇 129
+使命感 129
+信口开河 129
+俯视 129
+公生门 129
+切记 129
+北爱尔兰 129
+十百 129
+卵子 129
+历史系 129
+参加者 129
+台词 129
+叶绿体 129
+各队 129
+同源 129
+同道 129
+名企 129
+四十八斤 129
+国度 129
+土葬 129
+多点 129
+大同小异 129
+大鼓 129
+天下大乱 129
+头像 129
+奇事 129
+奔放 129
+妇产科 129
+姨娘 129
+安身 129
+小企业 129
+小平 129
+尸骸 129
+岂止 129
+岩体 129
+左环 129
+师部 129
+幽默感 129
+库容 129
+开普敦 129
+开炮 129
+快车 129
+慈爱 129
+戊戌变法 129
+成语 129
+我党 129
+房东 129
+手肘 129
+扑面 129
+插曲 129
+揭幕 129
+撒手锏 129
+效仿 129
+教民 129
+文翰 129
+旧有 129
+昏睡 129
+晚霞 129
+曲阜 129
+最大化 129
+朝夕 129
+杨廷 129
+杨涛 129
+格陵兰 129
+桌旁 129
+梁中书 129
+植物学 129
+楼九楹 129
+歧 129
+死角 129
+毛里求斯 129
+沈阳市 129
+油工 129
+海内 129
+海船 129
+淳朴 129
+游乐 129
+游走 129
+灌木丛 129
+燃气轮机 129
+牛爱香 129
+特高 129
+狗贼 129
+猎枪 129
+猜忌 129
+王冶秋 129
+生活必需品 129
+痔 129
+省略 129
+等闲 129
+管委 129
+箸 129
+精液 129
+紧靠 129
+红苗正 129
+纳斯 129
+老工人 129
+肩摩毂击 129
+背靠 129
+胚层 129
+胸腹 129
+能进宫 129
+膂力 129
+自行火炮 129
+自认 129
+船家 129
+节前 129
+英子 129
+蓝布 129
+藏文 129
+虚无 129
+蜂窝 129
+蜥蜴 129
+行文 129
+西为德 129
+西交民巷 129
+西朝房 129
+观察家 129
+诽谤 129
+豪宅 129
+赎回 129
+趁势 129
+跃跃欲试 129
+跳台 129
+车臣 129
+转行 129
+轰隆 129
+进击 129
+通海运 129
+逼死 129
+重合 129
+金银财宝 129
+钢针 129
+铝土 129
+铩 129
+长线 129
+防区 129
+陆祥 129
+雅各 129
+零散 129
+雷鸣 129
+韩雪亭 129
+顾养民 129
+驱车 129
+高中生 129
+龙画凤 129
+一窍不通 128
+一般见识 128
+七七四十九响 128
+三十六扇 128
+上万头 128
+上御玺 128
+不肖 128
+丘群望 128
+东南角 128
+东周 128
+丰豫之 128
+丹楹 128
+久留 128
+二千平方米 128
+二数 128
+于步廊 128
+云拂羽 128
+云杉 128
+云绕龙 128
+五脏 128
+亚马孙河 128
+京广中心 128
+人名册 128
+人性化 128
+今德 128
+今正阳 128
+体味 128
+信鹏 128
+修造 128
+元宫城 128
+光华木材厂 128
+入耳 128
+全冠清 128
+全盛 128
+八日 128
+公冶乾 128
+公生 128
+六十根 128
+关洪野 128
+内蒙 128
+决斗 128
+几万片 128
+列岛 128
+刘基 128
+初兴靖 128
+加盖 128
+包红巾 128
+化子 128
+北京会议中心 128
+华觉明 128
+单詹歇 128
+变数 128
+叫嚣 128
+叶绿素 128
+叶缨 128
+合作化 128
+合办 128
+合谋 128
+同月 128
+名号 128
+唐为幽 128
+回味 128
+国际象棋 128
+圣土 128
+坠入 128
+基督教徒 128
+堕入 128
+墨水 128
+声速 128
+外海 128
+外祖父 128
+大冢匡 128
+大运河 128
+孙建文 128
+孙玉玺 128
+孟春祈 128
+安邦治 128
+定夺 128
+宣诏员 128
+宣金受 128
+宫城南 128
+宫大典 128
+宫才会 128
+寻杜谷 128
+寿兴国 128
+封之政 128
+小门 128
+工整 128
+布依 128
+干步廊 128
+平均主义 128
+建房 128
+张利民 128
+归咎 128
+徐啸 128
+徐啸力 128
+徐继斌 128
+成祖大 128
+扁铲 128
+扳机 128
+承天之 128
+承露盘 128
+抗菌 128
+拆散 128
+换算 128
+换言之 128
+探访 128
+搂抱 128
+摩托 128
+数十万万 128
+数落 128
+明宪 128
+明景宗 128
+明正言 128
+明顾炎 128
+景祖 128
+曲宇 128
+曹萧诗 128
+最惠国 128
+最起码 128
+有双凤 128
+有把握 128
+木凤衔 128
+木梁均 128
+朱厚磐 128
+李景林 128
+杜里调 128
+架子工 128
+查浦诗 128
+栅栏 128
+栗子 128
+核定 128
+梵语 128
+楅 128
+楼兰 128
+武进士徐 128
+武进士梅 128
+沈玉英 128
+河港 128
+泼辣 128
+洞门 128
+浮力 128
+海盐 128
+淝 128
+深思熟虑 128
+清律典 128
+漆巨柱 128
+潮州 128
+煞白 128
+牟利 128
+牧人 128
+犻 128
+献礼 128
+献礼片 128
+王瑞领 128
+生谢绍 128
+申宿令 128
+申请人 128
+白琳 128
+百四十六 128
+益切钦 128
+直肠癌 128
+碧波 128
+磁石 128
+神宗 128
+神祖主 128
+积分榜 128
+章淳 128
+筜 128
+红一方面军 128
+纲常 128
+纵火 128
+纽伦堡 128
+绝世 128
+罗小蛮 128
+耳聋 128
+职业化 128
+聪慧 128
+肃静 128
+胜出 128
+自大狂 128
+舒芬 128
+花观景 128
+花轿 128
+茅新进 128
+蒀 128
+蒯侍郎 128
+蒯祥官 128
+蒯祥死 128
+蓧 128
+藏传 128
+蚴 128
+补丁 128
+袖手旁观 128
+西红墙 128
+覃恩 128
+见多识广 128
+许三品 128
+许亲王 128
+许皇帝 128
+诜 128
+诸君 128
+诸进士 128
+质变 128
+贺子珍 128
+贾英廷 128
+赖矣 128
+越加 128
+跃进 128
+迎晓 128
+进场 128
+逆差 128
+金刀绣 128
+钟克勤 128
+钟灵可 128
+铁盒 128
+铤而走险 128
+铲除 128
+镫 128
+阙右门 128
+阙平明 128
+阙门未 128
+陈乔年 128
+陈履生 128
+陈警云 128
+随葬品 128
+面向对象 128
+饱餐 128
+马不停蹄 128
+高长河 128
+鲁特氏 128
+麒麟 128
+黄封殿 128
+黄巩 128
+黄榜 128
+龙服 128
+一五一十 127
+一夫 127
+一栋 127
+一气 127
+三寸 127
+不严 127
+丝织品 127
+中标 127
+举兵 127
+义兄 127
+乔大夯 127
+亚平妈 127
+仇家 127
+价格战 127
+伸舌头 127
+住址 127
+佝偻 127
+佣金 127
+佼佼者 127
+信使 127
+候选 127
+党代表 127
+入药 127
+公德 127
+六名 127
+内生 127
+再起 127
+出线 127
+制冷 127
+力战 127
+十二指肠 127
+十六个 127
+叆 127
+叧 127
+周报 127
+哭诉 127
+商品化 127
+囤 127
+圆球 127
+场子 127
+城建 127
+增资 127
+夈 127
+复归 127
+天价 127
+失散 127
+奴隶社会 127
+婕 127
+密集型 127
+寥寥无几 127
+射影 127
+小汽车 127
+尼克松 127
+屈从 127
+应天 127
+废气 127
+度假村 127
+座椅 127
+开庭 127
+徭 127
+德性 127
+思想史 127
+悧 127
+惯用 127
+愤愤 127
+扬子 127
+扭动 127
+抗战时期 127
+拉长 127
+招工 127
+拜托 127
+探子 127
+政坛 127
+文化馆 127
+暖气 127
+朽 127
+权重 127
+李德全 127
+杨树 127
+核技术 127
+核果 127
+楗 127
+橘 127
+欧洲共同体 127
+汤沛 127
+河南人 127
+深入人心 127
+添置 127
+清高 127
+漓江 127
+火攻 127
+烽火 127
+然则 127
+生根 127
+生硬 127
+田产 127
+甲壳 127
+疆界 127
+白寒枫 127
+白话 127
+眨巴 127
+碰巧 127
+禾本科 127
+秉承 127
+管待 127
+粲 127
+纯朴 127
+纲领性 127
+细碎 127
+缆 127
+老两口 127
+老妈妈 127
+耿耿于怀 127
+苦命 127
+茉 127
+草根 127
+药性 127
+藩王 127
+蚕食 127
+蝉联 127
+褥 127
+调档 127
+赵姨娘 127
+足球赛 127
+迈开 127
+连锁店 127
+郁郁 127
+酷爱 127
+重温 127
+锥形 127
+镇海 127
+阳气 127
+阴道炎 127
+集中地 127
+驯化 127
+麦当劳 127
+不遗余力 126
+专家组 126
+东南西北 126
+两江 126
+乙炔 126
+乱子 126
+了如指掌 126
+五六 126
+亡灵 126
+亮出 126
+今生 126
+从业者 126
+伸进 126
+低收入 126
+体统 126
+供销社 126
+保温 126
+俾斯麦 126
+写生 126
+出口量 126
+力矩 126
+加水 126
+半山 126
+协办 126
+卷土重来 126
+参考书 126
+发炎 126
+发生率 126
+合宜 126
+吴元华 126
+基调 126
+声张 126
+复古 126
+夏侯 126
+多些 126
+大区 126
+大雾 126
+奋发 126
+好好儿 126
+如之奈何 126
+妻室 126
+嫩江 126
+孔径 126
+宇文泰 126
+宗师 126
+宿太尉 126
+小树 126
+小玲子 126
+小站 126
+师从 126
+希腊语 126
+平添 126
+弹奏 126
+当归 126
+德高望重 126
+怪圈 126
+恰如 126
+悄无声息 126
+懿旨 126
+房顶 126
+打尖 126
+打牌 126
+执业资格 126
+抑郁症 126
+拓殖 126
+持久战 126
+挺立 126
+摇滚 126
+撇嘴 126
+效劳 126
+斑斓 126
+无意间 126
+明文规定 126
+朱九真 126
+朱高炽 126
+机房 126
+林克 126
+枯枝 126
+橱窗 126
+歌唱家 126
+水污染 126
+水浒 126
+沃尔夫 126
+津浦 126
+消停 126
+滑落 126
+满意度 126
+濒于 126
+炮制 126
+班师 126
+甑 126
+用钱 126
+癖 126
+盘面 126
+盛况 126
+瞭如指掌 126
+秦军 126
+穷困 126
+第四位 126
+算卦 126
+簪 126
+纳粹党 126
+缩手 126
+罗晓维 126
+耐力 126
+脱口 126
+茜 126
+莹 126
+蛩 126
+蜗牛 126
+言词 126
+记数 126
+贪官污吏 126
+踊 126
+辅以 126
+运回 126
+运输业 126
+返还 126
+还款 126
+追随者 126
+通路 126
+鄄 126
+镂空 126
+闲聊 126
+附息 126
+随随便便 126
+震怒 126
+韩愈 126
+音讯 126
+马克思主义者 126
+高射炮 126
+一不小心 125
+一帆风顺 125
+一视同仁 125
+一锭 125
+不共戴天 125
+不支 125
+专辑 125
+两篇 125
+临淄 125
+主业 125
+乒 125
+买进 125
+乱跑 125
+二十日 125
+交响乐 125
+交通银行 125
+京沪铁路 125
+介子 125
+仰面 125
+位列 125
+何红药 125
+佛罗里达 125
+保不住 125
+保证金 125
+信得过 125
+俯仰 125
+儒雅 125
+兴亡 125
+冰上 125
+冲锋陷阵 125
+凰 125
+刀尖 125
+初八 125
+包庇 125
+十多个 125
+卫海 125
+参谋部 125
+取自 125
+受力 125
+受孕 125
+同伙 125
+同夥 125
+后脑 125
+园地 125
+国事访问 125
+增选 125
+多头 125
+太阳神 125
+失掉 125
+好意思 125
+姑母 125
+威逼 125
+婶婶 125
+嬉戏 125
+孙策 125
+对不住 125
+小杨 125
+山神 125
+岔开 125
+崔秋山 125
+巷口 125
+府邸 125
+开窍 125
+张义兰 125
+徒劳 125
+心房 125
+打劫 125
+抒 125
+拉近 125
+拜堂 125
+探求 125
+推导 125
+推进器 125
+提纯 125
+敏感性 125
+新民 125
+新潮 125
+新诗 125
+新野 125
+日新月异 125
+杀人放火 125
+查办 125
+栽种 125
+梅朗 125
+水笙 125
+洞房 125
+浓雾 125
+浪子 125
+海事 125
+淡然 125
+深圳市中级人民法院 125
+滚落 125
+父母亲 125
+玉真子 125
+球蛋白 125
+琴弦 125
+申办 125
+画法 125
+痴迷 125
+白世镜 125
+白崇禧 125
+皮肤病 125
+矇住 125
+石庄主 125
+石河 125
+社会性 125
+神州行 125
+突触 125
+立誓 125
+第十次 125
+精炼 125
+绝句 125
+缟 125
+网络化 125
+肛 125
+脓肿 125
+自传 125
+船尾 125
+芒果 125
+苇 125
+草料 125
+荣华富贵 125
+蒙住 125
+虎头 125
+蚕茧 125
+螛 125
+观世音 125
+规约 125
+视距 125
+词人 125
+试想 125
+该区 125
+适得其反 125
+那幅 125
+阴历 125
+隐没 125
+雁门关 125
+雪亮 125
+面相 125
+鞭打 125
+颓然 125
+飙升 125
+飞鸟 125
+高密 125
+鲎 125
+鸵 125
+黄蓉见 125
+一万两 124
+三倍 124
+东张西望 124
+两响 124
+两轮 124
+中空 124
+丰臣秀 124
+事业部 124
+二三 124
+五颜六色 124
+人寿 124
+人文科学 124
+代表处 124
+伯克 124
+何地 124
+俯伏 124
+几支 124
+分子结构 124
+刹那 124
+卑微 124
+原发性 124
+发帖 124
+受难 124
+史官 124
+史峤 124
+吉安 124
+后事 124
+吐谷浑 124
+唐古拉山 124
+国安队 124
+图书馆学 124
+土墙 124
+地磁 124
+城防 124
+如皋 124
+姚苌 124
+孤寂 124
+安康 124
+宋史 124
+宋徽宗 124
+室温 124
+尖利 124
+屋门 124
+巢湖 124
+广为流传 124
+广电总局 124
+庆功 124
+应用软件 124
+强渡 124
+录音带 124
+怯懦 124
+悠然 124
+慨叹 124
+截获 124
+扫清 124
+抽打 124
+接手 124
+摆弄 124
+支吾 124
+改道 124
+政敌 124
+断裂带 124
+无损 124
+有线电视 124
+朝拜 124
+木桩 124
+本地人 124
+本村 124
+板壁 124
+梦寐以求 124
+殷商 124
+殷红 124
+民族学 124
+水火 124
+沾染 124
+法学院 124
+派头 124
+海洛因 124
+涯 124
+深意 124
+渔港 124
+灿坤 124
+热处理 124
+燕国 124
+牛鼻子 124
+现年 124
+生就 124
+由是 124
+番号 124
+相投 124
+相爱 124
+硪 124
+社火 124
+神人 124
+科尔 124
+纱锭 124
+织成 124
+终归 124
+结集 124
+继母 124
+缫丝 124
+考评 124
+耐人寻味 124
+联考 124
+育苗 124
+能动 124
+良田 124
+虎年 124
+计提 124
+议论纷纷 124
+词曲 124
+贝多芬 124
+财阀 124
+跌幅 124
+蹒跚 124
+辑录 124
+连日来 124
+遗传物质 124
+釜山 124
+钚 124
+门神 124
+闹剧 124
+闽江 124
+阴部 124
+陆小艺 124
+陈禹 124
+难事 124
+额角 124
+飨 124
+马岛 124
+马岱 124
+马桶 124
+高调 124
+魇 124
+万丈 123
+三八线 123
+三十万 123
+世界级 123
+世系 123
+东三省 123
+中草药 123
+临阵 123
+亲朋好友 123
+位处 123
+保龄球 123
+倞 123
+光秃秃 123
+兖 123
+党务 123
+公产 123
+公债 123
+公署 123
+关宁兵 123
+农事 123
+减值 123
+凹凸 123
+千五 123
+反扑 123
+发生器 123
+叶柄 123
+后缘 123
+含金量 123
+吴承瑛 123
+周游 123
+固着 123
+地皮 123
+地窖 123
+塔里木河 123
+墙根 123
+增值税 123
+墨尔本 123
+大逆不道 123
+失却 123
+失足 123
+奇兵 123
+妻妾 123
+嬷嬷 123
+完整性 123
+客套 123
+宰杀 123
+小玄子 123
+川东 123
+巡按 123
+巨变 123
+庙会 123
+开脱 123
+张有智 123
+强攻 123
+强求 123
+当家的 123
+怨毒 123
+悔恨 123
+悕 123
+悠 123
+戈尔巴乔夫 123
+投标 123
+探险队 123
+控球 123
+攀比 123
+政策性 123
+文学院 123
+新文化 123
+日日夜夜 123
+明令 123
+显贵 123
+智谋 123
+暴戾 123
+月饼 123
+木然 123
+本业 123
+本相 123
+条码 123
+架势 123
+梨花 123
+欲言又止 123
+正教 123
+比萨 123
+气闷 123
+水潭 123
+水牛 123
+沾满 123
+法老 123
+海龟 123
+深恶痛绝 123
+混账 123
+清丽 123
+湄公河 123
+漠视 123
+潘金莲 123
+烟道 123
+牧羊 123
+特邀 123
+猫头鹰 123
+珛 123
+用材 123
+病史 123
+瘑 123
+瘢痕 123
+直销 123
+眸子 123
+祝寿 123
+神速 123
+称奇 123
+端午节 123
+细化 123
+经济法 123
+结拜 123
+缎子 123
+群起 123
+耳畔 123
+胡青风 123
+膺 123
+航海家 123
+艾穆 123
+苏联政府 123
+草率 123
+荒僻 123
+菇 123
+行销 123
+补报 123
+褒 123
+要约 123
+该会 123
+诬蔑 123
+调价 123
+负于 123
+达赖喇嘛 123
+过活 123
+这辆 123
+鄣 123
+里约热内卢 123
+鋈 123
+钟山 123
+钟情 123
+铁锁 123
+长街 123
+阿拉法特 123
+陆立鼎 123
+驾临 123
+骄横 123
+高墙 123
+高阁 123
+鸬 123
+龟儿子 123
+一片片 122
+一腔 122
+七岁 122
+三张 122
+下关 122
+下界 122
+丝状 122
+乙酸 122
+买不起 122
+人犯 122
+令人满意 122
+伊比利亚 122
+伤处 122
+住手 122
+体魄 122
+倾角 122
+假日办 122
+入围 122
+全数 122
+兴头 122
+养伤 122
+军事学院 122
+冷峻 122
+凶悍 122
+出局 122
+击鼓 122
+刘国能 122
+刚果河 122
+劈头 122
+升格 122
+单发 122
+卡宾枪 122
+印加 122
+厅里 122
+厚道 122
+合法化 122
+合资企业 122
+后盾 122
+后顾之忧 122
+启蒙运动 122
+员外郎 122
+哐 122
+喜鹊 122
+嘹亮 122
+圆周率 122
+地磁场 122
+多者 122
+天涯海角 122
+娿 122
+媒人 122
+存量 122
+宁肯 122
+富民 122
+小家伙 122
+差点儿 122
+巴伐利亚 122
+布匹 122
+常例 122
+弃权票 122
+张海 122
+形容词 122
+彷 122
+彼得堡 122
+很多年 122
+忠厚 122
+慢性病 122
+战国策 122
+打字机 122
+扣子 122
+抵销 122
+招揽 122
+收据 122
+数百万 122
+无上 122
+明帝 122
+李振邺 122
+榄 122
+正人君子 122
+武人 122
+武王 122
+沙哑 122
+没意思 122
+油水 122
+海口市 122
+激发态 122
+火山岛 122
+火辣辣 122
+灵台 122
+灵智 122
+班里 122
+疫 122
+着色 122
+睡意 122
+碟子 122
+礁石 122
+祛 122
+神经细胞 122
+租税 122
+立定 122
+第三步 122
+第十八 122
+第四种 122
+答非所问 122
+管弦乐 122
+纷飞 122
+继承权 122
+罕有 122
+胡大哥 122
+自嘲 122
+自得 122
+舢板 122
+艾森豪威尔 122
+苇子 122
+苏州市 122
+英名 122
+英里 122
+茄子 122
+荒岛 122
+董平 122
+血路 122
+行止 122
+讨论会 122
+设计局 122
+诬告 122
+语境 122
+诸侯王 122
+负心 122
+赎罪 122
+转脸 122
+轴线 122
+边防军 122
+迪奥 122
+遴选 122
+酒瓶 122
+镇远 122
+长长地 122
+防晒 122
+阳痿 122
+阴丽华 122
+阿克苏 122
+陋 122
+降落伞 122
+顶撞 122
+颇为 122
+颚 122
+风月 122
+馈 122
+骗局 122
+高唯 122
+万里长城 121
+东关 121
+中华苏维埃共和国 121
+乱动 121
+五脏六腑 121
+交割 121
+交融 121
+何惕守 121
+俭 121
+倒入 121
+倔 121
+偏袒 121
+停赛 121
+克扣 121
+内室 121
+军分区 121
+凇 121
+凉风 121
+出气 121
+创见 121
+办完 121
+加劲 121
+助战 121
+包围圈 121
+化工厂 121
+双轮 121
+发话 121
+取道 121
+叡 121
+口碑 121
+嗷 121
+国有化 121
+地下党 121
+坏蛋 121
+天目 121
+失恋 121
+夹角 121
+孝心 121
+家破人亡 121
+寄予 121
+富士 121
+对立面 121
+干细胞 121
+并力 121
+府城 121
+弧线 121
+弬 121
+快攻 121
+悻悻 121
+手印 121
+扑面而来 121
+抬举 121
+拖鞋 121
+拘泥 121
+择偶 121
+按期 121
+损益 121
+接头 121
+掼 121
+摞 121
+摩根 121
+擀 121
+收听 121
+改行 121
+斗牛 121
+新作 121
+旁遮普 121
+早熟 121
+春雨 121
+暗处 121
+有产者 121
+有恃无恐 121
+枣树 121
+此战 121
+水塘 121
+沟里 121
+没事儿 121
+洗钱 121
+深达 121
+灵山 121
+烟花 121
+烧杯 121
+焦灼 121
+煳 121
+爱子 121
+病历 121
+痛斥 121
+盐业 121
+监听 121
+相碰 121
+睾 121
+砝码 121
+秘方 121
+稀烂 121
+稹 121
+窗上 121
+章楚红 121
+童立本 121
+第一份 121
+第二场 121
+第五代 121
+罪魁祸首 121
+羞惭 121
+耷拉 121
+肝胆 121
+肥厚 121
+脊椎 121
+茶具 121
+虚空 121
+蚜 121
+西安交通大学 121
+西苑 121
+误伤 121
+谎话 121
+谢清斋 121
+谱写 121
+足智多谋 121
+蹋 121
+转嫁 121
+退伍 121
+透气 121
+郑公子 121
+镌 121
+门框 121
+闹市 121
+隐性 121
+集合体 121
+雪峯 121
+雪峰 121
+馕 121
+驶过 121
+鱼塘 121
+鲁肃 121
+一斗 120
+一票 120
+一臂之力 120
+七名 120
+三百年 120
+上证 120
+下期 120
+丘陵区 120
+东起 120
+乌江 120
+乔帮主 120
+九寨沟 120
+乩 120
+五毒 120
+亲缘 120
+仆妇 120
+休斯 120
+倩 120
+偏好 120
+光合 120
+六十年 120
+关隘 120
+兵荒马乱 120
+农产 120
+冯文修 120
+冰盖 120
+凭借着 120
+凯恩斯 120
+凶残 120
+击剑 120
+前世 120
+劫难 120
+包谷 120
+千变万化 120
+千钧一发 120
+卡塔尔 120
+发髻 120
+变小 120
+口唇 120
+口香糖 120
+名正言顺 120
+吕氏 120
+君主立宪 120
+呢绒 120
+周折 120
+商鞅 120
+嘉陵江 120
+嘻嘻哈哈 120
+四时 120
+围裙 120
+圆锥 120
+坨 120
+基本功 120
+基线 120
+壮丁 120
+声讨 120
+外交大臣 120
+夜战 120
+大队长 120
+大黄 120
+夹层 120
+套话 120
+好望角 120
+妊娠期 120
+子系统 120
+孟健雄 120
+安培 120
+室主任 120
+宫颈癌 120
+寒带 120
+寔 120
+小鸡 120
+山东鲁能 120
+巷道 120
+希冀 120
+帚 120
+常胜 120
+影印 120
+待命 120
+心绞痛 120
+恶果 120
+悬空 120
+慌慌张张 120
+慢吞吞 120
+战史 120
+打折扣 120
+把子 120
+拖累 120
+挖苦 120
+敬爱 120
+有假 120
+朗朗 120
+杀灭 120
+李克智 120
+李宝 120
+李渊 120
+榫 120
+橡皮 120
+欢乐谷 120
+正确性 120
+母爱 120
+江户 120
+涤 120
+清流 120
+温情 120
+湀 120
+灰心 120
+灵便 120
+猛将 120
+猬 120
+理发师 120
+生意人 120
+男单 120
+畬 120
+畾 120
+白脸 120
+白鹤 120
+省去 120
+矿化度 120
+砖墙 120
+离心 120
+秉笔 120
+租房 120
+立身 120
+粉丝 120
+系统化 120
+约摸 120
+纯真 120
+纱布 120
+维权 120
+缢 120
+美国会 120
+羽翼 120
+老父 120
+老蒋 120
+耳环 120
+职掌 120
+联系人 120
+脑浆 120
+腕上 120
+腭 120
+艮 120
+萨姆 120
+落魄 120
+蔼 120
+血污 120
+讨教 120
+诵读 120
+谄 120
+豪情 120
+贪生怕死 120
+费劲 120
+轻骑 120
+运销 120
+酧 120
+钻探 120
+铋 120
+锆 120
+锶 120
+镯子 120
+降解 120
+隐忍 120
+雇工 120
+雷州 120
+飞蝗 120
+黄眉僧 120
+默默无闻 120
+龙井茶 120
+三里 119
+上山下乡 119
+下肚 119
+两粒 119
+中西医 119
+亚泰 119
+仙境 119
+余篇 119
+作势 119
+信息处理 119
+傦 119
+充其量 119
+党部 119
+公示 119
+内置 119
+冷暖 119
+出走 119
+利弊 119
+化痰 119
+半碗 119
+南岭 119
+原子序数 119
+取水 119
+变卖 119
+名流 119
+呵呵 119
+咋样 119
+喃喃自语 119
+喔 119
+回禀 119
+土人 119
+坡地 119
+城郭 119
+堆放 119
+塔什干 119
+多极化 119
+多着 119
+大鱼 119
+天马 119
+头里 119
+奏鸣曲 119
+女佣 119
+奸诈 119
+安睡 119
+官爵 119
+定罪 119
+小宝 119
+平分 119
+年龄段 119
+御膳 119
+循序渐进 119
+微电子 119
+心惊胆战 119
+忧心 119
+快报 119
+抽空 119
+拖住 119
+捐助 119
+撤兵 119
+支承 119
+敕令 119
+教区 119
+整块 119
+斯菲尔德 119
+新台币 119
+新政府 119
+旦夕 119
+智能化 119
+有方 119
+条件反射 119
+来路 119
+杨一清 119
+柠檬酸 119
+桐油 119
+档案馆 119
+检修 119
+歇斯底里 119
+气势汹汹 119
+水城 119
+没来由 119
+沪市 119
+流感 119
+浓浓的 119
+淮海 119
+物权 119
+瓦片 119
+电弧 119
+皇恩 119
+盆子 119
+盟约 119
+砾 119
+硕士生 119
+确凿 119
+碧玉 119
+碳酸 119
+磁体 119
+社保 119
+秦良玉 119
+积淀 119
+空儿 119
+立交桥 119
+红茶 119
+纽扣 119
+继承者 119
+羽田 119
+背风 119
+能动性 119
+脚踏实地 119
+自首 119
+船老大 119
+草鞋 119
+萨克森 119
+蒙特利尔 119
+解放军报 119
+解闷 119
+警卫员 119
+诊疗 119
+财政部长 119
+赔罪 119
+赵氏 119
+足联 119
+车流量 119
+运量 119
+追悼会 119
+退居 119
+逾期 119
+遗传性 119
+郑亲王 119
+野心勃勃 119
+银娣 119
+长时间 119
+门徒 119
+须眉 119
+高高兴兴 119
+髡 119
+魂不附体 119
+鸡毛 119
+鹿清笃 119
+麝香 119
+齐鸣 119
+一时三刻 118
+不犯 118
+丝织 118
+中考 118
+临场 118
+久经 118
+乔纳森 118
+习惯性 118
+习题 118
+二十万两 118
+二郎 118
+付诸实施 118
+伊藤 118
+估摸 118
+保鲜 118
+修成 118
+停歇 118
+偷偷摸摸 118
+兴安 118
+凉亭 118
+几两 118
+几百万 118
+分文 118
+刊载 118
+创造者 118
+前委 118
+加冕 118
+劳动密集型 118
+半生 118
+单价 118
+南亚次大陆 118
+印刷术 118
+印尼队 118
+发电厂 118
+取值 118
+变体 118
+后腿 118
+嘈 118
+困倦 118
+坟地 118
+外孙 118
+大吏 118
+天极网 118
+好客 118
+娄 118
+孙海 118
+孤身一人 118
+富华 118
+射入 118
+小伙 118
+小夥 118
+小指 118
+小曲 118
+小花 118
+帕米尔 118
+帿 118
+年迈 118
+并非如此 118
+幼子 118
+弄错 118
+彁 118
+彭城 118
+得利 118
+得益于 118
+忍无可忍 118
+忍痛 118
+总决赛 118
+总集 118
+想尽 118
+慕容博 118
+慰藉 118
+打鱼 118
+折衷 118
+拉普拉斯 118
+排卵 118
+揭穿 118
+播放器 118
+操劳 118
+救救 118
+整饬 118
+斡旋 118
+无物 118
+无道 118
+时限 118
+昏晕 118
+服兵役 118
+朱见深 118
+来龙去脉 118
+林带 118
+柏柏尔 118
+梵蒂冈 118
+氢氧化钠 118
+法儿 118
+注水 118
+洗刷 118
+浴场 118
+海神 118
+海老清 118
+涌起 118
+涵养 118
+淀 118
+渐进 118
+湖滨 118
+湘潭 118
+火头 118
+火苗 118
+烈日 118
+烻 118
+牺牲品 118
+猿猴 118
+生气勃勃 118
+督抚 118
+短裤 118
+祈福 118
+索郎泽 118
+经济史 118
+继发性 118
+翠绿 118
+背离 118
+胜算 118
+菜园 118
+西蒙 118
+解冻 118
+警车 118
+诙 118
+请勿 118
+败亡 118
+赵用贤 118
+足底 118
+过门 118
+迥然不同 118
+透顶 118
+遇害 118
+部里 118
+酷暑 118
+里斯本 118
+金价 118
+钱塘 118
+钻孔 118
+锰矿 118
+镣 118
+长毛 118
+长江口 118
+隶书 118
+雠 118
+饥渴 118
+饶恕 118
+龙口 118
+一筹莫展 117
+一触即发 117
+丁大哥 117
+三头 117
+不外乎 117
+不寒而栗 117
+东渡 117
+临到 117
+临清 117
+乓 117
+乾草 117
+云山 117
+五千年 117
+人文主义 117
+休息室 117
+侍者 117
+信守 117
+倍数 117
+倾家荡产 117
+兢兢业业 117
+公共场所 117
+冠军杯 117
+出口额 117
+出类拔萃 117
+创业者 117
+创建人 117
+务求 117
+动怒 117
+千辛万苦 117
+半成品 117
+卑下 117
+卡通 117
+变型 117
+变本加厉 117
+合掌 117
+吝啬 117
+品评 117
+喜气洋洋 117
+四部 117
+圣训 117
+坛子 117
+埃弗顿 117
+埋头苦干 117
+增压 117
+外方 117
+夸耀 117
+奶妈 117
+如火如荼 117
+姉 117
+威海卫 117
+孤军 117
+安达 117
+布告 117
+干草 117
+床铺 117
+序曲 117
+建业 117
+异构 117
+往复 117
+征文 117
+徵文 117
+必不可缺 117
+怎么办 117
+手工艺品 117
+手舞足蹈 117
+拨给 117
+捣鬼 117
+掉转 117
+撕破 117
+擞 117
+教程 117
+数据处理 117
+新闻社 117
+星期三 117
+昭示 117
+月华 117
+有声 117
+杭州人 117
+查封 117
+栉 117
+欢腾 117
+止步 117
+正交 117
+正弦 117
+毕加索 117
+毦 117
+民主改革 117
+氯化钠 117
+求教 117
+汲 117
+沙丁鱼 117
+油轮 117
+油页岩 117
+波折 117
+海大 117
+润滑油 117
+滇池 117
+滑溜 117
+潍坊 117
+澎湃 117
+澎湖 117
+灯泡 117
+烟斗 117
+爬山 117
+狡诈 117
+狲 117
+獭 117
+王仁美 117
+王铁口 117
+用工 117
+申述 117
+番茄 117
+痛经 117
+瘪 117
+白金 117
+百名 117
+省事 117
+瞬息 117
+硬性 117
+碑刻 117
+神祗 117
+第二日 117
+糊弄 117
+红烛 117
+约略 117
+纸浆 117
+细问 117
+续航力 117
+绿竹 117
+罗素 117
+罪责 117
+置办 117
+自以为是 117
+臭氧层 117
+花果山 117
+苯甲酸 117
+荥阳 117
+葛尔丹 117
+薛月儿 117
+蜂巢 117
+调养 117
+调匀 117
+谭震林 117
+赵亮 117
+越位 117
+路人 117
+辛劳 117
+迎击 117
+进位 117
+连天 117
+道行 117
+郑州市 117
+郭靖见 117
+释迦牟尼 117
+量化 117
+钾盐 117
+阳离子 117
+附加值 117
+陵寝 117
+靠得住 117
+饭盒 117
+馆子 117
+驶入 117
+麦秸 117
+鼻尖 117
+不了了之 116
+专制主义 116
+个体户 116
+中央革命根据地 116
+丰沛 116
+乔道清 116
+乖巧 116
+乾爹 116
+互惠 116
+些小 116
+交钱 116
+仄 116
+从天而降 116
+仓卒 116
+伀 116
+企业界 116
+优越感 116
+体罚 116
+余座 116
+保险费 116
+倾泻 116
+偷懒 116
+共性 116
+关键词 116
+冿 116
+凤翔 116
+分叉 116
+制宪 116
+十九日 116
+南宫 116
+南欧 116
+双剑 116
+口气道 116
+古生物 116
+台式机 116
+右眼 116
+叶酸 116
+吉诺 116
+听证会 116
+哈雷 116
+哥萨克 116
+唉声叹气 116
+商业化 116
+噶尔 116
+困乏 116
+国家杜马 116
+圆寂 116
+声母 116
+备份 116
+复杂化 116
+大喊大叫 116
+奇耻大辱 116
+套房 116
+如此一来 116
+字句 116
+安逸 116
+官道 116
+宝应 116
+寅 116
+尿素 116
+山洪 116
+岳维山 116
+左丞相 116
+布鞋 116
+干支 116
+干爹 116
+库车 116
+底细 116
+廖锡龙 116
+异乎寻常 116
+张康 116
+情急 116
+惩戒 116
+抄写 116
+持续性 116
+掔 116
+推动力 116
+教条 116
+旅游团 116
+日数 116
+星辰 116
+暴政 116
+曲率 116
+李忠 116
+核素 116
+止境 116
+正史 116
+永嘉 116
+沛 116
+洪都拉斯 116
+流浪汉 116
+混为一谈 116
+炮手 116
+烦闷 116
+照办 116
+熊文灿 116
+犊 116
+由来已久 116
+电子器件 116
+画报 116
+瘦长 116
+相片 116
+知心 116
+短篇 116
+秦淮河 116
+突防 116
+立法会 116
+站上 116
+筵宴 116
+紫金 116
+红袍 116
+红颜 116
+绂 116
+绝艺 116
+继之 116
+绸带 116
+综观 116
+缩影 116
+罗氏 116
+老妈子 116
+耶鲁大学 116
+肖伟 116
+脚尖 116
+自然经济 116
+舞会 116
+色散 116
+苦力 116
+草莓 116
+莫氏 116
+菲尼克斯 116
+落泪 116
+虚张声势 116
+行囊 116
+行装 116
+裂片 116
+西游记 116
+角膜炎 116
+许都 116
+豪放 116
+货真价实 116
+身心健康 116
+转眼之间 116
+输尿管 116
+选题 116
+通鉴 116
+逝去 116
+邓州 116
+里德 116
+长尾 116
+闷气 116
+阐 116
+陆大有 116
+陶片 116
+雷公 116
+音域 116
+音阶 116
+风箱 116
+首度 116
+香槟 116
+马世耀 116
+马拉 116
+马来半岛 116
+默许 116
+一干二净 115
+三十二年 115
+三大战役 115
+三相 115
+不愧为 115
+二胡 115
+交朋友 115
+交通管理 115
+产经 115
+亲见 115
+令狐冲心 115
+伸直 115
+假冒伪劣 115
+偏振 115
+儿科 115
+先父 115
+光明正大 115
+光棍 115
+八百 115
+内行 115
+再接再厉 115
+再造 115
+冯敏杰 115
+初恋 115
+刽子手 115
+动议 115
+助学金 115
+单产 115
+卖淫 115
+厂子 115
+吃食 115
+合格证 115
+吴婉容 115
+吸食 115
+味觉 115
+商业性 115
+嗅到 115
+嘣 115
+围坐 115
+基点 115
+塞入 115
+声声 115
+多哥 115
+大展 115
+大悲 115
+天下事 115
+夯土 115
+姳 115
+媾和 115
+孙传芳 115
+宗亲 115
+宝柱 115
+寂然 115
+富余 115
+小泉 115
+小调 115
+屠戮 115
+州市 115
+工种 115
+布景 115
+年鉴 115
+年青 115
+广电 115
+废人 115
+强队 115
+弼 115
+恶霸 115
+悉心 115
+意象 115
+手软 115
+抚摩 115
+抵赖 115
+按揭 115
+探知 115
+掩体 115
+搏击 115
+搜罗 115
+搬走 115
+政务院 115
+敌阵 115
+散失 115
+文稿 115
+斯洛文尼亚 115
+无机盐 115
+明治维新 115
+星系团 115
+暗号 115
+暗红色 115
+暴利 115
+有救 115
+本镇 115
+机械能 115
+李登云 115
+校内 115
+桡 115
+梗阻 115
+檬 115
+殓 115
+毒计 115
+洄游 115
+涮 115
+淙 115
+漠然 115
+焚化 115
+煜 115
+煮沸 115
+父辈 115
+猛增 115
+现实性 115
+球体 115
+痴情 115
+登陆战 115
+盛唐 115
+直挺挺 115
+砺 115
+示意图 115
+科学化 115
+秦云飞 115
+窗纸 115
+答卷 115
+粗重 115
+累死 115
+绝经 115
+翻越 115
+肺结核 115
+自焚 115
+自筹 115
+船伕 115
+船夫 115
+芟 115
+莫声谷 115
+落脚 115
+褐煤 115
+讨饭 115
+让路 115
+讼案 115
+诱饵 115
+贫困户 115
+赫赫 115
+踱步 115
+车行道 115
+过桥 115
+野草 115
+金质 115
+钱普 115
+铠 115
+长夜 115
+防空洞 115
+风扇 115
+首当其冲 115
+马儿 115
+马瑶草 115
+骂声 115
+麦克阿瑟 115
+一窝蜂 114
+七嘴八舌 114
+万有引力 114
+不结盟 114
+东夷 114
+东拉河 114
+两用桥 114
+两鬓 114
+中书令 114
+中亚地区 114
+中外合资 114
+中藏 114
+中选 114
+中郎将 114
+举家 114
+买点 114
+二日 114
+二类 114
+亟待 114
+交大 114
+亲兄弟 114
+亵 114
+佞臣 114
+侯爵 114
+信步 114
+修长 114
+八仙桌 114
+兵卒 114
+写意 114
+凉粉 114
+凝胶 114
+几间 114
+凯利 114
+凯歌 114
+划清 114
+刚巧 114
+利比里亚 114
+到此为止 114
+制成品 114
+制片 114
+匆 114
+匿 114
+十七日 114
+南方局 114
+受降 114
+台湾人 114
+合成纤维 114
+商埠 114
+国大 114
+国家标准 114
+囿 114
+地拉 114
+地洞 114
+垦殖 114
+复生 114
+太妃 114
+奇袭 114
+女真人 114
+宋太宗 114
+宛然 114
+寨子 114
+封建制 114
+将门 114
+少阳 114
+尼布楚 114
+屏住 114
+岷 114
+幽暗 114
+庄太后 114
+弹指 114
+彭莹玉 114
+恩赐 114
+恶习 114
+把柄 114
+接风 114
+揭帖 114
+援手 114
+摄食 114
+方桌 114
+明早 114
+曹雪芹 114
+有声有色 114
+有效射程 114
+李国瑞 114
+杭嘉平 114
+某位 114
+染坊 114
+植入 114
+楼市 114
+欠佳 114
+比照 114
+永定河 114
+汉藏语系 114
+汉阳铁 114
+江心 114
+法国政府 114
+洽 114
+浮云 114
+消炎 114
+消肿 114
+液滴 114
+深秋 114
+渴求 114
+游丝 114
+湘南 114
+滐 114
+满街 114
+火盆 114
+点拨 114
+狅 114
+狙击手 114
+玩艺儿 114
+班图 114
+癳 114
+登高 114
+盘剥 114
+直裰 114
+砾岩 114
+福音 114
+科学界 114
+空心菜 114
+窠 114
+立委 114
+笔触 114
+算算 114
+粒状 114
+粖 114
+粘连 114
+素称 114
+红四军 114
+经营权 114
+维森特 114
+羧酸 114
+老城 114
+考订 114
+胳臂 114
+脱困 114
+自治领 114
+荷尔蒙 114
+蜻蜓 114
+西进 114
+触觉 114
+许下 114
+谙 114
+豆浆 114
+贝利 114
+赵小双 114
+过渡性 114
+远非 114
+违犯 114
+选举人 114
+选修 114
+遗骨 114
+采办 114
+野猫 114
+铿锵 114
+镬 114
+阮星竹 114
+陈鼻 114
+陡坡 114
+青海湖 114
+靖儿 114
+鞍钢 114
+顾念 114
+颤巍巍 114
+马尔代夫 114
+髁 114
+高岗 114
+高桂英 114
+高纬度 114
+黄芪 114
+黄铜 114
+黑雾 114
+一堂 113
+一筹 113
+万米 113
+三块 113
+专利权 113
+严令 113
+中天 113
+中央局 113
+串通 113
+乇 113
+交友 113
+人机 113
+亿股 113
+任一 113
+何其 113
+佛家 113
+佩剑 113
+俊俏 113
+俯瞰 113
+倒戈 113
+健身房 113
+光柱 113
+凌乱 113
+几把 113
+几近 113
+刘司令 113
+刷牙 113
+匕 113
+十余招 113
+半里 113
+南站 113
+发稿 113
+咕嘟 113
+喇嘛教 113
+喜马拉雅山脉 113
+囬 113
+堤防 113
+多多少少 113
+大喜过望 113
+大麻 113
+太平洋地区 113
+奇幻 113
+娼妓 113
+实证主义 113
+家住 113
+小红马 113
+小鹿 113
+巨款 113
+巴塞尔 113
+帆布 113
+常平 113
+幽雅 113
+应有尽有 113
+底特律 113
+店面 113
+庙堂 113
+庭园 113
+开开 113
+张良 113
+忥 113
+战况 113
+执业 113
+执勤 113
+报告文学 113
+招商局 113
+指斥 113
+提辖 113
+文明史 113
+方便面 113
+方便麪 113
+方士 113
+无愧 113
+无机物 113
+明说 113
+昕 113
+未遂 113
+村寨 113
+林生 113
+架次 113
+柳枝 113
+核武 113
+模板 113
+死死地 113
+毛毯 113
+毛竹 113
+气绝 113
+水落石出 113
+油然而生 113
+泰语 113
+浙西 113
+渡海 113
+澳大利亚人 113
+激动人心 113
+灰暗 113
+爆炸性 113
+犯不着 113
+猎犬 113
+献祭 113
+玛丽 113
+生姜 113
+画集 113
+畿辅 113
+痛痛快快 113
+盎司 113
+盛衰 113
+瞠目结舌 113
+粗放 113
+索超 113
+红红的 113
+织工 113
+美感 113
+考古队 113
+耳闻 113
+联欢 113
+联运 113
+聚丙烯 113
+聪明伶俐 113
+脱出 113
+腰包 113
+自下而上 113
+自然主义 113
+自然法 113
+航路 113
+艄公 113
+若有所思 113
+获知 113
+葛洛 113
+蒸发量 113
+虢 113
+街区 113
+言伯乾 113
+词义 113
+谢景新 113
+货运量 113
+贫困生 113
+贸易区 113
+赚取 113
+赤杨 113
+路基 113
+迪拜 113
+送与 113
+遣返 113
+邓艾 113
+酒类 113
+金川 113
+金昌 113
+锋锐 113
+镠 113
+长春市 113
+长途电话 113
+问好 113
+闲事 113
+防火墙 113
+阳城 113
+陈镜泉 113
+青岛市 113
+鞠 113
+预装 113
+颜康 113
+香案 113
+马萨诸塞州 113
+高句丽 113
+高名衡 113
+鬼头 113
+鬼话 113
+鸥 113
+黑漆漆 113
+黑脸 113
+黑道 113
+龃 113
+一丝不苟 112
+一亩 112
+下凡 112
+不拘 112
+不法分子 112
+东方红 112
+东端 112
+中国新民主主义革命 112
+为伍 112
+举世瞩目 112
+乘积 112
+二万 112
+五十万 112
+人世间 112
+人眼 112
+代行 112
+众目睽睽 112
+传诵 112
+借此机会 112
+停工 112
+傅作义 112
+入夜 112
+八岁 112
+八方 112
+六岁 112
+共和制 112
+冠状动脉 112
+冲积扇 112
+凶多吉少 112
+刘璋 112
+制陶 112
+前庭 112
+剖腹 112
+副业 112
+加价 112
+加尔各答 112
+加长 112
+包抄 112
+北京师范大学 112
+十一个 112
+半叶 112
+华辉 112
+南京大学 112
+南诏 112
+卜辞 112
+卢克纳 112
+卢瑟福 112
+县衙 112
+参会 112
+取回 112
+变乱 112
+口述 112
+叮咛 112
+右腕 112
+同一性 112
+名作 112
+回纥 112
+圆锥花序 112
+地块 112
+外阴 112
+多平方米 112
+多艘 112
+天光 112
+天罡 112
+太阴 112
+失血 112
+好使 112
+如一 112
+如上 112
+如释重负 112
+姚文元 112
+媛 112
+安力满 112
+安居乐业 112
+寒战 112
+对子 112
+封装 112
+小脑 112
+小陈 112
+岛弧 112
+市属 112
+幼苗 112
+康佳 112
+建宁 112
+异己 112
+弄虚作假 112
+弗莱舍 112
+弛 112
+强权 112
+心慌意乱 112
+心窝 112
+怒色 112
+您好 112
+惯常 112
+感染者 112
+抗病毒 112
+招生办 112
+掌故 112
+摄动 112
+摇头晃脑 112
+摘自 112
+操行 112
+政要 112
+文成 112
+斯堪的纳维亚 112
+无中生有 112
+日本海 112
+明证 112
+有神 112
+杀机 112
+杂役 112
+杂粮 112
+杂货 112
+极光 112
+架空 112
+查士丁 112
+桁梁 112
+槈 112
+欢天喜地 112
+此文 112
+毕恭毕敬 112
+毛衣 112
+氩 112
+油腻 112
+泦 112
+浮夸 112
+淡季 112
+清官 112
+渺小 112
+灵验 112
+炊烟 112
+烟雨 112
+烧瓶 112
+照明弹 112
+狂怒 112
+猕猴 112
+理工大学 112
+璀璨 112
+生活史 112
+病原 112
+知识青年 112
+矫健 112
+磁层 112
+移位 112
+窃取 112
+笑语 112
+系列赛 112
+线虫 112
+绝食 112
+续航 112
+缈 112
+罢课 112
+翻飞 112
+背斜 112
+胡国华 112
+脐 112
+脚踝 112
+膨大 112
+自告奋勇 112
+营口 112
+蚦 112
+血痕 112
+裸子植物 112
+西京 112
+要员 112
+讴歌 112
+译者 112
+该队 112
+诱因 112
+谈心 112
+财源 112
+赵凤昌 112
+轻捷 112
+辞退 112
+迅即 112
+连续不断 112
+郪 112
+配对 112
+配给 112
+酬谢 112
+里加 112
+重金属 112
+野地 112
+鏖战 112
+钥 112
+铁树 112
+门第 112
+阴云 112
+阿基米德 112
+降压 112
+降生 112
+隆达斯 112
+预兆 112
+风土人情 112
+马兰 112
+高见 112
+鸟兽 112
+黄帮主 112
+黄钟公 112
+黑发 112
+七上八下 111
+上下文 111
+专攻 111
+世贸组织 111
+习武 111
+京郊 111
+何谓 111
+佩刀 111
+依依 111
+侵吞 111
+信服 111
+修整 111
+倒行逆施 111
+催动 111
+关键性 111
+农庄 111
+减至 111
+几部 111
+分忧 111
+分类学 111
+划算 111
+到任 111
+刺眼 111
+加油站 111
+动容 111
+南行 111
+卧房 111
+卵石 111
+厚望 111
+厮守 111
+发黄 111
+可乐 111
+后会有期 111
+告密 111
+咬伤 111
+哄笑 111
+四十二章 111
+回话 111
+图谱 111
+圣劳伦斯 111
+堵死 111
+奇峯 111
+奇峰 111
+学部 111
+宇宙飞船 111
+家政 111
+小芳 111
+居高不下 111
+崃 111
+左子穆 111
+巨龙 111
+师祖 111
+帮子 111
+干掉 111
+店员 111
+张骞 111
+弯弯曲曲 111
+忤 111
+悟性 111
+悼 111
+惨淡 111
+愁苦 111
+憾 111
+懈怠 111
+戚长发 111
+手电 111
+技术人员 111
+抄袭 111
+折旧 111
+按兵不动 111
+捐资 111
+救亡 111
+敯 111
+旅游点 111
+无家可归 111
+晕眩 111
+朝外 111
+栽倒 111
+段皇爷 111
+毛里塔尼亚 111
+毛钱 111
+气团 111
+汻 111
+沈绿村 111
+沉迷 111
+泛泛 111
+消融 111
+港澳台 111
+满门 111
+火红 111
+炀帝 111
+熟习 111
+爬行动物 111
+瑾 111
+瓧 111
+甘油 111
+电令 111
+电费 111
+疲累 111
+痊 111
+皇冠 111
+皮囊 111
+皮疹 111
+皮靴 111
+直视 111
+直面 111
+相抵 111
+眉头一皱 111
+眉梢 111
+砒霜 111
+立足点 111
+简氏 111
+算不了 111
+类比 111
+累赘 111
+终场 111
+老话 111
+聂 111
+肉瘤 111
+股民 111
+肪 111
+胡先生 111
+脂肪肝 111
+腾起 111
+舒坦 111
+舜天 111
+航空队 111
+袋中 111
+西化 111
+西路军 111
+见习 111
+课题组 111
+谯 111
+跟头 111
+身体力行 111
+轩权 111
+远山 111
+遭殃 111
+酵母 111
+锾 111
+镭 111
+闫京生 111
+闲适 111
+阴间 111
+阿炳妈 111
+震耳欲聋 111
+青藏铁路 111
+顺德 111
+颓 111
+飘浮 111
+马屁 111
+鳗 111
+黑河 111
+一百两 110
+一针 110
+下同 110
+不亦乐乎 110
+专属经济区 110
+东头 110
+两尺 110
+中央党校 110
+中转 110
+义不容辞 110
+乘胜 110
+九宫 110
+京兆 110
+以逸待劳 110
+似笑非笑 110
+保守派 110
+信义 110
+元器件 110
+兜圈子 110
+入场券 110
+内因 110
+凡人 110
+出厂 110
+出神 110
+出神入化 110
+分家 110
+分权 110
+前排 110
+厮打 110
+发配 110
+受惊 110
+变速箱 110
+古国 110
+同行业 110
+听者 110
+周口店 110
+咕噜 110
+咣 110
+哗变 110
+哝 110
+啧啧 110
+噩耗 110
+因缘 110
+坏账 110
+墨子 110
+复述 110
+多架 110
+大刀阔斧 110
+天将 110
+天际 110
+女主人 110
+姑父 110
+婢女 110
+宁死 110
+宇宙空间 110
+宇宙线 110
+安家 110
+宋神宗 110
+官宦 110
+实话实说 110
+密闭 110
+封皮 110
+小僧 110
+尘世 110
+山楂 110
+帐户 110
+平均利润 110
+年节 110
+幻化 110
+庄田 110
+开刀 110
+开行 110
+彻夜 110
+御花园 110
+恩惠 110
+悆 110
+慨然 110
+打包 110
+打打 110
+抄录 110
+拉走 110
+据传 110
+掣肘 110
+教坊 110
+教育部门 110
+文化界 110
+斯特 110
+旅舍 110
+日食 110
+明言 110
+曡 110
+有志 110
+李东阳 110
+李向前 110
+枣子 110
+标兵 110
+检查和 110
+毕达哥拉斯 110
+毯子 110
+民航总局 110
+汴梁 110
+治罪 110
+泌乳 110
+洛伦兹 110
+海香亭 110
+温存 110
+湖口 110
+湖沼 110
+溪边 110
+滋补 110
+灵药 110
+热气腾腾 110
+照管 110
+王羲之 110
+珑 110
+瓦特 110
+甚广 110
+用尽 110
+电磁学 110
+疑忌 110
+病理学 110
+瞿鸿 110
+神采 110
+穿山甲 110
+穿着 110
+立于不败之地 110
+第一页 110
+第七届 110
+筹资 110
+紧锣密鼓 110
+绿营 110
+缝制 110
+缝纫机 110
+罗山 110
+罗瑞卿 110
+罗纳尔迪 110
+耳目一新 110
+肆虐 110
+肝功能 110
+胡家 110
+能人 110
+花掉 110
+莫高窟 110
+蓼 110
+虎视眈眈 110
+蛛丝马迹 110
+袅袅 110
+裤带 110
+角动量 110
+触电 110
+诟 110
+谣 110
+败局 110
+货色 110
+购入 110
+起义者 110
+软组织 110
+轻伤 110
+辜 110
+遏 110
+酸雨 110
+金蝶 110
+铍 110
+铢 110
+闪失 110
+阅览室 110
+阐发 110
+阴虚 110
+除草剂 110
+陵园 110
+难处 110
+雄狮 110
+雄花 110
+香油 110
+高岭土 110
+鱼鳞 110
+鲂 110
+黑山 110
+齐集 110
+一日游 109
+三号 109
+不可告人 109
+不可开交 109
+不可逆转 109
+丙酮 109
+两夜 109
+中北部 109
+义无反顾 109
+乌兹别克 109
+乡绅 109
+事到临头 109
+二十八 109
+五两 109
+产业部门 109
+仙姑 109
+侦听 109
+侧翼 109
+倾销 109
+偏西 109
+傻笑 109
+兜里 109
+关露 109
+净值 109
+出关 109
+剪切 109
+力作 109
+勃勃 109
+十余名 109
+十几天 109
+南希仁 109
+卢旺达 109
+历史观 109
+双联 109
+叔侄 109
+变卦 109
+叽 109
+合同法 109
+合眼 109
+呎 109
+嗄 109
+圆顶 109
+塌陷 109
+塔山 109
+声腔 109
+处心积虑 109
+大案 109
+大清国 109
+大牢 109
+天问 109
+夺眶而出 109
+奇数 109
+奔逃 109
+婀 109
+学好 109
+客货 109
+宫刑 109
+宿营 109
+尼龙 109
+崔百泉 109
+巴博萨 109
+布隆迪 109
+干将 109
+平均数 109
+幽香 109
+张衡 109
+影坛 109
+影院 109
+心率 109
+心电图 109
+心皮 109
+思想性 109
+性价比 109
+恩娟 109
+情理之中 109
+成倍 109
+成都军区 109
+所有物 109
+拂晓 109
+捷报 109
+掀翻 109
+控制线 109
+插队 109
+摊派 109
+收发 109
+救应 109
+整肃 109
+无限期 109
+日复一日 109
+明思克 109
+星子 109
+普尔 109
+普选 109
+有识之士 109
+木桑 109
+木鱼 109
+未果 109
+本金 109
+来客 109
+根茎 109
+梅雨 109
+比例尺 109
+气瓶 109
+汇价 109
+江口 109
+洋底 109
+派对 109
+清真 109
+湿婆 109
+漱口 109
+火海 109
+烘托 109
+爆炸声 109
+牡丹江 109
+独唱 109
+生长激素 109
+田家 109
+电学 109
+白须 109
+着意 109
+石山 109
+祸福 109
+秀发 109
+科员 109
+积储 109
+窑子 109
+第十二 109
+粐 109
+粘度 109
+细雨 109
+综上所述 109
+羊群 109
+胡连生 109
+膀 109
+苏联红军 109
+若曦 109
+草棚 109
+荣辱观 109
+萤火虫 109
+萨尔瓦多 109
+蛮横 109
+蜜饯 109
+行营 109
+衣饰 109
+补肾 109
+衰微 109
+警醒 109
+议事日程 109
+该所 109
+该报 109
+诨 109
+说白了 109
+说说话 109
+诺贝尔文学奖 109
+谖 109
+货币地租 109
+赶车 109
+踉踉跄跄 109
+逆境 109
+通古斯 109
+造谣 109
+配发 109
+金鱼 109
+镜面 109
+长江三角洲 109
+閤眼 109
+防卫厅 109
+阿萍 109
+陈年 109
+除此以外 109
+集权 109
+雌蕊 109
+雕琢 109
+非国大 109
+面目全非 109
+飘落 109
+魏公公 109
+鴙 109
+龀 109
+一分为二 108
+一古脑儿 108
+一溜烟 108
+一百万 108
+三颗 108
+不测 108
+专横 108
+主营 108
+乌托邦 108
+乌纱帽 108
+书稿 108
+二奶 108
+亲和力 108
+今年初 108
+从头至尾 108
+伊斯坦布尔 108
+作孽 108
+信以为真 108
+兴许 108
+内情 108
+内裤 108
+军旗 108
+凛冽 108
+出让 108
+刁 108
+切实可行 108
+划着 108
+别号 108
+化学品 108
+北朝鲜 108
+千丝万缕 108
+千刀万剐 108
+半夜三更 108
+半辈子 108
+单据 108
+卟 108
+厚礼 108
+原生动物 108
+古今中外 108
+吉他 108
+后座 108
+呼噜 108
+命意 108
+哈布斯堡 108
+哈瓦那 108
+哗啦啦 108
+哪家 108
+回见 108
+壂 108
+外敌 108
+外逃 108
+大势所趋 108
+天灵盖 108
+奏乐 108
+女双 108
+姻 108
+导报 108
+导论 108
+尼古拉 108
+崖壁 108
+巴勒莫 108
+市盈率 108
+帮扶 108
+常山 108
+平原区 108
+康生 108
+征程 108
+御史台 108
+德甲 108
+心砚 108
+怵 108
+惰性 108
+战斧 108
+房事 108
+打铁 108
+拉大 108
+挂载 108
+按理说 108
+挎包 108
+接驾 108
+摇摇欲坠 108
+摘录 108
+支那 108
+放缓 108
+文摘 108
+文理 108
+新闻官 108
+时针 108
+曲凤来 108
+机种 108
+李某 108
+李舜臣 108
+村口 108
+林则徐 108
+橇 108
+步法 108
+比热 108
+毛茸茸 108
+民间艺术 108
+水网 108
+波形 108
+泣不成声 108
+洋货 108
+流动资金 108
+海鹰 108
+消化系统 108
+混帐 108
+渭水 108
+湮没 108
+火石 108
+火葬 108
+炕头 108
+炮口 108
+热源 108
+熟睡 108
+燕窝 108
+片段 108
+牙龈 108
+猩猩 108
+珍爱 108
+理化 108
+甜味 108
+生还 108
+申辩 108
+盛装 108
+直率 108
+相控阵 108
+看护 108
+短发 108
+磁悬浮 108
+祭品 108
+福田 108
+童兆 108
+粉尘 108
+终将 108
+继电器 108
+罗荣桓 108
+美满 108
+而外 108
+肺腑 108
+舞厅 108
+苁 108
+药水 108
+蓝宝石 108
+蛏 108
+蜕变 108
+西端 108
+说真的 108
+调戏 108
+谋害 108
+谗 108
+贺兰山 108
+贻 108
+赏花 108
+赤脚 108
+赫兹 108
+跫 108
+身不由己 108
+车骑 108
+载客 108
+还清 108
+远期 108
+迨 108
+退休金 108
+送别 108
+选址 108
+逼问 108
+遭际 108
+邹 108
+酸味 108
+醉人 108
+重度 108
+金毛狮 108
+陈庄 108
+陈总舵 108
+难解 108
+青年团 108
+青花 108
+面值 108
+香肠 108
+马军 108
+驶去 108
+驻跸 108
+鲆 108
+鸡鸣 108
+黄老邪 108
+一九四九年 107
+一塌糊涂 107
+一席之地 107
+三卷 107
+不具 107
+专心致志 107
+专款 107
+丝竹 107
+两本 107
+两线 107
+义理 107
+争权夺利 107
+事由 107
+二十三 107
+于莲 107
+亢奋 107
+交流会 107
+人民军 107
+仓储 107
+仰韶文化 107
+众生 107
+伤情 107
+伦敦大学 107
+余沧海 107
+依偎 107
+信息量 107
+偏激 107
+做作 107
+八达岭 107
+公审 107
+内阁制 107
+军帐 107
+冬奥会 107
+减税 107
+刘元鹤 107
+制图 107
+加试 107
+劳改 107
+南昌市 107
+博大精深 107
+原核 107
+口器 107
+吴敏 107
+呆板 107
+哥特式 107
+唯物史观 107
+团委 107
+团练 107
+地头 107
+垄断资本 107
+基础理论 107
+基础设施 107
+堤坝 107
+复合型 107
+外军 107
+多党制 107
+大旱 107
+大舅 107
+天下无敌 107
+字面 107
+实用性 107
+家室 107
+庀 107
+应试者 107
+心安理得 107
+惠香 107
+戏剧家 107
+手巾 107
+执照 107
+批林批孔 107
+报废 107
+拍板 107
+捕快 107
+搁浅 107
+改日 107
+改选 107
+攻进 107
+无边无际 107
+昏倒 107
+暀 107
+曹兵 107
+曼德拉 107
+服刑 107
+朝鲜人民军 107
+木糖醇 107
+李处温 107
+杨虎城 107
+梯级 107
+死不了 107
+气短 107
+汝南 107
+污染源 107
+汽车站 107
+没出息 107
+没脸 107
+泰达 107
+洰 107
+派员 107
+海子 107
+海洋性 107
+涿 107
+清扬 107
+温顺 107
+港埠 107
+灵位 107
+烟头 107
+照会 107
+爆竹 107
+爱民 107
+物理系 107
+玉龙 107
+王伦 107
+玻璃瓶 107
+珠三角 107
+白茫茫 107
+百出 107
+皋 107
+盘查 107
+直角 107
+眉眼 107
+眼部 107
+知情权 107
+礼器 107
+礼帽 107
+空智 107
+空格 107
+突飞猛进 107
+篆刻 107
+粮油 107
+红斑 107
+绝佳 107
+绿衣 107
+网络安全 107
+罔 107
+老鹰 107
+胆战心惊 107
+自由市场 107
+自由泳 107
+自考办 107
+自豪感 107
+舌尖 107
+艄 107
+苏克萨 107
+茶坊 107
+获奖者 107
+落榜 107
+落选 107
+虚证 107
+蜷缩 107
+蝌 107
+衾 107
+西坡 107
+见外 107
+见教 107
+觐见 107
+记挂 107
+证词 107
+评测 107
+诗话 107
+谠 107
+责问 107
+走强 107
+赵杰 107
+踏步 107
+身经百战 107
+车底 107
+轻骑兵 107
+连城 107
+迥异 107
+述职 107
+酢 107
+醮 107
+重中之重 107
+量级 107
+钟爱 107
+铁桶 107
+阴谋诡计 107
+阴雨 107
+降幅 107
+陶子安 107
+隔膜 107
+难测 107
+霾 107
+音符 107
+飞机场 107
+饯行 107
+馄饨 107
+馆驿 107
+首都机场 107
+驳回 107
+黑褐色 107
+一捆 106
+一楼 106
+万份 106
+万州 106
+下定 106
+东南大学 106
+临江 106
+为题 106
+主轴 106
+乌镇 106
+乐山 106
+乒乓 106
+乱糟糟 106
+二十八宿 106
+五毒教 106
+交流电 106
+人民代表 106
+伤愈 106
+作伪 106
+侦破 106
+修路 106
+俯首 106
+做做 106
+兢 106
+八分 106
+公卿 106
+公诉 106
+六天 106
+六条 106
+养料 106
+写法 106
+军帽 106
+凯平 106
+击伤 106
+分销 106
+刕 106
+刘鹤真 106
+力士 106
+北缘 106
+南北向 106
+印有 106
+危局 106
+厦门市 106
+受聘 106
+变态反应 106
+各村 106
+告警 106
+和亲 106
+四分五裂 106
+国航 106
+大不列颠 106
+大年 106
+失魂落魄 106
+奥林匹克运动会 106
+嫌隙 106
+孙楚庭 106
+宅院 106
+安安静静 106
+安定团结 106
+安插 106
+完结 106
+实乃 106
+密西西比河 106
+小臣 106
+尽心竭力 106
+嵁 106
+幂 106
+开城 106
+引人入胜 106
+彇 106
+征象 106
+循规蹈矩 106
+徵象 106
+心仪 106
+忖 106
+怒气冲冲 106
+总署 106
+才识 106
+技术装备 106
+报信 106
+拉屎 106
+挂架 106
+挟制 106
+推波助澜 106
+搭讪 106
+摄影师 106
+收紧 106
+改嫁 106
+散客 106
+文件名 106
+斗胆 106
+新品 106
+新居 106
+新罗 106
+方子 106
+无可厚非 106
+无忧无虑 106
+无恶不作 106
+无所适从 106
+无水 106
+日耳曼人 106
+易如反掌 106
+曪 106
+朱瞻基 106
+权臣 106
+李四 106
+杽 106
+枕上 106
+标点 106
+梯队 106
+正反 106
+每户 106
+毫不相干 106
+汴京 106
+汾 106
+没趣 106
+测距仪 106
+海员 106
+海气 106
+浸入 106
+清晰度 106
+湜 106
+滤波器 106
+漾 106
+烷基 106
+玠 106
+生火 106
+画院 106
+疏漏 106
+痔疮 106
+痛心疾首 106
+痢疾 106
+痴心 106
+白河 106
+皖南事变 106
+盖世 106
+盛夏 106
+盟誓 106
+石帮主 106
+票房 106
+离奇 106
+窥视 106
+第七次 106
+笮 106
+筋疲力尽 106
+筏子 106
+管束 106
+绀 106
+绥靖 106
+综述 106
+羞怯 106
+羰基 106
+老练 106
+股利 106
+胆识 106
+胶东 106
+艰苦创业 106
+苏门答腊 106
+英吉利 106
+药酒 106
+菜刀 106
+落幕 106
+葡萄球菌 106
+董庞儿 106
+蒋经国 106
+藏匿 106
+虎皮 106
+蚕丝 106
+血丝 106
+见怪 106
+许愿 106
+许晓书 106
+败类 106
+质押 106
+贺喜 106
+赖以生存 106
+走高 106
+起动 106
+路易斯 106
+迷离 106
+退避 106
+邂逅 106
+郭夫人 106
+配电 106
+酒香 106
+铺垫 106
+错乱 106
+闻所未闻 106
+阴风 106
+阿拉木图 106
+陆平 106
+雌花 106
+颧骨 106
+高平 106
+鬼火 106
+魰 106
+黄泛区 106
+鼻炎 106
+三四 105
+三届 105
+下卷 105
+下端 105
+不声不响 105
+不由分说 105
+不齐 105
+两员 105
+两枝 105
+两班 105
+亡命 105
+仙人掌 105
+伯努利 105
+低频 105
+倏然 105
+先祖 105
+八度 105
+关帝庙 105
+冤屈 105
+几棵 105
+出租汽车 105
+刘启 105
+创立者 105
+刻印 105
+北洋水师 105
+十三岁 105
+十佳 105
+双赢 105
+发电量 105
+取乐 105
+受控 105
+同感 105
+听课 105
+周王 105
+哗啦 105
+商报 105
+喜人 105
+喷水 105
+四川大学 105
+土豪 105
+场外 105
+堤岸 105
+塞舌尔 105
+壳体 105
+复命 105
+复国 105
+夜来 105
+大少爷 105
+大气压 105
+大相径庭 105
+失和 105
+失礼 105
+契合 105
+女流 105
+妖人 105
+孕期 105
+孤岛 105
+学成 105
+安德森 105
+宣传部长 105
+小女子 105
+少侠 105
+尧舜 105
+尼日尔河 105
+层状 105
+展厅 105
+岂有此理 105
+岔路 105
+巴赫 105
+市价 105
+席间 105
+帮派 105
+常务委员 105
+徐晃 105
+悟出 105
+悬臂 105
+惂 105
+惠民 105
+打搅 105
+抛售 105
+拍桌子 105
+拔掉 105
+招展 105
+挣得 105
+据守 105
+排气 105
+救星 105
+散佚 105
+散漫 105
+数以万计 105
+文明古国 105
+星期四 105
+朝日 105
+朦朦胧胧 105
+木质部 105
+本岛 105
+李贤 105
+杴 105
+标致 105
+桥牌 105
+梦姑 105
+梴 105
+森然 105
+楼台 105
+欧文 105
+水泄不通 105
+氶 105
+沥 105
+泥巴 105
+洗手间 105
+洛林 105
+津液 105
+活像 105
+活血 105
+浑河 105
+涉猎 105
+渥太华 105
+潗 105
+灵猫 105
+烂泥 105
+烟幕弹 105
+焐 105
+爬坡度 105
+犍 105
+生产型 105
+甩手 105
+电容器 105
+疲惫不堪 105
+白光 105
+白浪 105
+盯上 105
+石人 105
+社会主义者 105
+祖孙 105
+禅房 105
+秋千 105
+第一手 105
+第一级 105
+箮 105
+粂 105
+粗浅 105
+约翰内斯堡 105
+纳斯达克 105
+经期 105
+结业 105
+给定 105
+翎 105
+肝肾 105
+肴 105
+背对 105
+腥臭 105
+臃肿 105
+自便 105
+良将 105
+芋 105
+若想 105
+萼片 105
+蔪 105
+薄壁 105
+薯 105
+藤椅 105
+虚荣心 105
+衏 105
+衢州 105
+覆命 105
+记不清 105
+试飞员 105
+谜底 105
+费米 105
+转来转去 105
+轻忽 105
+边线 105
+运动场 105
+违约金 105
+逐个 105
+通通 105
+逢年过节 105
+遛 105
+遽 105
+邮寄 105
+銶 105
+鎱 105
+错位 105
+锡金 105
+锨 105
+长袖 105
+闭关自守 105
+闲逛 105
+阴森森 105
+阴气 105
+陶红英 105
+隐忧 105
+青城山 105
+鞦韆 105
+风帆 105
+飞虎 105
+食粮 105
+饮用水 105
+饰品 105
+饺 105
+高炉 105
+高盛 105
+麦地那 105
+一怒之下 104
+上地 104
+上端 104
+不屈不挠 104
+东芝 104
+两重 104
+临时性 104
+丸药 104
+习气 104
+书局 104
+二十名 104
+二届 104
+二里 104
+于雅先 104
+亦然 104
+人民公社化 104
+以军 104
+仰视 104
+伯颜 104
+使用量 104
+依归 104
+保送 104
+傩 104
+元顺帝 104
+充气 104
+先决条件 104
+光影 104
+全息 104
+关机 104
+兴中会 104
+冰球 104
+分摊 104
+助威 104
+化脓性 104
+匪徒 104
+医学家 104
+半自动 104
+卖家 104
+卧床 104
+原煤 104
+发扬光大 104
+口译 104
+告一段落 104
+呶 104
+咕咚 104
+国法 104
+国际私法 104
+场馆 104
+坚冰 104
+垭 104
+埋没 104
+大张 104
+天数 104
+夸赞 104
+妤 104
+姜太公 104
+官寨 104
+定出 104
+密克罗尼西亚 104
+寒酸 104
+小辈 104
+尔斯 104
+岩壁 104
+崔天凯 104
+巡查 104
+布兰德 104
+帕尔马 104
+平山县 104
+平等互利 104
+开机 104
+弯弓 104
+征用 104
+徐青君 104
+得救 104
+徵用 104
+心狠手辣 104
+必败 104
+惠州 104
+抗干扰 104
+披风 104
+押送 104
+抽噎 104
+拉尼 104
+拜占庭 104
+括号 104
+持枪 104
+持重 104
+挑战者 104
+掾 104
+插件 104
+搪瓷 104
+撤消 104
+放荡 104
+政治犯 104
+文学奖 104
+新书 104
+明镜 104
+晓谕 104
+晤 104
+朝贡 104
+木瓜 104
+杀光 104
+李十娘 104
+杨莲亭 104
+杭嘉乔 104
+林师弟 104
+林玉龙 104
+枝头 104
+桁架 104
+棚子 104
+植物油 104
+椭 104
+比利 104
+汪啸风 104
+汪波 104
+没奈何 104
+沸水 104
+海印 104
+海路 104
+深信不疑 104
+源源 104
+片中 104
+狭长的 104
+玫瑰花 104
+璟 104
+用膳 104
+田中 104
+电信业 104
+监守 104
+直言不讳 104
+真真 104
+督导 104
+研读 104
+硬朗 104
+磨合 104
+立正 104
+第一层 104
+第一本 104
+第九卷 104
+算学 104
+管理学 104
+管闲事 104
+箩 104
+粗话 104
+粘稠 104
+紧握 104
+累及 104
+繙 104
+纛 104
+绕组 104
+网格 104
+网游 104
+置酒 104
+美帝国主义 104
+考据 104
+脱发 104
+脱节 104
+舌苔 104
+舍利 104
+花花绿绿 104
+花言巧语 104
+药理 104
+莱顿 104
+落日 104
+薄片 104
+薄雾 104
+补习 104
+让渡 104
+记忆犹新 104
+词组 104
+诖 104
+诮 104
+读出 104
+调离 104
+谢爱光 104
+赤条条 104
+转会 104
+迯 104
+送还 104
+逆行 104
+那婆子 104
+邮轮 104
+鉔 104
+长生不老 104
+防不胜防 104
+阿碧 104
+隋文帝 104
+隐士 104
+雏鸟 104
+雷吉纳 104
+霉素 104
+非人 104
+顾名思义 104
+顿觉 104
+风景画 104
+饿狼 104
+马褂 104
+鹿茸 104
+黏液 104
+黑洞洞 104
+一夫一妻制 103
+一网打尽 103
+七点 103
+万山 103
+下水道 103
+不合时宜 103
+不等式 103
+临危 103
+主板 103
+主考官 103
+乌苏里江 103
+乱哄哄 103
+二十六 103
+亏本 103
+五个一工程 103
+五根 103
+从师 103
+佛教徒 103
+作恶多端 103
+保送生 103
+修明 103
+借来 103
+假药 103
+偏转 103
+具体化 103
+写本 103
+凄楚 103
+几内亚比绍 103
+几十里 103
+分晓 103
+切口 103
+判断力 103
+勃起 103
+博取 103
+厂矿 103
+压痛 103
+县官 103
+只身 103
+含油 103
+命官 103
+品茶 103
+响尾蛇 103
+商界 103
+四十八 103
+围巾 103
+国台办 103
+圆润 103
+外星人 103
+天目山 103
+太爷 103
+奇景 103
+奥委会 103
+如此这般 103
+婴孩 103
+安国 103
+导火线 103
+小桥 103
+居所 103
+工矿 103
+工龄 103
+巨岩 103
+干热 103
+平均化 103
+强项 103
+彩排 103
+彼时 103
+心上人 103
+恋恋不舍 103
+悲苦 103
+惠更斯 103
+愠 103
+成例 103
+成家 103
+所求 103
+托尼 103
+投胎 103
+投诚 103
+抢掠 103
+护城河 103
+抵偿 103
+招集 103
+拳打 103
+挑战性 103
+损毁 103
+提价 103
+搔头 103
+摄入量 103
+摘掉 103
+敢情 103
+断臂 103
+昆明市 103
+景仰 103
+有气无力 103
+机长 103
+杂居 103
+条陈 103
+杨荣 103
+杭州湾 103
+林朝英 103
+枯萎 103
+栓塞 103
+梅兰芳 103
+梆 103
+梧桐 103
+殁 103
+段天德 103
+泰安 103
+洗漱 103
+海淀 103
+淬火 103
+清廉 103
+热火朝天 103
+烯烃 103
+熙熙攘攘 103
+爆出 103
+犹疑 103
+独创性 103
+申购 103
+白热化 103
+盛宴 103
+相依为命 103
+相好 103
+盹 103
+硬骨鱼 103
+秋收起义 103
+称谢 103
+笔录 103
+第二国际 103
+粗俗 103
+结社 103
+编结 103
+罪证 103
+翠翠 103
+肃然起敬 103
+背书 103
+脉象 103
+脚趾 103
+自卫军 103
+自称为 103
+自语 103
+自选 103
+舒缓 103
+芭蕾舞团 103
+苯酚 103
+英烈 103
+苹 103
+血肉模糊 103
+表里 103
+议席 103
+语重心长 103
+诱骗 103
+诺曼底 103
+贤能 103
+账号 103
+贵贱 103
+费马 103
+赶集 103
+轰隆隆 103
+轶闻 103
+轻信 103
+进气道 103
+迷彩服 103
+配角 103
+酒馆 103
+酸奶 103
+野牛 103
+金丹 103
+金大中 103
+金珠 103
+鐬 103
+锋线 103
+长大成人 103
+长治 103
+防御工事 103
+陈平 103
+陈胜 103
+随时随地 103
+霜冻 103
+韩语 103
+饱含 103
+鲁特 103
+黄绿色 103
+黄衫 103
+黄金时代 103
+黑体 103
+鼍 103
+齐发 103
+七种 102
+三七 102
+三思 102
+不丹 102
+不置可否 102
+专营 102
+中校 102
+乐天 102
+乞讨 102
+二十米 102
+五派 102
+京畿 102
+亮晶晶 102
+人事部门 102
+仕女 102
+传布 102
+侦察兵 102
+俚俗 102
+入网 102
+公海 102
+六世 102
+农舍 102
+冰碛 102
+几片 102
+分界 102
+创口 102
+刮风 102
+剑士 102
+剔除 102
+加班加点 102
+北京军区 102
+半封建社会 102
+卡米拉 102
+压电 102
+厚厚 102
+参看 102
+叙说 102
+合用 102
+吸盘 102
+哈欠 102
+哈达 102
+喀拉 102
+噜 102
+噫 102
+四万 102
+四平 102
+土炕 102
+基尔 102
+大主教 102
+大沽 102
+天王星 102
+天资 102
+失言 102
+奇门 102
+安特卫普 102
+安第斯山脉 102
+完颜 102
+实弹 102
+寄宿 102
+密宗 102
+射杀 102
+尖顶 102
+尤二姐 102
+就义 102
+屈指可数 102
+工商业者 102
+年代学 102
+张謇 102
+归路 102
+当啷 102
+心力衰竭 102
+心包 102
+心烦意乱 102
+快意 102
+怀旧 102
+急转 102
+总行 102
+息肉 102
+悉数 102
+惊厥 102
+惊涛骇浪 102
+意念 102
+感情用事 102
+愤然 102
+慈庆宫 102
+懒散 102
+打炮 102
+打赌 102
+抽水 102
+拨通 102
+挂帅 102
+换位 102
+掉落 102
+摄像 102
+支气管炎 102
+收割机 102
+教学质量 102
+文秘 102
+文采 102
+斯坦 102
+新闻记者 102
+无一例外 102
+无锡市 102
+旱季 102
+暴怒 102
+有性 102
+有机体 102
+李平水 102
+李敏 102
+杨成协 102
+枇杷 102
+枡 102
+校庆 102
+核工业 102
+核磁共振 102
+棬 102
+楷 102
+欧里 102
+民和 102
+气呼呼 102
+氯仿 102
+波尔多 102
+深涧 102
+深部 102
+滚蛋 102
+潘家园 102
+火车头 102
+灵通 102
+烁 102
+照面 102
+牛角 102
+特写 102
+狞笑 102
+玩命 102
+现货 102
+生力军 102
+甲虫 102
+电动力学 102
+电化学 102
+电子显微镜 102
+电磁辐射 102
+疑点 102
+痉 102
+白云山 102
+白内障 102
+皇天 102
+相变 102
+睦邻友好 102
+矿务局 102
+破获 102
+砻 102
+碎屑岩 102
+神学家 102
+税制 102
+粗人 102
+粗布 102
+素以 102
+细软 102
+经济区 102
+结出 102
+美术家 102
+翱翔 102
+联谊会 102
+肾小球 102
+肿物 102
+胡桂南 102
+航空器 102
+船舷 102
+花招 102
+苏兆征 102
+茹风 102
+荀 102
+药效 102
+莫扎特 102
+蜷 102
+蠓 102
+行经 102
+袒护 102
+言达平 102
+话本 102
+贴心 102
+足印 102
+路德 102
+迎头 102
+道别 102
+避讳 102
+采掘 102
+里屋 102
+铜板 102
+铺平 102
+长裙 102
+门首 102
+阿斯 102
+陆炳 102
+陆逊 102
+陈寅恪 102
+隔断 102
+难能可贵 102
+雄心勃勃 102
+雨衣 102
+顿河 102
+预应力 102
+频段 102
+风致 102
+马列 102
+驼子 102
+驿传 102
+高危 102
+龉 102
+一个劲地 101
+一千多 101
+一声声 101
+一对一 101
+三艘 101
+下城 101
+中州 101
+久病 101
+乐师 101
+九一八事变 101
+乳剂 101
+仰光 101
+何干 101
+侃 101
+侧重点 101
+俄联邦 101
+俚 101
+保护环境 101
+党团 101
+全营 101
+公使衔 101
+关中平原 101
+冒昧 101
+农学院 101
+冰水 101
+刁钻 101
+列日涅夫 101
+初五 101
+剖开 101
+剪纸 101
+务工 101
+十四岁 101
+半信半疑 101
+卑贱 101
+卢大夫 101
+卵生 101
+厄立特里亚 101
+原作 101
+参差不齐 101
+双线 101
+发明家 101
+向小龙 101
+听天由命 101
+国防费 101
+坦荡 101
+填空 101
+复式 101
+复眼 101
+大惑不解 101
+天祚帝 101
+失信 101
+奔袭 101
+女仆 101
+女眷 101
+嫡系 101
+学籍 101
+安于 101
+安神 101
+安草儿 101
+实干 101
+宣纸 101
+宴饮 101
+寿山 101
+小球 101
+川剧 101
+应收 101
+异口同声 101
+弓弩 101
+引述 101
+征得 101
+得克萨斯州 101
+徵得 101
+必修课 101
+怪石 101
+情报局 101
+惴惴不安 101
+意气 101
+愤愤不平 101
+打落 101
+抑制剂 101
+投注 101
+指令性 101
+挟持 101
+推进剂 101
+揭批 101
+支招 101
+改良主义 101
+断崖 101
+斯诺 101
+斯达康 101
+新星 101
+无期徒刑 101
+明白人 101
+星体 101
+暴发 101
+有的放矢 101
+朝圣 101
+李林甫 101
+核聚变 101
+次级 101
+歹人 101
+汁液 101
+沙天广 101
+沙漠化 101
+泥水 101
+洞察 101
+活火山 101
+活计 101
+流行性 101
+涉案 101
+涨落 101
+火成岩 101
+点阵 101
+牯牛 101
+狗熊 101
+生机勃勃 101
+疃 101
+疱疹 101
+病危 101
+痪 101
+益处 101
+神出鬼没 101
+神化 101
+种族主义 101
+秦河 101
+秸 101
+简章 101
+篡改 101
+粨 101
+糊里糊涂 101
+紫红 101
+红尘 101
+结结实实 101
+缺损 101
+美国务院 101
+考量 101
+联营 101
+肖像画 101
+肚兜 101
+股息 101
+胸痛 101
+艽 101
+芳草 101
+苍生 101
+苏秦 101
+袖手 101
+西北方 101
+西里西亚 101
+要脸 101
+要诀 101
+见天 101
+譬如说 101
+谦和 101
+豆子 101
+貌似 101
+负离子 101
+赌注 101
+起死回生 101
+蹼 101
+迈克尔 101
+近邻 101
+远大 101
+迤逦 101
+郓 101
+里拉 101
+针织 101
+钢铁厂 101
+钢鞭 101
+钪 101
+锤炼 101
+镀金 101
+长春亚泰 101
+陆海空 101
+雕塑家 101
+顺眼 101
+顾命 101
+马号 101
+骆雨 101
+高杰 101
+黄兴 101
+黑名单 101
+龙虾 101
+上新世 100
+下笔 100
+举目 100
+余次 100
+佳绩 100
+侧目 100
+信报 100
+候鸟 100
+元丰 100
+入座 100
+出生入死 100
+击倒 100
+分厂 100
+分赴 100
+刘氏 100
+制高点 100
+办起 100
+加意 100
+加里 100
+勉力 100
+区政府 100
+华灯 100
+南海舰队 100
+卡诺 100
+印染 100
+历史博物馆 100
+反对者 100
+古文字 100
+叽哩咕噜 100
+吃香 100
+各行 100
+合理化 100
+吊销 100
+名篇 100
+向山 100
+向海 100
+君主立宪制 100
+周庄 100
+和文 100
+和暖 100
+善策 100
+喅 100
+噔 100
+圆筒形 100
+垦荒 100
+城市道路 100
+大维 100
+大话 100
+天真烂漫 100
+失水 100
+女婴 100
+奴隶制度 100
+妨 100
+娱乐城 100
+孙仲寿 100
+安拉 100
+安第斯山 100
+定量分析 100
+密不可分 100
+寓所 100
+导游员 100
+小梅 100
+崔希敏 100
+工程学 100
+巴哈马 100
+布施 100
+布鲁斯 100
+店堂 100
+延髓 100
+开拓者 100
+归根到底 100
+快递 100
+怒斥 100
+思辨 100
+总统制 100
+悠长 100
+患儿 100
+惊扰 100
+惊魂 100
+惹事 100
+慷 100
+成长性 100
+扇面 100
+手摇 100
+手谕 100
+打斗 100
+打球 100
+打趣 100
+托拉斯 100
+扫墓 100
+报仇雪恨 100
+拘捕 100
+招魂 100
+指纹 100
+捻军 100
+掇 100
+接踵而至 100
+搪塞 100
+搿 100
+摩西 100
+收市 100
+敌营 100
+整流 100
+旁观者 100
+旃 100
+旋翼 100
+无足轻重 100
+旧式 100
+昏迷不醒 100
+星月 100
+曹军 100
+有利可图 100
+期权 100
+机要 100
+李铁嘴 100
+杨文骢 100
+查禁 100
+株连 100
+楼道 100
+欧阳天 100
+步兵旅 100
+死敌 100
+水波 100
+水烟袋 100
+汉末 100
+沈阳军区 100
+沙通天 100
+没头没脑 100
+油条 100
+沼气 100
+波斯语 100
+浮点 100
+海城 100
+淡薄 100
+渤海湾 100
+游刃有余 100
+渺 100
+滂 100
+滦 100
+烇 100
+牴 100
+猎豹 100
+班禅 100
+生光 100
+田晓霞 100
+男双 100
+畏缩 100
+白净 100
+直升飞机 100
+秦朝 100
+笔记本电脑 100
+精熟 100
+精确度 100
+羽坛 100
+耄 100
+自然力 100
+芽孢 100
+菜地 100
+蓄水量 100
+蓝光 100
+衣角 100
+该案 100
+该片 100
+诱降 100
+购得 100
+赀 100
+赛制 100
+軓 100
+转述 100
+载重量 100
+通判 100
+通情达理 100
+郑重其事 100
+酸菜 100
+量变 100
+金条 100
+銐 100
+鐚 100
+钱孙爱 100
+镇南 100
+长兴 100
+闭口 100
+防护林 100
+陆基 100
+集体所有制 100
+雏 100
+雷斯 100
+面世 100
+马奔 100
+高招办 100
+一招一式 99
+一摞 99
+一棵树 99
+七道 99
+三长两短 99
+下坠 99
+不备 99
+不振 99
+世博会 99
+严防 99
+个体经济 99
+个案 99
+中医学 99
+中国青年报 99
+中新世 99
+乐工 99
+乾咳 99
+二进制 99
+人山人海 99
+仁政 99
+仇怨 99
+侃侃而谈 99
+信口 99
+信息产业 99
+偃师 99
+免试 99
+全副 99
+八种 99
+养老金 99
+再就是 99
+凛凛 99
+分居 99
+分期付款 99
+初六 99
+初四 99
+刻度 99
+前言 99
+力克 99
+勾画 99
+北部湾 99
+匿名 99
+十成 99
+升平 99
+半年前 99
+单机 99
+发迹 99
+司空见惯 99
+合流 99
+合而为一 99
+后半夜 99
+吵嚷 99
+周武王 99
+嗣后 99
+四块 99
+堆积如山 99
+堋 99
+声言 99
+外因 99
+夹道 99
+奁 99
+奇险 99
+奶牛 99
+妙法 99
+妙玉 99
+姻亲 99
+姿色 99
+娍 99
+孾 99
+定神 99
+实用主义 99
+宣抚 99
+小乘 99
+小学校 99
+小钱 99
+尸变 99
+山风 99
+岩溶 99
+崋 99
+帕斯卡 99
+干咳 99
+废纸 99
+度量衡 99
+开火 99
+开荒 99
+影业 99
+征调 99
+心神不定 99
+快餐 99
+忸怩 99
+愚弄 99
+慢条斯理 99
+手术室 99
+打碎 99
+承包商 99
+排毒 99
+摁 99
+擦洗 99
+放射性核素 99
+放水 99
+文才 99
+文莱 99
+新莽 99
+新闻学 99
+施压 99
+族群 99
+无极 99
+易懂 99
+暴发户 99
+最高处 99
+朝里 99
+本刊 99
+朱温 99
+李志常 99
+李济深 99
+来年 99
+林家 99
+枯草 99
+枯荣 99
+检点 99
+横山 99
+横飞 99
+死心 99
+死讯 99
+段玉芬 99
+殷切 99
+毛纺 99
+氢化 99
+水龙 99
+沉浮 99
+沟壑 99
+活动家 99
+淄博市 99
+清一色 99
+湖心 99
+火箭筒 99
+物价局 99
+物象 99
+犒 99
+独身 99
+王安 99
+王某 99
+琳琅满目 99
+甜头 99
+生死关头 99
+田青文 99
+畅谈 99
+畠 99
+白云石 99
+盐场 99
+相向 99
+眉飞色舞 99
+瞧得起 99
+短语 99
+神经纤维 99
+科龙 99
+税费 99
+第一辆 99
+第九章 99
+第五卷 99
+篙 99
+精准 99
+紧迫性 99
+綍 99
+终身制 99
+绝口 99
+绝地 99
+绰绰有余 99
+缺血 99
+老气 99
+聆 99
+联邦制 99
+胱 99
+至多 99
+舞剑 99
+苗凯 99
+茅山 99
+行善 99
+街口 99
+补益 99
+觯 99
+誓师 99
+记名 99
+设计院 99
+评点 99
+试样 99
+试试看 99
+说书 99
+谈吐 99
+谬论 99
+败北 99
+货源 99
+费尽 99
+赔笑 99
+越王 99
+连绵不断 99
+通论 99
+道口 99
+遗失 99
+遗骸 99
+醤 99
+重物 99
+金玉 99
+锦上添花 99
+长凳 99
+长途汽车 99
+门诊部 99
+阉割 99
+阿尔卑斯 99
+陈亮 99
+集邮 99
+雕版 99
+雨声 99
+雷同 99
+顺路 99
+领路 99
+颜萍 99
+额定 99
+飞越 99
+高技术 99
+高质量 99
+高高在上 99
+鬴 99
+麟 99
+七十年 98
+万两 98
+三十余 98
+上传 98
+不伦不类 98
+丑事 98
+专事 98
+丰台 98
+串门 98
+临摹 98
+乳化剂 98
+五十里 98
+付诸 98
+传唤 98
+伯纳乌 98
+低劣 98
+低音 98
+体系结构 98
+侵凌 98
+信风 98
+倒头 98
+克虏伯 98
+六十万 98
+关防 98
+凄苦 98
+分贝 98
+划过 98
+刘邓 98
+化学系 98
+匹夫 98
+十二岁 98
+十二点 98
+十指 98
+华尧 98
+南联盟 98
+卫生厅 98
+印欧 98
+厉鬼 98
+原始人 98
+参知政事 98
+命苦 98
+和弦 98
+哥白尼 98
+哭丧着脸 98
+哺育 98
+商行 98
+嘉峪关 98
+嚎啕 98
+四出 98
+囤积 98
+国运 98
+国防军 98
+地形图 98
+地衣 98
+壅 98
+复变 98
+大忙 98
+大生 98
+奚落 98
+存取 98
+宗派主义 98
+客客气气 98
+富室 98
+寒热 98
+小吴 98
+小幅 98
+尔顿 98
+尚武 98
+山岳 98
+山嶽 98
+岐 98
+峨眉 98
+工委 98
+希尔伯特 98
+广州起义 98
+广陵 98
+建文 98
+循声 98
+心急 98
+忍气吞声 98
+怒容 98
+急功近利 98
+戒心 98
+所幸 98
+才艺 98
+打火机 98
+扮成 98
+扳回 98
+承担者 98
+报收 98
+拜谒 98
+按钮 98
+探马 98
+散曲 98
+数十里 98
+整容 98
+新郑 98
+旗帜鲜明 98
+无度 98
+无止境 98
+昏君 98
+春光 98
+更正 98
+曹仁 98
+有劳 98
+末代 98
+来来往往 98
+柯西 98
+校友 98
+校址 98
+椽 98
+歌咏 98
+民房 98
+民族英雄 98
+氧化剂 98
+汇款 98
+汝才 98
+江宁 98
+沙门 98
+油墨 98
+法理 98
+流水线 98
+浩荡 98
+游览区 98
+濒海 98
+火化 98
+灭口 98
+热茶 98
+牧童 98
+独门 98
+王政 98
+玖 98
+瓦砾 98
+甄 98
+生产工具 98
+白振 98
+盗魁 98
+相配 98
+眉心 98
+眷恋 98
+着火 98
+知趣 98
+研磨 98
+硶 98
+空子 98
+窗台 98
+管内 98
+精疲力竭 98
+纪传体 98
+线粒体 98
+给付 98
+缬 98
+翻涌 98
+耐火 98
+耶律楚材 98
+联想集团 98
+胭脂红 98
+脚力 98
+腾讯 98
+自办 98
+苏美尔 98
+营养液 98
+蒙古高原 98
+蛇岛 98
+计数器 98
+误国 98
+调兵遣将 98
+谣传 98
+谩骂 98
+豚 98
+财政局 98
+质检 98
+赣江 98
+赵孟 98
+起脚 98
+边路 98
+进境 98
+遍体 98
+郏 98
+酆 98
+金家湾 98
+钱包 98
+锉 98
+镇住 98
+问安 98
+阇 98
+阴离子 98
+陈二湖 98
+险境 98
+随之而来 98
+难以忍受 98
+霍尔 98
+非比 98
+非生产 98
+音乐声 98
+领口 98
+颓废 98
+飕 98
+飞碟 98
+马伊堪 98
+马关条约 98
+马达 98
+驶出 98
+骈文 98
+骨盆 98
+鬃 98
+鹞 98
+黄包车 98
+一往情深 97
+一门心思 97
+三段论 97
+上高 97
+不相上下 97
+业者 97
+两手抓 97
+丧葬 97
+中山市 97
+主力军 97
+主语 97
+举步 97
+乌贼 97
+乘凉 97
+书案 97
+买单 97
+乱飞 97
+乳母 97
+事理 97
+五原 97
+五彩缤纷 97
+休伊特 97
+众议员 97
+供应量 97
+侨乡 97
+催生 97
+傲然 97
+充填 97
+免征 97
+免徵 97
+六日 97
+六经 97
+兼及 97
+内服 97
+冲泡 97
+冷眼 97
+刁难 97
+制衡 97
+制鞋 97
+刷子 97
+刺探 97
+剧增 97
+剽悍 97
+劳斯莱斯 97
+北大荒 97
+北极圈 97
+发到 97
+发呆 97
+发痒 97
+受业 97
+受制于 97
+口哨 97
+台式 97
+叶啸天 97
+司马昭 97
+合算 97
+后赵 97
+吮吸 97
+和衷共济 97
+和顺 97
+哗然 97
+哥们 97
+哥本哈根 97
+哭哭啼啼 97
+售出 97
+嘁 97
+嘟囔 97
+国学 97
+在天之灵 97
+塔吉克 97
+大动脉 97
+大批量 97
+大获全胜 97
+大马士革 97
+夹带 97
+奥美定 97
+如是说 97
+姚明 97
+子代 97
+孙亮 97
+孤注一掷 97
+学学 97
+安分 97
+安徒生 97
+宋词 97
+审题 97
+密约 97
+封死 97
+射灯 97
+小邦 97
+岩性 97
+巨蟒 97
+布莱尔 97
+希腊文 97
+常昊 97
+广袤 97
+度牒 97
+开路 97
+引渡 97
+张一光 97
+弯路 97
+彘 97
+形态学 97
+徐福 97
+忘情 97
+恣 97
+恶名 97
+悇 97
+悍然 97
+戏子 97
+手边 97
+扣篮 97
+扩容 97
+投送 97
+排演 97
+掞 97
+掺杂 97
+教学楼 97
+文言文 97
+施以 97
+无穷小 97
+早产 97
+曜 97
+有钱人 97
+板式 97
+板门 97
+果皮 97
+查验 97
+柴达木盆地 97
+棉絮 97
+款款 97
+正着 97
+歼击 97
+殿宇 97
+毊 97
+水层 97
+沈惟敬 97
+沙蒙 97
+油布 97
+油棕 97
+波利尼西亚 97
+泥人 97
+津津乐道 97
+海空 97
+淫贼 97
+渗流 97
+灵宝 97
+烈士陵园 97
+热辣辣 97
+牡蛎 97
+状物 97
+独木桥 97
+猛然间 97
+猢狲 97
+环山 97
+珍奇 97
+琐事 97
+生疼 97
+电势 97
+画眉 97
+皇亲国戚 97
+盲动 97
+睿智 97
+瞑目 97
+石材 97
+礼制 97
+祸根 97
+离异 97
+穑 97
+立柱 97
+站岗 97
+竞标 97
+竹书纪年 97
+第十四 97
+箟 97
+索道 97
+约克 97
+纳罕 97
+羁 97
+群居 97
+翻两番 97
+翻动 97
+老幼 97
+老牛 97
+耐受 97
+胆碱 97
+胶粘剂 97
+脑部 97
+自相矛盾 97
+致谢 97
+节水 97
+芭蕾舞 97
+芹菜 97
+范文 97
+药铺 97
+蔫 97
+蜡黄 97
+螫 97
+行规 97
+被害人 97
+装做 97
+西征 97
+诉诸 97
+调查组 97
+资本化 97
+赫连 97
+超脱 97
+路由器 97
+踔 97
+蹑手蹑脚 97
+辽沈战役 97
+连载 97
+追忆 97
+邖 97
+郝红梅 97
+配属 97
+里根 97
+重罚 97
+野果 97
+金字 97
+金鹏 97
+钙化 97
+铁皮 97
+镌刻 97
+门房 97
+闫 97
+限制性 97
+集训队 97
+霸业 97
+青光 97
+顺心 97
+领头羊 97
+风雅 97
+馆藏 97
+马六甲 97
+马毓真 97
+驶向 97
+骰 97
+鸣声 97
+麻利 97
+黄羊 97
+黄酒 97
+一千个 96
+一年半载 96
+上赛季 96
+下课 96
+不屑一顾 96
+不爽 96
+东西南北 96
+两卷 96
+两株 96
+中意 96
+中译本 96
+丹根 96
+争夺战 96
+二十九 96
+享福 96
+代言 96
+令郎 96
+任飞燕 96
+份量 96
+伯利安 96
+作恶 96
+保健酒 96
+保护主义 96
+俺家 96
+做文章 96
+像素 96
+克里木 96
+入伙 96
+入夥 96
+兰特 96
+内家 96
+冤案 96
+冷热 96
+减租 96
+几度 96
+击掌 96
+劳教 96
+化学键 96
+北航 96
+卡拉奇 96
+卤化 96
+卷曲 96
+历尽 96
+友军 96
+双十 96
+发牢骚 96
+古士 96
+合组 96
+同龄人 96
+后汉书 96
+囊肿 96
+回绝 96
+土库曼斯坦 96
+城府 96
+塑料袋 96
+塞满 96
+大众化 96
+大公报 96
+大器 96
+奥诺 96
+娇媚 96
+子侄 96
+孢子囊 96
+实心 96
+审察 96
+寲 96
+小组赛 96
+尕 96
+局促 96
+工作室 96
+左权 96
+庐山会议 96
+底气 96
+异状 96
+张家寨 96
+张汉 96
+张青 96
+彩民 96
+得不偿失 96
+心慌 96
+总后勤部 96
+惠特曼 96
+懦夫 96
+成就感 96
+投弹 96
+护驾 96
+拉倒 96
+指指点点 96
+按压 96
+援救 96
+擦肩而过 96
+收住 96
+收效 96
+散播 96
+断流 96
+无意识 96
+时有发生 96
+昉 96
+晶状体 96
+有说有笑 96
+木剑 96
+札记 96
+朴达摩 96
+杀手锏 96
+李雷 96
+杨鹏举 96
+柞蚕 96
+校订 96
+椭球 96
+歌厅 96
+歌星 96
+沤 96
+沱 96
+法拉 96
+洛劳斯 96
+洜 96
+流浪者 96
+浮标 96
+港澳地区 96
+演义 96
+火铳 96
+炊事员 96
+热恋 96
+煮饭 96
+熔炼 96
+燥热 96
+爱憎 96
+牍 96
+特为 96
+狼狈不堪 96
+猛冲 96
+班次 96
+珲 96
+瑰丽 96
+男装 96
+瘛 96
+白费 96
+皂隶 96
+盐务 96
+盛事 96
+砽 96
+硐 96
+祆 96
+穹 96
+空头 96
+第二局 96
+罪该万死 96
+美称 96
+翱 96
+翻天覆地 96
+老友 96
+联大 96
+肖玲 96
+肺泡 96
+脑干 96
+自耕农 96
+航空公司 96
+舶 96
+芭 96
+苏中 96
+莫吉 96
+萧何 96
+萧萧 96
+蔡京 96
+藜 96
+虎狼 96
+蚊虫 96
+蚌埠市 96
+蛔虫 96
+蜃 96
+裆 96
+让出 96
+课文 96
+调低 96
+谄媚 96
+谈笑 96
+赛程 96
+赫卢太 96
+赫赫有名 96
+走资派 96
+身不由主 96
+转危为安 96
+轮椅 96
+软绵绵 96
+轻灵 96
+载歌载舞 96
+载运 96
+邪教 96
+配送 96
+金盆 96
+钓鱼台 96
+销魂 96
+长孙 96
+闯荡 96
+阈 96
+阎基 96
+防伪 96
+陶渊明 96
+隘口 96
+雨伞 96
+频仍 96
+飞龙 96
+食客 96
+馈赠 96
+黄泉 96
+黾 96
+齐心协力 96
+万宝 95
+三十多岁 95
+三批 95
+三毛 95
+下陷 95
+不如说 95
+东北亚 95
+为数众多 95
+了然 95
+二十八日 95
+二线 95
+云游 95
+云端 95
+云霄 95
+五百两 95
+交点 95
+低等 95
+作祟 95
+修剪 95
+倌 95
+停火 95
+健在 95
+偶数 95
+光天化日 95
+免疫学 95
+公尺 95
+公演 95
+六方 95
+关胜 95
+内塔尼亚胡 95
+冷不丁 95
+几滴 95
+出书 95
+出处 95
+刘庄 95
+刺骨 95
+刺鼻 95
+加农炮 95
+动脑筋 95
+勺子 95
+十条 95
+千载难逢 95
+卢叔 95
+危言耸听 95
+口供 95
+可溶性 95
+台北市 95
+史蒂夫 95
+名册 95
+听错 95
+启东 95
+周文王 95
+咖啡因 95
+咧嘴 95
+哥们儿 95
+四十多岁 95
+回报率 95
+国家体委 95
+图集 95
+圣洁 95
+墨荷 95
+处级 95
+外行 95
+多级 95
+夜班 95
+大连队 95
+天下无双 95
+天主教徒 95
+奸邪 95
+娱 95
+婵 95
+嫢 95
+孝义 95
+家财 95
+届满 95
+山巅 95
+崽 95
+币值 95
+店伙 95
+店夥 95
+庭审 95
+张维赤 95
+弹词 95
+心焦 95
+心软 95
+总状花序 95
+总督府 95
+恭请 95
+情人节 95
+成文法 95
+打官司 95
+投敌 95
+指法 95
+排泄物 95
+搅乱 95
+放射状 95
+教育界 95
+数十招 95
+文士 95
+文汇报 95
+无处不在 95
+晚唐 95
+暴跳如雷 95
+有氧 95
+有道是 95
+望风 95
+李光 95
+来犯 95
+林妹妹 95
+枝节 95
+柳条 95
+标示 95
+榧 95
+此情 95
+歹 95
+比特 95
+毗连 95
+汉化 95
+汗马功劳 95
+沉湎 95
+沉溺 95
+沿街 95
+泄密 95
+洋葱 95
+洞悉 95
+浑圆 95
+海卫 95
+海市蜃楼 95
+渐变 95
+演艺 95
+灾难性 95
+烟消云散 95
+燫 95
+牛仔裤 95
+犟 95
+猩 95
+獬 95
+王总 95
+瓣膜 95
+由此可知 95
+电信号 95
+畦 95
+病灶 95
+白皮书 95
+百种 95
+皮箱 95
+瞭然 95
+碛 95
+碳酸钙 95
+离任 95
+科技部 95
+第一号 95
+第八次 95
+第十五 95
+糊口 95
+纤毛 95
+组织部长 95
+经卷 95
+经济界 95
+续约 95
+罗长礼 95
+美国防部 95
+翻新 95
+老儿 95
+而复 95
+肖潇 95
+胃酸 95
+胜景 95
+胡来 95
+胸腺 95
+脑炎 95
+自营 95
+臼齿 95
+苏麻喇 95
+苦水 95
+荣立 95
+药片 95
+薛国观 95
+虎丘 95
+蚕桑 95
+血气 95
+西南角 95
+议院 95
+说来说去 95
+说话声 95
+诸如此类 95
+诺贝尔物理学奖 95
+调换 95
+贴补 95
+赛马 95
+踏踏实实 95
+轻机枪 95
+适逢 95
+邻舍 95
+锣声 95
+阿兹特 95
+阿拔斯 95
+险胜 95
+雪茄 95
+雷横 95
+韬略 95
+香港大学 95
+香香公主 95
+高射 95
+鬼魅 95
+鲫 95
+齐鲁 95
+一个家 94
+一餐 94
+七千 94
+万象 94
+万难 94
+三天三夜 94
+三河 94
+三门峡 94
+上下班 94
+上肢 94
+上装 94
+东倒西歪 94
+两千年 94
+中国工程院 94
+主叫 94
+事体 94
+二十七 94
+二叠纪 94
+二流 94
+于菱 94
+互信 94
+五口 94
+会诊 94
+伟业 94
+伤心地 94
+倡导者 94
+假名 94
+假想敌 94
+偷税 94
+僚属 94
+克里斯 94
+八队 94
+公历 94
+典当 94
+冀东 94
+内能 94
+冷凝 94
+几本 94
+刀割 94
+刀背 94
+刻骨铭心 94
+匠师 94
+十斤 94
+十有八九 94
+双肩 94
+反超 94
+发展前途 94
+叟 94
+古琴 94
+可巧 94
+吃醋 94
+合拢 94
+同情心 94
+同盟条约 94
+后半期 94
+后脑勺 94
+听命 94
+嗖嗖 94
+嗝 94
+土坡 94
+土货 94
+坏处 94
+塔顶 94
+塞尔柱 94
+士子 94
+外向 94
+多行 94
+夜明珠 94
+大有人在 94
+大雁 94
+夺下 94
+好话 94
+姒 94
+姟 94
+孀 94
+安然无恙 94
+宋太祖 94
+客轮 94
+寒假 94
+小蒋 94
+就寝 94
+居家 94
+山脚 94
+市集 94
+常见病 94
+平复 94
+床位 94
+库仑 94
+废掉 94
+延至 94
+心志 94
+心苑 94
+恍若 94
+恭迎 94
+恿 94
+悴 94
+惇 94
+愚见 94
+戏楼 94
+战坦克 94
+戴维斯 94
+手艺人 94
+扑哧 94
+打头 94
+扬戈维 94
+拘禁 94
+招徕 94
+掬 94
+摩尔多瓦 94
+收拢 94
+攻坚战 94
+故国 94
+文告 94
+日伪 94
+时效 94
+春联 94
+暗地 94
+服务性 94
+木桶 94
+权且 94
+权谋 94
+杨洁篪 94
+杨锐 94
+果决 94
+桐城 94
+桑给巴尔 94
+梅花鹿 94
+梧 94
+棉纺织业 94
+正大 94
+步兵营 94
+武汉长江大桥 94
+沉郁 94
+沿海地区 94
+洋务派 94
+浩瀚 94
+涌进 94
+滹沱河 94
+炯炯 94
+爆裂 94
+牛仔 94
+玉雕 94
+理发 94
+甄别 94
+甙 94
+生动活泼 94
+电磁感应 94
+甾 94
+瘴 94
+盘腿 94
+真金 94
+积习 94
+税金 94
+立式 94
+第二招 94
+笱 94
+管好 94
+篡位 94
+米粒 94
+糖浆 94
+索赔 94
+繁荣富强 94
+红日 94
+结亲 94
+给水 94
+绽放 94
+美式 94
+翔实 94
+翻番 94
+聊城 94
+胍 94
+脉动 94
+自家人 94
+自然选择 94
+舅父 94
+舛 94
+花博会 94
+花椒 94
+苦读 94
+英王 94
+荧屏 94
+虽则 94
+西夏文 94
+要义 94
+见死不救 94
+解禁 94
+论争 94
+讽 94
+该馆 94
+贴现率 94
+贵姓 94
+转瞬 94
+辕 94
+近距 94
+返航 94
+进攻性 94
+远见卓识 94
+退学 94
+造访 94
+邪术 94
+邻里 94
+配料 94
+采药 94
+量度 94
+金冉冉 94
+金威 94
+针叶 94
+错事 94
+镇南王 94
+长兄 94
+闯祸 94
+际遇 94
+陆川 94
+随处 94
+隐蔽性 94
+震级 94
+静玄 94
+非同一般 94
+韦尔奇 94
+须发 94
+飞跑 94
+饥寒 94
+魏玛 94
+鳕 94
+麻疹 94
+黄山市 94
+一个天 93
+一代代 93
+一条龙 93
+一百多 93
+七位 93
+三十五年 93
+上官云 93
+不可磨灭 93
+不轨 93
+两丈 93
+严复 93
+中晚期 93
+中资 93
+临别 93
+临海 93
+乔柏 93
+九岁 93
+乾杯 93
+事务局 93
+二代 93
+二度 93
+五霸 93
+人心惶惶 93
+低纬度 93
+何去何从 93
+作用力 93
+偏私 93
+先行者 93
+其意 93
+具名 93
+兽皮 93
+再版 93
+农协 93
+冬春 93
+减息 93
+切身利益 93
+刘光世 93
+别具一格 93
+刻划 93
+动脉硬化 93
+十六日 93
+十招 93
+单色 93
+卷轴 93
+去留 93
+史迹 93
+名臣 93
+君山 93
+吴佩珍 93
+呼风唤雨 93
+和缓 93
+哄抢 93
+商家堡 93
+喜闻乐见 93
+嘫 93
+嚎叫 93
+四旧 93
+回敬 93
+团中央 93
+围拢 93
+地府 93
+坐牢 93
+塔斯社 93
+声威 93
+声色 93
+多情 93
+夜行 93
+大分子 93
+大年初一 93
+大百科全书 93
+天尊 93
+天险 93
+女作家 93
+始新世 93
+委顿 93
+孟加拉湾 93
+孤山 93
+宋颖仪 93
+官办 93
+实测 93
+宠幸 93
+宾语 93
+寒噤 93
+寒暑 93
+小康之家 93
+就范 93
+尼德兰 93
+尾羽 93
+属相 93
+山边 93
+岷江 93
+崐 93
+布托 93
+希尔 93
+干杯 93
+平城 93
+平心而论 93
+建元 93
+张五侠 93
+张北 93
+张弓 93
+弧度 93
+得道 93
+微观粒子 93
+情歌 93
+情郎 93
+憡 93
+懊丧 93
+扒开 93
+扳平 93
+扶风 93
+折服 93
+拉莫斯 93
+拨弄 93
+拷问 93
+挝 93
+揿 93
+摢 93
+敮 93
+新衣 93
+方证 93
+映出 93
+晋阳 93
+晚宴 93
+智亭山 93
+暴风雪 93
+曹变蛟 93
+最差 93
+有偿 93
+末梢 93
+本家 93
+朱家 93
+机率 93
+杀人不眨眼 93
+杂货铺 93
+李狗儿 93
+李衮 93
+枝干 93
+标尺 93
+桃子 93
+楼板 93
+模样儿 93
+武师 93
+水溶性 93
+永生 93
+求人 93
+汇丰银行 93
+汾河 93
+没法儿 93
+油气田 93
+波澜壮阔 93
+泼皮 93
+洛克 93
+浔 93
+浸湿 93
+消费量 93
+涸 93
+激愤 93
+濛濛 93
+热浪 93
+照镜子 93
+片麻岩 93
+牛头 93
+王崇古 93
+王旗 93
+班车 93
+琦 93
+电子束 93
+登机 93
+白桦 93
+百分之八十 93
+百感交集 93
+看不惯 93
+看点 93
+神木 93
+移入 93
+穆弘 93
+窄小 93
+笛卡尔 93
+简捷 93
+管状 93
+米脂 93
+精壮 93
+素心 93
+纪及 93
+绕开 93
+绝对量 93
+缔造者 93
+胆气 93
+胡扯 93
+脸儿 93
+自责 93
+艳羡 93
+莱索托 93
+菌丝 93
+董仲舒 93
+蒙古包 93
+蒙蒙 93
+蓝本 93
+蚤 93
+蛋黄 93
+装模作样 93
+见义勇为 93
+警察局 93
+请罪 93
+诽 93
+谭婆 93
+谷氨酸 93
+谷英 93
+豺狼 93
+赵珏 93
+超导体 93
+越过 93
+轩辕 93
+软件业 93
+轻浮 93
+边长 93
+迎刃而解 93
+远射 93
+通透 93
+造纸厂 93
+邻接 93
+郴 93
+酒饭 93
+钃 93
+钱庄 93
+长驱 93
+闿 93
+阻尼 93
+阻抗 93
+陆生 93
+零售商 93
+雷达站 93
+霓虹灯 93
+非议 93
+面罩 93
+鞫 93
+音信 93
+音箱 93
+顶门 93
+顺帝 93
+预谋 93
+饨 93
+骊 93
+高尔夫球 93
+鱼叉 93
+鹘 93
+麦克 93
+龆 93
+一厢情愿 92
+三碗 92
+不端 92
+专有 92
+个人电脑 92
+中国民主同盟 92
+中微子 92
+义乌 92
+乾枯 92
+争先 92
+互市 92
+亢进 92
+交响 92
+休斯敦 92
+侬 92
+保驾 92
+傅以渐 92
+兆头 92
+公益性 92
+六万 92
+农桑 92
+冥王星 92
+冯默风 92
+几十名 92
+刑期 92
+划水 92
+刘某 92
+初学 92
+力所能及 92
+加力 92
+午间 92
+单干 92
+危重 92
+原发 92
+句型 92
+可调 92
+叹为观止 92
+吉布提 92
+吊起 92
+吊车 92
+名古屋 92
+呕血 92
+周作人 92
+唏嘘 92
+唐王 92
+喀斯特地貌 92
+嘲 92
+因人而异 92
+国画 92
+国际主义 92
+圉 92
+埃特 92
+塞子 92
+壮年 92
+多起 92
+大宝 92
+大屠杀 92
+天下太平 92
+头头是道 92
+奥迪 92
+娘儿们 92
+宝琴 92
+寄存器 92
+富足 92
+导入 92
+尌 92
+工行 92
+巴黎公社 92
+常规武器 92
+干枯 92
+平叛 92
+店主人 92
+张伯伦 92
+思前想后 92
+意料之中 92
+手把 92
+扶手 92
+承继 92
+投宿 92
+折算 92
+抛光 92
+押金 92
+挤进 92
+接生 92
+摩崖 92
+撒野 92
+教父 92
+文案 92
+文身 92
+新约 92
+方术 92
+旅居 92
+无线电波 92
+明爱芬 92
+明神 92
+易筋经 92
+昢 92
+暲 92
+曼哈顿 92
+有机合成 92
+本草 92
+朱亚 92
+杆件 92
+材料科学 92
+林间 92
+某处 92
+核导弹 92
+核战争 92
+根状茎 92
+楔 92
+横七竖八 92
+比萨饼 92
+毛线 92
+永昌 92
+求医 92
+汩汩 92
+汴河 92
+沉沦 92
+波多黎各 92
+洽谈会 92
+深表 92
+湟 92
+点灯 92
+烘乾 92
+烘干 92
+狂人 92
+玛雅人 92
+甄洛 92
+疯疯癫癫 92
+疹 92
+白狼 92
+白袍 92
+百余年 92
+百忙 92
+相差无几 92
+睨 92
+短长 92
+石质 92
+砆 92
+硬生生 92
+确确实实 92
+神秘主义 92
+种族歧视 92
+称量 92
+稚嫩 92
+空对空 92
+笫 92
+第一张 92
+第三国际 92
+第三日 92
+管理科学 92
+粱 92
+素朴 92
+纱厂 92
+纹样 92
+织锦 92
+绝路 92
+统购统销 92
+罗干 92
+美育 92
+羟 92
+脚夫 92
+腐殖质 92
+自然规律 92
+舆情 92
+花盆 92
+茵 92
+萘 92
+葬送 92
+行侠仗义 92
+衣裙 92
+西厢记 92
+规规矩矩 92
+计算机信息 92
+诔 92
+该省 92
+谔 92
+豪斯 92
+起眼 92
+跑动 92
+身家性命 92
+身败名裂 92
+还乡 92
+远望 92
+迸裂 92
+邹可仁 92
+金枪鱼 92
+钺 92
+铱 92
+银耳 92
+闷闷不乐 92
+防病 92
+阿房宫 92
+陆胡理 92
+陈揖怀 92
+雷厉风行 92
+静候 92
+页面 92
+飞人 92
+高个子 92
+鱼群 92
+麦哲伦 92
+鼬 92
+龟甲 92
+七号 91
+万万岁 91
+万状 91
+三遍 91
+上中 91
+上颌 91
+下落不明 91
+下首 91
+东川 91
+两三 91
+中时 91
+中暑 91
+主犯 91
+义和拳 91
+九华山 91
+云霞 91
+人烟 91
+伊犁河 91
+休眠 91
+会聚 91
+俗话 91
+偏振光 91
+充盈 91
+克敌制胜 91
+克鲁 91
+全自动 91
+公婆 91
+兰德 91
+兵马俑 91
+冒雨 91
+冯世宽 91
+冰天雪地 91
+凑热闹 91
+几枚 91
+刀柄 91
+切莫 91
+刘玉升 91
+制钱 91
+势均力敌 91
+势如破竹 91
+十三日 91
+千伏 91
+华盛顿邮报 91
+南沙群岛 91
+博士后 91
+博览 91
+占全洲 91
+卢浮宫 91
+受累 91
+古斯 91
+另眼相看 91
+可见一斑 91
+名存实亡 91
+吴中行 91
+呵欠 91
+哈勃 91
+嚣 91
+国药 91
+在业 91
+均势 91
+大屋 91
+大巴 91
+大锅饭 91
+大雄宝殿 91
+天台 91
+夫子 91
+头晕眼花 91
+头面 91
+娶亲 91
+嫭 91
+子孙后代 91
+学生会 91
+安德鲁 91
+定格 91
+宣判 91
+对虾 91
+寺观 91
+山海经 91
+嶂 91
+川西 91
+常晗 91
+建德 91
+录像机 91
+忘我 91
+性骚扰 91
+战壕 91
+战平 91
+手镯 91
+打压 91
+打天下 91
+打滚 91
+承兑 91
+投资人 91
+抚育 91
+报导 91
+拉车 91
+挑出 91
+挫伤 91
+推销员 91
+撤换 91
+撩拨 91
+撺掇 91
+敌害 91
+数以千计 91
+新安 91
+无名指 91
+晋王 91
+暍 91
+未雨绸缪 91
+本案 91
+柔然 91
+柳亚子 91
+柳湘兰 91
+梁长老 91
+概要 91
+欢愉 91
+母公司 91
+比索 91
+毫不费力 91
+民政部 91
+氛 91
+汛 91
+汤隆 91
+沙弥 91
+泪光 91
+洗头 91
+活路 91
+济宁 91
+海生 91
+海鸥 91
+清热解毒 91
+渎职 91
+渡船 91
+温哥华 91
+潜流 91
+熟读 91
+熬煎 91
+爆满 91
+特拉 91
+狂笑 91
+狡狯 91
+独断 91
+王法 91
+玛丽亚 91
+现代派 91
+琵 91
+生命科学 91
+田畴 91
+电话铃 91
+略为 91
+痛惜 91
+痴痴 91
+白虎 91
+皇叔 91
+盐税 91
+督率 91
+石洞 91
+破天荒 91
+硫化氢 91
+祁山 91
+神权 91
+秘史 91
+稀有金属 91
+童心 91
+竭尽 91
+笥 91
+筑巢 91
+红一 91
+经济性 91
+绿藻 91
+缗 91
+缠身 91
+缤纷 91
+耶律燕 91
+聚伞花序 91
+腾飞 91
+芊 91
+苦役 91
+荩 91
+菏 91
+萦绕 91
+萧远山 91
+蔗 91
+薛慕华 91
+蛛网 91
+蟑螂 91
+补足 91
+裴恩才 91
+褒奖 91
+西区 91
+西四 91
+覆盖面 91
+训话 91
+试验场 91
+谭公 91
+豪客 91
+赏钱 91
+赫尔辛基 91
+走着瞧 91
+跃马 91
+跳入 91
+踌躇满志 91
+过电压 91
+远道 91
+逃散 91
+逻辑思维 91
+那曲 91
+酒气 91
+量力而行 91
+钠灯 91
+铆接 91
+错怪 91
+闲居 91
+阿尔卑斯山脉 91
+陆震天 91
+难为情 91
+集体主义 91
+青绿 91
+面庞 91
+韩世忠 91
+顿首 91
+额尔古纳河 91
+飕飕 91
+飞黄腾达 91
+馄 91
+高居 91
+高水平 91
+高谈阔论 91
+鲍罗廷 91
+黄大仙 91
+鼓膜 91
+齐桓公 91
+齐白石 91
+一五 90
+一审 90
+一层层 90
+一床 90
+三国志 90
+三桥 90
+三派 90
+上气不接下气 90
+下处 90
+下月 90
+下潜 90
+不知所云 90
+东山再起 90
+中组部 90
+主打 90
+义子 90
+书画家 90
+事半功倍 90
+二十两 90
+五块 90
+交加 90
+交汇处 90
+亲子 90
+人物画 90
+仆从 90
+代数方程 90
+似是而非 90
+何师 90
+余志 90
+佩带 90
+保甲 90
+停刊 90
+健儿 90
+克拉底 90
+八股 90
+内阁总理 90
+军制 90
+农贸市场 90
+冰冻 90
+冰窖 90
+冲走 90
+凌霄城 90
+函授 90
+切换 90
+刘询 90
+剧组 90
+割舍 90
+剿共 90
+势能 90
+区县 90
+十四日 90
+华诞 90
+卖点 90
+卡住 90
+口红 90
+可辨 90
+史论 90
+吉林市 90
+名唤 90
+后备军 90
+咸菜 90
+哈姆雷特 90
+唐国强 90
+啊哈 90
+喀布尔 90
+嘟嘟 90
+国奥 90
+国有股 90
+土坯 90
+基址 90
+堂堂正正 90
+塔里木 90
+增开 90
+增益 90
+声嘶力竭 90
+大肠癌 90
+天国 90
+失措 90
+奇遇 90
+好恶 90
+学习班 90
+学名 90
+家世 90
+密友 90
+密探 90
+封禅 90
+小数 90
+就餐 90
+层级 90
+屈指 90
+工笔 90
+平白 90
+年发电量 90
+广发 90
+应予 90
+开放性 90
+开馆 90
+张孝纯 90
+归辛树 90
+形迹 90
+心事重重 90
+念咒 90
+性成熟 90
+怪诞 90
+惴 90
+才刚 90
+打针 90
+抹布 90
+拜辞 90
+拼抢 90
+按部就班 90
+掉以轻心 90
+接续 90
+操守 90
+放射性元素 90
+教益 90
+文法 90
+斑鸠 90
+旁若无人 90
+无愧于 90
+晨光 90
+普希金 90
+有成 90
+本溪 90
+权能 90
+李文忠 90
+杭九斋 90
+柴进 90
+株式会社 90
+梧州 90
+棒子 90
+比亚诺 90
+比比 90
+民谣 90
+求实 90
+沃尔特 90
+沉默寡言 90
+沙化 90
+波斯尼亚 90
+浇水 90
+海航 90
+海马 90
+消长 90
+清溪 90
+潜伏期 90
+燎 90
+爱女 90
+獠 90
+王城 90
+王经宇 90
+玎 90
+环卫 90
+班轮 90
+甲子 90
+瘢 90
+皟 90
+直落 90
+知识界 90
+矿产品 90
+碰壁 90
+磷灰石 90
+磺胺 90
+社会活动 90
+神兵 90
+私房钱 90
+秦雍西 90
+稍事 90
+空腹 90
+第十章 90
+红叶 90
+红巾军 90
+红衫儿 90
+统购 90
+绶 90
+缕缕 90
+缘何 90
+缪 90
+罗兰 90
+罗曼 90
+罗马教皇 90
+老徐 90
+耐高温 90
+肟 90
+胡里胡涂 90
+胧 90
+胸膜 90
+脂蛋白 90
+腐蚀性 90
+自用 90
+致辞 90
+舅母 90
+花萼 90
+苞片 90
+英豪 90
+草莽 90
+荧光屏 90
+获益 90
+蓝藻 90
+虎豹 90
+西兰 90
+西营 90
+诈欺 90
+诱使 90
+谷燕山 90
+贝加尔湖 90
+账面 90
+贾谊 90
+走漏 90
+赵大锤 90
+超新星 90
+跨越式 90
+辅仁 90
+辽沈 90
+运移 90
+追索 90
+通力合作 90
+邯 90
+酒具 90
+金家 90
+错愕 90
+锡矿 90
+锤子 90
+长鸣 90
+阳明 90
+阿凡 90
+阿訇 90
+陈大毛 90
+集约化 90
+雪橇 90
+非政府 90
+靠岸 90
+鞒 90
+顽抗 90
+领子 90
+首例 90
+马驹 90
+高浓度 90
+高烧 90
+魔力 90
+鱼汤 90
+鲑 90
+鸣响 90
+黄宗会 90
+一个团 89
+七言 89
+万圭 89
+万幸 89
+三匹 89
+三言两语 89
+上三 89
+上海申花 89
+不容置疑 89
+不懈努力 89
+两封 89
+两棵 89
+中居 89
+义愤 89
+乌羊王 89
+九三学社 89
+乳糖 89
+二十多岁 89
+五斗 89
+井井有条 89
+井底 89
+交头接耳 89
+交联 89
+亩产 89
+什物 89
+从轻 89
+代表队 89
+仰卧 89
+伤天害理 89
+佛爷 89
+保险业 89
+偷眼 89
+光驱 89
+公子爷 89
+六安 89
+兰氏 89
+共同点 89
+关联方 89
+内华达 89
+册子 89
+决然 89
+冷藏 89
+准噶尔盆地 89
+准头 89
+减持 89
+几圈 89
+几斤 89
+出土文物 89
+刘徽 89
+医疗队 89
+半枚 89
+厩 89
+台南 89
+后进 89
+吗啡 89
+周杰伦 89
+呼吸系统 89
+唑 89
+喜怒哀乐 89
+噬菌体 89
+四千 89
+四野 89
+垂钓 89
+城关 89
+城隍 89
+埗 89
+堂而皇之 89
+备查 89
+复用 89
+夏雪 89
+大提琴 89
+大洪 89
+奖状 89
+奭 89
+奶茶 89
+婚嫁 89
+小吏 89
+少部分 89
+就势 89
+履历 89
+山崩 89
+巡检 89
+左宗棠 89
+布达拉宫 89
+常备军 89
+平头 89
+开皇 89
+开篇 89
+张口结舌 89
+形变 89
+彤 89
+彩塑 89
+徐光启 89
+心服 89
+忝 89
+怀柔 89
+惤 89
+惴惴 89
+憨厚 89
+或非 89
+戾 89
+支派 89
+支离破碎 89
+散文集 89
+方家 89
+无怪 89
+时务 89
+智取 89
+暗色 89
+暹罗 89
+服务商 89
+服务费 89
+木本 89
+朴贞 89
+查尔顿 89
+标志性 89
+栗姬 89
+校对 89
+格丁根 89
+格格不入 89
+桀 89
+榆树 89
+槊 89
+横渡 89
+殷实 89
+气运 89
+水生植物 89
+泛称 89
+波幅 89
+泯 89
+测量学 89
+浘 89
+火塘 89
+灯影 89
+灵丹妙药 89
+烤火 89
+热那亚 89
+照实 89
+狂潮 89
+狡黠 89
+狼牙山 89
+猜猜 89
+王惠平 89
+球菌 89
+甜食 89
+番禺 89
+白求恩 89
+省钱 89
+瞅见 89
+石双英 89
+砍柴 89
+碁 89
+磷脂 89
+离间 89
+空穴 89
+窗棂 89
+第四天 89
+簳 89
+籴 89
+繁星 89
+红布 89
+纵情 89
+纷至沓来 89
+纸钱 89
+经线 89
+聚居区 89
+肌体 89
+肩部 89
+胃病 89
+背信弃义 89
+胡克 89
+胡宗南 89
+胡琴 89
+胶原 89
+脍炙人口 89
+腹膜 89
+花树 89
+蒙汗药 89
+蔬 89
+虫卵 89
+虹桥 89
+蚌埠 89
+蛉 89
+衙内 89
+装腔作势 89
+评论员 89
+诡计多端 89
+语词 89
+说闲话 89
+读音 89
+貂皮 89
+贝克汉姆 89
+贝里 89
+贪墨 89
+赡养 89
+越战 89
+踝 89
+辄 89
+辐照 89
+过手 89
+近臣 89
+进制 89
+道贺 89
+遗志 89
+都柏林 89
+鄱 89
+配股 89
+重工 89
+野心家 89
+野性 89
+鍫 89
+铁胆庄 89
+销往 89
+闭目养神 89
+闹翻 89
+陆丰 89
+随葬 89
+集体化 89
+韩非子 89
+顿悟 89
+风吹草动 89
+首架 89
+马王堆 89
+鲁国 89
+鲜为人知 89
+黄宏生 89
+黑手 89
+齐心 89
+齐明 89
+齐齐哈尔 89
+龙儿 89
+一小撮 88
+一鼓作气 88
+三弦 88
+三线 88
+专利费 88
+专断 88
+东城区 88
+两款 88
+两首 88
+中国证券监督管理委员会 88
+中高级 88
+乙型肝炎 88
+乱流 88
+乾柴 88
+亃 88
+二不休 88
+五分钟 88
+仙都 88
+代办 88
+任官 88
+作乱 88
+俄亥俄 88
+俏丽 88
+光鲜 88
+克拉克 88
+军事法庭 88
+凶杀 88
+刘大顺 88
+初稿 88
+剑麻 88
+剼 88
+劳动党 88
+匮 88
+十一日 88
+单晶 88
+博茨瓦纳 88
+卢沟桥 88
+危机感 88
+原物 88
+参政会 88
+反身 88
+发端 88
+取证 88
+受限 88
+口称 88
+古来 88
+吃不消 88
+呈报 88
+喊话 88
+嗟叹 88
+四声 88
+固化 88
+图文 88
+在野 88
+垫子 88
+墨家 88
+夜深人静 88
+大佛 88
+奄 88
+奇形怪状 88
+奋进 88
+如数 88
+娇滴滴 88
+婆婆妈妈 88
+婚姻法 88
+孵卵 88
+宋高宗 88
+容儿 88
+小王子 88
+小脚 88
+少顷 88
+屙 88
+山海卫 88
+岂不知 88
+工头 88
+左营 88
+差异化 88
+巴布亚新几内亚 88
+巴金 88
+干柴 88
+干起 88
+平顶山 88
+幼主 88
+建筑面积 88
+建设部 88
+开府 88
+异教徒 88
+张仪 88
+张诚 88
+当好 88
+当月 88
+志同道合 88
+怒目而视 88
+怜爱 88
+急迫 88
+总归 88
+恭顺 88
+打盹 88
+抛射 88
+报案 88
+拓扑学 88
+探路 88
+提神 88
+摘取 88
+支教 88
+放羊 88
+旅游节 88
+无党派人士 88
+无所作为 88
+无敌手 88
+日立 88
+显微 88
+景泰 88
+有目共睹 88
+杀身之祸 88
+杏花 88
+杜青海 88
+枉然 88
+格里 88
+楚汉 88
+横流 88
+死党 88
+母语 88
+比对 88
+民女 88
+氮肥 88
+水工 88
+水门 88
+汤姆 88
+沦陷区 88
+河豚 88
+洗浴 88
+浞 88
+涕 88
+淮阳 88
+清心 88
+温文尔雅 88
+湿淋淋 88
+漕粮 88
+潜移默化 88
+濛 88
+灯盏 88
+灵狐 88
+炸死 88
+炼焦 88
+热学 88
+烽 88
+焦头烂额 88
+牢狱 88
+牧地 88
+牵引车 88
+玄想 88
+玩法 88
+瑰宝 88
+生儿育女 88
+病魔 88
+痛感 88
+痛饮 88
+盐分 88
+相去 88
+眼帘 88
+短波 88
+碧水 88
+稳态 88
+竖琴 88
+第一项 88
+精神抖擞 88
+聋哑 88
+脚蹬 88
+腊梅 88
+腿部 88
+臂弯 88
+自食其力 88
+致词 88
+节理 88
+花旗 88
+苄 88
+苏铁 88
+英武 88
+范志红 88
+莫斯科大学 88
+蓟县 88
+蕴涵 88
+薄荷 88
+虎将 88
+虚掩 88
+蝠 88
+蟛 88
+蟠桃 88
+袁督师 88
+裳 88
+褪色 88
+观测者 88
+言下之意 88
+讲理 88
+诱惑力 88
+谢意 88
+贪财 88
+赖斯 88
+赶超 88
+足额 88
+路经 88
+软硬 88
+逃遁 88
+酸中毒 88
+醉酒 88
+重量级 88
+野鸡 88
+錾 88
+钢叉 88
+铁丝网 88
+铁厂 88
+银行法 88
+闭经 88
+防风 88
+陀罗 88
+陇右 88
+陈列馆 88
+陈长老 88
+雄鸡 88
+雹 88
+鞭炮声 88
+额尔齐斯河 88
+风姿 88
+首歌 88
+驸 88
+骋 88
+骑兵团 88
+高梁 88
+鱼雷艇 88
+黄巢 88
+一不做 87
+一蹶不振 87
+万册 87
+三菱 87
+上机 87
+下贱 87
+不来梅 87
+临潼 87
+临街 87
+为非作歹 87
+主考 87
+乌拉 87
+从长计议 87
+伐木 87
+会众 87
+会前 87
+传抄 87
+余者 87
+作践 87
+侧门 87
+促进会 87
+信号处理 87
+俳 87
+党报 87
+全封闭 87
+公墓 87
+兵船 87
+内层 87
+农区 87
+冰糖 87
+凋零 87
+出丑 87
+创收 87
+利马 87
+助推 87
+劬 87
+北外 87
+千姿百态 87
+半圆形 87
+博鳌 87
+印花 87
+原价 87
+原则性 87
+双子 87
+反反复复 87
+反反覆覆 87
+发行人 87
+史学界 87
+合唱队 87
+合成橡胶 87
+后园 87
+吴兴 87
+商品生产者 87
+喷洒 87
+四代 87
+回调 87
+因袭 87
+图斯 87
+增厚 87
+夏朝 87
+大个 87
+大可不必 87
+大清早 87
+大锅 87
+天台山 87
+天心 87
+天窗 87
+太原市 87
+嬉笑 87
+字节 87
+学画 87
+守寡 87
+宝钗笑 87
+客户服务 87
+客流量 87
+害臊 87
+家书 87
+家小 87
+寒心 87
+小兵 87
+小妹子 87
+小毛 87
+小雪 87
+山涧 87
+工程院 87
+康师傅 87
+康王 87
+开化 87
+张牙舞爪 87
+德拉 87
+心机 87
+心计 87
+志趣 87
+怖 87
+怨不得 87
+悎 87
+情志 87
+惊魂未定 87
+手感 87
+打磨 87
+扩大化 87
+抗震 87
+拍击 87
+接吻 87
+播音员 87
+政论 87
+数天 87
+数字式 87
+数度 87
+整机 87
+无所事事 87
+无缝 87
+无遗 87
+旧人 87
+春生 87
+晋西北 87
+暴露无遗 87
+有助 87
+李邦彦 87
+村头 87
+杜马 87
+杨元珍 87
+杨振宁 87
+杨过心 87
+查考 87
+核扩散 87
+橘子 87
+残渣 87
+民主德国 87
+民政部门 87
+气态 87
+汇编语言 87
+汉密尔 87
+汉文帝 87
+汜 87
+汪洋大海 87
+波利 87
+泪汪汪 87
+洋面 87
+洋麪 87
+浦口 87
+海洋学 87
+海燕 87
+深究 87
+混同 87
+潜逃 87
+灰土 87
+炝 87
+煞有介事 87
+熨 87
+爱丁堡 87
+独处 87
+献媚 87
+玉皇大帝 87
+玉蜂针 87
+王传志 87
+王国维 87
+珀斯 87
+瓤 87
+瓮城 87
+生地 87
+生境 87
+生殖细胞 87
+生物钟 87
+甥 87
+男男女女 87
+疑惧 87
+登峰造极 87
+白云观 87
+白沟 87
+白洋淀 87
+百步 87
+直白 87
+相关性 87
+眷顾 87
+石蜡 87
+硫黄 87
+磁力 87
+礼佛 87
+福泽 87
+离岸 87
+私通 87
+稀松 87
+稚气 87
+空位 87
+第二页 87
+答礼 87
+精油 87
+精练 87
+索尼公司 87
+纤维化 87
+纸包 87
+纺锤形 87
+纽约州 87
+线状 87
+结肠 87
+绘图 87
+缘于 87
+罪大恶极 87
+美国市场 87
+老赵 87
+聚酰胺 87
+聚醯胺 87
+脸膛 87
+腓尼基 87
+至宝 87
+良家 87
+芤 87
+芪 87
+苍山 87
+苏荃 87
+药膏 87
+观察者 87
+言中 87
+诉求 87
+该局 87
+该类 87
+诸保昆 87
+诸生 87
+谐音 87
+豪奢 87
+负隅顽抗 87
+败家子 87
+费加罗 87
+赜 87
+走江湖 87
+输往 87
+辽代 87
+迁怒 87
+运费 87
+近地 87
+返程 87
+进步党 87
+逍遥派 87
+道白 87
+金月兰 87
+銮 87
+钮 87
+铺路 87
+锡山 87
+长跑 87
+阮士中 87
+阳虚 87
+难产 87
+食物链 87
+首屈一指 87
+首期 87
+马元利 87
+马拉维 87
+高成 87
+魁北克 87
+黑衣人 87
+一万多 86
+一个打 86
+一口咬定 86
+一撇 86
+一条街 86
+一般性 86
+三农 86
+三十六年 86
+上体 86
+上好 86
+丢开 86
+两回 86
+两所 86
+个人主义 86
+中上 86
+主讲 86
+五方 86
+亚马孙 86
+亴 86
+人民出版社 86
+代名词 86
+代销 86
+以身作则 86
+伏尔加 86
+伏羲 86
+伕 86
+伯尔尼 86
+但丁 86
+佞 86
+倍感 86
+僧尼 86
+儒教 86
+先兆 86
+光化学 86
+光明日报 86
+克里姆林宫 86
+兔娃 86
+全身心 86
+八千 86
+公分 86
+六七 86
+冒失 86
+冗 86
+农会 86
+凉意 86
+出人头地 86
+刻不容缓 86
+副食品 86
+北江 86
+十七八岁 86
+单骑 86
+厚爱 86
+反坦克炮 86
+发酸 86
+取样 86
+受宠若惊 86
+变化多端 86
+叩拜 86
+台山 86
+同心协力 86
+同性 86
+同辈 86
+名目繁多 86
+吡 86
+含水量 86
+吴江 86
+周期表 86
+呼哨 86
+咽部 86
+哀怨 86
+响当当 86
+善事 86
+四射 86
+国家统计局 86
+国文 86
+国防科工委 86
+国际奥委会 86
+在野党 86
+均田制 86
+坦克师 86
+堤上 86
+壤土 86
+大小便 86
+大略 86
+天山南北 86
+天衣无缝 86
+存留 86
+孝文帝 86
+学社 86
+守夜 86
+安第斯 86
+宋健 86
+定购 86
+密旨 86
+尔雅 86
+巴拿马运河 86
+巴萨 86
+干劲 86
+废弃物 86
+异想天开 86
+弦乐 86
+弯刀 86
+彭楚政 86
+心平气和 86
+志士 86
+忠孝 86
+念诵 86
+总社 86
+总装 86
+悯 86
+战成 86
+执笔 86
+扼守 86
+抽动 86
+拆借 86
+拉格朗 86
+指导性 86
+排污 86
+措词 86
+摸底 86
+撰稿人 86
+攻杀 86
+放哨 86
+故旧 86
+数学系 86
+数百里 86
+方兴未艾 86
+方寸 86
+旁门左道 86
+昊 86
+昏厥 86
+显性 86
+晶格 86
+本年 86
+机械式 86
+杜威 86
+来头 86
+板状 86
+核威慑 86
+根除 86
+桑昆 86
+梁子 86
+模糊不清 86
+横断山脉 86
+欣欣向荣 86
+歉疚 86
+正电 86
+死灰 86
+永世 86
+永宁 86
+污垢 86
+没良心 86
+泓 86
+活劳动 86
+淋巴瘤 86
+淡雅 86
+淦 86
+清雅 86
+温方达 86
+濄 86
+瀚 86
+灵光 86
+烫伤 86
+热乎乎 86
+热流 86
+热烘烘 86
+热身赛 86
+狖 86
+独居 86
+独揽 86
+猎取 86
+珍重 86
+珯 86
+用词 86
+病势 86
+白粉 86
+百分 86
+矇蔽 86
+短途 86
+石堆 86
+砍头 86
+砸烂 86
+硫酸盐 86
+磨牙 86
+礼教 86
+祚 86
+福大帅 86
+积弊 86
+童工 86
+笑骂 86
+箴 86
+米勒 86
+米尔 86
+粎 86
+紫胶 86
+织布机 86
+绷紧 86
+绿城 86
+绿色植物 86
+翊 86
+翻案 86
+老夫子 86
+考分 86
+考试题 86
+胃液 86
+胃炎 86
+背向 86
+胶卷 86
+自取 86
+芳烃 86
+范仲淹 86
+茅台 86
+草堆 86
+药液 86
+营里 86
+蒙蔽 86
+蚌壳 86
+表现主义 86
+裁剪 86
+装点 86
+观赏植物 86
+言官 86
+计件工资 86
+计价 86
+诣 86
+诸臣 86
+调回 86
+谜团 86
+貉 86
+贪欲 86
+赖特 86
+起立 86
+身陷 86
+输沙量 86
+选育 86
+野史 86
+铜铃 86
+铬铁矿 86
+银联 86
+闲散 86
+阴郁 86
+阿苏 86
+面如土色 86
+顶层 86
+食道 86
+驾驶证 86
+高仪 86
+鸵鸟 86
+黑云 86
+黑剑 86
+龙驹 86
+一尘不染 85
+一般来讲 85
+万次 85
+三晋 85
+上一年 85
+不三不四 85
+不瞒你说 85
+不绝于耳 85
+不苟言笑 85
+业经 85
+两百 85
+中等教育 85
+丹参 85
+丹蔘 85
+主人家 85
+乌兰巴托 85
+乘虚 85
+事关重大 85
+二十三日 85
+二十二 85
+五体投地 85
+交与 85
+亲历 85
+价值连城 85
+会客室 85
+低位 85
+俄亥俄州 85
+保暖 85
+信笺 85
+倍加 85
+倓 85
+停滞不前 85
+公推 85
+六十岁 85
+决出 85
+几株 85
+出价 85
+出谋划策 85
+分路 85
+刹车 85
+前脚 85
+剪裁 85
+助兴 85
+化学纤维 85
+十二日 85
+十数 85
+十种 85
+千丈 85
+千百万 85
+升天 85
+卑鄙无耻 85
+厦门大学 85
+发卡 85
+叩谢 85
+可叹 85
+可贺 85
+叹服 85
+合肥市 85
+吉野 85
+吉隆坡 85
+同济 85
+后嗣 85
+后晌 85
+后路 85
+吴革 85
+哑口无言 85
+唐占魁 85
+四元 85
+在所难免 85
+堆砌 85
+外周 85
+外线 85
+多利 85
+多糖 85
+夜半 85
+大半天 85
+大脚 85
+奉陪 85
+孺 85
+宣示 85
+对开 85
+对流层 85
+峰峦 85
+崇山峻岭 85
+州立 85
+工业国 85
+工农红军 85
+布基纳法索 85
+希奇古怪 85
+幸喜 85
+庄丁 85
+建功立业 85
+异乡 85
+异域 85
+引流 85
+弗洛伊德 85
+得数 85
+御笔 85
+御览 85
+性关系 85
+怯怯 85
+悚 85
+情义 85
+情分 85
+惠帝 85
+才情 85
+打拼 85
+执委会 85
+扬名 85
+报恩 85
+押租 85
+抽样调查 85
+拖车 85
+招商银行 85
+拜寿 85
+拮据 85
+指手划脚 85
+按键 85
+挥拳 85
+授与 85
+探查 85
+接合 85
+推说 85
+揉搓 85
+摩尼教 85
+撑持 85
+撞死 85
+教习 85
+方格 85
+无地自容 85
+春运 85
+月末 85
+有效率 85
+有鬼 85
+查查 85
+查证 85
+株洲 85
+梁武帝 85
+梨园 85
+梳子 85
+次长 85
+欧盟委员会 85
+步兵团 85
+歪歪斜斜 85
+母乳 85
+每桶 85
+民风 85
+气相 85
+水坝 85
+水寨 85
+汇合处 85
+汇市 85
+汗毛 85
+沙陀 85
+泡茶 85
+淫秽 85
+清正 85
+滇西 85
+滦河 85
+灉 85
+火车票 85
+炽烈 85
+烤肉 85
+热病 85
+照相馆 85
+爻 85
+特洛伊 85
+独个儿 85
+王永吉 85
+环礁 85
+理发店 85
+理工科 85
+生发 85
+生理学家 85
+生理性 85
+白刃 85
+白白地 85
+皆大欢喜 85
+相国 85
+相扑 85
+眼目 85
+磨砺 85
+神山 85
+神经质 85
+空谈 85
+简明扼要 85
+粉笔 85
+精气 85
+缓急 85
+缭 85
+罗得岛 85
+老红军 85
+老舍 85
+耐热 85
+肉欲 85
+肌腱 85
+肺病 85
+胖胖的 85
+胰腺 85
+腰身 85
+膝下 85
+自谋 85
+苦痛 85
+落荒而逃 85
+藏北 85
+蛇头 85
+行伍 85
+行政权 85
+街心 85
+袁承 85
+要饭 85
+解惑 85
+记分 85
+评委会 85
+诛戮 85
+谏阻 85
+赋值 85
+赛跑 85
+赞礼 85
+路军 85
+辈分 85
+辩驳 85
+过激 85
+远走高飞 85
+迟滞 85
+透亮 85
+逐次 85
+酒厂 85
+酒水 85
+重播 85
+金俊文 85
+铁路局 85
+铯 85
+铺开 85
+锦囊 85
+门径 85
+阎罗 85
+防御性 85
+阿尔泰 85
+附庸 85
+降服 85
+霍乱 85
+韩文冲 85
+顾全 85
+飞腾 85
+首富 85
+香客 85
+马某 85
+驾驶室 85
+黑乎乎 85
+黜 85
+一个班 84
+一无所获 84
+一气呵成 84
+三股 84
+下压 84
+不齿 84
+世界贸易组织 84
+丘脑 84
+两万余 84
+主楼 84
+乒乒乓乓 84
+乳制品 84
+五路 84
+井水 84
+亚太 84
+亡佚 84
+交通线 84
+价款 84
+仿造 84
+传热 84
+侍剑 84
+侯赛因 84
+便服 84
+信不过 84
+停息 84
+偿命 84
+关西 84
+其四 84
+养父 84
+内宅 84
+再犯 84
+军统 84
+冰面 84
+冲压 84
+冷嘲热讽 84
+几内亚湾 84
+几段 84
+出生地 84
+分子生物学 84
+分拆 84
+分拨 84
+初始化 84
+删去 84
+前夜 84
+加法 84
+动产 84
+劳斯 84
+勤于 84
+勤快 84
+包涵 84
+北海舰队 84
+十多天 84
+卷心菜 84
+厚薄 84
+双座 84
+反转 84
+可不可以 84
+可怜见 84
+史氏 84
+含糊其辞 84
+听闻 84
+呜呜咽咽 84
+国内法 84
+坡道 84
+坦克炮 84
+垂死 84
+堂皇 84
+复议 84
+外族 84
+多项式 84
+大失所望 84
+大敌当前 84
+大行星 84
+套子 84
+套用 84
+奥古斯 84
+好书 84
+好多年 84
+妙龄 84
+威吓 84
+宄 84
+安闲 84
+宋美龄 84
+定金 84
+小气 84
+小灵通 84
+小牛队 84
+少尉 84
+尾气 84
+尿酸 84
+山海 84
+帐目 84
+带球 84
+常备 84
+应验 84
+开掘 84
+引线 84
+张平 84
+归并 84
+恋战 84
+惹祸 84
+感激不尽 84
+成瘾 84
+房山 84
+打坐 84
+扫视 84
+扬眉吐气 84
+报以 84
+拉普捷夫 84
+拙劣 84
+持节 84
+排山倒海 84
+推心置腹 84
+操办 84
+攀枝花 84
+攻击性 84
+敲定 84
+新款 84
+方差 84
+旆 84
+无政府 84
+既是 84
+本本 84
+村外 84
+村社 84
+杨家 84
+果酱 84
+柳宗元 84
+核子 84
+桁 84
+梯形 84
+榕树 84
+武宗 84
+死无葬身之地 84
+殉难 84
+毒箭 84
+毛孔 84
+气候带 84
+氪 84
+水管 84
+汪汪 84
+波罗星 84
+洋房 84
+洪泽湖 84
+流变 84
+游击队员 84
+滴水不漏 84
+演奏家 84
+潺潺 84
+灼灼 84
+炔 84
+爱看 84
+犯上作乱 84
+王进宝 84
+玷污 84
+珠帘 84
+班超 84
+生事 84
+生产过剩 84
+电阻率 84
+病毒性 84
+盖一鸣 84
+盛润 84
+目录学 84
+真心实意 84
+矫捷 84
+破片 84
+禨 84
+稀世 84
+稼 84
+穆勒 84
+竖井 84
+第二期 84
+等不及 84
+箙 84
+紫菜 84
+繍 84
+纳粮 84
+纺车 84
+编年史 84
+缝合 84
+缩聚 84
+缶 84
+翦 84
+老字号 84
+老身 84
+自慰 84
+舆论界 84
+舞弊 84
+花布 84
+苍劲 84
+茶商 84
+莫测 84
+蜀汉 84
+蜚声 84
+衣衫褴褛 84
+褚元海 84
+襁褓 84
+西昌 84
+西河 84
+要数 84
+豫北 84
+贝克 84
+贱民 84
+费事 84
+贺卡 84
+贾环 84
+赁 84
+赊 84
+赐教 84
+赞颂 84
+赠与 84
+赴任 84
+超生 84
+车辙 84
+车速 84
+转制 84
+辈份 84
+迎候 84
+近代化 84
+进占 84
+进校 84
+送死 84
+送葬 84
+逆向 84
+递归 84
+通志 84
+郭圣通 84
+酒意 84
+重罪 84
+钟鼓 84
+钢琴家 84
+错失 84
+长流 84
+闺阁 84
+阕 84
+阮小七 84
+雅利安 84
+音量 84
+音高 84
+风平浪静 84
+食言 84
+饭局 84
+香草 84
+麻子 84
+黎斐 84
+黑布 84
+鼠类 84
+一笔勾销 83
+一茬 83
+三刀 83
+不假思索 83
+不可同日而语 83
+东向西 83
+中间派 83
+乌合之众 83
+乌拉尔山脉 83
+乘以 83
+乘隙 83
+买票 83
+事业心 83
+二十一日 83
+亏空 83
+云海 83
+五帝 83
+人来人往 83
+从重 83
+代偿 83
+仰仗 83
+众说纷纭 83
+优胜者 83
+传讯 83
+伸开 83
+俗家 83
+偏方 83
+克敌 83
+全员 83
+全国妇联 83
+公民权 83
+六百 83
+内华达州 83
+农业银行 83
+凶神恶煞 83
+创新型 83
+副本 83
+副油箱 83
+势在必行 83
+勉为其难 83
+十八道 83
+十块 83
+千名 83
+华源 83
+单车 83
+南京临时政府 83
+原产地 83
+双汇发展 83
+収 83
+发财致富 83
+叔祖 83
+受压 83
+口头禅 83
+司马林 83
+各得其所 83
+吉奥森 83
+吉日 83
+后花园 83
+后防 83
+呼号 83
+唿哨 83
+四星级 83
+囫囵 83
+圆桌 83
+圆筒 83
+声部 83
+外相 83
+外缘 83
+多枚 83
+多难 83
+大哥大 83
+大姓 83
+大数 83
+大白 83
+大额 83
+太常 83
+孔夫子 83
+学杂费 83
+宋金 83
+宣传队 83
+宿命 83
+小莲 83
+小街 83
+尘封 83
+山东队 83
+山腹 83
+巴拉 83
+席子 83
+常言说 83
+康托尔 83
+开创性 83
+异种 83
+张明弼 83
+张鲁 83
+彬彬有礼 83
+征管 83
+徵管 83
+心肌梗死 83
+恨之入骨 83
+惕 83
+惧色 83
+惹恼 83
+意中人 83
+慎用 83
+戌 83
+戏班子 83
+才学 83
+打抱不平 83
+打瞌睡 83
+托尔斯泰 83
+扩编 83
+扳倒 83
+拖泥带水 83
+拿给 83
+按理 83
+换乘 83
+捧得 83
+排入 83
+提要 83
+摩纳哥 83
+改作 83
+教研 83
+斗笠 83
+斜睨 83
+斥骂 83
+无果 83
+时间段 83
+映衬 83
+智虑 83
+曲谱 83
+更新换代 83
+木柴 83
+未婚妻 83
+杂费 83
+权门 83
+杨慎 83
+架不住 83
+柇 83
+栖息地 83
+桃红 83
+梅梅 83
+棱角 83
+正定 83
+死路一条 83
+毛利人 83
+民族主义者 83
+水敬洪 83
+水货 83
+汉斯 83
+池子 83
+沅江 83
+涖 83
+液相 83
+深呼吸 83
+深水港 83
+游弋 83
+游轮 83
+滑头 83
+满怀信心 83
+濒危 83
+火电厂 83
+炮兵团 83
+烂熟 83
+烟酒 83
+爱将 83
+独霸 83
+猪头 83
+玉门 83
+王军 83
+王稼祥 83
+珊瑚岛 83
+瓯 83
+用友 83
+用纸 83
+由此看来 83
+申请书 83
+疲于奔命 83
+癌变 83
+白皙 83
+盐土 83
+相对性 83
+眼底 83
+着眼点 83
+睌 83
+矇昧 83
+知己知彼 83
+石勇 83
+石家庄市 83
+砂石 83
+砖窑 83
+碳酸氢钠 83
+示例 83
+社会分工 83
+空空荡荡 83
+简长老 83
+粗野 83
+红十字 83
+绊倒 83
+绝密 83
+统战部 83
+维克特 83
+绽开 83
+美色 83
+羔羊 83
+老迈 83
+联成 83
+聚精会神 83
+肃反 83
+脑电图 83
+脱壳 83
+腾空而起 83
+自娱 83
+自由民 83
+苦味 83
+茉莉 83
+茉莉花 83
+茶饭 83
+莎车 83
+营盘 83
+落伍 83
+葡萄牙语 83
+蒙昧 83
+裱 83
+要强 83
+订票 83
+证券法 83
+话剧团 83
+谈判桌 83
+败血症 83
+贵阳市 83
+赃 83
+赠给 83
+赵齐贤 83
+超低空 83
+跃过 83
+蹑 83
+迁延 83
+进进出出 83
+违禁 83
+迪斯尼乐园 83
+透骨 83
+通融 83
+遨游 83
+都市报 83
+金沙 83
+铁制 83
+镅 83
+阜阳 83
+阿契 83
+阿姆河 83
+霖 83
+领事馆 83
+风云变幻 83
+风能 83
+风骚 83
+马队 83
+骱 83
+高松年 83
+魂飞天外 83
+龙尾 83
+龙椅 83
+一望无际 82
+一沓 82
+七五 82
+万古 82
+万左右 82
+三两 82
+上首 82
+下唇 82
+不择手段 82
+不知好歹 82
+两分钟 82
+中度 82
+主客 82
+亚特兰大 82
+亲朋 82
+亳 82
+亿千瓦 82
+从此以后 82
+仪礼 82
+任内 82
+休养生息 82
+会集 82
+佃客 82
+佯装 82
+依依不舍 82
+侦探 82
+俊秀 82
+倒换 82
+值班室 82
+允诺 82
+光溜溜 82
+免役 82
+入骨 82
+全局性 82
+八一队 82
+公堂 82
+公用事业 82
+六道 82
+冕 82
+写景 82
+几套 82
+切入点 82
+列斯 82
+刘子 82
+励精图治 82
+势利 82
+十二分 82
+华尔街日报 82
+华语 82
+占廷 82
+厣 82
+参天 82
+参观者 82
+发霉 82
+叛逃 82
+句式 82
+可憎 82
+同心同德 82
+同理 82
+同盟者 82
+启事 82
+呼气 82
+哲人 82
+唐军 82
+嗑 82
+四岁 82
+四座 82
+困顿 82
+圣像 82
+地亚 82
+地震带 82
+城关镇 82
+基本点 82
+多姿多彩 82
+大开眼界 82
+大院里 82
+天不怕 82
+天青 82
+奇闻 82
+姑子 82
+威权 82
+婚纱 82
+子囊 82
+孪生 82
+守则 82
+实力派 82
+宠臣 82
+小东西 82
+局外人 82
+展宽 82
+工商界 82
+左光斗 82
+巴拉克 82
+干净利落 82
+库什 82
+延边 82
+建设者 82
+弓箭手 82
+张维迎 82
+强横 82
+归隐 82
+当权者 82
+徐元文 82
+徐国强 82
+心乱如麻 82
+忍让 82
+怅惘 82
+总政 82
+惀 82
+意趣 82
+戈珊 82
+手劲 82
+手抄本 82
+扭伤 82
+技战术 82
+披头散发 82
+拉力 82
+招摇 82
+拨动 82
+挑担 82
+推诿 82
+旈 82
+时势 82
+有心人 82
+木棉 82
+未决 82
+李天 82
+李登辉 82
+松赞干布 82
+格鲁派 82
+案板 82
+楔形文字 82
+歇宿 82
+氮气 82
+池水 82
+汪铁鹗 82
+沈括 82
+油气藏 82
+治本 82
+法西斯主义 82
+洪水期 82
+流行病学 82
+海王星 82
+海蚀 82
+湫 82
+溢价 82
+滑膛炮 82
+满堂 82
+灵帝 82
+热度 82
+烷烃 82
+焦作 82
+狸子 82
+玄妙 82
+生生的 82
+申能 82
+界内 82
+疗养院 82
+病理性 82
+百越 82
+皴 82
+相熟 82
+眼圈儿 82
+着迷 82
+短枪 82
+磨炼 82
+神经性 82
+神采飞扬 82
+科教兴国 82
+稿费 82
+穷尽 82
+窬 82
+立足之地 82
+竽 82
+笀 82
+第五位 82
+等位 82
+箭步 82
+米利都 82
+粗气 82
+紫霞 82
+纳贡 82
+纷纭 82
+纹路 82
+纽芬兰 82
+编选 82
+缩头 82
+耳根 82
+聂士成 82
+肉身 82
+胃溃疡 82
+胜者 82
+脱产 82
+腹内 82
+腹水 82
+膈 82
+自拔 82
+臭骂 82
+至诚 82
+致信 82
+舞刀 82
+航展 82
+艺术馆 82
+芗 82
+苤 82
+草酸 82
+落到实处 82
+虚报 82
+血亲 82
+血汗 82
+血脂 82
+衣柜 82
+表叔 82
+讥嘲 82
+议决 82
+请柬 82
+调频 82
+谪 82
+负电 82
+贵妇 82
+赛会 82
+赫拉克 82
+赵辛楣 82
+路向 82
+迂 82
+过得去 82
+还俗 82
+进口商品 82
+连败 82
+选矿 82
+通政司 82
+郁积 82
+酝 82
+重剑 82
+金象 82
+鍞 82
+铺面 82
+长期性 82
+问讯 82
+阎锡山 82
+阿拉伯海 82
+阿济格 82
+阿里巴巴 82
+陆相 82
+陈寺福 82
+降雪 82
+隋末 82
+青蒿 82
+革除 82
+韦德 82
+顾媚生 82
+颛顼 82
+食疗 82
+馅饼 82
+馊 82
+马勺 82
+高楼大厦 82
+高锰酸钾 82
+鲍桑尼 82
+鸳鸯刀 82
+鹿儿岛 82
+麻省理工学院 82
+黄旗 82
+鼻血 82
+七八年 81
+万众 81
+万枚 81
+万福 81
+三叠 81
+三步走 81
+上饶 81
+下摆 81
+下颚 81
+不好过 81
+不紧不慢 81
+不逊 81
+丑八怪 81
+东巴 81
+两局 81
+两盏 81
+两阵 81
+中国作家协会 81
+中国网通 81
+中国羽毛球队 81
+中街 81
+丽丽 81
+九段 81
+二本 81
+井喷 81
+产油国 81
+人寿保险 81
+亿度 81
+休战 81
+借势 81
+偏颇 81
+停办 81
+僵直 81
+元月 81
+先锋队 81
+先驱者 81
+全身性 81
+关涉 81
+关连 81
+再则 81
+几十米 81
+几百个 81
+刀鞘 81
+分内 81
+划转 81
+刘处玄 81
+刘建业 81
+刘步蟾 81
+刮脸 81
+刻板 81
+加封 81
+北回归线 81
+北国 81
+医书 81
+升官发财 81
+华侨城 81
+卡尔基 81
+卡门 81
+卧倒 81
+卫国战争 81
+卫生部门 81
+发明者 81
+受训 81
+变脸 81
+古旧 81
+古树 81
+史无前例 81
+合子 81
+名下 81
+呱 81
+和服 81
+和龙 81
+哕 81
+四行 81
+四边 81
+四面体 81
+国难 81
+圆脸 81
+地穴 81
+坟头 81
+壮胆 81
+夏侯渊 81
+多姿 81
+大号 81
+大肚子 81
+大肠杆菌 81
+太古 81
+奖品 81
+奥斯陆 81
+存折 81
+守势 81
+寿星 81
+封锁线 81
+尔曼 81
+尖子 81
+尤杯 81
+尽如人意 81
+尽职 81
+岔子 81
+嵴 81
+布料 81
+干支流 81
+库尔勒 81
+库藏 81
+庞加莱 81
+建功 81
+开外 81
+强学会 81
+彩旗 81
+彻骨 81
+律诗 81
+怀特 81
+总则 81
+恭亲王 81
+恶言 81
+意大利语 81
+感化 81
+感染性 81
+愤激 81
+房地产业 81
+手扶拖拉机 81
+打鼾 81
+批阅 81
+报捷 81
+抹杀 81
+拆掉 81
+拍卖会 81
+拾遗 81
+按察使 81
+挑明 81
+挥师 81
+挨骂 81
+掂量 81
+接下 81
+政教 81
+数千名 81
+数种 81
+方向性 81
+易事 81
+普陀 81
+暗河 81
+暴风 81
+更有甚者 81
+更衣 81
+月余 81
+本题 81
+朱白氏 81
+李明心 81
+李芳 81
+杨贵妃 81
+杯中 81
+松辽 81
+枋 81
+柉 81
+某日 81
+柚 81
+查伊璜 81
+柴胡 81
+桥孔 81
+梅加拉 81
+椴 81
+楚地居 81
+樽 81
+正方形 81
+武侠 81
+毂 81
+气势磅礴 81
+气道 81
+水文学 81
+水柱 81
+水洗 81
+汇演 81
+汞柱 81
+汤药 81
+汪林 81
+活死人 81
+浇灌 81
+浮冰 81
+淡忘 81
+清闲 81
+温氏 81
+湘财 81
+溺爱 81
+滑县 81
+火箭队 81
+灵隐寺 81
+热热闹闹 81
+焘 81
+特价 81
+玉镯 81
+王冠 81
+王剑杰 81
+球衣 81
+理想化 81
+理查德 81
+瓦良格 81
+畸变 81
+瘫软 81
+瘿 81
+登封 81
+白沙 81
+皖北 81
+盐碱 81
+盛誉 81
+真传 81
+眯缝 81
+着数 81
+硝基苯 81
+硬木 81
+神勇 81
+禀赋 81
+移步 81
+穿透力 81
+立宪派 81
+简单化 81
+紧身 81
+紧逼 81
+綅 81
+终身大事 81
+绣花鞋 81
+综合体 81
+缘起 81
+羊羔 81
+美塞尼亚 81
+羚 81
+羽毛球赛 81
+翁同 81
+翻盘 81
+老君 81
+肝硬化 81
+自编 81
+芬奇 81
+苋 81
+苏门答腊岛 81
+苛求 81
+萃 81
+蛟龙 81
+蜀中 81
+被囚 81
+西式 81
+觳 81
+评书 81
+话语权 81
+象形文字 81
+起跳 81
+路线图 81
+身段 81
+软和 81
+轻取 81
+达吉亚娜 81
+运筹帷幄 81
+还贷 81
+连环画 81
+迟误 81
+邮箱 81
+酷热 81
+钖 81
+门柱 81
+阳线 81
+阴霾 81
+陈涛 81
+降顺 81
+陕甘宁 81
+隐情 81
+零乱 81
+雷管 81
+非公有制 81
+韕 81
+韪 81
+顿然 81
+高远 81
+高高地 81
+鳞翅目 81
+黄壤 81
+黑木崖 81
+一边倒 80
+三焦 80
+三队 80
+上瘾 80
+不知天高地厚 80
+专车 80
+东南方 80
+两千多年 80
+严冬 80
+中继 80
+中联部 80
+串连 80
+为伴 80
+主次 80
+乔丹 80
+乳胶 80
+二十余里 80
+互连 80
+五等 80
+代词 80
+令旗 80
+会派 80
+伺服 80
+低低的 80
+作废 80
+作案 80
+修身 80
+八九 80
+关掉 80
+冀中 80
+决定论 80
+出货量 80
+分门别类 80
+利空 80
+别样 80
+刷卡 80
+十根 80
+半寸 80
+半死不活 80
+华微电子 80
+印度半岛 80
+双倍 80
+反射镜 80
+发令 80
+发电站 80
+号子 80
+司考 80
+同人 80
+同龄 80
+后半生 80
+吴晗 80
+吴爱珍 80
+周琦思 80
+咸水湖 80
+哭闹 80
+哲学系 80
+啜 80
+嗓 80
+四六级 80
+四目 80
+圆周 80
+圈圈 80
+圣殿 80
+地地道道 80
+坐位 80
+垂柳 80
+夏历 80
+大汗淋漓 80
+大马 80
+天元 80
+头盖骨 80
+娇羞 80
+娘子军 80
+子集 80
+安盟 80
+宋明 80
+容桂芳 80
+密谈 80
+小丑 80
+小丘 80
+小说集 80
+尖刻 80
+尽兴 80
+展位 80
+屠夫 80
+山茶 80
+山药 80
+崛 80
+巡游 80
+平凉 80
+平山 80
+府第 80
+开具 80
+异味 80
+异形 80
+弛懈 80
+张勇 80
+当仁不让 80
+彭颖 80
+忠贞 80
+思潮起伏 80
+总产 80
+情书 80
+惯于 80
+成批 80
+所见所闻 80
+扎耶德 80
+打垮 80
+托起 80
+把玩 80
+抢眼 80
+指挥家 80
+掠取 80
+提花 80
+搱 80
+摆渡 80
+摩拳擦掌 80
+攻心 80
+放炮 80
+教育工作者 80
+料事如神 80
+旅社 80
+昂扬 80
+昌盛 80
+普林斯 80
+普通股 80
+替人 80
+有愧 80
+杂食 80
+李国兴 80
+李成 80
+李承晚 80
+枪头 80
+格尔木 80
+梁万俊 80
+梅西纳 80
+棱镜 80
+楸 80
+樊纲 80
+橙色 80
+櫄 80
+欲绝 80
+水力发电 80
+水沟 80
+汉语言 80
+沉沉的 80
+注销 80
+洗洗 80
+流毒 80
+浏阳 80
+海胆 80
+海龙 80
+润州 80
+溶化 80
+滴溜溜 80
+灰鼠 80
+炕沿 80
+烧水 80
+焯 80
+照理 80
+爪牙 80
+牛棚 80
+状子 80
+狗头 80
+王蒙 80
+玻利瓦尔 80
+琪 80
+瓦当 80
+甚或 80
+白凤 80
+白鹿镇 80
+目击 80
+相帮 80
+眉宇 80
+石匣 80
+碱金属 80
+碳酸盐岩 80
+磁头 80
+磁铁矿 80
+神经衰弱 80
+祥云 80
+科幻 80
+稽核 80
+空地导弹 80
+空门 80
+窃窃私语 80
+窦太后 80
+第三层 80
+纪念地 80
+纯金 80
+结案 80
+绿树 80
+缆车 80
+网罗 80
+置业 80
+群情 80
+翻案风 80
+聚散 80
+聪颖 80
+肿痛 80
+脉门 80
+脑血管 80
+舞蹈家 80
+英国国防部 80
+藤萝 80
+蚜虫 80
+血尿 80
+袒 80
+袖珍 80
+裂痕 80
+装运 80
+言听计从 80
+议会制 80
+诺华 80
+谕令 80
+谤 80
+贞元 80
+负电荷 80
+败将 80
+赏月 80
+赢家 80
+赤水 80
+走火入魔 80
+赵本山 80
+踩踏 80
+身先士卒 80
+轮作 80
+辗 80
+迁回 80
+迂腐 80
+运输车 80
+进退两难 80
+通电 80
+通过率 80
+遣散 80
+那老僧 80
+酪 80
+醺 80
+释然 80
+里应外合 80
+野狗 80
+钻机 80
+铁环 80
+链球菌 80
+长途跋涉 80
+阴囊 80
+阿卡 80
+阿留申 80
+陈州 80
+限价 80
+雷射 80
+震中 80
+露宿 80
+顾得上 80
+颔首 80
+飞鱼 80
+饧 80
+香喷喷 80
+马嘶 80
+马拉松 80
+马来亚 80
+骠骑 80
+髼 80
+麂 80
+麻皮阿 80
+一力 79
+一朝一夕 79
+三天两头 79
+三星电子 79
+不同凡响 79
+不着边际 79
+不速之客 79
+中庸之道 79
+临朝 79
+丹尼斯 79
+乱伦 79
+事权 79
+云贵高原 79
+产品线 79
+京华 79
+亶 79
+付托 79
+仙丹 79
+令箭 79
+传扬 79
+低平 79
+体察 79
+何乐而不为 79
+余热 79
+你来我往 79
+便道 79
+保护国 79
+信息管理 79
+倒台 79
+倾吐 79
+偏心 79
+光洁 79
+克勒斯 79
+八条 79
+六盘山 79
+共谋 79
+冀南 79
+农业国 79
+冠冕堂皇 79
+冰淇淋 79
+凋 79
+凌波微 79
+几里 79
+几门 79
+出纳 79
+刘健 79
+初审 79
+前因后果 79
+加尔 79
+加权 79
+劣迹 79
+勋爵 79
+勤勉 79
+十万火急 79
+单字 79
+卖艺 79
+卫戍 79
+压缩机 79
+原状 79
+参选 79
+双生 79
+反病毒 79
+发售 79
+发射场 79
+变通 79
+台儿庄 79
+吉士 79
+吟唱 79
+听证 79
+吴良辅 79
+呜呼 79
+哈医大二院 79
+啜泣 79
+噺 79
+四张 79
+壁虎 79
+多夫 79
+多端 79
+大亚湾 79
+大佛寺 79
+大发雷霆 79
+大庭广众 79
+大模大样 79
+夹缝 79
+季报 79
+孤苦 79
+定数 79
+客运站 79
+宣化 79
+宣帝 79
+宣府 79
+容光焕发 79
+尊长 79
+小尔依 79
+小黄 79
+山岩 79
+工业部 79
+师德 79
+常氏 79
+干不了 79
+平津战役 79
+平西伯 79
+并吞 79
+广告牌 79
+弓弦 79
+张太雷 79
+形影不离 79
+征粮 79
+微波炉 79
+念念有词 79
+怏怏 79
+恣意 79
+患病率 79
+懊 79
+戛 79
+房檐 79
+所向 79
+手杖 79
+打骂 79
+扬鞭 79
+护身符 79
+报送 79
+拾掇 79
+按计划 79
+挚爱 79
+挨饿 79
+掺和 79
+撒马尔罕 79
+撰稿 79
+敊 79
+整套 79
+整流罩 79
+新东方 79
+旸 79
+昂起 79
+暖意 79
+最优化 79
+朔风 79
+木高峯 79
+木高峰 79
+未愈 79
+机队 79
+杨不悔 79
+杨廷麟 79
+林兴珠 79
+果胶 79
+架式 79
+树顶 79
+样板 79
+檀木 79
+欢欣鼓舞 79
+正则 79
+正离子 79
+每期 79
+气急 79
+气话 79
+汤圆 79
+汾酒 79
+没空 79
+法术 79
+注文 79
+泰和 79
+洋鬼子 79
+深恐 79
+深沟 79
+湟水 79
+溃退 79
+滋阴 79
+漯河 79
+潇 79
+潜质 79
+火山灰 79
+炮楼 79
+烈性 79
+烦乱 79
+焓 79
+牛鬼蛇神 79
+猜中 79
+玄寂 79
+玩物 79
+生漆 79
+电量 79
+癫 79
+白沫 79
+白米 79
+白话文 79
+百济 79
+皮匠 79
+盐铁使 79
+相认 79
+真迹 79
+眼儿 79
+知事 79
+石炭纪 79
+福地 79
+离合器 79
+私生活 79
+秺 79
+窘境 79
+竞争性 79
+笤 79
+第三件 79
+第十三 79
+第十二章 79
+簸箕 79
+精研 79
+糙 79
+红六军团 79
+结扎 79
+维奇 79
+编配 79
+缠斗 79
+网通 79
+肺动脉 79
+胡吹 79
+胡桃 79
+胡爷 79
+胶塞 79
+能事 79
+脱氢 79
+脾性 79
+腓 79
+臣僚 79
+自述 79
+艹 79
+花篮 79
+苹果公司 79
+范帮主 79
+茅舍 79
+荒淫 79
+荷载 79
+蔡靖 79
+藏医 79
+虚火 79
+袋鼠 79
+西王母 79
+解职 79
+詋 79
+认股权证 79
+诺夫 79
+读取 79
+调料 79
+谷歌 79
+败军 79
+购买者 79
+贺海 79
+赋诗 79
+赴汤蹈火 79
+超度 79
+超强 79
+较劲 79
+进村 79
+透射 79
+通身 79
+造船业 79
+邛 79
+邮报 79
+配售 79
+重托 79
+金根 79
+铔 79
+锥子 79
+长空 79
+长绳 79
+阿婆 79
+阿绣 79
+陀螺仪 79
+院系 79
+难者 79
+雷暴 79
+雷演祚 79
+霍光 79
+顶头上司 79
+顺道 79
+预售 79
+颠三倒四 79
+风风雨雨 79
+飞狐 79
+马明德 79
+骁勇 79
+高矮 79
+魏王 79
+鱼龙 79
+鲁朵 79
+鹌鹑 79
+麦草 79
+黄文炳 79
+齐射 79
+龙潭 79
+一师 78
+一点一滴 78
+一走了之 78
+七十岁 78
+三五 78
+三十八年 78
+上唇 78
+上海人民出版社 78
+下半时 78
+下坡 78
+两期 78
+个体经营 78
+中流 78
+临时工 78
+争雄 78
+二队 78
+亚太区 78
+人名 78
+人迹罕至 78
+仨 78
+会计学 78
+俄文 78
+借故 78
+僧袍 78
+儹 78
+八尺 78
+八道 78
+公议 78
+六十四 78
+共产主义者 78
+养神 78
+军权 78
+冷冷 78
+刀斧手 78
+创作者 78
+制盐 78
+剑气 78
+功耗 78
+动物学 78
+努嘴 78
+勃发 78
+勤工俭学 78
+北河 78
+十多名 78
+单核 78
+南北战争 78
+历练 78
+双面 78
+反华 78
+口风 78
+号召力 78
+合意 78
+吉尔吉斯 78
+同宗 78
+后坐 78
+吕蒙 78
+听凭 78
+啬 78
+喋喋不休 78
+嗍 78
+土体 78
+地价 78
+地堡 78
+埋设 78
+堂兄弟 78
+堕胎 78
+处身 78
+大位 78
+大凌河 78
+大家族 78
+大恩大德 78
+大淩河 78
+大禹 78
+大藏省 78
+天书 78
+天柱 78
+头晕目眩 78
+女伴 78
+威廉姆斯 78
+婧 78
+宁都 78
+宋真宗 78
+实处 78
+客家 78
+客运量 78
+家贫 78
+密书 78
+富国 78
+寒温带 78
+寡头政治 78
+寻欢作乐 78
+射频 78
+屠龙 78
+山阳 78
+崾 78
+巡回演出 78
+工作队 78
+巴尔 78
+帐房 78
+年货 78
+幼发拉底河 78
+开天辟地 78
+张义 78
+弱化 78
+弹弓 78
+归去 78
+彩霞 78
+彼得大帝 78
+待业 78
+待续 78
+徐力里 78
+御史中丞 78
+循环系统 78
+忙忙碌碌 78
+怎奈 78
+怪招 78
+慢悠悠 78
+成功者 78
+戒惧 78
+打手 78
+批斗 78
+承当 78
+把住 78
+抓捕 78
+指挥车 78
+指日可待 78
+掏腰包 78
+掕 78
+握紧 78
+摄影机 78
+收盘价 78
+敼 78
+文房四宝 78
+斯塔夫 78
+无产者 78
+无时无刻 78
+无过 78
+明净 78
+易卜拉欣 78
+昵 78
+暂时性 78
+最新型 78
+本末 78
+来来去去 78
+杨善 78
+枯死 78
+核计划 78
+桑树 78
+桑结 78
+楼价 78
+横蛮 78
+正题 78
+此病 78
+死法 78
+死海 78
+毛岸英 78
+毛纺织 78
+民革 78
+水晶宫 78
+江姐 78
+汶 78
+活体 78
+浴血奋战 78
+海象 78
+涡流 78
+涨潮 78
+淡泊 78
+深长 78
+温润 78
+渭南 78
+滑石 78
+滑翔机 78
+潜水艇 78
+激光束 78
+瀬 78
+烊 78
+烟气 78
+烧焦 78
+燃眉之急 78
+物证 78
+牵引式 78
+犯上 78
+狄拉克 78
+狩 78
+独生 78
+玄贞 78
+玉泉 78
+理疗 78
+生物武器 78
+电击 78
+电线杆 78
+畅通无阻 78
+疾忙 78
+病痛 78
+癞 78
+皮特 78
+皮鞭 78
+盖房 78
+直航 78
+相对而言 78
+省港大罢工 78
+眼见得 78
+瞬息万变 78
+矇 78
+碴 78
+磊 78
+社会史 78
+祝家庄 78
+神鬼 78
+秦红棉 78
+穷凶极恶 78
+窗格 78
+第一眼 78
+第十一章 78
+粜 78
+紫荆 78
+红岩 78
+细胞器 78
+经度 78
+绯红 78
+综合症 78
+网络版 78
+羁押 78
+美国科学院 78
+联机 78
+聪敏 78
+致函 78
+舅妈 78
+舌战 78
+艳无双 78
+药粉 78
+落山 78
+蒴 78
+藉故 78
+融会贯通 78
+蟒蛇 78
+装饰性 78
+西哈努克 78
+西楚 78
+西雅图 78
+角门 78
+讲坛 78
+论罪 78
+识见 78
+词源 78
+说穿 78
+调式 78
+谥号 78
+豫州 78
+贴图 78
+起重机 78
+超常 78
+足球场 78
+踮 78
+轶事 78
+邳 78
+酸枣 78
+醉意 78
+金桂 78
+铜城 78
+锡耶纳 78
+长此以往 78
+门客 78
+闶 78
+阳关 78
+阿拉伯文 78
+险滩 78
+隆胸 78
+难辨 78
+霍夫 78
+飘渺 78
+飞入 78
+马里昂 78
+鬼门关 78
+魏徵 78
+黄袍 78
+黑锅 78
+龈 78
+一个点 77
+一年到头 77
+一百名 77
+三份 77
+三十二 77
+三反 77
+下体 77
+下周 77
+不大不小 77
+不胜枚举 77
+不能自拔 77
+东移 77
+丝路 77
+两手空空 77
+中央苏区 77
+中文版 77
+中条山 77
+中间人 77
+书体 77
+争权 77
+二十六日 77
+二十天 77
+亡故 77
+交费 77
+从小到大 77
+众家 77
+似曾相识 77
+何腾蛟 77
+余观主 77
+供热 77
+侵掠 77
+保值 77
+保山 77
+保费 77
+倒水 77
+倚仗 77
+停业 77
+先验 77
+光栅 77
+全厂 77
+八项 77
+六成 77
+具体说来 77
+内蒙古高原 77
+军事科学 77
+凯尔特 77
+出巡 77
+凼 77
+切齿 77
+刍 77
+刖 77
+制导系统 77
+剪辑 77
+加载 77
+勃艮第 77
+化脓 77
+北大西洋公约组织 77
+十四个 77
+半途而废 77
+协力 77
+卵细胞 77
+卸去 77
+卸妆 77
+厄尔尼诺 77
+双数 77
+发射机 77
+古猿 77
+后勤部 77
+吨级 77
+吹风 77
+周浩 77
+哈林 77
+哈特 77
+喂奶 77
+喜怒 77
+嗻 77
+噘 77
+四等 77
+囟 77
+国产化 77
+地利 77
+基站 77
+塞维利亚 77
+墨汁 77
+多分 77
+大不一样 77
+大生产 77
+天罗地网 77
+奢靡 77
+女色 77
+婆娑 77
+字里行间 77
+存贮 77
+宋仁宗 77
+家私 77
+寒冬 77
+小步 77
+尴 77
+山虎 77
+崇德 77
+工学 77
+左右为难 77
+巴巴多斯 77
+巴解组织 77
+布下 77
+希拉克 77
+带病 77
+帮办 77
+平型关 77
+廪 77
+强击机 77
+归类 77
+御寒 77
+微乎其微 77
+德累斯顿 77
+念念 77
+怪叫 77
+总编 77
+恣肆 77
+惨剧 77
+意中 77
+戏班 77
+成交额 77
+成堆 77
+扑簌簌 77
+抢断 77
+拉希德 77
+拉斯 77
+挥发性 77
+捣蛋 77
+授粉 77
+播音 77
+放款 77
+教改 77
+敲门声 77
+文具 77
+新会 77
+新报 77
+方军 77
+方山 77
+无性生殖 77
+易名 77
+昭帝 77
+曲儿 77
+曹国伟 77
+服毒 77
+朗读 77
+本息 77
+朱庆 77
+杀人灭口 77
+杂沓 77
+李靖 77
+极乐 77
+林产品 77
+标出 77
+树起 77
+校点 77
+桑弘羊 77
+检出 77
+棒状 77
+棒球 77
+横滨 77
+每张 77
+毛囊 77
+毫无办法 77
+民不聊生 77
+汉简 77
+汪文言 77
+洗衣粉 77
+济贫 77
+海图 77
+海角 77
+涉及面 77
+滁州 77
+潘汉年 77
+潦草 77
+灵长类 77
+炙手可热 77
+牯 77
+狍 77
+王充 77
+环视 77
+生养 77
+用光 77
+甲壳动物 77
+电影界 77
+白赵氏 77
+监理 77
+盘活 77
+眉间 77
+眼波 77
+着色剂 77
+睑 77
+研究部 77
+破鞋 77
+确定性 77
+竹木 77
+笔划 77
+笨蛋 77
+第一支 77
+筶 77
+算子 77
+篆书 77
+类固醇 77
+粗心 77
+系统对 77
+红太阳 77
+纪元 77
+纹银 77
+组组 77
+结核病 77
+绕行 77
+络腮胡子 77
+缧 77
+老江湖 77
+老狐狸 77
+能干 77
+脘 77
+自爱 77
+舒舒服服 77
+节外生枝 77
+苌 77
+苏东坡 77
+范阳 77
+荨 77
+药箱 77
+菜蔬 77
+营利 77
+藏经阁 77
+蚩 77
+裤脚 77
+诋毁 77
+误入 77
+读本 77
+谨防 77
+贝鲁特 77
+走后门 77
+超负荷 77
+跳远 77
+这么些 77
+进行性 77
+迷彩 77
+逐日 77
+道听途说 77
+遗精 77
+郓城县 77
+郭啸天 77
+郭盛 77
+鄂温克 77
+酌情 77
+针状 77
+钋 77
+钱凤览 77
+铁锹 77
+银联卡 77
+锝 77
+锡华 77
+锵 77
+门牙 77
+问罪 77
+间歇性 77
+阜新 77
+阮小 77
+阳江 77
+阴柔 77
+阿诺斯 77
+陈家 77
+随地 77
+雁门 77
+韦爵爷 77
+顺口溜 77
+领情 77
+题诗 77
+马三婆 77
+高峻 77
+鳅 77
+龙城 77
+一个名 76
+一瓢 76
+一统天下 76
+不可多得 76
+不在少数 76
+丘疹 76
+东北方 76
+中下旬 76
+为之一振 76
+主修 76
+义勇 76
+乘务员 76
+五部 76
+价值形式 76
+伏尔泰 76
+伏特 76
+伯利兹 76
+余架 76
+修练 76
+倒流 76
+债主 76
+免收 76
+入球 76
+共价键 76
+养女 76
+决绝 76
+冷冷清清 76
+几篇 76
+几路 76
+出海口 76
+分房 76
+刘丽华 76
+刘凤云 76
+初叶 76
+别无选择 76
+剪除 76
+势不两立 76
+化学能 76
+十万两 76
+千百 76
+升限 76
+南院 76
+危害性 76
+参股 76
+发报 76
+发电机组 76
+变幅 76
+可循 76
+吉斯 76
+含含糊糊 76
+吴淞 76
+周穆王 76
+和颜悦色 76
+哄传 76
+哽 76
+售楼 76
+喜气 76
+四层 76
+国泰 76
+图拉 76
+垄断性 76
+夏国相 76
+夜叉 76
+大一统 76
+大中华区 76
+大师赛 76
+大风大浪 76
+太上老君 76
+失神 76
+套间 76
+她俩 76
+字斟句酌 76
+宅邸 76
+客道 76
+寿县 76
+将息 76
+小名 76
+小姑 76
+小童 76
+山猫 76
+工业用 76
+工程兵 76
+布加勒斯特 76
+布防 76
+干校 76
+平反昭雪 76
+应手 76
+廨 76
+开道 76
+弥散 76
+弱智 76
+彉 76
+徒孙 76
+得势 76
+必由之路 76
+恰如其分 76
+悲怆 76
+悲欢 76
+愩 76
+慕容景岳 76
+慕容景嶽 76
+憧 76
+成熟期 76
+抒写 76
+抱头 76
+拗陷 76
+招术 76
+持之以恒 76
+指示剂 76
+探险者 76
+掺入 76
+搞清 76
+摔交 76
+教案 76
+敢死队 76
+数一数二 76
+整条 76
+文化站 76
+文辞 76
+斑驳 76
+断后 76
+斯大林格勒 76
+斯罗普 76
+无休止 76
+无数个 76
+无精打采 76
+明目张胆 76
+是非曲直 76
+晋书 76
+普林斯顿大学 76
+曹洪 76
+曾孙 76
+月氏 76
+木婉 76
+木条 76
+木马 76
+杀毒软件 76
+杂环 76
+杂耍 76
+杂记 76
+权当 76
+染病 76
+校注 76
+样机 76
+棒槌 76
+楼梯口 76
+歆 76
+正电荷 76
+残片 76
+比及 76
+民主联盟 76
+水深火热 76
+求治 76
+汗腺 76
+汪帮主 76
+汹汹 76
+汽笛 76
+洱海 76
+浪涛 76
+淤塞 76
+淮南子 76
+深色 76
+混用 76
+清迈 76
+湘桂 76
+漏税 76
+潜藏 76
+潮间带 76
+灌注 76
+炯炯有神 76
+烧饭 76
+熹 76
+燎原 76
+爱多 76
+爱新觉罗 76
+牙刷 76
+犄角 76
+犹存 76
+独行 76
+猫儿 76
+獗 76
+獠牙 76
+王化贞 76
+王志坦 76
+王敦 76
+王英 76
+甘于 76
+生锈 76
+用车 76
+甲苯 76
+畅饮 76
+痛处 76
+白云岩 76
+白地 76
+监听站 76
+盗墓者 76
+直立人 76
+真腊 76
+石勒 76
+破涕为笑 76
+硅质 76
+磬 76
+祁 76
+神机妙算 76
+税额 76
+粉刺 76
+粮价 76
+粽 76
+糉 76
+红河 76
+红褐色 76
+纯洁性 76
+经传 76
+绝学 76
+维纳斯 76
+绿肥 76
+羽化 76
+耐火材料 76
+育成 76
+胂 76
+胆小鬼 76
+脱位 76
+舍下 76
+船板 76
+船艇 76
+节肢动物 76
+芡 76
+花岗石 76
+花鸟画 76
+芳心 76
+苔原 76
+苡 76
+草房 76
+蒸馏水 76
+藌 76
+虫草 76
+蚡 76
+蚶 76
+译作 76
+诛灭 76
+诱敌 76
+豆制品 76
+货架 76
+贵在 76
+资格赛 76
+赏罚 76
+跳高 76
+踏青 76
+转型期 76
+软着陆 76
+辞行 76
+达官贵人 76
+达鲁花赤 76
+过氧化苯甲酰 76
+运筹 76
+通途 76
+邬桥 76
+郡守 76
+鄯善 76
+野鸭 76
+金面佛 76
+钱明经 76
+钹 76
+销声匿迹 76
+长平 76
+长白 76
+闺房 76
+防疫 76
+阵痛 76
+难耐 76
+雄健 76
+雅典娜 76
+雌雄异体 76
+霭 76
+静谧 76
+顾全大局 76
+预知 76
+馆舍 76
+马扎 76
+高干 76
+高徒 76
+高瞻远瞩 76
+魔法 76
+鹅卵石 76
+麻黄 76
+黑土 76
+一季 75
+一宗 75
+一而再 75
+丁姨 75
+七中全会 75
+七八名 75
+七八里 75
+万亿 75
+万科 75
+三四天 75
+三四年 75
+上甘岭 75
+下回 75
+不稳定性 75
+世纪之交 75
+个别人 75
+中举 75
+中国香港 75
+中水 75
+中立国 75
+中青旅 75
+乘人之危 75
+乳品 75
+二十几个 75
+五六十 75
+五只 75
+五金 75
+些微 75
+亣 75
+伊凡 75
+优伶 75
+会盟 75
+伟哥 75
+伪君子 75
+低层 75
+佛堂 75
+佳话 75
+侍御史 75
+做爱 75
+儿歌 75
+先下手为强 75
+光电效应 75
+免疫性 75
+入境者 75
+入海口 75
+八股文 75
+公房 75
+六分 75
+关卡 75
+冯国璋 75
+几成 75
+出洋 75
+出风头 75
+初创 75
+动物性 75
+北京国安 75
+十家 75
+千差万别 75
+千里马 75
+半张 75
+华东师范大学 75
+华硕 75
+华西 75
+南京军区 75
+南齐 75
+原始公社 75
+原始积累 75
+原故 75
+原样 75
+原貌 75
+反战 75
+发狠 75
+受益者 75
+古代人 75
+古刹 75
+台子 75
+叶姐 75
+合情合理 75
+同方 75
+同甘共苦 75
+名分 75
+名帖 75
+吕雉 75
+否决权 75
+周转率 75
+喜不自胜 75
+噶尔丹 75
+四卷 75
+四口 75
+四蹄 75
+因势利导 75
+国务院办公厅 75
+国防大学 75
+圆滑 75
+土产 75
+地脉 75
+坦克团 75
+增派 75
+处方药 75
+夏侯蔼 75
+夏岚 75
+外轮 75
+多特蒙德 75
+大二 75
+大手笔 75
+大烟 75
+大藏经 75
+大起大落 75
+大都会 75
+奉节 75
+女皇 75
+好斗 75
+威远 75
+子夜 75
+孔有德 75
+学术会议 75
+容身 75
+宿迁 75
+寄居 75
+密诏 75
+寒玉 75
+对立统一 75
+寻衅 75
+封建礼教 75
+射精 75
+小慧 75
+小袁营 75
+少年人 75
+尔德 75
+屋瓦 75
+山人 75
+屻 75
+峯值 75
+峰值 75
+崔立凡 75
+工具书 75
+巴西利亚 75
+布包 75
+帕米尔高原 75
+干扰素 75
+广宁 75
+开拔 75
+异军突起 75
+引证 75
+得罪人 75
+忐忑 75
+忧色 75
+悬崖峭壁 75
+意气风发 75
+战斗队 75
+手下留情 75
+手本 75
+手球 75
+扦插 75
+扫盲 75
+抗氧化 75
+拒腐防变 75
+括约肌 75
+指挥权 75
+捉迷藏 75
+捎带 75
+换换 75
+掰开 75
+揣测 75
+揽工 75
+摈 75
+摔打 75
+撮合 75
+整体性 75
+断肠草 75
+断腿 75
+无人区 75
+无冤无仇 75
+无烟煤 75
+春意 75
+曹刚川 75
+替天行道 75
+木器 75
+木屑 75
+杨世光 75
+松脂 75
+枯树 75
+查抄 75
+格拉斯哥 75
+梳妆 75
+椼 75
+横过 75
+歌舞剧 75
+武行 75
+歪斜 75
+死记硬背 75
+死鬼 75
+母校 75
+毛利 75
+民夫 75
+民进 75
+水压 75
+水浴 75
+求偶 75
+沃克 75
+河北平原 75
+油料作物 75
+法理学 75
+泥盆纪 75
+浓茶 75
+海蛇 75
+涤纶 75
+深更半夜 75
+清明上河图 75
+湛蓝 75
+灌水 75
+炸掉 75
+烟灰 75
+爱抚 75
+片片 75
+牛家庄 75
+牟取 75
+物美 75
+特委会 75
+狂暴 75
+狂欢节 75
+狂风暴雨 75
+独岛 75
+狸 75
+王罕 75
+玳瑁 75
+珍视 75
+用武之地 75
+甲等 75
+男女老幼 75
+瘘 75
+登岸 75
+白鹿仓 75
+皥 75
+盐碱地 75
+直直地 75
+相知 75
+省人大 75
+省部级 75
+真皮 75
+眼病 75
+磨蹭 75
+社科院 75
+秋雨 75
+秦二世 75
+积存 75
+窣 75
+窥伺 75
+章太炎 75
+第九次 75
+筹款 75
+簪子 75
+粦 75
+纂修 75
+红瓦店 75
+红酒 75
+纱线 75
+细弱 75
+结膜 75
+翻译家 75
+耗时 75
+耸人听闻 75
+职衔 75
+聚变 75
+腓特烈 75
+腰椎 75
+腾云驾雾 75
+膝头 75
+臭虫 75
+艰苦卓绝 75
+节令 75
+花园里 75
+花圈 75
+花枝 75
+芾 75
+苗大侠 75
+苜蓿 75
+范骅 75
+萜 75
+蓝筹 75
+蔑 75
+蚂蚱 75
+蛘 75
+蛰 75
+表演艺术家 75
+见谅 75
+言简意赅 75
+计算机病毒 75
+订阅 75
+论丛 75
+语言学家 75
+说辞 75
+诿 75
+败兵 75
+贲 75
+赵文华 75
+赶尽杀绝 75
+越剧 75
+越轨 75
+趔趄 75
+身外 75
+躺倒 75
+转瞬间 75
+轻手轻脚 75
+辟谣 75
+近战 75
+逃奔 75
+逃逸 75
+选美 75
+通读 75
+道统 75
+遗像 75
+金田 75
+钢琴曲 75
+铵 75
+长波 75
+长鞭 75
+长颈鹿 75
+门铃 75
+阴沉沉 75
+阵列 75
+阵雨 75
+阿兰 75
+附加费 75
+陈应凤 75
+陪葬品 75
+隐晦 75
+雅尔塔 75
+震源 75
+青松 75
+靖国神社 75
+静观 75
+面包车 75
+韵母 75
+顺义 75
+顾大嫂 75
+颅内 75
+领奖台 75
+颤栗 75
+风雷 75
+飞瀑 75
+食管癌 75
+馑 75
+驯服 75
+骤雨 75
+高温多雨 75
+高热 75
+高者 75
+高起潜 75
+高通公司 75
+鲁王 75
+鸳 75
+黄巾 75
+黑奴 75
+黑潮 75
+鼠疫 75
+齐心合力 75
+一折 74
+三叠纪 74
+三餐 74
+下注 74
+不容乐观 74
+中央人民广播电台 74
+中间件 74
+丸子 74
+丹顶鹤 74
+主顾 74
+乌迪内 74
+乡农 74
+二手 74
+二百年 74
+五千两 74
+五台 74
+交行 74
+亲疏 74
+人烟稀少 74
+仁智 74
+佛罗里达州 74
+侦察员 74
+便携 74
+保单 74
+保留地 74
+倒立 74
+兆赫 74
+克己复礼 74
+共商 74
+兴味 74
+内罗毕 74
+再进一步 74
+军威 74
+冲浪 74
+出头之日 74
+刀片 74
+分得 74
+切沃 74
+初九 74
+前妻 74
+加班费 74
+北亚 74
+医道 74
+十位 74
+十数个 74
+千奇百怪 74
+半衰期 74
+华纳 74
+卖地 74
+卖身 74
+卢普斯 74
+历书 74
+历历在目 74
+历年来 74
+厝 74
+原料药 74
+双键 74
+反右 74
+受惠 74
+古时候 74
+古生物学 74
+古音 74
+古风 74
+可歌可泣 74
+台后 74
+史湘云 74
+史进 74
+叶县 74
+叽叽 74
+各组 74
+合作医疗 74
+同班 74
+吟诵 74
+吴祥 74
+吵醒 74
+咯噔 74
+哀叹 74
+哺乳期 74
+售货员 74
+啥子 74
+喟 74
+回手 74
+围岩 74
+土崩瓦解 74
+地面站 74
+场边 74
+坏分子 74
+士林 74
+士民 74
+外宾 74
+外遇 74
+大事记 74
+大气圈 74
+大牙 74
+奔涌 74
+好家伙 74
+婀娜 74
+婶娘 74
+嫖娼 74
+安东 74
+定单 74
+定形 74
+宝山 74
+客观性 74
+宣宗 74
+宽泛 74
+寂寥 74
+寻人 74
+导电性 74
+小火 74
+小眼 74
+小菜 74
+山梁 74
+崇拜者 74
+巡逻艇 74
+巿 74
+布政司 74
+布道 74
+干群 74
+建武 74
+开闸 74
+张凯 74
+张氏 74
+当街 74
+影影绰绰 74
+忱 74
+恼羞成怒 74
+成千成万 74
+手工艺 74
+打字 74
+找矿 74
+抢白 74
+披衣 74
+抽筋 74
+拉夫 74
+接引 74
+推土机 74
+推让 74
+推陈出新 74
+操控 74
+敌台 74
+救世主 74
+散装 74
+敾 74
+文静 74
+施救 74
+旷日持久 74
+昆仑山脉 74
+明晚 74
+春色 74
+景况 74
+暗影 74
+暴风骤雨 74
+月牙 74
+朝夕相处 74
+本土化 74
+朱成矩 74
+杂乱无章 74
+李鬼 74
+杜撰 74
+枝桠 74
+枩 74
+枯水 74
+柔性 74
+柴云振 74
+梅庄 74
+樊瑞 74
+欺矇 74
+欺蒙 74
+歌儿 74
+死不瞑目 74
+死对头 74
+毒打 74
+氡 74
+汝州 74
+汤夫人 74
+油井 74
+泡泡 74
+洮 74
+深有感触 74
+清贫 74
+漏掉 74
+潧 74
+火鸡 74
+灯具 74
+烙饼 74
+烟瘾 74
+烧光 74
+热辐射 74
+爷儿俩 74
+特种兵 74
+犬齿 74
+犯愁 74
+王世贞 74
+瓦德西 74
+瓶塞 74
+甭管 74
+田庄 74
+白种人 74
+皎洁 74
+直爽 74
+相似性 74
+相距甚远 74
+砖块 74
+破空 74
+碲 74
+磾 74
+示人 74
+祗 74
+禁食 74
+秃子 74
+科委 74
+科考 74
+科龙电器 74
+穿甲 74
+等效 74
+粘着 74
+糖精钠 74
+纠结 74
+纫 74
+纬线 74
+绝症 74
+缫 74
+缴械 74
+罗嗦 74
+老爷爷 74
+耸动 74
+耿万钟 74
+肌瘤 74
+育人 74
+自备 74
+航空线 74
+花鼓 74
+茶业 74
+茶盅 74
+莫尔 74
+营业税 74
+蒲扇 74
+薮 74
+衲 74
+西南夷 74
+角速度 74
+诒 74
+试演 74
+诗选 74
+诚惶诚恐 74
+调笑 74
+贡赋 74
+货币化 74
+赤贫 74
+超大 74
+越野车 74
+趱 74
+足尖 74
+趴下 74
+踏板 74
+蹴 74
+身孕 74
+车顶 74
+转送 74
+输油 74
+输注 74
+迫降 74
+退伍军人 74
+那阵子 74
+邵佳一 74
+酬劳 74
+采邑 74
+量子化 74
+金光闪闪 74
+铁棍 74
+长谈 74
+门巴 74
+闲心 74
+阡 74
+防控 74
+防盗 74
+阴极射线 74
+阿古柏 74
+阿特拉斯 74
+降下 74
+隐喻 74
+隐隐作痛 74
+雨天 74
+雨林 74
+零配件 74
+面见 74
+顅 74
+顾盼 74
+风云人物 74
+飘散 74
+香河 74
+香烛 74
+駇 74
+魏源 74
+魔芋 74
+黄帝内经 74
+齐刷刷 74
+龌龊 74
+一两次 73
+一转眼 73
+一重 73
+万余元 73
+万多公顷 73
+三千两 73
+上元 73
+上工 73
+上百 73
+下咽 73
+下品 73
+不巧 73
+丧父 73
+中华网 73
+中长 73
+乐音 73
+乘虚而入 73
+书童 73
+二十二日 73
+二手房 73
+二次大战 73
+五倍 73
+仁川 73
+代称 73
+任选 73
+会商 73
+位数 73
+何须 73
+作息 73
+使君 73
+保安团 73
+保护地 73
+倒影 73
+做媒 73
+充塞 73
+先机 73
+光敏 73
+六神无主 73
+兴化 73
+兵站 73
+冗长 73
+减半 73
+几匹 73
+出阁 73
+分光 73
+分水 73
+判例 73
+制片人 73
+动因 73
+化为乌有 73
+医药费 73
+十几米 73
+十步 73
+华商 73
+单宁 73
+单枪匹马 73
+南方人 73
+双侠 73
+双钩 73
+受审 73
+受邀 73
+吉特 73
+同族 73
+名句 73
+名画 73
+吓跑 73
+听讲 73
+呐喊声 73
+周而复始 73
+周转量 73
+咨文 73
+唐寅 73
+商榷 73
+四季度 73
+固定式 73
+地下铁道 73
+地对空 73
+增稠剂 73
+处治 73
+复活节 73
+夙 73
+多产 73
+多发性 73
+夜袭 73
+大龙 73
+天义叔 73
+奶酪 73
+如出一辙 73
+妄动 73
+媸 73
+字字 73
+存在主义 73
+孙安 73
+孚 73
+孟津 73
+守信 73
+安分守己 73
+安眠药 73
+定做 73
+宛若 73
+宣王 73
+寄生虫病 73
+居心 73
+山道人 73
+山阴 73
+岁入 73
+岩床 73
+嵊 73
+工期 73
+左旋 73
+带笑 73
+平安里 73
+年产值 73
+年复一年 73
+年数 73
+年期 73
+年轮 73
+底数 73
+底薪 73
+府县 73
+异心 73
+微动 73
+微细 73
+德比 73
+心照不宣 73
+心音 73
+忠良 73
+总后 73
+恫吓 73
+惜春 73
+惦 73
+戏文 73
+戙 73
+手掌心 73
+抛物线 73
+抱憾 73
+抱抱 73
+抵京 73
+拉达 73
+招商引资 73
+拳道 73
+持有人 73
+挂怀 73
+捕鲸 73
+据为己有 73
+排便 73
+掘进 73
+摩登 73
+数不胜数 73
+文学界 73
+旅行团 73
+无言以对 73
+旧情 73
+显要 73
+普及率 73
+晴空 73
+有源 73
+朔方 73
+本地化 73
+机箱 73
+李刚 73
+李善长 73
+李密 73
+杭得放 73
+柴大官人 73
+根毛 73
+格力 73
+桥栏杆 73
+梭子 73
+检视 73
+殖民军 73
+毒刺 73
+毒酒 73
+毙伤 73
+毫不留情 73
+民族所 73
+气滞 73
+气量 73
+水帘洞 73
+水獭 73
+求证 73
+江南地区 73
+汽油机 73
+沈绿 73
+沸石 73
+泄洪 73
+泼妇 73
+派息 73
+淋巴管 73
+淡红 73
+湖东 73
+热身 73
+照壁 73
+燕郊 73
+牛黄 73
+特设 73
+狂言 73
+猛打 73
+猿人 73
+理气 73
+琉璃厂 73
+瓢虫 73
+甆 73
+甘州 73
+生分 73
+电话会议 73
+瘉 73
+白涛 73
+白蜡 73
+百亩 73
+盆腔 73
+盘点 73
+盛典 73
+相形之下 73
+省力 73
+眉开眼笑 73
+真才实学 73
+眼镜蛇 73
+石凳 73
+砂砾 73
+神魂颠倒 73
+禁烟 73
+秋后 73
+科场 73
+科尔多凡 73
+税后 73
+穆易 73
+穴居 73
+空空如也 73
+突发性 73
+竹笋 73
+第一招 73
+箔 73
+篓 73
+粘合 73
+綝 73
+红外光 73
+纣王 73
+组曲 73
+网路 73
+罗文 73
+罗炳辉 73
+罗进 73
+美名 73
+美国哈佛大学 73
+老奶奶 73
+老龄化 73
+耐旱 73
+肉麻 73
+胆寒 73
+背道而驰 73
+脱氧 73
+自主性 73
+自在 73
+船运 73
+芄 73
+芒硝 73
+苍松 73
+茶话会 73
+蔡惟 73
+虬 73
+蚬 73
+血渍 73
+行头 73
+裤腿 73
+裤裆 73
+覆辙 73
+譬喻 73
+计无施 73
+论调 73
+试纸 73
+课税 73
+调运 73
+谶纬 73
+豁然开朗 73
+豪富 73
+购房者 73
+赞助商 73
+赵国 73
+起跑线 73
+身患 73
+车驾 73
+轮值 73
+软件系统 73
+辐合 73
+辛弃疾 73
+辛格 73
+进餐 73
+违法乱纪 73
+连坐 73
+追回 73
+退票 73
+逃之夭夭 73
+逃兵 73
+逃税 73
+透不过气 73
+通宝 73
+逞强 73
+遐想 73
+遗风 73
+那样的话 73
+鄂尔多斯 73
+重塑 73
+金库 73
+金秀 73
+锡伯 73
+锡兰 73
+镯 73
+问心无愧 73
+陆健 73
+隆福寺 73
+雌雄同株 73
+雍容 73
+霍克 73
+青烟 73
+静力学 73
+颅骨 73
+风霜 73
+马超兴 73
+驳船 73
+高尔夫球场 73
+魔术师 73
+鹿特丹 73
+黑市 73
+黑麦 73
+一传 72
+一意孤行 72
+万达 72
+三五个 72
+三分钟 72
+三十四年 72
+三十日 72
+不自量力 72
+世外桃源 72
+东乡 72
+东京城 72
+东区 72
+东航 72
+丝织业 72
+丧服 72
+中华全国总工会 72
+中老年 72
+乙级 72
+交相辉映 72
+京津 72
+亲娘 72
+从命 72
+从早到晚 72
+伯利 72
+作陪 72
+佤族 72
+偷窃 72
+傲气 72
+元狩 72
+充分发挥 72
+八天 72
+八百里 72
+养尊处优 72
+军礼 72
+冦 72
+冰月 72
+冲口而出 72
+出庭 72
+勤俭 72
+勤工助学 72
+医学界 72
+华山论剑 72
+原位 72
+参政议政 72
+发问 72
+受得了 72
+古井 72
+台胞 72
+同盟军 72
+向善 72
+吕二 72
+吹拂 72
+周初 72
+商洛 72
+嗜睡 72
+嘬 72
+国家药监局 72
+土改运动 72
+地牢 72
+坐视 72
+垂头 72
+埛 72
+基体 72
+基性岩 72
+基板 72
+塑胶 72
+塴 72
+外侮 72
+夙愿 72
+大枣 72
+大都市 72
+大青山 72
+天兵 72
+天幸 72
+天旋地转 72
+夫差 72
+失火 72
+头破血流 72
+妹夫 72
+委以 72
+姚平仲 72
+威仪 72
+孤僻 72
+学系 72
+宁波市 72
+安德烈 72
+官田 72
+官阶 72
+对垒 72
+对抗赛 72
+对症下药 72
+导演奖 72
+封杀 72
+封王 72
+尤里 72
+山冈 72
+崭露头角 72
+工商联 72
+左思右想 72
+左邻右舍 72
+巴尔的摩 72
+巾帼 72
+干涩 72
+幽谷 72
+庙前 72
+庙坪 72
+府尹 72
+庞杂 72
+废料 72
+廉洁自律 72
+廓尔喀 72
+开疆拓土 72
+开走 72
+弓弩手 72
+张可旺 72
+张敬轩 72
+张文明 72
+征购 72
+得奖 72
+徽章 72
+心灰意冷 72
+恢宏 72
+恶梦 72
+悬赏 72
+情思 72
+惊世骇俗 72
+成佛 72
+成百 72
+战无不胜 72
+截流 72
+扑朔迷离 72
+折辱 72
+拍卖行 72
+招招手 72
+拜望 72
+拥军 72
+数下 72
+整部 72
+斯巴达克 72
+方巾 72
+日本队 72
+昭雪 72
+末尾 72
+李学勤 72
+李斯 72
+板岩 72
+枬 72
+柴达木 72
+榜文 72
+正巧 72
+正酣 72
+武夫 72
+毡帽 72
+民乐 72
+民意测验 72
+水务 72
+水墨画 72
+汉学家 72
+汉语拼音 72
+汴州 72
+沁源县 72
+油门 72
+泗水 72
+波束 72
+浓眉 72
+海基 72
+淘金 72
+混迹 72
+溶蚀 72
+滩头 72
+火圈 72
+炉头 72
+炮仗 72
+点破 72
+烟幕 72
+牙缝 72
+牛书道 72
+特等奖 72
+狈 72
+玄铁剑 72
+王导 72
+王燮 72
+王老 72
+瓦努阿图 72
+生命线 72
+生死攸关 72
+生长素 72
+畅销书 72
+白头 72
+益气 72
+相中 72
+看齐 72
+睡衣 72
+硬碰硬 72
+碍于 72
+碗口 72
+碾盘 72
+离解 72
+离骚 72
+秦刚 72
+稿纸 72
+空无一人 72
+笑容可掬 72
+管住 72
+篱 72
+纠察队 72
+红塔 72
+红杉 72
+细胞学 72
+绝对值 72
+绝热 72
+缣 72
+翁波意 72
+翩翩 72
+老城区 72
+肝火 72
+脱光 72
+脱胎 72
+腹背受敌 72
+自动机 72
+艾伦 72
+芨 72
+花销 72
+苏德 72
+苗木 72
+英气 72
+草寇 72
+莅 72
+莫里斯 72
+莽莽 72
+萨特 72
+蓄积 72
+蕙 72
+虚数 72
+虮 72
+蚓 72
+行政官员 72
+衣料 72
+袖箭 72
+被单 72
+西人 72
+观赏性 72
+视点 72
+解热 72
+该种 72
+谢幕 72
+豪绅 72
+豫剧 72
+负数 72
+财大气粗 72
+败阵 72
+轮渡 72
+轰鸣声 72
+轴心国 72
+辣子 72
+达玛拉 72
+过冬 72
+运筹学 72
+进货 72
+选材 72
+逗笑 72
+通用电气 72
+通货膨胀率 72
+造型艺术 72
+郑崇俭 72
+都御史 72
+金杯 72
+铁饭碗 72
+银丝 72
+镟 72
+长条 72
+闻言 72
+阿妈妮 72
+阿泰斯 72
+陈夫人 72
+陈奎 72
+陡峻 72
+雪豹 72
+霍尔木兹海峡 72
+露营 72
+静养 72
+頫 72
+顾苓 72
+颔 72
+风起云涌 72
+飞红 72
+饽饽 72
+馆内 72
+馆陶 72
+首日 72
+马援 72
+骁将 72
+高分辨率 72
+高利贷者 72
+高发 72
+鲁达 72
+鹰犬 72
+麻贵 72
+鼓足 72
+鼻翼 72
+一刻起 71
+一字一句 71
+一汽 71
+一览 71
+一针见血 71
+七律 71
+上位 71
+上海浦东 71
+专家系统 71
+世界史 71
+世行 71
+东德 71
+两伊 71
+中军帐 71
+中国共产主义青年团 71
+中央美术学院 71
+中盘 71
+中纪委 71
+中联 71
+中距离 71
+久居 71
+二十四日 71
+二十多个 71
+二氧化硅 71
+五言 71
+亚硝胺 71
+交战国 71
+享年 71
+人声鼎沸 71
+人居 71
+人类学家 71
+人身自由 71
+代议制 71
+仰天大笑 71
+价格比 71
+伦常 71
+伪证 71
+便民 71
+保守主义 71
+倍受 71
+偏殿 71
+做活 71
+儫 71
+先贤 71
+入席 71
+全名 71
+公信力 71
+兴师动众 71
+其妻 71
+兼容性 71
+冷兵器 71
+准予 71
+几周 71
+几百米 71
+凭吊 71
+刊刻 71
+列阵 71
+刘福通 71
+刘表 71
+刘贵妃 71
+初涉 71
+到校 71
+制造者 71
+前置 71
+剩饭 71
+劈开 71
+包乾 71
+包干 71
+化学变化 71
+化学式 71
+北京市旅游局 71
+北庭 71
+区里 71
+医患 71
+千疮百孔 71
+华工 71
+南京路 71
+历数 71
+友协 71
+双休日 71
+反制 71
+古语 71
+可怜巴巴 71
+可视 71
+合身 71
+含水 71
+吴军 71
+吵吵闹闹 71
+呱呱 71
+哇啦 71
+哨探 71
+唴 71
+喜乐 71
+嘉年华 71
+四类 71
+四邻 71
+团团转 71
+图尔 71
+图解 71
+土地庙 71
+土生土长 71
+地支 71
+地瓜 71
+坚挺 71
+坳 71
+声气 71
+外患 71
+外甥女 71
+多亿 71
+多倍 71
+大堡礁 71
+大大方方 71
+大宛 71
+大干 71
+大显身手 71
+大熊 71
+天方夜谭 71
+奶娘 71
+娜拉 71
+婆罗 71
+婺源 71
+孙武 71
+孟达 71
+季雨林 71
+学人 71
+宏远 71
+官能团 71
+定则 71
+宝珠 71
+实业家 71
+实弹射击 71
+密商 71
+寒毛 71
+对光 71
+对冲 71
+对抗性 71
+对系统 71
+小博尔 71
+屋宇 71
+山穷水尽 71
+峰会 71
+崂山 71
+川北 71
+师尊 71
+帑 71
+帝国主义者 71
+库恩 71
+引力场 71
+张铁男 71
+弯子 71
+当权派 71
+很多很多 71
+德尔 71
+德川 71
+思想界 71
+急诊 71
+总目 71
+恳请 71
+意犹未尽 71
+慵 71
+戍边 71
+成果奖 71
+戴尔公司 71
+打喷嚏 71
+执迷不悟 71
+扫雷器 71
+投机商 71
+投票系统 71
+披肩 71
+拉诺 71
+拜天地 71
+挚友 71
+捕风捉影 71
+掩蔽 71
+揭破 71
+攫 71
+支使 71
+收纳 71
+敌楼 71
+文苑 71
+斗智 71
+斜阳 71
+斩草除根 71
+新年伊始 71
+新生事物 71
+施特劳斯 71
+无情无义 71
+日月星辰 71
+时兴 71
+昞 71
+晕头转向 71
+普林斯顿 71
+曾随 71
+月夜 71
+望尘莫及 71
+朝气 71
+朱丽叶 71
+杀生 71
+李妈 71
+李煜 71
+李王 71
+杨靖宇 71
+梩 71
+模态 71
+欠债 71
+欲求 71
+止痛药 71
+水旱 71
+污浊 71
+污辱 71
+沈钧儒 71
+沙暴 71
+油区 71
+波导 71
+洪福 71
+海洋法 71
+混成 71
+温仪 71
+温庭 71
+温格 71
+游迅 71
+满目 71
+漏水 71
+炎炎 71
+炭疽 71
+热腾腾 71
+爪哇岛 71
+牢靠 71
+特有种 71
+狂呼 71
+狗官 71
+狼狗 71
+猎狗 71
+王仁 71
+玻璃纤维 71
+甲状旁腺 71
+疽 71
+登记表 71
+白令 71
+白龟 71
+百思不得其解 71
+监工 71
+盗取 71
+相与 71
+相对湿度 71
+矢石 71
+碘缺乏病 71
+磱 71
+社会心理学 71
+祇 71
+私奔 71
+科夫 71
+空间站 71
+窝藏 71
+竹篙 71
+第二盘 71
+第二项 71
+第十二届 71
+第十四章 71
+简牍 71
+箩筐 71
+簋 71
+粗疏 71
+精要 71
+红豆杉 71
+纰漏 71
+纸业 71
+细细地 71
+维尔士 71
+缝补 71
+罗什 71
+群星 71
+翻版 71
+耕牛 71
+联合公报 71
+联袂 71
+肝脑涂地 71
+腋窝 71
+膜拜 71
+致病菌 71
+舒心 71
+花斑 71
+苏共中央 71
+英国皇家海军 71
+草创 71
+荒漠化 71
+药学 71
+菀 71
+菁 71
+萨拉 71
+萨满教 71
+蛮夷 71
+蠊 71
+衣帽 71
+袭扰 71
+西军 71
+西游 71
+计费 71
+议论文 71
+读数 71
+谈笑风生 71
+谘 71
+豆腐块 71
+象形 71
+负债表 71
+财会 71
+账户卡 71
+贾德 71
+走掉 71
+超现实主义 71
+超高压 71
+跃升 71
+跌破 71
+输运 71
+违宪 71
+退步 71
+透风 71
+速射 71
+道格拉斯 71
+醴 71
+里克 71
+里斯 71
+重力场 71
+量刑 71
+錝 71
+针头 71
+钢轨 71
+铁路网 71
+铭刻 71
+银矿 71
+锁链 71
+门厅 71
+阿巴拉契亚 71
+隧 71
+隧洞 71
+集子 71
+雍州 71
+雨子 71
+雨雪 71
+雪片 71
+雷震 71
+霄 71
+露骨 71
+鞭挞 71
+鞭笞 71
+韩城 71
+音程 71
+项目管理 71
+领有 71
+颈项 71
+频带 71
+风土 71
+风尘仆仆 71
+飙 71
+飞抵 71
+马尔萨斯 71
+骶 71
+高升 71
+高彦超 71
+魂灵 71
+鲁能泰山 71
+黑色素 71
+黑龙潭 71
+鼓角 71
+龋齿 71
+一个头 70
+丁基 70
+万般无奈 70
+三三两两 70
+三清 70
+上海交大 70
+上海地区 70
+上钩 70
+下野 70
+不可估量 70
+不可逾越 70
+东华 70
+两斤 70
+两朵 70
+丧气 70
+中东战争 70
+中国国民党革命委员会 70
+中轴 70
+义女 70
+二百两 70
+亓 70
+五元 70
+五六年 70
+五城 70
+京东 70
+亲本 70
+仁寿 70
+作客 70
+佳木斯 70
+俦 70
+健忘 70
+偷渡 70
+先遣 70
+克利斯 70
+全职 70
+全镇 70
+公干 70
+公所 70
+兴业 70
+兴旺发达 70
+兹曼 70
+冒险家 70
+农活 70
+冤仇 70
+凉快 70
+几页 70
+分科 70
+刊印 70
+刘伯温 70
+制出 70
+助推器 70
+劳什子 70
+勇往直前 70
+包衣 70
+十字街头 70
+升息 70
+单名 70
+南宁市 70
+博尔顿 70
+卡斯特罗 70
+印行 70
+厔 70
+双刃剑 70
+变频 70
+史地 70
+各向异性 70
+合议 70
+吉奥克斯 70
+吉鸿昌 70
+后置 70
+后脚 70
+含混 70
+含碳量 70
+吴襄 70
+呆坐 70
+哈马斯 70
+哨声 70
+唱词 70
+唾骂 70
+啄木鸟 70
+商户 70
+善本 70
+善终 70
+喉部 70
+嘎嘎 70
+嘶鸣 70
+噎住 70
+四十七年 70
+四环素 70
+圆木 70
+地震波 70
+坐椅 70
+块茎 70
+塔尔 70
+塞北 70
+填词 70
+复姓 70
+多场 70
+大理岩 70
+大统 70
+大雪山 70
+女娲 70
+女尼 70
+妯 70
+始发 70
+始末 70
+嫉恨 70
+孱弱 70
+安全带 70
+定势 70
+定稿 70
+宝二爷 70
+实绩 70
+实验场 70
+宣慰司 70
+密报 70
+寸步不离 70
+小兴安岭 70
+小强 70
+尔兹 70
+尻 70
+展区 70
+岩缝 70
+崤 70
+巍然 70
+师专 70
+师姐 70
+帕尔玛 70
+帝君 70
+延寿 70
+开拓进取 70
+开源 70
+异香 70
+张阿生 70
+弩箭 70
+归心 70
+彩灯 70
+往届 70
+征地 70
+徵地 70
+总揽 70
+总站 70
+懒腰 70
+战报 70
+所剩无几 70
+手笔 70
+手记 70
+手迹 70
+扯淡 70
+扼要 70
+抖擞精神 70
+拉合尔 70
+拖曳 70
+拜别 70
+拳打脚踢 70
+挂断 70
+挑逗 70
+挖坑 70
+挺胸 70
+据实 70
+搅动 70
+搞乱 70
+摇滚乐 70
+撩开 70
+操作符 70
+擽 70
+政府军 70
+政纲 70
+救生艇 70
+整人 70
+斑马 70
+斯特拉斯堡 70
+新文学 70
+暗礁 70
+暗红 70
+暗语 70
+曝 70
+曹真 70
+有生以来 70
+李德 70
+李琳 70
+杏子 70
+杏黄 70
+杨氏 70
+校场 70
+桑飞虹 70
+桔梗 70
+植物学家 70
+楼头 70
+樟脑 70
+歌喉 70
+正电子 70
+段公子 70
+气数 70
+气象台 70
+水地 70
+汕 70
+没错 70
+油光 70
+注射剂 70
+洗胃 70
+洛克菲勒 70
+洛川 70
+海沙 70
+润滑剂 70
+淡蓝 70
+深蓝色 70
+溜达 70
+满天飞 70
+潢 70
+濮阳 70
+热诚 70
+燃烧弹 70
+爱丽丝 70
+狄德罗 70
+独眼龙 70
+猪油 70
+生长期 70
+甲胄 70
+电传 70
+电影剧本 70
+电路板 70
+疏于 70
+百慕大 70
+监考 70
+盒盖 70
+直布罗陀 70
+破破烂烂 70
+硝基 70
+碧眼 70
+礩 70
+神交 70
+神器 70
+神户 70
+神经外科 70
+祷祝 70
+离席 70
+秋山 70
+秦钟 70
+窗缝 70
+竞渡 70
+第一所 70
+筋脉 70
+筑路 70
+筛子 70
+管理中心 70
+管理法 70
+粮票 70
+粷 70
+糖分 70
+系统分析 70
+纪念堂 70
+经手 70
+缆绳 70
+署长 70
+老干部 70
+聊天室 70
+聚乙烯 70
+聚居地 70
+聚酯 70
+肇庆 70
+肖邦 70
+肮 70
+胚胎学 70
+胶合板 70
+腰杆 70
+腾挪 70
+自不必说 70
+自傲 70
+自强不息 70
+自由电子 70
+自绝 70
+自荐 70
+艰难困苦 70
+芏 70
+芯片组 70
+芹 70
+苏州人 70
+若是 70
+英格兰队 70
+茅庐 70
+茏 70
+茶文化 70
+草包 70
+蓄水池 70
+蓝领 70
+薄层 70
+蚝 70
+蜒 70
+裁撤 70
+装订 70
+西柏林 70
+西藏地区 70
+见鬼 70
+解渴 70
+许显纯 70
+论理 70
+识相 70
+语塞 70
+说破 70
+诸子百家 70
+赈灾 70
+走马灯 70
+跑马 70
+跨步 70
+路况 70
+跸 70
+车行 70
+软泥 70
+软语 70
+辙 70
+辱没 70
+运城 70
+返国 70
+这下子 70
+通信网 70
+造次 70
+郭晶晶 70
+重压 70
+金光亮 70
+钱学森 70
+铁心 70
+铜鼓 70
+铲子 70
+长女 70
+长臂 70
+阌 70
+防癌 70
+阳刚 70
+阳春 70
+陆乘风 70
+随员 70
+随访 70
+雨滴 70
+雪上加霜 70
+露脸 70
+青天白日 70
+青楼 70
+静寂 70
+韦大人 70
+音质 70
+领航 70
+风风火火 70
+饫 70
+香香 70
+马丁内斯 70
+马兰峪 70
+骄人 70
+骨针 70
+高论 70
+高难度 70
+魏军 70
+鲜亮 70
+鸮 70
+鹆 70
+龌 70
+一蹴而就 69
+七七事变 69
+七夜 69
+七绝 69
+三十多个 69
+不省人事 69
+不道德 69
+世界报 69
+东路军 69
+两双 69
+两瓶 69
+两税 69
+个旧 69
+中华人民共和国政府 69
+中老年人 69
+丰功伟绩 69
+主人翁 69
+乃蛮 69
+乌老大 69
+乐歌 69
+乐毅 69
+乘胜追击 69
+九层 69
+九溪 69
+二三百 69
+二十九日 69
+亲耳 69
+人工呼吸 69
+仙鹤 69
+令牌 69
+侥 69
+俊雅 69
+俗人 69
+保护性 69
+保险金 69
+倒卖 69
+倒毙 69
+倝 69
+偷情 69
+全资 69
+六七个 69
+关安基 69
+冒火 69
+冰晶 69
+几百里 69
+几辆 69
+出人命 69
+划拨 69
+刘曜 69
+创纪录 69
+刻下 69
+前瞻性 69
+剳 69
+包工头 69
+北京猿人 69
+北道 69
+医疗费 69
+南京长江二桥 69
+卧病 69
+卫青 69
+压死 69
+参考系 69
+叉手 69
+反过来说 69
+叚 69
+古庙 69
+可耕地 69
+可读性 69
+各行其是 69
+名闻 69
+吴兵 69
+吴哥 69
+吸血鬼 69
+喷涌 69
+四十四年 69
+四十多年 69
+国家经贸委 69
+圣安东尼奥 69
+坚忍 69
+垙 69
+埋入 69
+埔 69
+基础科学 69
+塞纳河 69
+墿 69
+复检 69
+多姆 69
+大厂 69
+大合唱 69
+大褂 69
+大襟 69
+好样 69
+妻女 69
+婚俗 69
+嬉皮笑脸 69
+孙儿 69
+宁死不屈 69
+守护神 69
+宗主国 69
+富士山 69
+导语 69
+小契 69
+小规模 69
+尿布 69
+屋梁 69
+屯垦 69
+岌岌可危 69
+崡 69
+嵇康 69
+工分 69
+工商户 69
+工矿业 69
+巴方 69
+常压 69
+平常心 69
+幽门 69
+广汉 69
+张家港 69
+张迪 69
+归因于 69
+归天 69
+录像带 69
+影星 69
+忘怀 69
+悲从中来 69
+慈和 69
+慰劳 69
+成昆 69
+战例 69
+战神 69
+房前 69
+打岔 69
+托利亚 69
+扬长避短 69
+抽射 69
+拓片 69
+拔高 69
+拱桥 69
+挂号 69
+挑动 69
+挥洒 69
+振华 69
+探头探脑 69
+探问 69
+推入 69
+提示性 69
+揪心 69
+收支平衡 69
+改良派 69
+攻掠 69
+敛财 69
+散散心 69
+数千里 69
+数术 69
+斯图亚 69
+新泽西州 69
+方鲁 69
+日经 69
+暗流 69
+暴跌 69
+有喜 69
+有过之而无不及 69
+朠 69
+李先生 69
+束手 69
+杨宇飞 69
+林荫 69
+查点 69
+柯尔克孜 69
+柱石 69
+柳燕 69
+树影 69
+树身 69
+桑吉卓 69
+桠 69
+棉裤 69
+楔形 69
+武器库 69
+残兵 69
+残缺不全 69
+段氏 69
+每亩 69
+民间舞 69
+气象卫星 69
+氘 69
+水圈 69
+水螅 69
+永别 69
+汉室 69
+汤尤杯 69
+沏茶 69
+沾沾自喜 69
+泳装 69
+流形 69
+海狮 69
+海禁 69
+海难 69
+混血 69
+清乡 69
+溃逃 69
+潥 69
+潮汕 69
+潮红 69
+灰黑色 69
+烂摊子 69
+燕云 69
+爱妾 69
+爱恋 69
+狠命 69
+玄武门 69
+玉玑子 69
+玉玺 69
+玩偶 69
+珍异 69
+琴谱 69
+璀 69
+瓦房 69
+瓦窑堡会议 69
+用场 69
+用料 69
+田汉 69
+病体 69
+痧 69
+白糖 69
+皮袄 69
+盈亏 69
+盲目性 69
+看门 69
+短片 69
+短裙 69
+短路 69
+石灰水 69
+石砌 69
+砇 69
+硬骨头 69
+磁通 69
+祭拜 69
+祸国殃民 69
+禁绝 69
+禳 69
+笏 69
+第一点 69
+第四名 69
+第四条 69
+粘附 69
+精纺 69
+糟粕 69
+緟 69
+经办 69
+绚烂 69
+绣球 69
+缺阵 69
+美容院 69
+美政府 69
+美玉 69
+羽毛球队 69
+老生 69
+老鸨 69
+考察队 69
+耿直 69
+聋子 69
+联防 69
+肯干 69
+胸骨 69
+航船 69
+芦荟 69
+花式 69
+草叶 69
+菊科 69
+薛鹊 69
+虁 69
+蝮蛇 69
+蠖 69
+表字 69
+袅 69
+袍服 69
+见称 69
+规劝 69
+规定性 69
+讶异 69
+该社 69
+详解 69
+诸公 69
+诺维茨基 69
+读经 69
+课以 69
+谷类 69
+货轮 69
+贫贱 69
+购汇 69
+赞比西河 69
+越南战争 69
+身子骨 69
+车轴 69
+转角 69
+轻型坦克 69
+达斡尔 69
+递进 69
+道门 69
+遗传病 69
+遵化 69
+酿制 69
+重责 69
+金德队 69
+铁岭 69
+长距离 69
+长顺 69
+长驱直入 69
+门齿 69
+防晒霜 69
+阳历 69
+阿尔法 69
+附耳 69
+陈剀 69
+雪水 69
+霍夫曼 69
+非暴力 69
+顶用 69
+预告 69
+领导力 69
+食谱 69
+饭食 69
+香槟酒 69
+马马虎虎 69
+马驰 69
+骨董 69
+高能物理 69
+高阳 69
+鲍大楚 69
+鲫鱼 69
+鹑 69
+麻木不仁 69
+黑龙 69
+默念 69
+龊 69
+龙卷风 69
+龙江 69
+一两句 68
+一千年 68
+一言一行 68
+一长制 68
+万件 68
+万恶 68
+万金 68
+上下级 68
+上半身 68
+上推 68
+下士 68
+不怕牺牲 68
+不无关系 68
+不能自己 68
+丛刊 68
+中卫 68
+中国文联 68
+中央处理器 68
+中巴 68
+主页 68
+九泉之下 68
+乳癌 68
+争光 68
+二丈 68
+二千两 68
+二嫂 68
+二把手 68
+亢龙有悔 68
+人工授精 68
+亿多 68
+伏牛 68
+作协 68
+作弄 68
+俗务 68
+信访 68
+借入 68
+借款人 68
+倾向性 68
+做伴 68
+傅全有 68
+元勋 68
+六甲 68
+冈比亚 68
+军属 68
+准将 68
+刘晓波 68
+初七 68
+副手 68
+加拉加斯 68
+加点 68
+包月 68
+医学会 68
+十全十美 68
+十卷 68
+华东地区 68
+华特尔 68
+卖命 68
+博物 68
+占优 68
+即墨 68
+厮混 68
+双汇 68
+反潜机 68
+反观 68
+发胖 68
+叕 68
+变坏 68
+变幻莫测 68
+口授 68
+叶家 68
+叶腋 68
+合规 68
+同桌 68
+同谋 68
+君士坦丁 68
+启航 68
+吴广 68
+告捷 68
+哈尼 68
+唐武德 68
+嗔怪 68
+嗲 68
+回眸 68
+团部 68
+国体 68
+国际足联 68
+地层学 68
+城隍庙 68
+壮志 68
+多米尼加 68
+夜视仪 68
+大久 68
+大吉 68
+大士 68
+大定 68
+大款 68
+天赐 68
+奸商 68
+妆奁 68
+姐弟 68
+娅 68
+安燕 68
+宋培起 68
+小轿车 68
+尖儿 68
+尽力而为 68
+尽善尽美 68
+尾数 68
+层流 68
+山凹 68
+山溪 68
+岸基 68
+左权县 68
+差异性 68
+巴特勒 68
+干旱区 68
+库夫曼 68
+庠 68
+康广陵 68
+建校 68
+张昭 68
+形同虚设 68
+彻头彻尾 68
+微克 68
+德之士 68
+必修 68
+慨 68
+成不了 68
+成都平原 68
+房室 68
+扑打 68
+扭亏 68
+抻 68
+拍案而起 68
+指导价 68
+捍 68
+排解 68
+提速 68
+搅和 68
+摘除 68
+摩挲 68
+撞倒 68
+擂台赛 68
+支脉 68
+收税 68
+教务长 68
+敦厚 68
+数据分析 68
+敷设 68
+文丑 68
+文墨 68
+斜纹 68
+斥资 68
+新品种 68
+新手 68
+无依无靠 68
+无助于 68
+无神论 68
+既得 68
+日文 68
+旧居 68
+易县 68
+显明 68
+晋江 68
+曲予 68
+月租费 68
+束手待毙 68
+条条 68
+某年 68
+格拉 68
+梦游 68
+棦 68
+棹 68
+椰 68
+樘 68
+橄榄油 68
+欧式 68
+款子 68
+歉收 68
+正大光明 68
+水价 68
+水晶石 68
+水泥厂 68
+水涨船高 68
+水鸟 68
+求亲 68
+汤姆孙 68
+泛光灯 68
+泛美 68
+洗涤剂 68
+浮华 68
+浮沉 68
+海螺 68
+涪 68
+湇 68
+湮 68
+源代码 68
+溪口 68
+溿 68
+漫山遍野 68
+火焰山 68
+火药味 68
+烟云 68
+烟盒 68
+热水器 68
+片面性 68
+物语 68
+牵牛 68
+特大型 68
+特效 68
+王处一 68
+王弼 68
+王渊 68
+现代戏 68
+珠玉 68
+琼崖 68
+生存率 68
+用做 68
+申斥 68
+画室 68
+留任 68
+痨病 68
+白描 68
+白矮星 68
+白蛋白 68
+盐池 68
+盖起 68
+盗汗 68
+盛赞 68
+盱眙 68
+硝酸盐 68
+硬壳 68
+磨坊 68
+社会学系 68
+祖母绿 68
+秉公 68
+科恩 68
+稍候 68
+窦氏 68
+笃信 68
+笔挺 68
+答谢 68
+簟 68
+米德尔斯堡 68
+类书 68
+粒度 68
+精明强干 68
+红人 68
+绦虫 68
+缔约 68
+编次 68
+老五 68
+老邓 68
+耳濡目染 68
+联播 68
+肝硬变 68
+脚掌 68
+腰斩 68
+自控 68
+艺术界 68
+节度 68
+节气 68
+苍苍 68
+草皮 68
+落难 68
+蒸煮 68
+蠎 68
+街边 68
+街面 68
+衣食住行 68
+褝 68
+见面礼 68
+讲稿 68
+诗社 68
+诚如 68
+误事 68
+谜语 68
+败绩 68
+质心 68
+贾兰 68
+赏心悦目 68
+赞不绝口 68
+起重 68
+跌打 68
+路费 68
+跳楼 68
+软木 68
+辞呈 68
+迎客 68
+近卫军 68
+退税 68
+选送 68
+速成 68
+逶迤 68
+遒劲 68
+郭子兴 68
+醪 68
+采收 68
+里奥 68
+重启 68
+钢绳 68
+铭记 68
+长春亚泰队 68
+长篇大论 68
+阗 68
+阴阳怪气 68
+阵地战 68
+陇海铁路 68
+雄辩 68
+雌雄异株 68
+雨后春笋 68
+非正常 68
+面膜 68
+韦氏 68
+韩滔 68
+韶关 68
+顾准 68
+颜阿骨 68
+食欲不振 68
+饼子 68
+马绍尔 68
+骑术 68
+髞 68
+鳗鲡 68
+鳝 68
+鼙 68
+一丝一毫 67
+一举两得 67
+一九九七年 67
+一刀切 67
+一见如故 67
+三镇 67
+上官 67
+上期 67
+上进 67
+不然的话 67
+世故 67
+两节 67
+个人所得税 67
+中国史 67
+中转站 67
+主产区 67
+主因 67
+乔装改扮 67
+九死一生 67
+乱发 67
+事与愿违 67
+二八 67
+二十一岁 67
+五家 67
+人情味 67
+人民日报社 67
+今文 67
+伊玛目 67
+休学 67
+会考 67
+伤兵 67
+伪书 67
+候审 67
+假惺惺 67
+健民 67
+偷生 67
+傻眼 67
+再而三 67
+军代表 67
+刀兵 67
+分店 67
+刊出 67
+创生 67
+利诱 67
+别开生面 67
+前一阵子 67
+动笔 67
+勾搭 67
+包住 67
+包销 67
+北极熊 67
+十六七岁 67
+十几位 67
+十几名 67
+半山腰 67
+华盖 67
+印军 67
+危在旦夕 67
+危房 67
+及第 67
+双曲线 67
+取法 67
+受宠 67
+受让 67
+变分法 67
+叛国 67
+口试 67
+可敬 67
+台灯 67
+史伯威 67
+史叔刚 67
+叶尼塞河 67
+吉尔伯特 67
+同窗 67
+听写 67
+唠唠叨叨 67
+商厦 67
+商港 67
+回响 67
+园丁 67
+固氮 67
+土山 67
+块根 67
+墓园 67
+墓志铭 67
+墓群 67
+壮实 67
+夏婆 67
+外经贸部 67
+大吃大喝 67
+大安 67
+大摇大摆 67
+大藏 67
+大连实德队 67
+太虚 67
+太阳风 67
+失球 67
+夹竹桃 67
+女奴 67
+如故 67
+如花似玉 67
+妊 67
+妙处 67
+委曲 67
+婆媳 67
+媴 67
+孙继海 67
+孝道 67
+学前教育 67
+守土 67
+安理会常任理事国 67
+定州 67
+对弈 67
+对襟 67
+居鲁士 67
+屯驻 67
+岭上 67
+峦 67
+左掌 67
+巴特 67
+巴马 67
+布鲁克斯 67
+平平安安 67
+底板 67
+店门 67
+建炎 67
+弗兰克 67
+张弘 67
+张狂 67
+心急如焚 67
+心有余悸 67
+心血来潮 67
+心领神会 67
+急待 67
+怦然心动 67
+惨不忍睹 67
+想当然 67
+愆 67
+愚兄 67
+愧对 67
+慷慨陈词 67
+截留 67
+打颤 67
+扰民 67
+抗灾 67
+拉链 67
+拒之门外 67
+拼杀 67
+指手画脚 67
+挛 67
+排位 67
+描金 67
+摄影家 67
+摇摇摆摆 67
+撺 67
+撼动 67
+放大镜 67
+放生 67
+放线菌 67
+政治性 67
+散会 67
+敦煌学 67
+文员 67
+斗士 67
+新制 67
+新浪潮 67
+方志 67
+方舟 67
+既得利益 67
+日喀则 67
+日增 67
+早前 67
+最底层 67
+月儿 67
+李西华 67
+村姑 67
+杜震宇 67
+杨梅 67
+杨行密 67
+杨龙 67
+杰克 67
+林哥 67
+果敢 67
+果肉 67
+柏慧 67
+染指 67
+柢 67
+树杈 67
+树荫 67
+案头 67
+楙 67
+榈 67
+横生 67
+檀香山 67
+欢悦 67
+步出 67
+毫不客气 67
+气儿 67
+氯化氢 67
+水利部 67
+汽化 67
+沛县 67
+泥潭 67
+泪如雨下 67
+洞察力 67
+洞房花烛 67
+流离失所 67
+流连 67
+涨势 67
+源地 67
+溶血 67
+漆黑一团 67
+潦 67
+澳元 67
+灵灵 67
+灵秀 67
+烧纸 67
+烧结 67
+热乎 67
+熟食 67
+特别奖 67
+特拉斯 67
+猫腻 67
+玉簪 67
+王储 67
+王大 67
+珠江口 67
+留驻 67
+畜牲 67
+痛哭流涕 67
+白吃 67
+白斑 67
+短时期 67
+破伤风 67
+破口 67
+碗碟 67
+碰头 67
+磨擦 67
+社会民主主义 67
+祜 67
+神思 67
+秦律 67
+竹篮 67
+竺 67
+第三年 67
+第三部 67
+第六条 67
+等式 67
+簦 67
+米特 67
+粛 67
+紧锁 67
+红松 67
+纸质 67
+绞尽脑汁 67
+统一性 67
+维他命 67
+缉私 67
+美洲虎 67
+羔子 67
+羧 67
+老脸 67
+聒噪 67
+肠炎 67
+自修 67
+舞女 67
+花旦 67
+花期 67
+芸香 67
+苗头 67
+苦行 67
+药都 67
+莫洛托夫 67
+蚰蜒 67
+蛇纹石 67
+蜂起 67
+融通 67
+蠡 67
+血汗钱 67
+衣钵 67
+表演队 67
+袁术 67
+记叙 67
+讳言 67
+许多次 67
+诗云 67
+调出 67
+路由 67
+跳神 67
+身外之物 67
+躺椅 67
+轩宇 67
+连锁反应 67
+适应症 67
+逃课 67
+通令 67
+通式 67
+造山运动 67
+邓大海 67
+邦交 67
+邹侑根 67
+采样 67
+采油 67
+金桥 67
+金融界 67
+釼 67
+鎏 67
+钠盐 67
+钢盔 67
+铁杆 67
+银器 67
+锁骨 67
+锅底 67
+锕 67
+镔铁 67
+镢头 67
+长久以来 67
+闭气 67
+阀门 67
+阿拉善 67
+陈三 67
+陶土 67
+隔热 67
+雨果 67
+零售价 67
+雾水 67
+青溪 67
+静脉曲张 67
+颈椎病 67
+马科斯 67
+驿道 67
+鲍彻 67
+鲱 67
+鹿仔 67
+麦克斯 67
+黄真 67
+一二日 66
+一代人 66
+一勺 66
+一忽儿 66
+一言既出 66
+丁关根 66
+丁玲 66
+三十个 66
+三台 66
+三夜 66
+三教 66
+三聚氰胺 66
+三连冠 66
+上海港 66
+下网 66
+不出所料 66
+不成话 66
+世兄 66
+丙烯 66
+东北虎 66
+中保 66
+中信证券 66
+中国农业银行 66
+中国民主建国会 66
+中铁 66
+丰度 66
+乐此不疲 66
+乐艺 66
+乐观主义 66
+乳酪 66
+五十多岁 66
+五寸 66
+五洲 66
+人工流产 66
+亿公顷 66
+令狐掌门 66
+任脉 66
+份子 66
+仿古 66
+伃 66
+伸冤 66
+佛得角 66
+作伴 66
+信纸 66
+停机 66
+傻乎乎 66
+儁 66
+克朗 66
+入校 66
+全真道 66
+全速 66
+内耳 66
+军功 66
+冤孽 66
+冬小麦 66
+冯铁匠 66
+几百名 66
+几粒 66
+刀枪不入 66
+列祖列宗 66
+功不可没 66
+加厚 66
+加德满都 66
+加温 66
+动兵 66
+劲射 66
+劳动法 66
+北京协和医院 66
+十三陵 66
+半只 66
+华佗 66
+单日 66
+单铁生 66
+南天门 66
+卧铺 66
+发展局 66
+发文 66
+口传 66
+古松 66
+古里 66
+叮咬 66
+叶尔羌 66
+叽叽喳喳 66
+合璧 66
+同义 66
+后尘 66
+后继 66
+吴长老 66
+呼和浩特市 66
+和歌 66
+咬咬牙 66
+哈工大 66
+响器 66
+售票员 66
+啧 66
+嗌 66
+嘌 66
+四两 66
+圈里 66
+土库曼 66
+圣谕 66
+地痞 66
+坝头 66
+复核 66
+夏令营 66
+多台 66
+夜风 66
+大千世界 66
+大本 66
+太甚 66
+夯实 66
+失分 66
+好几位 66
+如约 66
+妽 66
+姑夫 66
+婽 66
+嫖客 66
+嫤 66
+孙二娘 66
+孙雯 66
+孝廉 66
+季刊 66
+宁海县 66
+守口如瓶 66
+安布 66
+宗教界 66
+宗泽 66
+寒冰 66
+对位 66
+导火索 66
+导轨 66
+将相 66
+小群 66
+尚方剑 66
+就座 66
+山东人 66
+山乡 66
+山泉 66
+峡湾 66
+嶙峋 66
+巍巍 66
+差分 66
+巴中 66
+帅哥 66
+帅气 66
+干涉仪 66
+年糕 66
+并联 66
+库区 66
+庞培 66
+开合 66
+开球 66
+异体 66
+弹压 66
+弹唱 66
+待机 66
+心服口服 66
+心气 66
+忠勇 66
+急骤 66
+性器官 66
+怨愤 66
+愁闷 66
+感念 66
+慧眼 66
+成心 66
+戒毒 66
+战兢兢 66
+扈从 66
+打制 66
+打工者 66
+托运 66
+扫描仪 66
+扬帆 66
+承蒙 66
+抗命 66
+押韵 66
+拆卸 66
+拌嘴 66
+拔地而起 66
+招行 66
+排开 66
+撱 66
+教育社 66
+敢当 66
+数米 66
+斧子 66
+断交 66
+斯威士兰 66
+方伯谦 66
+方向盘 66
+旅游界 66
+无耻之徒 66
+无趣 66
+无路可走 66
+时令 66
+时运 66
+星河 66
+曲非烟 66
+有何面目 66
+木门 66
+本体论 66
+李立三 66
+杨家庄 66
+松枝 66
+松香 66
+板斧 66
+林里 66
+枪杆 66
+根须 66
+格布 66
+桑托斯 66
+档案学 66
+检查组 66
+欢欣 66
+毋庸置疑 66
+母牛 66
+每时每刻 66
+比利牛斯 66
+氆氇 66
+气韵 66
+水经注 66
+汇流 66
+沃尔玛 66
+油库 66
+油船 66
+法意 66
+泚 66
+海湾地区 66
+消旋 66
+涌泉 66
+清议 66
+清退 66
+温厚 66
+温病 66
+湎 66
+湘莲 66
+溃烂 66
+溺水 66
+滑跑 66
+滚轮 66
+潜水器 66
+澧水 66
+濙 66
+火坑 66
+火神 66
+炸雷 66
+点将 66
+烟管 66
+煤山 66
+犯错 66
+狂飙 66
+狐狸精 66
+独到之处 66
+狱吏 66
+狼吞虎咽 66
+猝然 66
+猱 66
+玄虚 66
+玉溪 66
+王伟 66
+环保局 66
+环路 66
+现象学 66
+珙桐 66
+球茎 66
+理当 66
+瓿 66
+田租 66
+电热 66
+男队 66
+畸 66
+白区 66
+百事 66
+百户 66
+百色 66
+盖帽 66
+相依 66
+相得益彰 66
+眉清目秀 66
+真心话 66
+短处 66
+石椁 66
+石破天惊 66
+砂矿 66
+研究型 66
+砧 66
+磁力线 66
+神不知鬼不觉 66
+神童 66
+票号 66
+祯 66
+福利院 66
+离岛 66
+秋子 66
+秘籍 66
+秦癫 66
+穷光蛋 66
+窦开远 66
+童威 66
+笑谈 66
+笑逐颜开 66
+第五个 66
+第十三章 66
+管风琴 66
+箱笼 66
+粮站 66
+纵欲 66
+织女 66
+维希 66
+绿荫 66
+缝纫 66
+缴费 66
+罗伦 66
+罗网 66
+老宅 66
+老庄 66
+耳房 66
+胆大妄为 66
+胡志明市 66
+胡诌 66
+胶带 66
+脂类 66
+脑膜炎 66
+腰板 66
+自作主张 66
+自满 66
+自由港 66
+舞姿 66
+航宇 66
+艇长 66
+色盲 66
+花团锦簇 66
+苦不堪言 66
+苦海 66
+获救 66
+萒 66
+营私 66
+董方卓 66
+蒙语 66
+薹 66
+虚词 66
+蛴 66
+蜀国 66
+蜂群 66
+蝠王 66
+视差 66
+认准 66
+讲道 66
+诉讼法 66
+该文 66
+说来话长 66
+说理 66
+说说笑笑 66
+谁料 66
+调味品 66
+谓词 66
+谚 66
+豫南 66
+货栈 66
+费尔巴哈 66
+赤峯 66
+赤峰 66
+超声速 66
+跑遍 66
+路数 66
+转氨酶 66
+辅导书 66
+达卡 66
+达尔 66
+迎面而来 66
+还原剂 66
+进袭 66
+远扬 66
+迟迟疑疑 66
+那也好 66
+都大锦 66
+酒肆 66
+释疑 66
+重头戏 66
+金印 66
+金牛 66
+铁杉 66
+铁罗汉 66
+铁锚 66
+铜元 66
+铷 66
+锞 66
+锲而不舍 66
+长传 66
+长龙 66
+门扇 66
+闹哄哄 66
+阅览 66
+阆 66
+阵发性 66
+阿尔及尔 66
+陈逸飞 66
+雇佣军 66
+霎 66
+露头 66
+霸气 66
+鞴 66
+韧皮部 66
+顾问团 66
+风物 66
+风驰电掣 66
+饭前 66
+馍馍 66
+马山 66
+马棚 66
+马步军 66
+马灯 66
+骨节 66
+鬣 66
+鱼贯 66
+鱼鹰 66
+鲋 66
+鳞次栉比 66
+麾 66
+黑车 66
+一百元 65
+一箱 65
+一马当先 65
+万倍 65
+万分之一 65
+三拳 65
+三斤 65
+上半 65
+上半时 65
+上步 65
+上海大学 65
+不成器 65
+不谋而合 65
+东大 65
+两全其美 65
+两刀 65
+中共中央书记处 65
+中国民主促进会 65
+中国美术家协会 65
+丰饶 65
+临战 65
+主存 65
+乌龙 65
+乖戾 65
+乗 65
+习练 65
+乡宦 65
+争论不休 65
+二人转 65
+五味 65
+五花大绑 65
+五铢钱 65
+仁厚 65
+介乎 65
+介词 65
+仙气 65
+代表作品 65
+令爱 65
+伊克昭盟 65
+传唱 65
+伸手不见五指 65
+佐料 65
+停用 65
+光强 65
+光谱分析 65
+光量子 65
+全团 65
+全村人 65
+公使馆 65
+公安人员 65
+公开信 65
+关平 65
+内府 65
+内阁大臣 65
+冤魂 65
+几千万 65
+出口商 65
+刘海 65
+前兆 65
+前呼后拥 65
+劈头盖脸 65
+力保 65
+千叶 65
+半条 65
+半途 65
+华光 65
+南京政府 65
+卢员外 65
+双胞胎 65
+发狂 65
+发笑 65
+口琴 65
+古代史 65
+台球 65
+叶仙 65
+各党 65
+各户 65
+合拍 65
+听戏 65
+呕心沥血 65
+命根子 65
+哀告 65
+善解人意 65
+喳 65
+噌 65
+四匹 65
+四场 65
+四溢 65
+团组织 65
+国家科委 65
+圆珠笔 65
+圣约翰 65
+圣马力诺 65
+地心 65
+埃托奥 65
+基督徒 65
+塞拉利昂 65
+墙脚 65
+外乡人 65
+够意思 65
+大篷车 65
+大言不惭 65
+大餐 65
+失重 65
+奈良 65
+女扮男装 65
+如梦初醒 65
+妄言 65
+妥贴 65
+姛 65
+婚约 65
+媒质 65
+宜兰 65
+家养 65
+容克 65
+密令 65
+密电 65
+射猎 65
+尽心尽力 65
+山里人 65
+崴 65
+布尔人 65
+帅克 65
+帐幕 65
+平视 65
+幽会 65
+幽禁 65
+广昌 65
+庾 65
+开放区 65
+开膛 65
+异曲同工 65
+张旭 65
+张洋 65
+形同 65
+形神 65
+微末 65
+心力 65
+情妇 65
+意译 65
+慢慢说 65
+成团 65
+战袍 65
+扈三娘 65
+扭亏为盈 65
+扭断 65
+扶正 65
+拍案 65
+拔腿 65
+拖拉 65
+招考办 65
+捕猎 65
+排忧解难 65
+搏杀 65
+搡 65
+搷 65
+摈弃 65
+摊牌 65
+撒腿 65
+文武全才 65
+文论 65
+斜线 65
+斯宾诺 65
+新泽西 65
+新贵 65
+旗鼓相当 65
+无怨无悔 65
+无花果 65
+日产 65
+时效性 65
+时隐时现 65
+星图 65
+映入眼帘 65
+晨曦 65
+晴天霹雳 65
+曲直 65
+曹吉祥 65
+曾达 65
+有增无减 65
+机巧 65
+李嘉诚 65
+李政道 65
+李清照 65
+来风 65
+松嫩平原 65
+林铭球 65
+枪尖 65
+查实 65
+栖身 65
+栲胶 65
+核糖核酸 65
+核裂变 65
+梁王 65
+棉田 65
+樵夫 65
+欠款 65
+正旦 65
+正经事 65
+民初 65
+气田 65
+水力学 65
+江津 65
+河渠 65
+法币 65
+波拿巴 65
+波旁 65
+流散 65
+测字 65
+浓眉大眼 65
+海因里希 65
+海客 65
+海布 65
+海老公 65
+涫 65
+液面 65
+液麪 65
+淋浴 65
+深山老林 65
+添乱 65
+溢于言表 65
+滩地 65
+潮差 65
+灌浆 65
+炒股 65
+炕桌 65
+烟丝 65
+爬升 65
+爱迪生 65
+牛犊 65
+狎 65
+王万仞 65
+王德勤 65
+王进 65
+现代舞 65
+珐琅 65
+疵 65
+白城 65
+百万富翁 65
+百丈 65
+百分之二 65
+百老汇 65
+相国寺 65
+相安无事 65
+相对高度 65
+相悖 65
+看家 65
+睃 65
+知交 65
+短距离 65
+磁共振 65
+礼敬 65
+社会活动家 65
+祦 65
+离场 65
+离心力 65
+离石 65
+秀气 65
+稀里糊涂 65
+笔尖 65
+第二性 65
+第五条 65
+第十一卷 65
+第十五章 65
+管弦乐队 65
+粘土矿 65
+粯 65
+系列化 65
+紫云 65
+终了 65
+终审 65
+结巴 65
+统战 65
+绵阳 65
+绿灯 65
+编目 65
+缯 65
+缺一不可 65
+网络系统 65
+罗密欧 65
+美国公司 65
+羝 65
+群峰 65
+老丈 65
+老卡 65
+耂 65
+耐磨性 65
+肇事 65
+肉酱 65
+肯德基 65
+育龄 65
+胶州湾 65
+脆性 65
+脑力 65
+脱胎换骨 65
+腆 65
+腥气 65
+自决 65
+自圆其说 65
+舅爷 65
+芭蕉扇 65
+花万紫 65
+花丝 65
+花旗银行 65
+苟且 65
+苦干 65
+苦心经营 65
+苸 65
+草屋 65
+药瓶 65
+蓖 65
+蓟辽 65
+虔敬 65
+蚋 65
+蛑 65
+蛾眉 65
+螳螂 65
+裂化 65
+褚时健 65
+言谈举止 65
+许予明 65
+诸善 65
+诹 65
+调情 65
+谧 65
+账房 65
+货船 65
+贪恋 65
+走红 65
+超凡 65
+跟风 65
+路南 65
+车流 65
+达罗 65
+迎霜 65
+邓颖超 65
+郊野 65
+酣畅 65
+里尔 65
+重灾区 65
+金领 65
+钱物 65
+铁面 65
+铙 65
+问卷调查 65
+闲杂 65
+阿布 65
+除权 65
+雷诺 65
+非礼 65
+音速 65
+顺水 65
+顺水推舟 65
+预制 65
+饷银 65
+马步 65
+马绍 65
+驱动器 65
+高估 65
+高攀 65
+鬓发 65
+魁星 65
+鲜肉 65
+黄杨 65
+黄门 65
+丁氏 64
+七次 64
+万寿无疆 64
+三头六臂 64
+三才 64
+三把 64
+三江平原 64
+三盘 64
+上劲 64
+上周五 64
+上海队 64
+东拉西扯 64
+两百年 64
+中肯 64
+丰年 64
+临场发挥 64
+主战场 64
+义举 64
+书僮 64
+书札 64
+乳液 64
+五光十色 64
+井台 64
+产状 64
+亲代 64
+人事局 64
+付与 64
+优先权 64
+优裕 64
+传回 64
+低血糖 64
+佳品 64
+侏儒 64
+倒悬 64
+停机坪 64
+先声 64
+入冬 64
+全科 64
+共聚物 64
+内经 64
+冒汗 64
+农人 64
+农奴主 64
+冰封 64
+冻伤 64
+凋敝 64
+几所 64
+出出 64
+创世 64
+剪彩 64
+加开 64
+加沙 64
+动物所 64
+劳碌 64
+勾心斗角 64
+化缘 64
+北京理工大学 64
+北疆 64
+区段 64
+十五万 64
+十五六岁 64
+十八代 64
+十恶不赦 64
+十进制 64
+千余年 64
+千篇一律 64
+千般 64
+千言万语 64
+南帝 64
+南征北战 64
+博尔术 64
+卡利 64
+压倒性 64
+压境 64
+厚意 64
+取现 64
+取长补短 64
+口服液 64
+叩首 64
+可控 64
+右江 64
+各有所长 64
+合成氨 64
+合股 64
+向斜 64
+吕始东 64
+吕梁 64
+吴宓 64
+吴觉农 64
+吹动 64
+吹箫 64
+周文 64
+周铁 64
+哀痛 64
+哪样 64
+哪里话 64
+唐突 64
+喀嚓 64
+四一二 64
+四期 64
+四重奏 64
+固结 64
+国际联盟 64
+圆心 64
+地仙村 64
+坝上 64
+塞尔维亚人 64
+塠 64
+墙面 64
+壮健 64
+备课 64
+多心 64
+夜中 64
+太史慈 64
+失真 64
+失禁 64
+奠基者 64
+好动 64
+娄萌 64
+娇小 64
+娇柔 64
+嫩叶 64
+嬴 64
+孑遗 64
+学医 64
+安安稳稳 64
+安小慧 64
+宋师爷 64
+宋濂 64
+完好无损 64
+宛转 64
+宫调 64
+察举 64
+察言观色 64
+封套 64
+小工 64
+小戏 64
+居柏塞 64
+山径 64
+山鸡 64
+崼 64
+川江 64
+左联 64
+差池 64
+巴斯 64
+布莱克 64
+希求 64
+帕金森 64
+帮腔 64
+常梅 64
+平实 64
+庄稼汉 64
+床板 64
+应激 64
+开发者 64
+开怀 64
+开户 64
+开放日 64
+开方 64
+张万年 64
+张利胜 64
+张澜 64
+弹片 64
+归云庄 64
+归口 64
+形相 64
+影视剧 64
+心寒 64
+思过 64
+恩宠 64
+恭贺 64
+悸 64
+惫 64
+感慨万千 64
+戏法 64
+成见 64
+所罗门群岛 64
+抑止 64
+抢渡 64
+报名点 64
+报馆 64
+拉基亚 64
+招股 64
+拼音文字 64
+指正 64
+换气 64
+捧场 64
+掏空 64
+排印 64
+排遣 64
+探针 64
+控制力 64
+撑住 64
+攀谈 64
+收归 64
+敋 64
+敲诈 64
+文种 64
+斐然 64
+斗鸡 64
+斜倚 64
+断气 64
+旄 64
+无独有偶 64
+旱魃 64
+昆鹏 64
+明崇祯 64
+明矾 64
+晋武帝 64
+暴富 64
+曲棍球 64
+替死鬼 64
+木版 64
+朱贵 64
+权宜之计 64
+杜迁 64
+杠子 64
+杯赛 64
+枯井 64
+柏林墙 64
+栲 64
+格林尼治 64
+桃花源 64
+梧桐树 64
+植棉 64
+模棱两可 64
+橄榄球 64
+欺辱 64
+正厅 64
+步行者 64
+武惠良 64
+死气沉沉 64
+民俗学 64
+永兴 64
+求索 64
+沙石 64
+油松 64
+油盐 64
+治病救人 64
+沿边 64
+法制化 64
+法勒亚 64
+洛克里 64
+流涕 64
+浅显 64
+济世 64
+浑浑噩噩 64
+浪荡 64
+浮夸风 64
+海兽 64
+消减 64
+淫乱 64
+深谈 64
+深谋远虑 64
+清道 64
+港务 64
+游嬉 64
+湿气 64
+漂白 64
+演剧 64
+澳柯玛 64
+濯 64
+炮舰 64
+烈酒 64
+烧炭 64
+焦距 64
+煞气 64
+爱庇丹 64
+物性 64
+特科 64
+狄昂 64
+猝死 64
+玄贞道人 64
+王喜 64
+王胆 64
+王铎 64
+珠江流域 64
+理性主义 64
+琶 64
+璜 64
+甲酸 64
+电泳 64
+男仆 64
+画作 64
+画师 64
+疑神疑鬼 64
+疟原虫 64
+登顶 64
+白明川 64
+白瓷 64
+的的确确 64
+皇甫 64
+监察部 64
+盛传 64
+目镜 64
+相亲 64
+省属 64
+省政协 64
+知难而退 64
+破落 64
+碧海 64
+碾米 64
+磁针 64
+社会科学院 64
+社民党 64
+祸乱 64
+突厥语 64
+窖藏 64
+竞走 64
+第八卷 64
+第十六章 64
+第四日 64
+管区 64
+箭石 64
+篓子 64
+粗豪 64
+粘胶 64
+精进 64
+綎 64
+繇 64
+线下 64
+线形 64
+经不住 64
+经世 64
+经验论 64
+绡 64
+罗埃岑 64
+置信 64
+翻倒 64
+老泪纵横 64
+老谋深算 64
+耗用 64
+胜局 64
+胡麻 64
+胥 64
+脑力劳动 64
+脑垂体 64
+脚背 64
+脱逃 64
+自夸 64
+自查 64
+自由派 64
+自诩 64
+自贡 64
+致病性 64
+船底 64
+节骨眼 64
+花剑 64
+茶客 64
+茶房 64
+茶盘 64
+荣膺 64
+菌类 64
+菩 64
+菩提树 64
+落基 64
+落点 64
+蓑衣 64
+藤牌 64
+蠢蠢欲动 64
+襄城 64
+西基雄 64
+西海 64
+西王 64
+见怪不怪 64
+角儿 64
+解聘 64
+觺 64
+訷 64
+警力 64
+诸职司 64
+调处 64
+谄谀 64
+谐和 64
+谐波 64
+贪心 64
+贪赃枉法 64
+贵戚 64
+贾府 64
+赌客 64
+赔不是 64
+赔钱 64
+赵松寿 64
+超大规模 64
+越秀 64
+转义 64
+辞掉 64
+辣手 64
+进言 64
+进香 64
+送命 64
+选择权 64
+通关 64
+邓世昌 64
+邻人 64
+郁结 64
+酵 64
+重离子 64
+金桂华 64
+金焰 64
+钱宝琮 64
+铁人 64
+铁铲 64
+铁锈 64
+锅盖 64
+锐意 64
+镓 64
+镜框 64
+闱 64
+间作 64
+阋 64
+防波堤 64
+阿契劳斯 64
+附肢 64
+陈玄风 64
+陕南 64
+非晶态 64
+面食 64
+顶天立地 64
+预设 64
+颓势 64
+食盒 64
+食量 64
+马哈木 64
+马麟 64
+高塔 64
+鱼骨 64
+鲁西南 64
+鸱 64
+麦苗 64
+麪食 64
+麻巧 64
+麻田 64
+麻花 64
+黄娜 64
+黎民 64
+黑心 64
+黑线 64
+默默无言 64
+龙王庙 64
+一多半 63
+一言为定 63
+上臂 63
+与其说 63
+世交 63
+世代交替 63
+世祖 63
+东胜 63
+东西部 63
+东门外 63
+中农 63
+中国女排 63
+中央办公厅 63
+丰腴 63
+主战派 63
+乙酸乙酯 63
+乙醛 63
+买好 63
+乾果 63
+二批 63
+亏心事 63
+亚行 63
+亹 63
+介绍所 63
+仑 63
+何九叔 63
+例会 63
+信誓旦旦 63
+值勤 63
+假手 63
+充耳不闻 63
+兆瓦 63
+先入为主 63
+八位 63
+八里 63
+公关部 63
+公敌 63
+养蚕 63
+军屯 63
+农科院 63
+准绳 63
+几手 63
+出奔 63
+刀山 63
+分道扬镳 63
+刑事犯罪 63
+刘一 63
+初战 63
+刻字 63
+前列腺癌 63
+剑拔弩张 63
+劈柴 63
+动态平衡 63
+劳动部门 63
+北京大学出版社 63
+十几里 63
+千锤百炼 63
+千难万难 63
+升级换代 63
+半米 63
+单数 63
+单方 63
+南苑 63
+博彩 63
+原木 63
+受制 63
+古韵 63
+可亲 63
+台长 63
+各段 63
+同于 63
+同质 63
+吡啶 63
+哀乐 63
+哄堂大笑 63
+哨子 63
+商队 63
+嘟哝 63
+四书五经 63
+国舅 63
+垁 63
+垂直带 63
+垂青 63
+埍 63
+堂弟 63
+墨绿色 63
+备选 63
+外设 63
+多件 63
+多角度 63
+多边形 63
+大办 63
+大圆 63
+大林 63
+天籁 63
+央告 63
+失身 63
+夹板 63
+女性化 63
+奶制品 63
+妖术 63
+始创 63
+娇美 63
+婚检 63
+孪 63
+安保 63
+安道全 63
+宋平 63
+害处 63
+家法 63
+家长制 63
+密钥 63
+封堵 63
+小人物 63
+小帽 63
+就事论事 63
+屏气 63
+山塘 63
+岐山 63
+岚 63
+岩土 63
+工伤 63
+布须曼 63
+席勒 63
+席地而坐 63
+干完 63
+干果 63
+干道 63
+平四 63
+幸甚 63
+庞文道 63
+开列 63
+开船 63
+异彩 63
+引子 63
+引荐 63
+弘光皇帝 63
+张浚 63
+张清 63
+弹力 63
+彟 63
+彭和尚 63
+彸 63
+彼得格勒 63
+徐大哥 63
+心功能 63
+忋 63
+性灵 63
+成矿作用 63
+成耀东 63
+截杀 63
+房改 63
+扑克 63
+执行者 63
+抢购 63
+护持 63
+报称 63
+担惊受怕 63
+拉瓦锡 63
+拍片 63
+拔尖 63
+拖入 63
+指挥塔 63
+挖空心思 63
+振振有词 63
+捌 63
+搏动 63
+摆列 63
+播州 63
+改错 63
+文学系 63
+斗室 63
+斯瑞克 63
+新安江 63
+新鲜事 63
+方块 63
+无名小卒 63
+日盛 63
+显德 63
+晞 63
+普陀山 63
+更上一层楼 63
+月工资 63
+有何不可 63
+朝鲜民主主义人民共和国 63
+本队 63
+杀戒 63
+李孝忠 63
+李能 63
+板桥 63
+果酒 63
+某事 63
+标有 63
+格萨尔 63
+椎间盘 63
+武断 63
+死有余辜 63
+毁弃 63
+毫无用处 63
+民生主义 63
+氟化物 63
+氨水 63
+水轮机 63
+河漫滩 63
+波波夫 63
+波纹 63
+测控 63
+海相 63
+涂层 63
+涡扇 63
+清茶 63
+湛 63
+湿疹 63
+滑铁卢 63
+满桂 63
+滤波 63
+漏网 63
+潘独鳌 63
+澎湖列岛 63
+火急 63
+灯会 63
+炸药包 63
+点位 63
+烤鸭 63
+煽惑 63
+犒劳 63
+猎手 63
+獐子 63
+现钱 63
+理想主义 63
+理所应当 63
+琉球 63
+甘宁 63
+生离死别 63
+田伯 63
+电文 63
+疍 63
+疏密 63
+病原菌 63
+白白的 63
+百万雄师 63
+百分之三 63
+百分之五 63
+的哥 63
+皇军 63
+益发 63
+睡醒 63
+知错 63
+矿物学 63
+碱基 63
+碳酸钠 63
+磨料 63
+秋毫无犯 63
+空腔 63
+窇 63
+站不住脚 63
+笘 63
+第二十 63
+简而言之 63
+管理费 63
+箱内 63
+精疲力尽 63
+糁 63
+系统论 63
+紫檀 63
+红豆 63
+经纬仪 63
+统筹兼顾 63
+绿意 63
+缄 63
+缏 63
+缦 63
+罢黜 63
+美美 63
+耐久 63
+耗散 63
+肄业 63
+肉刑 63
+胡同口 63
+胡志明 63
+脑神经 63
+脓血 63
+舞步 63
+舟子 63
+芎 63
+花果 63
+苍穹 63
+苍翠 63
+苏伊士 63
+苦处 63
+苴 63
+荡平 63
+药典 63
+萧山 63
+葱岭 63
+藤本 63
+虎踞 63
+血本 63
+行书 63
+行船 63
+表演赛 63
+西南方 63
+西餐 63
+观察所 63
+论衡 63
+设色 63
+诉状 63
+诌 63
+诡谲 63
+该刊 63
+语感 63
+说三道四 63
+豪华团 63
+贝贝 63
+赝品 63
+赤诚 63
+赵南星 63
+赵良栋 63
+起爆 63
+越狱 63
+趋同 63
+趾高气扬 63
+跛子 63
+身家 63
+车费 63
+轫 63
+转炉 63
+轴心 63
+连战 63
+逐项 63
+速写 63
+遑 63
+道喜 63
+邓中夏 63
+郑霖 63
+鄙人 63
+酒色 63
+醉乡 63
+金石学 63
+针尖 63
+钳制 63
+铝矾土 63
+锊 63
+镳 63
+闽浙 63
+阒 63
+阔别 63
+阔绰 63
+阴凉 63
+阴曹 63
+阵型 63
+阿贝尔 63
+附注 63
+陈列室 63
+陷坑 63
+雨燕 63
+青史 63
+青苗 63
+靖哥哥 63
+鞭策 63
+项城 63
+颂歌 63
+领军人物 63
+风湿病 63
+风车 63
+食用菌 63
+馆里 63
+马厩 63
+马甲 63
+马镫 63
+高欢 63
+魏孝文帝 63
+魔兽 63
+鲍旭 63
+鸟鸣 63
+鸡头 63
+默想 63
+一万个 62
+一个样 62
+一拖 62
+一见钟情 62
+万张 62
+万蕙 62
+三四十 62
+上户 62
+上苍 62
+不啻 62
+不羁 62
+世凯 62
+严查 62
+中国政法大学 62
+中地 62
+乌木 62
+九姓 62
+乾巴巴 62
+争胜 62
+二二八 62
+二话没说 62
+二阶 62
+亳州 62
+今世 62
+今春 62
+仙山 62
+伏笔 62
+优胜劣汰 62
+伪善 62
+伴儿 62
+住店 62
+余首 62
+供大于求 62
+保湿 62
+儆 62
+先世 62
+入仕 62
+全属 62
+全篇 62
+全金发 62
+八卦刀 62
+公安厅 62
+共处 62
+共工 62
+兴安岭 62
+典狱 62
+养蜂 62
+内分泌腺 62
+内奸 62
+冷宫 62
+准军事 62
+准确度 62
+几丈 62
+几刀 62
+凤丫头 62
+出塞 62
+出榜 62
+出诊 62
+刀耕火种 62
+分布式 62
+刚硬 62
+初十 62
+别忙 62
+前驱 62
+功亏一篑 62
+加元 62
+劳神 62
+化险为夷 62
+北关 62
+北洋军 62
+医嘱 62
+十九岁 62
+千岛 62
+半坡 62
+单质 62
+博采 62
+原生质 62
+原稿 62
+参阅 62
+反击战 62
+反日 62
+司马氏 62
+吃喝玩乐 62
+吃苦耐劳 62
+同仁堂 62
+后排 62
+含水层 62
+听筒 62
+听而不闻 62
+周转期 62
+唱和 62
+唾弃 62
+啼笑皆非 62
+四十三年 62
+回归年 62
+围猎 62
+固原 62
+国家所有 62
+国家教育部 62
+国旗护卫队 62
+国际共产主义运动 62
+场强 62
+坐姿 62
+埄 62
+士隐 62
+复制品 62
+夜市 62
+大出血 62
+大力神 62
+大尉 62
+大调 62
+大集 62
+天华 62
+失敬 62
+奇想 62
+奔丧 62
+奥陶纪 62
+婚宴 62
+嫡传 62
+子蔚 62
+孤立无援 62
+孤苦伶仃 62
+学术团体 62
+守望 62
+宗子 62
+家宴 62
+寥 62
+对攻 62
+封条 62
+尊奉 62
+小城镇 62
+尔依 62
+尖石 62
+屈居 62
+屯扎 62
+崔呈秀 62
+左撇子 62
+差值 62
+帉 62
+帺 62
+干巴巴 62
+年轻化 62
+庄院 62
+庆历 62
+廊坊 62
+张仲景 62
+弹子 62
+弹道学 62
+强碱 62
+彊 62
+彭湘湘 62
+彰显 62
+德才兼备 62
+心坎 62
+心安 62
+志得意满 62
+快板 62
+怀揣 62
+怦 62
+恒心 62
+恬静 62
+恶狗 62
+悔改 62
+情缘 62
+想当初 62
+感召 62
+感言 62
+憜 62
+戈登 62
+战略家 62
+房地产商 62
+手到擒来 62
+打圆场 62
+打理 62
+承压 62
+把儿 62
+抗坏血酸 62
+折价 62
+拉克 62
+拉萨市 62
+拐点 62
+拔除 62
+拥护者 62
+挂到 62
+指印 62
+按捺 62
+挖洞 62
+捉襟见肘 62
+掌灯 62
+排版 62
+掘金 62
+接班 62
+揹着 62
+摊主 62
+撕扯 62
+改朝换代 62
+改版 62
+放飞 62
+散心 62
+散文家 62
+敬帅 62
+整场 62
+斯坦福大学 62
+斯金斯 62
+新乡 62
+方腊 62
+无可挽回 62
+无庸 62
+无拘无束 62
+无穷无尽 62
+无线电通信 62
+既往不咎 62
+日影 62
+星象 62
+昭王 62
+晋绥 62
+景宗 62
+月台 62
+有机酸 62
+李力世 62
+李定国 62
+李香 62
+来世 62
+杨杰 62
+杨鹤 62
+栊 62
+栗钙土 62
+校验 62
+桂桂 62
+桤 62
+椰枣 62
+槼 62
+樋 62
+檀香 62
+欍 62
+歌女 62
+歙 62
+正北 62
+武氏兄弟 62
+死光 62
+殷殷 62
+毁于一旦 62
+每队 62
+毕业证 62
+毗湿奴 62
+氤氲 62
+水到渠成 62
+治平 62
+法律系 62
+法统 62
+洋洋得意 62
+浅黄色 62
+浡 62
+海道 62
+混响 62
+渡江战役 62
+火力点 62
+热传导 62
+热销 62
+焰火 62
+熟谙 62
+熴 62
+牛蛙 62
+独木舟 62
+玄冥 62
+王如一 62
+王砾 62
+王磊 62
+球鞋 62
+理亏 62
+理清 62
+甘拜下风 62
+甜言蜜语 62
+生辉 62
+电影史 62
+画坛 62
+疝 62
+痛不欲生 62
+痴心妄想 62
+登载 62
+白兔 62
+白塔 62
+白孝武 62
+百发百中 62
+百战百胜 62
+百货公司 62
+的黎波里 62
+相去甚远 62
+省院 62
+看客 62
+矖 62
+短跑 62
+石敬瑭 62
+石炭 62
+破甲弹 62
+破费 62
+硝石 62
+磨盘 62
+神机 62
+秦始皇陵 62
+空对地 62
+突进 62
+第一盘 62
+筑堤 62
+筒子 62
+篮球队 62
+粉色 62
+粗粮 62
+粘结 62
+紞 62
+素食 62
+繁殖期 62
+红红火火 62
+纸板 62
+绒线 62
+缺德 62
+置备 62
+美国财政部 62
+羲 62
+翁师傅 62
+翕 62
+老丈人 62
+老师傅 62
+耐性 62
+聚餐 62
+肉牛 62
+股东会 62
+背着 62
+胡斐笑 62
+脲 62
+致歉 62
+航标 62
+芍 62
+苏里南 62
+苦瓜 62
+莱州 62
+萎靡 62
+落寞 62
+落款 62
+蓝狮 62
+虫害 62
+蜕皮 62
+衩 62
+裉 62
+西北军 62
+规模化 62
+解码 62
+讲经 62
+讶 62
+试验室 62
+诗学 62
+说不过去 62
+请便 62
+诸宫调 62
+诺贝尔化学奖 62
+课余 62
+调治 62
+谗言 62
+贤王 62
+账单 62
+贾宝玉 62
+赏玩 62
+走低 62
+赵王 62
+跌价 62
+路易十四 62
+转录 62
+软硬件 62
+辛树 62
+边关 62
+迁出 62
+过份 62
+过氧化物 62
+造作 62
+造桥 62
+道路交通 62
+邗沟 62
+邱四毛 62
+郁郁葱葱 62
+部长级 62
+醉汉 62
+里长 62
+重写 62
+重重叠叠 62
+金一南 62
+铜鼎 62
+锅子 62
+长势 62
+门坎 62
+门当户对 62
+门楣 62
+阶梯状 62
+阿东 62
+附设 62
+陆陆续续 62
+陈在竹 62
+雄心壮志 62
+需要量 62
+霉变 62
+面无人色 62
+颞 62
+飒然 62
+飒飒 62
+香港中文大学 62
+马可尼 62
+高层次 62
+鬓角 62
+麦穗 62
+鼻祖 62
+一万元 61
+一下子把 61
+一了百了 61
+一睹 61
+一骨碌 61
+万般 61
+万部 61
+三十九年 61
+三环 61
+三百两 61
+上宾 61
+下臣 61
+不定式 61
+世情 61
+东北民主联军 61
+丝带 61
+两束 61
+丨 61
+中科 61
+主力舰 61
+主笔 61
+主罚 61
+乌思藏 61
+九尾 61
+九条 61
+二寸 61
+交互式 61
+交投 61
+交结 61
+交通网 61
+交道 61
+京沪高速铁路 61
+人伦 61
+代代相传 61
+令人信服 61
+令人担忧 61
+以上者 61
+以礼相待 61
+仪征 61
+伊斯兰堡 61
+伓 61
+会心 61
+伤风 61
+伦琴 61
+伸伸 61
+似懂非懂 61
+余秋雨 61
+作图 61
+信息工程 61
+信用证 61
+修配 61
+倒映 61
+候车室 61
+借债 61
+停电 61
+儒术 61
+光武帝 61
+光电子 61
+全剧 61
+全国总工会 61
+全院 61
+八万 61
+八九个 61
+兵临 61
+养家 61
+兼而有之 61
+内外交困 61
+军徽 61
+冰河 61
+冷然 61
+减灾 61
+减负 61
+几丝 61
+几十种 61
+几碗 61
+出阵 61
+刀光剑影 61
+刑具 61
+刘公岛 61
+刚强 61
+判明 61
+制表 61
+刻石 61
+剑客 61
+劝酒 61
+包皮 61
+化学药品 61
+北岛 61
+医学奖 61
+十八个 61
+十只 61
+午睡 61
+卤水 61
+危机四伏 61
+参禅 61
+双眉 61
+反败为胜 61
+发回 61
+发愣 61
+发现者 61
+发车 61
+取保 61
+古画 61
+可操作性 61
+可有可无 61
+台面 61
+司隶校 61
+吉田 61
+后坐力 61
+后来居上 61
+吸吮 61
+呼罗珊 61
+商都 61
+啻 61
+善心 61
+嘉里 61
+四枚 61
+国书 61
+国家计委 61
+国手 61
+国统会 61
+图克 61
+图强 61
+土堆 61
+土布 61
+土豪劣绅 61
+圣物 61
+均线 61
+垅 61
+垒球 61
+城子 61
+堆积物 61
+堪培拉 61
+壮美 61
+复审 61
+外泄 61
+多巴哥 61
+多维 61
+大喇嘛 61
+大树下 61
+大浪 61
+天壤之别 61
+天干 61
+天狼星 61
+天都 61
+头颈部 61
+夹子 61
+奇痒 61
+奸党 61
+如影随形 61
+妇幼 61
+姇 61
+媺 61
+子兵 61
+字书 61
+孙吴 61
+宗主 61
+宗第 61
+审阅 61
+家底 61
+宿卫 61
+密密层层 61
+密密的 61
+寿宁宫 61
+寿礼 61
+封建迷信 61
+小农经济 61
+小房 61
+小气候 61
+尼赫鲁 61
+山东大学 61
+嶙 61
+巴库 61
+巴贝奇 61
+干热风 61
+平移 61
+幼仔 61
+底舱 61
+废弛 61
+康普顿 61
+廖仲恺 61
+廖若 61
+开饭 61
+张三爷 61
+张某 61
+张翼 61
+强令 61
+归队 61
+徐才厚 61
+心扉 61
+心血管病 61
+志在必得 61
+怨天尤人 61
+总装备部 61
+恬 61
+扒拉 61
+打游击 61
+打车 61
+执行机构 61
+抓走 61
+护士长 61
+报喜 61
+披散 61
+拉曼 61
+拊 61
+拔擢 61
+拜伦 61
+拱门 61
+挤挤 61
+搭话 61
+操作性 61
+放牛 61
+教科文 61
+教规 61
+数着 61
+文胸 61
+方孝孺 61
+旋臂 61
+无党派 61
+无存 61
+无机化学 61
+无理数 61
+无痛 61
+日晒 61
+日月神教 61
+旧约 61
+早年间 61
+时过境迁 61
+昏黑 61
+易水 61
+春分 61
+春暖花开 61
+晶闸管 61
+更始 61
+朋党 61
+朝阳门 61
+木架 61
+李典 61
+李继迁 61
+杜牧 61
+林下 61
+果糖 61
+柔韧 61
+根由 61
+格林 61
+桃树 61
+案发 61
+桦树 61
+梦乡 61
+棭 61
+槐花 61
+樗 61
+欢度 61
+欢聚 61
+欣喜若狂 61
+歙县 61
+正理 61
+武三娘 61
+死寂 61
+殃 61
+残局 61
+民宅 61
+民进党 61
+水彩画 61
+水轮 61
+求新 61
+汗巾 61
+河山 61
+法医 61
+法轮功 61
+波利尼西亚人 61
+波涛汹涌 61
+洗牌 61
+洛宁 61
+洛水 61
+洪堡 61
+流云 61
+流线型 61
+浅浅的 61
+海牛 61
+清朗 61
+済 61
+溯源 61
+滑腻 61
+澎 61
+灌肠 61
+灵霄 61
+点播 61
+烫手 61
+煊 61
+爱妻 61
+父权 61
+爽利 61
+牛股 61
+犯罪人 61
+玄难 61
+王之仁 61
+王旭明 61
+王阳明 61
+玑 61
+玛纳斯 61
+珠母 61
+电子元件 61
+疆场 61
+病患者 61
+瘦肉 61
+百分之九 61
+盛极一时 61
+目击者 61
+相若 61
+相赠 61
+知悉 61
+知识面 61
+硬汉 61
+碘盐 61
+社会党人 61
+神采奕奕 61
+科摩罗 61
+科罗拉多 61
+移情 61
+空调器 61
+竞技场 61
+童谣 61
+第一国际 61
+第七条 61
+第二句 61
+第十个 61
+答题卡 61
+管壁 61
+篡权 61
+米粥 61
+粗粗 61
+精算师 61
+紧俏 61
+红帅 61
+纬纱 61
+纶 61
+组成员 61
+经济系 61
+绝好 61
+绵长 61
+网校 61
+羊脂 61
+老朱 61
+耶夫斯基 61
+肚脐 61
+脾脏 61
+腐坏 61
+臃 61
+自负盈亏 61
+苣 61
+英德 61
+荒滩 61
+虚妄 61
+蛋白尿 61
+蝴蝶谷 61
+融汇 61
+血细胞 61
+补品 61
+褚红柳 61
+订正 61
+订约 61
+设伏 61
+诗坛 61
+调酒师 61
+谈瀛洲 61
+谢大侠 61
+财神 61
+贪得无厌 61
+赞歌 61
+赤色 61
+超载 61
+身姿 61
+车主 61
+轰动一时 61
+轴突 61
+辞世 61
+辽南 61
+运动量 61
+连衣裙 61
+通顺 61
+遭难 61
+遵纪守法 61
+邹家华 61
+郁金香 61
+重见天日 61
+量子化学 61
+金丝燕 61
+金红石 61
+金鑫 61
+钻营 61
+铃铛 61
+银杏树 61
+银根 61
+银花 61
+银锭 61
+锻压 61
+闹钟 61
+阿拉伯联合酋长国 61
+阿斗 61
+阿琪 61
+附则 61
+附睾 61
+陈诚 61
+陪侍 61
+隔声 61
+霍华德 61
+非分 61
+靠背 61
+鞠躬尽瘁 61
+颐指气使 61
+颜宗弼 61
+颦 61
+骁 61
+高原区 61
+魃 61
+鸟雀 61
+鸡尾酒 61
+鹭 61
+黄光裕 61
+一丝不挂 60
+一刀两断 60
+一尾 60
+七八成 60
+七尺 60
+三十余年 60
+三官庙 60
+三本 60
+上代 60
+上手 60
+上火 60
+上西天 60
+下世纪 60
+不像话 60
+不周 60
+东阳 60
+中国科协 60
+中山装 60
+中心区 60
+中直 60
+丰富多采 60
+为数不少 60
+主动脉 60
+九十年代 60
+买断 60
+二十四节气 60
+云岭 60
+云豹 60
+亚尔 60
+人人皆知 60
+付清 60
+令狐兄 60
+任情 60
+会费 60
+伯爷 60
+伲 60
+位次 60
+佛事 60
+供桌 60
+保护法 60
+值得一提 60
+偏执 60
+停运 60
+健全人 60
+偷人 60
+偷鸡摸狗 60
+傈僳族 60
+光谱学 60
+克尔 60
+兜售 60
+八次 60
+关汉卿 60
+军事学术 60
+军地 60
+农工商 60
+冲程 60
+净额 60
+准入 60
+几十倍 60
+几米 60
+刚玉 60
+创举 60
+创新性 60
+前功尽弃 60
+剽窃 60
+劳动改造 60
+勾股定理 60
+北师大 60
+十三道 60
+十五届 60
+千头万绪 60
+千山 60
+千岁 60
+千载 60
+千龙网 60
+华清池 60
+协和医院 60
+占去 60
+卢氏 60
+卵泡 60
+原形 60
+县份 60
+反应器 60
+发条 60
+发还 60
+取款 60
+变电所 60
+可塑性 60
+叶卡捷 60
+吃大亏 60
+合格者 60
+吋 60
+名满天下 60
+吵嘴 60
+吸进 60
+周宣王 60
+和慧梅 60
+哮喘病 60
+唯物辩证法 60
+商号 60
+啸桐 60
+啿 60
+喷涂 60
+嗪 60
+圣手 60
+地核 60
+坐等 60
+坚定性 60
+垏 60
+垫付 60
+基本矛盾 60
+塔克拉玛干沙漠 60
+塔城 60
+声浪 60
+多块 60
+大大咧咧 60
+大棒 60
+大段 60
+大清河 60
+天然林 60
+太阳城 60
+头状花序 60
+夸口 60
+奉化 60
+奥林匹亚 60
+女娃 60
+如上所述 60
+如前所述 60
+如意算盘 60
+妇道人家 60
+妙用 60
+始料不及 60
+姚广孝 60
+姬晓峰 60
+婚外情 60
+嬷 60
+子民 60
+孕激素 60
+孙乾 60
+孙坚 60
+宅第 60
+安锁子 60
+害病 60
+家兄 60
+宽宏大量 60
+射流 60
+小商 60
+尔佳 60
+尔朱 60
+尼米兹 60
+岩石学 60
+州牧 60
+工质 60
+巴德 60
+市场准入 60
+席面 60
+幅员 60
+干瘪 60
+平易近人 60
+广开 60
+应召 60
+底本 60
+建业队 60
+建言 60
+开春 60
+引文 60
+张学文 60
+弶 60
+当事 60
+彗 60
+徐悲鸿 60
+徐泰来 60
+德国社会民主党 60
+心急火燎 60
+心身 60
+忏 60
+忠诚度 60
+怛 60
+思来想去 60
+怡红院 60
+情有独钟 60
+情致 60
+感觉器官 60
+戍守 60
+戏耍 60
+戏谑 60
+成数 60
+戗 60
+战斗舰 60
+所罗门 60
+扫黄 60
+扬威 60
+技术改造 60
+抚恤金 60
+报务员 60
+报告团 60
+担待 60
+拉斐尔 60
+拍子 60
+拘役 60
+招亲 60
+捷利 60
+探询 60
+接骨 60
+摆平 60
+撂下 60
+擢升 60
+改头换面 60
+效命 60
+救起 60
+整军 60
+文泰 60
+无底洞 60
+旧地 60
+明信片 60
+易燃 60
+春日 60
+晕厥 60
+暐 60
+暨南大学 60
+曾几何时 60
+机不可失 60
+杂事 60
+李商隐 60
+李宁 60
+李小芽 60
+杨溢之 60
+枇 60
+枈 60
+架子车 60
+柔美 60
+查勘 60
+柳江 60
+桃李 60
+梦魇 60
+椰岛 60
+楦 60
+樉 60
+横财 60
+正襟危坐 60
+毒雾 60
+比丘 60
+民主制 60
+氮化合物 60
+汉景帝 60
+汤水 60
+汾阳 60
+油纸 60
+泉州市 60
+法场 60
+泠 60
+洪老帮 60
+洪青 60
+流行歌曲 60
+海陆空 60
+海鸟 60
+涂改 60
+涠 60
+润泽 60
+淄 60
+淅 60
+淮扬 60
+淮海路 60
+湘乡 60
+溆 60
+滑轮 60
+热效应 60
+爱斯基摩 60
+特例 60
+特指 60
+犒赏 60
+犯病 60
+狠劲 60
+猪肝 60
+玄烨 60
+玉门关 60
+王猛 60
+王维 60
+珶 60
+理论界 60
+理赔 60
+甚微 60
+生丝 60
+田径运动 60
+田海民 60
+申请者 60
+略作 60
+病榻 60
+症候 60
+瘴气 60
+百无聊赖 60
+盐矿 60
+监视器 60
+盘山 60
+省军区 60
+真菌性 60
+眬 60
+硬撑 60
+碍手碍脚 60
+磁场强度 60
+示范性 60
+祛风 60
+神秘莫测 60
+福尔马林 60
+禹贡 60
+私房 60
+科技人员 60
+秦氏 60
+程控 60
+立竿见影 60
+端午 60
+竹编 60
+笔砚 60
+第一枪 60
+第二十章 60
+第十九路 60
+第聂伯河 60
+等值 60
+箴言 60
+粓 60
+粗心大意 60
+精打细算 60
+繎 60
+红柳 60
+红灯区 60
+红玉 60
+红领巾 60
+练剑 60
+经济学说 60
+经纬 60
+统一体 60
+维管束 60
+缺位 60
+罗汉堂 60
+羊角 60
+老克腊 60
+老秀才 60
+老范 60
+职守 60
+聚拢 60
+肉芽肿 60
+胃部 60
+胆管 60
+背弃 60
+胸臆 60
+脱稿 60
+腊八 60
+腹心 60
+腿骨 60
+臧霸 60
+自欺欺人 60
+自知之明 60
+自问 60
+舍生忘死 60
+舍甫琴科 60
+舱口 60
+苦果 60
+茶汤 60
+茶点 60
+莱特 60
+营部 60
+萨哈夫 60
+蓖麻 60
+虚寒 60
+蚍 60
+蛊惑 60
+蛮不讲理 60
+蟀 60
+装傻 60
+褥子 60
+襕 60
+西贡 60
+西辽 60
+覆亡 60
+警备区 60
+警戒线 60
+认罪 60
+译名 60
+该县 60
+谓语 60
+谢安 60
+谦虚谨慎 60
+豪族 60
+财产权 60
+败诉 60
+赃物 60
+超重 60
+越州 60
+足踝 60
+跎 60
+跗 60
+跣 60
+踟蹰 60
+车裂 60
+转岗 60
+转轮 60
+边缘化 60
+还原性 60
+这方 60
+逋 60
+逑 60
+遗恨 60
+遗民 60
+遵奉 60
+遵行 60
+避孕套 60
+醋意 60
+里奇 60
+金蛇剑 60
+金银花 60
+鑶 60
+铁笼 60
+银山 60
+镜泊湖 60
+门锁 60
+间冰期 60
+闹别扭 60
+闹革命 60
+闹鬼 60
+阴魂不散 60
+阿卜杜拉 60
+陆军航空兵 60
+陆海军 60
+陈公博 60
+陈永明 60
+陪都 60
+难缠 60
+难言之隐 60
+雄鹰 60
+雄黄 60
+雨露 60
+霍去病 60
+霰 60
+青紫 60
+青鸟 60
+题字 60
+饭钱 60
+驼峰 60
+高不可攀 60
+鲜食 60
+鸟巢 60
+黄蓉叹 60
+鼓劲 60
+一事无成 59
+一日三餐 59
+丁夫人 59
+三井 59
+三员 59
+三资企业 59
+上告 59
+上周末 59
+上呼吸道 59
+下半夜 59
+下线 59
+下船 59
+不堪重负 59
+不拘一格 59
+专责 59
+专集 59
+两千多 59
+两团 59
+两寸 59
+中国化 59
+临川 59
+临洮 59
+丹东市 59
+主谋 59
+乌力 59
+乐寿堂 59
+九九 59
+习作 59
+乱兵 59
+二三十年 59
+二亩 59
+二连 59
+五卷 59
+产下 59
+介绍信 59
+从小儿 59
+休息日 59
+众叛亲离 59
+佃权 59
+低三下四 59
+侧向 59
+保命 59
+保险柜 59
+信号弹 59
+倚靠 59
+假托 59
+健旺 59
+儗 59
+先端 59
+党卫军 59
+党史 59
+党纲 59
+入会 59
+八十岁 59
+公允 59
+兵源 59
+兵符 59
+军事史 59
+冷遇 59
+凌霄 59
+几轮 59
+出口值 59
+刀伤 59
+分庭抗礼 59
+刘安 59
+刚度 59
+创利 59
+判决书 59
+别家 59
+别离 59
+别见怪 59
+前所 59
+前生 59
+劳动所 59
+北温带 59
+北镇 59
+医德 59
+十三家 59
+十六大 59
+十字形 59
+千个 59
+华罗庚 59
+单糖 59
+南线 59
+占有率 59
+参悟 59
+反光 59
+古色古香 59
+古训 59
+只读 59
+叫板 59
+召募 59
+史保林 59
+后继者 59
+吕维祺 59
+吴孟明 59
+吴昌硕 59
+呫 59
+唐伯虎 59
+唯唯诺诺 59
+喉舌 59
+噾 59
+四五十 59
+四十里 59
+四轮 59
+回教 59
+回望 59
+国家图书馆 59
+国民政府军事委员会 59
+图瓦卢 59
+圆弧 59
+地摊 59
+地界 59
+坚城 59
+坚韧不拔 59
+坝体 59
+坟丘 59
+垯 59
+处女作 59
+夏布 59
+多万吨 59
+多斯 59
+多极 59
+多首 59
+大慈大悲 59
+大棚 59
+大陆坡 59
+大限度 59
+天呀 59
+天幕 59
+天顶 59
+太宰 59
+夷为平地 59
+奂 59
+奥尔梅克 59
+奥维尔斯 59
+奸夫 59
+如鱼得水 59
+妙手 59
+姜春云 59
+威士忌 59
+婆罗门教 59
+嫩芽 59
+孤军奋战 59
+宇宙学 59
+安源 59
+宗喀巴 59
+定居点 59
+密苏里 59
+寒武 59
+将计就计 59
+小号 59
+小湖 59
+小点 59
+尚贤 59
+尰 59
+山鹰 59
+岁末 59
+岑 59
+岳贞黎 59
+左右两 59
+巧巧 59
+希图 59
+广告商 59
+庄稼地 59
+应交 59
+底片 59
+开曼 59
+张宏 59
+张阁 59
+徨 59
+心弦 59
+忽明忽暗 59
+怀上 59
+怀表 59
+怔忡 59
+情态 59
+慈母 59
+慢走 59
+懔 59
+战幕 59
+所向无敌 59
+扬子鳄 59
+承建 59
+抛撒 59
+拉尔森 59
+挖土 59
+挥毫 59
+换班 59
+排比 59
+接球 59
+接触面 59
+携带者 59
+携程 59
+摩擦力 59
+敌强我弱 59
+整队 59
+文件系统 59
+新创 59
+新干线 59
+新英格兰 59
+无孔不入 59
+无私奉献 59
+日圆 59
+显像管 59
+普通法 59
+暖洋洋 59
+曲曲折折 59
+曹钦 59
+朱常洛 59
+机宜 59
+机顶盒 59
+杀人犯 59
+权奸 59
+李文 59
+李昆 59
+李福 59
+村村 59
+杨国忠 59
+杨廷和 59
+核军备 59
+核糖 59
+核能力 59
+桂林市 59
+桓温 59
+梢公 59
+梭子鱼 59
+椰干 59
+橄榄石 59
+次生林 59
+殆 59
+殚精竭虑 59
+比做 59
+毫发 59
+民愤 59
+气不过 59
+水磨 59
+水色 59
+永州 59
+沙盘 59
+沙质 59
+法力 59
+泡桐 59
+波特 59
+泰州市 59
+洛里亚 59
+浊流 59
+海狼 59
+海空军 59
+海选 59
+淠 59
+淯 59
+深水炸弹 59
+清客 59
+清洁剂 59
+渔翁 59
+渗漏 59
+温方义 59
+滑膛 59
+潭水 59
+澈 59
+灵武 59
+煞费苦心 59
+照抄 59
+熄火 59
+熏染 59
+片剂 59
+牛群 59
+牸 59
+狗东西 59
+玄幻情 59
+玉佩 59
+珐 59
+瑕疵 59
+瑶池 59
+璈 59
+瓶口 59
+甜瓜 59
+生水 59
+田桂花 59
+田福 59
+甲方 59
+电冰箱 59
+白马寺 59
+百万美元 59
+皱褶 59
+盗匪 59
+盗掘 59
+着重点 59
+石片 59
+破釜沉舟 59
+硅藻 59
+碎尸万段 59
+碰碰 59
+社会民主工党 59
+票数 59
+禅寺 59
+禅让 59
+积德 59
+称病 59
+立体声 59
+端端正正 59
+笑傲 59
+第一等 59
+粘贴 59
+糊糊 59
+素菜 59
+縻 59
+红学 59
+纺纱机 59
+组织生活 59
+细菌性 59
+结点 59
+络合物 59
+统合 59
+续编 59
+续聘 59
+编委会 59
+编审 59
+翻船 59
+老冯 59
+耘 59
+聊聊天 59
+联络员 59
+胡斐见 59
+胡永合 59
+脸上无光 59
+腕足 59
+腰肢 59
+腹腔镜 59
+膘 59
+自留地 59
+舃 59
+舥 59
+舻 59
+良人 59
+芫 59
+英国牛津大学 59
+茅盾文学奖 59
+草图 59
+药监局 59
+莫若 59
+落脚点 59
+董福祥 59
+蒸蒸日上 59
+薰染 59
+藏家 59
+蛇毒 59
+融会 59
+蠢事 59
+行商 59
+表面积 59
+装具 59
+装神弄鬼 59
+装聋作哑 59
+西南联大 59
+西城区 59
+西头 59
+视界 59
+训诫 59
+诀别 59
+诸军 59
+谭望嵩 59
+负极 59
+财经类 59
+质料 59
+购票 59
+赌徒 59
+赎买 59
+超短裙 59
+超跌 59
+跶 59
+躯壳 59
+转卖 59
+软瘫 59
+载流子 59
+迅疾 59
+运算器 59
+违心 59
+迪南 59
+迷住 59
+迷误 59
+速滑 59
+逢迎 59
+邋遢 59
+那小丐 59
+郑智 59
+郭守敬 59
+醋酸 59
+重商主义 59
+重庆队 59
+鏊 59
+钳子 59
+铊 59
+银监会 59
+闼 59
+阮圆海 59
+霍普金斯大学 59
+青丝 59
+青工 59
+靖康 59
+面生 59
+面谕 59
+韩家 59
+韩江 59
+顺其自然 59
+食糖 59
+饭时 59
+首付 59
+马德拉斯 59
+骨科 59
+高二 59
+高等级 59
+鲍文 59
+鲷 59
+鸡皮疙瘩 59
+麦其 59
+黑痣 59
+龙爪 59
+龙虎 59
+一丝丝 58
+一反常态 58
+一往无前 58
+一条心 58
+七彩 58
+七手八脚 58
+万不得已 58
+上下左右 58
+上坡 58
+上桌 58
+上联 58
+下嫁 58
+不依不饶 58
+不厌其烦 58
+不费吹灰之力 58
+不辞而别 58
+专科生 58
+严云京 58
+中国棋院 58
+中子星 58
+中小学校 58
+中纬度 58
+中远 58
+久别重逢 58
+乐呵呵 58
+乡党 58
+乱纷纷 58
+事务部 58
+五十名 58
+五号 58
+五味子 58
+亲族 58
+人之常情 58
+人意 58
+人贩子 58
+仁义道德 58
+仁爱 58
+今夏 58
+今生今世 58
+令人瞩目 58
+仪清 58
+优选 58
+体位 58
+余所 58
+佲 58
+使徒 58
+例行公事 58
+保健食品 58
+保安厅 58
+保育 58
+俶 58
+倒灌 58
+倒置 58
+做戏 58
+僵死 58
+先见 58
+八门 58
+兰溪 58
+关上门 58
+其职 58
+兼收 58
+写实主义 58
+军阵 58
+农垦 58
+冯紫英 58
+冲断 58
+凉茶 58
+出尔反尔 58
+切夫斯基 58
+刘泽清 58
+初选 58
+制药厂 58
+北方地区 58
+医家 58
+十丈 58
+午觉 58
+华南地区 58
+华约 58
+华阳 58
+单眼 58
+南满 58
+博览群书 58
+印刷品 58
+印第安语 58
+原原本本 58
+双枪 58
+反唇相讥 58
+反贪局 58
+发明人 58
+发烟 58
+受托 58
+叙旧 58
+古木 58
+可燃 58
+史道邻 58
+叶景林 58
+同流合污 58
+名宿 58
+向杨 58
+吴道通 58
+告成 58
+周山 58
+唇舌 58
+售卖 58
+唯物主义者 58
+商品率 58
+善罢甘休 58
+喷雾 58
+嗓子眼 58
+四十万 58
+四十八年 58
+团扇 58
+国家文物局 58
+国家银行 58
+国恩 58
+圆环 58
+圣女 58
+坏帐 58
+块儿 58
+垝 58
+复印机 58
+夏秋季 58
+夏衍 58
+多克 58
+多管闲事 58
+夜校 58
+夜生活 58
+夜郎 58
+大坑 58
+大寺 58
+大木 58
+大西 58
+大韩 58
+夫斯 58
+失陪 58
+奇书 58
+奉还 58
+奥斯特 58
+好找 58
+妙策 58
+姮 58
+婉约 58
+安大娘 58
+安纳 58
+完形 58
+实名 58
+宣慰 58
+宽宏 58
+宽窄 58
+宿舍楼 58
+小型化 58
+小魏 58
+尖锐化 58
+尼亚加拉 58
+尼采 58
+尾端 58
+山雨 58
+岔道 58
+岷山 58
+崈 58
+工业界 58
+工作制 58
+工商部门 58
+市舶司 58
+帕提亚 58
+帛书 58
+年终奖 58
+并拢 58
+幸会 58
+幽冥 58
+库兹涅佐娃 58
+庙号 58
+廉耻 58
+张倩 58
+归二娘 58
+录取线 58
+影剧院 58
+徐凯 58
+德政 58
+忠君 58
+快刀 58
+忻 58
+怀恨在心 58
+恩准 58
+恰逢 58
+悌 58
+情性 58
+惊心 58
+惊起 58
+想定 58
+成品油 58
+成材 58
+所在国 58
+所感 58
+承受力 58
+抑扬顿挫 58
+拉登 58
+拉里 58
+拫 58
+拱卫 58
+指环 58
+指称 58
+排定 58
+推土 58
+推戴 58
+推车 58
+描述符 58
+揶揄 58
+撕碎 58
+支配权 58
+敌舰 58
+敲敲 58
+断代史 58
+断头 58
+新华书店 58
+新喀里多尼亚 58
+新派 58
+旅游业界 58
+无悔 58
+无瑕 58
+日积月累 58
+旱作 58
+明廷 58
+易怒 58
+春播 58
+暗合 58
+曘 58
+曲靖 58
+有理数 58
+有轨 58
+本源 58
+杌 58
+李云 58
+李彦 58
+李锐 58
+村边 58
+来生 58
+杨镐 58
+板材 58
+极右 58
+枕木 58
+林白 58
+果戈理 58
+柯尔克孜族 58
+校风 58
+检测器 58
+楣 58
+櫎 58
+欺人太甚 58
+欺君 58
+歌诀 58
+气性 58
+氰 58
+水患 58
+水淹 58
+永兴岛 58
+求胜 58
+汉献帝 58
+江主席 58
+河曲 58
+河狸 58
+油坊 58
+泉涌 58
+泥沼 58
+泼墨 58
+泾河 58
+洸 58
+活性剂 58
+活检 58
+浪头 58
+海星 58
+消耗量 58
+涨跌 58
+深谙 58
+清晰可见 58
+湃 58
+湖光山色 58
+溏 58
+瀛台 58
+火冒三丈 58
+火力发电 58
+火并 58
+灰濛濛 58
+灰蒙蒙 58
+灰飞烟灭 58
+灼伤 58
+热水瓶 58
+焱 58
+煤油灯 58
+牛粪 58
+牧牛 58
+特拉维夫 58
+犯罪率 58
+独当一面 58
+独白 58
+独立团 58
+猗 58
+王元霸 58
+王宝山 58
+球类 58
+理学院 58
+生存期 58
+电磁铁 58
+疋 58
+疟 58
+白熊 58
+白马山 58
+百胜 58
+盘庚 58
+目眩 58
+盲从 58
+看报 58
+真意 58
+眼尖 58
+眼皮子 58
+知识经济 58
+砚台 58
+硝酸银 58
+硫酸铜 58
+示范点 58
+神怪 58
+神甫 58
+神魂 58
+祥瑞 58
+福布斯 58
+科学史 58
+秘传 58
+秦绢 58
+秩序井然 58
+稀土元素 58
+程普 58
+稗 58
+穸 58
+空军一号 58
+空落落 58
+空车 58
+窦婴 58
+窨 58
+立夏 58
+章帝 58
+端阳节 58
+竹篓 58
+第三系 58
+第十七章 58
+筟 58
+粤剧 58
+糖精 58
+索夫 58
+纠察 58
+红棉 58
+红烧肉 58
+纱帽 58
+纸牌 58
+经验主义 58
+绫罗 58
+缓兵之计 58
+编年体 58
+罗海 58
+罗纳尔多 58
+羁绊 58
+考务 58
+耗竭 58
+耳垂 58
+联合王国 58
+肺水肿 58
+肾虚 58
+胆道 58
+胡言 58
+胡辉 58
+能歌善舞 58
+脏话 58
+脱氧核糖核酸 58
+腾出 58
+自惭形秽 58
+节奏感 58
+芥蒂 58
+花岗 58
+花柱 58
+花眼 58
+苦劝 58
+苦熬 58
+苯乙烯 58
+英国剑桥大学 58
+荔 58
+荣八耻 58
+莆田 58
+莸 58
+菜园子 58
+菜花 58
+蔚蓝 58
+薄一波 58
+藏药 58
+虎子 58
+蜀地 58
+衄 58
+补缀 58
+袖筒 58
+西北面 58
+西岳 58
+西嶽 58
+解析几何 58
+誊 58
+认购证 58
+记入 58
+论文集 58
+设身处地 58
+识时务 58
+该厂 58
+谨严 58
+贫民窟 58
+贺信 58
+资料室 58
+赋闲 58
+赤身裸体 58
+超自然 58
+趣味性 58
+车床 58
+过氧化氢 58
+进藏 58
+迭起 58
+追认 58
+选择地 58
+透光 58
+通量 58
+造孽 58
+郁郁寡欢 58
+部副 58
+配药 58
+野物 58
+金刚经 58
+錞 58
+鍡 58
+钻头 58
+铁质 58
+铸件 58
+错划 58
+镒 58
+镣铐 58
+长眠 58
+长风 58
+闷棍 58
+阎敬铭 58
+阴宅 58
+阴沟 58
+陂 58
+限令 58
+陡崖 58
+院方 58
+随机数 58
+隐痛 58
+雅砻江 58
+零时 58
+雷蒙 58
+靓 58
+非分之想 58
+靥 58
+韩宇 58
+韩揖 58
+韶 58
+顾君恩 58
+预祝 58
+频谱 58
+飞雪 58
+首局 58
+驶来 58
+骨器 58
+鲍威尔 58
+鲜鱼 58
+鳞甲 58
+黄金海岸 58
+一知半解 57
+三四百 57
+三皇 57
+三联书店 57
+上达 57
+下联 57
+下颌骨 57
+不一而足 57
+不闻不问 57
+专稿 57
+东方人 57
+中国人民外交学会 57
+中职 57
+中英文 57
+中队长 57
+举一反三 57
+久负盛名 57
+书坊 57
+二部 57
+云天 57
+五湖四海 57
+五轮 57
+亚丁 57
+亚当斯 57
+亚洲杯 57
+交办 57
+人才观 57
+令人羡慕 57
+价码 57
+伙食费 57
+会社 57
+伟晶岩 57
+伽马 57
+低声下气 57
+低能 57
+体恤 57
+余件 57
+作假 57
+作威作福 57
+俎 57
+保质期 57
+停职 57
+偷走 57
+充溢 57
+光光 57
+克尔特 57
+党八股 57
+全貌 57
+八卦掌 57
+八只 57
+八家 57
+公意 57
+公证处 57
+共同语言 57
+共度 57
+共青团员 57
+关系式 57
+兴庆 57
+兵勇 57
+军事化 57
+军号 57
+冥冥中 57
+冷饮 57
+减振器 57
+几架 57
+凡尔登 57
+凤冠 57
+凯末尔 57
+分站 57
+刘易斯 57
+刚烈 57
+别集 57
+加宽 57
+加高 57
+励磁 57
+劳动节 57
+北向南 57
+千家 57
+半壁江山 57
+半期 57
+华赫艮 57
+协约 57
+印刷机 57
+印象派 57
+厌弃 57
+原子结构 57
+原汁原味 57
+双发 57
+双翼 57
+反腐倡廉 57
+发热量 57
+变速 57
+叫苦不迭 57
+台湾大学 57
+叶亦心 57
+号啕大哭 57
+号衣 57
+同江 57
+名实 57
+吐丝 57
+吝惜 57
+含羞 57
+吹打 57
+呒 57
+呲 57
+咱村 57
+哥俩 57
+哨所 57
+商路 57
+喌 57
+喾 57
+嘞 57
+囚车 57
+四书 57
+回溯 57
+困难重重 57
+图景 57
+土方 57
+地税 57
+坩埚 57
+垨 57
+基洛 57
+外运 57
+大专生 57
+大人物 57
+大别山区 57
+大难临头 57
+太君 57
+夫君 57
+头天 57
+夹袄 57
+夺魁 57
+奥克兰 57
+奥兰多 57
+如春 57
+委曲求全 57
+威德 57
+学长 57
+孬 57
+完事 57
+宜必思 57
+宝地 57
+宝洁 57
+宫前 57
+容姑 57
+小舅子 57
+小蒙 57
+尾闾 57
+屸 57
+峥嵘 57
+崎 57
+川军 57
+差劲 57
+希望工程 57
+帓 57
+帝师 57
+干电池 57
+年关 57
+并驾齐驱 57
+幸福感 57
+庆元 57
+开打 57
+引号 57
+引退 57
+张关羽 57
+张若 57
+强酸 57
+归罪 57
+形式化 57
+徐家汇 57
+得病 57
+徜徉 57
+心肌梗塞 57
+总纲 57
+恨死 57
+恩仇 57
+恩泽 57
+恶棍 57
+情话 57
+惠英嫂 57
+意外险 57
+懒觉 57
+成灾 57
+成说 57
+戴震 57
+房主 57
+房玄龄 57
+扈 57
+扑克牌 57
+打更 57
+打火 57
+投其所好 57
+抗倭 57
+抚琴 57
+抢手 57
+拉普拉塔 57
+拍品 57
+拭泪 57
+挑拨离间 57
+换能器 57
+控制技术 57
+推搡 57
+摆摊 57
+摩天大楼 57
+数据结构 57
+数条 57
+敲诈勒索 57
+敲锣打鼓 57
+文武双全 57
+斜长石 57
+断案 57
+方队 57
+无价之宝 57
+无所顾忌 57
+昙花一现 57
+星族 57
+普特 57
+普里 57
+智囊 57
+暹 57
+曹植 57
+曼璐道 57
+曾祖 57
+有损于 57
+未始 57
+朴茨茅斯 57
+李大哥 57
+杞人忧天 57
+来稿 57
+杭嘉湖 57
+林青 57
+枪杆子 57
+核辐射 57
+梳妆台 57
+棉织品 57
+槻 57
+欢声 57
+欢送 57
+正负 57
+正邪 57
+武夷 57
+武学之 57
+每况愈下 57
+气垫 57
+水闸 57
+污蔑 57
+汤显祖 57
+沃顿 57
+法会 57
+泡影 57
+派遣军 57
+海伦 57
+海北 57
+海斯 57
+消弭 57
+消息报 57
+混饭吃 57
+湘鄂赣 57
+满洲国 57
+演奏者 57
+漳河 57
+烦琐 57
+牬 57
+特尔斯 57
+狭路相逢 57
+狼心狗肺 57
+猫眼 57
+献殷勤 57
+玉杯 57
+瓟 57
+生育期 57
+生路 57
+用书 57
+田某 57
+画图 57
+画笔 57
+留宿 57
+畜产 57
+病患 57
+相承 57
+真值 57
+瞾 57
+知遇之恩 57
+石台 57
+矿化 57
+砌墙 57
+破冰 57
+砸碎 57
+硬质 57
+票面 57
+私盐 57
+童猛 57
+童百熊 57
+笄 57
+笑靥 57
+笔调 57
+第一回 57
+第三招 57
+简述 57
+管委会 57
+粥样 57
+素问 57
+紦 57
+紫砂 57
+緥 57
+纪检委 57
+细语 57
+经合 57
+经堂 57
+绚丽多彩 57
+维拉 57
+绵竹 57
+羌塘 57
+肝胆相照 57
+胆大包天 57
+能谱 57
+脊索 57
+脑组织 57
+脚镣 57
+腥味 57
+致癌物 57
+舱室 57
+艺名 57
+花枝招展 57
+苛捐杂税 57
+若隐若现 57
+英模 57
+茁壮 57
+范一飞 57
+药草 57
+营养学 57
+蒺藜 57
+藤条 57
+虚脱 57
+蛄 57
+蛮干 57
+蜱 57
+蜹 57
+螈 57
+血流成河 57
+行为人 57
+行气 57
+衣被 57
+补助金 57
+袒露 57
+裾 57
+西乡 57
+西关 57
+观者 57
+视死如归 57
+证明书 57
+评剧 57
+诚心诚意 57
+诱敌深入 57
+课后 57
+调阅 57
+贞操 57
+财长 57
+资源共享 57
+赵昭 57
+软件产品 57
+辐射能 57
+辩证逻辑 57
+过滤器 57
+过载 57
+迈克 57
+近藤 57
+这点儿 57
+进取心 57
+选修课 57
+逢凶化吉 57
+道经 57
+遗训 57
+邀集 57
+邦联 57
+邪魔 57
+邪魔外道 57
+邮电部 57
+邻村 57
+郑科伟 57
+酒窝 57
+重开 57
+野战炮 57
+金属元素 57
+金銮殿 57
+钇 57
+钌 57
+铁水 57
+银川市 57
+锅灶 57
+长裤 57
+间不容发 57
+阻遏 57
+阿宝 57
+阿尔萨斯 57
+阿青 57
+险象环生 57
+陪审团 57
+陪客 57
+陶俑 57
+陶行知 57
+雄才大略 57
+霁 57
+鞹 57
+项英 57
+颓丧 57
+颜烈 57
+风骨 57
+马克斯 57
+骄纵 57
+髂 57
+高专 57
+高帽 57
+高清 57
+高风险 57
+鸡笼 57
+鸷 57
+黄公略 57
+黄尘 57
+黄继光 57
+黑狗 57
+鼻头 57
+一个身 56
+一家老小 56
+一技之长 56
+一气之下 56
+一脉相承 56
+一言难尽 56
+一霎 56
+七窍 56
+三十七年 56
+三心二意 56
+上虞 56
+上规模 56
+下位 56
+下半身 56
+不可理喻 56
+与世无争 56
+东非大裂谷 56
+两难 56
+中下层 56
+中东部 56
+中央气象台 56
+中小城市 56
+中山陵 56
+中平 56
+中科大 56
+中脑 56
+义务教育法 56
+乐律 56
+乘风 56
+二一五 56
+二处 56
+于是乎 56
+五成 56
+人满为患 56
+人龙 56
+仇鸾 56
+令兄 56
+以法 56
+仲裁员 56
+伊尹 56
+位尊 56
+佗 56
+余杭 56
+作战部 56
+侵染 56
+修史 56
+偏食 56
+元古代 56
+入驻 56
+公共汽车 56
+公开化 56
+内政部长 56
+军刀 56
+几份 56
+几次三番 56
+几首 56
+凯旋门 56
+刘昌蓉 56
+刘歆 56
+刚健 56
+刮痧 56
+制海权 56
+剃度 56
+前襟 56
+剑阁 56
+务使 56
+劫夺 56
+劳逸结合 56
+劳顿 56
+北京队 56
+北斗七星 56
+十五个 56
+十元 56
+十六名 56
+千秋万载 56
+升班马 56
+单树模 56
+单线 56
+南特 56
+去往 56
+县太爷 56
+变化率 56
+变调 56
+古塔 56
+可嘉 56
+史文恭 56
+各业 56
+合抱 56
+同病相怜 56
+同舟共济 56
+同质化 56
+后患无穷 56
+吐纳 56
+吕阁 56
+含冤 56
+吴侯 56
+吹捧 56
+告负 56
+命妇 56
+咏叹 56
+咛 56
+咩 56
+咸阳市 56
+哀愁 56
+哈尔科夫 56
+唐山市 56
+唐才常 56
+唐末 56
+唱曲 56
+嗷嗷 56
+四十一年 56
+四成 56
+四派 56
+四至 56
+国税局 56
+图巴 56
+土生 56
+土石 56
+地质部 56
+地铁站 56
+坑害 56
+坝顶 56
+垂直扫描频率 56
+基丹 56
+基因组 56
+复职 56
+复赛 56
+外敷 56
+大政方针 56
+大无畏 56
+大限 56
+大饼 56
+天主 56
+天极 56
+天津大学 56
+太平盛世 56
+套曲 56
+子午线 56
+子皇帝 56
+字义 56
+宁周义 56
+宇称 56
+官衙 56
+宙 56
+宜居 56
+宜阳 56
+客船 56
+小户 56
+小渊 56
+小美 56
+少卿 56
+尖头 56
+山梨酸钾 56
+岛礁 56
+巡礼 56
+巡逻机 56
+市立 56
+帐户卡 56
+平躺 56
+幷 56
+应用型 56
+廖思源 56
+建中 56
+引黄 56
+弦乐器 56
+归途 56
+徐石 56
+得愈 56
+御医 56
+德罗 56
+心术 56
+心火 56
+恁般 56
+恶徒 56
+悬梁 56
+悲戚 56
+悲欢离合 56
+情商 56
+情面 56
+慢跑 56
+憷 56
+成飞 56
+成龙 56
+戴安娜 56
+手扶 56
+扑救 56
+打家劫舍 56
+打翻 56
+扶老携幼 56
+技校 56
+折叠式 56
+护卫队 56
+抹掉 56
+挂出 56
+指名 56
+挈 56
+挟带 56
+挨个 56
+挨家挨户 56
+授职 56
+接收站 56
+提干 56
+插值 56
+插座 56
+搭桥 56
+撑开 56
+撒旦 56
+撞入 56
+擦伤 56
+改性 56
+政教合一 56
+救济金 56
+散伙 56
+散夥 56
+数名 56
+敺 56
+文化厅 56
+文化课 56
+文字狱 56
+斑块 56
+斯塔克 56
+早市 56
+明鉴 56
+昏天黑地 56
+星斗 56
+暂住 56
+暗里 56
+曹爽 56
+朔望 56
+朝南 56
+朝纲 56
+木杆 56
+本草纲目 56
+本行 56
+朴实无华 56
+杀虫 56
+李大人 56
+杜莉 56
+杨旭 56
+柯尼斯堡 56
+核讹诈 56
+桌案 56
+桵 56
+楚长城 56
+樀 56
+模子 56
+欢欢喜喜 56
+正则表达式 56
+武都 56
+死火山 56
+殊途同归 56
+段誉见 56
+毁损 56
+毁约 56
+毒副作用 56
+毛毡 56
+毫不动摇 56
+毫无顾忌 56
+毫米波 56
+氥 56
+水坡集 56
+沙嘴 56
+河马 56
+油层 56
+法制史 56
+法旨 56
+泛舟 56
+波速 56
+注射液 56
+泯灭 56
+洒家 56
+洪大 56
+洪帮主 56
+活化石 56
+活字 56
+流星锤 56
+流窜 56
+浆液 56
+涟漪 56
+淡出 56
+混合型 56
+清洁工 56
+温家 56
+港商 56
+游山玩水 56
+湘鄂川黔 56
+溍 56
+滔 56
+潈 56
+火柱 56
+灵隐 56
+点明 56
+烃类 56
+烟霞 56
+熟透 56
+燕铁木 56
+燕顺 56
+父老乡亲 56
+爽爽快快 56
+特克斯 56
+狡辩 56
+独来独往 56
+玄德大 56
+玉环 56
+王建 56
+珊瑚虫 56
+琎 56
+璭 56
+瓦拉 56
+瓷土 56
+生恐 56
+生物制品 56
+甬 56
+略图 56
+疑窦 56
+瘟神 56
+白搭 56
+白铜 56
+盒饭 56
+真名 56
+眦 56
+眼睫毛 56
+石缝 56
+砍刀 56
+砩 56
+磨光 56
+科伦坡 56
+科班 56
+称心 56
+稳稳地 56
+窝轮 56
+窥见 56
+笔会 56
+第二点 56
+第十七路 56
+筛糠 56
+简括 56
+管仲 56
+箱底 56
+米面 56
+米麪 56
+粒子束 56
+糕饼 56
+红树 56
+纽卡斯尔 56
+线性规划 56
+细密画 56
+经纱 56
+绝活 56
+绣花针 56
+继任者 56
+继往开来 56
+维尔 56
+编委 56
+缺漏 56
+网状结构 56
+置若罔闻 56
+羊献容 56
+老吴 56
+老周 56
+老成 56
+耗材 56
+耪 56
+耶律淳 56
+肝气 56
+脑室 56
+脚后跟 56
+脚板 56
+自作自受 56
+自投罗网 56
+自然观 56
+艉 56
+色光 56
+节食 56
+苯胺 56
+茅坑 56
+菜市场 56
+萦 56
+落花 56
+蕨类 56
+蚂蟥 56
+蚨 56
+蜜源 56
+蜡染 56
+衣兜 56
+西厢 56
+西撒哈拉 56
+覆没 56
+观察使 56
+解忧 56
+计生 56
+认命 56
+论说 56
+试看 56
+贷款人 56
+费率 56
+赘述 56
+赵敏笑 56
+赶早 56
+超高 56
+越儿 56
+趣事 56
+踵 56
+身兼 56
+转换器 56
+轮距 56
+过客 56
+过来人 56
+过火 56
+选拔赛 56
+选秀 56
+通病 56
+通风报信 56
+逞能 56
+酣饮 56
+酱菜 56
+酸甜苦辣 56
+醇和 56
+重印 56
+重排 56
+金灿灿 56
+金顶 56
+锇 56
+长丝 56
+长拳 56
+问路 56
+阈限 56
+防震 56
+阳坡 56
+阿卜杜勒 56
+陆家庄 56
+陆空 56
+陆运 56
+陈妍 56
+随意性 56
+难上加难 56
+青年会 56
+青白 56
+面点 56
+韫 56
+音位 56
+韵文 56
+顺流而下 56
+飘零 56
+飞禽 56
+马勒 56
+马术 56
+高利 56
+高枕无忧 56
+高架 56
+高纬 56
+魏巍史 56
+鲁南 56
+鲍尔默 56
+鲑鱼 56
+鲖 56
+鸡腿 56
+鸿雁 56
+鹬 56
+麦克唐纳 56
+黄道周 56
+黄马褂 56
+黑头 56
+黯 56
+鼠辈 56
+齐王 56
+一维 55
+三趾马 55
+三陪 55
+上榜 55
+下切 55
+下结论 55
+不干不净 55
+不得要领 55
+不独 55
+世族 55
+东南亚地区 55
+东林派 55
+东莞市 55
+中共中央党史研究室 55
+中兴通讯 55
+中观 55
+主根 55
+义旗 55
+书斋 55
+争战 55
+二倍体 55
+云逸 55
+云阳 55
+亚欧大陆 55
+亚洲地区 55
+人本主义 55
+从速 55
+伊朗人 55
+休息区 55
+低档 55
+余条 55
+侵夺 55
+信教 55
+倒伏 55
+做功 55
+催逼 55
+儿童医院 55
+元军 55
+全日 55
+全运会 55
+公田 55
+六味地黄 55
+共聚 55
+兴叹 55
+兴奋性 55
+冗员 55
+军资 55
+军车 55
+农妇 55
+冰点 55
+几夜 55
+几秒 55
+出格 55
+刀头 55
+刑名 55
+刘培生 55
+刘渊 55
+到访 55
+前车之鉴 55
+加时赛 55
+务工人员 55
+劣种 55
+动手动脚 55
+助残 55
+勤政 55
+北京国安队 55
+十一大 55
+十万八千里 55
+半分钟 55
+单行本 55
+占居 55
+占领军 55
+卡拉什尼科夫 55
+厖 55
+厚恩 55
+原版 55
+取用 55
+句法 55
+台资 55
+叶景奎 55
+号段 55
+司马炎 55
+合欢 55
+吉兆 55
+吉布斯 55
+后来者 55
+吴壮达 55
+吴氏 55
+呋 55
+周伯信 55
+命门 55
+和硕 55
+咖啡厅 55
+咣当 55
+哭丧棒 55
+唐高宗 55
+唾手可得 55
+商业街 55
+喀喇昆仑山 55
+喜形于色 55
+嗤之以鼻 55
+嘧啶 55
+噀 55
+四员 55
+四川人 55
+四肢百骸 55
+四部丛刊 55
+回程 55
+国家自然科学奖 55
+国家行政学院 55
+国际原子能机构 55
+图说 55
+圆柱体 55
+圆领 55
+地沟 55
+均值 55
+埋单 55
+城邑 55
+堂会 55
+堂兄 55
+塞上 55
+塞车 55
+声带 55
+外景 55
+多彩 55
+多才多艺 55
+多渠道 55
+大片大片 55
+天灾人祸 55
+头昏 55
+头筹 55
+奉告 55
+姚崇 55
+姬海涛 55
+婆母 55
+孙学武 55
+学分制 55
+学术性 55
+宁城 55
+宗教学 55
+宗社 55
+官商 55
+家族史 55
+密信 55
+小嘎子 55
+小巧玲珑 55
+小朱 55
+小病 55
+尿频 55
+屋前 55
+岳大壮 55
+工业生产 55
+工作服 55
+市话 55
+常客 55
+平顶 55
+底色 55
+建厂 55
+建设银行 55
+张励 55
+张瀚 55
+强子 55
+形而上 55
+徐克 55
+微管 55
+德宗 55
+心力交瘁 55
+心灰意懒 55
+怀古 55
+怄 55
+恶贯满盈 55
+悠远 55
+患得患失 55
+惦念 55
+愁容 55
+愿不愿意 55
+慵懒 55
+戎狄 55
+我行我素 55
+战祸 55
+戛然而止 55
+所以然 55
+手写 55
+打头阵 55
+打遍天下 55
+扩军 55
+找死 55
+投资商 55
+抢光 55
+拉加 55
+拉子模 55
+持股数 55
+挂面 55
+挤兑 55
+挥之不去 55
+捧杯 55
+接踵 55
+提调 55
+搬动 55
+摘编 55
+收款 55
+教育者 55
+斯人 55
+斯坦福 55
+新乐府 55
+新元 55
+新科 55
+新竹 55
+方位角 55
+无利可图 55
+无心恋战 55
+无所不能 55
+既往 55
+晦涩 55
+曼城 55
+有惊无险 55
+有机可乘 55
+服务生 55
+望而却步 55
+朝气蓬勃 55
+木华黎 55
+杂音 55
+松子 55
+极值 55
+枪击 55
+某省 55
+柚木 55
+查究 55
+校官 55
+格罗斯 55
+桂军 55
+梽 55
+榕 55
+横行霸道 55
+正义感 55
+歹意 55
+殷离 55
+气管炎 55
+汕头市 55
+沙文主义 55
+河姆渡 55
+油品 55
+波光 55
+波动性 55
+注疏 55
+洪七 55
+测量船 55
+海明威 55
+海派 55
+海王 55
+涂片 55
+消受 55
+涡旋 55
+清太祖 55
+清音 55
+温习 55
+温和派 55
+温湿 55
+游乐场 55
+游云 55
+溃不成军 55
+溜之大吉 55
+演技 55
+火暴 55
+烦心 55
+烧化 55
+煊赫 55
+煤焦油 55
+犯有 55
+独力 55
+玉虚散 55
+王大人 55
+王守义 55
+班主 55
+瓷碗 55
+甘南 55
+甘甜 55
+番邦 55
+疏失 55
+病种 55
+白送 55
+百余名 55
+百元 55
+皮袋 55
+盖尔 55
+盘根错节 55
+直布罗陀海峡 55
+相映 55
+看相 55
+真善美 55
+真定府 55
+眮 55
+石级 55
+石阵 55
+砹 55
+硬拼 55
+磁极 55
+磐石 55
+神学院 55
+福分 55
+离宫 55
+科技界 55
+科迪勒拉 55
+租给 55
+称誉 55
+稍纵即逝 55
+稳当 55
+穷追不舍 55
+空化 55
+窄窄的 55
+窝子 55
+窞 55
+立体感 55
+站住脚 55
+竹签 55
+笔意 55
+第一款 55
+第七场 55
+第二十一章 55
+第十三卷 55
+第十八章 55
+简约 55
+米开朗琪罗 55
+类同 55
+粘弹性 55
+粮棉 55
+索尔 55
+繁荣昌盛 55
+纡 55
+约见 55
+纷呈 55
+纸上谈兵 55
+纺锤 55
+结构化 55
+结膜炎 55
+绝色 55
+统管 55
+编组站 55
+缩微 55
+网球赛 55
+美金 55
+翠竹 55
+老刘 55
+老死 55
+耗损 55
+耿耿 55
+联创 55
+肓 55
+胡匪 55
+胶结 55
+腈 55
+自省 55
+自若 55
+至德 55
+芋头 55
+花园口 55
+花花公子 55
+苍猿 55
+苦斗 55
+范本 55
+茶室 55
+茹 55
+荪 55
+药力 55
+莫邪 55
+莱布尼兹 55
+落草 55
+蕨类植物 55
+薄幸 55
+薛定谔 55
+薜 55
+藤蔓 55
+蘅 55
+蛞 55
+蜗 55
+螟 55
+袁军 55
+被装 55
+装假 55
+裨益 55
+褒贬 55
+褪去 55
+西宁市 55
+见诸 55
+视神经 55
+解数 55
+计算机系统 55
+记谱 55
+评话 55
+识货 55
+试训 55
+说老实话 55
+谈情说爱 55
+谢玄 55
+谭纶 55
+财帛 55
+贤者 55
+赋存 55
+赞成票 55
+赣西南 55
+赫尔 55
+趋避 55
+车水马龙 55
+转瞬即逝 55
+轴距 55
+辅导员 55
+辐射状 55
+运走 55
+进气 55
+退场 55
+逃犯 55
+逐条 55
+通才 55
+遥遥领先 55
+避雨 55
+邮电局 55
+邵阳 55
+郭嵩焘 55
+酣睡 55
+重载 55
+野驴 55
+金乌 55
+钟瑶 55
+钢丝绳 55
+钩子 55
+钱袋 55
+铁蹄 55
+铺张 55
+错别字 55
+门市 55
+闽南 55
+阎世章 55
+阿公 55
+附体 55
+陆先生 55
+陆奥宗 55
+陈氏 55
+雌雄同体 55
+霰弹 55
+青苔 55
+静心 55
+非难 55
+韦春芳 55
+韭 55
+饮品 55
+饶有 55
+骤增 55
+骨牌 55
+骨骼肌 55
+高棉 55
+高阶 55
+髭 55
+鬻 55
+鱼米之乡 55
+鲀 55
+鸺 55
+鸿门宴 55
+麦其家 55
+黄果树 55
+鼗 55
+一下头 54
+一册 54
+一清早 54
+万全 54
+万多名 54
+万顷 54
+三令五申 54
+三套 54
+三星级 54
+三角函数 54
+上千 54
+上海滩 54
+不值一提 54
+不治之症 54
+专门化 54
+丙烷 54
+东麓 54
+丧门 54
+中华医学会 54
+为时已晚 54
+主使 54
+主祭 54
+丽水 54
+乌尔 54
+乔柏年 54
+九一八 54
+九道 54
+乡人 54
+乳名 54
+乳突 54
+乾嘉 54
+二叠 54
+二尖瓣 54
+二重 54
+五牛 54
+五级 54
+交游 54
+人头攒动 54
+人所共知 54
+人生在世 54
+亿港元 54
+令人不安 54
+仺 54
+企划 54
+伆 54
+伦巴 54
+佛国 54
+侈 54
+促织 54
+保举 54
+健将 54
+偷窥 54
+元凶 54
+元始 54
+兑付 54
+八块 54
+兴风作浪 54
+兵临城下 54
+具体地说 54
+养德斋 54
+内斯 54
+减刑 54
+几百块 54
+凡尔赛宫 54
+凭据 54
+凿子 54
+刊发 54
+刑警 54
+刘向 54
+刘履丁 54
+前夫 54
+劓 54
+加利福尼亚大学 54
+劳辛 54
+匀速 54
+十几种 54
+十四五岁 54
+千佛洞 54
+千手观音 54
+华文 54
+单管 54
+南隅 54
+印痕 54
+受敌 54
+叫门 54
+叮咚 54
+史公 54
+号哭 54
+合剂 54
+吉庆 54
+同台 54
+名不见经传 54
+名园 54
+名扬天下 54
+后防线 54
+吕文德 54
+吕留良 54
+吴道子 54
+吸力 54
+吸收光谱 54
+呤 54
+周扬 54
+周秉 54
+咋舌 54
+和蔼可亲 54
+咸水 54
+品学兼优 54
+哈桑 54
+器质性 54
+四乡 54
+囥 54
+国家体育总局 54
+图灵机 54
+圆雕 54
+圣徒 54
+地学 54
+地质图 54
+坐像 54
+垰 54
+城镇化 54
+填平 54
+墨脱 54
+备注 54
+外孙女 54
+多万公顷 54
+多不少 54
+夜宴 54
+大做文章 54
+大半夜 54
+大打出手 54
+大雅 54
+天日 54
+契诃夫 54
+奥其尔巴特 54
+奶水 54
+如来佛 54
+姬清虚 54
+娇弱 54
+娴静 54
+嫡亲 54
+子宫颈 54
+孙甜甜 54
+孙膑 54
+孟德尔 54
+学习者 54
+安顺 54
+宋文帝 54
+宋长老 54
+定县 54
+宝象 54
+实在话 54
+宰割 54
+家谱 54
+对饮 54
+寿险 54
+小卒 54
+小妞儿 54
+小鼠 54
+尽忠报国 54
+尽收眼底 54
+尿毒症 54
+属吏 54
+山炮 54
+巡回赛 54
+工商企业 54
+左右手 54
+左右逢源 54
+巴尔扎克 54
+巴拉那河 54
+巴斯克 54
+巻 54
+帮凶 54
+常服 54
+平平的 54
+年息 54
+年青人 54
+幼童 54
+应收款 54
+底质 54
+府兵制 54
+废铁 54
+座右铭 54
+延续性 54
+开尔文 54
+开眼 54
+异动 54
+弄权 54
+弱项 54
+德尔斐 54
+忌食 54
+怀胎 54
+总任务 54
+恍恍惚惚 54
+恩义 54
+恭祝 54
+悲切 54
+悲剧性 54
+惰性气体 54
+感激涕零 54
+懵懂 54
+成名作 54
+成竹在胸 54
+成绩单 54
+戚家军 54
+戴姆勒 54
+户数 54
+所里 54
+打击乐器 54
+打嗝 54
+打草惊蛇 54
+打雷 54
+扬声器 54
+扬琴 54
+扭打 54
+抚州 54
+报关 54
+报出 54
+拂袖而去 54
+拦网 54
+揆 54
+提亲 54
+揭秘 54
+摸黑 54
+擭 54
+支支吾吾 54
+攻讦 54
+救国会 54
+教条化 54
+数位 54
+数十种 54
+数十米 54
+文治 54
+斜视 54
+斤斤计较 54
+断开 54
+斯坦尼 54
+无憾 54
+无稽之谈 54
+昆明湖 54
+晋文公 54
+景颇族 54
+暣 54
+曹涤非 54
+月食 54
+望而生畏 54
+本周一 54
+朱三太子 54
+朱重八 54
+机变 54
+杀人者 54
+杂技团 54
+李金华 54
+杨坚 54
+杨大娘 54
+杨素 54
+杭盼 54
+板蓝根 54
+果蝇 54
+枯黄 54
+柳叶刀 54
+柳若菲 54
+校务 54
+格兰特 54
+格式化 54
+格拉玛 54
+桥边 54
+梁天柱 54
+樟树 54
+樯 54
+正态分布 54
+殃及 54
+段誉心 54
+段誉笑 54
+殿阁 54
+每套 54
+比利亚雷 54
+毛泽覃 54
+毛细管 54
+气昂昂 54
+氯化物 54
+水头 54
+汰 54
+沃土 54
+河运 54
+泡汤 54
+泰晤士河 54
+洋洋 54
+流汗 54
+济州岛 54
+浩罕 54
+浴池 54
+海塘 54
+消释 54
+淳熙 54
+游学 54
+满面春风 54
+滥杀无辜 54
+滨湖 54
+滴落 54
+潘晟 54
+潦倒 54
+潪 54
+激动不已 54
+灌入 54
+火场 54
+火眼金睛 54
+灭顶之灾 54
+灯油 54
+炮兵营 54
+烦扰 54
+烧碱 54
+煽风点火 54
+特立尼达 54
+狁 54
+狍子 54
+猎户座 54
+猪圈 54
+王姑娘 54
+王孙 54
+现代性 54
+现场会 54
+瑞典队 54
+瑞栋 54
+甜香 54
+甲兵 54
+电讯报 54
+电镀 54
+甾醇 54
+病根 54
+痕量 54
+癣 54
+白帝城 54
+白氏 54
+百团大战 54
+百草 54
+皮肤科 54
+益都 54
+相看 54
+真真切切 54
+睡袋 54
+石制 54
+石化集团 54
+砂糖 54
+碇 54
+碧蓝 54
+磁学 54
+礼义 54
+礼盒 54
+私藏 54
+秉赋 54
+秋汛 54
+科斯 54
+稳扎稳打 54
+穆宗 54
+空军指挥学院 54
+立体化 54
+童男 54
+笔力 54
+笪 54
+第三项 54
+第八条 54
+第十六 54
+笸 54
+答疑 54
+类推 54
+粤东 54
+精明能干 54
+紫绀 54
+纯系 54
+组胺 54
+结肠癌 54
+绝少 54
+绵亘 54
+绿水 54
+缠足 54
+网瘾 54
+罹患 54
+美利纸业 54
+翌日 54
+老九 54
+老规矩 54
+老郑 54
+耐腐蚀 54
+联合报 54
+肥水 54
+肺腑之言 54
+胡氏 54
+自命 54
+自感 54
+自行式 54
+自鸣钟 54
+致残 54
+色鬼 54
+节间 54
+花子 54
+花药 54
+花鼓戏 54
+苟延残喘 54
+英文版 54
+茅台酒 54
+荐举 54
+荣中堂 54
+荣誉奖 54
+莫里森 54
+菲尔 54
+营养师 54
+董渡江 54
+薛宝钗 54
+藏经 54
+蛋白酶 54
+螒 54
+螺旋体 54
+蠼 54
+血吸虫 54
+行政村 54
+装裱 54
+襟翼 54
+西高东 54
+要不了 54
+观其行 54
+诈降 54
+诡辩 54
+误杀 54
+误用 54
+说法不一 54
+谇 54
+谢艾 54
+贱妾 54
+贾秀全 54
+赝 54
+赶跑 54
+足不出户 54
+躲躲闪闪 54
+辐射源 54
+输气 54
+输水 54
+输精管 54
+边儿 54
+过路 54
+迎送 54
+进呈 54
+退隐 54
+逊于 54
+速战速决 54
+那道人 54
+郭子仪 54
+郭杨 54
+郭淮 54
+酒石酸 54
+金富 54
+金环 54
+金笛 54
+金贵 54
+钱牧斋 54
+铁血 54
+铅直 54
+铣床 54
+锋面 54
+长笛 54
+长野 54
+阬 54
+防弹 54
+阶级性 54
+阿里山 54
+陇东 54
+限时 54
+陶方 54
+陶罐 54
+随机性 54
+雅乐 54
+雅马哈 54
+集注 54
+雇佣兵 54
+青蛇 54
+青衫 54
+静息 54
+革命史 54
+韦尔 54
+韦昌辉 54
+风干 54
+风调雨顺 54
+鲁西 54
+鲍鱼 54
+鹿皮 54
+麦收 54
+黄梦然 54
+黄钟 54
+黑幕 54
+鼻咽癌 54
+鼻端 54
+龙永图 54
+一上午 53
+一多 53
+一大批 53
+一字一顿 53
+一老一少 53
+一首歌 53
+七八岁 53
+丈母 53
+丈母娘 53
+三五年 53
+三水 53
+下基层 53
+不厌 53
+不解之缘 53
+不言自明 53
+两拳 53
+两码事 53
+两面针 53
+严实 53
+中低 53
+中发 53
+中放 53
+举棋不定 53
+乍得湖 53
+买得起 53
+乳业 53
+于事无补 53
+产品设计 53
+人性论 53
+人格化 53
+人民银行 53
+从无到有 53
+仓猝 53
+代管 53
+优柔寡断 53
+伙房 53
+会宁 53
+伪满洲国 53
+低俗 53
+住居 53
+何长工 53
+余千米 53
+侍立在 53
+依傍 53
+侧卧 53
+俏皮 53
+俗气 53
+偷工减料 53
+元明清 53
+克斯 53
+入不敷出 53
+公方 53
+写真 53
+农牧民 53
+冠军赛 53
+冶金学 53
+几幅 53
+出货 53
+刀口 53
+划桨 53
+初潮 53
+利华 53
+制冷剂 53
+削发 53
+前爪 53
+剥削者 53
+剪影 53
+劈脸 53
+劝导 53
+动物学家 53
+劳动对象 53
+勐 53
+北京市委 53
+北运河 53
+半工半读 53
+南京大屠杀 53
+博学多才 53
+占款 53
+卡塞尔 53
+及时雨 53
+反间计 53
+可信度 53
+叶鞘 53
+号角声 53
+同党 53
+吕方 53
+吧嗒 53
+吴荣 53
+吾辈 53
+和煦 53
+和盘托出 53
+唐斌 53
+唐继尧 53
+善为 53
+喷灌 53
+回放 53
+困守 53
+围垦 53
+国信 53
+圆孔 53
+圣灵 53
+地底下 53
+地道战 53
+场里 53
+坑洼 53
+坝子 53
+填涂 53
+夂 53
+复合物 53
+多派 53
+多相 53
+多辆 53
+大市 53
+大幕 53
+大钟 53
+大错特错 53
+大麻子 53
+天寒地冻 53
+失业者 53
+夸夸其谈 53
+奉送 53
+如数家珍 53
+妥帖 53
+姙 53
+姚伯 53
+娘胎 53
+娩 53
+婺 53
+子午 53
+孔雀石 53
+孤陋寡闻 53
+学识渊博 53
+安全法 53
+安卡拉 53
+安泰 53
+安营 53
+宋思 53
+宋江便 53
+宗维侠 53
+宝钢 53
+宿舍区 53
+寂寂 53
+富国强兵 53
+寓于 53
+寘 53
+对撞机 53
+小娘子 53
+小安 53
+小篆 53
+小翠 53
+尔虞我诈 53
+山海城 53
+峤 53
+嵆 53
+川流不息 53
+左军 53
+左顾右盼 53
+布哈拉 53
+希罗多德 53
+带鱼 53
+干活儿 53
+平白无故 53
+年增长率 53
+年景 53
+底儿 53
+开普勒 53
+弈 53
+弗朗西斯科 53
+张帅 53
+弯弯的 53
+弹跳 53
+强权政治 53
+当事国 53
+彩带 53
+徐州市 53
+得克萨斯 53
+德勤 53
+心田 53
+心迹 53
+心里有数 53
+忌恨 53
+忠烈 53
+总工 53
+情愫 53
+惊弓之鸟 53
+懋 53
+成事 53
+成衣 53
+戕 53
+手机游戏 53
+扬弃 53
+拨付 53
+按规定 53
+挤占 53
+振兴中华 53
+掌舵 53
+推却 53
+掮 53
+摄像头 53
+摹本 53
+撒克逊 53
+撤掉 53
+收治 53
+收罗 53
+收购人 53
+改天 53
+改订 53
+故址 53
+教书育人 53
+教职 53
+数以百计 53
+数百个 53
+斑疹 53
+斩获 53
+断口 53
+新党 53
+新气象 53
+方越 53
+旅人 53
+既成事实 53
+早婚 53
+明眼人 53
+晨鸣 53
+暴晒 53
+服务队 53
+望城 53
+木椁 53
+末段 53
+朱慈 53
+杂念 53
+李三才 53
+枯木 53
+查理一世 53
+标引 53
+树胶 53
+校车 53
+核心区 53
+栽赃 53
+梦溪笔谈 53
+楚怀王 53
+楼门 53
+欢聚一堂 53
+正名 53
+武娘子 53
+武生 53
+死结 53
+死胎 53
+比拼 53
+毫不在乎 53
+气焰嚣张 53
+水云 53
+求真务实 53
+汉族人 53
+汽艇 53
+没劲 53
+治愈率 53
+法人代表 53
+洪灾 53
+涕泪 53
+淮河流域 53
+混合液 53
+混成旅 53
+清清白白 53
+游资 53
+游鱼 53
+溥 53
+溺死 53
+滞育 53
+潘季驯 53
+潮头 53
+澡堂 53
+激灵 53
+炮位 53
+燃烧室 53
+狂澜 53
+猥琐 53
+獒 53
+玉龙杯 53
+王任直 53
+王夫之 53
+王小倜 53
+王朴 53
+玘 53
+玩味 53
+现身说法 53
+珊瑚树 53
+珍珠粉 53
+球果 53
+琊 53
+瓦岗军 53
+甜甜的 53
+电扇 53
+畏罪 53
+畖 53
+留用 53
+疫病 53
+痛定思痛 53
+瘤子 53
+癫狂 53
+登临 53
+白鹿书院 53
+百事可乐 53
+盐城 53
+盛气凌人 53
+目不暇接 53
+直捣 53
+真宗 53
+短衣 53
+石崖 53
+矿泉 53
+硝烟弥漫 53
+碰面 53
+磁导率 53
+禾草 53
+秃顶 53
+秉烛 53
+积木 53
+称兄道弟 53
+穗状花序 53
+穷汉 53
+穷追 53
+穹窿 53
+空当 53
+立地 53
+章田 53
+竹叶 53
+笕 53
+符咒 53
+第三局 53
+第六场 53
+第四层 53
+第四部 53
+粉条 53
+粌 53
+粭 53
+红妆 53
+纸盒 53
+经世致用 53
+结发 53
+绝交 53
+绝缘体 53
+绯闻 53
+网卡 53
+罗经 53
+羊城 53
+老尚 53
+老房子 53
+老早 53
+老陆 53
+耗电 53
+耳熟能详 53
+耿炳文 53
+职业性 53
+肾盂 53
+胡格诺 53
+腾冲 53
+膨出 53
+自成一家 53
+航天局 53
+良善 53
+艰难险阻 53
+节节胜利 53
+花心 53
+花生米 53
+花蜜 53
+苏杭 53
+茶山 53
+荻 53
+莱芜 53
+萍乡 53
+营养元素 53
+营养品 53
+萧干 53
+萨克斯 53
+葆 53
+董存瑞 53
+蒪 53
+蒸馍 53
+蚩尤 53
+蝰 53
+蠢才 53
+行云流水 53
+补遗 53
+袂 53
+装帧 53
+褡裢 53
+西移 53
+西风带 53
+见面会 53
+言明 53
+计划外 53
+诈败 53
+误食 53
+调节器 53
+谋事 53
+豁口 53
+豆芽 53
+责打 53
+败落 53
+贫户 53
+贺金龙 53
+贾瑞 53
+赂 53
+资政 53
+赤铁矿 53
+起吊 53
+趋利避害 53
+跌宕起伏 53
+路标 53
+跳蚤 53
+踏雪 53
+躁动 53
+身临其境 53
+车头 53
+软盘 53
+载波 53
+运输船 53
+迷惑不解 53
+迷濛 53
+迷蒙 53
+通盘 53
+道学 53
+遥见 53
+邯钢 53
+邹渊 53
+郑玄 53
+郝大 53
+郭靖听 53
+郾城 53
+酋长国 53
+酒醒 53
+醉眼 53
+重蹈 53
+量子论 53
+金龟 53
+钵盂 53
+铁头 53
+铜锤 53
+铨 53
+银圆 53
+锒 53
+锸 53
+镊子 53
+问津 53
+闻见 53
+防化 53
+防护力 53
+阴坡 53
+阿基诺 53
+阿比让 53
+阿盟 53
+除湿 53
+除草 53
+陶冶性情 53
+零售业 53
+雷山 53
+震区 53
+震撼人心 53
+预科班 53
+风扬 53
+飞艇 53
+驱动力 53
+骺 53
+高个 53
+高抬贵手 53
+高歌猛进 53
+高腔 53
+鬲 53
+魁伟 53
+魉 53
+鲈形目 53
+鲜见 53
+鳌山 53
+鸟笼 53
+鸟蛋 53
+鸭蛋 53
+鸯 53
+黄巾起义 53
+黄花菜 53
+黯然神伤 53
+一个半月 52
+一峯 52
+一峰 52
+一百块 52
+一盘散沙 52
+丁壮 52
+万亿元 52
+万多元 52
+万里迢迢 52
+三十五 52
+三昧 52
+三百名 52
+三角学 52
+上海古籍出版社 52
+不济事 52
+不胫而走 52
+不过如此 52
+专柜 52
+东京大学 52
+东四 52
+中原军区 52
+中耳炎 52
+中院 52
+中高层 52
+为国为民 52
+主政者 52
+久等 52
+久长 52
+了断 52
+争闹 52
+二十四个 52
+互助组 52
+井陉 52
+亚硝酸钠 52
+亟需 52
+产学研 52
+京师大学堂 52
+亿英镑 52
+以牙还牙 52
+仪仗队 52
+会党 52
+伯乐 52
+低点 52
+余党 52
+作息时间 52
+佝偻病 52
+你一言我一语 52
+侃侃 52
+侯玉英 52
+便装 52
+倒掉 52
+倾慕 52
+僖 52
+八景 52
+六尺 52
+六次 52
+关系史 52
+内分泌科 52
+农畜 52
+农闲 52
+决堤 52
+凄惶 52
+几何图形 52
+几多 52
+几百元 52
+凤尾 52
+凤老爷 52
+分崩离析 52
+分析化学 52
+刘刚 52
+刘宋 52
+初来乍到 52
+利玛窦 52
+功利主义 52
+动员令 52
+勃勃生机 52
+勰 52
+包拯 52
+包车 52
+十二万 52
+十部 52
+卖关子 52
+卜卦 52
+卵黄 52
+卷进 52
+厂主 52
+厉宁 52
+原点 52
+县志 52
+参事 52
+双掌齐 52
+发丝 52
+发货 52
+叫花子 52
+可行性研究 52
+叱咤风云 52
+右耳 52
+叴 52
+吇 52
+合家 52
+吉元 52
+同调 52
+后窗 52
+吐气 52
+吙 52
+吴月琴 52
+呆若木鸡 52
+周一良 52
+和硕特 52
+哈尔滨工业大学 52
+哈聪 52
+响马 52
+哭腔 52
+唐再加 52
+啄食 52
+啖 52
+喜迎 52
+嘌呤 52
+嘶叫 52
+噗通 52
+四十五 52
+四十余 52
+四颗 52
+回吐 52
+因明 52
+地中海地区 52
+地方队 52
+培养基 52
+塌方 52
+复交 52
+外交家 52
+多任务 52
+夜总会 52
+大度 52
+大网 52
+大羽 52
+大腕 52
+天福 52
+失惊 52
+头数 52
+奔忙 52
+妖魔鬼怪 52
+始作俑者 52
+姜虎 52
+威尔 52
+嫩绿 52
+孔亮 52
+孔府 52
+孜孜不倦 52
+安抚司 52
+安达曼 52
+宋孝宗 52
+家道 52
+宿命论 52
+将错就错 52
+尉缭 52
+小时工 52
+小津 52
+小结 52
+屁事 52
+屈曲 52
+岬角 52
+巡行 52
+巴哈 52
+市政协 52
+布尊丹 52
+帅府 52
+帮不了 52
+干戈 52
+幻象 52
+幽怨 52
+广告主 52
+广泛性 52
+库页岛 52
+康定 52
+弗吉尼亚州 52
+弝 52
+弦外之音 52
+弯儿 52
+弹药库 52
+归属感 52
+形成层 52
+徐兵河 52
+得宠 52
+得意忘形 52
+德国政府 52
+德秀 52
+心心相印 52
+心碎 52
+心高气傲 52
+忘乎所以 52
+怀恨 52
+怫 52
+惊雷 52
+愍 52
+愣怔 52
+憋气 52
+戈壁滩 52
+戏言 52
+成交价 52
+户主 52
+房门口 52
+手拉手 52
+手柄 52
+才华横溢 52
+托收 52
+批文 52
+扼住 52
+投球 52
+投鼠忌器 52
+护肤品 52
+报请 52
+抹煞 52
+拉齐奥 52
+拗不过 52
+拟出 52
+择校 52
+拿手好戏 52
+持仓 52
+挂职 52
+挑子 52
+挨近 52
+推磨 52
+描摹 52
+描画 52
+插言 52
+摆明 52
+摇钱树 52
+摩拉维亚 52
+支链 52
+收视率 52
+放射线 52
+放荡不羁 52
+政论家 52
+文殊 52
+斑白 52
+斩落 52
+新教徒 52
+新浪网 52
+新鲜感 52
+方人智 52
+旗开得胜 52
+无微不至 52
+无敌于天下 52
+无际 52
+旱涝 52
+时差 52
+旻 52
+明中叶 52
+明尼苏达 52
+昏昏欲睡 52
+易卜生 52
+易学 52
+春小麦 52
+晟 52
+晨会 52
+暅 52
+曢 52
+曲洋 52
+曲解 52
+曲霉 52
+有分寸 52
+有生之年 52
+服务中心 52
+木讷 52
+朱由校 52
+朱统 52
+李春来 52
+杨左 52
+杨百业 52
+杨过见 52
+杳 52
+林培杰 52
+枪决 52
+柏油 52
+柔顺 52
+标定 52
+格林纳达 52
+格鲁门 52
+桑土公 52
+桧 52
+梁金雄 52
+棋艺 52
+植物药 52
+榛子 52
+橛 52
+櫌 52
+此情此景 52
+此起彼落 52
+武侯 52
+死胡同 52
+殷仲翔 52
+母性 52
+毒杀 52
+比肩 52
+毕比 52
+民政局 52
+民族化 52
+氚 52
+氧化铜 52
+水战 52
+水渠 52
+水线 52
+水蛭 52
+求告 52
+汉译 52
+汗液 52
+汽轮 52
+沙尔克 52
+泥污 52
+洒泪 52
+洛夫 52
+洛阳市 52
+活蹦乱跳 52
+流失率 52
+流离 52
+流行病 52
+浓淡 52
+海产品 52
+海拉尔 52
+涉险 52
+深发展 52
+渔夫 52
+渔家 52
+渔捞 52
+温莎 52
+温软 52
+湶 52
+漏斗状 52
+激酶 52
+火地岛 52
+灯节 52
+灰头土脸 52
+点数 52
+点点滴滴 52
+焣 52
+煦 52
+熄灯 52
+熊明遇 52
+牛国兴 52
+牡丹亭 52
+牡丹花 52
+牧马 52
+犀角 52
+王吉 52
+王鹏 52
+环城 52
+琴音 52
+生疑 52
+用户数 52
+电导 52
+画像石 52
+畅所欲言 52
+疑云 52
+痂 52
+瘯 52
+癞蛤蟆 52
+白骨精 52
+百分之八 52
+百货商店 52
+皮货 52
+盐溶液 52
+目光炯炯 52
+直上直下 52
+相形见绌 52
+看破 52
+眳 52
+督脉 52
+知情人 52
+石磨 52
+石臼 52
+石鼓 52
+砂锅 52
+砙 52
+砦 52
+硗 52
+硬仗 52
+示警 52
+礼俗 52
+祛痰 52
+神经管 52
+福德 52
+积欠 52
+稀奇古怪 52
+空客 52
+竞猜 52
+竭诚 52
+笃定 52
+笤帚 52
+第三场 52
+笺纸 52
+等闲之辈 52
+籁 52
+籍没 52
+米哈伊洛夫 52
+粏 52
+粗放型 52
+系统软件 52
+纯利润 52
+线型 52
+细沙 52
+细粮 52
+终点站 52
+结合能 52
+绸子 52
+职校 52
+联产承包 52
+胆略 52
+胡斯 52
+脊梁骨 52
+脑脊液 52
+自作聪明 52
+自暴自弃 52
+舫 52
+良久良久 52
+良方 52
+良渚 52
+色彩斑斓 52
+花剌子 52
+花天酒地 52
+花茶 52
+花蕾 52
+荣国府 52
+荤腥 52
+莫文隆 52
+菰 52
+薪俸 52
+藏学 52
+虚设 52
+蚑 52
+蛲 52
+街景 52
+裴勇 52
+裴宣 52
+西北欧 52
+西流 52
+要价 52
+解手 52
+言传 52
+计分 52
+订制 52
+认认真真 52
+记取 52
+论集 52
+译法 52
+诗剧 52
+语无伦次 52
+诺伊曼 52
+调转 52
+谨小慎微 52
+豕 52
+贫困线 52
+购并 52
+费孝通 52
+费席尔 52
+赏脸 52
+赵子龙 52
+超然 52
+跆拳道 52
+跌进 52
+跳绳 52
+轻重缓急 52
+输出国 52
+输油管道 52
+辨证论治 52
+边境线 52
+迎亲 52
+运输舰 52
+远走 52
+选录 52
+造势 52
+逻辑推理 52
+逼视 52
+遍野 52
+道号 52
+那么些 52
+邩 52
+酸溜溜 52
+采买 52
+重子 52
+金属钠 52
+钉住 52
+钟馗 52
+铁军 52
+铁拳 52
+铁片 52
+铜墙铁壁 52
+锖 52
+锡尔河 52
+锩 52
+长三 52
+长臂猿 52
+閤家 52
+门捷列夫 52
+问长问短 52
+防弹衣 52
+防患于未然 52
+防毒 52
+阿帕奇 52
+阿托品 52
+阿米巴 52
+阿胶 52
+陆海空军 52
+陈文英 52
+陈腐 52
+雄踞 52
+雕饰 52
+震天动地 52
+青春痘 52
+青跃 52
+静修 52
+非国有 52
+非常规 52
+鞔 52
+鞘翅目 52
+韩新月 52
+音韵学 52
+顺手牵羊 52
+领事裁判权 52
+题记 52
+餮 52
+饣 52
+马宣赞 52
+驮运 52
+骠 52
+髀 52
+高标准 52
+高难 52
+髟 52
+鬼才 52
+鬼混 52
+鲜果 52
+鸹 52
+鹿贺氏 52
+麦粒 52
+黄瑞兰 52
+黄蓉心 52
+黄蕉风 52
+黎阳 52
+黑地 52
+鼠药 52
+鼻塞 52
+龙洞 52
+一亿 51
+一半左右 51
+一哄而散 51
+一时半会儿 51
+一落千丈 51
+一阵响 51
+七里 51
+万众一心 51
+万套 51
+三辆 51
+上供 51
+上屋 51
+下一场 51
+下届 51
+下酒 51
+不足道 51
+专一性 51
+东南风 51
+两圈 51
+两系 51
+中伏 51
+中共中央宣传部 51
+中央军 51
+中经 51
+乌鲁木齐市 51
+乙酰胆碱 51
+九霄云外 51
+习用 51
+乳化 51
+乾笑 51
+五大连池 51
+五指山 51
+五百名 51
+五言诗 51
+亲戚家 51
+亿人次 51
+从宽 51
+从江 51
+任课 51
+伊朗队 51
+伏案 51
+休憩 51
+众口 51
+会演 51
+伽蓝 51
+体校 51
+佛殿 51
+作价 51
+作秀 51
+侄孙 51
+保尔 51
+信息检索 51
+倒挂 51
+倸 51
+值班员 51
+假言 51
+做派 51
+先圣 51
+光谱线 51
+入室 51
+入账 51
+全真剑 51
+公孙瓒 51
+公积 51
+六十多岁 51
+六只 51
+兰卡威 51
+内务部 51
+军大衣 51
+军管 51
+军门 51
+冤狱 51
+冰凌 51
+决定书 51
+几亩 51
+几千米 51
+几封 51
+凡夫俗子 51
+凤毛麟角 51
+凯泽斯劳 51
+出口成章 51
+出道 51
+刁钻古怪 51
+分划 51
+划界 51
+初春 51
+别失八里 51
+前清 51
+前缀 51
+力促 51
+力挽狂澜 51
+加盟店 51
+动脉血 51
+动荡不安 51
+助阵 51
+匚 51
+十八九岁 51
+十记 51
+卂 51
+南丰 51
+南蛮 51
+卡萨布兰卡 51
+印绶 51
+厤 51
+县尉 51
+参团 51
+双料 51
+反切 51
+反法西斯战争 51
+发怵 51
+发货量 51
+叶脉 51
+各自为战 51
+同生共死 51
+名产 51
+后记 51
+吕宋岛 51
+和润叶 51
+咽气 51
+咿 51
+喋 51
+四十五年 51
+四围 51
+回访 51
+国光 51
+国青队 51
+地域性 51
+坂 51
+埃米尔 51
+城市贫民 51
+塔勒 51
+墙体 51
+增白剂 51
+墨色 51
+复旧 51
+夏普 51
+外交部门 51
+外包装 51
+大仁大义 51
+大学城 51
+大宅 51
+大家伙 51
+大平 51
+大是大非 51
+大汶口 51
+天花乱坠 51
+太元 51
+失策 51
+奇丽 51
+奇偶 51
+奇瑞 51
+奇缺 51
+奥斯丁 51
+女权 51
+始得 51
+娄底 51
+嫉恶如仇 51
+嬗 51
+子母 51
+孟什维克 51
+学法 51
+守宫 51
+守敌 51
+安德罗 51
+安曼 51
+定义域 51
+定弦 51
+宝安 51
+室内乐 51
+容人 51
+寸步难行 51
+小武 51
+小胜 51
+尘埃落定 51
+尘肺 51
+山县 51
+山本 51
+岩浆岩 51
+岳阳楼 51
+崽子 51
+嵘 51
+左光先 51
+巧取豪夺 51
+巴人 51
+巴伦支海 51
+布莱恩 51
+帝永 51
+幅员辽阔 51
+干笑 51
+平心静气 51
+年代久远 51
+年历 51
+年率 51
+库尔德 51
+底比斯 51
+弯弯 51
+强手 51
+当空 51
+影评 51
+徐盛 51
+微循环 51
+德耀 51
+心脾 51
+快慰 51
+忻口 51
+急性子 51
+怨声载道 51
+总吨位 51
+惹麻烦 51
+成百上千 51
+扁桃体 51
+执法者 51
+扶养 51
+承重 51
+技术水平 51
+抱定 51
+招引 51
+招认 51
+拨号 51
+掉队 51
+排放量 51
+控制台 51
+推手 51
+掩藏 51
+援助国 51
+摩尔根 51
+故都 51
+教团 51
+教育网 51
+散热器 51
+数量级 51
+整个儿 51
+料子 51
+断喝 51
+斯拉夫斯基 51
+无余 51
+无所不包 51
+无日 51
+日月潭 51
+日本外务省 51
+日本航空自卫队 51
+旧石器 51
+时态 51
+明丽 51
+春药 51
+晋冀豫 51
+晋城 51
+景德 51
+曷 51
+最高层 51
+有感于 51
+木排 51
+木桥 51
+本乡 51
+本职 51
+朱祁 51
+杂务 51
+李勃玲 51
+李固 51
+村级 51
+林海 51
+果不其然 51
+枣红 51
+某次 51
+柘 51
+查继佐 51
+查访 51
+树苗 51
+栖居 51
+校领导 51
+楼层 51
+模特儿 51
+横断面 51
+樷 51
+欢畅 51
+歇脚 51
+正常值 51
+正统派 51
+段家 51
+殷野王 51
+比亚 51
+毫不迟疑 51
+气盛 51
+气象学家 51
+水榭 51
+水漂 51
+水疱 51
+水盆 51
+水龙头 51
+汉光武帝 51
+汗流浃背 51
+汤匙 51
+汽船 51
+沙粒 51
+油性 51
+沾边 51
+法纪 51
+泥岩 51
+派发 51
+派神 51
+测度 51
+浙南 51
+浮萍 51
+海州 51
+海门 51
+润肺 51
+添加物 51
+清纯 51
+温景春 51
+游园 51
+滔天 51
+演兵场 51
+灌顶 51
+热情洋溢 51
+焚尸炉 51
+焦文期 51
+焦裕禄 51
+煅 51
+煤灰 51
+煤球 51
+熔炉 51
+熟虑 51
+爱尔兰人 51
+牛羊 51
+独舞 51
+狼藉 51
+猞猁 51
+獯 51
+环形山 51
+现代史 51
+玻璃杯 51
+理性认识 51
+琇 51
+用得上 51
+田园诗 51
+甲状腺肿 51
+申请表 51
+电炉 51
+电码 51
+疱 51
+疾行 51
+疾走 51
+登堡 51
+白炽灯 51
+百分之 51
+百分之十 51
+皮克林 51
+盎格鲁 51
+直勾勾 51
+省府 51
+真相大白 51
+瞎眼 51
+知识性 51
+短柄 51
+矸 51
+矿种 51
+破冰船 51
+硬挺 51
+碎块 51
+碎银 51
+碳化物 51
+磷光 51
+社会科学家 51
+神不守舍 51
+神经中枢 51
+神经症 51
+离乡 51
+离谱 51
+秣 51
+积极分子 51
+窕 51
+立新 51
+端量 51
+第十一次 51
+第十九章 51
+第十二次 51
+等温 51
+策源地 51
+箭杆 51
+篾 51
+粉饰 51
+精益求精 51
+紊 51
+红扑扑 51
+纸人 51
+纽约市 51
+细察 51
+细粒 51
+终久 51
+终老 51
+络绎 51
+继父 51
+缅因 51
+罗宾逊 51
+罗致 51
+罪有应得 51
+羍 51
+群芳 51
+肾气 51
+胡卫民 51
+脑门子 51
+脯 51
+脸水 51
+腿伤 51
+至迟 51
+航空展 51
+色目人 51
+芥菜 51
+花都 51
+苦寒 51
+范秀枝 51
+茅房 51
+茶农 51
+荒郊 51
+荣宝 51
+荷兰语 51
+荷塘 51
+莲娜 51
+菊石 51
+萎 51
+落井下石 51
+蒂姆 51
+蒙骗 51
+蓄势 51
+蓝山 51
+蓬头垢面 51
+薛永 51
+虚汗 51
+蚐 51
+蛆虫 51
+蛮荒 51
+衙前 51
+补气 51
+表面张力 51
+装殓 51
+裤管 51
+褐藻 51
+褟 51
+西邻 51
+见报 51
+话别 51
+说明会 51
+诸岛 51
+调性 51
+调演 51
+谢世 51
+谱系 51
+费尔干纳 51
+赔礼 51
+赖氨酸 51
+走下坡路 51
+赵率教 51
+超速 51
+蹦跳 51
+转学 51
+轻贱 51
+达拉 51
+运出 51
+近半年 51
+还债 51
+进见 51
+迷你 51
+追赃 51
+适者生存 51
+逍遥自在 51
+通敌 51
+遗愿 51
+邢宇 51
+郭威 51
+都护府 51
+采写 51
+金本位制 51
+金牛座 51
+金融时报 51
+鐡 51
+针法 51
+钱龙锡 51
+钾肥 51
+铅球 51
+链式 51
+锃亮 51
+锓 51
+错题 51
+长嘴 51
+长春队 51
+长随 51
+门下省 51
+闷雷 51
+阴平 51
+降职 51
+隔海相望 51
+隳 51
+雁荡山 51
+雅俗 51
+雪灾 51
+霍然 51
+露珠 51
+青翠 51
+青花瓷 51
+靺 51
+鞲 51
+韝 51
+顺民 51
+须根 51
+顾颉刚 51
+风水宝地 51
+风铃 51
+馆员 51
+首战 51
+骑手 51
+骨碌碌 51
+高蛋白 51
+高踞 51
+鬽 51
+鲜红色 51
+黄油 51
+黄泥 51
+黑亮 51
+一梦 50
+一等功 50
+一闪而过 50
+七条 50
+万多个 50
+万门 50
+三八 50
+三具 50
+三则 50
+三十一 50
+三老 50
+下三滥 50
+下图 50
+下河 50
+下风 50
+不吝 50
+不得已而为之 50
+世孙 50
+两万多 50
+两委 50
+两批 50
+两番 50
+两角 50
+两面性 50
+中国内蒙古自治区 50
+中国女足 50
+举手投足 50
+久之 50
+乜 50
+书评 50
+二百余 50
+于大龙 50
+亏负 50
+五六里 50
+五步 50
+井壁 50
+亚得里亚海 50
+亡命之徒 50
+交趾 50
+亲亲热热 50
+人材 50
+从古到今 50
+以权谋私 50
+伊斯特 50
+休止 50
+众望所归 50
+会期 50
+伤俘 50
+体育界 50
+何思豪 50
+佤 50
+佩里 50
+佯攻 50
+例题 50
+侦测 50
+侨务 50
+保税 50
+信号灯 50
+修仙 50
+倒卵形 50
+借重 50
+停课 50
+健康人 50
+储济仓 50
+入定 50
+入超 50
+入院 50
+全兴 50
+八袋 50
+兰开斯特 50
+共和派 50
+养鸡 50
+军事学 50
+军衣 50
+冬训 50
+凋谢 50
+出版署 50
+出猎 50
+刀把 50
+切除术 50
+刘玉林 50
+初任 50
+初始条件 50
+初试 50
+前茅 50
+副产品 50
+力推 50
+加藤 50
+劳损 50
+勇武 50
+勒紧 50
+募兵 50
+勾栏 50
+化为灰烬 50
+十多 50
+十拿九稳 50
+千多年 50
+千种 50
+半招 50
+半杯 50
+南盘江 50
+卫报 50
+历历 50
+原装 50
+双笔 50
+变戏法 50
+古北口 50
+叫停 50
+史火龙 50
+合作者 50
+名角 50
+后怕 50
+吕碧城 50
+周原 50
+周官 50
+咫尺 50
+咴 50
+哈拉 50
+唏 50
+唐宪宗 50
+唐高祖 50
+商老太 50
+啒 50
+喀土穆 50
+喜滋滋 50
+噱头 50
+四十二年 50
+四头 50
+团丁 50
+圈闭 50
+圣战 50
+地儿 50
+坦克兵 50
+埃因霍温 50
+塄 50
+塞拉 50
+声像 50
+夏娃 50
+外史 50
+外语系 50
+多款 50
+大房子 50
+大柳树 50
+大泽 50
+天昏地暗 50
+失宠 50
+头版 50
+奇珍异宝 50
+奉劝 50
+奎宁 50
+奥兰治 50
+女侠 50
+好心人 50
+如虎添翼 50
+姚古 50
+姥爷 50
+娇生惯养 50
+子母弹 50
+子爵 50
+孔德 50
+孟家 50
+学院派 50
+宇文护 50
+安全系数 50
+安子文 50
+安检 50
+宋门 50
+完美无缺 50
+官渡 50
+定向生 50
+室韦 50
+宫娥 50
+宫闱 50
+家务事 50
+富忠 50
+对答如流 50
+寿儿 50
+封丘 50
+封建割据 50
+小动作 50
+小宗 50
+小家 50
+小生产者 50
+小草 50
+小队长 50
+层层叠叠 50
+屠城 50
+山岗 50
+山梨酸 50
+岳庙 50
+峄 50
+嶃 50
+巡察 50
+左面 50
+布尼茨 50
+师事 50
+帮衬 50
+幂级数 50
+干政 50
+并肩作战 50
+庥 50
+度假区 50
+建模 50
+开卷 50
+异类 50
+引动 50
+弹性体 50
+弹起 50
+强弩之末 50
+彭冲 50
+影儿 50
+徇私舞弊 50
+得人心 50
+微调 50
+忠言 50
+念青 50
+思乡 50
+急中生智 50
+怪声 50
+怪话 50
+总务 50
+恍如 50
+恒隆 50
+恩将仇报 50
+悬而未决 50
+惘然 50
+慕容垂 50
+憬 50
+戈林 50
+所向披靡 50
+手相 50
+才略 50
+技术部 50
+抗性 50
+拆穿 50
+拉伸 50
+拉手 50
+拉普拉塔河 50
+拍掌 50
+拔河 50
+招弟 50
+招待费 50
+择日 50
+拱坝 50
+捶打 50
+授受 50
+提取物 50
+搭腔 50
+摔伤 50
+撒泼 50
+改过 50
+数家 50
+文理科 50
+新茶 50
+新馆 50
+旗主 50
+无懈可击 50
+无所不在 50
+无隙可乘 50
+时弊 50
+明码 50
+星相 50
+昴 50
+晚点 50
+晢 50
+景颇 50
+晶粒 50
+暴烈 50
+曹休 50
+曾庆红 50
+望台 50
+朝天门 50
+未婚夫 50
+本社 50
+朱力亚 50
+朽木 50
+杂七杂八 50
+权属 50
+李隆基 50
+村上 50
+村主任 50
+条幅 50
+杭城 50
+杲 50
+林县 50
+林子里 50
+柏油路 50
+查普曼 50
+核威胁 50
+桂公公 50
+桎梏 50
+棝 50
+椰子树 50
+武青婴 50
+歧途 50
+死死的 50
+比高 50
+民法典 50
+气候学 50
+气象站 50
+氧原子 50
+汝宁 50
+汤姆逊 50
+沈城 50
+沙岗 50
+治家 50
+法军 50
+法器 50
+波希米亚 50
+泰然自若 50
+泼水节 50
+活期 50
+浮尸 50
+海岸带 50
+消息灵通 50
+液氦 50
+深入浅出 50
+清唱剧 50
+温南扬 50
+温言 50
+漠不关心 50
+煞有其事 50
+照本宣科 50
+爆发力 50
+独特性 50
+猩红 50
+玉皇 50
+王制 50
+王振宇 50
+环境影响 50
+琉璃河 50
+电火花 50
+电话线 50
+瘠 50
+登出 50
+白文杰 50
+皮帽 50
+盆花 50
+盖头 50
+盖州 50
+直来直去 50
+相克 50
+相容性 50
+真身 50
+眼熟 50
+瞬息间 50
+石宝 50
+石屏 50
+砣 50
+破坏力 50
+破案 50
+硝化 50
+磨床 50
+社会工作 50
+祁连 50
+神圣化 50
+神笔 50
+禁欲 50
+福斯 50
+离队 50
+秋夜 50
+秋波 50
+秦昭王 50
+税前 50
+稳产 50
+窜入 50
+立方体 50
+童志成 50
+第二十一条 50
+第十一条 50
+第十七届 50
+管家婆 50
+管理工作 50
+管理方 50
+箧 50
+簿记 50
+粗鄙 50
+粘合剂 50
+粮秣 50
+索子 50
+紧接 50
+紫微 50
+紫杉醇 50
+纯化 50
+经血 50
+结构力学 50
+维京 50
+绵软 50
+综合司 50
+罗马式 50
+罴 50
+群集 50
+羽扇 50
+老大哥 50
+老巢 50
+耐酸 50
+聿 50
+肇事者 50
+肥猪 50
+肥美 50
+肺鱼 50
+胰液 50
+脓液 50
+腊八粥 50
+自顾自 50
+臬 50
+良多 50
+节目单 50
+花会 50
+苍渔隐 50
+苦口婆心 50
+英国广播公司 50
+草原区 50
+荼毒 50
+葭 50
+蓦 50
+薛延 50
+藏民 50
+藩属 50
+蚊帐 50
+行迹 50
+衣雪 50
+补过 50
+襄王 50
+西奈半岛 50
+解放日报 50
+解约 50
+解表 50
+讨饶 50
+讲论 50
+许雪亭 50
+设若 50
+访问团 50
+评理 50
+诎 50
+试探性 50
+误诊 50
+说一不二 50
+请帖 50
+读卖新闻 50
+调适 50
+谍报 50
+谦卑 50
+谭平山 50
+豆角 50
+豺 50
+财贸 50
+贤才 50
+贾平凹 50
+赔本 50
+赔礼道歉 50
+赤子 50
+赤红 50
+赵军 50
+转调 50
+转账 50
+辊 50
+辗转反侧 50
+辩护律师 50
+辩白 50
+过奖 50
+运十 50
+还礼 50
+远近闻名 50
+连杆 50
+述评 50
+迷途 50
+道貌岸然 50
+邹容 50
+配乐 50
+酒器 50
+酒筵 50
+酥油 50
+醉翁之意不在酒 50
+重拳 50
+量词 50
+金口 50
+金铃 50
+钉鞋 50
+钏 50
+铁索 50
+铁锨 50
+铜币 50
+铜质 50
+销售部 50
+长卷 50
+闪光灯 50
+阁员 50
+阔大 50
+防潮 50
+阴魂 50
+阿秀 50
+附会 50
+陆军部 50
+陋习 50
+降格 50
+险阻 50
+陪话 50
+随声附和 50
+隐姓埋名 50
+隔板 50
+难得一见 50
+震旦纪 50
+青冈 50
+青梅竹马 50
+静安 50
+静思 50
+非金属矿 50
+面汤 50
+鞨 50
+顶风 50
+预估 50
+题跋 50
+颧 50
+风向标 50
+飘带 50
+飘飘然 50
+饭量 50
+驱策 50
+骓 50
+骨血 50
+骷 50
+鲵 50
+鲸鱼 50
+鸟岛 50
+麦地 50
+麻药 50
+黄光 50
+黄土地 50
+黄师爷 50
+黄秋雅 50
+黠戛斯 50
+鼓荡 50
+鼓风 50
+齐齐 50
+龙岩 50
+龙翔 50
+一万块 49
+一揽子 49
+一档 49
+一概而论 49
+七一 49
+七八天 49
+万港元 49
+三千年 49
+三千里 49
+三叶虫 49
+三响 49
+三季度 49
+三载 49
+上坟 49
+上海市政府 49
+上移 49
+不得人心 49
+不近人情 49
+专业户 49
+丘道长 49
+业务部 49
+丢人现眼 49
+严肃性 49
+中央人民政府政务院 49
+主存储器 49
+乐事 49
+乐土 49
+九霄 49
+习见 49
+书页 49
+云台山 49
+井然有序 49
+亚健康 49
+交通事故 49
+产道 49
+人事不知 49
+人民警察 49
+从古至今 49
+以方 49
+以毒攻毒 49
+休会 49
+伙同 49
+伤寒论 49
+伯祖 49
+余粮 49
+作品展 49
+信息学 49
+倨傲 49
+偏安 49
+催命 49
+先声夺人 49
+先王 49
+光程 49
+克己 49
+八达岭长城 49
+公厘 49
+公理化 49
+兴城 49
+兵强马壮 49
+养殖场 49
+内政部 49
+内热 49
+凝集 49
+凫 49
+凹凸不平 49
+出版业 49
+击节 49
+切线 49
+刘志祥 49
+刘根民 49
+刘武 49
+刘胡兰 49
+刘骜 49
+初中生 49
+初出茅庐 49
+前列腺素 49
+加工区 49
+勋绩 49
+勨 49
+包藏 49
+化学性 49
+北京站 49
+匹兹堡 49
+十一点 49
+十米 49
+华南理工大学 49
+协理 49
+单刀直入 49
+单叶 49
+南珠 49
+卧底 49
+卧榻 49
+印信 49
+印地语 49
+原罪 49
+叉腰 49
+反刍 49
+受益匪浅 49
+口实 49
+叵耐 49
+司法部长 49
+叻 49
+各向同性 49
+合写 49
+同形 49
+后缀 49
+向心力 49
+吕七 49
+吴起 49
+吸热 49
+周亚夫 49
+周大哥 49
+呼伦贝尔 49
+哈罗 49
+哥特 49
+哲理性 49
+唐绍仪 49
+唯物论 49
+唯美 49
+啋 49
+喁 49
+喝西北风 49
+喷火器 49
+四手 49
+回暖 49
+因应 49
+国威 49
+国粹 49
+图式 49
+圈儿 49
+在押 49
+地广人稀 49
+地方戏 49
+均分 49
+埙 49
+基洛夫 49
+堵车 49
+塔中 49
+塞曼 49
+处理厂 49
+外资股 49
+外高加索 49
+多嘴 49
+多多益善 49
+夥同 49
+大中专 49
+大中华 49
+大义凛然 49
+大利 49
+大后方 49
+大显 49
+大白天 49
+大白菜 49
+天台宗 49
+天威保变 49
+天威英 49
+天气图 49
+太岁 49
+太平无事 49
+失之交臂 49
+失迎 49
+头胸甲 49
+奇效 49
+套件 49
+套取 49
+女强人 49
+女真族 49
+女高音 49
+奸徒 49
+好好先生 49
+如入无人之境 49
+妖魔化 49
+姚锡铭 49
+姨奶奶 49
+威慑力 49
+威震天下 49
+娇嗔 49
+婺州 49
+宅基地 49
+宇航局 49
+宋子文 49
+宋廷 49
+官窑 49
+官营 49
+定西 49
+实利 49
+宫铭 49
+富士通 49
+寻宝 49
+寿衣 49
+尉迟 49
+尼尔森 49
+尼罗 49
+展销 49
+展销会 49
+崔玉蓉 49
+工薪阶层 49
+巴士拉 49
+巴比 49
+巴西人 49
+市中心区 49
+帕子 49
+平仄 49
+序数 49
+库兹涅佐夫 49
+庚子 49
+庸才 49
+延时 49
+建兴 49
+开小差 49
+异位 49
+异步 49
+式微 49
+弗拉 49
+张北县 49
+待发 49
+微生物学 49
+忧愤 49
+怅 49
+怆 49
+急诊室 49
+惟妙惟肖 49
+惠及 49
+惨绝人寰 49
+惯性力 49
+意向书 49
+扁豆 49
+手部 49
+扑腾 49
+打成一片 49
+打散 49
+托孤 49
+扩及 49
+扪 49
+扬戈 49
+批发商 49
+投案 49
+抢滩 49
+报考者 49
+押运 49
+拜耳 49
+振荡器 49
+捉摸不定 49
+捶胸顿足 49
+掐死 49
+接洽 49
+搪 49
+操作员 49
+收报 49
+救苦救难 49
+教唆 49
+数百米 49
+文绉绉 49
+新大陆 49
+新机 49
+旅顺口 49
+无后 49
+无穷大 49
+早逝 49
+明知故问 49
+易观 49
+昭陵 49
+晔 49
+晨昏 49
+普照 49
+晶形 49
+暖烘烘 49
+暮年 49
+曲江 49
+替代品 49
+有求于 49
+本溪市 49
+机库 49
+李亚平 49
+李家寨 49
+李淳风 49
+来来回回 49
+杨一 49
+杨度 49
+杨溢 49
+杨秀清 49
+构筑物 49
+果冻 49
+枪榴弹 49
+枯瘦 49
+柳家 49
+校本 49
+核仁 49
+根式 49
+桥洞 49
+横线 49
+橡 49
+欢声笑语 49
+欧莱雅 49
+正极 49
+正阳 49
+步调 49
+武夷山脉 49
+武库 49
+武王伐 49
+殷吉 49
+母猪 49
+民团 49
+气垫船 49
+气愤愤 49
+水浸 49
+水球 49
+水蛇 49
+水车 49
+沃尔科特 49
+沉雄 49
+沙巴 49
+沙拉 49
+沙里 49
+波兰人 49
+泰铢 49
+洛河 49
+浇铸 49
+浓香 49
+海冰 49
+海安 49
+海缆 49
+深蓝 49
+湭 49
+满布 49
+潮热 49
+澄澈 49
+灰分 49
+灰溜溜 49
+炪 49
+烂漫 49
+爱河 49
+爽约 49
+牛排 49
+特立独行 49
+独独 49
+狼嗥 49
+献计 49
+玉如意 49
+玉枕 49
+王世充 49
+王座 49
+王灿 49
+玩忽职守 49
+环境温度 49
+珍禽 49
+甚而 49
+用度 49
+电子电路 49
+电晕 49
+电表 49
+男爵 49
+留声机 49
+畚 49
+畜群 49
+疯狗 49
+痞子 49
+白发苍苍 49
+白喉 49
+白毛女 49
+白鸽 49
+百万元 49
+盖洛 49
+眼中钉 49
+短时 49
+石沉大海 49
+矿脉 49
+砖红壤 49
+破袭 49
+砷化镓 49
+硬邦邦 49
+碣 49
+祝祷 49
+祟 49
+禀性 49
+禁赛 49
+离心机 49
+私语 49
+秋色 49
+科协 49
+科学研究 49
+秦淮 49
+稍为 49
+穰 49
+突显 49
+童男童女 49
+笑容满面 49
+第一周 49
+第三座 49
+精纯 49
+糖原 49
+系里 49
+索斯 49
+索非亚 49
+紫光 49
+紫星 49
+红衣主教 49
+约定俗成 49
+纳尔 49
+纸袋 49
+组织部门 49
+绊脚石 49
+经得住 49
+结仇 49
+结对 49
+结怨 49
+结论性 49
+绘声绘色 49
+绝后 49
+绝大 49
+绝对化 49
+绞车 49
+缓坡 49
+罗人杰 49
+罗林斯 49
+罗耶定 49
+罽 49
+老农 49
+老手 49
+耍赖 49
+背板 49
+胶鞋 49
+脱毛 49
+膲 49
+自愈 49
+自生自灭 49
+自私自利 49
+自讨苦吃 49
+般配 49
+良莠不齐 49
+艳福 49
+苏武 49
+苗圃 49
+英雄传 49
+荣誉感 49
+莫衷一是 49
+董事局 49
+蒸汽轮机 49
+蔡锷 49
+虚虚实实 49
+蛊惑人心 49
+蛐蛐 49
+蛙泳 49
+蜇 49
+蟢 49
+血管瘤 49
+行政法 49
+补药 49
+表兄弟 49
+衿 49
+装糊涂 49
+西哥特 49
+议会党团 49
+讳莫如深 49
+评断 49
+误打误撞 49
+诸暨 49
+豫园 49
+贤士 49
+账款 49
+账簿 49
+贬斥 49
+贯串 49
+费神 49
+贺礼 49
+赵三爷 49
+趣闻 49
+路遇 49
+跳马 49
+轩昂 49
+转体 49
+轻质 49
+边沟 49
+迁飞 49
+迂回曲折 49
+近视眼 49
+返乡 49
+连片 49
+逆来顺受 49
+选编 49
+通用性 49
+逦 49
+遗老 49
+郝爱国 49
+鄂尔 49
+酒盅 49
+酒都 49
+酸钠 49
+野餐 49
+铁饼 49
+铜丝 49
+铜锣 49
+铡刀 49
+销售员 49
+锅台 49
+错开 49
+长青 49
+门市部 49
+阎李寨 49
+阮小二 49
+阻截 49
+阿尤 49
+阿巴 49
+陵县 49
+陶宫娥 49
+隐形技术 49
+隔夜 49
+隽永 49
+霜雪 49
+青木堂 49
+面额 49
+面饼 49
+韩国政府 49
+顺流 49
+颇丰 49
+风带 49
+飞轮 49
+饿鬼 49
+馥郁 49
+马来西亚队 49
+马赫数 49
+驱邪 49
+驾云 49
+骂娘 49
+骨化 49
+高洁 49
+鱼肝油 49
+鲤科 49
+鳐 49
+鳕鱼 49
+鳞茎 49
+黄炎培 49
+黄芩 49
+黑水河 49
+黑袍 49
+黥 49
+一夫多妻制 48
+一来二去 48
+万事大吉 48
+三岛 48
+三度 48
+三段 48
+三等功 48
+三资 48
+三起 48
+下腹 48
+不甘落后 48
+世博 48
+世外 48
+东帝汶 48
+丝绒 48
+两三万 48
+丧胆 48
+中间价 48
+丰碑 48
+义诊 48
+乐帮 48
+乐谱 48
+乔二爷 48
+乔布斯 48
+乔装 48
+九分 48
+九届 48
+书道 48
+乾渠 48
+了然于胸 48
+争创 48
+二十大 48
+于全邦 48
+交易额 48
+人民文学出版社 48
+人造革 48
+仓廪 48
+以德 48
+任重道远 48
+伯仲之间 48
+伴娘 48
+体毛 48
+何年 48
+余辆 48
+作品集 48
+依曼 48
+侯宝山 48
+侯马 48
+俚语 48
+俞志忠 48
+停停 48
+傈僳 48
+儨 48
+元江 48
+先遣队 48
+光润 48
+克尔得 48
+克尼杜 48
+克里亚 48
+党国 48
+全球定位系统 48
+八达 48
+八面 48
+六角形 48
+关顾 48
+兽骨 48
+内江 48
+农科村 48
+冤大头 48
+冯胜 48
+决死 48
+决策层 48
+决胜局 48
+净水 48
+凝成 48
+几许 48
+出拳 48
+出版商 48
+分布图 48
+分蘖 48
+分选 48
+分野 48
+刘玄德 48
+刘皇叔 48
+刚毛 48
+利爪 48
+利维 48
+前嫌 48
+前无古人 48
+割开 48
+加拿大人 48
+势不可挡 48
+勒布朗 48
+包膜 48
+北区 48
+十几家 48
+千吨 48
+华南虎 48
+单面 48
+南中国海 48
+南充 48
+博物院 48
+卢晓南 48
+卫军 48
+印刷厂 48
+卷烟厂 48
+历朝历代 48
+压服 48
+压条 48
+原体 48
+原初 48
+友邦 48
+发汗 48
+变法维新 48
+叶向高 48
+号炮 48
+同上 48
+吕第亚 48
+吟哦 48
+含盐量 48
+听之任之 48
+吴亮 48
+吴斌 48
+周仲 48
+周显谟 48
+周老 48
+周青臣 48
+呼伦贝尔盟 48
+咸味 48
+哈谟第 48
+唐三藏 48
+唐铉 48
+唐骏 48
+商汤 48
+商铺 48
+啊呀 48
+啧啧称奇 48
+啮 48
+喝斥 48
+喹啉 48
+喻为 48
+嘉禾 48
+嘴硬 48
+器乐曲 48
+四号 48
+四望 48
+回礼 48
+圆锥曲线 48
+场场 48
+垂询 48
+埃尔 48
+埋骨 48
+基多 48
+基本粒子 48
+堇 48
+塔兰顿 48
+壃 48
+壳子 48
+备办 48
+多亿美元 48
+夤 48
+大型机 48
+大罗 48
+天外 48
+太仆寺 48
+太宗皇帝 48
+头条 48
+头胸部 48
+夺占 48
+奇正 48
+奔马 48
+奥庞波 48
+好久好久 48
+如日中天 48
+如醉如痴 48
+妄自尊大 48
+妖孽 48
+妯娌 48
+始终不渝 48
+娱乐性 48
+孙隆 48
+孟伯飞 48
+孟尝君 48
+学理 48
+孳生 48
+孵化期 48
+宇文虚 48
+安大略省 48
+安妮 48
+宋德方 48
+定计 48
+宝货 48
+宫苑 48
+富春江 48
+富阳 48
+寓居 48
+导弹艇 48
+封书子 48
+封闭性 48
+小两口 48
+小宋 48
+小报 48
+小旦 48
+小标题 48
+小瞧 48
+小视 48
+少先队员 48
+居民点 48
+展演 48
+山芋 48
+山陵 48
+工程系 48
+巴厘岛 48
+巴斯德 48
+希亚 48
+干儿子 48
+干渠 48
+幸运儿 48
+床头柜 48
+库拉 48
+库里 48
+康文涛 48
+开创者 48
+开成 48
+开诚布公 48
+弄懂 48
+张灯结彩 48
+彭茜卢 48
+役畜 48
+徐先生 48
+徐弘 48
+微利 48
+德胜 48
+心知肚明 48
+忧思 48
+怒放 48
+怪模怪样 48
+总医院 48
+总论 48
+恐惧感 48
+情敌 48
+想像力 48
+感性认识 48
+慢慢来 48
+戏院 48
+成山 48
+房梁 48
+托管地 48
+抄斩 48
+投稿 48
+抛头露面 48
+报名表 48
+拉各斯 48
+拉祜族 48
+拐角 48
+挺举 48
+捞钱 48
+据理力争 48
+掀动 48
+排斥异己 48
+排涝 48
+掘开 48
+搜救 48
+摝 48
+撒手不管 48
+攀爬 48
+支农 48
+攸关 48
+故弄玄虚 48
+敬而远之 48
+数周 48
+文成公主 48
+文物局 48
+文献学 48
+斋饭 48
+斑岩 48
+斯特林 48
+新低 48
+旁敲侧击 48
+旁落 48
+旅游线 48
+无垠 48
+时气 48
+昆泰 48
+明争暗斗 48
+明州 48
+易传 48
+星形 48
+映入 48
+春雷 48
+显圣 48
+普罗米修斯 48
+更衣室 48
+曼生 48
+有名无实 48
+有善德 48
+服务台 48
+期中 48
+木椅 48
+朱可夫 48
+机械性 48
+朽烂 48
+李佳明 48
+李冰 48
+李建英 48
+杜克 48
+杨利普 48
+杨本庵 48
+杰弗森 48
+板结 48
+果木 48
+枪刺 48
+柔媚 48
+标新立异 48
+核禁试 48
+根尼 48
+桌布 48
+桑蚕 48
+梅县 48
+梕 48
+梯恩梯 48
+槿 48
+横征暴敛 48
+横越 48
+橙黄色 48
+次大陆 48
+欢叫 48
+欧洲联盟 48
+正本 48
+正黄旗 48
+武打 48
+武穆 48
+歧异 48
+殳 48
+毕达库 48
+毛海峯 48
+毛海峰 48
+氅 48
+民企 48
+气人 48
+气哼哼 48
+气筒 48
+水上飞机 48
+水杉 48
+汇丰 48
+汉光 48
+江安 48
+沃岛 48
+沈祥福 48
+沱江 48
+河工 48
+油膏 48
+法式 48
+法网 48
+波尔 48
+洞穿 48
+洲际导弹 48
+洳 48
+活性炭 48
+流苏 48
+济助 48
+浑然 48
+浑然一体 48
+浓浓 48
+海山 48
+海量 48
+海鱼 48
+消毒剂 48
+润色 48
+港汊 48
+游乐园 48
+游手好闲 48
+滇南 48
+滑板 48
+漠漠 48
+灾变 48
+炉灶 48
+烦人 48
+熊市 48
+爱不释手 48
+爱神 48
+牛肉面 48
+牛肉麪 48
+牧歌 48
+牵累 48
+狄奥克 48
+王京 48
+王屋山 48
+王彩 48
+王霞斐 48
+球赛 48
+琮 48
+瑞典人 48
+甘宝宝 48
+生产厂 48
+由城邦 48
+由近及远 48
+皮萨罗 48
+盲点 48
+看家本领 48
+矫情 48
+短袖 48
+祖祖辈辈 48
+神秘化 48
+禅堂 48
+福冈 48
+私交 48
+科拉迪 48
+科洛封 48
+科里 48
+移转 48
+穷乡僻壤 48
+突击队员 48
+窗户纸 48
+章进 48
+童儿 48
+第一枚 48
+第一段 48
+第一路 48
+第九条 48
+第五种 48
+笳 48
+答理 48
+管理条例 48
+管理部 48
+箶 48
+粝 48
+精神病院 48
+糅合 48
+纠合 48
+纠错 48
+红曲红 48
+红藻 48
+约法三章 48
+线段 48
+组团社 48
+绨 48
+维吾尔语 48
+维达 48
+缩成一团 48
+网开一面 48
+置入 48
+美利坚 48
+美林 48
+羞愤 48
+群龙无首 48
+翻车 48
+老奸巨猾 48
+老宋 48
+老鸦 48
+肷 48
+肺气肿 48
+胖瘦 48
+胡汉民 48
+胰岛 48
+胸罩 48
+腐臭 48
+膨松剂 48
+自得其乐 48
+自流 48
+至爱 48
+航海图 48
+航运业 48
+花花世界 48
+苏联最高苏维埃 48
+苯甲酸钠 48
+范慧 48
+茚 48
+荆楚网 48
+草坡 48
+草席 48
+葡萄架 48
+蒲公英 48
+蓩 48
+蚹 48
+血肉相连 48
+衣架 48
+补编 48
+表尺 48
+表演者 48
+袁将军 48
+被控 48
+被覆 48
+褴褛 48
+西飞 48
+解劝 48
+记仇 48
+记账 48
+词根 48
+诟病 48
+诸善德 48
+诺尔 48
+谷仓 48
+豢养 48
+豪言壮语 48
+贞节 48
+贪玩 48
+资方 48
+赐与 48
+走弯路 48
+超级市场 48
+蹩脚 48
+车船 48
+软弱无力 48
+轻生 48
+辛兴宗 48
+过虑 48
+迈入 48
+迈尔 48
+运动学 48
+还乡团 48
+远远近近 48
+远道而来 48
+迷魂 48
+退朝 48
+退潮 48
+送客 48
+逗人 48
+通信兵 48
+逢场作戏 48
+逯 48
+道尔 48
+道德经 48
+遗孀 48
+那书生 48
+那老儿 48
+邦政府 48
+郑三娘 48
+郭靖忙 48
+配伍 48
+酒钱 48
+采取措施 48
+金钱松 48
+钜 48
+钫 48
+铁合金 48
+铁氧体 48
+铅锌 48
+铟 48
+铺张浪费 48
+链霉素 48
+错话 48
+镇定自若 48
+问清 48
+闻名遐迩 48
+阄 48
+防灾 48
+阿卡亚 48
+阿姆斯特朗 48
+附属物 48
+陈永森 48
+降压药 48
+除法 48
+陨星 48
+隹 48
+难解难分 48
+集锦 48
+零售额 48
+雷基 48
+霸州 48
+非正规 48
+韵致 48
+顾此失彼 48
+预算内 48
+预算案 48
+风度翩翩 48
+飞蛾 48
+食用油 48
+马亚 48
+马士 48
+驶往 48
+骨灰盒 48
+鬣狗 48
+鱼油 48
+鸑 48
+黑云母 48
+黑瞎子 48
+默写 48
+鼓点 48
+齐齐哈尔市 48
+龙岭 48
+一九九八年 47
+一刻钟 47
+一千五百 47
+一线生机 47
+一锅粥 47
+一集 47
+七八十 47
+万劫不复 47
+万只 47
+万盏 47
+万贯 47
+三十余里 47
+三回 47
+三番五次 47
+三行 47
+三面红旗 47
+上半期 47
+上谷 47
+下降时 47
+不偏不倚 47
+不分青红皂白 47
+不识好歹 47
+两成 47
+两起 47
+两顿 47
+中专生 47
+丰茂 47
+临客 47
+举国上下 47
+乖孩子 47
+书吏 47
+二三月 47
+二十四史 47
+二斤 47
+亘古 47
+亚马逊河 47
+交响诗 47
+京剧团 47
+介壳 47
+仙界 47
+以彼之道 47
+伊洛瓦底江 47
+众矢之的 47
+会堂 47
+何铁手 47
+余艘 47
+你们好 47
+依此类推 47
+侯景 47
+保护神 47
+保税区 47
+保藏 47
+保释 47
+保障部门 47
+信鸽 47
+修订本 47
+偂 47
+兄嫂 47
+光点 47
+免予 47
+入殓 47
+八九分 47
+公勤 47
+公粮 47
+公道话 47
+六号 47
+六旬 47
+六腑 47
+共党 47
+兵燹 47
+养母 47
+兽性 47
+冀鲁豫 47
+内海 47
+内蕴 47
+写手 47
+农牧区 47
+决定权 47
+凉鞋 47
+减速器 47
+凑趣 47
+几户 47
+几朵 47
+凶器 47
+出轨 47
+分则 47
+列宁主义 47
+刘孔 47
+刘忠德 47
+制伏 47
+力排众议 47
+加仑 47
+加西亚 47
+勒死 47
+勤勤恳恳 47
+包罗万象 47
+化学分析 47
+化肥厂 47
+北大营 47
+十几块 47
+升船机 47
+半两 47
+南怀仁 47
+博尔济吉 47
+卡利亚 47
+卫星城 47
+印度斯坦 47
+印支 47
+厉行 47
+厌食 47
+厚朴 47
+厶 47
+参评 47
+双曲 47
+双管齐下 47
+反作用力 47
+只字不提 47
+叶面 47
+司马相如 47
+吃光 47
+各样 47
+吉伦 47
+同里 47
+含盐度 47
+吭哧 47
+吴廷英 47
+吸烟者 47
+和平区 47
+咪唑 47
+咯血 47
+唐书 47
+唐学曾 47
+唐开元 47
+唿 47
+啡 47
+喃 47
+喜孜孜 47
+四件 47
+四大发明 47
+因由 47
+国务院学位委员会 47
+地直 47
+地级 47
+坐禅 47
+坦噶尼喀 47
+堃 47
+塔拉 47
+塾 47
+墓志 47
+墙垣 47
+壁厢 47
+处理不当 47
+多一分 47
+多万名 47
+多具 47
+多尔 47
+多肽 47
+大动干戈 47
+大半辈子 47
+大嫂子 47
+大椎穴 47
+天山山脉 47
+天父 47
+太白山 47
+头寸 47
+套管 47
+好大喜功 47
+好头 47
+婆罗洲 47
+孔融 47
+季风区 47
+孤傲 47
+学以致用 47
+孩子气 47
+安彩 47
+宋江听 47
+完满 47
+官能 47
+室女 47
+宫观 47
+家村 47
+家母 47
+密特朗 47
+寳 47
+小文 47
+小金 47
+崏 47
+嶷 47
+工棚 47
+左右开弓 47
+左帅 47
+布雷斯 47
+帝辛 47
+干休 47
+平民化 47
+幼儿教育 47
+应接不暇 47
+废渣 47
+庶几 47
+延边队 47
+开价 47
+开矿 47
+弄巧成拙 47
+弓形 47
+弟媳 47
+张治中 47
+张洁 47
+张黎 47
+弭 47
+强化剂 47
+征辟 47
+徐俊鸣 47
+徐震纲 47
+徒有虚名 47
+徵辟 47
+德山 47
+忧郁症 47
+念旧 47
+忻州 47
+总罢工 47
+惩罚性 47
+惹事生非 47
+愁云 47
+愚民 47
+戈尔 47
+成菊 47
+战团 47
+戴克里 47
+戴戈 47
+扇贝 47
+手气 47
+托庇 47
+扠 47
+扩音器 47
+报怨 47
+抱头痛哭 47
+拉肚子 47
+招兵 47
+招兵买马 47
+招摇过市 47
+挂彩 47
+挞 47
+挡风 47
+挤眉弄眼 47
+捉奸 47
+捐税 47
+掩人耳目 47
+搅扰 47
+搜狐公司 47
+摩根斯坦利 47
+撑船 47
+收工 47
+改向 47
+救世 47
+敖彪 47
+教育会 47
+数只 47
+整点 47
+文庙 47
+新剧 47
+新词 47
+方法学 47
+无可挑剔 47
+无所不为 47
+无量山 47
+日本国 47
+时长 47
+明胶 47
+明辨 47
+易木云 47
+星星点点 47
+晋中 47
+晋祠 47
+暮霭 47
+月相 47
+有产阶级 47
+有备而来 47
+有难同当 47
+木片 47
+机械运动 47
+权利能力 47
+李延宗 47
+李润田 47
+来劲 47
+杨鸣 47
+松木 47
+枕边 47
+果脯 47
+枝城 47
+枭雄 47
+树林子 47
+格格娇 47
+桓玄 47
+梁山伯 47
+梅树 47
+榔 47
+樟木 47
+欧化 47
+正房 47
+步步为营 47
+武堂 47
+武臣 47
+残联 47
+殡仪馆 47
+毒水 47
+毫秒 47
+民族性 47
+气机 47
+永济渠 47
+汉宣帝 47
+没辙 47
+油彩 47
+泄愤 47
+波罗蜜 47
+洋钱 47
+活络 47
+流言蜚语 47
+浓缩铀 47
+浩繁 47
+淡红色 47
+淡绿 47
+深明大义 47
+混纺 47
+清唱 47
+清苦 47
+清规戒律 47
+渔具 47
+湖南大学 47
+滚瓜烂熟 47
+滴哒 47
+漫无边际 47
+潜水员 47
+潜热 47
+潸然泪下 47
+激进派 47
+火上浇油 47
+火炭 47
+灵牌 47
+炊具 47
+热值 47
+热气球 47
+烽火台 47
+熊元献 47
+熟铁 47
+燃放 47
+燕园 47
+物镜 47
+状态方程 47
+玉蜂 47
+王超 47
+玩乐 47
+球拍 47
+理论物理 47
+琉球群岛 47
+琤 47
+瑙鲁 47
+生死之交 47
+田主 47
+田虎 47
+电子政务 47
+男女平等 47
+界外球 47
+留人 47
+留名 47
+疔 47
+疯话 47
+痛风 47
+白令海 47
+白山 47
+白恩杰 47
+百强 47
+皇甫阁 47
+皈 47
+皎然 47
+盆中 47
+益邦 47
+盐化 47
+盐枭 47
+看官 47
+真面目 47
+着床 47
+矇牛 47
+矉 47
+短兵相接 47
+短工 47
+石剑 47
+砀山 47
+砍伤 47
+砭 47
+破烂不堪 47
+硕果 47
+硖 47
+硦 47
+硬弓 47
+磁感应 47
+礇 47
+示波器 47
+神乎其神 47
+神秘感 47
+神龛 47
+祥子 47
+票友 47
+禄寺 47
+秋纹 47
+租车 47
+秷 47
+秾 47
+程序化 47
+程颢 47
+税则 47
+稣 47
+穹顶 47
+空山 47
+窈 47
+笑纳 47
+笔致 47
+第三只 47
+第三道 47
+第五天 47
+第六卷 47
+第十条 47
+箓 47
+米尼 47
+精当 47
+糟踏 47
+紒 47
+织女星 47
+经委 47
+结余 47
+维克斯 47
+绿宝石 47
+缓期 47
+缚鸡之力 47
+缤 47
+缺额 47
+罗姆 47
+罗安江 47
+罗马城 47
+羊水 47
+美中不足 47
+美国在线 47
+羧基 47
+耳廓 47
+股份合作制 47
+脑细胞 47
+腥风血雨 47
+腮帮子 47
+腰围 47
+自诉 47
+舞者 47
+航天站 47
+船篷 47
+良友 47
+良辰 47
+节欲 47
+花容月貌 47
+苏格兰人 47
+若虫 47
+茸毛 47
+荒诞不经 47
+莫如 47
+菪 47
+萧瑟 47
+董超 47
+蒙牛 47
+蔡永兴 47
+虎啸 47
+虾类 47
+蛇胆 47
+蛳 47
+蜡丸 47
+蝶泳 47
+融融 47
+螺丝 47
+血腥气 47
+衣橱 47
+表示法 47
+褚墨伦 47
+角闪石 47
+言念 47
+言而有信 47
+詹事府 47
+计大妙 47
+计票 47
+讹诈 47
+论处 47
+诗文集 47
+语音学 47
+调虎离山 47
+谋臣 47
+谳 47
+豪侠 47
+豪士 47
+财大 47
+败给 47
+贪污腐化 47
+贾冰 47
+赤卫队 47
+赤峯市 47
+赤峰市 47
+赤松 47
+赤水河 47
+走俏 47
+走马上任 47
+赶场 47
+趋之若鹜 47
+踏遍 47
+踒 47
+踦 47
+躯干部 47
+转基因 47
+轻轻松松 47
+辅酶 47
+输油管 47
+辔头 47
+辞海 47
+边郡 47
+迟暮 47
+追思 47
+通县 47
+通河 47
+道奇 47
+遗尿 47
+邓绶林 47
+邕 47
+郜林 47
+郝思文 47
+郭汜 47
+郾 47
+鄯 47
+酸酐 47
+醉醺醺 47
+重阳节 47
+金光明 47
+鐾 47
+针剂 47
+针叶树 47
+钱三强 47
+铁匠铺 47
+铁托 47
+铜片 47
+铺陈 47
+锁上 47
+错杂 47
+闫相闯 47
+闷响 47
+阮籍 47
+阴湿 47
+阿克巴 47
+阿司匹林 47
+阿鲁台 47
+陆九渊 47
+陈晓兰 47
+雁行 47
+雍和宫 47
+震旦 47
+青云 47
+顾宪成 47
+颌骨 47
+颜真卿 47
+风俗画 47
+飘香 47
+飞燕 47
+食邑 47
+饭堂 47
+馊主意 47
+香皂 47
+马关 47
+马彦超 47
+马腾 47
+驿卒 47
+骙 47
+高个儿 47
+鲁山 47
+鲜于通 47
+鲽 47
+鸡肋 47
+麻类 47
+麻老四 47
+麻脸 47
+黄巢起义 47
+黑天 47
+鼓鼓的 47
+鼻烟壶 47
+一罐 46
+一钱不值 46
+一锤定音 46
+丁二烯 46
+七情 46
+万劫谷 46
+三万两 46
+三家村 46
+三流 46
+三脚 46
+上海铁路局 46
+下挫 46
+不义之财 46
+不冷不热 46
+不甘示弱 46
+不管三七二十一 46
+不识时务 46
+与人为善 46
+丘成桐 46
+两滴 46
+严词 46
+中共中央党校 46
+中国农工民主党 46
+中国同盟会 46
+中国海关 46
+中旅总社 46
+丰台区 46
+主动轮 46
+丽春 46
+乐理 46
+乘数 46
+乞婆 46
+乡巴佬 46
+书屋 46
+乱来 46
+争持 46
+二三十个 46
+二十三岁 46
+于洋 46
+云烟 46
+五十个 46
+井边 46
+亚利桑那州 46
+亚的斯亚贝巴 46
+亚非拉 46
+亚音速 46
+交易量 46
+享有盛誉 46
+亭台楼阁 46
+亲民党 46
+从简 46
+价值链 46
+伊利湖 46
+传习 46
+供电局 46
+信源 46
+修水 46
+倍率 46
+倒刺 46
+假根 46
+假释 46
+元成 46
+克复 46
+六家 46
+兰州市 46
+兰考 46
+内忧外患 46
+内敛 46
+内质网 46
+再嫁 46
+军品 46
+军界 46
+农技 46
+冲毁 46
+冷光 46
+冷面 46
+冷麪 46
+凉山 46
+几十家 46
+几头 46
+几起 46
+凯里 46
+出版界 46
+出航 46
+分装 46
+切切 46
+刚劲 46
+别来无恙 46
+到户 46
+制做 46
+削平 46
+前仰后合 46
+前臂 46
+割草 46
+办事员 46
+功成名就 46
+劣等 46
+劳心 46
+十一岁 46
+十余日 46
+千万年 46
+千瓦时 46
+半点儿 46
+华力西 46
+华青 46
+协作区 46
+协调性 46
+单杠 46
+单间 46
+南华 46
+原生态 46
+发胀 46
+古板 46
+古镜 46
+史季强 46
+叶传萍 46
+叶志清 46
+叶江川 46
+叶轮 46
+司库 46
+司徒尚纪 46
+司法权 46
+吃不了兜着走 46
+各抒己见 46
+吆喝声 46
+合众国 46
+吉诃德 46
+名山大川 46
+名花 46
+吴淞江 46
+吸声 46
+吹熄 46
+呆账 46
+咨询会 46
+咬定 46
+咸海 46
+哀号 46
+哀鸣 46
+哈尔 46
+哪门子 46
+唇膏 46
+喷嘴 46
+嗥 46
+因数 46
+困难户 46
+国破家亡 46
+国资 46
+地拉那 46
+地貌学 46
+地震学 46
+垂涎 46
+城垛 46
+基本型 46
+塔台 46
+增兵 46
+声韵 46
+夏禹 46
+多亿元 46
+多口 46
+多指 46
+大写 46
+大行其道 46
+天伦之乐 46
+天成 46
+天纵 46
+天聪 46
+奇美 46
+女声 46
+女装 46
+女贞 46
+奸佞 46
+好几遍 46
+好又多 46
+妖娆 46
+妖气 46
+姆妈 46
+姑丈 46
+姑婆 46
+姑嫂 46
+姢 46
+嫌疑犯 46
+嬁 46
+嬲 46
+孔明笑 46
+孔洞 46
+孙海平 46
+安丰 46
+安帝 46
+宋清 46
+官印 46
+定时炸弹 46
+定约 46
+宛城 46
+实打实 46
+实践论 46
+客气话 46
+宣州 46
+寝食难安 46
+寸草不生 46
+导流 46
+小人儿 46
+小团体 46
+小有名气 46
+小王爷 46
+尘沙 46
+尚方宝剑 46
+尹志 46
+局里 46
+岛津 46
+岩屑 46
+峡口 46
+崖顶 46
+崩裂 46
+崭 46
+巡哨 46
+工兵团 46
+工时 46
+巨无霸 46
+巴布亚 46
+布达 46
+帹 46
+平川 46
+平炉 46
+平身 46
+年金 46
+库尔 46
+库斯科 46
+底格里斯河 46
+康涅狄格州 46
+廴 46
+开窗 46
+开立 46
+开起 46
+弄鬼 46
+引火 46
+张艺谋 46
+弥漫性 46
+弱国 46
+强直 46
+影音 46
+彻底性 46
+徇 46
+微露 46
+德智体 46
+心悦诚服 46
+心肌炎 46
+心脑血管 46
+忠厚老实 46
+怀才不遇 46
+急转直下 46
+性高潮 46
+总理府 46
+悬崖勒马 46
+悲痛欲绝 46
+情投意合 46
+惊惶失措 46
+惫懒 46
+意蕴 46
+懗 46
+成日 46
+我院 46
+战力 46
+战斗性 46
+战罢 46
+房客 46
+托塔 46
+扩张主义 46
+抖落 46
+抗税 46
+抟 46
+护肤 46
+抹黑 46
+拉巴特 46
+拉斯维加斯 46
+拜物教 46
+拦不住 46
+拼接 46
+持有者 46
+捡拾 46
+提琴 46
+揪斗 46
+搬入 46
+播下 46
+支路 46
+政治史 46
+救急 46
+教职员工 46
+教育法 46
+敬服 46
+敲门砖 46
+文弱 46
+文质彬彬 46
+断肢 46
+新界 46
+新车 46
+族类 46
+无虞 46
+日夜兼程 46
+昆士兰州 46
+晋北 46
+晦暗 46
+晷 46
+有去无回 46
+有悖于 46
+有感 46
+未卜 46
+杂货店 46
+权责 46
+李昊 46
+李植 46
+李阎王 46
+李高 46
+杨霞 46
+杯水 46
+松涛 46
+枪身 46
+枫叶 46
+某月 46
+柑桔 46
+核型 46
+根底 46
+格雷 46
+栽培植物 46
+桉树 46
+档子 46
+梁先生 46
+棉织 46
+楚楚可怜 46
+楚霸王 46
+楼宇 46
+横断山 46
+正月初一 46
+正途 46
+歼灭战 46
+殢 46
+毛头 46
+氙 46
+氢氧化物 46
+氢键 46
+水样 46
+永存 46
+求同存异 46
+污言秽语 46
+油亮 46
+油污 46
+油菜花 46
+泥板 46
+泰戈尔 46
+洋酒 46
+洗练 46
+洞府 46
+洪熙 46
+活动性 46
+浏 46
+浑仪 46
+浓荫 46
+海顿 46
+涌流 46
+深褐色 46
+混合泳 46
+温方山 46
+游廊 46
+滋扰 46
+漠河 46
+潴留 46
+火舌 46
+灰褐 46
+灵渠 46
+炒鸡蛋 46
+烧灼 46
+热塑性 46
+热效率 46
+燧石 46
+爱国统一战线 46
+爵士乐 46
+牙科 46
+牟取暴利 46
+牢笼 46
+特多 46
+特快 46
+特遣部队 46
+犯嘀咕 46
+状告 46
+献艺 46
+王时雍 46
+王珍如 46
+玩世不恭 46
+玻色子 46
+珠光宝气 46
+班头 46
+琴曲 46
+琴棋书画 46
+生词 46
+电视网 46
+男工 46
+画幅 46
+畜力 46
+痛觉 46
+瘦瘦的 46
+白水 46
+白起 46
+百灵 46
+百花奖 46
+盆栽 46
+监察部门 46
+盛期 46
+目标价 46
+相左 46
+瞠目 46
+石印 46
+石塔 46
+砌筑 46
+硅石 46
+硼酸 46
+磁山 46
+礼拜堂 46
+神曲 46
+神物 46
+种皮 46
+科学奖 46
+秧 46
+稀稀落落 46
+程朱 46
+稳如泰山 46
+稼轩 46
+穆里尼 46
+空壳 46
+空穴来风 46
+空载 46
+站队 46
+端木 46
+竹椅 46
+第一局 46
+第十七 46
+第十三条 46
+第十五条 46
+第十四条 46
+等价交换 46
+简报 46
+粪土 46
+紫胶虫 46
+红霉素 46
+纪念塔 46
+纳妾 46
+纸箱 46
+纸老虎 46
+细菌武器 46
+细观 46
+细读 46
+经济林木 46
+绝杀 46
+绝缘性 46
+统揽全局 46
+缜 46
+网络资源 46
+罗思 46
+罗摩 46
+美国联邦 46
+美菱 46
+翩翩起舞 46
+翻山越岭 46
+老万 46
+老吕 46
+老妪 46
+老金 46
+耆那教 46
+耶鲁 46
+聚首 46
+肖上唇 46
+肘弯 46
+肢解 46
+育儿 46
+自封 46
+自然语言 46
+致命伤 46
+舞台剧 46
+舾 46
+良缘 46
+艾哈迈德 46
+芈 46
+芦笋 46
+花莲 46
+花蕊 46
+苛政 46
+英雄主义 46
+荒山野岭 46
+荧光灯 46
+荷包蛋 46
+莱阳 46
+营养学家 46
+萨伊夫 46
+董师爷 46
+蒲式耳 46
+蓝皮书 46
+蔚为壮观 46
+藏历 46
+虎跑 46
+虚惊 46
+蛇形 46
+蜀锦 46
+蜡质 46
+蜷曲 46
+行政处分 46
+装备部 46
+西南风 46
+西屋 46
+西甲 46
+西装革履 46
+见机行事 46
+解嘲 46
+言极 46
+警备队 46
+计划局 46
+讨要 46
+记恨 46
+讲明 46
+识记 46
+译音 46
+语文学 46
+诰命 46
+说情 46
+说笑话 46
+课时 46
+调升 46
+谋利 46
+象限 46
+贝勒爷 46
+贝格 46
+贤惠 46
+贫富悬殊 46
+贵妇人 46
+费耀色 46
+贾庆林 46
+赏析 46
+赛果 46
+赴约 46
+赶明儿 46
+起誓 46
+越共 46
+越南人 46
+跑警报 46
+路矿 46
+近东 46
+进口商 46
+进步奖 46
+进项 46
+追诉 46
+追赠 46
+逆风 46
+通灵 46
+逵 46
+遄 46
+避险 46
+邓亚萍 46
+邹韬奋 46
+郑畋 46
+鄙弃 46
+酒味 46
+酚类 46
+酬答 46
+醒觉 46
+重生 46
+重见 46
+鎏金 46
+钟夫人 46
+钢种 46
+钣 46
+钦州 46
+铁通 46
+铜盆 46
+链式反应 46
+锈病 46
+错过 46
+锦鳞 46
+镆 46
+镇西 46
+长吁短叹 46
+长庆 46
+长生不死 46
+长轴 46
+问计 46
+闻听 46
+阍 46
+阎婆 46
+阴天 46
+阿什 46
+阿含 46
+阿尔金山 46
+陈老 46
+随波逐流 46
+隔三差五 46
+隰 46
+难懂 46
+难闻 46
+雄奇 46
+雪雁 46
+雷动 46
+面壁 46
+靼 46
+鞅 46
+顶子 46
+领养 46
+风翔 46
+飞机票 46
+飞毛腿 46
+餍 46
+餠 46
+饕餮 46
+饱尝 46
+饱食 46
+马氏 46
+马特 46
+马祖 46
+马蹄形 46
+驾机 46
+骤起 46
+魁首 46
+魏文帝 46
+鲱鱼 46
+鶗 46
+鸨 46
+鸪 46
+鹰派 46
+麦角 46
+黄澄澄 46
+黄白色 46
+黄蓉微微 46
+黯然失色 46
+一万五千 45
+一中一台 45
+一览无余 45
+万卷 45
+万道 45
+三十五岁 45
+三组 45
+上架 45
+不务正业 45
+不卑不亢 45
+不可胜数 45
+世贸 45
+业态 45
+两副 45
+两包 45
+中共中央办公厅 45
+中医院 45
+中国共产党第一次全国代表大会 45
+中国史学会 45
+中国科技大学 45
+中央音乐学院 45
+中频 45
+临阵脱逃 45
+主刀 45
+主神 45
+举凡 45
+义正词严 45
+乌什 45
+乌龙茶 45
+乐府诗 45
+九中 45
+习习 45
+书函 45
+二家 45
+二甲 45
+云中雕 45
+五十元 45
+五四新文化运动 45
+五权宪法 45
+五百里 45
+五龙 45
+井田 45
+亡魂 45
+产房 45
+亲密无间 45
+仅此而已 45
+仕宦 45
+付息 45
+付钱 45
+仞 45
+伊东 45
+伍德 45
+优生 45
+会务 45
+会议厅 45
+伞形 45
+传道 45
+伤痕累累 45
+伦加德 45
+何足挂齿 45
+余壮远 45
+余裕 45
+侯恂 45
+保家卫国 45
+俞康宰 45
+俞斌 45
+信天游 45
+修园子 45
+修士 45
+倔犟 45
+倚老卖老 45
+偃旗息鼓 45
+偏头痛 45
+储户 45
+傻了眼 45
+像章 45
+僛 45
+僧俗 45
+儒士 45
+光彩照人 45
+光焰 45
+克拉玛依 45
+克拉科夫 45
+全洲 45
+公主坟 45
+公论 45
+六十里 45
+共同市场 45
+关子 45
+兵不血刃 45
+兵阵 45
+养羊业 45
+兽医学 45
+兽面 45
+内皮 45
+农业社 45
+冬青 45
+冰糖葫芦 45
+凄婉 45
+减价 45
+函谷关 45
+分时 45
+刑律 45
+刘三爷 45
+刘澜涛 45
+刘芬 45
+创可贴 45
+创面 45
+初秋 45
+制冷机 45
+前贤 45
+剖腹产 45
+剿除 45
+加勒比地区 45
+劳军 45
+包头市 45
+匍 45
+北府兵 45
+十八层 45
+十四条 45
+半开 45
+博斯普鲁斯海峡 45
+卢龙 45
+印加人 45
+原配 45
+参展商 45
+双唇 45
+受害人 45
+变例 45
+叛贼 45
+口信 45
+口苦 45
+古里古怪 45
+可采储量 45
+台子上 45
+史迪威 45
+司法部门 45
+吃透 45
+吉姆 45
+吉尼斯 45
+吉祥物 45
+同归 45
+后起之秀 45
+吴学谦 45
+吵吵嚷嚷 45
+吹鼓手 45
+周顺昌 45
+咐 45
+咸鱼 45
+哑然失笑 45
+唬人 45
+售票处 45
+唯理论 45
+啘 45
+喜宴 45
+喝彩声 45
+喧腾 45
+囚室 45
+四则运算 45
+四艘 45
+回波 45
+国统 45
+图书室 45
+图们江 45
+圈层 45
+在所不辞 45
+在案 45
+坎儿 45
+坝址 45
+基础课 45
+堛 45
+塔利班 45
+增建 45
+增色 45
+声名狼藉 45
+复本 45
+外场 45
+外邪 45
+多平方公里 45
+够呛 45
+大会党 45
+大卡 45
+大程度 45
+大鼻子 45
+天会 45
+天南地北 45
+天鹅湖 45
+失势 45
+婆姨 45
+婉言 45
+媚眼 45
+媾 45
+子霖 45
+孙家 45
+孤掌难鸣 45
+学报 45
+安吉 45
+定力 45
+定案 45
+定语 45
+宝姐姐 45
+家什 45
+宿州 45
+宿营地 45
+密不透风 45
+富婆 45
+封泥 45
+小半 45
+小媳妇 45
+小憩 45
+尔特 45
+尽孝 45
+居间 45
+屈明泉 45
+山北 45
+山场 45
+山形 45
+山杨 45
+山色 45
+岛链 45
+岵 45
+崇奉 45
+工兵营 45
+左梦庚 45
+巫峡 45
+巴厘 45
+布帛 45
+师实兵 45
+帏 45
+帝陵 45
+常会 45
+干光豪 45
+干妈 45
+平展 45
+平平常常 45
+平淡无奇 45
+年久失修 45
+年刊 45
+年深日久 45
+并处 45
+幸事 45
+庞云 45
+废寝忘食 45
+开宝 45
+引咎辞职 45
+弘图 45
+当差 45
+彩礼 45
+彩色电视 45
+彮 45
+徐氏 45
+德克 45
+心潮澎湃 45
+忠县 45
+忧心如焚 45
+怄气 45
+怎么得了 45
+急遽 45
+怪僻 45
+恩重如山 45
+悄悄话 45
+惮 45
+感怀 45
+愧色 45
+慌不择路 45
+慢腾腾 45
+成仙 45
+成风 45
+才女 45
+打基础 45
+打屁股 45
+打闹 45
+托儿所 45
+执迷 45
+扬尘 45
+承揽 45
+报批 45
+拉克斯 45
+拉拉扯扯 45
+招子 45
+拱北 45
+拳师 45
+指南车 45
+按倒 45
+掺假 45
+握拳 45
+摊铺 45
+摸爬滚打 45
+撕心裂肺 45
+撰述 45
+敢作敢为 45
+散见于 45
+敬老院 45
+数处 45
+整地 45
+文化区 45
+斗气 45
+斜对面 45
+斯托 45
+新老交替 45
+斻 45
+无人不知 45
+无可非议 45
+无坚不摧 45
+旦角 45
+旧年 45
+旺儿 45
+春水 45
+显像 45
+显灵 45
+晃荡 45
+普吉 45
+普洱茶 45
+智光 45
+暟 45
+曲波 45
+有机磷 45
+服务部 45
+机关枪 45
+机具 45
+李悝 45
+杨兄 45
+杨志毅 45
+杨红英 45
+杰斐逊 45
+板儿 45
+林业局 45
+栋梁 45
+栥 45
+核糖体 45
+格鲁曼 45
+植树造林 45
+椎体 45
+楚雁 45
+榆次 45
+横波 45
+横须贺 45
+橡胶树 45
+正传 45
+正负电子 45
+此剧 45
+武关 45
+武士道 45
+武进 45
+死水 45
+每部 45
+气溶胶 45
+水箱 45
+汝阳 45
+汪达尔 45
+沉住气 45
+沙山 45
+沟槽 45
+河槽 45
+治沙 45
+法共 45
+法方 45
+法线 45
+洁身自好 45
+活度 45
+活血化瘀 45
+海疆 45
+海蜇 45
+涛声 45
+淋湿 45
+淘汰赛 45
+混日子 45
+温凉 45
+温床 45
+湓 45
+滞销 45
+漏网之鱼 45
+潘哈德 45
+潮白河 45
+灰质 45
+灶间 45
+点化 45
+烟台市 45
+爱意 45
+牵线 45
+特出 45
+特异功能 45
+特征值 45
+特征性 45
+特徵值 45
+特徵性 45
+犬吠 45
+献丑 45
+玄铁令 45
+玉熹 45
+玉米面 45
+玉米麪 45
+王大雷 45
+王殿甫 45
+现如今 45
+玻璃门 45
+瑞星 45
+瑞气 45
+生活区 45
+用刑 45
+田七 45
+田兄 45
+田润叶 45
+电光石火 45
+电子化 45
+电闪 45
+疯牛病 45
+瘬 45
+白影 45
+白礼文 45
+百次 45
+百辆 45
+监造 45
+直角坐标 45
+相比而言 45
+省吃俭用 45
+真性 45
+真理报 45
+眼明手快 45
+睡莲 45
+瞻前顾后 45
+知之甚少 45
+石头城 45
+石碣 45
+研究法 45
+硬气 45
+磅礴 45
+礼金 45
+私欲 45
+空明拳 45
+空行 45
+空闻 45
+空难 45
+窭 45
+竹枝 45
+竾 45
+第一列 45
+第二十二章 45
+第二次国内革命战争 45
+第十九 45
+第十二条 45
+第十六条 45
+筒子楼 45
+简本 45
+简简单单 45
+篡党 45
+篮下 45
+米歇尔 45
+粺 45
+糜竺 45
+紫文心 45
+紫癜 45
+红水河 45
+红粉 45
+红艳艳 45
+纯利 45
+经济体 45
+结账 45
+续集 45
+绿油油 45
+缉毒 45
+编译器 45
+缥 45
+罪孽深重 45
+置身事外 45
+羊祜 45
+美利坚合众国 45
+翩然 45
+翼城 45
+老丁 45
+老皇爷 45
+老街 45
+老魏 45
+耍弄 45
+耸肩 45
+聚氯乙烯 45
+聚积 45
+肉桂 45
+肌理 45
+肖明子 45
+肠壁 45
+肩胛 45
+胜似 45
+胡大爷 45
+胡秉安 45
+胫骨 45
+胸径 45
+脚踏车 45
+脱俗 45
+臊子 45
+自主神经 45
+自立军 45
+自给有余 45
+致畸 45
+船票 45
+艏 45
+花炮 45
+苋菜 45
+苏莱曼 45
+苔藓植物 45
+英杰 45
+范雎 45
+茧丝 45
+荥 45
+萧树文 45
+董其昌 45
+蒋军 45
+蓬乱 45
+薄礼 45
+藏书楼 45
+蛇蝎 45
+蛭石 45
+蛰伏 45
+蝼蚁 45
+蝾螈 45
+血海深仇 45
+血瘀 45
+行前 45
+街亭 45
+褚遂良 45
+襄垣县 45
+西西伯利亚 45
+解气 45
+詹春 45
+警句 45
+认账 45
+讨人喜欢 45
+许平君 45
+许褚 45
+评介 45
+诗情画意 45
+诗赋 45
+话机 45
+详实 45
+语义学 45
+读研 45
+谆谆 45
+谋计 45
+貘 45
+贞德 45
+贤哲 45
+赔付 45
+赔偿金 45
+赞叹不已 45
+赤道几内亚 45
+赫斯特 45
+趁热打铁 45
+超时 45
+超限 45
+超高速 45
+趺 45
+路障 45
+蹶 45
+辞赋 45
+过继 45
+过速 45
+运价 45
+连云港市 45
+退市 45
+递送 45
+通宵达旦 45
+造物 45
+遗传学家 45
+那小鬟 45
+邮政局 45
+配器 45
+酒鬼 45
+釉瓷 45
+里甲 45
+野村 45
+金丰投资 45
+金强 45
+金枝玉叶 45
+金牛实业 45
+鍣 45
+鑜 45
+钟雪 45
+钱潮 45
+铝业 45
+铪 45
+铸就 45
+镜像 45
+镶边 45
+门巴族 45
+闪石 45
+闷头 45
+阮小五 45
+阿布扎比 45
+阿斯旺 45
+阿育王 45
+阿霉素 45
+除名 45
+陶瓷器 45
+隐约可见 45
+雄强 45
+集束 45
+零花钱 45
+震耳 45
+靖远 45
+靛蓝 45
+非国家 45
+鞋带 45
+预防性 45
+颠沛流离 45
+风势 45
+风花雪月 45
+风雨飘摇 45
+饪 45
+首季 45
+首部 45
+驳壳枪 45
+骻 45
+高头大马 45
+鬼使神差 45
+魏宫 45
+魏巍文 45
+鸡巴 45
+黄子澄 45
+黄教 45
+黄胄 45
+黄道吉日 45
+黎拔力 45
+黑灯瞎火 45
+黑白分明 45
+默默无语 45
+黧黑 45
+鼓胀 45
+齐家 45
+龙门山 45
+一以贯之 44
+一霎时 44
+丁烷 44
+丁肇中 44
+七八分 44
+七十二路 44
+七情六欲 44
+万众瞩目 44
+万余公顷 44
+万分之 44
+万家灯火 44
+万永年 44
+万磊 44
+三峯 44
+三峰 44
+三样 44
+三爷 44
+上菜 44
+上颌骨 44
+下马威 44
+不可救药 44
+不可限量 44
+不赖 44
+专才 44
+专案 44
+东直门 44
+中下级 44
+中国民航 44
+中士 44
+中篇 44
+丰水期 44
+为人师表 44
+为时过早 44
+乌龙岭 44
+九江市 44
+二十多天 44
+二卷 44
+二百名 44
+云儿 44
+云气 44
+互补性 44
+五岭 44
+交相 44
+交通局 44
+交通运输 44
+人人自危 44
+人理 44
+仇杀 44
+仲夏 44
+任满 44
+仿制品 44
+企业管理者 44
+会阴 44
+传送带 44
+伦巴第 44
+余海华 44
+佟国纲 44
+侧根 44
+便血 44
+俗套 44
+保护价 44
+保护者 44
+修业 44
+修辞学 44
+借记卡 44
+倦意 44
+倨 44
+偶发 44
+光复会 44
+光导纤维 44
+光怪陆离 44
+克利夫兰 44
+克雷库 44
+入画 44
+全聚德 44
+公事公办 44
+公文包 44
+六一 44
+六七十 44
+共商国是 44
+共济 44
+兼容并包 44
+兼容幷包 44
+兼得 44
+军事科学院 44
+军火商 44
+军籍 44
+农业税 44
+冯邦宁 44
+冲垮 44
+冼 44
+准星 44
+几案 44
+凤凰琴 44
+凯泽 44
+划线 44
+刘戈 44
+刘新生 44
+刘禹锡 44
+刘静逸 44
+创办人 44
+利雅得 44
+前些时 44
+剤 44
+劫匪 44
+劳动和社会保障部 44
+化敌为友 44
+北京音乐厅 44
+匪患 44
+十八名 44
+千恩万谢 44
+升幅 44
+半段 44
+单倍体 44
+单边 44
+卡文迪什 44
+卤代烃 44
+印花布 44
+厅房 44
+厚颜无耻 44
+双关 44
+双眼皮 44
+反式 44
+发急 44
+叛离 44
+只限 44
+叶钊颖 44
+司寇 44
+各色人等 44
+合作方 44
+听政 44
+吴冠中 44
+吴敬琏 44
+吴梦玲 44
+吴王夫 44
+吸尘器 44
+周文龙 44
+周星驰 44
+和平主义 44
+哀歌 44
+哗众取宠 44
+哱 44
+哺乳纲 44
+唐天宝 44
+唠 44
+商务网 44
+喉癌 44
+喉结 44
+喜剧片 44
+嗏 44
+嘧 44
+回馈 44
+因果报应 44
+围堵 44
+国商 44
+国境线 44
+国民性 44
+图瓦 44
+图谋不轨 44
+地气 44
+地膜 44
+坊间 44
+坑坑洼洼 44
+坶 44
+垃圾堆 44
+垦区 44
+境内外 44
+壅塞 44
+夏县 44
+外型 44
+多里 44
+夜宵 44
+够戗 44
+大宫 44
+大屯 44
+大快人心 44
+大虾 44
+天工 44
+天知道 44
+太阳黑子 44
+奋发图强 44
+奥尔良 44
+妙传 44
+始料 44
+委以重任 44
+姘头 44
+姜风 44
+婉言谢绝 44
+子充 44
+孔雀河 44
+孙犁 44
+孟县 44
+学时 44
+孩提 44
+宁国 44
+宇文 44
+守旧派 44
+安全检查 44
+安身立命 44
+宋解放 44
+宗主权 44
+定式 44
+实地调查 44
+实验所 44
+宣传品 44
+密歇根 44
+寒碜 44
+寒颤 44
+寰宇 44
+对苯二胺 44
+小胡子 44
+尔锦 44
+尤甲仁 44
+岪 44
+嵬 44
+工力 44
+工蜂 44
+左方 44
+差数 44
+巴尔喀什湖 44
+巴甫洛夫 44
+布列塔尼 44
+师公 44
+帮帮忙 44
+幽闭 44
+库尔斯克 44
+应诺 44
+度数 44
+座无虚席 44
+延年益寿 44
+张召 44
+张思之 44
+弥勒佛 44
+徐力 44
+御厨 44
+微词 44
+心神不宁 44
+志留纪 44
+忘形 44
+怀来 44
+怒火中烧 44
+思琳 44
+急难 44
+总价 44
+总师 44
+惊风 44
+意识流 44
+慕天颜 44
+慧书 44
+成岩 44
+成组 44
+战争史 44
+打杂 44
+打道回府 44
+托梦 44
+扫雷艇 44
+扬州市 44
+承运人 44
+技术学校 44
+投机取巧 44
+投降主义 44
+护兵 44
+抽咽 44
+抽奖 44
+拉帮结派 44
+拔脚 44
+拯 44
+拳头产品 44
+挂车 44
+挥泪 44
+挪开 44
+探矿 44
+接客 44
+搜括 44
+改革者 44
+放浪 44
+放血 44
+散碎 44
+数十步 44
+数多 44
+数据通信 44
+斗智斗勇 44
+断线 44
+新墨西哥州 44
+新楼 44
+新疆省 44
+新造 44
+方志敏 44
+施泰因 44
+旗手 44
+日产量 44
+日兴 44
+日思夜想 44
+春汛 44
+晨风 44
+普洱 44
+智勇双全 44
+晾乾 44
+晾干 44
+曲阳 44
+曹满仓 44
+曾铁鸥 44
+最大者 44
+有板有眼 44
+有血有肉 44
+朝内 44
+木匣 44
+木桑道人 44
+本专科 44
+本院 44
+朱氏 44
+朵朵 44
+机数 44
+杀菌剂 44
+杂碎 44
+村舍 44
+杰奎琳 44
+林大可 44
+林辉 44
+核发 44
+桑拿 44
+梅香 44
+楚军 44
+槲 44
+横扫千军 44
+每份 44
+毐 44
+民族之林 44
+民谚 44
+气鼓鼓 44
+水费 44
+汗颜 44
+江天 44
+油烟 44
+法拉利 44
+津门 44
+活物 44
+浊水溪 44
+浙大 44
+海葵 44
+涡轮机 44
+涨停板 44
+涿县 44
+深仇大恨 44
+深红 44
+深绿色 44
+清谈 44
+清远 44
+渗透率 44
+渭北 44
+港股 44
+游泳馆 44
+火电 44
+灵鹫 44
+炊事 44
+炸裂 44
+烟熏 44
+热敷 44
+热风 44
+烹茶 44
+煤窑 44
+照见 44
+爱侣 44
+牌九 44
+牛爱江 44
+牛郎 44
+特纳 44
+特遣 44
+牺牲者 44
+犬马之劳 44
+犯难 44
+独步 44
+猥 44
+王和高 44
+王盘山 44
+王菲 44
+玛克辛 44
+玛格丽特 44
+现款 44
+玻璃钢 44
+瓜子脸 44
+瓦格纳 44
+甘孜 44
+生员 44
+用脑 44
+田哥 44
+画布 44
+疑为 44
+白兴儿 44
+白蛇 44
+百合花 44
+盐井 44
+目中无人 44
+目的性 44
+直列 44
+省心 44
+真心诚意 44
+眻 44
+眼疾手快 44
+眼线 44
+着凉 44
+知底 44
+石笋 44
+研修 44
+碰头会 44
+碳氢化合物 44
+磔 44
+磨灭 44
+祸首 44
+禁闭 44
+离世 44
+科西嘉 44
+稀有气体 44
+程序性 44
+章炳麟 44
+章鸣 44
+竹帘 44
+笔头 44
+第三十 44
+第二十九 44
+第十七条 44
+笾 44
+簇新 44
+米拉 44
+精义 44
+精神分裂症 44
+精神饱满 44
+糖厂 44
+縺 44
+红山 44
+纲纪 44
+纸笺 44
+线性系统 44
+组画 44
+绎 44
+绕弯子 44
+维多利亚湖 44
+综艺 44
+缉拿 44
+缍 44
+编演 44
+罗隐 44
+美其名曰 44
+美发 44
+美眉 44
+美艳 44
+翳 44
+耳膜 44
+耳鼓 44
+耿恭 44
+职级 44
+肾阴 44
+胡杨 44
+胨 44
+能源部 44
+能说会道 44
+脉冲星 44
+腮帮 44
+腺苷 44
+膝关节 44
+膝弯 44
+臆想 44
+臑 44
+自燃 44
+航空站 44
+艾滋 44
+花容 44
+花市 44
+花脸 44
+芸芸众生 44
+苦功 44
+苯环 44
+英译汉 44
+茂名 44
+茅塞顿开 44
+荚果 44
+菱镁矿 44
+营业厅 44
+萷 44
+落第 44
+蒙头 44
+蒙得维的亚 44
+蒲缓昌 44
+蓑 44
+蕖 44
+薡 44
+虿 44
+蠕 44
+行政诉讼 44
+衍变 44
+补补 44
+袁隆平 44
+被保险人 44
+装车 44
+西凉 44
+西戎 44
+观众席 44
+视场 44
+觐 44
+角化 44
+觚 44
+计量学 44
+记忆法 44
+话里有话 44
+该州 44
+谁是谁非 44
+谢亚龙 44
+谭处端 44
+贝卡 44
+财礼 44
+赎金 44
+走马 44
+走马换将 44
+赵大哥 44
+赵楚 44
+赵煦 44
+起跑 44
+超大型 44
+趿 44
+跑堂 44
+跑腿 44
+跨学科 44
+跹 44
+轻佻 44
+辩护人 44
+边角 44
+还施彼身 44
+这样儿 44
+连部 44
+迟延 44
+迭代 44
+选配 44
+通化 44
+通用电气公司 44
+遗容 44
+遥相呼应 44
+邬梨 44
+邮递员 44
+邱得用 44
+郭靖笑 44
+酒坛 44
+酣畅淋漓 44
+酵母菌 44
+释义 44
+重刑 44
+重庆大学 44
+重构 44
+金帛 44
+鉴真 44
+鑨 44
+钢索 44
+钿 44
+铁冠道人 44
+铁管 44
+铆 44
+银盘 44
+银轮 44
+链路 44
+锣鼓声 44
+锥体 44
+镖局子 44
+长住 44
+长编 44
+闯将 44
+闲来无事 44
+闻声 44
+防御战 44
+阳朔 44
+阴司 44
+阿勒泰 44
+阿布哈兹 44
+阿斯科 44
+阿瓦 44
+阿萨姆 44
+陆二娘 44
+陆海 44
+陈词 44
+陶铸 44
+难愈 44
+雅戈尔 44
+集贤 44
+霍元龙 44
+静力 44
+非统 44
+面陈 44
+韣 44
+预演 44
+领回 44
+颍川 44
+颗粒状 44
+飞镖 44
+饶有兴趣 44
+饶漱石 44
+首盘 44
+马科 44
+驱虫 44
+骊山 44
+骤降 44
+鳗鱼 44
+鸡血 44
+鹧 44
+麦克风 44
+黄氏 44
+黑塞哥维那 44
+黑海舰队 44
+黑面 44
+黑麪 44
+鼾 44
+龚光杰 44
+一一对应 43
+一半儿 43
+一百万两 43
+一箭双雕 43
+七八丈 43
+三两个 43
+三十名 43
+三叶 43
+三强 43
+三教九流 43
+三篇 43
+上佳 43
+上送 43
+下移 43
+不言不语 43
+专用线 43
+东京帝国大学 43
+东半球 43
+东芝公司 43
+东阿 43
+两栖动物 43
+两趟 43
+严阵以待 43
+中上层 43
+中华人民共和国香港特别行政区 43
+中餐 43
+中高 43
+临界点 43
+丹鼎 43
+主官 43
+乐安 43
+乐都 43
+乙方 43
+九龙江 43
+习惯法 43
+乡邻 43
+乡音 43
+书后 43
+书面语 43
+乱蓬蓬 43
+争宠 43
+二种 43
+五千元 43
+亚寒带 43
+交好 43
+产业革命 43
+产科 43
+人马座 43
+人鱼 43
+仁人志士 43
+今非昔比 43
+代收 43
+令人鼓舞 43
+令堂 43
+仰角 43
+传粉 43
+住家 43
+何叔衡 43
+何高见 43
+佛手 43
+使用率 43
+侑 43
+供给制 43
+依样葫芦 43
+信息战 43
+停飞 43
+元鼎 43
+光标 43
+光降 43
+克伦威尔 43
+党外人士 43
+党纪 43
+入微 43
+全乡 43
+全唐诗 43
+公共课 43
+公子哥儿 43
+公设 43
+共和元年 43
+内销 43
+内陆国 43
+冥冥之中 43
+冯国斌 43
+冯难敌 43
+冰心 43
+冲力 43
+冶铸 43
+几十次 43
+凶暴 43
+出口型 43
+出笼 43
+分析员 43
+分步 43
+分系统 43
+刑徒 43
+刚愎自用 43
+判若两人 43
+力劝 43
+加官 43
+加那利 43
+加里曼丹岛 43
+劣根性 43
+勖 43
+包勇 43
+包被 43
+医理 43
+十载 43
+十面埋伏 43
+升帐 43
+半月刊 43
+半边天 43
+华而不实 43
+南坡 43
+卫城 43
+厂卫 43
+原封不动 43
+原水 43
+取之不尽 43
+古乐 43
+叫驴 43
+史仲猛 43
+号叫 43
+吃斋 43
+各执一词 43
+合式 43
+合成树脂 43
+同方向 43
+同音 43
+后写 43
+后掠角 43
+后援 43
+向背 43
+吘 43
+吴大鹏 43
+吴山圆 43
+呐喊助威 43
+周勃 43
+周成王 43
+呼啦 43
+和平谈判 43
+和睦相处 43
+哄骗 43
+喂食 43
+喻上猷 43
+四万多 43
+四十个 43
+四十九 43
+四十六年 43
+回环 43
+国家环保局 43
+国家税务总局 43
+国标 43
+图塔 43
+圣迭戈 43
+在编 43
+地黄 43
+坐堂 43
+坐月子 43
+垕 43
+埇 43
+培训费 43
+塑料布 43
+墖 43
+墤 43
+处士 43
+夏粮 43
+多套 43
+夜以继日 43
+大决战 43
+大包大揽 43
+大呼拉尔 43
+大连理工大学 43
+大鹏 43
+天真无邪 43
+太湖流域 43
+太空站 43
+头面人物 43
+如雷贯耳 43
+妖艳 43
+始终如一 43
+姱 43
+姿态控制 43
+威斯敏斯特 43
+存贮器 43
+孙女儿 43
+孙思邈 43
+孟姜女 43
+宋哲宗 43
+宋江见 43
+官差 43
+定亲 43
+实物地租 43
+客位 43
+宣传画 43
+宣言书 43
+宫太后 43
+寄生性 43
+导热性 43
+小宁 43
+小宝宝 43
+少林拳 43
+少男少女 43
+尖塔 43
+尖角 43
+屈光 43
+峯林 43
+峰林 43
+崔健 43
+崟 43
+工作部 43
+工程量 43
+左路军 43
+巴克 43
+巴克特 43
+市中区 43
+市府 43
+布拉马普特拉河 43
+师里 43
+平易 43
+平流层 43
+幻灭 43
+幽燕 43
+康奈尔大学 43
+建议书 43
+开封市 43
+开门红 43
+引颈 43
+弘光 43
+张佳胤 43
+张雪梅 43
+弦索 43
+弹夹 43
+弻 43
+归位 43
+径迹 43
+德克勒克 43
+忍辱负重 43
+总指挥部 43
+总方针 43
+恂 43
+恽代英 43
+悼词 43
+情有可原 43
+惨叫声 43
+慰问团 43
+憋闷 43
+戏校 43
+成命 43
+成鱼 43
+戒酒 43
+戕害 43
+扎堆 43
+托洛茨基 43
+扫雷舰 43
+投手 43
+投机者 43
+折中 43
+拖长 43
+招录 43
+拟合 43
+拥政爱民 43
+按动 43
+换文 43
+换防 43
+捽 43
+推三阻四 43
+插槽 43
+插花 43
+援建 43
+擤 43
+支撑点 43
+收服 43
+改判 43
+政审 43
+敌众我寡 43
+救驾 43
+敖包 43
+数千万 43
+整备 43
+整日价 43
+文化宫 43
+斤两 43
+斯宾塞 43
+新疆生产建设兵团 43
+新闻公报 43
+旎 43
+无产 43
+无间 43
+日光浴 43
+日化 43
+旧城区 43
+早泄 43
+早衰 43
+旱情 43
+昏死 43
+晃悠 43
+晃眼 43
+晚风 43
+晾晒 43
+暗黑 43
+曳光弹 43
+曹哥 43
+曼德 43
+有滋有味 43
+朐 43
+木桨 43
+未几 43
+本当 43
+本日 43
+本田 43
+机件 43
+李仙风 43
+李国豪 43
+李子树 43
+李广 43
+李建波 43
+条凳 43
+板垣 43
+林产 43
+枯坐 43
+枯槁 43
+标牌 43
+标量 43
+树敌 43
+树桩 43
+格陵兰岛 43
+桑叶 43
+桦木 43
+梗塞 43
+梨树 43
+棍儿 43
+止痒 43
+步态 43
+死亡线 43
+死板 43
+殉情 43
+每间 43
+毒草 43
+毛毛 43
+水禽 43
+永丰 43
+求爱 43
+汝阳王 43
+河州 43
+油状 43
+油腔滑调 43
+泊头 43
+泛滥成灾 43
+波里 43
+注射器 43
+洪水泛滥 43
+流注 43
+流脑 43
+浑然不觉 43
+浓黑 43
+浪人 43
+浮士德 43
+海损 43
+涓涓 43
+淄川 43
+深市 43
+添麻烦 43
+温县 43
+温家庄 43
+游猎 43
+湘阴 43
+滋事 43
+满含 43
+满庚哥 43
+满腔热情 43
+潖 43
+潘多拉 43
+潚 43
+潜台词 43
+潜回 43
+潜行 43
+潮涌 43
+灭族 43
+灯市 43
+炜 43
+烟波 43
+烟煤 43
+焙烧 43
+焦糖 43
+焦虑不安 43
+熟记 43
+牌匾 43
+牛丞相 43
+物候 43
+牵强 43
+特力 43
+特勤 43
+狂犬病 43
+狼山 43
+猎获 43
+猜度 43
+玉兔 43
+玉凤 43
+玉盘 43
+王力 43
+王坚 43
+玛丽仙 43
+班吉 43
+班迪安 43
+瓠 43
+甘夫人 43
+甘苦 43
+生冷 43
+生成物 43
+用劲 43
+田鼠 43
+电筒 43
+疗治 43
+疚 43
+病假 43
+痴痴地 43
+白大褂 43
+白海 43
+白茶 43
+皮尔 43
+皮脂腺 43
+监制 43
+盗卖 43
+盘丫吉 43
+眀 43
+瞿式耜 43
+矍铄 43
+矢口否认 43
+砫 43
+破晓 43
+砸开 43
+礼让 43
+祖居 43
+祝英台 43
+神行太保 43
+祭仪 43
+祸胎 43
+私企 43
+私底下 43
+种数 43
+积怨 43
+称心如意 43
+移师 43
+税务局 43
+穆斯堡 43
+穹庐 43
+窥测 43
+窸 43
+立博 43
+竑 43
+笌 43
+第一站 43
+第二份 43
+第六位 43
+筦 43
+筮 43
+箹 43
+籼 43
+粕 43
+粤西 43
+粤语 43
+糊状 43
+素志 43
+紫文大 43
+紫砂壶 43
+累加 43
+緷 43
+縎 43
+繁花 43
+红丝 43
+红二方面军 43
+红石 43
+纯一 43
+纱灯 43
+纳赛尔 43
+组队 43
+经济部 43
+结婚证 43
+结构主义 43
+结缘 43
+结队 43
+维特 43
+绵密 43
+缋 43
+编订 43
+缺货 43
+罗章龙 43
+群英会 43
+老大娘 43
+耐药性 43
+耡 43
+耳道 43
+联华 43
+肠梗阻 43
+股肱 43
+胆结石 43
+胜率 43
+胜诉 43
+胸围 43
+脐带 43
+脑力劳动者 43
+臂力 43
+自由职业者 43
+良性肿瘤 43
+良马 43
+色觉 43
+艺术展 43
+芑 43
+芩 43
+花博园 43
+苏台德 43
+若干倍 43
+若然 43
+范式 43
+茅厕 43
+茶缸 43
+荡起 43
+荣府 43
+药费 43
+获得性 43
+莼 43
+菱角 43
+萨芬 43
+落英 43
+蒙古马 43
+蓴 43
+蔚然成风 43
+虚无缥缈 43
+蜂拥而至 43
+蜂王 43
+蜘蛛网 43
+血肿 43
+行列式 43
+袁凯 43
+被占领土 43
+裂解 43
+裤腰带 43
+褡 43
+西奥 43
+觞 43
+解难 43
+言重 43
+警惕性 43
+警报器 43
+讨还 43
+训令 43
+讷河 43
+许宪民 43
+论剑 43
+误读 43
+说客 43
+诺基亚公司 43
+课桌 43
+调味料 43
+谛听 43
+谶语 43
+费德勒 43
+贺凤英 43
+资产者 43
+赞普 43
+赫捷 43
+赳 43
+赵庆华 43
+赵志皋 43
+赵欣婷 43
+趁火打劫 43
+超导电性 43
+跑车 43
+跪伏 43
+路演 43
+路芷佩 43
+蹑足 43
+身板 43
+车手 43
+轧钢 43
+轮班 43
+轮转 43
+载弹量 43
+输送机 43
+达喀尔 43
+近日来 43
+进益 43
+追剿 43
+逆时针 43
+通用汽车公司 43
+速记 43
+遗赠 43
+遥控器 43
+郁江 43
+部首 43
+酒肴 43
+醌 43
+里运河 43
+重蹈覆辙 43
+鎝 43
+钂 43
+针孔 43
+钎 43
+钓鱼台国宾馆 43
+钮扣 43
+钻心 43
+铁勒 43
+铁牛 43
+铜制 43
+银灰色 43
+银白 43
+铺排 43
+锷 43
+长葛 43
+闲坐 43
+阮士 43
+阳沟 43
+阴差阳错 43
+阿瑟 43
+阿诺德 43
+降伏 43
+除虫菊 43
+陶谦 43
+隔世 43
+集约 43
+雍容华贵 43
+青光眼 43
+青壮 43
+青黄不接 43
+韩山童 43
+韩德彩 43
+音乐系 43
+顾怀远 43
+顾惜 43
+颁赐 43
+预热 43
+领馆 43
+颗粒物 43
+题外 43
+颜良 43
+飘泊 43
+飞行队 43
+馓 43
+首义 43
+香茅 43
+马六河 43
+马顺 43
+驶近 43
+骂名 43
+魁北克省 43
+魏征 43
+魔爪 43
+鹁鸽 43
+鹳 43
+麻辣 43
+黄维 43
+黄鼬 43
+默里 43
+龙岛主 43
+一个劲 42
+一个排 42
+一九五 42
+一吨 42
+一壁厢 42
+一数 42
+一步到位 42
+一病不起 42
+一面之辞 42
+丁真吾 42
+七万 42
+七套 42
+万死不辞 42
+三十六个 42
+三明 42
+三生 42
+三维空间 42
+三进 42
+上海贝尔 42
+下不了台 42
+下不来 42
+不同点 42
+不堪回首 42
+不谙 42
+不露声色 42
+丑化 42
+丑行 42
+专业对口 42
+业务量 42
+丝织物 42
+两口儿 42
+两回事 42
+两幢 42
+严世 42
+严颜 42
+中国民主政团同盟 42
+中山狼 42
+中耳 42
+中航 42
+丹湖 42
+主序星 42
+久别 42
+乐陵 42
+乔氏 42
+九泉 42
+乱麻 42
+乾渴 42
+乾瘦 42
+二十元 42
+二十六岁 42
+二滩 42
+亏损额 42
+云南大学 42
+云南红塔集团 42
+云图 42
+五卅 42
+五声 42
+五服 42
+井田制 42
+亚速海 42
+人困马乏 42
+人民大学 42
+人潮 42
+人造纤维 42
+从众 42
+代工 42
+令狐少侠 42
+仪容 42
+价值论 42
+任劳任怨 42
+任天堂 42
+伊朗语 42
+优生学 42
+传阅 42
+伪劣 42
+低语 42
+使役 42
+侔 42
+保准 42
+保加利亚人 42
+信佛 42
+信儿 42
+修斯 42
+修通 42
+借入者 42
+倩影 42
+偕同 42
+傻里傻气 42
+光谱仪 42
+共同社 42
+关注点 42
+养家糊口 42
+兼程 42
+内耗 42
+内陆河 42
+冬暖夏凉 42
+冰壁 42
+冰激凌 42
+几笔 42
+凡间 42
+凤来仪 42
+出血量 42
+出门在外 42
+刀旋 42
+分册 42
+分工合作 42
+刘心武 42
+刘禅 42
+创投 42
+初见成效 42
+利湿 42
+制版 42
+剃刀 42
+前瞻 42
+剩菜 42
+劝进 42
+加急 42
+加热器 42
+勇夺 42
+勒斯 42
+包产 42
+十六届 42
+十四行诗 42
+十方 42
+十路 42
+千龙 42
+卓不凡 42
+单列 42
+单通 42
+南街 42
+卡福 42
+卧式 42
+卫生员 42
+厚葬 42
+双塔 42
+双头 42
+双季稻 42
+发展商 42
+口乾舌燥 42
+口头文学 42
+口干舌燥 42
+古寺 42
+另加 42
+可卡因 42
+右都御史 42
+司局 42
+合龙 42
+吊桶 42
+同志会 42
+同村 42
+吐口 42
+向心 42
+君临 42
+吴仲平 42
+吴国栋 42
+呋喃 42
+和易 42
+和晓霞 42
+咏叹调 42
+响箭 42
+唐律 42
+唐德宗 42
+唳 42
+喀山 42
+善举 42
+喹 42
+四五年 42
+四五百 42
+四百两 42
+国内革命战争 42
+国民革命军第八路军 42
+圆月 42
+圈椅 42
+地方化 42
+地陪 42
+坟场 42
+坠儿 42
+坦承 42
+埃斯特 42
+埧 42
+壁炉 42
+处刑 42
+复刊 42
+复星 42
+夕阳西下 42
+外罩 42
+外胚层 42
+多媒体技术 42
+多户 42
+多毛 42
+够朋友 42
+大有作为 42
+大枪 42
+大田 42
+大老婆 42
+大老远 42
+大西北 42
+大项 42
+天下大治 42
+天长日久 42
+头名 42
+头昏脑胀 42
+头盖 42
+夹具 42
+奉调 42
+套近乎 42
+奥林匹 42
+女巫 42
+好学 42
+如履薄冰 42
+威尔斯 42
+嫨 42
+嫩枝 42
+子儿 42
+孙先生 42
+孙氏 42
+安健刚 42
+安大略湖 42
+安提督 42
+安适 42
+宋青 42
+官厅 42
+定襄 42
+宛平 42
+宝顶 42
+实景 42
+客户端 42
+宾夕法尼亚州 42
+宿县 42
+寄出 42
+富矿 42
+寿太郎 42
+寿辰 42
+射界 42
+小个子 42
+小倩 42
+小儿科 42
+小蔡 42
+少先队 42
+尖细 42
+山陕 42
+岳老三 42
+峋 42
+峒 42
+崉 42
+嶽老三 42
+巡道 42
+工矿区 42
+左轮手枪 42
+巩县 42
+己见 42
+市廛 42
+布片 42
+帝乙 42
+帽子戏法 42
+干渴 42
+干瘦 42
+平纹 42
+平衡点 42
+广元 42
+广州军区 42
+应用科学 42
+弗拉基米尔 42
+张伯苓 42
+张榜 42
+弢 42
+弦子 42
+弹坑 42
+归乡 42
+形式美 42
+形象化 42
+形骸 42
+征婚 42
+徒众 42
+徒子徒孙 42
+循环往复 42
+徵婚 42
+心腹之患 42
+心腹大患 42
+念白 42
+怀仁堂 42
+急不可待 42
+怯生生 42
+总体而言 42
+总商会 42
+总汇 42
+恨事 42
+恶念 42
+悍将 42
+悔悟 42
+患难与共 42
+悬空寺 42
+惶惶 42
+惶惶不可终日 42
+愁眉不展 42
+意气用事 42
+慌里慌张 42
+憩 42
+懜 42
+战略物资 42
+战鹰 42
+截肢 42
+户户 42
+所思 42
+扶余 42
+抓耳挠腮 42
+抖擞 42
+抗日军政大学 42
+抚远 42
+拗口 42
+招供 42
+招用 42
+拜服 42
+拥军优属 42
+持久性 42
+指挥舰 42
+挠头 42
+挡箭牌 42
+损兵折将 42
+换洗 42
+换药 42
+排查 42
+排空 42
+提水 42
+揵 42
+摊点 42
+撒丁 42
+操作者 42
+攀缘 42
+故事集 42
+敌我矛盾 42
+救命稻草 42
+教场 42
+散文诗 42
+数十家 42
+数杯 42
+文学革命 42
+文工团员 42
+文广 42
+斜靠 42
+断桥 42
+断电 42
+新天地 42
+新奥尔良 42
+新疆地区 42
+旁支 42
+旅游部 42
+旅费 42
+旗鼓 42
+无例外 42
+日全食 42
+时代感 42
+时序 42
+昌都 42
+明令禁止 42
+明基 42
+明堂 42
+昭通 42
+晋东南 42
+晠 42
+景仁宫 42
+暂定 42
+曝晒 42
+曲府 42
+最低价 42
+有鉴于 42
+木屐 42
+木本植物 42
+未来主义 42
+朱孟春 42
+朱文 42
+杀人如麻 42
+杂色 42
+权术 42
+李善 42
+李峰 42
+杨健 42
+杨成武 42
+极刑 42
+林业部 42
+果仁 42
+枝杈 42
+柞 42
+查察 42
+查韦斯 42
+柳树下 42
+柴门 42
+核国家 42
+根瘤 42
+根瘤菌 42
+桀骜不驯 42
+桂儿 42
+桑斯儿 42
+桑梓 42
+桥本龙太郎 42
+棉鞋 42
+樾 42
+次声波 42
+次官 42
+次席 42
+欧姆定律 42
+欲罢不能 42
+欺瞒 42
+正东 42
+正职 42
+正逢 42
+武卫 42
+武川 42
+残迹 42
+殒 42
+毛虫 42
+气密 42
+水浇地 42
+永泰 42
+沉积层 42
+没精打采 42
+波峯 42
+波峰 42
+波粒二象性 42
+注音 42
+泪如泉涌 42
+泻下 42
+洗雪 42
+洞箫 42
+派性 42
+浅绿 42
+海宇 42
+海森伯 42
+深加工 42
+混血儿 42
+清剿 42
+清君侧 42
+渔获量 42
+湘中 42
+满座 42
+满满当当 42
+滤纸 42
+演播室 42
+潘敏立 42
+澶渊 42
+灌木林 42
+火工 42
+火炕 42
+灼烧 42
+炙热 42
+点击率 42
+烧窑 42
+煎饼 42
+照着 42
+熄风 42
+熟视无睹 42
+燃料箱 42
+爨 42
+爬虫 42
+牌号 42
+牙根 42
+牛乳 42
+牛油 42
+牡牛 42
+牵线搭桥 42
+特困户 42
+狂妄自大 42
+独山 42
+猛涨 42
+玉璧 42
+王从周 42
+王朗 42
+玲珑剔透 42
+珠海市 42
+琵琶骨 42
+生厌 42
+电子对 42
+电导率 42
+电解液 42
+男性化 42
+界山 42
+疑兵 42
+疯病 42
+痼疾 42
+白虹贯日 42
+百姓家 42
+盐官 42
+监牢 42
+相斗 42
+真刀真枪 42
+瞭望台 42
+石达开 42
+砑 42
+砗 42
+砜 42
+破除迷信 42
+磷酸化 42
+神女 42
+福泽喻 42
+离合 42
+私仇 42
+种树 42
+科洛尔 42
+科研院所 42
+空中客车 42
+窈窕 42
+窗玻璃 42
+竞价 42
+竹篾 42
+笑盈盈 42
+笟 42
+第一把 42
+第二十五 42
+第十四届 42
+筇 42
+管理所 42
+管管 42
+簇簇 42
+粟特 42
+精采 42
+紧追 42
+紧邻 42
+紩 42
+紫风 42
+絮絮叨叨 42
+纪冰川 42
+纵横家 42
+经贸委 42
+结合点 42
+绿柱石 42
+罂 42
+罗立如 42
+美人计 42
+美容师 42
+群策群力 42
+羽衣 42
+老冬子 42
+老态龙钟 42
+老窝 42
+聚义 42
+聚苯乙烯 42
+胎体 42
+脊索动物 42
+脑瓜 42
+脸蛋儿 42
+腰眼 42
+腰鼓 42
+腻烦 42
+自发性 42
+自寻短见 42
+致公党 42
+船用 42
+色相 42
+色雷斯 42
+艺术史 42
+花环 42
+若果 42
+草庵 42
+草菴 42
+莒县 42
+菲利普 42
+萤 42
+萧伯纳 42
+葱茏 42
+薨 42
+虚度 42
+蚰 42
+蝽 42
+融雪 42
+蠢人 42
+血清学 42
+行尸走肉 42
+行得通 42
+街门 42
+衣梅 42
+表兄 42
+裂口 42
+褃 42
+西南面 42
+西吉 42
+见利忘义 42
+见惯 42
+观瞻 42
+角力 42
+解放报 42
+讣 42
+记叙文 42
+讷讷 42
+许多天 42
+试办 42
+说媒 42
+调查团 42
+谋面 42
+谢升 42
+谢某 42
+豆腐乾 42
+豆腐干 42
+贝塞尔 42
+贵州高原 42
+费尽心机 42
+贾似道 42
+贾充 42
+资水 42
+赎身 42
+赤膊 42
+赵武灵 42
+起见 42
+足总杯 42
+踏勘 42
+蹙眉 42
+身首异处 42
+车次 42
+软性 42
+轰响 42
+轻狂 42
+辘轳 42
+辩明 42
+辽东湾 42
+达拉斯 42
+近似值 42
+迓 42
+远郊 42
+迩 42
+迭出 42
+追缴 42
+适用性 42
+造纸术 42
+邓恩铭 42
+邪说 42
+郓城 42
+郫县 42
+郭嘉 42
+鄂毕河 42
+酰氯 42
+酸度 42
+醯氯 42
+采风 42
+里尼 42
+里弄 42
+重点线 42
+重负 42
+金仙 42
+金熙 42
+金表 42
+铁定 42
+铁杖 42
+铁磁体 42
+铝制 42
+铲平 42
+铺位 42
+锐意进取 42
+错综 42
+错落有致 42
+锦衣玉食 42
+长存 42
+长门 42
+长队 42
+门旁 42
+门环 42
+闪躲 42
+问鼎 42
+闯关 42
+阎罗王 42
+阑尾炎 42
+阴谋家 42
+阿穆尔河 42
+陆柏 42
+陈冲 42
+陈宏 42
+陈赓 42
+难以忘怀 42
+雅俗共赏 42
+雅兴 42
+集中区 42
+雕刻家 42
+零落 42
+青年报 42
+非同 42
+音乐剧 42
+音乐节 42
+风格各异 42
+馏分 42
+首开 42
+香格里拉 42
+马云 42
+马帮 42
+马萨诸塞 42
+骂骂咧咧 42
+骨板 42
+高世宣 42
+髰 42
+鲁尔 42
+鲁斯 42
+鲁艺 42
+鲁长老 42
+鲜味 42
+鲭 42
+鸡毛蒜皮 42
+麦道 42
+黄衫女子 42
+黔北 42
+齐头并进 42
+龃龉 42
+龙潭虎穴 42
+一万余 41
+一二年 41
+一去不复返 41
+一扫而光 41
+一步登天 41
+一览表 41
+一贯性 41
+一面之缘 41
+丁大全 41
+七七 41
+万吨轮 41
+三万余 41
+三亚市 41
+三十三 41
+三十余万 41
+三十卷 41
+三环股份 41
+三百多 41
+上东 41
+上海电影制片厂 41
+上药 41
+不下于 41
+不为所动 41
+不学无术 41
+不省得 41
+不结盟运动 41
+不辞劳苦 41
+不遂 41
+丑态 41
+世居 41
+东东 41
+东道国 41
+两串 41
+两壁 41
+严刑峻法 41
+严打 41
+丧身 41
+中丞 41
+中华人民共和国外交部 41
+中国女篮 41
+中毒者 41
+临产 41
+临夏 41
+主项 41
+举世无双 41
+举债 41
+义务兵役制 41
+乌孜别克 41
+乖觉 41
+九十九 41
+书报 41
+乬 41
+事出有因 41
+事务处 41
+二十余招 41
+二外 41
+二等功 41
+云梦秦 41
+五一节 41
+五七 41
+五十四 41
+五千余 41
+五斤 41
+亚泰队 41
+交通壕 41
+人情世故 41
+人武 41
+人民政权 41
+仅只 41
+代数和 41
+仰泳 41
+伊吾 41
+伊朗政府 41
+伊甸园 41
+伒 41
+伯格 41
+但崇祯 41
+低血压 41
+住嘴 41
+佐夫 41
+佛光 41
+佛号 41
+佛门弟子 41
+供体 41
+供气 41
+供过于求 41
+俜 41
+保护关税 41
+修好 41
+借阅 41
+储秀宫 41
+傻事 41
+像模像样 41
+儎 41
+元嘉 41
+先天不足 41
+先锋派 41
+光辐射 41
+全国假日办 41
+全国科学大会奖 41
+公厕 41
+公安处 41
+内人 41
+再世 41
+冒险性 41
+农忙 41
+冰清玉洁 41
+冷眼旁观 41
+凌振 41
+几十条 41
+几千名 41
+几枝 41
+几瓶 41
+出口处 41
+刘军 41
+刘彦宗 41
+割礼 41
+力帆队 41
+办公会议 41
+助剂 41
+劳动部 41
+劳民伤财 41
+勃兰登堡 41
+勒拿河 41
+包房 41
+匏 41
+北京火车站 41
+北街 41
+匪首 41
+医学类 41
+匿名信 41
+十项 41
+千金小姐 41
+半夏 41
+华严宗 41
+华盛顿州 41
+单件 41
+卖乖 41
+南回归线 41
+南河 41
+卜垣 41
+占有者 41
+卢泰愚 41
+印刷业 41
+危殆 41
+压岁钱 41
+反是 41
+发冷 41
+发展期 41
+发愤 41
+变易 41
+台东 41
+右方 41
+叶蜡石 41
+吃荤 41
+各卷 41
+各站 41
+吉祥如意 41
+吊环 41
+同列 41
+吕洞宾 41
+吞服 41
+启功 41
+吱呀 41
+吲哚 41
+呈文 41
+周云阳 41
+周期律 41
+哈市 41
+哑铃 41
+哗啦哗啦 41
+唐三彩 41
+唱法 41
+商剑鸣 41
+嘎吱 41
+四倍 41
+四支 41
+四环 41
+四节 41
+国家股 41
+国际共运 41
+图章 41
+土建 41
+圣心 41
+地方军 41
+塞尔 41
+墨盒 41
+复兴党 41
+复合式 41
+复活节岛 41
+外交史 41
+多云 41
+多尼 41
+多种形式 41
+多科性 41
+大化 41
+大司农 41
+大同市 41
+大昭寺 41
+大曲 41
+大有裨益 41
+大武 41
+大法官 41
+大盐 41
+大葱 41
+大麴 41
+天崖 41
+天津泰达 41
+夫婿 41
+如狼似虎 41
+姄 41
+威廉斯 41
+嫦 41
+子虚乌有 41
+孔孟之道 41
+存身 41
+宁古塔 41
+宇宙射线 41
+守兵 41
+安东诺夫 41
+宋太公 41
+定货 41
+实在论 41
+实验性 41
+宠儿 41
+家训 41
+寄人篱下 41
+密西西比 41
+寒窗 41
+对劲 41
+对着干 41
+尊者 41
+小寨 41
+小河边 41
+小轮 41
+尤三姐 41
+尤物 41
+岜 41
+岩上 41
+岳先生 41
+崇信 41
+嶽先生 41
+川菜 41
+工资制 41
+左上角 41
+左耳 41
+巨型机 41
+帝侯 41
+干馏 41
+平辈 41
+幻境 41
+庄聚贤 41
+底价 41
+开花结果 41
+弋 41
+引火烧身 41
+张大哥 41
+张康年 41
+弥合 41
+弱酸 41
+形式多样 41
+彩头 41
+待人接物 41
+心连心 41
+心醉 41
+忽忽 41
+急不可耐 41
+急用 41
+急行军 41
+怯薛 41
+悲鸣 41
+惨祸 41
+想入非非 41
+戎马 41
+手底下 41
+打江山 41
+扫把 41
+扬中 41
+承前启后 41
+投河 41
+投缘 41
+抗毒素 41
+报到 41
+抵债 41
+抽检 41
+拉杰 41
+拉比 41
+拐弯抹角 41
+拒收 41
+拭目以待 41
+挂心 41
+挥笔 41
+捆扎 41
+捞一把 41
+掉泪 41
+接字 41
+握把 41
+揭阳 41
+搜检 41
+摇撼 41
+摸透 41
+撒切尔 41
+支前 41
+收藏品 41
+放债 41
+放权 41
+放胆 41
+政和 41
+教学法 41
+教学班 41
+教育史 41
+教谕 41
+散场 41
+数分钟 41
+数据流 41
+整年 41
+敷药 41
+文人学士 41
+斗柄 41
+断章取义 41
+斯科特 41
+新币 41
+新市区 41
+新平 41
+新沂市 41
+方生 41
+无从下手 41
+无可比拟 41
+无理取闹 41
+无话不谈 41
+旧俗 41
+明灯 41
+明细 41
+星星之火 41
+暒 41
+暗室 41
+最大值 41
+月影 41
+朱重 41
+机动力 41
+李国华 41
+李存勖 41
+李斯特 41
+李秀成 41
+杏山 41
+来历不明 41
+果然如此 41
+枪膛 41
+某团 41
+柜门 41
+标杆 41
+树形 41
+梁漱溟 41
+梗概 41
+梷 41
+检查站 41
+棉袍 41
+横空 41
+横纹 41
+橙子 41
+欧安会 41
+正手 41
+步进 41
+死缓 41
+死而后已 41
+比目鱼 41
+气缸 41
+氢氟酸 41
+水合 41
+水雾 41
+水饺 41
+江铃 41
+污点 41
+沉陷 41
+沙坑 41
+沙宗琪 41
+泄泻 41
+法伦 41
+法帖 41
+法相 41
+泵站 41
+洋洋自得 41
+洋车 41
+津浦铁路 41
+活水 41
+浅蓝色 41
+浅黄 41
+浆糊 41
+浚县 41
+浴缸 41
+海涅 41
+海西 41
+浸出 41
+消夏 41
+淋病 41
+混合式 41
+清水河 41
+清规 41
+温婉 41
+温方悟 41
+游水 41
+源源不绝 41
+溴苯 41
+滕代远 41
+潍 41
+澂 41
+火烧店 41
+灰绿色 41
+灵长目 41
+灾害性 41
+炎黄 41
+炤 41
+点头哈腰 41
+炼狱 41
+烈度 41
+烟锅 41
+烦劳 41
+焦虑症 41
+燃煤 41
+燕山山脉 41
+爱琴 41
+父爱 41
+物化劳动 41
+物美价廉 41
+牵住 41
+特勤队 41
+独断专行 41
+玉钏儿 41
+王皇后 41
+王谢逊 41
+王贝勒 41
+玩笑话 41
+玩艺 41
+珒 41
+班组 41
+瓖 41
+瓶装 41
+瓷片 41
+申说 41
+电话费 41
+畏畏缩缩 41
+疯颠颠 41
+痤疮 41
+瘠薄 41
+百分之七 41
+皮影戏 41
+皮损 41
+盖碗 41
+盯防 41
+瞭 41
+矇矇亮 41
+短浅 41
+石墩 41
+石景山 41
+砂轮 41
+破土 41
+磁通量 41
+磨刀 41
+磨碎 41
+礼单 41
+祁志诚 41
+神游 41
+票证 41
+福大 41
+福威 41
+私财 41
+秋儿 41
+科举制 41
+科尔特斯 41
+稀树草原 41
+稳步前进 41
+稿酬 41
+穷酸 41
+空余 41
+窦建德 41
+章句 41
+端线 41
+竹器 41
+笋干 41
+笑柄 41
+笑而不答 41
+第二十二条 41
+第二家 41
+第二张 41
+第六个 41
+第十四次 41
+第纳尔 41
+策划者 41
+简讯 41
+箱体 41
+米其林 41
+粘滞 41
+粼 41
+精读 41
+糟践 41
+红心 41
+约请 41
+纱帐 41
+纸巾 41
+练达 41
+细部 41
+结构式 41
+结纳 41
+绝唱 41
+续弦 41
+绿泥石 41
+绿茵场 41
+缛 41
+缩醛 41
+罗布 41
+罗得西亚 41
+罗振玉 41
+罗洗河 41
+罗湖 41
+罹 41
+羊绒 41
+群众组织 41
+群像 41
+老战士 41
+老花镜 41
+老辣 41
+耐压 41
+耗电量 41
+耶律铸 41
+胆小怕事 41
+脚伤 41
+脱皮 41
+脱钩 41
+腈纶 41
+自体 41
+自卑感 41
+自毁 41
+自由贸易区 41
+船主 41
+船儿 41
+艌 41
+芮 41
+花盘 41
+花马剑 41
+苏哈托 41
+苏必利尔湖 41
+英伦 41
+茎秆 41
+茓 41
+茫然不解 41
+荣华 41
+荧 41
+莫里哀 41
+莫须有 41
+菌丝体 41
+菌种 41
+菜品 41
+菜油 41
+菜豆 41
+萨摩亚 41
+落雪 41
+蒙蒙亮 41
+蓝冰 41
+蔽日 41
+薛公远 41
+虫儿 41
+虹膜 41
+蚱 41
+蛇口 41
+蛐 41
+血债 41
+血块 41
+血虚 41
+衬底 41
+袁丁 41
+裤头 41
+褫夺 41
+观测站 41
+视频会议 41
+言不由衷 41
+言而无信 41
+讣告 41
+设厂 41
+诈尸 41
+词话 41
+诗笺 41
+诺曼人 41
+谂 41
+调令 41
+豆粕 41
+貂裘 41
+负反馈 41
+负气 41
+贵干 41
+贺词 41
+资本金 41
+赏善罚恶 41
+赤胆忠心 41
+赵三哥 41
+赵红梅 41
+赵长征 41
+超低 41
+越界 41
+路剑法 41
+跻 41
+身强力壮 41
+軆 41
+轩机 41
+转瞬之间 41
+软件包 41
+软骨头 41
+边墙 41
+迅雷不及掩耳 41
+违例 41
+适可而止 41
+选读 41
+通篇 41
+速冻 41
+造册 41
+遇难者 41
+遒 41
+遮天蔽日 41
+避世 41
+邢王二 41
+郝海东 41
+郭云龙 41
+郭靖心 41
+都市人 41
+鄂尔多斯高原 41
+配型 41
+酒令 41
+金明池 41
+金榜题名 41
+金海陵 41
+金融学 41
+金钏儿 41
+钳工 41
+银发 41
+锡箔 41
+闭幕式 41
+问询 41
+闰年 41
+阎王爷 41
+阏 41
+队内 41
+阵子 41
+阿紫笑 41
+陆卖婆 41
+陈果夫 41
+陈立夫 41
+陈绍鹏 41
+陈说 41
+隆美尔 41
+隐现 41
+雅各布 41
+雅安 41
+集大成者 41
+零钱 41
+青鱼 41
+顶礼膜拜 41
+风言风语 41
+餐饮业 41
+饮食起居 41
+饽 41
+首一 41
+首航 41
+香港地区 41
+马戏团 41
+马春 41
+马格尼 41
+驻屯 41
+高人一等 41
+高士 41
+高朗 41
+高梦九 41
+鮛 41
+鱼贩 41
+鲁帮主 41
+鸨母 41
+鹦 41
+麦芽 41
+麻涧 41
+黄先生 41
+黄克诚 41
+黄公望 41
+黄岩 41
+黄湘 41
+黄祖 41
+黑鹰 41
+鼎沸 41
+一两万 40
+一二百 40
+一十六年 40
+一双双 40
+一呼百应 40
+一拍即合 40
+一柱 40
+一碗水 40
+七八招 40
+七寸 40
+万亿日元 40
+万余名 40
+三九集团 40
+三十招 40
+三字经 40
+三更半夜 40
+三木 40
+三生有幸 40
+三百余 40
+三百元 40
+三秦 40
+上下游 40
+上升流 40
+上海师范大学 40
+上解 40
+下厨 40
+不分彼此 40
+不祥之兆 40
+不识抬举 40
+专刊 40
+丙烯腈 40
+东京都 40
+东条英机 40
+两税法 40
+严凤笙 40
+中国西藏自治区 40
+中国音乐家协会 40
+中央党 40
+中心论 40
+中投 40
+中文名 40
+中高档 40
+临门 40
+丶 40
+丹心 40
+为国捐躯 40
+主治医师 40
+义师 40
+乌青 40
+九百 40
+乪 40
+乾酪 40
+争鸣 40
+二万多 40
+二十二岁 40
+二十块 40
+二流子 40
+五万多 40
+五刑 40
+五千里 40
+五处 40
+五招 40
+五绝 40
+井盐 40
+亚马逊 40
+交变 40
+交售 40
+交通费 40
+亿个 40
+仔仔细细 40
+以不变应万变 40
+任人唯亲 40
+企业化 40
+企及 40
+伉俪 40
+伊拉克人 40
+伊斯法罕 40
+伊蒂哈 40
+位或 40
+低保 40
+何老爹 40
+余晖 40
+佳丽 40
+供稿 40
+侠士 40
+保安队 40
+保定市 40
+信贷资金 40
+偁 40
+偛 40
+僻静处 40
+儳 40
+先见之明 40
+克莱斯勒 40
+兔崽子 40
+入乡随俗 40
+八卦阵 40
+八句 40
+八项注意 40
+公决 40
+公瑾 40
+公私兼顾 40
+六艺 40
+兴奋不已 40
+养精蓄锐 40
+内侄 40
+军乐队 40
+净增 40
+凌岩寺 40
+几盏 40
+凤华 40
+凯文 40
+出乱子 40
+分点 40
+分馏 40
+切碎 40
+刘文武 40
+初版 40
+删改 40
+利多 40
+刮刀 40
+劝止 40
+动手术 40
+劳驾 40
+勇悍 40
+勤谨 40
+包管 40
+匈牙利人 40
+北京科技大学 40
+十二条 40
+十几步 40
+十多万 40
+十字街口 40
+千分 40
+单程 40
+南运河 40
+南都 40
+占有量 40
+卤化物 40
+卫尉 40
+即期 40
+卷上 40
+卷筒 40
+历朝 40
+压惊 40
+去职 40
+参政员 40
+双份 40
+发射光谱 40
+发展趋势 40
+发愿 40
+发窘 40
+受领 40
+台州市 40
+史春生 40
+各自为政 40
+合不拢嘴 40
+合集 40
+同等学力 40
+后半段 40
+吕文焕 40
+吸水性 40
+周天 40
+和秀莲 40
+和稀泥 40
+品系 40
+品茗 40
+哈谢 40
+啹 40
+喷吐 40
+嘘寒问暖 40
+嚟 40
+回历 40
+国库券 40
+国投 40
+国本 40
+图标 40
+土路 40
+圣君 40
+地物 40
+地球日 40
+垂尾 40
+埃塞 40
+埃镑 40
+基辛格 40
+塔楼 40
+增强型 40
+声讯 40
+复国主义 40
+夕照 40
+外办 40
+夜长梦多 40
+大力士 40
+大厂镇 40
+大历 40
+大幸 40
+大板 40
+大案要案 40
+天佑 40
+天诛地灭 40
+失落感 40
+头前 40
+头皮屑 40
+奏响 40
+奥斯 40
+奸雄 40
+姴 40
+婚外恋 40
+婚期 40
+嫁祸 40
+孽障 40
+宇宙观 40
+安县 40
+安福 40
+安营扎寨 40
+完全恢复 40
+审评 40
+宣城 40
+封山 40
+小天鹅 40
+小家庭 40
+小打小闹 40
+尹兴圣 40
+尼科巴 40
+局部变量 40
+居延 40
+居首 40
+屡禁不止 40
+山根 40
+山神庙 40
+山顶洞人 40
+山鬼 40
+岸炮 40
+嵊县 40
+川藏公路 40
+川黔 40
+巡防 40
+工大 40
+左拉 40
+差官 40
+布劳恩 40
+师辅臣 40
+帕拉 40
+带伤 40
+帮规 40
+干酪 40
+平南 40
+平均分 40
+平抑 40
+平顶山市 40
+并辔 40
+广东省委 40
+广德 40
+庄园主 40
+庆州 40
+库克群岛 40
+庛 40
+府绸 40
+康乐 40
+廖萦卫 40
+延展性 40
+开播 40
+开采量 40
+异国他乡 40
+弱不禁风 40
+彭志海 40
+徐中玉 40
+徐志摩 40
+徐院长 40
+微电脑 40
+心算 40
+心结 40
+忍俊不禁 40
+忤逆 40
+忧闷 40
+急先锋 40
+情真意切 40
+惊鸿 40
+惠勒 40
+惶恐不安 40
+惹不起 40
+意志力 40
+懠 40
+戈什 40
+戏剧界 40
+成册 40
+戴维森 40
+所欲 40
+手术台 40
+打结 40
+打退堂鼓 40
+托辞 40
+扩股 40
+扰攘 40
+扶桑 40
+抓挠 40
+投影机 40
+抚河 40
+报警器 40
+抬杠 40
+拂袖 40
+拉姆齐 40
+拐骗 40
+招聘者 40
+拮抗 40
+指点迷津 40
+指示信 40
+挤入 40
+挥洒自如 40
+掠夺性 40
+探察 40
+提篮 40
+摆正 40
+摒 40
+播种机 40
+操之过急 40
+擦亮 40
+故友 40
+敖东 40
+散打 40
+敦煌石窟 40
+数等 40
+整齐划一 40
+敹 40
+文传 40
+文哥 40
+文山 40
+文科生 40
+斗法 40
+斜拉桥 40
+断头台 40
+新宠 40
+新闻性 40
+族谱 40
+无污染 40
+日本帝国 40
+日神 40
+旧体诗 40
+昆剧 40
+昌平县 40
+明报 40
+易手 40
+春分点 40
+春夏秋冬 40
+暄 40
+暖湿气流 40
+暗箭 40
+暗访 40
+替罪羊 40
+最高分 40
+月蚀 40
+有蹄类 40
+木塔 40
+木牛流马 40
+本义 40
+朱标 40
+杀人罪 40
+杂陈 40
+李克 40
+李善兰 40
+来凤 40
+来势汹汹 40
+来潮 40
+杭嘉和 40
+杰弗逊 40
+板门店 40
+枝繁叶茂 40
+枳 40
+某国 40
+柔嫩 40
+栀子 40
+栓皮栎 40
+格杀勿论 40
+格物 40
+桂圆 40
+梭镖 40
+梺 40
+棘刺 40
+棘皮动物 40
+概括性 40
+横加 40
+歌舞升平 40
+正体 40
+正儿八经 40
+武安 40
+残月 40
+殡 40
+殷铁生 40
+母婴 40
+毒砂 40
+毕天 40
+毖 40
+毛南族 40
+毛细 40
+民权主义 40
+民脂民膏 40
+水准面 40
+水压机 40
+水文站 40
+水法 40
+水流量 40
+水火棍 40
+永徽 40
+汇报会 40
+汉民 40
+汉萨 40
+汗衫 40
+江南运河 40
+汽车业 40
+没准儿 40
+沪宁 40
+河北梆子 40
+油然 40
+泰迪熊 40
+泻药 40
+洪积 40
+浓妆艳抹 40
+海军部 40
+海参崴 40
+海景 40
+消食 40
+淘汰率 40
+淳化 40
+渖 40
+温州市 40
+游人如织 40
+湿法 40
+溉 40
+溢洪道 40
+溪谷 40
+溲 40
+满江红 40
+满额 40
+滴答 40
+漂白粉 40
+演绎推理 40
+潭边 40
+灿然 40
+点菜 40
+炼化 40
+烟筒 40
+热播 40
+焦土 40
+煎药 40
+熙攘 40
+熹宗 40
+牖 40
+牙牌 40
+牙雕 40
+牛郎织女 40
+牠 40
+犀鸟 40
+犮 40
+状语 40
+狄更斯 40
+狐皮 40
+独吞 40
+独奏曲 40
+独孤求败 40
+獊 40
+王世才 40
+王母娘娘 40
+王蛤蟆 40
+玻璃体 40
+珍馐 40
+球馆 40
+瑶家 40
+生变 40
+生物系 40
+生龙活虎 40
+甠 40
+田螺 40
+申志凡 40
+电声 40
+疾步 40
+白杨树 40
+白森森 40
+百余种 40
+皇姑屯 40
+皮清玄 40
+直角三角形 40
+相濡以沫 40
+看涨 40
+矍 40
+矢志 40
+知性 40
+石炭世 40
+研究组 40
+硝酸钠 40
+碑林 40
+碑石 40
+磺酸 40
+礋 40
+礼花 40
+礼贤下士 40
+社科 40
+禁卫 40
+科利 40
+科尔沁 40
+租约 40
+秦思民 40
+秦曼卿 40
+税源 40
+穆雷 40
+空防 40
+突然性 40
+立春 40
+笑呵呵 40
+笔锋 40
+第一次国内革命战争 40
+第七个 40
+第二十八章 40
+第二遍 40
+筹算 40
+简亲王 40
+簻 40
+粮库 40
+精工 40
+精神病学 40
+索索 40
+索贿 40
+纪律性 40
+纱窗 40
+纳瓦霍 40
+纳黛特 40
+线性方程组 40
+组织系统 40
+细毛羊 40
+绝笔 40
+统一党 40
+罗曼蒂克 40
+罗瓦 40
+罗金仙 40
+美不胜收 40
+美意 40
+羔 40
+羪 40
+翻箱倒柜 40
+耀斑 40
+老伯伯 40
+老成持重 40
+考古工作者 40
+耳蜗 40
+聘书 40
+肉搏 40
+肖恩 40
+肱 40
+胆囊炎 40
+胸廓 40
+胺类 40
+脊骨 40
+脊髓灰质炎 40
+脑病 40
+脑膜 40
+脑髓 40
+腐肉 40
+腕骨 40
+自由体操 40
+舒适性 40
+航迹 40
+艾卜 40
+芉 40
+苏斯洛 40
+苗家 40
+苟活 40
+苦肉计 40
+苯基 40
+英华 40
+茶座 40
+莫雪 40
+莱切 40
+菔 40
+菜农 40
+萧衍 40
+萨哈林 40
+落笔 40
+蓝凤凰 40
+蕨菜 40
+虎符 40
+融资券 40
+蟪 40
+血洗 40
+补偿费 40
+被动式 40
+西半球 40
+西和 40
+西奈 40
+西萨摩亚 40
+观光客 40
+观察力 40
+观测点 40
+解放者 40
+言之凿凿 40
+警世 40
+警卫连 40
+警报声 40
+识趣 40
+试液 40
+试穿 40
+试车 40
+试验机 40
+该村 40
+误入歧途 40
+请愿书 40
+读报 40
+调派 40
+豆奶 40
+贞洁 40
+贤明 40
+质体 40
+贾布 40
+赭 40
+赵鑫鑫 40
+起风 40
+越级 40
+转盘 40
+轻软 40
+输导 40
+达斡尔族 40
+运抵 40
+近在眼前 40
+进口国 40
+连珠炮 40
+连生 40
+遗属 40
+遥遥无期 40
+遮遮掩掩 40
+避嫌 40
+郭勋 40
+郭姑娘 40
+酒桶 40
+酷吏 40
+酸碱度 40
+醡 40
+重犯 40
+金大坚 40
+金州 40
+金氏 40
+铁铸 40
+铝厂 40
+银川平原 40
+铺盖卷 40
+锆石 40
+镇江市 40
+长命百岁 40
+长年累月 40
+长椅 40
+长江局 40
+长衣 40
+门户之见 40
+闯荡江湖 40
+阔步 40
+防寒 40
+附上 40
+附属国 40
+陆奥 40
+陈娇 40
+陈永 40
+院本 40
+除害 40
+陨落 40
+难觅 40
+雕漆 40
+雨雾 40
+雷区 40
+青眼 40
+青藏公路 40
+革命英雄主义 40
+鞍子 40
+韩美军 40
+顾锋 40
+频发 40
+风机 40
+飞沙走石 40
+飞涨 40
+饭庄 40
+饲养业 40
+首陀罗 40
+马晓春 40
+马格里布 40
+骄奢淫逸 40
+骥 40
+高君宇 40
+高山流水 40
+高年级 40
+高福 40
+高级人民法院 40
+高跷 40
+鬃毛 40
+魔幻 40
+鲁忽赤 40
+鸟语花香 40
+鸡窝 40
+鹅毛 40
+鹅黄 40
+鹏程 40
+鹜 40
+鹦鹉螺 40
+麻纱 40
+麻醉剂 40
+黄仙姑 40
+黄信 40
+黑豹 40
+黑黑的 40
+龙旗 40
+一万年 39
+一两件 39
+一口口 39
+一片汪洋 39
+一站式 39
+一药 39
+丁零 39
+七十多岁 39
+七只 39
+七袋 39
+三叠系 39
+三明治 39
+三朵 39
+三番 39
+三百里 39
+三结合 39
+下铺 39
+不可抗力 39
+不容分说 39
+不成文法 39
+不期而遇 39
+不畏强暴 39
+不能自已 39
+且末 39
+东吴大学 39
+东莱 39
+两下子 39
+中国农业大学 39
+中央戏剧学院 39
+中央革命军事委员会 39
+中直机关 39
+丰润 39
+丰都 39
+临河 39
+丹徒 39
+丹霞 39
+主频 39
+举例来说 39
+举借 39
+乌克兰人 39
+乌纱 39
+乐坛 39
+九名 39
+九州岛 39
+乳罩 39
+二十多 39
+二员 39
+互不侵犯条约 39
+交感 39
+亭子间 39
+亲骨肉 39
+人力车 39
+人武部 39
+从商 39
+从始至终 39
+代用 39
+仲华 39
+仿冒 39
+伊朗外交部 39
+会所 39
+伯德 39
+伴星 39
+体纯 39
+体院 39
+佛兰德 39
+俗话说得好 39
+保卫部 39
+修女 39
+候机楼 39
+假劣 39
+假性 39
+偌 39
+偎倚 39
+偏巧 39
+偏旁 39
+偖 39
+做鬼 39
+健胃 39
+偶遇 39
+傲视 39
+元帝 39
+元昊 39
+光圈 39
+光大银行 39
+光辉灿烂 39
+克西 39
+克里米亚 39
+免交 39
+全面性 39
+公平性 39
+公益金 39
+关云长 39
+关系网 39
+关门主义 39
+其实不然 39
+具体来说 39
+养兵 39
+兼收并蓄 39
+兼收幷蓄 39
+再审 39
+冬寒 39
+冰原 39
+冰霜 39
+冷冷道 39
+冻僵 39
+净尽 39
+准确率 39
+凝固点 39
+出人意外 39
+出入口 39
+出殡 39
+列支敦士登 39
+刘先生 39
+刘华秋 39
+刘恒 39
+删节 39
+利尿剂 39
+功名富贵 39
+加味 39
+加拉 39
+加油添酱 39
+加注 39
+勘定 39
+匐 39
+北京故宫 39
+北京邮电大学 39
+北口 39
+匹夫之勇 39
+千条 39
+午休 39
+半死 39
+半瓶 39
+半音 39
+华帝 39
+单极 39
+单簧管 39
+南京站 39
+南北对话 39
+南大 39
+南社 39
+卜筮 39
+卢氏县 39
+卫辉 39
+压价 39
+双杠 39
+双翅目 39
+反其道而行之 39
+发奋 39
+发射台 39
+发晕 39
+取巧 39
+受困 39
+口臭 39
+古雅 39
+叫法 39
+叱喝 39
+司南 39
+叿 39
+各镇 39
+同房 39
+名酒 39
+后进生 39
+吩 39
+含辛茹苦 39
+吴达海 39
+吸湿性 39
+呼呼地 39
+哀嚎 39
+哈里森 39
+哮 39
+唱喏 39
+商船队 39
+啼叫 39
+啼鸣 39
+喑 39
+喜怒无常 39
+喧嚷 39
+喷管 39
+四合 39
+四方面军 39
+四星 39
+回传 39
+国贸 39
+图象 39
+圆山 39
+圆点 39
+土壤学 39
+土耳其语 39
+圣何塞 39
+地雷战 39
+坐浴 39
+坑口 39
+块体 39
+坢 39
+坦克车 39
+埢 39
+基里巴斯 39
+堂倌 39
+塔塔儿 39
+填表 39
+增白 39
+增订 39
+墨宝 39
+壁立 39
+声频 39
+复合体 39
+复垦 39
+夏口 39
+外带 39
+外强中干 39
+多汗 39
+多颗 39
+大个儿 39
+大亨 39
+大公无私 39
+大吉大利 39
+大暴雨 39
+大槐树 39
+大红袍 39
+大肉 39
+大龄 39
+天人合一 39
+天字 39
+天无绝人之路 39
+天牛 39
+天长地久 39
+天马行空 39
+太岳区 39
+失节 39
+失语 39
+奇巧 39
+奇葩 39
+奈斯 39
+套数 39
+奤 39
+媷 39
+嫁娶 39
+嬗变 39
+存根 39
+孙思克 39
+孝服 39
+孟德斯鸠 39
+安东尼奥 39
+安全事件 39
+安全措施 39
+安山岩 39
+宏图 39
+定位球 39
+定常 39
+宝柱妈 39
+宝钞 39
+实用化 39
+客座教授 39
+宫腔 39
+密歇根州 39
+寒湿 39
+对仗 39
+小品文 39
+小项 39
+少者 39
+尔布 39
+山茶花 39
+岸防 39
+嵝 39
+工作台 39
+工友 39
+左翼文化运动 39
+巧手 39
+巨子 39
+已往 39
+巴丁 39
+巴塔哥尼亚 39
+市北 39
+布莱希 39
+布鲁克 39
+帆板 39
+帐号 39
+席棚 39
+平准 39
+广播体操 39
+庸人 39
+庾亮 39
+开县 39
+开航 39
+张叔夜 39
+弯道 39
+彭三春 39
+彭越 39
+彴 39
+循序 39
+微雨 39
+徽宗 39
+心外科 39
+怅然 39
+急电 39
+急进 39
+总括 39
+恶变 39
+惊天 39
+想想也是 39
+戏迷 39
+扁鹊 39
+手机号 39
+打住 39
+打工仔 39
+扯皮 39
+批判性 39
+承上启下 39
+抗联 39
+折冲 39
+抚弄 39
+抢下 39
+报告期 39
+报复性 39
+抱佛脚 39
+拆分 39
+拉撒 39
+拉杆 39
+拉米雷斯 39
+拍手叫好 39
+拖船 39
+拥堵 39
+挂名 39
+指示器 39
+捐给 39
+捐躯 39
+排量 39
+插足 39
+搔痒 39
+搞垮 39
+摇旗呐喊 39
+撕破脸 39
+擒拿法 39
+收回成命 39
+收徒 39
+改革派 39
+攻击者 39
+政经 39
+教长 39
+敝处 39
+散射光 39
+数十条 39
+文学社 39
+文思 39
+文龙 39
+斤斗 39
+斯克 39
+斯托克斯 39
+斯蒂芬 39
+新店 39
+旋转式 39
+旒 39
+无亲无故 39
+无崖子 39
+无怪乎 39
+无邪 39
+无雨 39
+旧学 39
+早教 39
+旱路 39
+春风得意 39
+显庆 39
+景泰蓝 39
+梃 39
+楫 39
+歋 39
+殣 39
+泟 39
+烺 39
+珵 39
+癍 39
+苈 39
+莒 39
+蔛 39
+蘼 39
+蚯 39
+蜚 39
+蠛 39
+袕 39
+诶 39
+诼 39
+谲 39
+跏 39
+轲 39
+辘 39
+鐍 39
+骼 39
+鬟 39
+鲚 39
+鳊 39
+鳜 39
+鹨 39
+鹱 39
+鹾 39
+仉 38
+伅 38
+佘 38
+啛 38
+圻 38
+堞 38
+妭 38
+姗 38
+媪 38
+嫠 38
+屌 38
+幄 38
+幞 38
+挙 38
+昀 38
+昪 38
+暠 38
+桉 38
+棔 38
+欌 38
+浯 38
+潬 38
+煄 38
+熘 38
+燮 38
+畍 38
+砉 38
+碓 38
+碕 38
+纎 38
+肼 38
+胩 38
+膵 38
+荞 38
+薅 38
+蘑 38
+虺 38
+裼 38
+襆 38
+赉 38
+遬 38
+锍 38
+锘 38
+镧 38
+韹 38
+餝 38
+驎 38
+魍 38
+仃 37
+偻 37
+兏 37
+刞 37
+卉 37
+咖 37
+哌 37
+啉 37
+嗉 37
+嗫 37
+嚯 37
+塩 37
+墽 37
+尓 37
+嵯 37
+惘 37
+掋 37
+枂 37
+椆 37
+橯 37
+湉 37
+漪 37
+炱 37
+牺 37
+琯 37
+瓘 37
+睫 37
+礌 37
+窳 37
+篦 37
+籀 37
+羰 37
+蒱 37
+蠲 37
+袢 37
+裟 37
+覃 37
+覊 37
+豞 37
+邾 37
+鏮 37
+钕 37
+铈 37
+铧 37
+鲼 37
+鸢 37
+仈 36
+唺 36
+嚅 36
+寀 36
+恫 36
+抍 36
+昱 36
+曛 36
+梖 36
+棻 36
+楘 36
+泸 36
+洦 36
+爞 36
+璝 36
+璮 36
+畛 36
+祓 36
+腚 36
+舴 36
+菽 36
+萄 36
+蒽 36
+蛱 36
+蹠 36
+邝 36
+銎 36
+陼 36
+馁 36
+髹 36
+鬈 36
+鹁 36
+佼 35
+偓 35
+僦 35
+儜 35
+啯 35
+忾 35
+殇 35
+炀 35
+熀 35
+猲 35
+猷 35
+獙 35
+瓨 35
+紪 35
+耦 35
+艋 35
+茤 35
+莪 35
+蛎 35
+蜓 35
+褫 35
+踉 35
+郯 35
+酃 35
+酽 35
+鈋 35
+铑 35
+鲐 35
+鹕 35
+仴 34
+儓 34
+吣 34
+墰 34
+媿 34
+嫚 34
+寕 34
+寷 34
+崮 34
+崱 34
+崲 34
+憉 34
+拺 34
+敫 34
+柙 34
+榇 34
+槢 34
+氤 34
+沭 34
+湳 34
+灦 34
+猝 34
+瑄 34
+瓥 34
+痞 34
+瘝 34
+瞽 34
+緳 34
+芴 34
+蚣 34
+輣 34
+郿 34
+铛 34
+頬 34
+颙 34
+髌 34
+鬵 34
+鲟 34
+鸫 34
+鸲 34
+齑 34
+卅 33
+呔 33
+唵 33
+嚧 33
+堙 33
+嫪 33
+孲 33
+屐 33
+巶 33
+弡 33
+扦 33
+晣 33
+槔 33
+樆 33
+泺 33
+煍 33
+璠 33
+畧 33
+瘇 33
+睷 33
+碜 33
+粸 33
+罱 33
+肭 33
+苊 33
+蕈 33
+藋 33
+蟊 33
+譬 33
+逿 33
+邚 33
+酎 33
+铉 33
+镃 33
+阂 33
+霆 33
+鲅 33
+黐 33
+仝 32
+仮 32
+呓 32
+垡 32
+嫑 32
+惌 32
+惚 32
+愎 32
+朊 32
+棁 32
+泧 32
+洏 32
+潡 32
+珷 32
+疖 32
+痨 32
+瘷 32
+皙 32
+稔 32
+穨 32
+粙 32
+粴 32
+纥 32
+纻 32
+茐 32
+莘 32
+莞 32
+藁 32
+裰 32
+趮 32
+踜 32
+鄢 32
+鍨 32
+铼 32
+頧 32
+伔 31
+僰 31
+剽 31
+勣 31
+嘏 31
+壹 31
+奘 31
+姵 31
+屉 31
+崆 31
+庹 31
+弸 31
+暌 31
+栱 31
+浒 31
+煺 31
+瞩 31
+矞 31
+粹 31
+缔 31
+罟 31
+翚 31
+肫 31
+莮 31
+萁 31
+蚠 31
+襦 31
+譺 31
+豳 31
+蹩 31
+轸 31
+鄗 31
+铖 31
+镕 31
+飓 31
+鲲 31
+鲻 31
+麋 31
+侎 30
+偢 30
+噤 30
+孎 30
+孥 30
+尃 30
+尥 30
+崰 30
+嵒 30
+彾 30
+愦 30
+愫 30
+摐 30
+摥 30
+朦 30
+槗 30
+滁 30
+澘 30
+牂 30
+牚 30
+猖 30
+瑀 30
+皒 30
+皦 30
+皻 30
+眺 30
+瞠 30
+砀 30
+砘 30
+硇 30
+笣 30
+簵 30
+縯 30
+聣 30
+胬 30
+腝 30
+艚 30
+荦 30
+蚪 30
+褴 30
+谰 30
+躧 30
+逶 30
+鏿 30
+鑉 30
+锔 30
+阃 30
+陟 30
+靳 30
+飝 30
+髋 30
+黝 30
+侂 29
+倛 29
+儋 29
+厓 29
+呖 29
+噼 29
+嚈 29
+埘 29
+妳 29
+姽 29
+嫾 29
+屹 29
+恓 29
+惛 29
+憯 29
+懆 29
+掴 29
+桴 29
+槬 29
+橄 29
+殏 29
+潫 29
+潴 29
+烨 29
+烬 29
+琓 29
+疥 29
+瞑 29
+矽 29
+碚 29
+笊 29
+箰 29
+紭 29
+耜 29
+聒 29
+胗 29
+蒎 29
+蘏 29
+蛜 29
+蹻 29
+逡 29
+酐 29
+醭 29
+铄 29
+锲 29
+闉 29
+陬 29
+雎 29
+髅 29
+鹣 29
+乺 28
+儭 28
+凵 28
+叁 28
+哠 28
+嗾 28
+圳 28
+圷 28
+墡 28
+嫒 28
+嫫 28
+嫱 28
+徘 28
+愀 28
+戢 28
+扆 28
+扉 28
+扢 28
+旖 28
+旰 28
+暾 28
+棌 28
+楯 28
+榉 28
+槁 28
+櫒 28
+殌 28
+泲 28
+滏 28
+潅 28
+牎 28
+獋 28
+畈 28
+瘎 28
+瘨 28
+皿 28
+眇 28
+眸 28
+睍 28
+瞏 28
+矀 28
+砝 28
+礠 28
+祢 28
+篁 28
+粩 28
+罨 28
+罿 28
+羿 28
+耒 28
+耨 28
+肀 28
+胴 28
+舯 28
+莳 28
+藿 28
+蘩 28
+衽 28
+裥 28
+谆 28
+跖 28
+軴 28
+辚 28
+辶 28
+钘 28
+锟 28
+锿 28
+镎 28
+陉 28
+饄 28
+馗 28
+骟 28
+魈 28
+鮟 28
+鱇 28
+伩 27
+倐 27
+倗 27
+倜 27
+傒 27
+刄 27
+咻 27
+啶 27
+圮 27
+婼 27
+寜 27
+峥 27
+崳 27
+嵎 27
+嵫 27
+帤 27
+彶 27
+擘 27
+敉 27
+晗 27
+杅 27
+枹 27
+栩 27
+欹 27
+殗 27
+氓 27
+泫 27
+泶 27
+泷 27
+湹 27
+溟 27
+熳 27
+牝 27
+玳 27
+甓 27
+畑 27
+痿 27
+瘈 27
+瘔 27
+睘 27
+窛 27
+竳 27
+籝 27
+絙 27
+胙 27
+舸 27
+萑 27
+蓿 27
+谍 27
+蹓 27
+逭 27
+郜 27
+鍌 27
+鏴 27
+陗 27
+靬 27
+麸 27
+鼩 27
+鼷 27
+齆 27
+偦 26
+僗 26
+哏 26
+圯 26
+埅 26
+埭 26
+廆 26
+挒 26
+摷 26
+昰 26
+暧 26
+橦 26
+櫠 26
+湄 26
+潄 26
+潶 26
+煭 26
+煿 26
+獏 26
+玕 26
+盍 26
+硙 26
+祽 26
+筱 26
+箬 26
+粞 26
+繊 26
+藂 26
+蘖 26
+蛸 26
+讦 26
+诋 26
+谿 26
+贽 26
+赑 26
+赧 26
+鄏 26
+鄹 26
+钅 26
+靣 26
+飏 26
+骛 26
+鬯 26
+魟 26
+鲿 26
+鳀 26
+亘 25
+傉 25
+卬 25
+吝 25
+唻 25
+噰 25
+垟 25
+姌 25
+姸 25
+嫘 25
+嵛 25
+巈 25
+巼 25
+幛 25
+弰 25
+惆 25
+挧 25
+揎 25
+枾 25
+浍 25
+湔 25
+瀯 25
+瀷 25
+炅 25
+烶 25
+痫 25
+筨 25
+簠 25
+籰 25
+胛 25
+腧 25
+舳 25
+菅 25
+蕞 25
+薷 25
+藐 25
+蜽 25
+蝮 25
+螐 25
+襻 25
+觇 25
+诂 25
+诅 25
+邈 25
+邙 25
+鑋 25
+锪 25
+锬 25
+阯 25
+鞻 25
+馥 25
+鸶 25
+鸸 25
+麇 25
+麐 25
+仐 24
+冏 24
+刎 24
+剡 24
+劭 24
+嚒 24
+囱 24
+埞 24
+娊 24
+寗 24
+崌 24
+崞 24
+崠 24
+嵂 24
+帔 24
+庘 24
+弇 24
+彍 24
+恽 24
+悥 24
+悰 24
+惁 24
+拮 24
+挦 24
+撄 24
+擿 24
+昺 24
+暚 24
+杼 24
+桢 24
+梶 24
+槟 24
+橓 24
+檷 24
+汨 24
+汷 24
+渘 24
+溧 24
+漯 24
+烜 24
+煸 24
+爣 24
+瑭 24
+畀 24
+皠 24
+盥 24
+眈 24
+眿 24
+砒 24
+祏 24
+秙 24
+窆 24
+筅 24
+箖 24
+粢 24
+罾 24
+脍 24
+舨 24
+艨 24
+茴 24
+荟 24
+菟 24
+蘙 24
+裢 24
+諎 24
+譞 24
+邶 24
+醣 24
+鏖 24
+铚 24
+锼 24
+镊 24
+镺 24
+髑 24
+鹌 24
+亱 23
+仠 23
+伄 23
+剢 23
+劄 23
+啭 23
+坭 23
+婪 23
+孑 23
+寙 23
+尪 23
+彔 23
+抉 23
+拤 23
+拶 23
+撷 23
+暝 23
+棓 23
+椃 23
+檈 23
+檭 23
+櫤 23
+殛 23
+毚 23
+毵 23
+洊 23
+洵 23
+渑 23
+湝 23
+滓 23
+潼 23
+疡 23
+癋 23
+礻 23
+祫 23
+禧 23
+穬 23
+箤 23
+繝 23
+耧 23
+耷 23
+肜 23
+芠 23
+荑 23
+菘 23
+蓓 23
+薙 23
+觏 23
+譕 23
+跞 23
+輨 23
+迕 23
+迾 23
+逖 23
+鄜 23
+钄 23
+镄 23
+镢 23
+隗 23
+飑 23
+䴉 22
+丳 22
+偐 22
+哙 22
+垐 22
+垶 22
+堉 22
+塖 22
+妲 22
+娠 22
+嫆 22
+嫈 22
+嫟 22
+宊 22
+尬 22
+岽 22
+嵄 22
+廵 22
+徳 22
+怙 22
+悃 22
+惔 22
+憞 22
+摽 22
+昫 22
+暎 22
+曶 22
+棫 22
+殄 22
+氵 22
+琰 22
+畤 22
+瘹 22
+睶 22
+瞰 22
+硩 22
+箨 22
+簭 22
+綊 22
+縍 22
+胝 22
+胳 22
+茭 22
+茱 22
+菹 22
+蚺 22
+褊 22
+觌 22
+赓 22
+跚 22
+醵 22
+鍿 22
+颀 22
+颎 22
+骎 22
+鲉 22
+鲮 22
+鹮 22
+黻 22
+伧 21
+伫 21
+冽 21
+劁 21
+吖 21
+喆 21
+噣 21
+埜 21
+娾 21
+媲 21
+嫄 21
+崕 21
+崸 21
+嵡 21
+嵮 21
+巂 21
+彖 21
+徼 21
+惋 21
+慓 21
+憔 21
+扌 21
+挄 21
+挰 21
+挹 21
+攉 21
+攵 21
+昖 21
+柦 21
+梌 21
+棙 21
+棯 21
+椐 21
+檫 21
+泭 21
+渫 21
+湣 21
+溾 21
+漉 21
+甏 21
+畩 21
+睽 21
+祂 21
+笇 21
+篼 21
+緵 21
+纇 21
+纮 21
+缑 21
+罘 21
+翡 21
+腉 21
+芲 21
+苓 21
+菡 21
+蒕 21
+跄 21
+邽 21
+鄘 21
+钐 21
+頞 21
+頠 21
+骝 21
+鯯 21
+鲣 21
+鲴 21
+鹇 21
+鹈 21
+麴 21
+鼯 21
+亜 20
+偗 20
+儌 20
+勔 20
+厰 20
+吂 20
+吲 20
+哔 20
+哿 20
+啁 20
+坷 20
+垪 20
+憖 20
+敄 20
+晰 20
+暡 20
+曩 20
+杷 20
+栌 20
+椻 20
+橸 20
+洱 20
+漊 20
+珣 20
+珫 20
+磥 20
+礊 20
+秅 20
+筰 20
+紤 20
+絒 20
+脁 20
+茁 20
+茕 20
+荰 20
+莨 20
+萋 20
+蓕 20
+蔺 20
+薏 20
+谮 20
+貊 20
+逄 20
+逅 20
+邗 20
+郄 20
+郛 20
+酞 20
+醢 20
+鐕 20
+鑅 20
+锎 20
+隍 20
+霃 20
+飗 20
+饯 20
+鱀 20
+鲔 20
+鲕 20
+鳉 20
+麈 20
+黅 20
+黺 20
+侒 19
+侪 19
+俧 19
+傥 19
+傧 19
+剸 19
+劢 19
+呙 19
+嘡 19
+圼 19
+埒 19
+墭 19
+夆 19
+屭 19
+崦 19
+嵺 19
+巟 19
+恙 19
+悡 19
+悻 19
+扱 19
+挲 19
+捇 19
+摭 19
+旯 19
+晥 19
+枥 19
+栻 19
+梮 19
+榾 19
+檞 19
+檠 19
+檶 19
+欷 19
+焜 19
+牳 19
+獈 19
+獍 19
+玡 19
+皘 19
+皧 19
+睆 19
+矬 19
+粳 19
+緸 19
+翋 19
+翌 19
+腱 19
+腴 19
+萆 19
+蕹 19
+蕺 19
+蟟 19
+豨 19
+趼 19
+遐 19
+邰 19
+鄐 19
+酊 19
+钔 19
+铫 19
+锃 19
+闬 19
+雓 19
+饴 19
+鱊 19
+鼂 19
+仟 18
+倬 18
+偾 18
+兕 18
+凖 18
+厧 18
+吀 18
+咤 18
+哳 18
+唣 18
+噳 18
+圹 18
+垊 18
+垽 18
+墅 18
+墝 18
+壄 18
+妪 18
+忐 18
+怍 18
+惈 18
+慝 18
+懽 18
+戆 18
+挢 18
+揄 18
+搛 18
+攮 18
+斝 18
+晡 18
+晳 18
+査 18
+栀 18
+楮 18
+槒 18
+泅 18
+漽 18
+潌 18
+炻 18
+牻 18
+璨 18
+瓴 18
+畋 18
+痵 18
+皞 18
+盉 18
+矙 18
+硃 18
+笎 18
+簮 18
+繋 18
+脒 18
+荠 18
+荽 18
+蕬 18
+蚵 18
+蟭 18
+衖 18
+衤 18
+讠 18
+郫 18
+鄞 18
+酤 18
+錱 18
+鐇 18
+铓 18
+陲 18
+馇 18
+馏 18
+鬡 18
+魆 18
+鲝 18
+鳟 18
+鵖 18
+鵽 18
+鷶 18
+鹂 18
+鹩 18
+黠 18
+亰 17
+伡 17
+佉 17
+侉 17
+刽 17
+剀 17
+厡 17
+哞 17
+唨 17
+唹 17
+喦 17
+埓 17
+堠 17
+墧 17
+妧 17
+嫃 17
+屳 17
+嵇 17
+廒 17
+徍 17
+惣 17
+攲 17
+旮 17
+昩 17
+杧 17
+梿 17
+棐 17
+椓 17
+槠 17
+橷 17
+檺 17
+殚 17
+浄 17
+溞 17
+澍 17
+濑 17
+焺 17
+狙 17
+猾 17
+獞 17
+琍 17
+璃 17
+疁 17
+稶 17
+笓 17
+糇 17
+縼 17
+羸 17
+耓 17
+膦 17
+臆 17
+艔 17
+芘 17
+莓 17
+蒹 17
+蚇 17
+裣 17
+趎 17
+跆 17
+軃 17
+酹 17
+阱 17
+韘 17
+颡 17
+驺 17
+骧 17
+鮤 17
+鳚 17
+鵰 17
+鶿 17
+鹚 17
+伝 16
+伬 16
+倧 16
+劙 16
+噻 16
+嚏 16
+圾 16
+埁 16
+墼 16
+壈 16
+夨 16
+姈 16
+婳 16
+尐 16
+彫 16
+徆 16
+徎 16
+恊 16
+愔 16
+懑 16
+挓 16
+挼 16
+攱 16
+昶 16
+晽 16
+朷 16
+杄 16
+殧 16
+汆 16
+涒 16
+淸 16
+渰 16
+煁 16
+燊 16
+犴 16
+瑚 16
+瑴 16
+甗 16
+畎 16
+痎 16
+瘜 16
+瘰 16
+癯 16
+眘 16
+祻 16
+禆 16
+秫 16
+稃 16
+窀 16
+粫 16
+綖 16
+緛 16
+罼 16
+羑 16
+膑 16
+舡 16
+荇 16
+荝 16
+菝 16
+蒌 16
+蕍 16
+袼 16
+裎 16
+貀 16
+趵 16
+跬 16
+遹 16
+邃 16
+邨 16
+郷 16
+郸 16
+鎀 16
+鎑 16
+鲳 16
+鳏 16
+鸔 16
+麜 16
+仼 15
+佝 15
+偬 15
+唁 15
+唧 15
+啫 15
+嚩 15
+囡 15
+埥 15
+埮 15
+墉 15
+宑 15
+尗 15
+尞 15
+岌 15
+巉 15
+巯 15
+帰 15
+彃 15
+彳 15
+徕 15
+怈 15
+戡 15
+戥 15
+摵 15
+敟 15
+朓 15
+朾 15
+杪 15
+枘 15
+柨 15
+栾 15
+榍 15
+櫆 15
+殕 15
+洀 15
+洃 15
+洑 15
+洧 15
+潎 15
+灊 15
+灖 15
+炟 15
+猂 15
+獥 15
+獴 15
+玦 15
+珙 15
+珥 15
+璩 15
+瘕 15
+癚 15
+睐 15
+硭 15
+箅 15
+箪 15
+籂 15
+綦 15
+臜 15
+菃 15
+蓍 15
+蛖 15
+蛡 15
+蟝 15
+觔 15
+謆 15
+讧 15
+邠 15
+酡 15
+鉨 15
+钲 15
+霺 15
+鱏 15
+鲏 15
+鲥 15
+鲶 15
+鳁 15
+鳑 15
+鳓 15
+鸰 15
+麿 15
+丌 14
+乂 14
+侩 14
+偩 14
+傈 14
+儊 14
+剠 14
+剦 14
+嘹 14
+噩 14
+噱 14
+垃 14
+墀 14
+姎 14
+姷 14
+媖 14
+媰 14
+嫓 14
+宬 14
+寖 14
+尢 14
+屺 14
+崒 14
+彺 14
+悭 14
+惥 14
+惬 14
+愘 14
+懙 14
+挗 14
+揝 14
+搐 14
+搫 14
+搰 14
+摱 14
+攧 14
+杚 14
+杛 14
+柤 14
+柭 14
+桕 14
+桜 14
+棆 14
+様 14
+檰 14
+櫡 14
+泮 14
+浈 14
+渿 14
+湕 14
+漡 14
+瀻 14
+瀼 14
+焼 14
+爫 14
+琬 14
+祉 14
+簌 14
+簥 14
+繌 14
+聀 14
+聤 14
+肙 14
+腄 14
+腘 14
+腠 14
+腩 14
+腼 14
+莠 14
+蒯 14
+衯 14
+袤 14
+裒 14
+襜 14
+觜 14
+訾 14
+誯 14
+豽 14
+貥 14
+軏 14
+轠 14
+辋 14
+辎 14
+遴 14
+郦 14
+钭 14
+铦 14
+阝 14
+鞬 14
+飒 14
+駹 14
+騄 14
+驽 14
+鮣 14
+鯗 14
+鰕 14
+鲞 14
+鳛 14
+鹛 14
+鹡 14
+伢 13
+侏 13
+俰 13
+僬 13
+厫 13
+叜 13
+哜 13
+啣 13
+啺 13
+噧 13
+団 13
+坌 13
+垆 13
+妏 13
+妱 13
+姠 13
+嫙 13
+孱 13
+崯 13
+嵸 13
+嶛 13
+帾 13
+幆 13
+幏 13
+廾 13
+徉 13
+忊 13
+怿 13
+恅 13
+恲 13
+慂 13
+扜 13
+扤 13
+拻 13
+揲 13
+攼 13
+枼 13
+桾 13
+棽 13
+檿 13
+洇 13
+渆 13
+渷 13
+潩 13
+潺 13
+瀺 13
+狨 13
+玁 13
+痳 13
+瘖 13
+盌 13
+矊 13
+矝 13
+砊 13
+砟 13
+磶 13
+礂 13
+礽 13
+祴 13
+稂 13
+穇 13
+竆 13
+竣 13
+笩 13
+箁 13
+箻 13
+簃 13
+糸 13
+罍 13
+翄 13
+膂 13
+艟 13
+苺 13
+蜢 13
+蜮 13
+螂 13
+裃 13
+讉 13
+赅 13
+蹽 13
+蹾 13
+躟 13
+轺 13
+酫 13
+鈇 13
+钆 13
+钷 13
+閤 13
+鞶 13
+骕 13
+骘 13
+鯵 13
+伣 12
+俐 12
+俢 12
+倢 12
+倮 12
+冑 12
+刳 12
+剕 12
+匋 12
+匼 12
+吽 12
+嘾 12
+噁 12
+噭 12
+囔 12
+圗 12
+坼 12
+垗 12
+埑 12
+埝 12
+墛 12
+墷 12
+墺 12
+嬛 12
+尨 12
+屣 12
+崪 12
+嵏 12
+巜 12
+廛 12
+悏 12
+悐 12
+拡 12
+撯 12
+攽 12
+敇 12
+敥 12
+昃 12
+晱 12
+柝 12
+柰 12
+桺 12
+棇 12
+棨 12
+椇 12
+榱 12
+榸 12
+槅 12
+槮 12
+槰 12
+橼 12
+檽 12
+櫕 12
+殂 12
+汧 12
+淆 12
+漅 12
+潣 12
+濩 12
+烀 12
+焗 12
+焪 12
+煖 12
+熌 12
+燔 12
+狞 12
+瓕 12
+瓩 12
+疳 12
+皷 12
+盦 12
+眭 12
+眴 12
+眹 12
+睇 12
+矌 12
+礅 12
+祾 12
+簸 12
+紨 12
+絜 12
+翩 12
+脥 12
+腙 12
+腷 12
+苒 12
+茔 12
+莩 12
+菖 12
+葜 12
+蔸 12
+蚃 12
+蚥 12
+觋 12
+諅 12
+讴 12
+跽 12
+踥 12
+踲 12
+郐 12
+鄤 12
+醄 12
+釪 12
+鎉 12
+铒 12
+铕 12
+锫 12
+镈 12
+鞮 12
+鬾 12
+鯭 12
+鯼 12
+鴢 12
+鵣 12
+鸻 12
+鹎 12
+鼹 12
+仯 11
+倲 11
+偤 11
+冓 11
+厀 11
+哓 11
+圢 11
+垖 11
+垤 11
+墸 11
+壍 11
+妘 11
+姡 11
+嫀 11
+嫜 11
+屃 11
+帍 11
+忡 11
+怘 11
+慸 11
+拏 11
+挋 11
+挌 11
+昝 11
+曗 11
+杸 11
+枞 11
+枨 11
+枸 11
+柷 11
+柽 11
+桷 11
+棪 11
+棴 11
+椦 11
+樃 11
+檒 11
+歜 11
+殭 11
+汹 11
+沚 11
+泒 11
+泔 11
+浛 11
+湨 11
+溷 11
+漈 11
+瀀 11
+牁 11
+犏 11
+犫 11
+狝 11
+狺 11
+玝 11
+玷 11
+珜 11
+琥 11
+畞 11
+痝 11
+皑 11
+眝 11
+矧 11
+砬 11
+碔 11
+秕 11
+竫 11
+笐 11
+粣 11
+糅 11
+糗 11
+繲 11
+翀 11
+耑 11
+聃 11
+苾 11
+菥 11
+蒫 11
+蔕 11
+蕤 11
+薤 11
+螳 11
+蟋 11
+蟼 11
+蠋 11
+裛 11
+覻 11
+訬 11
+趆 11
+蹡 11
+躷 11
+轘 11
+辧 11
+釭 11
+鍅 11
+鐟 11
+钰 11
+铻 11
+雩 11
+骢 11
+髫 11
+鮄 11
+鳢 11
+鹉 11
+鹋 11
+黧 11
+亊 10
+亻 10
+伾 10
+俇 10
+倥 10
+倻 10
+偀 10
+偍 10
+偯 10
+偼 10
+傀 10
+僆 10
+僈 10
+僓 10
+刿 10
+坩 10
+垩 10
+堚 10
+姁 10
+媁 10
+嬧 10
+寞 10
+寣 10
+尮 10
+岙 10
+岾 10
+峺 10
+嵕 10
+幒 10
+廲 10
+忞 10
+忭 10
+怏 10
+怩 10
+悺 10
+悾 10
+惽 10
+愞 10
+擐 10
+敩 10
+曌 10
+曯 10
+枏 10
+枠 10
+枵 10
+梑 10
+棜 10
+槯 10
+殩 10
+毽 10
+汭 10
+泃 10
+泖 10
+泤 10
+泱 10
+洈 10
+洙 10
+潲 10
+燏 10
+牾 10
+狢 10
+猊 10
+猢 10
+玢 10
+珽 10
+琫 10
+璆 10
+瘁 10
+瘒 10
+眢 10
+眵 10
+睮 10
+睺 10
+祅 10
+禐 10
+禺 10
+秱 10
+筲 10
+箘 10
+籔 10
+粊 10
+粶 10
+缵 10
+羼 10
+翮 10
+聛 10
+脃 10
+脔 10
+脩 10
+舄 10
+茌 10
+菠 10
+菴 10
+菻 10
+蔟 10
+蔻 10
+蘘 10
+蚳 10
+蜊 10
+蜩 10
+蜻 10
+蜿 10
+蟦 10
+襞 10
+觊 10
+謤 10
+豊 10
+躐 10
+軶 10
+轵 10
+迍 10
+邡 10
+酩 10
+酺 10
+醠 10
+鐎 10
+铗 10
+陧 10
+隈 10
+騠 10
+骀 10
+骃 10
+鬘 10
+魜 10
+鲠 10
+鸓 10
+鹟 10
+黚 10
+乕 9
+亷 9
+俵 9
+倠 9
+偧 9
+傺 9
+剒 9
+劎 9
+劘 9
+呪 9
+咆 9
+咙 9
+啅 9
+嘚 9
+噍 9
+埕 9
+塨 9
+媠 9
+嫝 9
+寃 9
+寽 9
+峱 9
+崜 9
+嵑 9
+嶒 9
+嶡 9
+帎 9
+廿 9
+彅 9
+徂 9
+忸 9
+恇 9
+悷 9
+捊 9
+敜 9
+敧 9
+昑 9
+柕 9
+梡 9
+楖 9
+橥 9
+櫁 9
+櫈 9
+歍 9
+洴 9
+湼 9
+澒 9
+瀿 9
+焞 9
+熯 9
+燠 9
+犋 9
+玥 9
+珌 9
+珦 9
+琨 9
+疠 9
+疴 9
+瘗 9
+癎 9
+癜 9
+瞋 9
+瞮 9
+瞺 9
+硁 9
+碂 9
+碻 9
+祬 9
+禋 9
+秴 9
+秹 9
+稸 9
+穄 9
+穼 9
+笍 9
+笚 9
+筠 9
+箥 9
+簢 9
+緭 9
+繂 9
+繈 9
+纩 9
+绚 9
+耢 9
+耩 9
+肦 9
+胵 9
+舭 9
+茆 9
+萏 9
+萸 9
+葟 9
+薽 9
+藛 9
+藨 9
+蘧 9
+蚫 9
+蜋 9
+蟁 9
+蠀 9
+蠵 9
+裯 9
+訇 9
+豉 9
+踳 9
+躇 9
+轼 9
+邲 9
+郇 9
+鄫 9
+鉩 9
+鋆 9
+锠 9
+镪 9
+鞗 9
+韗 9
+颛 9
+飖 9
+馒 9
+鲦 9
+鸜 9
+麕 9
+麹 9
+齍 9
+仵 8
+伉 8
+佶 8
+佻 8
+侁 8
+偑 8
+僼 8
+儙 8
+児 8
+兠 8
+刭 8
+剻 8
+劋 8
+劼 8
+匽 8
+卨 8
+叇 8
+唃 8
+唲 8
+喱 8
+坆 8
+坈 8
+坫 8
+埉 8
+埵 8
+奓 8
+姃 8
+姭 8
+嫥 8
+尙 8
+尟 8
+屦 8
+岧 8
+峸 8
+崅 8
+嵃 8
+嵉 8
+嵿 8
+巹 8
+廑 8
+徢 8
+悀 8
+悙 8
+愌 8
+愱 8
+憟 8
+憺 8
+拃 8
+捃 8
+揌 8
+揸 8
+敳 8
+朘 8
+朮 8
+杙 8
+枆 8
+枧 8
+枲 8
+柠 8
+柣 8
+栤 8
+樐 8
+檇 8
+檎 8
+檪 8
+檵 8
+櫔 8
+櫦 8
+沌 8
+洨 8
+浂 8
+溄 8
+滉 8
+滘 8
+滟 8
+滹 8
+潨 8
+瀽 8
+灜 8
+灩 8
+灮 8
+烝 8
+焃 8
+焄 8
+煋 8
+煕 8
+狓 8
+猺 8
+獌 8
+獓 8
+瑮 8
+璪 8
+璬 8
+璿 8
+甍 8
+甦 8
+瘐 8
+瘼 8
+皡 8
+皤 8
+皼 8
+盩 8
+眚 8
+睅 8
+砋 8
+砕 8
+砼 8
+碏 8
+磼 8
+禥 8
+禩 8
+秮 8
+筘 8
+箑 8
+箠 8
+箼 8
+簛 8
+緤 8
+縦 8
+繑 8
+绹 8
+肄 8
+胭 8
+膭 8
+臞 8
+茯 8
+荖 8
+荜 8
+葶 8
+蒗 8
+蔵 8
+藦 8
+虓 8
+蛬 8
+蜈 8
+蝼 8
+蝾 8
+裈 8
+褙 8
+襛 8
+訳 8
+詝 8
+豴 8
+贶 8
+赆 8
+赙 8
+趄 8
+踠 8
+蹉 8
+躘 8
+迢 8
+遘 8
+邅 8
+邘 8
+鄠 8
+鄩 8
+酂 8
+釱 8
+鉲 8
+鑍 8
+铤 8
+铥 8
+锱 8
+镥 8
+閯 8
+阘 8
+陔 8
+雯 8
+霣 8
+霹 8
+靹 8
+靿 8
+鞸 8
+骅 8
+鱚 8
+鱩 8
+鲾 8
+鶒 8
+黟 8
+黮 8
+齁 8
+龁 8
+丗 7
+乴 7
+仜 7
+佰 7
+俉 7
+倽 7
+偆 7
+偲 7
+僜 7
+儇 7
+凗 7
+凮 7
+剺 7
+劖 7
+匜 7
+匬 7
+厹 7
+吔 7
+咫 7
+咹 7
+哻 7
+唎 7
+唼 7
+啲 7
+坧 7
+垧 7
+堀 7
+塯 7
+墕 7
+壌 7
+姖 7
+媋 7
+媵 7
+嫎 7
+嫧 7
+宐 7
+宔 7
+岈 7
+岼 7
+峘 7
+嵧 7
+嵵 7
+嶫 7
+帬 7
+弤 7
+忬 7
+怱 7
+悒 7
+惞 7
+惢 7
+憗 7
+憝 7
+憸 7
+扃 7
+抨 7
+拠 7
+挜 7
+挴 7
+掭 7
+掯 7
+搮 7
+搸 7
+摋 7
+摦 7
+昚 7
+暁 7
+暸 7
+柗 7
+柧 7
+柼 7
+栟 7
+梣 7
+椯 7
+槱 7
+槺 7
+橚 7
+檹 7
+檾 7
+毎 7
+毞 7
+毳 7
+泐 7
+渼 7
+溇 7
+滠 7
+滢 7
+滱 7
+滺 7
+澃 7
+澌 7
+瀴 7
+灒 7
+炩 7
+焴 7
+煴 7
+煹 7
+爢 7
+牥 7
+犷 7
+猕 7
+猚 7
+獘 7
+珺 7
+瑆 7
+瘆 7
+皣 7
+皪 7
+眄 7
+睵 7
+瞀 7
+矡 7
+硸 7
+磃 7
+磭 7
+祵 7
+祹 7
+祼 7
+禖 7
+禵 7
+秗 7
+穻 7
+窿 7
+筌 7
+筻 7
+簏 7
+籓 7
+絷 7
+縖 7
+繉 7
+耖 7
+耰 7
+聩 7
+脞 7
+臇 7
+臾 7
+舁 7
+舓 7
+舣 7
+舺 7
+芵 7
+苘 7
+莛 7
+莰 7
+莶 7
+蓊 7
+蓨 7
+蜍 7
+蜘 7
+螋 7
+螗 7
+螼 7
+蟘 7
+裀 7
+誜 7
+谞 7
+豸 7
+貔 7
+踌 7
+踬 7
+躄 7
+逎 7
+郕 7
+郤 7
+鄮 7
+鋐 7
+鋕 7
+钬 7
+铹 7
+锴 7
+镛 7
+镝 7
+镤 7
+镨 7
+閴 7
+阚 7
+霨 7
+韂 7
+顼 7
+饛 7
+饹 7
+馘 7
+騟 7
+骦 7
+鬶 7
+鮈 7
+鱓 7
+鱡 7
+鲃 7
+鳇 7
+鳒 7
+鳡 7
+鵚 7
+鹠 7
+鼱 7
+乢 6
+乥 6
+亝 6
+伋 6
+伖 6
+伛 6
+伥 6
+佾 6
+侤 6
+倹 6
+偄 6
+偔 6
+偫 6
+僡 6
+儰 6
+刓 6
+剚 6
+剟 6
+卙 6
+呻 6
+啑 6
+嗞 6
+嚊 6
+坙 6
+坻 6
+垠 6
+墁 6
+夼 6
+奡 6
+妣 6
+妶 6
+姫 6
+媱 6
+媹 6
+孈 6
+孖 6
+孞 6
+寈 6
+寑 6
+専 6
+尶 6
+屪 6
+岍 6
+崊 6
+嶎 6
+巎 6
+廌 6
+弴 6
+徯 6
+悱 6
+愒 6
+慴 6
+慹 6
+抁 6
+抔 6
+抴 6
+掊 6
+揾 6
+搇 6
+搲 6
+摤 6
+摬 6
+擝 6
+攺 6
+敔 6
+敠 6
+敭 6
+敻 6
+斀 6
+昬 6
+暻 6
+曂 6
+曧 6
+朄 6
+朙 6
+朡 6
+枅 6
+栝 6
+桚 6
+梇 6
+梈 6
+梉 6
+棅 6
+棥 6
+楡 6
+楿 6
+榌 6
+槀 6
+槙 6
+槜 6
+檩 6
+氕 6
+汩 6
+沩 6
+沬 6
+洣 6
+湲 6
+湻 6
+溘 6
+漷 6
+潸 6
+澉 6
+瀍 6
+灪 6
+焮 6
+煃 6
+煼 6
+牐 6
+牤 6
+犭 6
+犼 6
+狰 6
+狷 6
+獂 6
+瑇 6
+甪 6
+疂 6
+痖 6
+痟 6
+痱 6
+痷 6
+瘏 6
+癈 6
+癕 6
+皾 6
+盱 6
+眍 6
+硚 6
+硾 6
+礞 6
+祩 6
+禂 6
+穅 6
+笡 6
+筩 6
+篯 6
+簝 6
+簰 6
+簱 6
+籹 6
+糺 6
+絈 6
+緫 6
+縠 6
+縿 6
+繓 6
+羕 6
+翑 6
+翣 6
+耊 6
+耋 6
+聄 6
+臿 6
+苜 6
+菾 6
+葺 6
+蓁 6
+蓐 6
+蓰 6
+虩 6
+蛒 6
+蝙 6
+螃 6
+螠 6
+螽 6
+蟰 6
+袈 6
+褎 6
+踚 6
+蹐 6
+躜 6
+輂 6
+迮 6
+遢 6
+邋 6
+鄚 6
+酗 6
+醑 6
+鈚 6
+鏬 6
+鏯 6
+锧 6
+镱 6
+镲 6
+镴 6
+隞 6
+鞳 6
+颋 6
+颢 6
+馃 6
+骲 6
+鰯 6
+鳂 6
+鹲 6
+齄 6
+齇 6
+乊 5
+乸 5
+佀 5
+俍 5
+俤 5
+倅 5
+儠 5
+儦 5
+兊 5
+兓 5
+剨 5
+吥 5
+咵 5
+啝 5
+嗢 5
+圞 5
+坵 5
+垍 5
+埖 5
+堍 5
+堺 5
+塍 5
+塙 5
+塻 5
+墑 5
+墣 5
+墹 5
+壆 5
+壷 5
+奯 5
+姂 5
+娂 5
+嫏 5
+嫛 5
+孭 5
+孮 5
+寯 5
+屫 5
+岤 5
+峅 5
+峛 5
+峫 5
+峵 5
+崁 5
+崥 5
+嵙 5
+嵪 5
+嵷 5
+嶊 5
+嶜 5
+嶝 5
+嶳 5
+巺 5
+幉 5
+幵 5
+庳 5
+廱 5
+弖 5
+徰 5
+忦 5
+怗 5
+怣 5
+恈 5
+恜 5
+恧 5
+恵 5
+悂 5
+悫 5
+惓 5
+惖 5
+愅 5
+憪 5
+戋 5
+戬 5
+扵 5
+抎 5
+拑 5
+拹 5
+挳 5
+捀 5
+捩 5
+摠 5
+攁 5
+敎 5
+旲 5
+晧 5
+晬 5
+暭 5
+朏 5
+杁 5
+杻 5
+枓 5
+柲 5
+桲 5
+桸 5
+棷 5
+棼 5
+椀 5
+楜 5
+楢 5
+楪 5
+檗 5
+檼 5
+櫋 5
+殦 5
+殽 5
+汐 5
+汔 5
+溽 5
+滗 5
+炢 5
+焇 5
+焤 5
+焻 5
+煂 5
+煆 5
+煠 5
+爜 5
+牏 5
+牭 5
+猁 5
+猃 5
+猉 5
+瓌 5
+瓍 5
+畯 5
+疙 5
+痍 5
+痭 5
+癃 5
+皕 5
+皳 5
+盝 5
+眊 5
+眑 5
+眜 5
+睉 5
+瞈 5
+瞌 5
+砅 5
+硻 5
+磙 5
+磡 5
+祃 5
+秼 5
+稛 5
+穽 5
+窋 5
+窓 5
+箒 5
+箷 5
+篚 5
+篪 5
+糍 5
+糣 5
+絁 5
+絺 5
+綮 5
+縢 5
+繄 5
+纟 5
+翥 5
+肐 5
+芓 5
+茑 5
+茛 5
+茺 5
+莋 5
+菉 5
+葙 5
+葩 5
+葳 5
+薗 5
+薾 5
+蛃 5
+蝀 5
+蠃 5
+蠙 5
+衒 5
+袆 5
+袓 5
+襨 5
+觎 5
+豟 5
+赒 5
+赕 5
+踄 5
+踣 5
+蹀 5
+蹂 5
+蹒 5
+躏 5
+躻 5
+遨 5
+邴 5
+鄃 5
+鄷 5
+酲 5
+醅 5
+醕 5
+鈪 5
+鉠 5
+鋹 5
+鋽 5
+鎁 5
+鎈 5
+铽 5
+閟 5
+靸 5
+頢 5
+顗 5
+餂 5
+餈 5
+馂 5
+驩 5
+驵 5
+骜 5
+鬏 5
+魀 5
+鱑 5
+鱵 5
+鲒 5
+鲹 5
+鲺 5
+鳘 5
+鴗 5
+鴡 5
+鶑 5
+鸤 5
+黉 5
+黢 5
+黹 5
+龠 5
+乭 4
+亀 4
+亖 4
+仱 4
+仾 4
+伱 4
+佡 4
+侚 4
+侳 4
+俥 4
+俽 4
+倕 4
+偒 4
+偪 4
+傃 4
+儤 4
+儵 4
+兾 4
+冂 4
+冝 4
+冣 4
+処 4
+刜 4
+刼 4
+勅 4
+勌 4
+卍 4
+卺 4
+厑 4
+厞 4
+厬 4
+厱 4
+厺 4
+厼 4
+吿 4
+呣 4
+唞 4
+唪 4
+啕 4
+喈 4
+喕 4
+噕 4
+嚂 4
+囮 4
+圚 4
+圫 4
+圬 4
+坹 4
+垇 4
+垉 4
+垴 4
+垷 4
+垺 4
+塸 4
+墎 4
+墥 4
+墬 4
+墵 4
+壸 4
+娀 4
+婍 4
+媉 4
+嫮 4
+嬎 4
+嬚 4
+嬾 4
+宭 4
+宱 4
+宷 4
+対 4
+岕 4
+峆 4
+峗 4
+峚 4
+峝 4
+峹 4
+崓 4
+嵍 4
+嵖 4
+嵟 4
+嵦 4
+嵶 4
+嶐 4
+嶓 4
+巙 4
+巵 4
+幖 4
+廕 4
+弉 4
+彣 4
+徜 4
+徺 4
+忄 4
+忈 4
+忑 4
+忪 4
+怬 4
+恎 4
+恛 4
+悈 4
+悑 4
+悘 4
+悛 4
+悝 4
+悹 4
+惃 4
+惄 4
+惝 4
+慁 4
+慺 4
+憍 4
+懅 4
+懝 4
+戽 4
+扺 4
+抺 4
+挸 4
+捒 4
+掤 4
+揞 4
+搯 4
+搹 4
+摅 4
+摓 4
+撖 4
+攨 4
+敁 4
+旉 4
+旼 4
+昐 4
+昣 4
+暃 4
+暆 4
+暩 4
+曺 4
+曻 4
+朂 4
+朒 4
+朣 4
+朸 4
+杘 4
+杹 4
+枻 4
+柁 4
+桱 4
+梏 4
+梤 4
+棳 4
+椚 4
+椩 4
+楐 4
+楒 4
+榀 4
+榤 4
+樚 4
+樫 4
+橏 4
+橑 4
+橜 4
+橪 4
+櫀 4
+櫶 4
+欉 4
+欔 4
+欻 4
+歀 4
+歂 4
+殪 4
+殬 4
+毌 4
+毪 4
+氍 4
+沨 4
+沵 4
+洅 4
+洫 4
+淉 4
+淛 4
+渮 4
+湦 4
+漑 4
+漴 4
+潆 4
+潏 4
+潱 4
+澊 4
+灚 4
+炈 4
+烼 4
+焋 4
+焌 4
+焑 4
+煀 4
+熥 4
+牞 4
+獆 4
+獝 4
+獡 4
+獦 4
+玙 4
+珎 4
+珓 4
+珘 4
+琘 4
+璊 4
+璥 4
+璵 4
+瓉 4
+瓐 4
+甒 4
+畭 4
+疸 4
+痤 4
+瘙 4
+瘚 4
+瘳 4
+癁 4
+癔 4
+癝 4
+皹 4
+眔 4
+睄 4
+睥 4
+硠 4
+碡 4
+磖 4
+磝 4
+礓 4
+礲 4
+祔 4
+禊 4
+禌 4
+秭 4
+穉 4
+笭 4
+筚 4
+筼 4
+箦 4
+箾 4
+糈 4
+糨 4
+紣 4
+綒 4
+繜 4
+翈 4
+翛 4
+耱 4
+耵 4
+脋 4
+脎 4
+脬 4
+腗 4
+膌 4
+膒 4
+苰 4
+荏 4
+莜 4
+莣 4
+葀 4
+葇 4
+蒉 4
+蒷 4
+蒾 4
+蔹 4
+蜎 4
+螲 4
+蟑 4
+蠠 4
+衎 4
+衕 4
+衠 4
+袾 4
+褛 4
+褭 4
+襀 4
+覩 4
+訑 4
+豀 4
+豌 4
+貇 4
+蹊 4
+躔 4
+軎 4
+迺 4
+鄅 4
+鄡 4
+酻 4
+醎 4
+鈶 4
+鉏 4
+銙 4
+錬 4
+鎓 4
+鑐 4
+钶 4
+铞 4
+镘 4
+閚 4
+阠 4
+阼 4
+陯 4
+隭 4
+雟 4
+霤 4
+霩 4
+靊 4
+韈 4
+顸 4
+馿 4
+騕 4
+骐 4
+骣 4
+髙 4
+鬐 4
+鬰 4
+鯾 4
+鰶 4
+鱥 4
+鱽 4
+鲊 4
+鳷 4
+鵩 4
+鹐 4
+鹢 4
+麣 4
+黩 4
+丬 3
+乵 3
+亽 3
+仳 3
+伻 3
+佮 3
+侜 3
+侲 3
+侸 3
+侹 3
+倴 3
+偊 3
+偙 3
+偮 3
+傽 3
+僄 3
+僌 3
+僝 3
+僢 3
+僳 3
+僽 3
+儡 3
+儣 3
+冃 3
+冇 3
+冐 3
+冫 3
+凒 3
+凞 3
+刡 3
+剹 3
+勠 3
+匂 3
+卐 3
+厗 3
+吅 3
+吜 3
+呟 3
+呾 3
+咍 3
+咾 3
+唙 3
+唢 3
+唫 3
+唶 3
+唽 3
+啙 3
+喓 3
+喤 3
+嘦 3
+噇 3
+噉 3
+噿 3
+圌 3
+圧 3
+坋 3
+坥 3
+垔 3
+垘 3
+埏 3
+埐 3
+埸 3
+堎 3
+増 3
+墶 3
+妬 3
+妴 3
+妺 3
+娖 3
+嫅 3
+嫞 3
+寎 3
+尡 3
+尳 3
+屄 3
+屇 3
+屘 3
+屟 3
+岶 3
+岺 3
+峂 3
+峖 3
+峜 3
+峩 3
+峼 3
+崘 3
+嵓 3
+嵨 3
+嵹 3
+嵻 3
+嵽 3
+嶀 3
+嶟 3
+帞 3
+帡 3
+帲 3
+幊 3
+庅 3
+廗 3
+廸 3
+弎 3
+彯 3
+彽 3
+忁 3
+忮 3
+忲 3
+忼 3
+怞 3
+怟 3
+怢 3
+怲 3
+怳 3
+恔 3
+恠 3
+恴 3
+悁 3
+悪 3
+悿 3
+惎 3
+惐 3
+惙 3
+愢 3
+愻 3
+愽 3
+慥 3
+憰 3
+懓 3
+戤 3
+抅 3
+抆 3
+抇 3
+抾 3
+拞 3
+挬 3
+挷 3
+掎 3
+揳 3
+搋 3
+搣 3
+搳 3
+搼 3
+撗 3
+敂 3
+旛 3
+旳 3
+旹 3
+昸 3
+晇 3
+晈 3
+晛 3
+晹 3
+暬 3
+暿 3
+曍 3
+曓 3
+朻 3
+杇 3
+杔 3
+杢 3
+枴 3
+柌 3
+柍 3
+柮 3
+栄 3
+栘 3
+栰 3
+桎 3
+桟 3
+桹 3
+梊 3
+梪 3
+梼 3
+棊 3
+棑 3
+棛 3
+棡 3
+棸 3
+椔 3
+椙 3
+椛 3
+検 3
+椞 3
+椸 3
+楆 3
+楩 3
+楰 3
+楽 3
+榅 3
+榑 3
+榼 3
+槚 3
+槴 3
+槶 3
+樇 3
+樏 3
+橁 3
+橆 3
+橔 3
+橞 3
+橳 3
+檘 3
+櫐 3
+櫖 3
+欋 3
+欸 3
+歃 3
+歠 3
+歩 3
+歭 3
+歰 3
+殔 3
+殠 3
+殻 3
+毘 3
+毹 3
+氜 3
+氿 3
+汈 3
+汋 3
+汳 3
+沑 3
+沘 3
+泇 3
+泝 3
+泩 3
+泴 3
+洉 3
+洎 3
+洘 3
+洯 3
+浥 3
+浰 3
+淏 3
+淾 3
+渟 3
+湑 3
+湬 3
+溱 3
+漮 3
+澣 3
+瀣 3
+瀭 3
+灔 3
+灛 3
+灥 3
+烖 3
+烗 3
+烱 3
+焧 3
+煟 3
+煯 3
+煻 3
+熖 3
+熠 3
+熽 3
+燝 3
+爌 3
+犄 3
+犨 3
+狔 3
+狤 3
+狴 3
+猡 3
+獕 3
+玆 3
+玈 3
+玟 3
+珏 3
+珡 3
+珴 3
+珹 3
+琡 3
+琣 3
+琲 3
+瑔 3
+瑝 3
+瑱 3
+璎 3
+璡 3
+瓭 3
+甇 3
+甡 3
+畷 3
+疬 3
+疭 3
+疶 3
+痼 3
+瘅 3
+瘩 3
+癀 3
+癊 3
+癙 3
+皩 3
+盨 3
+盬 3
+眛 3
+睖 3
+睚 3
+瞍 3
+瞡 3
+瞢 3
+矘 3
+矟 3
+砪 3
+砮 3
+硋 3
+硔 3
+硿 3
+祰 3
+祳 3
+秏 3
+稊 3
+稒 3
+稞 3
+窎 3
+笧 3
+筈 3
+筎 3
+篌 3
+篝 3
+簖 3
+簙 3
+籕 3
+粻 3
+糌 3
+紴 3
+絋 3
+絍 3
+絧 3
+緐 3
+緜 3
+緪 3
+縌 3
+繐 3
+羛 3
+耣 3
+聢 3
+肞 3
+肸 3
+脌 3
+膅 3
+臌 3
+臮 3
+芺 3
+苭 3
+苽 3
+茇 3
+茼 3
+萣 3
+萩 3
+葎 3
+葖 3
+葫 3
+葴 3
+蒟 3
+蒻 3
+蓏 3
+薢 3
+蘝 3
+蘠 3
+蜉 3
+蝈 3
+蝤 3
+螵 3
+蟕 3
+蟚 3
+蟜 3
+蟷 3
+蠴 3
+袝 3
+裩 3
+褓 3
+襌 3
+覤 3
+覰 3
+訄 3
+詅 3
+謃 3
+讬 3
+谫 3
+豇 3
+豝 3
+赻 3
+趔 3
+跂 3
+踟 3
+躖 3
+軂 3
+軠 3
+轱 3
+迆 3
+迋 3
+酕 3
+酖 3
+酾 3
+醍 3
+鉷 3
+銾 3
+鋘 3
+鋚 3
+鍉 3
+鎌 3
+鎎 3
+鐄 3
+镡 3
+镵 3
+閺 3
+阛 3
+雘 3
+雡 3
+雼 3
+霪 3
+霫 3
+鞓 3
+韥 3
+頵 3
+顊 3
+飊 3
+飐 3
+駮 3
+騑 3
+骖 3
+髽 3
+魣 3
+鮌 3
+鮻 3
+鯈 3
+鰛 3
+鲡 3
+鲪 3
+鴏 3
+鴐 3
+鷋 3
+鷭 3
+鹀 3
+鹖 3
+黵 3
+齛 3
+齸 3
+丂 2
+丆 2
+丒 2
+乀 2
+乹 2
+乻 2
+亗 2
+仌 2
+伨 2
+伷 2
+伹 2
+佖 2
+侌 2
+侓 2
+価 2
+俓 2
+俕 2
+俻 2
+倁 2
+倰 2
+倱 2
+倵 2
+偘 2
+偞 2
+偭 2
+偱 2
+傄 2
+傇 2
+傐 2
+傤 2
+傪 2
+僘 2
+僺 2
+儍 2
+儢 2
+儧 2
+冞 2
+冡 2
+凔 2
+凘 2
+凨 2
+刬 2
+剞 2
+劂 2
+劦 2
+劵 2
+劸 2
+匴 2
+卌 2
+卩 2
+厐 2
+叅 2
+呑 2
+呠 2
+咅 2
+咇 2
+咥 2
+咶 2
+哃 2
+哖 2
+哚 2
+唖 2
+啂 2
+啎 2
+啱 2
+喛 2
+嗂 2
+嘷 2
+嘼 2
+噑 2
+噵 2
+嚖 2
+嚗 2
+嚱 2
+囏 2
+囗 2
+囝 2
+囫 2
+囵 2
+囹 2
+圊 2
+圥 2
+坒 2
+坔 2
+坕 2
+坘 2
+坮 2
+垀 2
+垑 2
+垞 2
+垼 2
+埤 2
+埪 2
+埬 2
+埱 2
+埴 2
+埼 2
+堌 2
+堐 2
+堨 2
+堼 2
+塓 2
+塶 2
+塷 2
+墏 2
+墚 2
+壀 2
+壖 2
+夁 2
+夬 2
+夶 2
+奌 2
+妅 2
+妎 2
+姯 2
+姺 2
+娙 2
+娭 2
+娽 2
+婌 2
+婒 2
+婰 2
+婻 2
+媜 2
+媦 2
+媭 2
+媶 2
+嫰 2
+嫸 2
+嫼 2
+嬠 2
+嬢 2
+孆 2
+孊 2
+実 2
+宩 2
+寠 2
+寱 2
+尛 2
+尣 2
+尩 2
+尭 2
+尵 2
+屝 2
+岅 2
+岴 2
+峍 2
+峎 2
+峟 2
+崨 2
+崹 2
+嵅 2
+嵗 2
+嵜 2
+嵾 2
+嶖 2
+嶞 2
+嶲 2
+巛 2
+帗 2
+帠 2
+帨 2
+帯 2
+幎 2
+幑 2
+庉 2
+庼 2
+廋 2
+廙 2
+廭 2
+廽 2
+弌 2
+弍 2
+弨 2
+弪 2
+彵 2
+従 2
+徝 2
+忇 2
+忣 2
+忶 2
+忷 2
+忹 2
+怊 2
+怭 2
+怶 2
+怹 2
+恄 2
+恉 2
+恞 2
+恦 2
+悮 2
+惏 2
+惪 2
+惸 2
+愃 2
+愇 2
+愖 2
+愙 2
+愝 2
+愲 2
+慀 2
+慅 2
+慆 2
+慜 2
+慱 2
+憦 2
+憻 2
+懫 2
+懭 2
+懱 2
+戉 2
+扞 2
+扥 2
+抃 2
+拝 2
+拲 2
+拵 2
+挏 2
+挮 2
+掆 2
+揠 2
+揯 2
+搊 2
+搕 2
+摙 2
+摲 2
+攠 2
+攦 2
+攭 2
+攴 2
+敀 2
+敚 2
+敨 2
+斠 2
+旡 2
+昈 2
+昛 2
+昿 2
+晅 2
+晪 2
+晻 2
+晿 2
+暋 2
+暵 2
+曁 2
+曎 2
+曤 2
+曫 2
+曮 2
+朚 2
+朲 2
+杋 2
+杫 2
+杮 2
+枖 2
+枦 2
+枺 2
+枿 2
+柀 2
+柋 2
+柒 2
+柸 2
+栒 2
+栔 2
+栕 2
+栛 2
+栳 2
+栴 2
+栶 2
+栿 2
+桄 2
+桯 2
+桰 2
+桼 2
+桽 2
+梎 2
+梙 2
+梜 2
+梠 2
+梫 2
+棏 2
+椄 2
+椊 2
+椖 2
+椗 2
+椝 2
+椡 2
+椥 2
+椧 2
+椫 2
+椺 2
+楇 2
+楏 2
+楟 2
+楬 2
+楱 2
+榗 2
+榣 2
+榬 2
+榳 2
+槞 2
+槥 2
+槩 2
+槷 2
+槹 2
+樄 2
+橮 2
+橾 2
+檅 2
+櫅 2
+櫹 2
+欆 2
+歘 2
+歳 2
+殝 2
+毝 2
+毤 2
+氇 2
+氼 2
+汸 2
+汿 2
+沋 2
+沜 2
+沞 2
+沰 2
+泋 2
+泑 2
+泞 2
+泬 2
+洂 2
+洍 2
+洡 2
+洤 2
+洭 2
+浬 2
+涽 2
+渞 2
+渳 2
+渹 2
+湈 2
+湐 2
+湠 2
+湢 2
+湥 2
+湸 2
+溎 2
+滙 2
+漤 2
+漭 2
+漶 2
+漼 2
+潋 2
+潠 2
+潳 2
+澏 2
+澖 2
+澛 2
+澥 2
+澴 2
+濸 2
+灂 2
+灍 2
+灎 2
+灟 2
+炁 2
+焫 2
+焸 2
+焹 2
+煏 2
+煾 2
+熋 2
+熚 2
+熧 2
+燪 2
+燺 2
+爇 2
+爊 2
+爧 2
+爼 2
+牦 2
+牮 2
+犎 2
+犛 2
+狒 2
+狯 2
+猄 2
+獚 2
+獜 2
+玗 2
+玚 2
+玞 2
+玪 2
+珉 2
+珧 2
+琗 2
+瑍 2
+瑑 2
+瑺 2
+瓄 2
+瓛 2
+瓪 2
+瓫 2
+甃 2
+畊 2
+疀 2
+疎 2
+痐 2
+痚 2
+痬 2
+瘵 2
+癑 2
+癗 2
+癨 2
+癶 2
+癹 2
+癿 2
+皽 2
+盫 2
+盻 2
+眓 2
+睱 2
+瞆 2
+瞦 2
+瞵 2
+矁 2
+矑 2
+矴 2
+矻 2
+矼 2
+砵 2
+硞 2
+硧 2
+碞 2
+碹 2
+磲 2
+磹 2
+礝 2
+礴 2
+祋 2
+祒 2
+祣 2
+祪 2
+稌 2
+稧 2
+穋 2
+穜 2
+窢 2
+窻 2
+竃 2
+竘 2
+竝 2
+竸 2
+竼 2
+笜 2
+筊 2
+筳 2
+箲 2
+篂 2
+篥 2
+篲 2
+篴 2
+簚 2
+籆 2
+籇 2
+粔 2
+糵 2
+紁 2
+絏 2
+絩 2
+絪 2
+絯 2
+绤 2
+缊 2
+缲 2
+缳 2
+缼 2
+罉 2
+罝 2
+羓 2
+羱 2
+羳 2
+翃 2
+翉 2
+翬 2
+耠 2
+聍 2
+胠 2
+胼 2
+胾 2
+脧 2
+腂 2
+膆 2
+膙 2
+膴 2
+臡 2
+舲 2
+艍 2
+芣 2
+苖 2
+苢 2
+茈 2
+茝 2
+荈 2
+荙 2
+荬 2
+莙 2
+莝 2
+莴 2
+菂 2
+菋 2
+菍 2
+菼 2
+葸 2
+葹 2
+蒍 2
+蒖 2
+蒢 2
+蒨 2
+蒩 2
+蒭 2
+蓂 2
+蓎 2
+蓣 2
+蔨 2
+薥 2
+薫 2
+薸 2
+蘍 2
+蘨 2
+虀 2
+虠 2
+蚖 2
+蚢 2
+蛪 2
+蜛 2
+蜣 2
+蜴 2
+蜺 2
+蜾 2
+蝣 2
+蝱 2
+蝴 2
+螘 2
+螱 2
+衦 2
+袊 2
+袪 2
+袲 2
+裚 2
+裿 2
+褀 2
+觛 2
+觝 2
+觬 2
+訉 2
+訫 2
+謒 2
+謦 2
+謩 2
+譔 2
+譩 2
+譭 2
+谹 2
+谻 2
+貅 2
+貒 2
+賛 2
+贌 2
+赥 2
+赾 2
+趷 2
+跩 2
+蹁 2
+蹅 2
+蹏 2
+蹫 2
+蹯 2
+蹱 2
+躅 2
+躱 2
+轑 2
+轳 2
+轹 2
+辬 2
+迠 2
+迻 2
+逧 2
+邂 2
+邆 2
+郞 2
+鄼 2
+酴 2
+醈 2
+釈 2
+釚 2
+釿 2
+鈛 2
+鈤 2
+鉧 2
+銊 2
+銿 2
+鋬 2
+錻 2
+鍱 2
+鎒 2
+鎞 2
+鏱 2
+铇 2
+镋 2
+镔 2
+閜 2
+閦 2
+阓 2
+阽 2
+陁 2
+陴 2
+隓 2
+雱 2
+霅 2
+霈 2
+霿 2
+鞁 2
+鞞 2
+鞦 2
+鞧 2
+韽 2
+顃 2
+顠 2
+颣 2
+飤 2
+饤 2
+饸 2
+駃 2
+駉 2
+駤 2
+駬 2
+騃 2
+騊 2
+騢 2
+驲 2
+骍 2
+髃 2
+髝 2
+髬 2
+髳 2
+鬬 2
+鮨 2
+鯺 2
+鲙 2
+鲰 2
+鳋 2
+鳣 2
+鴪 2
+鴭 2
+鵏 2
+鷃 2
+鷉 2
+鷟 2
+鸊 2
+鹍 2
+鹥 2
+麅 2
+麑 2
+麖 2
+鼲 2
+鼸 2
+齉 2
+齌 2
+`
@@ -0,0 +1,132 @@
+//go:build ignore
+// +build ignore
+
+// This program generates simhash_dictionary_data.go, run it with
+//
+//	go run simhash_dictionary_data_gen.go -dict path/to/dictionary.txt
+//
+// The dictionary is the one of jieba (https://github.com/fxsjy/jieba, MIT license), as shipped
+// in data/dictionary.txt of github.com/huichen/sego@v0.0.0-20180617034105-3f3c8a8cfacc, one
+// "word frequency [part of speech]" entry per line. Only words made of characters of the CJK
+// Unified Ideographs and Extension A blocks are kept, without the traditional characters of
+// simhash_t2s_table.go, which text is converted from before segmenting. The 60000 most frequent
+// of them and all single characters are embedded, by decreasing frequency.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const _TopWords = 60000
+
+// traditional returns the keys of _TraditionalToSimplified.
+func traditional() map[rune]bool {
+	f, err := parser.ParseFile(token.NewFileSet(), "simhash_t2s_table.go", nil, 0)
+	if err != nil {
+		panic(err)
+	}
+	keys := make(map[rune]bool)
+	ast.Inspect(f, func(n ast.Node) bool {
+		kv, ok := n.(*ast.KeyValueExpr)
+		if !ok {
+			return true
+		}
+		s, err := strconv.Unquote(kv.Key.(*ast.BasicLit).Value)
+		if err != nil {
+			panic(err)
+		}
+		keys[[]rune(s)[0]] = true
+		return false
+	})
+	return keys
+}
+
+func simplified(word string, traditional map[rune]bool) bool {
+	for _, r := range word {
+		if r < 0x3400 || r > 0x9fff || traditional[r] {
+			return false
+		}
+	}
+	return true
+}
+
+type entry struct {
+	word string
+	freq int
+}
+
+func main() {
+	dict := flag.String("dict", "", "jieba dictionary file")
+	flag.Parse()
+	if *dict == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	f, err := os.Open(*dict)
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+
+	t := traditional()
+	freqs := make(map[string]int)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || !simplified(fields[0], t) {
+			continue
+		}
+		freq, err := strconv.Atoi(fields[1])
+		if err != nil {
+			panic(fmt.Sprintf("invalid frequency %q", fields[1]))
+		}
+		// the dictionary has a few duplicated words.
+		if freq > freqs[fields[0]] {
+			freqs[fields[0]] = freq
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		panic(err)
+	}
+
+	entries := make([]entry, 0, len(freqs))
+	for w, freq := range freqs {
+		entries = append(entries, entry{w, freq})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].freq != entries[j].freq {
+			return entries[i].freq > entries[j].freq
+		}
+		return entries[i].word < entries[j].word
+	})
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by simhash_dictionary_data_gen.go from the dictionary of jieba; DO NOT EDIT.\n\n")
+	buf.WriteString("package simhash\n\n")
+	buf.WriteString("// The words and frequencies of _DefaultChineseDictionary come from the dictionary of jieba\n")
+	buf.WriteString("// (https://github.com/fxsjy/jieba), Copyright (c) 2013 Sun Junyi, released under the MIT\n")
+	buf.WriteString("// license, see the NOTICE file.\n\n")
+	buf.WriteString("// _DefaultChineseDictionary holds one \"word frequency\" entry per line.\n")
+	buf.WriteString("const _DefaultChineseDictionary = `")
+	for i, e := range entries {
+		if i < _TopWords || len([]rune(e.word)) == 1 {
+			fmt.Fprintf(&buf, "%s %d\n", e.word, e.freq)
+		}
+	}
+	buf.WriteString("`\n")
+
+	if err := ioutil.WriteFile("simhash_dictionary_data.go", buf.Bytes(), 0644); err != nil {
+		panic(err)
+	}
+}
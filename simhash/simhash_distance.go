@@ -0,0 +1,121 @@
+package simhash
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+	"sort"
+	"strconv"
+)
+
+/*
+	Fingerprints are compared by their Hamming distance, the number of bits they differ on.
+	Two texts are near-duplicates when their fingerprints are at most 3 bits apart, the
+	threshold of IsEqual, as proposed by "Detecting Near-Duplicates for Web Crawling".
+
+	Fingerprints can be stored as text in 3 encodings, all keeping the most significant bit
+	first:
+
+	1. binary, 64 '0' and '1' digits, see FingerprintToString and ParseFingerprint.
+	2. hexadecimal, 16 lower-case digits, see FingerprintToHex and ParseFingerprintHex.
+	3. base64, 11 characters of the unpadded URL-safe alphabet of RFC 4648 encoding the
+	   8 big-endian bytes, see FingerprintToBase64 and ParseFingerprintBase64.
+*/
+
+// Distance returns the Hamming distance between fingerprints a and b.
+func Distance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
+
+// Similarity returns the fraction of the bits a and b have in common, in [0, 1].
+func Similarity(a, b uint64) float64 {
+	return 1 - float64(Distance(a, b))/64
+}
+
+// Neighbor is a candidate of NearestK.
+type Neighbor struct {
+	// Index is the index of the candidate in the candidates passed to NearestK.
+	Index    int
+	Distance int
+}
+
+// NearestK returns the k candidates closest to query, by increasing distance, candidates at
+// the same distance by increasing index.
+func NearestK(query uint64, candidates []uint64, k int) []Neighbor {
+	if k > len(candidates) {
+		k = len(candidates)
+	}
+	if k <= 0 {
+		return nil
+	}
+
+	// distances only range over [0, 64], find the largest distance kept by counting.
+	distances := make([]uint8, len(candidates))
+	var counts [65]int
+	for i, c := range candidates {
+		d := Distance(query, c)
+		distances[i] = uint8(d)
+		counts[d]++
+	}
+	limit, closer := 0, 0
+	for closer+counts[limit] < k {
+		closer += counts[limit]
+		limit++
+	}
+
+	neighbors := make([]Neighbor, 0, k)
+	atLimit := k - closer
+	for i, d := range distances {
+		if int(d) < limit || int(d) == limit && atLimit > 0 {
+			if int(d) == limit {
+				atLimit--
+			}
+			neighbors = append(neighbors, Neighbor{Index: i, Distance: int(d)})
+		}
+	}
+	sort.SliceStable(neighbors, func(i, j int) bool {
+		return neighbors[i].Distance < neighbors[j].Distance
+	})
+	return neighbors
+}
+
+// ParseFingerprint parses the 64 binary digits of FingerprintToString.
+func ParseFingerprint(s string) (uint64, error) {
+	if len(s) != 64 {
+		return 0, fmt.Errorf("expected 64 binary digits, got %d", len(s))
+	}
+	return strconv.ParseUint(s, 2, 64)
+}
+
+// FingerprintToHex encodes fingerprint as 16 hexadecimal digits.
+func FingerprintToHex(fingerprint uint64) string {
+	return fmt.Sprintf("%016x", fingerprint)
+}
+
+// ParseFingerprintHex parses the 16 hexadecimal digits of FingerprintToHex, in either case.
+func ParseFingerprintHex(s string) (uint64, error) {
+	if len(s) != 16 {
+		return 0, fmt.Errorf("expected 16 hex digits, got %d", len(s))
+	}
+	return strconv.ParseUint(s, 16, 64)
+}
+
+// FingerprintToBase64 encodes fingerprint as 11 base64 characters.
+func FingerprintToBase64(fingerprint uint64) string {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], fingerprint)
+	return base64.RawURLEncoding.EncodeToString(buf[:])
+}
+
+// ParseFingerprintBase64 parses the 11 base64 characters of FingerprintToBase64.
+func ParseFingerprintBase64(s string) (uint64, error) {
+	buf, err := base64.RawURLEncoding.Strict().DecodeString(s)
+	if err != nil {
+		return 0, err
+	}
+	if len(buf) != 8 {
+		return 0, fmt.Errorf("expected 8 bytes, got %d", len(buf))
+	}
+	return binary.BigEndian.Uint64(buf), nil
+}
@@ -0,0 +1,86 @@
+package simhash
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistance(t *testing.T) {
+	assert.Equal(t, 0, Distance(0xdeadbeef, 0xdeadbeef))
+	assert.Equal(t, 64, Distance(0, ^uint64(0)))
+	assert.Equal(t, 3, Distance(0x1, 0x3<<1))
+	assert.Equal(t, 1.0, Similarity(42, 42))
+	assert.Equal(t, 0.0, Similarity(0, ^uint64(0)))
+	assert.Equal(t, 0.75, Similarity(0, 0xffff))
+
+	sh := NewSimHash(ENGLISH, "")
+	assert.True(t, sh.IsEqual(0, 0x7))
+	assert.False(t, sh.IsEqual(0, 0xf))
+	assert.True(t, sh.IsEqual(0, 0xf, 4))
+	assert.True(t, sh.IsEqual(0, ^uint64(0), 64))
+}
+
+func TestNearestK(t *testing.T) {
+	candidates := []uint64{0xff, 0x1, 0x0, 0x3, 0x10, 0x7}
+	assert.Equal(t, []Neighbor{{2, 0}, {1, 1}, {4, 1}, {3, 2}}, NearestK(0, candidates, 4))
+	assert.Equal(t, []Neighbor{{2, 0}, {1, 1}}, NearestK(0, candidates, 2))
+	assert.Len(t, NearestK(0, candidates, 100), len(candidates))
+	assert.Nil(t, NearestK(0, candidates, 0))
+	assert.Nil(t, NearestK(0, nil, 3))
+
+	// same as sorting all candidates.
+	r := rand.New(rand.NewSource(47))
+	candidates = make([]uint64, 1000)
+	for i := range candidates {
+		candidates[i] = r.Uint64() >> uint(r.Intn(64))
+	}
+	all := make([]Neighbor, len(candidates))
+	for i, c := range candidates {
+		all[i] = Neighbor{Index: i, Distance: Distance(0, c)}
+	}
+	sort.SliceStable(all, func(i, j int) bool { return all[i].Distance < all[j].Distance })
+	for _, k := range []int{1, 10, 100, 999} {
+		assert.Equal(t, all[:k], NearestK(0, candidates, k))
+	}
+}
+
+func TestFingerprintEncodings(t *testing.T) {
+	sh := NewSimHash(ENGLISH, "")
+	for _, fp := range []uint64{0, 1, 0x8000000000000000, 0xdeadbeefcafebabe, ^uint64(0)} {
+		s := sh.FingerprintToString(fp)
+		v, err := ParseFingerprint(s)
+		assert.Nil(t, err)
+		assert.Equal(t, fp, v)
+
+		s = FingerprintToHex(fp)
+		assert.Len(t, s, 16)
+		v, err = ParseFingerprintHex(s)
+		assert.Nil(t, err)
+		assert.Equal(t, fp, v)
+
+		s = FingerprintToBase64(fp)
+		assert.Len(t, s, 11)
+		v, err = ParseFingerprintBase64(s)
+		assert.Nil(t, err)
+		assert.Equal(t, fp, v)
+	}
+	assert.Equal(t, "deadbeefcafebabe", FingerprintToHex(0xdeadbeefcafebabe))
+	assert.Equal(t, "3q2-78r-ur4", FingerprintToBase64(0xdeadbeefcafebabe))
+	v, err := ParseFingerprintHex("DEADBEEFCAFEBABE")
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0xdeadbeefcafebabe), v)
+
+	_, err = ParseFingerprint("101")
+	assert.NotNil(t, err)
+	_, err = ParseFingerprint(sh.FingerprintToString(0)[1:] + "2")
+	assert.NotNil(t, err)
+	_, err = ParseFingerprintHex("deadbeefcafebabz")
+	assert.NotNil(t, err)
+	_, err = ParseFingerprintBase64("3q2-78r-ur")
+	assert.NotNil(t, err)
+	_, err = ParseFingerprintBase64("3q2+78r+ur4")
+	assert.NotNil(t, err)
+}
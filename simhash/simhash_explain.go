@@ -0,0 +1,146 @@
+package simhash
+
+import (
+	"sort"
+)
+
+/*
+	Explain computes a fingerprint like Fingerprint does, but keeps everything in between:
+	the features that made it into the fingerprint with their weights and hashes, the ones
+	topN left out, and the weight summed over every bit, whose sign gives the bit.
+
+	Diff compares the explanations of two documents. For every bit on which the fingerprints
+	differ, it lists the features that pushed the bit from its value in the first document to
+	its value in the second one, the most decisive first. The contribution of a feature to a
+	bit is +weight if the bit of its hash is set and -weight otherwise, 0 in a document the
+	feature is missing from.
+*/
+
+// Feature is a token with its weight and hash.
+type Feature struct {
+	Token  string
+	Weight float32
+	Hash   uint64
+}
+
+// contribution returns the signed weight f adds to bit i.
+func (f *Feature) contribution(i uint) float32 {
+	if f.Hash>>i&1 == 1 {
+		return f.Weight
+	}
+	return -f.Weight
+}
+
+// Explanation records how a fingerprint was computed.
+type Explanation struct {
+	// Features are the features the fingerprint is made of, heaviest first.
+	Features []Feature
+	// Dropped are the features left out by topN, heaviest first.
+	Dropped []Feature
+	// Weights[i] is the summed weight of bit i, bit 0 being the least significant one.
+	Weights [64]float32
+	// Fingerprint has every bit whose weight is not negative set.
+	Fingerprint uint64
+}
+
+// Explain computes the fingerprint of text like Fingerprint, and returns how it was computed.
+func (sh *SimHash) Explain(text []byte, topNOpts ...uint32) *Explanation {
+	concordance, language := sh.processTokenize(text)
+	sh.processStopwords(concordance, language)
+	if sh.idf != nil {
+		sh.idf.apply(concordance)
+	}
+	return sh.ExplainWeighted(concordance, topNOpts...)
+}
+
+// ExplainWeighted computes the fingerprint of features like FingerprintWeighted, and returns how it
+// was computed.
+func (sh *SimHash) ExplainWeighted(features map[string]float32, topNOpts ...uint32) *Explanation {
+	e := &Explanation{}
+	e.Features, e.Dropped = selectFeatures(features, topNOpts...)
+	e.Weights = bitWeights(e.Features)
+	e.Fingerprint = weightsToFingerprint(&e.Weights)
+	return e
+}
+
+// BitDiff explains a bit on which two fingerprints differ.
+type BitDiff struct {
+	// Bit is the index of the bit, 0 being the least significant one.
+	Bit uint
+	// LHS and RHS are the weights of the bit in both documents.
+	LHS, RHS float32
+	// Drivers are the features which pushed the bit towards its value in the second document,
+	// the one pushing the most first.
+	Drivers []Contribution
+}
+
+// Contribution is the signed weight a feature adds to a bit in both documents, 0 in a document
+// without the feature.
+type Contribution struct {
+	Token    string
+	LHS, RHS float32
+}
+
+// push returns how much c pushes a bit towards being set in the second document.
+func (c *Contribution) push() float32 {
+	return c.RHS - c.LHS
+}
+
+// Diff explains every bit on which the fingerprints of e and other differ, in increasing order.
+func (e *Explanation) Diff(other *Explanation) []BitDiff {
+	differing := e.Fingerprint ^ other.Fingerprint
+	if differing == 0 {
+		return nil
+	}
+
+	lhs := make(map[string]*Feature, len(e.Features))
+	for i := range e.Features {
+		lhs[e.Features[i].Token] = &e.Features[i]
+	}
+	rhs := make(map[string]*Feature, len(other.Features))
+	for i := range other.Features {
+		rhs[other.Features[i].Token] = &other.Features[i]
+	}
+
+	var diffs []BitDiff
+	for i := uint(0); i < 64; i++ {
+		if differing>>i&1 == 0 {
+			continue
+		}
+		d := BitDiff{Bit: i, LHS: e.Weights[i], RHS: other.Weights[i]}
+		// set reports whether the bit is set in the second document, and so clear in the first one.
+		set := other.Fingerprint>>i&1 == 1
+		add := func(token string) {
+			c := Contribution{Token: token}
+			if f, ok := lhs[token]; ok {
+				c.LHS = f.contribution(i)
+			}
+			if f, ok := rhs[token]; ok {
+				c.RHS = f.contribution(i)
+			}
+			if p := c.push(); p > 0 && set || p < 0 && !set {
+				d.Drivers = append(d.Drivers, c)
+			}
+		}
+		for token := range lhs {
+			add(token)
+		}
+		for token := range rhs {
+			if _, ok := lhs[token]; !ok {
+				add(token)
+			}
+		}
+		sort.Slice(d.Drivers, func(a, b int) bool {
+			pa, pb := d.Drivers[a].push(), d.Drivers[b].push()
+			if !set {
+				pa, pb = -pa, -pb
+			}
+			if pa != pb {
+				return pa > pb
+			}
+			return d.Drivers[a].Token < d.Drivers[b].Token
+		})
+		diffs = append(diffs, d)
+	}
+	return diffs
+}
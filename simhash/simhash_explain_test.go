@@ -0,0 +1,80 @@
+package simhash
+
+import (
+	"math/bits"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/amazingchow/photon-dance-bigdata-toolkit/hash"
+)
+
+func TestExplainWeighted(t *testing.T) {
+	sh := NewSimHash(ENGLISH, "")
+	features := map[string]float32{"alpha": 4, "beta": 3, "gamma": 2, "delta": 1, "epsilon": 5}
+
+	e := sh.ExplainWeighted(features)
+	assert.Equal(t, sh.FingerprintWeighted(features), e.Fingerprint)
+	assert.Empty(t, e.Dropped)
+	tokens := make([]string, 0, len(e.Features))
+	for _, f := range e.Features {
+		assert.Equal(t, features[f.Token], f.Weight)
+		assert.Equal(t, hash.FNV1A64(f.Token), f.Hash)
+		tokens = append(tokens, f.Token)
+	}
+	assert.Equal(t, []string{"epsilon", "alpha", "beta", "gamma", "delta"}, tokens)
+	for i := uint(0); i < 64; i++ {
+		var w float32
+		for token, weight := range features {
+			if hash.FNV1A64(token)>>i&1 == 1 {
+				w += weight
+			} else {
+				w -= weight
+			}
+		}
+		assert.Equal(t, w, e.Weights[i], "bit %d", i)
+		assert.Equal(t, w >= 0, e.Fingerprint>>i&1 == 1, "bit %d", i)
+	}
+
+	e = sh.ExplainWeighted(features, 2)
+	assert.Equal(t, sh.FingerprintWeighted(features, 2), e.Fingerprint)
+	assert.Len(t, e.Features, 2)
+	assert.Equal(t, []Feature{
+		{Token: "beta", Weight: 3, Hash: hash.FNV1A64("beta")},
+		{Token: "gamma", Weight: 2, Hash: hash.FNV1A64("gamma")},
+		{Token: "delta", Weight: 1, Hash: hash.FNV1A64("delta")},
+	}, e.Dropped)
+}
+
+func TestExplain(t *testing.T) {
+	sh := NewSimHash(ENGLISH, "")
+	text := []byte("quick brown fox jumps")
+	e := sh.Explain(text)
+	assert.Equal(t, sh.Fingerprint(text), e.Fingerprint)
+	assert.Len(t, e.Features, 4)
+	for _, f := range e.Features {
+		assert.Equal(t, float32(0.25), f.Weight)
+	}
+}
+
+func TestExplanationDiff(t *testing.T) {
+	sh := NewSimHash(ENGLISH, "")
+	lhs := sh.ExplainWeighted(map[string]float32{"alpha": 1, "beta": 1, "gamma": 1})
+	rhs := sh.ExplainWeighted(map[string]float32{"alpha": 1, "beta": 1, "delta": 1})
+	assert.Nil(t, lhs.Diff(lhs))
+
+	diffs := lhs.Diff(rhs)
+	assert.NotEmpty(t, diffs)
+	assert.Equal(t, bits.OnesCount64(lhs.Fingerprint^rhs.Fingerprint), len(diffs))
+	for _, d := range diffs {
+		assert.Equal(t, lhs.Weights[d.Bit], d.LHS)
+		assert.Equal(t, rhs.Weights[d.Bit], d.RHS)
+		assert.NotEmpty(t, d.Drivers, "bit %d", d.Bit)
+		set := rhs.Fingerprint>>d.Bit&1 == 1
+		for _, c := range d.Drivers {
+			// only the features present in one document can flip a bit.
+			assert.Contains(t, []string{"gamma", "delta"}, c.Token)
+			assert.Equal(t, set, c.RHS > c.LHS)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package simhash
+
+import (
+	"bytes"
+	"io/ioutil"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+	Golden vectors of AlgorithmVersion 1. If any of them changes, the fingerprints stored by
+	users change too: bump AlgorithmVersion and update the vectors together.
+*/
+
+func readFixture(t *testing.T, name string) []byte {
+	text, err := ioutil.ReadFile("./fixtures/" + name)
+	assert.Nil(t, err)
+	return text
+}
+
+func TestGoldenVectors(t *testing.T) {
+	assert.Equal(t, 1, AlgorithmVersion)
+
+	en := NewSimHash(ENGLISH, "")
+	english1, english2 := readFixture(t, "english1.txt"), readFixture(t, "english2.txt")
+	assert.Equal(t, "0b62754d143acf3e", FingerprintToHex(en.Fingerprint(english1)))
+	assert.Equal(t, "29f2767d1e3a6b32", FingerprintToHex(en.Fingerprint(english1, 5)))
+	assert.Equal(t, "ccb7e6c124918062", FingerprintToHex(en.Fingerprint(english2)))
+	// all weights are equal, topN keeps alpha, beta and delta.
+	assert.Equal(t, "520765bb854720a3", FingerprintToHex(en.Fingerprint([]byte("alpha beta gamma delta epsilon zeta eta theta"), 3)))
+	assert.Equal(t, en.FingerprintWeighted(map[string]float32{"alpha": 1, "beta": 1, "delta": 1}),
+		en.Fingerprint([]byte("alpha beta gamma delta epsilon zeta eta theta"), 3))
+	wide, err := en.FingerprintWide(english1, Width128)
+	assert.Nil(t, err)
+	assert.Equal(t, "38473c7f0846ac8fbd63be60c858a97b", wide.String())
+	wide, err = en.FingerprintWide(english1, Width256, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, "396e3cbd0b474aabbc637e644848b4bb1459f5474e4e068ad68941909d2bb275", wide.String())
+	fp, err := en.FingerprintReader(bytes.NewReader(english1))
+	assert.Nil(t, err)
+	assert.Equal(t, "0b62754d143acf3e", FingerprintToHex(fp))
+
+	en.SetNormalization(NormalizeAll)
+	assert.Equal(t, "ca371b1cbc74adcf", FingerprintToHex(en.Fingerprint([]byte(`<p>The <b>ＱＵＩＣＫ</b> brown foxes jumped over the lazy dogs &amp; cats</p>`))))
+	en = NewSimHash(ENGLISH, "")
+	en.SetIDF(en.BuildIDF([][]byte{english1, english2}))
+	assert.Equal(t, "6d9dd6c5b4998c68", FingerprintToHex(en.Fingerprint(english2, 10)))
+
+	chinese1, chinese2 := readFixture(t, "chinese1.txt"), readFixture(t, "chinese2.txt")
+	assert.Equal(t, "3b714f1fe2a48467", FingerprintToHex(NewSimHash(CHINESE, "").Fingerprint(chinese1)))
+	assert.Equal(t, "3fe0871b66a5c064", FingerprintToHex(NewSimHash(CHINESE, "").Fingerprint(chinese2)))
+	assert.Equal(t, "3b71cf1fc3e48567", FingerprintToHex(NewSimHash(CHINESE, "./fixtures/dictionary.txt").Fingerprint(chinese1)))
+	assert.Equal(t, "3fe0871b66a5c064", FingerprintToHex(NewSimHash(AUTO, "").Fingerprint(chinese2)))
+	assert.Equal(t, "67358c77bf2a284e", FingerprintToHex(NewSimHash(JAPANESE, "").Fingerprint([]byte("東京都は今日、新しい交通計画を正式に発表しました。"))))
+	assert.Equal(t, "2e6b802176d99ad6", FingerprintToHex(NewSimHash(KOREAN, "").Fingerprint([]byte("서울시는 오늘 새로운 교통 계획을 발표했다."))))
+	assert.Equal(t, "0f9f5711762983e0", FingerprintToHex(NewSimHash(MIXED, "").Fingerprint([]byte("苹果今天发布了iPhone 15 Pro Max，售价7999元。"))))
+}
+
+func TestConcurrentFingerprint(t *testing.T) {
+	texts := [][]byte{
+		readFixture(t, "english1.txt"), readFixture(t, "english2.txt"),
+		readFixture(t, "chinese1.txt"), readFixture(t, "chinese2.txt"),
+	}
+	sh := NewSimHash(MIXED, "")
+	sh.SetNormalization(NormalizeAll)
+	sh.SetIDF(sh.BuildIDF(texts))
+	expected := make([]uint64, len(texts))
+	for i, text := range texts {
+		expected[i] = sh.Fingerprint(text, 20)
+	}
+
+	// learning sh2's IDF changes its fingerprints, only check that it is safe.
+	sh2 := NewSimHash(MIXED, "")
+	sh2.SetIDF(NewIDF())
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := range texts {
+				j := (i + g) % len(texts)
+				assert.Equal(t, expected[j], sh.Fingerprint(texts[j], 20))
+				assert.Equal(t, expected[j], sh.Explain(texts[j], 20).Fingerprint)
+				_, err := sh.FingerprintWide(texts[j], Width128)
+				assert.Nil(t, err)
+				sh2.Fingerprint(texts[j])
+				sh2.idf.AddDocument(sh2.Tokens(texts[j]))
+			}
+		}(g)
+	}
+	wg.Wait()
+	assert.Equal(t, uint64(8*len(texts)), sh2.idf.Docs())
+}
+
+func TestFingerprintVersioned(t *testing.T) {
+	s := FingerprintToVersioned(0xdeadbeefcafebabe)
+	assert.Equal(t, "v1:deadbeefcafebabe", s)
+	version, fp, err := ParseFingerprintVersioned(s)
+	assert.Nil(t, err)
+	assert.Equal(t, AlgorithmVersion, version)
+	assert.Equal(t, uint64(0xdeadbeefcafebabe), fp)
+
+	version, _, err = ParseFingerprintVersioned("v12:0000000000000001")
+	assert.Nil(t, err)
+	assert.Equal(t, 12, version)
+	for _, s := range []string{"", "deadbeefcafebabe", "1:deadbeefcafebabe", "v:deadbeefcafebabe", "v0:deadbeefcafebabe", "v1:deadbeef"} {
+		_, _, err = ParseFingerprintVersioned(s)
+		assert.NotNil(t, err, s)
+	}
+}
@@ -0,0 +1,149 @@
+package simhash
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+)
+
+/*
+	IDF (inverse document frequency) weights a token by how rare it is across a corpus:
+
+		idf(t) = ln((1 + N) / (1 + df(t))) + 1
+
+	N is the number of documents and df(t) the number of documents containing t. Words
+	which show up in nearly every document end up with a weight close to 1, while rare
+	words, and words never seen, get the highest weights. Once plugged into SimHash with
+	SetIDF, the weight of every token becomes its relative frequency times its idf.
+*/
+
+// More info: https://en.wikipedia.org/wiki/Tf%E2%80%93idf
+
+// IDF counts the document frequency of tokens.
+type IDF struct {
+	mu sync.RWMutex
+
+	docs uint64
+	df   map[string]uint64
+}
+
+// NewIDF creates an empty IDF.
+func NewIDF() *IDF {
+	return &IDF{df: make(map[string]uint64)}
+}
+
+// AddDocument counts the tokens of one document, repeated tokens are counted once.
+func (idf *IDF) AddDocument(tokens []string) {
+	idf.mu.Lock()
+	defer idf.mu.Unlock()
+
+	idf.docs++
+	seen := make(map[string]struct{}, len(tokens))
+	for _, t := range tokens {
+		if _, ok := seen[t]; ok {
+			continue
+		}
+		seen[t] = struct{}{}
+		if _, ok := idf.df[t]; !ok {
+			// t may be a substring of the document, do not keep all of it alive.
+			t = cloneString(t)
+		}
+		idf.df[t]++
+	}
+}
+
+// Docs returns the number of documents counted.
+func (idf *IDF) Docs() uint64 {
+	idf.mu.RLock()
+	defer idf.mu.RUnlock()
+
+	return idf.docs
+}
+
+// Weight returns the idf of token.
+func (idf *IDF) Weight(token string) float32 {
+	idf.mu.RLock()
+	defer idf.mu.RUnlock()
+
+	return idf.weight(token)
+}
+
+func (idf *IDF) weight(token string) float32 {
+	return float32(math.Log(float64(1+idf.docs)/float64(1+idf.df[token])) + 1)
+}
+
+// apply multiplies every weight of concordance by the idf of its token.
+func (idf *IDF) apply(concordance map[string]float32) {
+	idf.mu.RLock()
+	defer idf.mu.RUnlock()
+
+	for k, v := range concordance {
+		concordance[k] = v * idf.weight(k)
+	}
+}
+
+// SerializeIDF serializes idf, tokens are written in sorted order so the output is stable.
+//
+// Layout: docs (uint64, little-endian), number of tokens (uvarint), then for every token
+// its length (uvarint), its bytes and its document frequency (uvarint).
+func SerializeIDF(idf *IDF) []byte {
+	idf.mu.RLock()
+	defer idf.mu.RUnlock()
+
+	tokens := make([]string, 0, len(idf.df))
+	for t := range idf.df {
+		tokens = append(tokens, t)
+	}
+	sort.Strings(tokens)
+
+	buf := make([]byte, 8, 8+binary.MaxVarintLen64)
+	binary.LittleEndian.PutUint64(buf, idf.docs)
+	var tmp [binary.MaxVarintLen64]byte
+	buf = append(buf, tmp[:binary.PutUvarint(tmp[:], uint64(len(tokens)))]...)
+	for _, t := range tokens {
+		buf = append(buf, tmp[:binary.PutUvarint(tmp[:], uint64(len(t)))]...)
+		buf = append(buf, t...)
+		buf = append(buf, tmp[:binary.PutUvarint(tmp[:], idf.df[t])]...)
+	}
+	return buf
+}
+
+// DeserializeIDF deserializes the output of SerializeIDF.
+func DeserializeIDF(bytes []byte) (*IDF, error) {
+	if len(bytes) < 8 {
+		return nil, fmt.Errorf("expected input byte slice to be at least 8 bytes, got %d", len(bytes))
+	}
+	idf := NewIDF()
+	idf.docs = binary.LittleEndian.Uint64(bytes)
+	bytes = bytes[8:]
+
+	n, read := binary.Uvarint(bytes)
+	if read <= 0 {
+		return nil, fmt.Errorf("invalid number of tokens")
+	}
+	bytes = bytes[read:]
+	for i := uint64(0); i < n; i++ {
+		l, read := binary.Uvarint(bytes)
+		if read <= 0 || uint64(len(bytes)-read) < l {
+			return nil, fmt.Errorf("invalid length of token %d", i)
+		}
+		t := string(bytes[read : read+int(l)])
+		bytes = bytes[read+int(l):]
+
+		df, read := binary.Uvarint(bytes)
+		if read <= 0 {
+			return nil, fmt.Errorf("invalid document frequency of token %q", t)
+		}
+		if df > idf.docs {
+			return nil, fmt.Errorf("document frequency %d of token %q exceeds %d documents", df, t, idf.docs)
+		}
+		bytes = bytes[read:]
+		idf.df[t] = df
+	}
+	if len(bytes) != 0 {
+		return nil, fmt.Errorf("unexpected %d trailing bytes", len(bytes))
+	}
+	return idf, nil
+}
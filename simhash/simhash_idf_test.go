@@ -0,0 +1,86 @@
+package simhash
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/amazingchow/photon-dance-bigdata-toolkit/hash"
+)
+
+var _NewsCorpus = [][]byte{
+	[]byte("Breaking news: the central bank raised interest rates today, officials said."),
+	[]byte("Breaking news: the football club signed a new striker today, officials said."),
+	[]byte("Breaking news: storms closed the airport today, officials said."),
+	[]byte("Breaking news: the parliament passed the budget today, officials said."),
+}
+
+func TestIDF(t *testing.T) {
+	sh := NewSimHash(ENGLISH, "")
+	idf := sh.BuildIDF(_NewsCorpus)
+	assert.Equal(t, uint64(4), idf.Docs())
+
+	// "the" is a stopword, it is never counted.
+	assert.Equal(t, idf.Weight("unseen"), idf.Weight("the"))
+	assert.Equal(t, true, idf.Weight("breaking") < idf.Weight("striker"))
+	assert.Equal(t, true, idf.Weight("striker") < idf.Weight("unseen"))
+	assert.InDelta(t, 1.0, idf.Weight("breaking"), 1e-6)
+
+	bytes := SerializeIDF(idf)
+	assert.Equal(t, bytes, SerializeIDF(idf))
+	idf2, err := DeserializeIDF(bytes)
+	assert.Empty(t, err)
+	assert.Equal(t, idf.docs, idf2.docs)
+	assert.Equal(t, idf.df, idf2.df)
+
+	_, err = DeserializeIDF(bytes[:len(bytes)-1])
+	assert.NotEmpty(t, err)
+	_, err = DeserializeIDF(append(bytes, 0))
+	assert.NotEmpty(t, err)
+}
+
+func TestFingerprintWeighted(t *testing.T) {
+	sh := NewSimHash(ENGLISH, "")
+	text := []byte("the quick brown fox jumps over the dog")
+	assert.Equal(t, sh.Fingerprint(text), sh.FingerprintWeighted(map[string]float32{
+		"quick": 1, "brown": 1, "fox": 1, "jumps": 1, "dog": 1,
+	}))
+
+	// a single feature gives its own hash.
+	assert.Equal(t, hash.FNV1A64("a"), sh.FingerprintWeighted(map[string]float32{"a": 1}))
+	assert.Equal(t, sh.FingerprintWeighted(map[string]float32{"a": 3, "b": 1}), sh.FingerprintWeighted(map[string]float32{"a": 1}))
+}
+
+func TestSimHashWithIDF(t *testing.T) {
+	sh := NewSimHash(ENGLISH, "")
+	text := []byte("alpha beta gamma delta zeta")
+	// five tokens of equal weight, every one of them counts.
+	assert.NotEqual(t, hash.FNV1A64("zeta"), sh.Fingerprint(text))
+
+	// the only token never seen in the corpus outweighs all the others together.
+	corpus := make([][]byte, 1000)
+	for i := range corpus {
+		corpus[i] = []byte("alpha beta gamma delta")
+	}
+	sh.SetIDF(sh.BuildIDF(corpus))
+	assert.Equal(t, hash.FNV1A64("zeta"), sh.Fingerprint(text))
+
+	sh.SetIDF(nil)
+	assert.NotEqual(t, hash.FNV1A64("zeta"), sh.Fingerprint(text))
+}
+
+func TestTokensDoNotAliasInput(t *testing.T) {
+	for _, language := range []LanguageType{ENGLISH, CHINESE, JAPANESE, AUTO, MIXED} {
+		sh := NewSimHash(language, "")
+		doc := []byte("alpha beta gamma 国务院召开常务会议")
+		idf := sh.BuildIDF([][]byte{doc})
+		tokens := sh.Tokens(doc)
+		weight := idf.Weight("alpha")
+
+		for i := range doc {
+			doc[i] = 'q'
+		}
+		assert.Equal(t, sh.Tokens([]byte("alpha beta gamma 国务院召开常务会议")), tokens, "language=%d", language)
+		assert.Equal(t, weight, idf.Weight("alpha"), "language=%d", language)
+	}
+}
@@ -0,0 +1,237 @@
+package simhash
+
+import (
+	"math/bits"
+	"sort"
+	"sync"
+)
+
+/*
+	Index finds all fingerprints within Hamming distance k of a query, without comparing
+	the query against every fingerprint, as described in section 3 of
+	"Detecting Near-Duplicates for Web Crawling".
+
+	The 64 bits are partitioned into n blocks (n > k). Two fingerprints which differ in at
+	most k bits must agree exactly on at least n-k of the blocks, so for every choice of
+	n-k blocks out of n there is one table, keyed by the bits of those blocks only (the
+	paper permutes the chosen blocks to the front and sorts by the prefix, keying a hash
+	table by the masked bits is the in-memory equivalent). A query probes each table once
+	and verifies the candidates it finds.
+
+	There are C(n, k) tables. More blocks means more tables and memory, but longer keys
+	and far fewer candidates to verify per table.
+*/
+
+// Index implements the permuted-table near-duplicate index mentioned by
+// "Detecting Near-Duplicates for Web Crawling".
+type Index struct {
+	mu sync.RWMutex
+
+	k      int
+	blocks int
+	masks  []uint64
+	tables []map[uint64][]uint64
+
+	fingerprints map[uint64]uint64
+}
+
+// NewIndex creates an Index able to answer queries up to Hamming distance k (0 <= k < 64), using at most
+// maxTables tables. The 64 bits are partitioned into the largest number of blocks n such that
+// C(n, k) <= maxTables, but at least k+1 blocks, which needs k+1 tables.
+func NewIndex(k int, maxTables int) *Index {
+	if k < 0 {
+		k = 0
+	}
+	if k > 63 {
+		k = 63
+	}
+	n := blockCount(k, maxTables, 64)
+
+	idx := &Index{
+		k:            k,
+		blocks:       n,
+		fingerprints: make(map[uint64]uint64),
+	}
+
+	blockMasks := make([]uint64, n)
+	for i, r := range blockRanges(64, n) {
+		if r.width == 64 {
+			blockMasks[i] = ^uint64(0)
+		} else {
+			blockMasks[i] = ((1 << r.width) - 1) << r.offset
+		}
+	}
+
+	// one table for every choice of n-k blocks.
+	combinations(n, n-k, func(chosen []int) {
+		var mask uint64
+		for _, b := range chosen {
+			mask |= blockMasks[b]
+		}
+		idx.masks = append(idx.masks, mask)
+		idx.tables = append(idx.tables, make(map[uint64][]uint64))
+	})
+	return idx
+}
+
+// K returns the maximum Hamming distance the Index can answer queries for.
+func (idx *Index) K() int {
+	return idx.k
+}
+
+// Tables returns the number of tables of the Index.
+func (idx *Index) Tables() int {
+	return len(idx.tables)
+}
+
+// Len returns the number of fingerprints inside the Index.
+func (idx *Index) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	return len(idx.fingerprints)
+}
+
+// Add adds the fingerprint of document id, replacing its previous fingerprint if any.
+func (idx *Index) Add(id uint64, fingerprint uint64) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if old, ok := idx.fingerprints[id]; ok {
+		if old == fingerprint {
+			return
+		}
+		idx.remove(id, old)
+	}
+	idx.fingerprints[id] = fingerprint
+	for t, mask := range idx.masks {
+		key := fingerprint & mask
+		idx.tables[t][key] = append(idx.tables[t][key], id)
+	}
+}
+
+// Remove removes document id from the Index, it returns false if id is not inside the Index.
+func (idx *Index) Remove(id uint64) bool {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	fingerprint, ok := idx.fingerprints[id]
+	if !ok {
+		return false
+	}
+	delete(idx.fingerprints, id)
+	idx.remove(id, fingerprint)
+	return true
+}
+
+func (idx *Index) remove(id uint64, fingerprint uint64) {
+	for t, mask := range idx.masks {
+		key := fingerprint & mask
+		ids := idx.tables[t][key]
+		for i := range ids {
+			if ids[i] == id {
+				ids[i] = ids[len(ids)-1]
+				ids = ids[:len(ids)-1]
+				break
+			}
+		}
+		if len(ids) == 0 {
+			delete(idx.tables[t], key)
+		} else {
+			idx.tables[t][key] = ids
+		}
+	}
+}
+
+// Query returns the ids of all documents whose fingerprints are within Hamming distance k of
+// fingerprint, in ascending order. k is capped by the k the Index was created with.
+func (idx *Index) Query(fingerprint uint64, k int) []uint64 {
+	if k > idx.k {
+		k = idx.k
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	seen := make(map[uint64]struct{})
+	var ids []uint64
+	for t, mask := range idx.masks {
+		for _, id := range idx.tables[t][fingerprint&mask] {
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+			if bits.OnesCount64(idx.fingerprints[id]^fingerprint) <= k {
+				ids = append(ids, id)
+			}
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// blockCount returns the largest number of blocks n, partitioning width bits, such that
+// C(n, k) <= maxTables, but at least k+1.
+func blockCount(k int, maxTables int, width int) int {
+	n := k + 1
+	for n < width && binomial(n+1, k) <= maxTables {
+		n++
+	}
+	return n
+}
+
+type blockRange struct {
+	offset uint
+	width  uint
+}
+
+// blockRanges partitions width bits into n blocks, the first width%n blocks are one bit wider than the rest.
+func blockRanges(width int, n int) []blockRange {
+	ranges := make([]blockRange, n)
+	offset := uint(0)
+	for i := 0; i < n; i++ {
+		w := uint(width / n)
+		if i < width%n {
+			w++
+		}
+		ranges[i] = blockRange{offset: offset, width: w}
+		offset += w
+	}
+	return ranges
+}
+
+// binomial returns C(n, k), saturated at the max int.
+func binomial(n, k int) int {
+	if k < 0 || k > n {
+		return 0
+	}
+	if k > n-k {
+		k = n - k
+	}
+	const maxInt = int(^uint(0) >> 1)
+	c := 1
+	for i := 1; i <= k; i++ {
+		if c > maxInt/(n-k+i) {
+			return maxInt
+		}
+		c = c * (n - k + i) / i
+	}
+	return c
+}
+
+// combinations calls fn with every k-combination of [0, n) in lexicographic order.
+func combinations(n, k int, fn func(chosen []int)) {
+	chosen := make([]int, k)
+	var walk func(start, depth int)
+	walk = func(start, depth int) {
+		if depth == k {
+			fn(chosen)
+			return
+		}
+		for i := start; i <= n-(k-depth); i++ {
+			chosen[depth] = i
+			walk(i+1, depth+1)
+		}
+	}
+	walk(0, 0)
+}
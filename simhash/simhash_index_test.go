@@ -0,0 +1,84 @@
+package simhash
+
+import (
+	"math/bits"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func flipBits(rnd *rand.Rand, fp uint64, n int) uint64 {
+	for _, i := range rnd.Perm(64)[:n] {
+		fp ^= 1 << uint(i)
+	}
+	return fp
+}
+
+func TestIndexTables(t *testing.T) {
+	// 4 blocks of 16 bits, C(4, 3) = 4 tables.
+	assert.Equal(t, 4, NewIndex(3, 0).Tables())
+	// 6 blocks, C(6, 3) = 20 tables, the layout used by the paper for k = 3.
+	assert.Equal(t, 20, NewIndex(3, 20).Tables())
+	assert.Equal(t, 20, NewIndex(3, 34).Tables())
+	assert.Equal(t, 35, NewIndex(3, 35).Tables())
+	assert.Equal(t, 1, NewIndex(0, 100).Tables())
+}
+
+func TestIndexQuery(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, tables := range []int{0, 20, 56} {
+		idx := NewIndex(3, tables)
+
+		fps := make(map[uint64]uint64)
+		var id uint64
+		for i := 0; i < 2000; i++ {
+			base := rnd.Uint64()
+			fps[id] = base
+			id++
+			// near-duplicates at every distance up to 4.
+			for d := 1; d <= 4; d++ {
+				fps[id] = flipBits(rnd, base, d)
+				id++
+			}
+		}
+		for id, fp := range fps {
+			idx.Add(id, fp)
+		}
+		assert.Equal(t, len(fps), idx.Len())
+
+		for q := uint64(0); q < id; q += 7 {
+			for _, k := range []int{0, 1, 3} {
+				var expected []uint64
+				for id, fp := range fps {
+					if bits.OnesCount64(fp^fps[q]) <= k {
+						expected = append(expected, id)
+					}
+				}
+				sort.Slice(expected, func(i, j int) bool { return expected[i] < expected[j] })
+				assert.Equal(t, expected, idx.Query(fps[q], k))
+			}
+		}
+	}
+}
+
+func TestIndexAddRemove(t *testing.T) {
+	idx := NewIndex(3, 20)
+	idx.Add(1, 0xdeadbeefcafebabe)
+	idx.Add(2, 0xdeadbeefcafebabf)
+	assert.Equal(t, []uint64{1, 2}, idx.Query(0xdeadbeefcafebabe, 3))
+
+	// replacing a fingerprint drops the old one.
+	idx.Add(2, 0x0123456789abcdef)
+	assert.Equal(t, []uint64{1}, idx.Query(0xdeadbeefcafebabe, 3))
+	assert.Equal(t, []uint64{2}, idx.Query(0x0123456789abcdee, 3))
+
+	assert.Equal(t, true, idx.Remove(1))
+	assert.Equal(t, false, idx.Remove(1))
+	assert.Empty(t, idx.Query(0xdeadbeefcafebabe, 3))
+	assert.Equal(t, 1, idx.Len())
+
+	// k is capped by the Index.
+	assert.Empty(t, idx.Query(^uint64(0x0123456789abcdef), 64))
+}
@@ -0,0 +1,126 @@
+package simhash
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+/*
+	DetectLanguage guesses the language of a document from the scripts of its letters. Every
+	letter scores its UTF-8 length, so a Han character weighs about as much as a short English
+	word, and the highest score wins:
+
+	1. Hangul: KOREAN.
+	2. Han, Hiragana and Katakana: JAPANESE if kana make up at least 1/10 of these characters,
+	   Chinese texts hardly ever use any, CHINESE otherwise.
+	3. Any other letters, or no letters at all: ENGLISH.
+
+	scriptRuns splits a document mixing languages into runs of one script instead: Hangul
+	runs are KOREAN, runs of Han characters are JAPANESE if they contain kana and CHINESE
+	otherwise, and runs of other letters and digits are ENGLISH. Spaces and punctuation do
+	not end a run, so "iPhone 15 Pro" stays in one piece.
+*/
+
+// More info: https://en.wikipedia.org/wiki/CJK_characters
+
+const (
+	// kana for at least 1/_JapaneseKanaRatio of the Han and kana characters make a text Japanese.
+	_JapaneseKanaRatio = 10
+	// the prolonged sound mark belongs to the Common script, but only appears in kana words.
+	_ProlongedSoundMark = 'ー'
+)
+
+// DetectLanguage returns ENGLISH, CHINESE, JAPANESE or KOREAN, whichever text seems to be written in.
+func DetectLanguage(text []byte) LanguageType {
+	var hangul, han, kana, other int
+	for len(text) > 0 {
+		r, size := utf8.DecodeRune(text)
+		text = text[size:]
+		switch {
+		case unicode.Is(unicode.Hangul, r):
+			hangul += size
+		case unicode.Is(unicode.Han, r):
+			han += size
+		case isKana(r):
+			kana += size
+		case unicode.IsLetter(r):
+			other += size
+		}
+	}
+
+	switch cjk := han + kana; {
+	case hangul > cjk && hangul > other:
+		return KOREAN
+	case cjk > other:
+		if kana*_JapaneseKanaRatio >= cjk {
+			return JAPANESE
+		}
+		return CHINESE
+	}
+	return ENGLISH
+}
+
+// scriptRuns calls fn with every run of one script of text, and the language of the run.
+func scriptRuns(text []byte, fn func(language LanguageType, run []byte)) {
+	start, script, kana := 0, _ScriptNone, false
+	emit := func(end int) {
+		if script == _ScriptNone {
+			return
+		}
+		language := ENGLISH
+		switch {
+		case script == _ScriptHangul:
+			language = KOREAN
+		case script == _ScriptHan && kana:
+			language = JAPANESE
+		case script == _ScriptHan:
+			language = CHINESE
+		}
+		fn(language, text[start:end])
+	}
+
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRune(text[i:])
+		s := scriptOf(r)
+		if s != _ScriptNone && s != script {
+			if script != _ScriptNone {
+				emit(i)
+				start = i
+			}
+			script, kana = s, false
+		}
+		if isKana(r) {
+			kana = true
+		}
+		i += size
+	}
+	emit(len(text))
+}
+
+const (
+	_ScriptNone = iota
+	_ScriptHan  // Han and kana
+	_ScriptHangul
+	_ScriptOther // other letters and digits
+)
+
+func scriptOf(r rune) int {
+	switch {
+	case unicode.Is(unicode.Hangul, r):
+		return _ScriptHangul
+	case unicode.Is(unicode.Han, r) || isKana(r):
+		return _ScriptHan
+	case unicode.IsLetter(r) || unicode.IsDigit(r):
+		return _ScriptOther
+	}
+	return _ScriptNone
+}
+
+func isKana(r rune) bool {
+	return unicode.In(r, unicode.Hiragana, unicode.Katakana) || r == _ProlongedSoundMark
+}
+
+// isCJK reports whether r is a Han, kana or Hangul character.
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hangul) || isKana(r)
+}
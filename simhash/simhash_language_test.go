@@ -0,0 +1,94 @@
+package simhash
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectLanguage(t *testing.T) {
+	assert.Equal(t, ENGLISH, DetectLanguage([]byte("The quick brown fox jumps over the lazy dog.")))
+	assert.Equal(t, ENGLISH, DetectLanguage([]byte("")))
+	assert.Equal(t, ENGLISH, DetectLanguage([]byte("12345 !?")))
+	assert.Equal(t, CHINESE, DetectLanguage([]byte("国务院今天发布了新的计划。")))
+	assert.Equal(t, CHINESE, DetectLanguage([]byte("iPhone 15 Pro Max 今天正式发布")))
+	assert.Equal(t, JAPANESE, DetectLanguage([]byte("東京都は今日、新しい計画を発表しました。")))
+	assert.Equal(t, JAPANESE, DetectLanguage([]byte("コンピューター")))
+	assert.Equal(t, KOREAN, DetectLanguage([]byte("서울시는 오늘 새로운 계획을 발표했다.")))
+}
+
+func TestCJKBigramTokenizer(t *testing.T) {
+	var tok CJKBigramTokenizer
+	assert.Equal(t, []string{"東京", "京都", "都は", "今日", "windows", "11", "の"}, tok.Tokenize([]byte("東京都は, 今日 Windows 11 の")))
+	assert.Equal(t, []string{"서울", "울시", "시는", "오늘"}, tok.Tokenize([]byte("서울시는 오늘")))
+	assert.Empty(t, tok.Tokenize([]byte(" 。")))
+}
+
+func TestJapaneseAndKoreanSimHash(t *testing.T) {
+	sh := NewSimHash(JAPANESE, "")
+	assert.Equal(t, []string{"い計", "しい", "は今", "を発", "京都", "今日", "新し", "東京", "画を", "発表", "表し", "計画", "都は"}, sh.Tokens([]byte("東京都は今日、新しい計画を発表しました。")))
+	f1 := sh.Fingerprint([]byte("東京都は今日、新しい交通計画を正式に発表しました。"))
+	f2 := sh.Fingerprint([]byte("東京都は本日、新しい交通計画を正式に発表した。"))
+	assert.True(t, sh.IsEqual(f1, f2, 16))
+
+	sh = NewSimHash(KOREAN, "")
+	assert.Equal(t, []string{"계획", "로운", "발표", "새로", "서울", "시는", "오늘", "울시", "표했", "획을"}, sh.Tokens([]byte("서울시는 오늘 새로운 계획을 발표했다.")))
+}
+
+func TestAutoSimHash(t *testing.T) {
+	sh := NewSimHash(AUTO, "")
+	assert.Equal(t, []string{"brown", "fox", "quick"}, sh.Tokens([]byte("The quick brown fox")))
+	assert.Equal(t, []string{"发布", "国务院", "新", "计划"}, sh.Tokens([]byte("国务院今天发布了新的计划。")))
+	assert.Equal(t, []string{"京都", "東京", "都は"}, sh.Tokens([]byte("東京都は")))
+	assert.Equal(t, []string{"서울", "시는", "울시"}, sh.Tokens([]byte("서울시는")))
+	// only the stopwords of the detected language are removed: 事 is a Japanese stopword, 以上 a
+	// Chinese one.
+	assert.Equal(t, []string{"事", "这件"}, sh.Tokens([]byte("这件事很重要")))
+	assert.Equal(t, []string{"は以", "上で", "以上", "告は", "報告"}, sh.Tokens([]byte("報告は以上です。")))
+	sh.SetStopWords(nil)
+	assert.Equal(t, []string{"the"}, sh.Tokens([]byte("The")))
+
+	tok := &AutoTokenizer{Tokenizers: map[LanguageType]Tokenizer{ENGLISH: WordTokenizer{}}}
+	assert.Equal(t, []string{"国务院今天", "2021"}, tok.Tokenize([]byte("国务院今天 2021")))
+	_, err := NewAutoTokenizer("./fixtures/missing.txt")
+	assert.NotNil(t, err)
+}
+
+func TestScriptRuns(t *testing.T) {
+	type run struct {
+		language LanguageType
+		text     string
+	}
+	var runs []run
+	scriptRuns([]byte("苹果发布了iPhone 15 Pro，售价7999元。서울에서도 東京では"), func(language LanguageType, text []byte) {
+		runs = append(runs, run{language, string(text)})
+	})
+	assert.Equal(t, []run{
+		{CHINESE, "苹果发布了"},
+		{ENGLISH, "iPhone 15 Pro，"},
+		{CHINESE, "售价"},
+		{ENGLISH, "7999"},
+		{CHINESE, "元。"},
+		{KOREAN, "서울에서도 "},
+		{JAPANESE, "東京では"},
+	}, runs)
+
+	runs = runs[:0]
+	scriptRuns([]byte(" !? "), func(language LanguageType, text []byte) {
+		runs = append(runs, run{language, string(text)})
+	})
+	assert.Empty(t, runs)
+}
+
+func TestMixedSimHash(t *testing.T) {
+	sh := NewSimHash(MIXED, "")
+	assert.Equal(t, []string{"15", "7999", "iphone", "max", "pro", "元", "发布", "售价", "苹果"},
+		sh.Tokens([]byte("苹果今天发布了iPhone 15 Pro Max，售价7999元。")))
+	// the English stopwords apply to the English runs, the Chinese ones to the Chinese runs.
+	assert.Equal(t, []string{"apple", "iphone", "发布", "苹果"}, sh.Tokens([]byte("苹果的iPhone is from Apple，发布了")))
+
+	tok := &MixedTokenizer{Tokenizers: map[LanguageType]Tokenizer{ENGLISH: EnglishTokenizer{}}}
+	assert.Equal(t, []string{"pro", "国务院今天"}, tok.Tokenize([]byte("Pro 15 国务院今天")))
+	_, err := NewMixedTokenizer("./fixtures/missing.txt")
+	assert.NotNil(t, err)
+}
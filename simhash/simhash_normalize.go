@@ -0,0 +1,271 @@
+package simhash
+
+import (
+	"html"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+/*
+	Normalization is applied to the text before tokenization, so that differences which
+	do not change the content do not change the fingerprint either:
+
+	1. NormalizeHTML: drops tags, comments, and the content of <script> and <style>, and
+	   decodes entities. Every tag turns into a space.
+	2. NormalizeNFKC: Unicode NFKC normalization, full-width letters and digits become
+	   ASCII, ligatures are split, composed and decomposed accents become the same.
+	3. NormalizeCaseFold: Unicode case folding, more thorough than lower-casing.
+	4. NormalizeTraditionalToSimplified: maps traditional Chinese characters to simplified
+	   ones, character by character.
+	5. NormalizeStem: replaces every token with its Porter stem, stopwords excepted.
+
+	The steps run in this order, none of them is enabled by default.
+*/
+
+// Normalization is a set of normalization steps.
+type Normalization uint8
+
+const (
+	NormalizeHTML Normalization = 1 << iota
+	NormalizeNFKC
+	NormalizeCaseFold
+	NormalizeTraditionalToSimplified
+	NormalizeStem
+
+	NormalizeNone Normalization = 0
+	NormalizeAll                = NormalizeHTML | NormalizeNFKC | NormalizeCaseFold | NormalizeTraditionalToSimplified | NormalizeStem
+)
+
+// SetNormalization selects the normalization steps of sh.
+func (sh *SimHash) SetNormalization(n Normalization) {
+	sh.normalization = n
+}
+
+// Normalization returns the normalization steps of sh.
+func (sh *SimHash) Normalization() Normalization {
+	return sh.normalization
+}
+
+// tokenize normalizes and tokenizes text, it also returns the language of text.
+func (sh *SimHash) tokenize(text []byte) ([]string, LanguageType) {
+	if sh.normalization&NormalizeHTML != 0 {
+		var hs htmlStripper
+		text = hs.flush(hs.strip(make([]byte, 0, len(text)), text))
+	}
+	return sh.tokenizeStripped(text)
+}
+
+// tokenizeStripped normalizes and tokenizes text, whose HTML has already been stripped if needed.
+// It also returns the language of text, detected only when sh picks stopwords by language.
+func (sh *SimHash) tokenizeStripped(text []byte) ([]string, LanguageType) {
+	if sh.normalization&NormalizeNFKC != 0 {
+		text = norm.NFKC.Bytes(text)
+	}
+	if sh.normalization&NormalizeCaseFold != 0 {
+		text = cases.Fold().Bytes(text)
+	}
+	if sh.normalization&NormalizeTraditionalToSimplified != 0 {
+		text = traditionalToSimplified(text)
+	}
+	language := sh.language
+	var tokens []string
+	if sh.languageStopWords != nil {
+		language = DetectLanguage(text)
+	}
+	if at, ok := sh.tokenizer.(*AutoTokenizer); ok && sh.languageStopWords != nil {
+		// the language is detected once.
+		tokens = at.tokenizeAs(text, language)
+	} else {
+		tokens = sh.tokenizer.Tokenize(text)
+	}
+	if sh.normalization&NormalizeStem != 0 {
+		for i, t := range tokens {
+			if !sh.isStopWord(t, language) {
+				tokens[i] = PorterStem(t)
+			}
+		}
+	}
+	return tokens, language
+}
+
+// traditionalToSimplified maps every traditional Chinese character of text to its simplified form.
+func traditionalToSimplified(text []byte) []byte {
+	out := make([]byte, 0, len(text))
+	for len(text) > 0 {
+		r, size := utf8.DecodeRune(text)
+		if s, ok := _TraditionalToSimplified[r]; ok {
+			out = append(out, string(s)...)
+		} else {
+			out = append(out, text[:size]...)
+		}
+		text = text[size:]
+	}
+	return out
+}
+
+const (
+	_HTMLText = iota
+	_HTMLTagStart
+	_HTMLTag
+	_HTMLComment
+	_HTMLRawText
+	_HTMLEntity
+)
+
+const (
+	_MaxHTMLTagName = 16
+	_MaxHTMLEntity  = 32
+)
+
+// htmlStripper removes the markup of HTML fed to it in any number of pieces.
+type htmlStripper struct {
+	state    int
+	name     []byte // lower-cased name of the current tag
+	nameDone bool
+	closing  bool
+	quote    byte
+	dashes   int    // consecutive '-' inside a comment
+	rawEnd   string // "</script" or "</style" inside raw text
+	matched  int    // bytes of rawEnd matched so far
+	entity   []byte
+}
+
+// strip appends the text of p to dst and returns the extended slice.
+func (hs *htmlStripper) strip(dst, p []byte) []byte {
+	for i := 0; i < len(p); i++ {
+		c := p[i]
+		switch hs.state {
+		case _HTMLText:
+			switch c {
+			case '<':
+				hs.state = _HTMLTagStart
+			case '&':
+				hs.state = _HTMLEntity
+				hs.entity = append(hs.entity[:0], c)
+			default:
+				dst = append(dst, c)
+			}
+
+		case _HTMLTagStart:
+			if isASCIILetter(c) || c == '/' || c == '!' || c == '?' {
+				hs.state = _HTMLTag
+				hs.name = hs.name[:0]
+				hs.nameDone = false
+				hs.closing = c == '/'
+				hs.quote = 0
+				if c != '/' {
+					hs.name = append(hs.name, lower(c))
+				}
+			} else {
+				// a lone '<', such as in "a < b".
+				dst = append(dst, '<')
+				hs.state = _HTMLText
+				i--
+			}
+
+		case _HTMLTag:
+			switch {
+			case hs.quote != 0:
+				if c == hs.quote {
+					hs.quote = 0
+				}
+			case c == '>':
+				dst = hs.endTag(dst)
+			case !hs.nameDone:
+				if c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f' || c == '/' {
+					hs.nameDone = true
+				} else if len(hs.name) < _MaxHTMLTagName {
+					hs.name = append(hs.name, lower(c))
+					if string(hs.name) == "!--" {
+						hs.state = _HTMLComment
+						hs.dashes = 0
+					}
+				}
+			case c == '"' || c == '\'':
+				hs.quote = c
+			}
+
+		case _HTMLComment:
+			if c == '>' && hs.dashes >= 2 {
+				hs.state = _HTMLText
+				dst = append(dst, ' ')
+			}
+			if c == '-' {
+				hs.dashes++
+			} else {
+				hs.dashes = 0
+			}
+
+		case _HTMLRawText:
+			if lower(c) == hs.rawEnd[hs.matched] {
+				hs.matched++
+				if hs.matched == len(hs.rawEnd) {
+					hs.state = _HTMLTag
+					hs.name = append(hs.name[:0], hs.rawEnd[2:]...)
+					hs.nameDone = true
+					hs.closing = true
+					hs.quote = 0
+				}
+			} else if c == '<' {
+				hs.matched = 1
+			} else {
+				hs.matched = 0
+			}
+
+		case _HTMLEntity:
+			switch {
+			case c == ';':
+				hs.entity = append(hs.entity, c)
+				dst = append(dst, html.UnescapeString(string(hs.entity))...)
+				hs.state = _HTMLText
+			case (isASCIILetter(c) || c == '#' || c >= '0' && c <= '9') && len(hs.entity) < _MaxHTMLEntity:
+				hs.entity = append(hs.entity, c)
+			default:
+				// not terminated, such as "AT&T" or "&amp " without the semicolon.
+				dst = append(dst, html.UnescapeString(string(hs.entity))...)
+				hs.state = _HTMLText
+				i--
+			}
+		}
+	}
+	return dst
+}
+
+// endTag handles the '>' closing a tag.
+func (hs *htmlStripper) endTag(dst []byte) []byte {
+	hs.state = _HTMLText
+	if !hs.closing {
+		switch string(hs.name) {
+		case "script", "style":
+			hs.state = _HTMLRawText
+			hs.rawEnd = "</" + string(hs.name)
+			hs.matched = 0
+		}
+	}
+	return append(dst, ' ')
+}
+
+// flush appends what is still buffered, at the end of the input, to dst and resets hs.
+func (hs *htmlStripper) flush(dst []byte) []byte {
+	switch hs.state {
+	case _HTMLTagStart:
+		dst = append(dst, '<')
+	case _HTMLEntity:
+		dst = append(dst, html.UnescapeString(string(hs.entity))...)
+	}
+	*hs = htmlStripper{name: hs.name[:0], entity: hs.entity[:0]}
+	return dst
+}
+
+func isASCIILetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func lower(c byte) byte {
+	if c >= 'A' && c <= 'Z' {
+		return c + 'a' - 'A'
+	}
+	return c
+}
@@ -0,0 +1,63 @@
+package simhash
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func stripHTML(pieces ...string) string {
+	var hs htmlStripper
+	var dst []byte
+	for _, p := range pieces {
+		dst = hs.strip(dst, []byte(p))
+	}
+	return string(hs.flush(dst))
+}
+
+func TestHTMLStripper(t *testing.T) {
+	page := `<!DOCTYPE html><html><head><title>Fish &amp; Chips</title>` +
+		`<style>p { color: red; }</style><script type="text/javascript">if (a < b && c > d) { x = "</p>"; }</script></head>` +
+		`<body><!-- <p>hidden</p> --><p class="a>b">AT&T costs 5 &lt; 6&nbsp;&#36;</p>bare & <br/>end &copy</body></html>`
+	expected := "    Fish & Chips         AT&T costs 5 < 6\u00a0$ bare &  end ©  "
+
+	assert.Equal(t, expected, stripHTML(page))
+	// any split gives the same result.
+	for i := 0; i <= len(page); i++ {
+		assert.Equal(t, expected, stripHTML(page[:i], page[i:]), "split at %d", i)
+	}
+	assert.Equal(t, "a < b", stripHTML("a < b"))
+	assert.Equal(t, "  ", stripHTML("<SCRIPT>x</ScRiPt >"))
+}
+
+func TestTraditionalToSimplified(t *testing.T) {
+	assert.Equal(t, "汉语简体与繁体转换, ok", string(traditionalToSimplified([]byte("漢語簡體與繁體轉換, ok"))))
+	// still written in simplified Chinese.
+	assert.Equal(t, "乾隆年间, 瞭望, 狼藉", string(traditionalToSimplified([]byte("乾隆年間, 瞭望, 狼藉"))))
+	assert.Equal(t, "䶧", string(traditionalToSimplified([]byte("䶧"))))
+}
+
+func TestSimHashNormalization(t *testing.T) {
+	sh := NewSimHash(ENGLISH, "")
+	assert.Equal(t, NormalizeNone, sh.Normalization())
+	raw := []byte(`<p>The <b>ＱＵＩＣＫ</b> brown foxes jumped over the lazy dogs &amp; cats</p>`)
+	assert.Equal(t, []string{"amp", "b", "brown", "cats", "dogs", "foxes", "jumped", "lazy", "p", "ｑｕｉｃｋ"}, sh.Tokens(raw))
+
+	sh.SetNormalization(NormalizeAll)
+	assert.Equal(t, []string{"brown", "cat", "dog", "fox", "jump", "lazi", "quick"}, sh.Tokens(raw))
+	assert.Equal(t, sh.Fingerprint(raw), sh.Fingerprint([]byte("the quick brown fox jumps over the lazy dog and cat")))
+
+	// the Builder normalizes the same way, whatever the pieces.
+	b := sh.NewBuilder()
+	for i := range raw {
+		b.Write(raw[i : i+1]) // nolint
+	}
+	assert.Equal(t, sh.FingerprintWeighted(map[string]float32{
+		"brown": 1, "cat": 1, "dog": 1, "fox": 1, "jump": 1, "lazi": 1, "quick": 1,
+	}), b.Fingerprint())
+
+	sh = NewSimHash(ENGLISH, "", NewNGramTokenizer(2))
+	sh.SetStopWords(nil)
+	sh.SetNormalization(NormalizeTraditionalToSimplified | NormalizeNFKC)
+	assert.Equal(t, sh.Tokens([]byte("简体中文12")), sh.Tokens([]byte("簡體中文１２")))
+}
@@ -0,0 +1,278 @@
+package simhash
+
+/*
+	The Porter stemming algorithm strips the common inflectional and derivational
+	suffixes of English words in five steps, so "connect", "connected", "connecting"
+	and "connection" all become "connect". A word is seen as [C](VC){m}[V], where C
+	and V are runs of consonants and vowels, and most rules only apply when the stem
+	left behind has a measure m above some value.
+
+	Forked from the ANSI C reference implementation by Martin Porter.
+*/
+
+// More info: https://tartarus.org/martin/PorterStemmer/
+
+var (
+	// suffix rules of steps 2 and 3, keyed by the penultimate and the last byte of the word respectively.
+	_PorterStep2Rules = map[byte][][2]string{
+		'a': {{"ational", "ate"}, {"tional", "tion"}},
+		'c': {{"enci", "ence"}, {"anci", "ance"}},
+		'e': {{"izer", "ize"}},
+		'l': {{"bli", "ble"}, {"alli", "al"}, {"entli", "ent"}, {"eli", "e"}, {"ousli", "ous"}},
+		'o': {{"ization", "ize"}, {"ation", "ate"}, {"ator", "ate"}},
+		's': {{"alism", "al"}, {"iveness", "ive"}, {"fulness", "ful"}, {"ousness", "ous"}},
+		't': {{"aliti", "al"}, {"iviti", "ive"}, {"biliti", "ble"}},
+		'g': {{"logi", "log"}},
+	}
+
+	_PorterStep3Rules = map[byte][][2]string{
+		'e': {{"icate", "ic"}, {"ative", ""}, {"alize", "al"}},
+		'i': {{"iciti", "ic"}},
+		'l': {{"ical", "ic"}, {"ful", ""}},
+		's': {{"ness", ""}},
+	}
+
+	// suffixes of step 4, keyed by the penultimate byte of the word.
+	_PorterStep4Suffixes = map[byte][]string{
+		'a': {"al"},
+		'c': {"ance", "ence"},
+		'e': {"er"},
+		'i': {"ic"},
+		'l': {"able", "ible"},
+		'n': {"ant", "ement", "ment", "ent"},
+		'o': {"ion", "ou"},
+		's': {"ism"},
+		't': {"ate", "iti"},
+		'u': {"ous"},
+		'v': {"ive"},
+		'z': {"ize"},
+	}
+)
+
+type porterStemmer struct {
+	b []byte
+	k int // offset of the last byte of the word
+	j int // offset of the last byte of the stem, set by ends
+}
+
+// PorterStem returns the Porter stem of word. Only words made of lower-case ASCII letters are stemmed,
+// other words are returned unchanged.
+func PorterStem(word string) string {
+	if len(word) <= 2 {
+		return word
+	}
+	for i := 0; i < len(word); i++ {
+		if word[i] < 'a' || word[i] > 'z' {
+			return word
+		}
+	}
+
+	z := &porterStemmer{b: []byte(word), k: len(word) - 1}
+	z.step1ab()
+	if z.k > 0 {
+		z.step1c()
+		z.step2()
+		z.step3()
+		z.step4()
+		z.step5()
+	}
+	return string(z.b[:z.k+1])
+}
+
+// cons reports whether b[i] is a consonant.
+func (z *porterStemmer) cons(i int) bool {
+	switch z.b[i] {
+	case 'a', 'e', 'i', 'o', 'u':
+		return false
+	case 'y':
+		return i == 0 || !z.cons(i-1)
+	}
+	return true
+}
+
+// m measures the number of consonant sequences in b[0:j+1].
+func (z *porterStemmer) m() int {
+	n, i := 0, 0
+	for {
+		if i > z.j {
+			return n
+		}
+		if !z.cons(i) {
+			break
+		}
+		i++
+	}
+	i++
+	for {
+		for {
+			if i > z.j {
+				return n
+			}
+			if z.cons(i) {
+				break
+			}
+			i++
+		}
+		i++
+		n++
+		for {
+			if i > z.j {
+				return n
+			}
+			if !z.cons(i) {
+				break
+			}
+			i++
+		}
+		i++
+	}
+}
+
+// vowelInStem reports whether b[0:j+1] contains a vowel.
+func (z *porterStemmer) vowelInStem() bool {
+	for i := 0; i <= z.j; i++ {
+		if !z.cons(i) {
+			return true
+		}
+	}
+	return false
+}
+
+// doubleC reports whether b[j-1:j+1] is a double consonant.
+func (z *porterStemmer) doubleC(j int) bool {
+	if j < 1 || z.b[j] != z.b[j-1] {
+		return false
+	}
+	return z.cons(j)
+}
+
+// cvc reports whether b[i-2:i+1] is consonant-vowel-consonant and the last consonant is not w, x or y.
+func (z *porterStemmer) cvc(i int) bool {
+	if i < 2 || !z.cons(i) || z.cons(i-1) || !z.cons(i-2) {
+		return false
+	}
+	switch z.b[i] {
+	case 'w', 'x', 'y':
+		return false
+	}
+	return true
+}
+
+// ends reports whether b[0:k+1] ends with s, and sets j to the end of the stem before s.
+func (z *porterStemmer) ends(s string) bool {
+	l := len(s)
+	if l > z.k+1 || string(z.b[z.k-l+1:z.k+1]) != s {
+		return false
+	}
+	z.j = z.k - l
+	return true
+}
+
+// setTo replaces b[j+1:k+1] with s.
+func (z *porterStemmer) setTo(s string) {
+	z.b = append(z.b[:z.j+1], s...)
+	z.k = z.j + len(s)
+}
+
+func (z *porterStemmer) r(s string) {
+	if z.m() > 0 {
+		z.setTo(s)
+	}
+}
+
+// step1ab gets rid of plurals and -ed or -ing.
+func (z *porterStemmer) step1ab() {
+	if z.b[z.k] == 's' {
+		if z.ends("sses") {
+			z.k -= 2
+		} else if z.ends("ies") {
+			z.setTo("i")
+		} else if z.b[z.k-1] != 's' {
+			z.k--
+		}
+	}
+	if z.ends("eed") {
+		if z.m() > 0 {
+			z.k--
+		}
+	} else if (z.ends("ed") || z.ends("ing")) && z.vowelInStem() {
+		z.k = z.j
+		if z.ends("at") {
+			z.setTo("ate")
+		} else if z.ends("bl") {
+			z.setTo("ble")
+		} else if z.ends("iz") {
+			z.setTo("ize")
+		} else if z.doubleC(z.k) {
+			z.k--
+			switch z.b[z.k] {
+			case 'l', 's', 'z':
+				z.k++
+			}
+		} else {
+			z.j = z.k
+			if z.m() == 1 && z.cvc(z.k) {
+				z.setTo("e")
+			}
+		}
+	}
+}
+
+// step1c turns a terminal y into i when there is another vowel in the stem.
+func (z *porterStemmer) step1c() {
+	if z.ends("y") && z.vowelInStem() {
+		z.b[z.k] = 'i'
+	}
+}
+
+// step2 maps double suffixes to single ones.
+func (z *porterStemmer) step2() {
+	z.applyRules(_PorterStep2Rules[z.b[z.k-1]])
+}
+
+// step3 deals with -ic-, -full, -ness etc.
+func (z *porterStemmer) step3() {
+	z.applyRules(_PorterStep3Rules[z.b[z.k]])
+}
+
+// applyRules replaces the first matching suffix if the measure of the stem is above 0.
+func (z *porterStemmer) applyRules(rules [][2]string) {
+	for _, rule := range rules {
+		if z.ends(rule[0]) {
+			z.r(rule[1])
+			return
+		}
+	}
+}
+
+// step4 takes off -ant, -ence etc. in context <c>vcvc<v>.
+func (z *porterStemmer) step4() {
+	matched := false
+	for _, s := range _PorterStep4Suffixes[z.b[z.k-1]] {
+		if z.ends(s) {
+			// -ion is only removed after s or t.
+			if s == "ion" && (z.j < 0 || (z.b[z.j] != 's' && z.b[z.j] != 't')) {
+				continue
+			}
+			matched = true
+			break
+		}
+	}
+	if matched && z.m() > 1 {
+		z.k = z.j
+	}
+}
+
+// step5 removes a final -e and changes -ll to -l if the measure is above 1.
+func (z *porterStemmer) step5() {
+	z.j = z.k
+	if z.b[z.k] == 'e' {
+		a := z.m()
+		if a > 1 || a == 1 && !z.cvc(z.k-1) {
+			z.k--
+		}
+	}
+	if z.b[z.k] == 'l' && z.doubleC(z.k) && z.m() > 1 {
+		z.k--
+	}
+}
@@ -0,0 +1,192 @@
+package simhash
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+/*
+	Segmenter splits Chinese text into words with a unigram language model: among all
+	the ways to cut a run of Han characters into dictionary words, it picks the one
+	maximizing the product of the word probabilities freq(w) / total, by dynamic
+	programming from the end of the run. A character missing from the dictionary becomes
+	a word on its own, with the probability of the rarest word.
+
+	Han characters are recognized with unicode.Han, which covers the CJK extension blocks
+	and the compatibility ideographs, not only [一-龥].
+*/
+
+// More info: https://github.com/fxsjy/jieba
+
+// Dictionary holds word frequencies for Segmenter.
+type Dictionary struct {
+	freq   map[string]uint64
+	total  uint64
+	maxLen int // in runes
+}
+
+var (
+	_DefaultDictionaryOnce sync.Once
+	_DefaultDictionary     *Dictionary
+)
+
+// NewDictionary creates an empty Dictionary.
+func NewDictionary() *Dictionary {
+	return &Dictionary{freq: make(map[string]uint64)}
+}
+
+// DefaultDictionary returns the built-in Dictionary, it must not be modified.
+func DefaultDictionary() *Dictionary {
+	_DefaultDictionaryOnce.Do(func() {
+		dict, err := LoadDictionary(strings.NewReader(_DefaultChineseDictionary))
+		if err != nil {
+			panic(err)
+		}
+		_DefaultDictionary = dict
+	})
+	return _DefaultDictionary
+}
+
+// LoadDictionary reads a Dictionary in the format of sego and jieba, one "word frequency [part-of-speech]"
+// entry per line. Empty lines are skipped, a line without a valid frequency is an error.
+func LoadDictionary(r io.Reader) (*Dictionary, error) {
+	dict := NewDictionary()
+	if err := dict.load(r); err != nil {
+		return nil, err
+	}
+	return dict, nil
+}
+
+// LoadDictionaryFiles reads a Dictionary from a comma-separated list of files.
+func LoadDictionaryFiles(files string) (*Dictionary, error) {
+	dict := NewDictionary()
+	for _, file := range strings.Split(files, ",") {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, err
+		}
+		err = dict.load(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", file, err)
+		}
+	}
+	return dict, nil
+}
+
+func (dict *Dictionary) load(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) < 2 {
+			return fmt.Errorf("line %d: expected a word and its frequency", line)
+		}
+		freq, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("line %d: invalid frequency %q", line, fields[1])
+		}
+		dict.Add(fields[0], freq)
+	}
+	return scanner.Err()
+}
+
+// Add adds word with the given frequency, or updates its frequency. It must not be called while a
+// Segmenter uses dict, Segment is safe for concurrent use otherwise.
+func (dict *Dictionary) Add(word string, freq uint64) {
+	dict.total -= dict.freq[word]
+	dict.freq[word] = freq
+	dict.total += freq
+	if n := utf8.RuneCountInString(word); n > dict.maxLen {
+		dict.maxLen = n
+	}
+}
+
+// Len returns the number of words of dict.
+func (dict *Dictionary) Len() int {
+	return len(dict.freq)
+}
+
+// Segmenter splits Chinese text into words.
+type Segmenter struct {
+	dict *Dictionary
+}
+
+// NewSegmenter creates a Segmenter, if dict is nil, DefaultDictionary is used.
+func NewSegmenter(dict *Dictionary) *Segmenter {
+	if dict == nil {
+		dict = DefaultDictionary()
+	}
+	return &Segmenter{dict: dict}
+}
+
+// Segment splits text into words, runs of other characters than Han ones are returned as they are.
+func (seg *Segmenter) Segment(text string) []string {
+	var words []string
+	for len(text) > 0 {
+		r, _ := utf8.DecodeRuneInString(text)
+		han := unicode.Is(unicode.Han, r)
+		end := strings.IndexFunc(text, func(r rune) bool { return unicode.Is(unicode.Han, r) != han })
+		if end < 0 {
+			end = len(text)
+		}
+		if han {
+			words = seg.segmentHan(words, text[:end])
+		} else {
+			words = append(words, text[:end])
+		}
+		text = text[end:]
+	}
+	return words
+}
+
+// segmentHan appends the words of a run of Han characters to words.
+func (seg *Segmenter) segmentHan(words []string, run string) []string {
+	// offsets[i] is the byte offset of the i-th rune, offsets[n] == len(run).
+	offsets := make([]int, 0, len(run)/3+1)
+	for i := range run {
+		offsets = append(offsets, i)
+	}
+	n := len(offsets)
+	offsets = append(offsets, len(run))
+
+	total := math.Log(float64(seg.dict.total + 1))
+	minLogP := -total
+
+	// best[i] is the best log probability of run[offsets[i]:], next[i] where its first word ends.
+	best := make([]float64, n+1)
+	next := make([]int, n+1)
+	for i := n - 1; i >= 0; i-- {
+		best[i] = math.Inf(-1)
+		for j := i + 1; j <= n && j-i <= seg.dict.maxLen; j++ {
+			logP := minLogP
+			if f, ok := seg.dict.freq[run[offsets[i]:offsets[j]]]; ok && f > 0 {
+				logP = math.Log(float64(f)) - total
+			} else if j-i > 1 {
+				continue
+			}
+			// prefer the longer word on ties, so the result does not depend on the iteration order.
+			if p := logP + best[j]; p >= best[i] {
+				best[i] = p
+				next[i] = j
+			}
+		}
+	}
+
+	for i := 0; i < n; i = next[i] {
+		words = append(words, run[offsets[i]:offsets[next[i]]])
+	}
+	return words
+}
@@ -0,0 +1,55 @@
+package simhash
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultDictionary(t *testing.T) {
+	seg := NewSegmenter(nil)
+	assert.Equal(t, []string{"我", "爱", "北京", "天安门"}, seg.Segment("我爱北京天安门"))
+	assert.Equal(t, []string{"南京市", "长江大桥"}, seg.Segment("南京市长江大桥"))
+	assert.Equal(t, []string{"研究", "生命", "的", "起源"}, seg.Segment("研究生命的起源"))
+	// characters of the extension blocks are Han characters too.
+	assert.Equal(t, []string{"𠀀", "𪚥", "中文", ", ok123"}, seg.Segment("𠀀𪚥中文, ok123"))
+	assert.Empty(t, seg.Segment(""))
+}
+
+func TestLoadDictionary(t *testing.T) {
+	dict, err := LoadDictionary(strings.NewReader("中 10 n\n国 10\n\n中国 100 ns\n国人 1\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, 4, dict.Len())
+	seg := NewSegmenter(dict)
+	assert.Equal(t, []string{"中国", "人"}, seg.Segment("中国人"))
+	dict.Add("中国人", 1000)
+	assert.Equal(t, []string{"中国人"}, seg.Segment("中国人"))
+
+	_, err = LoadDictionary(strings.NewReader("中国 100\n国人\n"))
+	assert.EqualError(t, err, "line 2: expected a word and its frequency")
+	_, err = LoadDictionary(strings.NewReader("中国 many\n"))
+	assert.EqualError(t, err, `line 1: invalid frequency "many"`)
+}
+
+func TestChineseTokenizer(t *testing.T) {
+	tok, err := NewChineseTokenizer("")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"国务院", "月", "召开", "常务", "会议", "𠀀"}, tok.Tokenize([]byte("国务院8月召开常务会议, ok 𠀀")))
+
+	tok, err = NewChineseTokenizer("./fixtures/dictionary.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"李克强", "主持", "召开", "国务院", "常务", "会议"}, tok.Tokenize([]byte("李克强主持召开国务院常务会议")))
+
+	_, err = NewChineseTokenizer("./fixtures/dictionary.txt,./fixtures/missing.txt")
+	assert.NotNil(t, err)
+	assert.Panics(t, func() { NewSimHash(CHINESE, "./fixtures/missing.txt") })
+	for _, language := range []LanguageType{CHINESE, AUTO, MIXED} {
+		_, err = NewSimHashWithDict(language, "./fixtures/missing.txt")
+		assert.NotNil(t, err)
+	}
+	_, err = NewSimHashWithDict(ENGLISH, "./fixtures/missing.txt")
+	assert.Nil(t, err)
+	_, err = NewSimHashWithDict(LanguageType(42), "")
+	assert.NotNil(t, err)
+}
@@ -2083,6 +2083,259 @@ var (
 		"打开天窗说亮话": struct{}{},
 	}
 
+	// JaStopWords 日文停词表
+	JaStopWords = map[string]struct{}{
+		"は":  struct{}{},
+		"が":  struct{}{},
+		"の":  struct{}{},
+		"に":  struct{}{},
+		"を":  struct{}{},
+		"で":  struct{}{},
+		"と":  struct{}{},
+		"も":  struct{}{},
+		"へ":  struct{}{},
+		"や":  struct{}{},
+		"か":  struct{}{},
+		"な":  struct{}{},
+		"ね":  struct{}{},
+		"よ":  struct{}{},
+		"これ": struct{}{},
+		"それ": struct{}{},
+		"あれ": struct{}{},
+		"どれ": struct{}{},
+		"この": struct{}{},
+		"その": struct{}{},
+		"あの": struct{}{},
+		"どの": struct{}{},
+		"ここ": struct{}{},
+		"そこ": struct{}{},
+		"どこ": struct{}{},
+		"こう": struct{}{},
+		"そう": struct{}{},
+		"どう": struct{}{},
+		"です": struct{}{},
+		"ます": struct{}{},
+		"でし": struct{}{},
+		"まし": struct{}{},
+		"しま": struct{}{},
+		"でき": struct{}{},
+		"した": struct{}{},
+		"して": struct{}{},
+		"しな": struct{}{},
+		"する": struct{}{},
+		"され": struct{}{},
+		"さん": struct{}{},
+		"ない": struct{}{},
+		"なか": struct{}{},
+		"なっ": struct{}{},
+		"なる": struct{}{},
+		"なり": struct{}{},
+		"なく": struct{}{},
+		"ある": struct{}{},
+		"あり": struct{}{},
+		"あっ": struct{}{},
+		"いる": struct{}{},
+		"いっ": struct{}{},
+		"いた": struct{}{},
+		"いて": struct{}{},
+		"いう": struct{}{},
+		"いい": struct{}{},
+		"おり": struct{}{},
+		"おる": struct{}{},
+		"れる": struct{}{},
+		"られ": struct{}{},
+		"れた": struct{}{},
+		"せる": struct{}{},
+		"させ": struct{}{},
+		"から": struct{}{},
+		"まで": struct{}{},
+		"より": struct{}{},
+		"など": struct{}{},
+		"こと": struct{}{},
+		"もの": struct{}{},
+		"ため": struct{}{},
+		"よう": struct{}{},
+		"とい": struct{}{},
+		"ので": struct{}{},
+		"のは": struct{}{},
+		"のが": struct{}{},
+		"のを": struct{}{},
+		"のに": struct{}{},
+		"のだ": struct{}{},
+		"のか": struct{}{},
+		"には": struct{}{},
+		"では": struct{}{},
+		"とは": struct{}{},
+		"でも": struct{}{},
+		"にも": struct{}{},
+		"とも": struct{}{},
+		"へは": struct{}{},
+		"にて": struct{}{},
+		"にお": struct{}{},
+		"おい": struct{}{},
+		"けれ": struct{}{},
+		"ども": struct{}{},
+		"だが": struct{}{},
+		"だっ": struct{}{},
+		"だけ": struct{}{},
+		"ほど": struct{}{},
+		"また": struct{}{},
+		"およ": struct{}{},
+		"及び": struct{}{},
+		"並び": struct{}{},
+		"さら": struct{}{},
+		"しか": struct{}{},
+		"ただ": struct{}{},
+		"なお": struct{}{},
+		"まず": struct{}{},
+		"もう": struct{}{},
+		"もし": struct{}{},
+		"ちょ": struct{}{},
+		"たち": struct{}{},
+		"私":  struct{}{},
+		"僕":  struct{}{},
+		"彼":  struct{}{},
+		"彼女": struct{}{},
+		"我々": struct{}{},
+		"我等": struct{}{},
+		"貴方": struct{}{},
+		"何":  struct{}{},
+		"誰":  struct{}{},
+		"事":  struct{}{},
+		"物":  struct{}{},
+		"時":  struct{}{},
+		"方":  struct{}{},
+		"等":  struct{}{},
+		"為":  struct{}{},
+	}
+	// KoStopWords 韩文停词表
+	KoStopWords = map[string]struct{}{
+		"이":  struct{}{},
+		"그":  struct{}{},
+		"저":  struct{}{},
+		"것":  struct{}{},
+		"수":  struct{}{},
+		"등":  struct{}{},
+		"및":  struct{}{},
+		"또":  struct{}{},
+		"더":  struct{}{},
+		"안":  struct{}{},
+		"못":  struct{}{},
+		"잘":  struct{}{},
+		"좀":  struct{}{},
+		"를":  struct{}{},
+		"을":  struct{}{},
+		"은":  struct{}{},
+		"는":  struct{}{},
+		"가":  struct{}{},
+		"의":  struct{}{},
+		"에":  struct{}{},
+		"와":  struct{}{},
+		"과":  struct{}{},
+		"도":  struct{}{},
+		"로":  struct{}{},
+		"들":  struct{}{},
+		"께":  struct{}{},
+		"뿐":  struct{}{},
+		"한":  struct{}{},
+		"할":  struct{}{},
+		"해":  struct{}{},
+		"된":  struct{}{},
+		"될":  struct{}{},
+		"때":  struct{}{},
+		"그리": struct{}{},
+		"리고": struct{}{},
+		"그러": struct{}{},
+		"러나": struct{}{},
+		"하지": struct{}{},
+		"지만": struct{}{},
+		"그래": struct{}{},
+		"래서": struct{}{},
+		"또는": struct{}{},
+		"또한": struct{}{},
+		"이런": struct{}{},
+		"그런": struct{}{},
+		"저런": struct{}{},
+		"이것": struct{}{},
+		"그것": struct{}{},
+		"저것": struct{}{},
+		"이는": struct{}{},
+		"그는": struct{}{},
+		"우리": struct{}{},
+		"저희": struct{}{},
+		"당신": struct{}{},
+		"너희": struct{}{},
+		"있다": struct{}{},
+		"있는": struct{}{},
+		"있고": struct{}{},
+		"있어": struct{}{},
+		"있을": struct{}{},
+		"있습": struct{}{},
+		"없다": struct{}{},
+		"없는": struct{}{},
+		"없이": struct{}{},
+		"하다": struct{}{},
+		"하는": struct{}{},
+		"하고": struct{}{},
+		"하여": struct{}{},
+		"하면": struct{}{},
+		"했다": struct{}{},
+		"했고": struct{}{},
+		"한다": struct{}{},
+		"합니": struct{}{},
+		"된다": struct{}{},
+		"되는": struct{}{},
+		"되어": struct{}{},
+		"되고": struct{}{},
+		"됐다": struct{}{},
+		"에서": struct{}{},
+		"으로": struct{}{},
+		"에게": struct{}{},
+		"에는": struct{}{},
+		"에도": struct{}{},
+		"부터": struct{}{},
+		"까지": struct{}{},
+		"보다": struct{}{},
+		"처럼": struct{}{},
+		"이다": struct{}{},
+		"이며": struct{}{},
+		"이고": struct{}{},
+		"이나": struct{}{},
+		"입니": struct{}{},
+		"니다": struct{}{},
+		"습니": struct{}{},
+		"때문": struct{}{},
+		"문에": struct{}{},
+		"위해": struct{}{},
+		"위한": struct{}{},
+		"대한": struct{}{},
+		"대해": struct{}{},
+		"통해": struct{}{},
+		"따라": struct{}{},
+		"관련": struct{}{},
+		"경우": struct{}{},
+		"정도": struct{}{},
+		"이번": struct{}{},
+		"지난": struct{}{},
+		"같은": struct{}{},
+		"같이": struct{}{},
+		"다른": struct{}{},
+		"모든": struct{}{},
+		"어떤": struct{}{},
+		"이미": struct{}{},
+		"아주": struct{}{},
+		"매우": struct{}{},
+		"너무": struct{}{},
+		"가장": struct{}{},
+		"다시": struct{}{},
+		"함께": struct{}{},
+		"바로": struct{}{},
+		"모두": struct{}{},
+		"각각": struct{}{},
+		"여러": struct{}{},
+		"그냥": struct{}{},
+		"아직": struct{}{},
+	}
 	// SpStopWords 特殊词停词表
 	SpStopWords = map[string]struct{}{
 		"?":                   struct{}{},
@@ -0,0 +1,107 @@
+package simhash
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"strings"
+)
+
+// StopWords is a set of tokens dropped before fingerprinting.
+type StopWords map[string]struct{}
+
+// NewStopWords creates a StopWords holding words.
+func NewStopWords(words ...string) StopWords {
+	sw := make(StopWords, len(words))
+	sw.Add(words...)
+	return sw
+}
+
+// DefaultStopWords returns a copy of the built-in stopwords of language, together with
+// the special stopwords (SpStopWords) shared by all languages. MIXED gets the stopwords of
+// every language, AUTO only the special ones: a SimHash of AUTO adds the stopwords of the
+// language it detects in every document.
+func DefaultStopWords(language LanguageType) StopWords {
+	sw := make(StopWords)
+	switch language {
+	case ENGLISH:
+		sw.Merge(EnStopWords)
+	case CHINESE:
+		sw.Merge(ChStopWords)
+	case JAPANESE:
+		sw.Merge(JaStopWords)
+	case KOREAN:
+		sw.Merge(KoStopWords)
+	case MIXED:
+		sw.Merge(EnStopWords)
+		sw.Merge(ChStopWords)
+		sw.Merge(JaStopWords)
+		sw.Merge(KoStopWords)
+	}
+	sw.Merge(SpStopWords)
+	return sw
+}
+
+// languageStopWords returns the default stopwords of every language AUTO detects.
+func languageStopWords() map[LanguageType]StopWords {
+	sws := make(map[LanguageType]StopWords)
+	for _, language := range []LanguageType{ENGLISH, CHINESE, JAPANESE, KOREAN} {
+		sws[language] = DefaultStopWords(language)
+	}
+	return sws
+}
+
+// LoadStopWords reads one stopword per line from r, leading and trailing white spaces are
+// trimmed, empty lines and lines starting with '#' are skipped.
+func LoadStopWords(r io.Reader) (StopWords, error) {
+	sw := make(StopWords)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		sw[line] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return sw, nil
+}
+
+// LoadStopWordsFromFile is LoadStopWords reading from the file at path.
+func LoadStopWordsFromFile(path string) (StopWords, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return LoadStopWords(f)
+}
+
+// Add adds words to sw.
+func (sw StopWords) Add(words ...string) {
+	for _, w := range words {
+		sw[w] = struct{}{}
+	}
+}
+
+// Merge adds all words of other to sw.
+func (sw StopWords) Merge(other map[string]struct{}) {
+	for w := range other {
+		sw[w] = struct{}{}
+	}
+}
+
+// Remove removes words from sw.
+func (sw StopWords) Remove(words ...string) {
+	for _, w := range words {
+		delete(sw, w)
+	}
+}
+
+// Contains reports whether word is a stopword.
+func (sw StopWords) Contains(word string) bool {
+	_, ok := sw[word]
+	return ok
+}
@@ -0,0 +1,55 @@
+package simhash
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadStopWords(t *testing.T) {
+	sw, err := LoadStopWords(strings.NewReader("# site footer\ncopyright\n\n  all rights reserved  \r\nprivacy\n"))
+	assert.Empty(t, err)
+	assert.Equal(t, NewStopWords("copyright", "all rights reserved", "privacy"), sw)
+
+	dir, err := ioutil.TempDir("", "stopwords")
+	assert.Empty(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "footer.txt")
+	assert.Empty(t, ioutil.WriteFile(path, []byte("copyright\nprivacy\n"), 0644))
+	sw, err = LoadStopWordsFromFile(path)
+	assert.Empty(t, err)
+	assert.Equal(t, NewStopWords("copyright", "privacy"), sw)
+
+	_, err = LoadStopWordsFromFile(filepath.Join(dir, "missing.txt"))
+	assert.NotEmpty(t, err)
+}
+
+func TestSimHashStopWords(t *testing.T) {
+	text := []byte("the quick brown fox jumps over the lazy dog, copyright acme, privacy policy")
+
+	sh := NewSimHash(ENGLISH, "")
+	assert.Equal(t, true, sh.StopWords().Contains("the"))
+	assert.Equal(t, []string{"acme", "brown", "copyright", "dog", "fox", "jumps", "lazy", "policy", "privacy", "quick"}, sh.Tokens(text))
+
+	// extending one instance does not leak into the defaults or other instances.
+	sh.AddStopWords("copyright", "acme", "privacy", "policy")
+	assert.Equal(t, []string{"brown", "dog", "fox", "jumps", "lazy", "quick"}, sh.Tokens(text))
+	assert.Equal(t, false, NewSimHash(ENGLISH, "").StopWords().Contains("acme"))
+	_, ok := EnStopWords["acme"]
+	assert.Equal(t, false, ok)
+
+	sh.StopWords().Remove("lazy", "policy")
+	assert.Equal(t, []string{"brown", "dog", "fox", "jumps", "lazy", "policy", "quick"}, sh.Tokens(text))
+
+	sh.SetStopWords(NewStopWords("the", "over"))
+	assert.Equal(t, []string{"acme", "brown", "copyright", "dog", "fox", "jumps", "lazy", "policy", "privacy", "quick"}, sh.Tokens(text))
+
+	sh.SetStopWords(nil)
+	assert.Contains(t, sh.Tokens(text), "the")
+	sh.AddStopWords("the")
+	assert.NotContains(t, sh.Tokens(text), "the")
+}
@@ -0,0 +1,183 @@
+package simhash
+
+import (
+	"io"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/amazingchow/photon-dance-bigdata-toolkit/hash"
+)
+
+/*
+	Builder computes a fingerprint incrementally, without holding the document or its
+	concordance in memory. Scaling every weight by the same total does not change the
+	sign of any bit accumulator, so each token occurrence is added to the 64 accumulators
+	as soon as it is read, with a weight of 1 (or its idf if sh has one).
+
+	Written bytes are only tokenized up to the last white space or punctuation, the rest
+	waits for the next Write, so words are never split across two Writes. A run of more
+	than 64KB without any of them is cut at a UTF-8 boundary. Tokens spanning such a cut,
+	and the n-grams or shingles spanning any cut, are lost.
+
+	HTML is stripped as it is written, the other normalization steps apply to every
+	piece cut as above.
+
+	Compared with Fingerprint, Builder does not support topN, and bits whose accumulator
+	is very close to 0 may come out differently due to rounding.
+*/
+
+const _MaxPendingBytes = 1 << 16
+
+// Builder computes the fingerprint of a stream of bytes, it implements io.Writer. A Builder is not
+// safe for concurrent use, create one per goroutine.
+type Builder struct {
+	sh      *SimHash
+	html    htmlStripper
+	scratch []byte
+	pending []byte
+	weights [64]float64
+}
+
+// NewBuilder creates a Builder using the tokenizer, stopwords and idf of sh.
+func (sh *SimHash) NewBuilder() *Builder {
+	return &Builder{sh: sh, pending: make([]byte, 0, 4096)}
+}
+
+// Write implements io.Writer, it never returns an error.
+func (b *Builder) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		chunk := p
+		if room := _MaxPendingBytes - len(b.pending); len(chunk) > room {
+			chunk = chunk[:room]
+		}
+		p = p[len(chunk):]
+		if b.sh.normalization&NormalizeHTML != 0 {
+			b.scratch = b.html.strip(b.scratch[:0], chunk)
+			chunk = b.scratch
+		}
+		// what is left pending has no boundary, only the new bytes need to be scanned.
+		old := len(b.pending)
+		b.pending = append(b.pending, chunk...)
+
+		cut := 0
+		if i := lastBoundary(b.pending[old:]); i > 0 {
+			cut = old + i
+		} else if len(b.pending) >= _MaxPendingBytes {
+			cut = lastRuneStart(b.pending)
+		}
+		if cut > 0 {
+			b.consume(b.pending[:cut], &b.weights)
+			b.pending = append(b.pending[:0], b.pending[cut:]...)
+		}
+	}
+	return n, nil
+}
+
+// WriteString is the string version of Write.
+func (b *Builder) WriteString(s string) (int, error) {
+	return b.Write([]byte(s))
+}
+
+// ReadFrom implements io.ReaderFrom, it consumes r until EOF.
+func (b *Builder) ReadFrom(r io.Reader) (int64, error) {
+	buf := make([]byte, 32*1024)
+	var total int64
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			b.Write(buf[:n]) // nolint
+			total += int64(n)
+		}
+		if err == io.EOF {
+			return total, nil
+		}
+		if err != nil {
+			return total, err
+		}
+	}
+}
+
+// Fingerprint returns the fingerprint of all bytes written so far, more bytes can be written afterwards.
+func (b *Builder) Fingerprint() uint64 {
+	weights := b.weights
+	if b.sh.normalization&NormalizeHTML != 0 {
+		hs := b.html
+		b.consume(hs.flush(append([]byte(nil), b.pending...)), &weights)
+	} else {
+		b.consume(b.pending, &weights)
+	}
+
+	var fingerprint uint64
+	for i := uint(0); i < 64; i++ {
+		if weights[i] >= 0 {
+			fingerprint |= 1 << i
+		}
+	}
+	return fingerprint
+}
+
+// Reset discards all bytes written so far.
+func (b *Builder) Reset() {
+	b.html.flush(nil)
+	b.pending = b.pending[:0]
+	b.weights = [64]float64{}
+}
+
+func (b *Builder) consume(text []byte, weights *[64]float64) {
+	if len(text) == 0 {
+		return
+	}
+	tokens, language := b.sh.tokenizeStripped(text)
+	for _, token := range tokens {
+		if b.sh.isStopWord(token, language) {
+			continue
+		}
+		w := 1.0
+		if b.sh.idf != nil {
+			w = float64(b.sh.idf.Weight(token))
+		}
+		h := hash.FNV1A64(token)
+		for i := uint(0); i < 64; i++ {
+			if h>>i&1 == 1 {
+				weights[i] += w
+			} else {
+				weights[i] -= w
+			}
+		}
+	}
+}
+
+// FingerprintReader computes the fingerprint of everything read from r until EOF, with bounded memory.
+func (sh *SimHash) FingerprintReader(r io.Reader) (uint64, error) {
+	b := sh.NewBuilder()
+	if _, err := b.ReadFrom(r); err != nil {
+		return 0, err
+	}
+	return b.Fingerprint(), nil
+}
+
+// lastBoundary returns the position right after the last white space or punctuation of p, 0 if none.
+func lastBoundary(p []byte) int {
+	for i := len(p); i > 0; {
+		r, size := utf8.DecodeLastRune(p[:i])
+		if unicode.IsSpace(r) || unicode.IsPunct(r) {
+			return i
+		}
+		i -= size
+	}
+	return 0
+}
+
+// lastRuneStart returns the position of the start of the last, possibly incomplete, rune of p.
+func lastRuneStart(p []byte) int {
+	for i := len(p) - 1; i >= 0 && i >= len(p)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(p[i]) {
+			if utf8.FullRune(p[i:]) {
+				return len(p)
+			}
+			return i
+		}
+	}
+	return len(p)
+}
@@ -0,0 +1,87 @@
+package simhash
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math/bits"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// counts returns the number of occurrences of every token of text, after removing the stopwords.
+func counts(sh *SimHash, text []byte) map[string]float32 {
+	features := make(map[string]float32)
+	for _, token := range sh.tokenizer.Tokenize(text) {
+		if !sh.stopwords.Contains(token) {
+			features[token]++
+		}
+	}
+	return features
+}
+
+func TestBuilder(t *testing.T) {
+	sh := NewSimHash(ENGLISH, "")
+	text, err := ioutil.ReadFile("./fixtures/english1.txt")
+	assert.Empty(t, err)
+
+	// integer weights are exact, so the result matches FingerprintWeighted bit for bit.
+	expected := sh.FingerprintWeighted(counts(sh, text))
+	t.Logf("distance to Fingerprint: %d", bits.OnesCount64(expected^sh.Fingerprint(text)))
+
+	fp, err := sh.FingerprintReader(bytes.NewReader(text))
+	assert.Empty(t, err)
+	assert.Equal(t, expected, fp)
+
+	b := sh.NewBuilder()
+	for _, size := range []int{1, 3, 7, 100, 4096, len(text)} {
+		b.Reset()
+		for i := 0; i < len(text); i += size {
+			end := i + size
+			if end > len(text) {
+				end = len(text)
+			}
+			n, err := b.Write(text[i:end])
+			assert.Empty(t, err)
+			assert.Equal(t, end-i, n)
+		}
+		assert.Equal(t, expected, b.Fingerprint(), "chunk size %d", size)
+	}
+
+	// Fingerprint does not consume the pending bytes.
+	b.Reset()
+	b.WriteString("quick brown")
+	f1 := b.Fingerprint()
+	b.WriteString("ish fox")
+	assert.Equal(t, f1, sh.FingerprintWeighted(map[string]float32{"quick": 1, "brown": 1}))
+	assert.Equal(t, b.Fingerprint(), sh.FingerprintWeighted(map[string]float32{"quick": 1, "brownish": 1, "fox": 1}))
+}
+
+func TestBuilderLongRuns(t *testing.T) {
+	sh := NewSimHash(ENGLISH, "", NewNGramTokenizer(1))
+	// no white spaces or punctuation at all, cut at a rune boundary every 64KB.
+	text := strings.Repeat("汉字", 100000)
+	b := sh.NewBuilder()
+	for i := 0; i < len(text); i += 1000 {
+		end := i + 1000
+		if end > len(text) {
+			end = len(text)
+		}
+		b.WriteString(text[i:end])
+		assert.Equal(t, true, len(b.pending) <= _MaxPendingBytes)
+	}
+	assert.Equal(t, sh.FingerprintWeighted(map[string]float32{"汉": 100000, "字": 100000}), b.Fingerprint())
+}
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) { return 0, errors.New("boom") }
+
+func TestFingerprintReaderError(t *testing.T) {
+	sh := NewSimHash(ENGLISH, "")
+	_, err := sh.FingerprintReader(io.MultiReader(strings.NewReader("hello world"), failingReader{}))
+	assert.NotEmpty(t, err)
+}
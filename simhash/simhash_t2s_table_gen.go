@@ -0,0 +1,95 @@
+//go:build ignore
+// +build ignore
+
+// This program generates simhash_t2s_table.go, run it with
+//
+//	go run simhash_t2s_table_gen.go
+//
+// It needs the uconv tool of ICU (the table was generated with ICU 72.1). Every character of
+// the CJK Unified Ideographs blocks, Extension A and Extension B is converted with the
+// "Hant-Hans" transliterator, which is derived from the kSimplifiedVariant field of Unihan,
+// and the characters converted to a single other character are kept, but those of _Kept.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os/exec"
+	"strings"
+)
+
+// Han blocks to convert, as [first, last] code points.
+var _Blocks = [][2]rune{
+	{0x3400, 0x4dbf},   // Extension A
+	{0x4e00, 0x9fff},   // CJK Unified Ideographs
+	{0x20000, 0x2a6df}, // Extension B
+}
+
+// _Kept holds the characters the transliterator converts although simplified Chinese still writes
+// them with a meaning of their own, or which are variants rather than traditional forms: converting
+// them would corrupt simplified text.
+var _Kept = map[rune]string{
+	'乾': "qián, as in 乾坤 and 乾隆, only the traditional form of 干 as gān",
+	'藉': "jí, as in 狼藉 and 慰藉",
+	'瞭': "liào, as in 瞭望",
+	'夥': "huǒ, many, as in 获益甚夥",
+	'徵': "zhǐ, as in 宫商角徵羽",
+	'䶧': "a variant of 咬, not its traditional form",
+}
+
+func main() {
+	var in []rune
+	var lines strings.Builder
+	for _, b := range _Blocks {
+		for r := b[0]; r <= b[1]; r++ {
+			in = append(in, r)
+			lines.WriteRune(r)
+			lines.WriteByte('\n')
+		}
+	}
+
+	cmd := exec.Command("uconv", "-x", "Hant-Hans")
+	cmd.Stdin = strings.NewReader(lines.String())
+	out, err := cmd.Output()
+	if err != nil {
+		panic(fmt.Sprintf("uconv: %v", err))
+	}
+	converted := strings.Split(strings.TrimSuffix(string(out), "\n"), "\n")
+	if len(converted) != len(in) {
+		panic(fmt.Sprintf("uconv returned %d lines, expected %d", len(converted), len(in)))
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by simhash_t2s_table_gen.go from the ICU \"Hant-Hans\" transliterator; DO NOT EDIT.\n\n")
+	buf.WriteString("package simhash\n\n")
+	buf.WriteString("// _TraditionalToSimplified maps traditional Chinese characters to their simplified forms, one to one.\n")
+	buf.WriteString("var _TraditionalToSimplified = map[rune]rune{\n")
+	n := 0
+	for i, r := range in {
+		s := []rune(converted[i])
+		if _, ok := _Kept[r]; ok || len(s) != 1 || s[0] == r {
+			continue
+		}
+		if n%8 == 0 {
+			if n > 0 {
+				buf.WriteString("\n")
+			}
+			buf.WriteString("\t")
+		} else {
+			buf.WriteString(" ")
+		}
+		fmt.Fprintf(&buf, "'%c': '%c',", r, s[0])
+		n++
+	}
+	buf.WriteString("\n}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		panic(err)
+	}
+	if err := ioutil.WriteFile("simhash_t2s_table.go", src, 0644); err != nil {
+		panic(err)
+	}
+}
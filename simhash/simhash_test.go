@@ -17,7 +17,7 @@ func TestEnglishTypeSimHash(t *testing.T) {
 	assert.Empty(t, err)
 	f2 := sh.Fingerprint(text, 5)
 	t.Logf("fingerprint: %s", sh.FingerprintToString(f2))
-	t.Log(sh.IsEqual(f1, f2))
+	assert.True(t, sh.IsEqual(f1, f2))
 }
 
 func TestChinsesTypeSimHash(t *testing.T) {
@@ -30,5 +30,5 @@ func TestChinsesTypeSimHash(t *testing.T) {
 	assert.Empty(t, err)
 	f2 := sh.Fingerprint(text, 5)
 	t.Logf("fingerprint: %s", sh.FingerprintToString(f2))
-	t.Log(sh.IsEqual(f1, f2))
+	assert.True(t, sh.IsEqual(f1, f2))
 }
@@ -0,0 +1,303 @@
+package simhash
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+/*
+	A Tokenizer turns the text into the features SimHash hashes, the weight of a
+	feature is its relative frequency among all features of the text.
+
+	1. EnglishTokenizer: runs of letters, lower-cased, the default for ENGLISH.
+	2. ChineseTokenizer: dictionary-based segmentation, keeping the Chinese words only, the default for CHINESE.
+	3. WordTokenizer: runs of letters and digits, lower-cased, suits code and URLs.
+	4. NGramTokenizer: overlapping character n-grams, needs no segmentation at all.
+	5. ShingleTokenizer: overlapping word n-grams on top of another Tokenizer, keeps the word order.
+	6. RegexpTokenizer: every match of a regular expression.
+	7. CJKBigramTokenizer: overlapping character bigrams of the runs of Han, kana and Hangul
+	   characters, and words of the other letters, the default for JAPANESE and KOREAN.
+	8. AutoTokenizer: the Tokenizer of the language DetectLanguage finds, the default for AUTO.
+	9. MixedTokenizer: the Tokenizer of every script run's language, the default for MIXED.
+*/
+
+// Tokenizer splits text into tokens. The tokens outlive text, in an IDF or the result of Tokens,
+// so they must not share its memory: the caller may reuse text afterwards.
+type Tokenizer interface {
+	Tokenize(text []byte) []string
+}
+
+// EnglishTokenizer splits text on anything but letters and lower-cases the words.
+type EnglishTokenizer struct{}
+
+// Tokenize implements Tokenizer.
+func (EnglishTokenizer) Tokenize(text []byte) []string {
+	fc := func(r rune) bool { return !unicode.IsLetter(r) }
+	words := strings.FieldsFunc(string(text), fc)
+	for i := range words {
+		words[i] = strings.ToLower(words[i])
+	}
+	return words
+}
+
+// ChineseTokenizer segments text with a Segmenter and keeps the Chinese words only.
+type ChineseTokenizer struct {
+	segmenter *Segmenter
+}
+
+// NewChineseTokenizer creates a ChineseTokenizer, dict is a comma-separated list of dictionary files
+// in the format of sego and jieba. If dict is empty, the built-in dictionary is used. It returns an
+// error if a dictionary file can not be loaded.
+func NewChineseTokenizer(dict string) (*ChineseTokenizer, error) {
+	if dict == "" {
+		return &ChineseTokenizer{segmenter: NewSegmenter(nil)}, nil
+	}
+	d, err := LoadDictionaryFiles(dict)
+	if err != nil {
+		return nil, err
+	}
+	return &ChineseTokenizer{segmenter: NewSegmenter(d)}, nil
+}
+
+// Tokenize implements Tokenizer.
+func (t *ChineseTokenizer) Tokenize(text []byte) []string {
+	segments := t.segmenter.Segment(string(text))
+	words := segments[:0]
+	for _, s := range segments {
+		r, _ := utf8.DecodeRuneInString(s)
+		if unicode.Is(unicode.Han, r) {
+			words = append(words, s)
+		}
+	}
+	return words
+}
+
+// WordTokenizer splits text on anything but letters and digits and lower-cases the words.
+type WordTokenizer struct{}
+
+// Tokenize implements Tokenizer.
+func (WordTokenizer) Tokenize(text []byte) []string {
+	fc := func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) }
+	words := strings.FieldsFunc(string(text), fc)
+	for i := range words {
+		words[i] = strings.ToLower(words[i])
+	}
+	return words
+}
+
+// NGramTokenizer emits every run of N consecutive characters, after collapsing each run of
+// white spaces into one space. A text shorter than N characters is emitted as a whole.
+type NGramTokenizer struct {
+	N int
+}
+
+// NewNGramTokenizer creates a NGramTokenizer, n < 1 is treated as 1.
+func NewNGramTokenizer(n int) *NGramTokenizer {
+	if n < 1 {
+		n = 1
+	}
+	return &NGramTokenizer{N: n}
+}
+
+// Tokenize implements Tokenizer.
+func (t *NGramTokenizer) Tokenize(text []byte) []string {
+	runes := []rune(strings.Join(strings.Fields(string(text)), " "))
+	if len(runes) == 0 {
+		return nil
+	}
+	if len(runes) <= t.N {
+		return []string{string(runes)}
+	}
+	grams := make([]string, 0, len(runes)-t.N+1)
+	for i := 0; i+t.N <= len(runes); i++ {
+		grams = append(grams, string(runes[i:i+t.N]))
+	}
+	return grams
+}
+
+// ShingleTokenizer emits every run of N consecutive words produced by Words, joined by a space.
+// A text with fewer than N words is emitted as a whole.
+type ShingleTokenizer struct {
+	Words Tokenizer
+	N     int
+}
+
+// NewShingleTokenizer creates a ShingleTokenizer, n < 1 is treated as 1. If words is nil, WordTokenizer is used.
+func NewShingleTokenizer(words Tokenizer, n int) *ShingleTokenizer {
+	if words == nil {
+		words = WordTokenizer{}
+	}
+	if n < 1 {
+		n = 1
+	}
+	return &ShingleTokenizer{Words: words, N: n}
+}
+
+// Tokenize implements Tokenizer.
+func (t *ShingleTokenizer) Tokenize(text []byte) []string {
+	words := t.Words.Tokenize(text)
+	if len(words) == 0 {
+		return nil
+	}
+	if len(words) <= t.N {
+		return []string{strings.Join(words, " ")}
+	}
+	shingles := make([]string, 0, len(words)-t.N+1)
+	for i := 0; i+t.N <= len(words); i++ {
+		shingles = append(shingles, strings.Join(words[i:i+t.N], " "))
+	}
+	return shingles
+}
+
+// RegexpTokenizer emits every match of a regular expression.
+type RegexpTokenizer struct {
+	regExp *regexp.Regexp
+}
+
+// NewRegexpTokenizer creates a RegexpTokenizer, it returns an error if expr does not compile.
+func NewRegexpTokenizer(expr string) (*RegexpTokenizer, error) {
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	return &RegexpTokenizer{regExp: re}, nil
+}
+
+// Tokenize implements Tokenizer.
+func (t *RegexpTokenizer) Tokenize(text []byte) []string {
+	return t.regExp.FindAllString(string(text), -1)
+}
+
+// CJKBigramTokenizer emits every two consecutive characters of a run of Han, kana or Hangul
+// characters, a run of a single character is emitted as it is. Runs of other letters and
+// digits are emitted as words, lower-cased.
+type CJKBigramTokenizer struct{}
+
+// Tokenize implements Tokenizer.
+func (CJKBigramTokenizer) Tokenize(text []byte) []string {
+	var tokens []string
+	var run []rune
+	flushRun := func() {
+		switch len(run) {
+		case 0:
+		case 1:
+			tokens = append(tokens, string(run))
+		default:
+			for i := 0; i+1 < len(run); i++ {
+				tokens = append(tokens, string(run[i:i+2]))
+			}
+		}
+		run = run[:0]
+	}
+
+	s := string(text)
+	word := -1 // offset of the current word of other letters
+	for i, r := range s {
+		isWord := !isCJK(r) && (unicode.IsLetter(r) || unicode.IsDigit(r))
+		if word >= 0 && !isWord {
+			tokens = append(tokens, strings.ToLower(s[word:i]))
+			word = -1
+		}
+		switch {
+		case isCJK(r):
+			run = append(run, r)
+		case isWord:
+			flushRun()
+			if word < 0 {
+				word = i
+			}
+		default:
+			flushRun()
+		}
+	}
+	flushRun()
+	if word >= 0 {
+		tokens = append(tokens, strings.ToLower(s[word:]))
+	}
+	return tokens
+}
+
+// AutoTokenizer tokenizes every text with the Tokenizer of the language DetectLanguage finds,
+// or with the one of ENGLISH if that language has none. Note that the Builder detects the
+// language, and picks the stopwords, of every chunk it tokenizes rather than of the whole document.
+type AutoTokenizer struct {
+	Tokenizers map[LanguageType]Tokenizer
+}
+
+// NewAutoTokenizer creates an AutoTokenizer with the default Tokenizer of every language,
+// dict is passed to NewChineseTokenizer.
+func NewAutoTokenizer(dict string) (*AutoTokenizer, error) {
+	tokenizers, err := defaultTokenizers(dict, EnglishTokenizer{})
+	if err != nil {
+		return nil, err
+	}
+	return &AutoTokenizer{Tokenizers: tokenizers}, nil
+}
+
+// defaultTokenizers returns the default Tokenizer of every language but ENGLISH, which gets english.
+func defaultTokenizers(dict string, english Tokenizer) (map[LanguageType]Tokenizer, error) {
+	chinese, err := NewChineseTokenizer(dict)
+	if err != nil {
+		return nil, err
+	}
+	return map[LanguageType]Tokenizer{
+		ENGLISH:  english,
+		CHINESE:  chinese,
+		JAPANESE: CJKBigramTokenizer{},
+		KOREAN:   CJKBigramTokenizer{},
+	}, nil
+}
+
+// Tokenize implements Tokenizer.
+func (t *AutoTokenizer) Tokenize(text []byte) []string {
+	return t.tokenizeAs(text, DetectLanguage(text))
+}
+
+// tokenizeAs tokenizes text whose language has already been detected.
+func (t *AutoTokenizer) tokenizeAs(text []byte, language LanguageType) []string {
+	tok, ok := t.Tokenizers[language]
+	if !ok {
+		tok, ok = t.Tokenizers[ENGLISH]
+	}
+	if !ok {
+		tok = EnglishTokenizer{}
+	}
+	return tok.Tokenize(text)
+}
+
+// MixedTokenizer splits text into runs of one script and tokenizes every run with the Tokenizer
+// of its language, or with the one of ENGLISH if that language has none. Han runs are CHINESE, or
+// JAPANESE when they contain kana, Hangul runs are KOREAN, and runs of other letters and digits
+// are ENGLISH.
+type MixedTokenizer struct {
+	Tokenizers map[LanguageType]Tokenizer
+}
+
+// NewMixedTokenizer creates a MixedTokenizer with the default Tokenizer of every language, except
+// for ENGLISH runs which are split with WordTokenizer, keeping numbers such as model names. dict
+// is passed to NewChineseTokenizer.
+func NewMixedTokenizer(dict string) (*MixedTokenizer, error) {
+	tokenizers, err := defaultTokenizers(dict, WordTokenizer{})
+	if err != nil {
+		return nil, err
+	}
+	return &MixedTokenizer{Tokenizers: tokenizers}, nil
+}
+
+// Tokenize implements Tokenizer.
+func (t *MixedTokenizer) Tokenize(text []byte) []string {
+	var tokens []string
+	scriptRuns(text, func(language LanguageType, run []byte) {
+		tok, ok := t.Tokenizers[language]
+		if !ok {
+			tok, ok = t.Tokenizers[ENGLISH]
+		}
+		if !ok {
+			tok = WordTokenizer{}
+		}
+		tokens = append(tokens, tok.Tokenize(run)...)
+	})
+	return tokens
+}
@@ -0,0 +1,38 @@
+package simhash
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenizers(t *testing.T) {
+	text := []byte("GET /api/v2/Users?id=42 HTTP/1.1")
+
+	assert.Equal(t, []string{"get", "api", "v", "users", "id", "http"}, EnglishTokenizer{}.Tokenize(text))
+	assert.Equal(t, []string{"get", "api", "v2", "users", "id", "42", "http", "1", "1"}, WordTokenizer{}.Tokenize(text))
+
+	assert.Equal(t, []string{"ab", "b ", " c"}, NewNGramTokenizer(2).Tokenize([]byte(" ab \n\t c ")))
+	assert.Equal(t, []string{"你好世", "好世界"}, NewNGramTokenizer(3).Tokenize([]byte("你好世界")))
+	assert.Equal(t, []string{"ab"}, NewNGramTokenizer(3).Tokenize([]byte("ab")))
+	assert.Empty(t, NewNGramTokenizer(3).Tokenize([]byte("  ")))
+
+	shingles := NewShingleTokenizer(nil, 2).Tokenize([]byte("the quick brown fox"))
+	assert.Equal(t, []string{"the quick", "quick brown", "brown fox"}, shingles)
+	assert.Equal(t, []string{"fox"}, NewShingleTokenizer(nil, 2).Tokenize([]byte("fox")))
+
+	re, err := NewRegexpTokenizer(`/[a-z0-9]+`)
+	assert.Empty(t, err)
+	assert.Equal(t, []string{"/api", "/v2", "/1"}, re.Tokenize(text))
+	_, err = NewRegexpTokenizer(`(`)
+	assert.NotEmpty(t, err)
+}
+
+func TestSimHashWithTokenizer(t *testing.T) {
+	// the default Tokenizer of ENGLISH drops the digits.
+	sh := NewSimHash(ENGLISH, "")
+	assert.Equal(t, sh.Fingerprint([]byte("abc")), sh.Fingerprint([]byte("123abc")))
+
+	sh = NewSimHash(ENGLISH, "", WordTokenizer{})
+	assert.NotEqual(t, sh.Fingerprint([]byte("abc")), sh.Fingerprint([]byte("123abc")))
+}
@@ -6,10 +6,16 @@ import (
 )
 
 // Bytes2String fast type conversion from byte array to string, both share the same mem pointer.
+// buf must not be modified while the string, or any substring of it, is in use.
 func Bytes2String(buf []byte) string {
 	return *(*string)(unsafe.Pointer(&buf))
 }
 
+// cloneString returns a copy of s which does not share its memory.
+func cloneString(s string) string {
+	return string(append([]byte(nil), s...))
+}
+
 func GetByteOrder() binary.ByteOrder {
 	var nativeEndian binary.ByteOrder
 
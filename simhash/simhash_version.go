@@ -0,0 +1,61 @@
+package simhash
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+/*
+	Fingerprints computed by different releases can only be compared if they were computed
+	the same way. Given the same options (language, Tokenizer, dictionary, stopwords,
+	normalization, IDF and topN), version 1 of the algorithm computes the fingerprint of a
+	text as follows:
+
+	1. The text is normalized and tokenized, the weight of a token is the number of times it
+	   occurs divided by the number of tokens, then stopwords are removed and the weights are
+	   multiplied by the IDF weight of the token if any.
+	2. Features are sorted by decreasing weight, equal weights by increasing token, compared
+	   bytewise, and only the topN first ones are kept.
+	3. Every feature is hashed with 64-bit FNV-1a. WideFingerprint uses MurmurHash3_x64_128
+	   seeded with 0, 1, ... for every 128 bits instead.
+	4. The weight of a bit is the float32 sum, in the order of step 2, of +weight for the
+	   features whose hash has the bit set and -weight for the others, and the bit is set if
+	   its weight is not negative.
+
+	The output does not depend on map iteration order, scheduling or the platform. Builder
+	sums the weights of tokens in the order they are written, in float64.
+
+	AlgorithmVersion is bumped whenever any of these steps changes the fingerprint of some
+	input, changes of the built-in dictionary, stopwords and tables included, which the
+	golden vectors of simhash_golden_test.go catch. Store fingerprints with their version,
+	see FingerprintToVersioned, and recompute them when it changes. CHANGELOG.md lists the
+	changes of every version.
+*/
+
+// AlgorithmVersion is the version of the algorithm computing fingerprints.
+const AlgorithmVersion = 1
+
+// FingerprintToVersioned encodes fingerprint as "v", AlgorithmVersion, ":" and its 16 hexadecimal digits,
+// such as "v1:deadbeefcafebabe".
+func FingerprintToVersioned(fingerprint uint64) string {
+	return fmt.Sprintf("v%d:%016x", AlgorithmVersion, fingerprint)
+}
+
+// ParseFingerprintVersioned parses the output of FingerprintToVersioned, whatever its version. A
+// fingerprint whose version is not AlgorithmVersion should not be compared with new ones.
+func ParseFingerprintVersioned(s string) (version int, fingerprint uint64, err error) {
+	sep := strings.IndexByte(s, ':')
+	if sep < 0 || !strings.HasPrefix(s, "v") {
+		return 0, 0, fmt.Errorf("expected \"v<version>:<hex digits>\", got %q", s)
+	}
+	version, err = strconv.Atoi(s[1:sep])
+	if err != nil || version <= 0 {
+		return 0, 0, fmt.Errorf("invalid version %q", s[1:sep])
+	}
+	fingerprint, err = ParseFingerprintHex(s[sep+1:])
+	if err != nil {
+		return 0, 0, err
+	}
+	return version, fingerprint, nil
+}
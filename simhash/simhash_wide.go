@@ -0,0 +1,296 @@
+package simhash
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/bits"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/amazingchow/photon-dance-bigdata-toolkit/hash"
+)
+
+/*
+	A 64-bit fingerprint built from few tokens has few bits with a clear majority, so
+	unrelated short texts end up a few bits apart surprisingly often. Wider fingerprints
+	lower the variance of the distance between unrelated texts: with 128 or 256 bits the
+	same relative threshold (say 3/64 of the bits) is much less likely to be met by chance.
+
+	Every feature is hashed with MurmurHash3_x64_128, seeded with 0, 1, ... for every
+	128 bits of the fingerprint.
+*/
+
+const (
+	// Width128 is the width of a 128-bit WideFingerprint.
+	Width128 = 128
+	// Width256 is the width of a 256-bit WideFingerprint.
+	Width256 = 256
+)
+
+// WideFingerprint is a fingerprint wider than 64 bits, words[0] holds the most significant 64 bits.
+type WideFingerprint []uint64
+
+// Width returns the number of bits of fp.
+func (fp WideFingerprint) Width() int {
+	return 64 * len(fp)
+}
+
+// Distance returns the Hamming distance between fp and other, which must have the same width.
+func (fp WideFingerprint) Distance(other WideFingerprint) int {
+	if len(fp) != len(other) {
+		panic("mismatched fingerprint widths")
+	}
+	d := 0
+	for i := range fp {
+		d += bits.OnesCount64(fp[i] ^ other[i])
+	}
+	return d
+}
+
+// Similarity returns 1 - Distance / Width, 1 for identical fingerprints.
+func (fp WideFingerprint) Similarity(other WideFingerprint) float64 {
+	return 1 - float64(fp.Distance(other))/float64(fp.Width())
+}
+
+// Equal reports whether fp and other are identical.
+func (fp WideFingerprint) Equal(other WideFingerprint) bool {
+	return len(fp) == len(other) && fp.Distance(other) == 0
+}
+
+// String encodes fp as Width/4 lower-case hex digits.
+func (fp WideFingerprint) String() string {
+	var sb strings.Builder
+	for _, w := range fp {
+		fmt.Fprintf(&sb, "%016x", w)
+	}
+	return sb.String()
+}
+
+// ParseWideFingerprint decodes the output of WideFingerprint.String.
+func ParseWideFingerprint(s string) (WideFingerprint, error) {
+	if len(s) == 0 || len(s)%32 != 0 {
+		return nil, fmt.Errorf("expected a multiple of 32 hex digits, got %d", len(s))
+	}
+	buf, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	fp := make(WideFingerprint, len(buf)/8)
+	for i := range fp {
+		fp[i] = binary.BigEndian.Uint64(buf[8*i:])
+	}
+	return fp, nil
+}
+
+// FingerprintWide computes the width-bit fingerprint of text, width must be a positive multiple of 128.
+// Only the topNOpts[0] heaviest tokens are used if given.
+func (sh *SimHash) FingerprintWide(text []byte, width int, topNOpts ...uint32) (WideFingerprint, error) {
+	concordance, language := sh.processTokenize(text)
+	sh.processStopwords(concordance, language)
+	if sh.idf != nil {
+		sh.idf.apply(concordance)
+	}
+	return sh.FingerprintWeightedWide(concordance, width, topNOpts...)
+}
+
+// FingerprintWeightedWide is the wide version of FingerprintWeighted. As in the 64-bit fingerprint,
+// bit i of words[w] of the fingerprint comes from bit i of words[w] of the hashes of the features.
+func (sh *SimHash) FingerprintWeightedWide(features map[string]float32, width int, topNOpts ...uint32) (WideFingerprint, error) {
+	if width <= 0 || width%128 != 0 {
+		return nil, fmt.Errorf("unsupported fingerprint width %d", width)
+	}
+	selected, _ := selectFeatures(features, topNOpts...)
+
+	words := width / 64
+	// weights[64*w+i] belongs to bit i of words[w], bit 0 being the least significant one, see bitWeights.
+	weights := make([]float32, width)
+	h := make([]uint64, words)
+	for _, f := range selected {
+		for w := 0; w < words; w += 2 {
+			h[w], h[w+1] = hash.MURMUR3X64_128(f.Token, uint32(w/2))
+		}
+		for b := range weights {
+			if h[b/64]>>uint(b%64)&1 == 1 {
+				weights[b] += f.Weight
+			} else {
+				weights[b] -= f.Weight
+			}
+		}
+	}
+
+	fp := make(WideFingerprint, words)
+	for b, weight := range weights {
+		if weight >= 0 {
+			fp[b/64] |= 1 << uint(b%64)
+		}
+	}
+	return fp, nil
+}
+
+// WideIndex is the Index for WideFingerprint, all fingerprints must have the same width.
+type WideIndex struct {
+	mu sync.RWMutex
+
+	k      int
+	width  int
+	masks  []WideFingerprint
+	tables []map[string][]uint64
+
+	fingerprints map[uint64]WideFingerprint
+}
+
+// NewWideIndex creates a WideIndex for width-bit fingerprints (a positive multiple of 128), able to answer
+// queries up to Hamming distance k (0 <= k < width), using at most maxTables tables, see NewIndex.
+func NewWideIndex(width int, k int, maxTables int) *WideIndex {
+	if width <= 0 || width%128 != 0 {
+		panic("unsupported fingerprint width")
+	}
+	if k < 0 {
+		k = 0
+	}
+	if k > width-1 {
+		k = width - 1
+	}
+	n := blockCount(k, maxTables, width)
+
+	idx := &WideIndex{
+		k:            k,
+		width:        width,
+		fingerprints: make(map[uint64]WideFingerprint),
+	}
+
+	blockMasks := make([]WideFingerprint, n)
+	for i, r := range blockRanges(width, n) {
+		blockMasks[i] = make(WideFingerprint, width/64)
+		for b := r.offset; b < r.offset+r.width; b++ {
+			blockMasks[i][b/64] |= 1 << (b % 64)
+		}
+	}
+
+	// one table for every choice of n-k blocks.
+	combinations(n, n-k, func(chosen []int) {
+		mask := make(WideFingerprint, width/64)
+		for _, b := range chosen {
+			for i := range mask {
+				mask[i] |= blockMasks[b][i]
+			}
+		}
+		idx.masks = append(idx.masks, mask)
+		idx.tables = append(idx.tables, make(map[string][]uint64))
+	})
+	return idx
+}
+
+// K returns the maximum Hamming distance the WideIndex can answer queries for.
+func (idx *WideIndex) K() int {
+	return idx.k
+}
+
+// Tables returns the number of tables of the WideIndex.
+func (idx *WideIndex) Tables() int {
+	return len(idx.tables)
+}
+
+// Len returns the number of fingerprints inside the WideIndex.
+func (idx *WideIndex) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	return len(idx.fingerprints)
+}
+
+// tableKey returns the bits of fingerprint selected by mask.
+func tableKey(fingerprint WideFingerprint, mask WideFingerprint) string {
+	buf := make([]byte, 8*len(mask))
+	for i := range mask {
+		binary.LittleEndian.PutUint64(buf[8*i:], fingerprint[i]&mask[i])
+	}
+	return string(buf)
+}
+
+// Add adds the fingerprint of document id, replacing its previous fingerprint if any.
+func (idx *WideIndex) Add(id uint64, fingerprint WideFingerprint) {
+	if fingerprint.Width() != idx.width {
+		panic("mismatched fingerprint widths")
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if old, ok := idx.fingerprints[id]; ok {
+		if old.Equal(fingerprint) {
+			return
+		}
+		idx.remove(id, old)
+	}
+	idx.fingerprints[id] = append(WideFingerprint(nil), fingerprint...)
+	for t, mask := range idx.masks {
+		key := tableKey(fingerprint, mask)
+		idx.tables[t][key] = append(idx.tables[t][key], id)
+	}
+}
+
+// Remove removes document id from the WideIndex, it returns false if id is not inside the WideIndex.
+func (idx *WideIndex) Remove(id uint64) bool {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	fingerprint, ok := idx.fingerprints[id]
+	if !ok {
+		return false
+	}
+	delete(idx.fingerprints, id)
+	idx.remove(id, fingerprint)
+	return true
+}
+
+func (idx *WideIndex) remove(id uint64, fingerprint WideFingerprint) {
+	for t, mask := range idx.masks {
+		key := tableKey(fingerprint, mask)
+		ids := idx.tables[t][key]
+		for i := range ids {
+			if ids[i] == id {
+				ids[i] = ids[len(ids)-1]
+				ids = ids[:len(ids)-1]
+				break
+			}
+		}
+		if len(ids) == 0 {
+			delete(idx.tables[t], key)
+		} else {
+			idx.tables[t][key] = ids
+		}
+	}
+}
+
+// Query returns the ids of all documents whose fingerprints are within Hamming distance k of
+// fingerprint, in ascending order. k is capped by the k the WideIndex was created with.
+func (idx *WideIndex) Query(fingerprint WideFingerprint, k int) []uint64 {
+	if fingerprint.Width() != idx.width {
+		panic("mismatched fingerprint widths")
+	}
+	if k > idx.k {
+		k = idx.k
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	seen := make(map[uint64]struct{})
+	var ids []uint64
+	for t, mask := range idx.masks {
+		for _, id := range idx.tables[t][tableKey(fingerprint, mask)] {
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+			if idx.fingerprints[id].Distance(fingerprint) <= k {
+				ids = append(ids, id)
+			}
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
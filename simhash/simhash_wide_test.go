@@ -0,0 +1,99 @@
+package simhash
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/amazingchow/photon-dance-bigdata-toolkit/hash"
+)
+
+func TestWideFingerprint(t *testing.T) {
+	sh := NewSimHash(ENGLISH, "")
+	for _, width := range []int{Width128, Width256} {
+		f1, err := sh.FingerprintWide([]byte("the quick brown fox jumps over the lazy dog"), width)
+		assert.Empty(t, err)
+		f2, _ := sh.FingerprintWide([]byte("the quick brown fox jumped over the lazy dog"), width)
+		f3, _ := sh.FingerprintWide([]byte("bloom filters answer set membership queries"), width)
+		assert.Equal(t, width, f1.Width())
+		assert.Equal(t, 0, f1.Distance(f1))
+		assert.Equal(t, 1.0, f1.Similarity(f1))
+		assert.Equal(t, true, f1.Similarity(f2) > f1.Similarity(f3))
+
+		s := f1.String()
+		assert.Equal(t, width/4, len(s))
+		f, err := ParseWideFingerprint(s)
+		assert.Empty(t, err)
+		assert.Equal(t, f1, f)
+	}
+
+	_, err := ParseWideFingerprint("0123")
+	assert.NotEmpty(t, err)
+	_, err = ParseWideFingerprint("zz000000000000000000000000000000")
+	assert.NotEmpty(t, err)
+
+	// a single feature gives its own hash.
+	fp, err := sh.FingerprintWeightedWide(map[string]float32{"a": 1}, Width256)
+	assert.Empty(t, err)
+	h1, h2 := hash.MURMUR3X64_128("a", 0)
+	h3, h4 := hash.MURMUR3X64_128("a", 1)
+	assert.Equal(t, WideFingerprint{h1, h2, h3, h4}, fp)
+
+	// only the heaviest feature is kept, ties broken by token as in the 64-bit fingerprint.
+	fp, err = sh.FingerprintWeightedWide(map[string]float32{"b": 1, "a": 1, "c": 0.5}, Width128, 1)
+	assert.Empty(t, err)
+	assert.Equal(t, WideFingerprint{h1, h2}, fp)
+
+	for _, width := range []int{0, 64, 192, -128} {
+		_, err = sh.FingerprintWide([]byte("a"), width)
+		assert.NotEmpty(t, err, "width=%d", width)
+	}
+	assert.Panics(t, func() { WideFingerprint{0, 0}.Distance(WideFingerprint{0, 0, 0, 0}) })
+}
+
+func flipWideBits(rnd *rand.Rand, fp WideFingerprint, n int) WideFingerprint {
+	fp = append(WideFingerprint(nil), fp...)
+	for _, i := range rnd.Perm(fp.Width())[:n] {
+		fp[i/64] ^= 1 << uint(i%64)
+	}
+	return fp
+}
+
+func TestWideIndexQuery(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	idx := NewWideIndex(Width128, 6, 28)
+	assert.Equal(t, 28, idx.Tables())
+
+	fps := make(map[uint64]WideFingerprint)
+	var id uint64
+	for i := 0; i < 1000; i++ {
+		base := WideFingerprint{rnd.Uint64(), rnd.Uint64()}
+		fps[id] = base
+		id++
+		for _, d := range []int{1, 3, 6, 7} {
+			fps[id] = flipWideBits(rnd, base, d)
+			id++
+		}
+	}
+	for id, fp := range fps {
+		idx.Add(id, fp)
+	}
+	assert.Equal(t, len(fps), idx.Len())
+
+	for q := uint64(0); q < id; q += 7 {
+		var expected []uint64
+		for id, fp := range fps {
+			if fp.Distance(fps[q]) <= 6 {
+				expected = append(expected, id)
+			}
+		}
+		sort.Slice(expected, func(i, j int) bool { return expected[i] < expected[j] })
+		assert.Equal(t, expected, idx.Query(fps[q], 6))
+	}
+
+	assert.Equal(t, true, idx.Remove(0))
+	assert.Equal(t, false, idx.Remove(0))
+	assert.NotContains(t, idx.Query(fps[0], 6), uint64(0))
+}
@@ -1,6 +1,9 @@
 package bloomfilter
 
 import (
+	"encoding/binary"
+	"fmt"
+	"math"
 	"sync"
 
 	"github.com/rs/zerolog/log"
@@ -20,6 +23,15 @@ const (
 	_ln2_div_3 float64 = 0.231049
 )
 
+const (
+	// cap is rounded up to a multiple of _CapUnit bits, at most _MaxCap.
+	_CapUnit uint32 = 1024 * 1024 * 2
+	_MaxCap  uint32 = math.MaxUint32 / _CapUnit * _CapUnit
+
+	// number of indexes of an item, k.
+	_NumHashes = 3
+)
+
 type BitSet []uint32
 
 // BloomFilter implements the Standard-Bloom-Filter mentioned by
@@ -38,21 +50,33 @@ type BloomFilter struct {
 	markBitset  BitSet
 	markDeleted bool
 
-	hashCluster []hash.HashFunc
+	key     *hash.SipKey
+	indexes func(x string) [_NumHashes]uint32
 }
 
 func NewBloomFilter(cap uint32, withMarkDeleted bool) *BloomFilter {
+	return newBloomFilter(cap, withMarkDeleted, nil)
+}
+
+// NewKeyedBloomFilter creates a BloomFilter whose indexes are derived from SipHash keyed by key,
+// use it when items come from untrusted input. The key is persisted by Serialize.
+func NewKeyedBloomFilter(cap uint32, withMarkDeleted bool, key hash.SipKey) *BloomFilter {
+	return newBloomFilter(cap, withMarkDeleted, &key)
+}
+
+func newBloomFilter(cap uint32, withMarkDeleted bool, key *hash.SipKey) *BloomFilter {
 	if cap == 0 {
 		cap = 1024 * 1024 * 256
 	}
 	cap = resizeCap(cap)
 
 	bf := &BloomFilter{
-		bitset:      make([]uint32, (cap/_BitPerWord)+1),
-		cap:         cap,
-		cnt:         0,
-		readOnly:    false,
-		hashCluster: registerHashCluster(),
+		bitset:   make([]uint32, (cap/_BitPerWord)+1),
+		cap:      cap,
+		cnt:      0,
+		readOnly: false,
+		key:      key,
+		indexes:  registerIndexes(key, cap),
 	}
 
 	if withMarkDeleted {
@@ -63,22 +87,38 @@ func NewBloomFilter(cap uint32, withMarkDeleted bool) *BloomFilter {
 	return bf
 }
 
+// resizeCap rounds cap up to a multiple of _CapUnit, at least _CapUnit and at most _MaxCap.
 func resizeCap(cap uint32) uint32 {
-	const twoMb uint32 = 1024 * 1024 * 2
-
-	var x uint32 = twoMb
-	for cap > x {
-		x += twoMb
+	if cap > _MaxCap {
+		return _MaxCap
+	}
+	if cap <= _CapUnit {
+		return _CapUnit
 	}
-	return x
+	return (cap-1)/_CapUnit*_CapUnit + _CapUnit
 }
 
-func registerHashCluster() []hash.HashFunc {
-	hashes := make([]hash.HashFunc, 3)
-	hashes[0] = hash.DoubleHashing_7
-	hashes[1] = hash.DoubleHashing_13
-	hashes[2] = hash.DoubleHashing_19
-	return hashes
+// registerIndexes returns the function computing the k bit indexes in [0, cap) of an item with
+// enhanced double hashing, so the item is hashed once whatever k.
+func registerIndexes(key *hash.SipKey, cap uint32) func(x string) [_NumHashes]uint32 {
+	hash128 := hash.DefaultHash128
+	if key != nil {
+		sk := *key
+		// the low and high 32 bits of SipHash-2-4 are h1(x) and h2(x), enough for a cap below 2^32.
+		hash128 = func(x string) (uint64, uint64) {
+			h := hash.SIPHASH24(x, sk)
+			return h & math.MaxUint32, h >> 32
+		}
+	}
+	return func(x string) [_NumHashes]uint32 {
+		h1, h2 := hash128(x)
+		g := hash.NewKIndexGenerator(h1, h2, uint64(cap))
+		var indexes [_NumHashes]uint32
+		for i := range indexes {
+			indexes[i] = uint32(g.Next())
+		}
+		return indexes
+	}
 }
 
 // Insert inserts a string item.
@@ -92,8 +132,8 @@ func (bf *BloomFilter) Insert(x string) {
 		return
 	}
 
-	for _, h := range bf.hashCluster {
-		bf.bitset.set(h(x) % bf.cap)
+	for _, idx := range bf.indexes(x) {
+		bf.bitset.set(idx)
 	}
 	log.Debug().Msgf("%s has been inserted", x)
 
@@ -109,8 +149,8 @@ func (bf *BloomFilter) Member(x string) bool {
 	bf.mu.RLock()
 	defer bf.mu.RUnlock()
 
-	for _, h := range bf.hashCluster {
-		if bf.bitset.test(h(x)%bf.cap) == 0 {
+	for _, idx := range bf.indexes(x) {
+		if bf.bitset.test(idx) == 0 {
 			log.Debug().Msgf("%s is not the member", x)
 			return false
 		}
@@ -118,8 +158,8 @@ func (bf *BloomFilter) Member(x string) bool {
 
 	if bf.markDeleted {
 		hasMarked := true
-		for _, h := range bf.hashCluster {
-			if bf.markBitset.test(h(x)%bf.cap) == 0 {
+		for _, idx := range bf.indexes(x) {
+			if bf.markBitset.test(idx) == 0 {
 				hasMarked = false
 				break
 			}
@@ -134,8 +174,8 @@ func (bf *BloomFilter) Member(x string) bool {
 }
 
 func (bf *BloomFilter) member(x string) bool {
-	for _, h := range bf.hashCluster {
-		if bf.bitset.test(h(x)%bf.cap) == 0 {
+	for _, idx := range bf.indexes(x) {
+		if bf.bitset.test(idx) == 0 {
 			return false
 		}
 	}
@@ -151,12 +191,120 @@ func (bf *BloomFilter) MarkDelete(x string) {
 		return
 	}
 
-	for _, h := range bf.hashCluster {
-		bf.markBitset.set(h(x) % bf.cap)
+	for _, idx := range bf.indexes(x) {
+		bf.markBitset.set(idx)
 	}
 	log.Debug().Msgf("%s has been marked deleted", x)
 }
 
+const (
+	_FlagKeyed       byte = 1 << 0
+	_FlagMarkDeleted byte = 1 << 1
+	_FlagReadOnly    byte = 1 << 2
+
+	// flags + cap + cnt
+	_HeaderSize = 1 + 4 + 8
+)
+
+// Serialize returns a byte slice representing a BloomFilter.
+/*
+	layout (little-endian):
+		flags       1 byte
+		cap         4 bytes
+		cnt         8 bytes
+		key         16 bytes, only if the filter is keyed
+		bitset      (cap/32+1) * 4 bytes
+		markBitset  (cap/32+1) * 4 bytes, only if the filter marks deleted items
+*/
+func Serialize(bf *BloomFilter) []byte {
+	bf.mu.RLock()
+	defer bf.mu.RUnlock()
+
+	var flags byte
+	size := _HeaderSize + len(bf.bitset)*4
+	if bf.key != nil {
+		flags |= _FlagKeyed
+		size += len(bf.key)
+	}
+	if bf.markDeleted {
+		flags |= _FlagMarkDeleted
+		size += len(bf.markBitset) * 4
+	}
+	if bf.readOnly {
+		flags |= _FlagReadOnly
+	}
+
+	bytes := make([]byte, 0, size)
+	bytes = append(bytes, flags)
+	bytes = appendUint32(bytes, bf.cap)
+	bytes = appendUint64(bytes, bf.cnt)
+	if bf.key != nil {
+		bytes = append(bytes, bf.key[:]...)
+	}
+	for _, w := range bf.bitset {
+		bytes = appendUint32(bytes, w)
+	}
+	for _, w := range bf.markBitset {
+		bytes = appendUint32(bytes, w)
+	}
+	return bytes
+}
+
+// Deserialize returns a BloomFilter from a byte slice.
+func Deserialize(bytes []byte) (*BloomFilter, error) {
+	if len(bytes) < _HeaderSize {
+		return nil, fmt.Errorf("expected input byte slice to be at least %d bytes, got %d", _HeaderSize, len(bytes))
+	}
+
+	flags := bytes[0]
+	cap := binary.LittleEndian.Uint32(bytes[1:5])
+	cnt := binary.LittleEndian.Uint64(bytes[5:13])
+	// checked before resizeCap or any allocation, cap comes from untrusted bytes.
+	if cap == 0 || cap%_CapUnit != 0 || cap > _MaxCap {
+		return nil, fmt.Errorf("invalid cap %d", cap)
+	}
+	bytes = bytes[_HeaderSize:]
+
+	var key *hash.SipKey
+	if flags&_FlagKeyed != 0 {
+		if len(bytes) < len(hash.SipKey{}) {
+			return nil, fmt.Errorf("expected a %d-byte hash key, got %d bytes", len(hash.SipKey{}), len(bytes))
+		}
+		key = new(hash.SipKey)
+		copy(key[:], bytes)
+		bytes = bytes[len(key):]
+	}
+
+	words := int(cap/_BitPerWord) + 1
+	expected := words * 4
+	if flags&_FlagMarkDeleted != 0 {
+		expected *= 2
+	}
+	if len(bytes) != expected {
+		return nil, fmt.Errorf("expected %d bytes of bitset, got %d", expected, len(bytes))
+	}
+
+	bf := newBloomFilter(cap, flags&_FlagMarkDeleted != 0, key)
+	bf.cnt = cnt
+	bf.readOnly = flags&_FlagReadOnly != 0
+	for i := range bf.bitset {
+		bf.bitset[i] = binary.LittleEndian.Uint32(bytes[i*4:])
+	}
+	bytes = bytes[words*4:]
+	for i := range bf.markBitset {
+		bf.markBitset[i] = binary.LittleEndian.Uint32(bytes[i*4:])
+	}
+	return bf, nil
+}
+
+func appendUint32(b []byte, x uint32) []byte {
+	return append(b, byte(x), byte(x>>8), byte(x>>16), byte(x>>24))
+}
+
+func appendUint64(b []byte, x uint64) []byte {
+	return appendUint32(appendUint32(b, uint32(x)), uint32(x>>32))
+}
+
 func (bs BitSet) set(i uint32) {
 	bs[i>>_Shift] |= (1 << (i & _Mask))
 }
@@ -170,7 +318,7 @@ func (bs BitSet) test(i uint32) uint32 {
 }
 
 /*
-	p ~= (1 - e^(-k*n/m))^k, m = len(bitset), n = cnt, k = num(hash_cluster)
+	p ~= (1 - e^(-k*n/m))^k, m = len(bitset), n = cnt, k = _NumHashes
 	if we want to make sure the p stay the resonable value, make n < m * ln2 / k
 */
 func (bf *BloomFilter) reachTheUpLimit() bool {
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/amazingchow/photon-dance-bigdata-toolkit/hash"
 )
 
 func TestBloomFilter(t *testing.T) {
@@ -18,3 +20,78 @@ func TestBloomFilter(t *testing.T) {
 	bf.MarkDelete("PHA")
 	assert.Equal(t, false, bf.Member("PHA"))
 }
+
+func TestKeyedBloomFilterSerialization(t *testing.T) {
+	key, err := hash.NewSipKey()
+	assert.Empty(t, err)
+
+	bf := NewKeyedBloomFilter(0, true, key)
+	bf.Insert("BTC")
+	bf.Insert("ETH")
+	bf.Insert("PHA")
+	bf.MarkDelete("PHA")
+
+	nbf, err := Deserialize(Serialize(bf))
+	assert.Empty(t, err)
+	assert.Equal(t, key, *nbf.key)
+	assert.Equal(t, true, nbf.Member("BTC"))
+	assert.Equal(t, true, nbf.Member("ETH"))
+	assert.Equal(t, false, nbf.Member("PHA"))
+	assert.Equal(t, false, nbf.Member("DOT"))
+
+	bf = NewBloomFilter(0, false)
+	bf.Insert("BTC")
+	nbf, err = Deserialize(Serialize(bf))
+	assert.Empty(t, err)
+	assert.Nil(t, nbf.key)
+	assert.Equal(t, true, nbf.Member("BTC"))
+
+	_, err = Deserialize([]byte{0x00})
+	assert.NotEmpty(t, err)
+}
+
+func TestResizeCap(t *testing.T) {
+	assert.Equal(t, _CapUnit, resizeCap(0))
+	assert.Equal(t, _CapUnit, resizeCap(_CapUnit))
+	assert.Equal(t, 2*_CapUnit, resizeCap(_CapUnit+1))
+	assert.Equal(t, _MaxCap, resizeCap(_MaxCap-1))
+	assert.Equal(t, _MaxCap, resizeCap(_MaxCap+1))
+	assert.Equal(t, _MaxCap, resizeCap(0xffffffff))
+}
+
+func TestDeserializeCorrupted(t *testing.T) {
+	header := func(flags byte, cap uint32) []byte {
+		return appendUint64(appendUint32([]byte{flags}, cap), 0)
+	}
+	valid := Serialize(NewBloomFilter(0, false))
+
+	for name, bytes := range map[string][]byte{
+		"empty":     nil,
+		"zero cap":  header(0, 0),
+		"cap":       header(0, _CapUnit+1),
+		"max cap":   header(0, 0xffffffff),
+		"key":       header(_FlagKeyed, _CapUnit),
+		"bitset":    valid[:len(valid)-1],
+		"markset":   append(header(_FlagMarkDeleted, _CapUnit), valid[_HeaderSize:]...),
+		"truncated": valid[:_HeaderSize],
+	} {
+		_, err := Deserialize(bytes)
+		assert.NotEmpty(t, err, name)
+	}
+}
+
+func TestIndexes(t *testing.T) {
+	var key hash.SipKey
+	for i := range key {
+		key[i] = byte(i)
+	}
+	h := hash.SIPHASH24("BTC", key)
+	keyed := hash.AppendKIndexes(nil, h&0xffffffff, h>>32, _NumHashes, uint64(_CapUnit))
+	unkeyed := hash.KIndexes("BTC", _NumHashes, uint64(_CapUnit))
+	for i, idx := range registerIndexes(&key, _CapUnit)("BTC") {
+		assert.Equal(t, keyed[i], uint64(idx))
+	}
+	for i, idx := range registerIndexes(nil, _CapUnit)("BTC") {
+		assert.Equal(t, unkeyed[i], uint64(idx))
+	}
+}